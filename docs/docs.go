@@ -3,5 +3,5 @@ package docs
 import "embed"
 
 // Docs are Ignite CLI docs.
-//go:embed *.md */*.md
+//go:embed *.md */*.md */*/*.md */*/*/*.md
 var Docs embed.FS
@@ -0,0 +1,72 @@
+package chainconfig
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+// Access restricts the access to the API and the faucet when they can be reached from other
+// machines, the requests sent from the machine serving the chain are always allowed.
+type Access struct {
+	// Tokens are the tokens accepted in the X-Access-Token header of the requests, the requests are
+	// authenticated when they're set. The environment variables of the tokens are expanded, e.g.
+	// ${DEVNET_TOKEN}, to keep them out of config.yml.
+	Tokens []string `yaml:"tokens"`
+
+	// AllowedIPs are the IPs and the CIDR ranges allowed to send requests, e.g. 10.0.0.0/8.
+	// All the IPs are allowed when they're not set.
+	AllowedIPs []string `yaml:"allowed_ips"`
+}
+
+// IsSet checks if the access is restricted.
+func (a Access) IsSet() bool {
+	return len(a.Tokens) > 0 || len(a.AllowedIPs) > 0
+}
+
+// Control returns the access control of the requests.
+func (a Access) Control() (xhttp.AccessControl, error) {
+	return xhttp.NewAccessControl(a.Tokens, a.AllowedIPs)
+}
+
+// IsAPIProxied checks if the API of the node is served behind a proxy controlling its access,
+// this is the case when the access is restricted and the API can be reached from other machines.
+// The API is served as is when its address is overwritten by the node config of init.
+func IsAPIProxied(conf Config) bool {
+	if !conf.Host.Access.IsSet() {
+		return false
+	}
+	for _, server := range Servers(conf) {
+		if server.Name == "API" {
+			return server.Key == "host.api" && server.IsExposed()
+		}
+	}
+	return false
+}
+
+func expandAccessTokens(access Access) Access {
+	if access.Tokens == nil {
+		return access
+	}
+	tokens := make([]string, len(access.Tokens))
+	for i, token := range access.Tokens {
+		tokens[i] = os.ExpandEnv(token)
+	}
+	access.Tokens = tokens
+	return access
+}
+
+func validateAccess(access Access) error {
+	for i, token := range access.Tokens {
+		if token == "" {
+			return &ValidationError{fmt.Sprintf("host.access.tokens[%d] is empty, check that its environment variables are set", i)}
+		}
+	}
+	for _, ip := range access.AllowedIPs {
+		if _, err := xhttp.ParseNetwork(ip); err != nil {
+			return &ValidationError{fmt.Sprintf("invalid host.access.allowed_ips: %s", err)}
+		}
+	}
+	return nil
+}
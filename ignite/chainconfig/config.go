@@ -6,10 +6,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/goccy/go-yaml"
 	"github.com/imdario/mergo"
 
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
 )
 
@@ -38,6 +41,9 @@ var DefaultConf = Config{
 		GRPC:    "0.0.0.0:9090",
 		GRPCWeb: "0.0.0.0:9091",
 		API:     "0.0.0.0:1317",
+		Rosetta: "0.0.0.0:8080",
+		// the Vite dev server of the frontend only listens on the local machine by default.
+		Frontend: "localhost:3000",
 	},
 	Build: Build{
 		Proto: Proto{
@@ -53,6 +59,14 @@ var DefaultConf = Config{
 	},
 }
 
+// DefaultPruning is the pruning of the state when the config doesn't set a strategy, a development
+// chain doesn't need the states of past heights so only the recent ones are kept.
+var DefaultPruning = Pruning{
+	Strategy:   PruningCustom,
+	KeepRecent: 100,
+	Interval:   10,
+}
+
 // Config is the user given configuration to do additional setup
 // during serve.
 type Config struct {
@@ -64,6 +78,21 @@ type Config struct {
 	Init      Init                   `yaml:"init"`
 	Genesis   map[string]interface{} `yaml:"genesis"`
 	Host      Host                   `yaml:"host"`
+	Plugins   []Plugin               `yaml:"plugins"`
+
+	// Denoms are the denominations of the chain with their metadata.
+	Denoms []Denom `yaml:"denoms"`
+
+	// Rosetta configures the Rosetta API gateway of the node.
+	Rosetta Rosetta `yaml:"rosetta"`
+
+	// AddressCodec is the codec of the account addresses of the chain, bech32 by default or hex.
+	// The scaffolded modules and the generated clients encode the addresses with it.
+	AddressCodec string `yaml:"address_codec,omitempty"`
+
+	// Profiles are the named overrides of the config, e.g. local, testnet and mainnet, only the
+	// selected one is applied.
+	Profiles map[string]Profile `yaml:"profiles"`
 }
 
 // AccountByName finds account by name.
@@ -86,12 +115,146 @@ type Account struct {
 
 	// The RPCAddress off the chain that account is issued at.
 	RPCAddress string `yaml:"rpc_address,omitempty"`
+
+	// Vesting makes the account a vesting account, a part of its coins is locked until it vests.
+	Vesting *AccountVesting `yaml:"vesting,omitempty"`
+
+	// Keyring imports the account with the same name of the Ignite CLI keyring, managed with the
+	// account commands, into the keyring of the chain instead of creating a new account.
+	Keyring bool `yaml:"keyring,omitempty"`
+}
+
+// Rosetta configures the Rosetta API gateway started by the node, it serves the Rosetta Data and
+// Construction APIs used by the exchanges to integrate the chain.
+type Rosetta struct {
+	// Enabled starts the gateway with the node.
+	Enabled bool `yaml:"enabled"`
+
+	// Blockchain is the blockchain of the network identifier of the gateway, the name of the app by default.
+	Blockchain string `yaml:"blockchain,omitempty"`
+
+	// Network is the network of the network identifier of the gateway, the chain ID by default.
+	Network string `yaml:"network,omitempty"`
+}
+
+// Plugin declares an external plugin of the CLI, a binary adding commands and hooks to ignite.
+type Plugin struct {
+	// Name of the plugin.
+	Name string `yaml:"name"`
+
+	// Path of the binary of the plugin, relative to the config.
+	Path string `yaml:"path"`
+
+	// With holds the parameters passed to the plugin.
+	With map[string]string `yaml:"with,omitempty"`
 }
 
 // Validator holds info related to validator settings.
 type Validator struct {
 	Name   string `yaml:"name"`
 	Staked string `yaml:"staked"`
+
+	// BlockTime is the time between two blocks, e.g. 1s or 6s.
+	// It's a shorthand for the timeout_commit of the consensus.
+	BlockTime string `yaml:"block_time"`
+
+	// Consensus overwrites the consensus timeouts of appd's config/config.toml.
+	Consensus Consensus `yaml:"consensus"`
+
+	// Pruning overwrites the pruning and the snapshot settings of appd's config/app.toml.
+	Pruning Pruning `yaml:"pruning"`
+
+	// RemoteSigner signs the blocks of the validator with a remote signer, e.g. tmkms, instead of
+	// the key of the node.
+	RemoteSigner RemoteSigner `yaml:"remote_signer"`
+}
+
+// RemoteSigner configures the remote signer of a validator, the node listens on Address and the
+// remote signer dials it to sign the blocks.
+type RemoteSigner struct {
+	// Address is the priv_validator_laddr of appd's config/config.toml, e.g. tcp://127.0.0.1:26659
+	// or unix:///tmp/signer.sock.
+	Address string `yaml:"address"`
+
+	// Mock runs a mock remote signer signing with the key of the node, to rehearse the setup of a
+	// remote signer locally.
+	Mock bool `yaml:"mock"`
+}
+
+// IsSet returns true when the validator signs with a remote signer.
+func (r RemoteSigner) IsSet() bool {
+	return r.Address != ""
+}
+
+// Pruning strategies of the state of the chain.
+const (
+	PruningDefault    = "default"
+	PruningNothing    = "nothing"
+	PruningEverything = "everything"
+	PruningCustom     = "custom"
+)
+
+// Pruning configures the states and the blocks kept by the node.
+type Pruning struct {
+	// Strategy is the pruning strategy of the state: default, nothing, everything or custom.
+	// The state is pruned with DefaultPruning when it's empty.
+	Strategy string `yaml:"strategy"`
+
+	// KeepRecent is the number of recent states kept with the custom strategy.
+	KeepRecent uint64 `yaml:"keep_recent"`
+
+	// Interval is the number of blocks between two prunings of the state with the custom strategy.
+	Interval uint64 `yaml:"interval"`
+
+	// MinRetainBlocks is the minimum number of recent blocks kept by the node, 0 keeps all the blocks.
+	MinRetainBlocks uint64 `yaml:"min_retain_blocks"`
+
+	// SnapshotInterval is the number of blocks between two state sync snapshots, 0 disables them.
+	SnapshotInterval uint64 `yaml:"snapshot_interval"`
+}
+
+// WithDefaultStrategy returns the pruning with the strategy of DefaultPruning when it's not set.
+func (p Pruning) WithDefaultStrategy() Pruning {
+	if p.Strategy == "" {
+		p.Strategy = DefaultPruning.Strategy
+		p.KeepRecent = DefaultPruning.KeepRecent
+		p.Interval = DefaultPruning.Interval
+	}
+	return p
+}
+
+// Consensus holds the timeouts of the consensus, they are durations, e.g. 500ms or 3s.
+type Consensus struct {
+	TimeoutPropose        string `yaml:"timeout_propose"`
+	TimeoutProposeDelta   string `yaml:"timeout_propose_delta"`
+	TimeoutPrevote        string `yaml:"timeout_prevote"`
+	TimeoutPrevoteDelta   string `yaml:"timeout_prevote_delta"`
+	TimeoutPrecommit      string `yaml:"timeout_precommit"`
+	TimeoutPrecommitDelta string `yaml:"timeout_precommit_delta"`
+	TimeoutCommit         string `yaml:"timeout_commit"`
+}
+
+// Timeouts returns the consensus timeouts that are set by their config.toml key.
+// The block time is the timeout_commit when it's not set.
+func (v Validator) Timeouts() map[string]string {
+	timeouts := map[string]string{
+		"timeout_propose":         v.Consensus.TimeoutPropose,
+		"timeout_propose_delta":   v.Consensus.TimeoutProposeDelta,
+		"timeout_prevote":         v.Consensus.TimeoutPrevote,
+		"timeout_prevote_delta":   v.Consensus.TimeoutPrevoteDelta,
+		"timeout_precommit":       v.Consensus.TimeoutPrecommit,
+		"timeout_precommit_delta": v.Consensus.TimeoutPrecommitDelta,
+		"timeout_commit":          v.Consensus.TimeoutCommit,
+	}
+	if timeouts["timeout_commit"] == "" {
+		timeouts["timeout_commit"] = v.BlockTime
+	}
+	for key, timeout := range timeouts {
+		if timeout == "" {
+			delete(timeouts, key)
+		}
+	}
+	return timeouts
 }
 
 // Build holds build configs.
@@ -110,18 +273,45 @@ type Proto struct {
 	// ThirdPartyPath is the relative path of where the third party proto files are
 	// located that used by the app.
 	ThirdPartyPaths []string `yaml:"third_party_paths"`
+
+	// Layout is the layout of the Go code generated from the proto files.
+	// The gogo layout is used by default.
+	Layout string `yaml:"layout"`
 }
 
+// Layouts of the Go code generated from the proto files.
+const (
+	// ProtoLayoutGogo generates the Go code with the gogoproto plugin in the packages
+	// defined by the go_package option of the proto files.
+	ProtoLayoutGogo = "gogo"
+
+	// ProtoLayoutPulsar generates the Go code of the gogo layout and the Go code generated
+	// with the pulsar plugin in the api directory of the app.
+	ProtoLayoutPulsar = "pulsar"
+)
+
 // Client configures code generation for clients.
 type Client struct {
 	// Vuex configures code generation for Vuex.
 	Vuex Vuex `yaml:"vuex"`
 
+	// React configures the generation of the React Query hooks.
+	React React `yaml:"react"`
+
 	// Dart configures client code generation for Dart.
 	Dart Dart `yaml:"dart"`
 
 	// OpenAPI configures OpenAPI spec generation for API.
 	OpenAPI OpenAPI `yaml:"openapi"`
+
+	// Components configures the generation of the UI components of the stored types.
+	Components Components `yaml:"components"`
+
+	// Go configures the generation of the Go client.
+	Go GoClient `yaml:"go"`
+
+	// Docs configures the generation of the reference docs of the modules.
+	Docs Docs `yaml:"docs"`
 }
 
 // Vuex configures code generation for Vuex.
@@ -130,6 +320,12 @@ type Vuex struct {
 	Path string `yaml:"path"`
 }
 
+// React configures the generation of the React Query hooks.
+type React struct {
+	// Path configures out location for the generated React hooks.
+	Path string `yaml:"path"`
+}
+
 // Dart configures client code generation for Dart.
 type Dart struct {
 	// Path configures out location for generated Dart code.
@@ -139,6 +335,40 @@ type Dart struct {
 // OpenAPI configures OpenAPI spec generation for API.
 type OpenAPI struct {
 	Path string `yaml:"path"`
+
+	// Version of the OpenAPI specs, 2 or 3. It's 2 by default.
+	Version int `yaml:"version"`
+
+	// ModulesPath configures the dir of the specs of the modules of the app, <module name>.yml.
+	// The specs of the modules aren't generated when it's empty.
+	ModulesPath string `yaml:"modules_path"`
+
+	// Modules overrides the paths of the specs of the modules by module name.
+	Modules map[string]string `yaml:"modules"`
+}
+
+// Docs configures the generation of the reference docs of the modules.
+type Docs struct {
+	// Path configures out location for the generated docs.
+	Path string `yaml:"path"`
+
+	// Format of the docs, markdown or html. It's markdown by default.
+	Format string `yaml:"format"`
+}
+
+// Components configures the generation of the UI components of the stored types.
+type Components struct {
+	// Path configures out location for generated components.
+	Path string `yaml:"path"`
+
+	// Framework is the frontend framework of the components, vue or react.
+	Framework string `yaml:"framework"`
+}
+
+// GoClient configures the generation of the Go client.
+type GoClient struct {
+	// Path configures out location for generated Go client package.
+	Path string `yaml:"path"`
 }
 
 // Faucet configuration.
@@ -161,6 +391,48 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port"`
+
+	// TestAccounts enables the endpoint creating funded test accounts.
+	TestAccounts bool `yaml:"test_accounts"`
+
+	// RateLimit limits the requests per receiver address and per IP.
+	RateLimit FaucetRateLimit `yaml:"rate_limit"`
+
+	// Captcha requires the requests to be verified by a CAPTCHA.
+	Captcha FaucetCaptcha `yaml:"captcha"`
+
+	// Batch sends the requests received in a time window in a single tx.
+	Batch FaucetBatch `yaml:"batch"`
+}
+
+// FaucetBatch configures the batches of the requests to the faucet.
+type FaucetBatch struct {
+	// Window is the duration during which the requests are collected in a batch, e.g. 2s.
+	Window string `yaml:"window"`
+
+	// MaxSize is the maximum number of requests in a batch.
+	MaxSize int `yaml:"max_size"`
+}
+
+// FaucetRateLimit limits the requests to the faucet in a time window.
+type FaucetRateLimit struct {
+	// Window is the duration of the window, e.g. 1h.
+	Window string `yaml:"window"`
+
+	// PerAddress is the maximum number of requests per receiver address in the window.
+	PerAddress int `yaml:"per_address"`
+
+	// PerIP is the maximum number of requests per IP in the window.
+	PerIP int `yaml:"per_ip"`
+}
+
+// FaucetCaptcha configures the CAPTCHA verifying the requests to the faucet.
+type FaucetCaptcha struct {
+	// Provider is the CAPTCHA service, hcaptcha or recaptcha.
+	Provider string `yaml:"provider"`
+
+	// SiteKey is the public site key of the CAPTCHA widget.
+	SiteKey string `yaml:"site_key"`
 }
 
 // Init overwrites sdk configurations with given values.
@@ -179,6 +451,36 @@ type Init struct {
 
 	// KeyringBackend is the default keyring backend to use for blockchain initialization
 	KeyringBackend string `yaml:"keyring-backend"`
+
+	// KeyringService is the name of the OS keyring service storing the accounts of the project
+	// created with the account commands.
+	KeyringService string `yaml:"keyring-service"`
+
+	// Genesis is an existing genesis the node is initialized with instead of generating one.
+	Genesis InitGenesis `yaml:"genesis"`
+
+	// Seeds is the list of seed nodes the node connects to.
+	Seeds []string `yaml:"seeds"`
+
+	// PersistentPeers is the list of peers the node keeps a connection with.
+	PersistentPeers []string `yaml:"persistent_peers"`
+}
+
+// InitGenesis is an existing genesis fetched from a URL or read from a local file.
+type InitGenesis struct {
+	// URL is the URL to fetch the genesis from.
+	URL string `yaml:"url"`
+
+	// SHA256 is the hex encoded hash the genesis must have, it is required with URL.
+	SHA256 string `yaml:"sha256"`
+
+	// File is the path of the genesis file, relative to the app path.
+	File string `yaml:"file"`
+}
+
+// IsSet returns true when an existing genesis is used.
+func (g InitGenesis) IsSet() bool {
+	return g.URL != "" || g.File != ""
 }
 
 // Host keeps configuration related to started servers.
@@ -189,41 +491,224 @@ type Host struct {
 	GRPC    string `yaml:"grpc"`
 	GRPCWeb string `yaml:"grpc-web"`
 	API     string `yaml:"api"`
+
+	// Rosetta is the address of the Rosetta API gateway, served when it's enabled.
+	Rosetta string `yaml:"rosetta"`
+
+	// Frontend is the address of the dev server of the Vue frontend, started with npm run dev.
+	Frontend string `yaml:"frontend"`
+
+	// Bind is the interface all the servers listen on, including the faucet, e.g. 127.0.0.1, 0.0.0.0 or ::.
+	// It replaces the host of the server addresses and keeps their ports.
+	Bind string `yaml:"bind"`
+
+	// Access restricts the access to the API and the faucet when they can be reached from other machines.
+	Access Access `yaml:"access"`
 }
 
 // Parse parses config.yml into UserConfig.
 func Parse(r io.Reader) (Config, error) {
+	return ParseProfile(r, "")
+}
+
+// ParseProfile parses config.yml into UserConfig overridden by the profile, the profiles are ignored
+// when it's empty.
+func ParseProfile(r io.Reader, profile string) (Config, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Config{}, err
+	}
+	if profile != "" {
+		if data, err = applyProfile(data, profile); err != nil {
+			return Config{}, err
+		}
+	}
+
 	var conf Config
-	if err := yaml.NewDecoder(r).Decode(&conf); err != nil {
+	if err := yaml.Unmarshal(data, &conf); err != nil {
 		return conf, err
 	}
 	if err := mergo.Merge(&conf, DefaultConf); err != nil {
 		return Config{}, err
 	}
-	return conf, validate(conf)
+	conf.Host.Access = expandAccessTokens(conf.Host.Access)
+	if err := validate(conf); err != nil {
+		return conf, err
+	}
+	conf, err = bindHost(conf)
+	if err != nil {
+		return conf, err
+	}
+	return conf, validatePorts(conf)
 }
 
 // ParseFile parses config.yml from the path.
 func ParseFile(path string) (Config, error) {
+	return ParseFileProfile(path, "")
+}
+
+// ParseFileProfile parses config.yml from the path overridden by the profile, see ParseProfile.
+func ParseFileProfile(path, profile string) (Config, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return Config{}, nil
 	}
 	defer file.Close()
-	return Parse(file)
+	return ParseProfile(file, profile)
 }
 
 // validate validates user config.
 func validate(conf Config) error {
-	if len(conf.Accounts) == 0 {
-		return &ValidationError{"at least 1 account is needed"}
+	if err := validateInitGenesis(conf.Init.Genesis); err != nil {
+		return err
 	}
-	if conf.Validator.Name == "" {
-		return &ValidationError{"validator is required"}
+
+	// the accounts and the validator are only added to a generated genesis
+	if !conf.Init.Genesis.IsSet() {
+		if len(conf.Accounts) == 0 {
+			return &ValidationError{"at least 1 account is needed"}
+		}
+		if conf.Validator.Name == "" {
+			return &ValidationError{"validator is required"}
+		}
+		for _, account := range conf.Accounts {
+			if err := validateVesting(account); err != nil {
+				return err
+			}
+			if account.Keyring && (account.Mnemonic != "" || account.Address != "") {
+				return &ValidationError{fmt.Sprintf("account %q from the keyring can't have a mnemonic or an address", account.Name)}
+			}
+		}
+		if err := validateDenoms(conf); err != nil {
+			return err
+		}
+	}
+	if err := validateValidatorTimeouts(conf.Validator); err != nil {
+		return err
+	}
+	if err := validatePruning(conf.Validator.Pruning); err != nil {
+		return err
+	}
+	if err := validateRemoteSigner(conf.Validator.RemoteSigner); err != nil {
+		return err
+	}
+	switch conf.Client.OpenAPI.Version {
+	case 0, 2, 3:
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"invalid client openapi.version %d, accepted values are 2 and 3",
+			conf.Client.OpenAPI.Version,
+		)}
+	}
+	if err := validatePlugins(conf.Plugins); err != nil {
+		return err
+	}
+	if err := validateAccess(conf.Host.Access); err != nil {
+		return err
+	}
+	switch conf.Build.Proto.Layout {
+	case "", ProtoLayoutGogo, ProtoLayoutPulsar:
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"invalid proto layout %q, accepted values are %q and %q",
+			conf.Build.Proto.Layout,
+			ProtoLayoutGogo,
+			ProtoLayoutPulsar,
+		)}
+	}
+	switch conf.AddressCodec {
+	case "", cosmosaccount.AddressCodecBech32, cosmosaccount.AddressCodecHex:
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"invalid address codec %q, accepted values are %q and %q",
+			conf.AddressCodec,
+			cosmosaccount.AddressCodecBech32,
+			cosmosaccount.AddressCodecHex,
+		)}
 	}
 	return nil
 }
 
+func validateInitGenesis(genesis InitGenesis) error {
+	if genesis.URL != "" && genesis.File != "" {
+		return &ValidationError{"init.genesis can't have both a url and a file"}
+	}
+	if genesis.URL != "" && genesis.SHA256 == "" {
+		return &ValidationError{"init.genesis.sha256 is required to fetch the genesis from a url"}
+	}
+	return nil
+}
+
+func validateValidatorTimeouts(validator Validator) error {
+	if validator.BlockTime != "" && validator.Consensus.TimeoutCommit != "" {
+		return &ValidationError{"validator can't have both a block_time and a consensus.timeout_commit"}
+	}
+	if validator.BlockTime != "" && !isDuration(validator.BlockTime) {
+		return &ValidationError{fmt.Sprintf("invalid validator block_time %q, use a duration, e.g. 1s", validator.BlockTime)}
+	}
+	for key, timeout := range validator.Timeouts() {
+		if !isDuration(timeout) {
+			return &ValidationError{fmt.Sprintf("invalid validator consensus %s %q, use a duration, e.g. 1s", key, timeout)}
+		}
+	}
+	return nil
+}
+
+func validatePruning(pruning Pruning) error {
+	switch pruning.Strategy {
+	case "", PruningDefault, PruningNothing, PruningEverything:
+	case PruningCustom:
+		if pruning.Interval == 0 {
+			return &ValidationError{"validator pruning.interval must be greater than 0 with the custom strategy"}
+		}
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"invalid validator pruning.strategy %q, accepted values are %q, %q, %q and %q",
+			pruning.Strategy,
+			PruningDefault,
+			PruningNothing,
+			PruningEverything,
+			PruningCustom,
+		)}
+	}
+	return nil
+}
+
+func validateRemoteSigner(signer RemoteSigner) error {
+	if !signer.IsSet() {
+		if signer.Mock {
+			return &ValidationError{"validator remote_signer.address is required to run a mock remote signer"}
+		}
+		return nil
+	}
+	if !strings.HasPrefix(signer.Address, "tcp://") && !strings.HasPrefix(signer.Address, "unix://") {
+		return &ValidationError{fmt.Sprintf(
+			"invalid validator remote_signer.address %q, use a tcp:// or a unix:// address",
+			signer.Address,
+		)}
+	}
+	return nil
+}
+
+func validatePlugins(plugins []Plugin) error {
+	names := make(map[string]bool)
+	for _, p := range plugins {
+		if p.Name == "" || p.Path == "" {
+			return &ValidationError{"plugins must have a name and a path"}
+		}
+		if names[p.Name] {
+			return &ValidationError{fmt.Sprintf("plugin %q is declared twice", p.Name)}
+		}
+		names[p.Name] = true
+	}
+	return nil
+}
+
+func isDuration(s string) bool {
+	d, err := time.ParseDuration(s)
+	return err == nil && d >= 0
+}
+
 // ValidationError is returned when a configuration is invalid.
 type ValidationError struct {
 	Message string
@@ -255,6 +740,13 @@ func FaucetHost(conf Config) string {
 		host = fmt.Sprintf(":%d", conf.Faucet.Port)
 	}
 
+	// the faucet address is validated when the config is parsed
+	if conf.Host.Bind != "" {
+		if bound, err := bindAddress(host, conf.Host.Bind); err == nil {
+			host = bound
+		}
+	}
+
 	return host
 }
 
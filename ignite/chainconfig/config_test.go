@@ -1,6 +1,7 @@
 package chainconfig
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -88,6 +89,268 @@ accounts:
 	require.Equal(t, &ValidationError{"validator is required"}, err)
 }
 
+func TestParseValidatorTimeouts(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+  block_time: 1s
+  consensus:
+    timeout_propose: 500ms
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"timeout_propose": "500ms",
+		"timeout_commit":  "1s",
+	}, conf.Validator.Timeouts())
+}
+
+func TestParseValidatorTimeoutsInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator string
+		err       error
+	}{
+		{
+			name:      "invalid block time",
+			validator: "block_time: fast",
+			err:       &ValidationError{`invalid validator block_time "fast", use a duration, e.g. 1s`},
+		},
+		{
+			name:      "invalid timeout",
+			validator: "consensus: {timeout_prevote: -1s}",
+			err:       &ValidationError{`invalid validator consensus timeout_prevote "-1s", use a duration, e.g. 1s`},
+		},
+		{
+			name:      "block time and timeout commit",
+			validator: "block_time: 1s\n  consensus: {timeout_commit: 2s}",
+			err:       &ValidationError{"validator can't have both a block_time and a consensus.timeout_commit"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confyml := fmt.Sprintf(`
+accounts:
+  - name: me
+validator:
+  name: me
+  %s
+`, tt.validator)
+			_, err := Parse(strings.NewReader(confyml))
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestParseValidatorPruning(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+validator:
+  name: me
+  pruning:
+    min_retain_blocks: 1000
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, Pruning{
+		Strategy:        PruningCustom,
+		KeepRecent:      100,
+		Interval:        10,
+		MinRetainBlocks: 1000,
+	}, conf.Validator.Pruning.WithDefaultStrategy())
+
+	conf.Validator.Pruning.Strategy = PruningNothing
+	require.Equal(t, PruningNothing, conf.Validator.Pruning.WithDefaultStrategy().Strategy)
+}
+
+func TestParseClientOpenAPI(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+validator:
+  name: me
+client:
+  openapi:
+    path: docs/static/openapi.yml
+    version: 3
+    modules_path: docs/static/modules
+    modules:
+      blog: docs/blog.yml
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, OpenAPI{
+		Path:        "docs/static/openapi.yml",
+		Version:     3,
+		ModulesPath: "docs/static/modules",
+		Modules:     map[string]string{"blog": "docs/blog.yml"},
+	}, conf.Client.OpenAPI)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "version: 3", "version: 4", 1)))
+	require.Equal(t, &ValidationError{"invalid client openapi.version 4, accepted values are 2 and 3"}, err)
+}
+
+func TestParseAddressCodec(t *testing.T) {
+	confyml := `
+address_codec: hex
+accounts:
+  - name: me
+validator:
+  name: me
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, "hex", conf.AddressCodec)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "hex", "base58", 1)))
+	require.Equal(t, &ValidationError{`invalid address codec "base58", accepted values are "bech32" and "hex"`}, err)
+}
+
+func TestParseValidatorPruningInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		pruning string
+		err     error
+	}{
+		{
+			name:    "invalid strategy",
+			pruning: "{strategy: some}",
+			err:     &ValidationError{`invalid validator pruning.strategy "some", accepted values are "default", "nothing", "everything" and "custom"`},
+		},
+		{
+			name:    "custom strategy without interval",
+			pruning: "{strategy: custom, keep_recent: 10}",
+			err:     &ValidationError{"validator pruning.interval must be greater than 0 with the custom strategy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confyml := fmt.Sprintf(`
+accounts:
+  - name: me
+validator:
+  name: me
+  pruning: %s
+`, tt.pruning)
+			_, err := Parse(strings.NewReader(confyml))
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestParseValidatorRemoteSigner(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+validator:
+  name: me
+  remote_signer:
+    address: tcp://127.0.0.1:26659
+    mock: true
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, RemoteSigner{Address: "tcp://127.0.0.1:26659", Mock: true}, conf.Validator.RemoteSigner)
+	require.True(t, conf.Validator.RemoteSigner.IsSet())
+}
+
+func TestParseValidatorRemoteSignerInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		signer string
+		err    error
+	}{
+		{
+			name:   "mock without address",
+			signer: "{mock: true}",
+			err:    &ValidationError{"validator remote_signer.address is required to run a mock remote signer"},
+		},
+		{
+			name:   "address without scheme",
+			signer: `{address: "127.0.0.1:26659"}`,
+			err:    &ValidationError{`invalid validator remote_signer.address "127.0.0.1:26659", use a tcp:// or a unix:// address`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confyml := fmt.Sprintf(`
+accounts:
+  - name: me
+validator:
+  name: me
+  remote_signer: %s
+`, tt.signer)
+			_, err := Parse(strings.NewReader(confyml))
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestParsePlugins(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+validator:
+  name: me
+plugins:
+  - name: hello
+    path: ./plugins/hello
+    with:
+      greeting: hi
+  - name: lint
+    path: /usr/local/bin/ignite-lint
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, []Plugin{
+		{Name: "hello", Path: "./plugins/hello", With: map[string]string{"greeting": "hi"}},
+		{Name: "lint", Path: "/usr/local/bin/ignite-lint"},
+	}, conf.Plugins)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "name: lint", "name: hello", 1)))
+	require.Equal(t, &ValidationError{`plugin "hello" is declared twice`}, err)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "path: ./plugins/hello", "", 1)))
+	require.Equal(t, &ValidationError{"plugins must have a name and a path"}, err)
+}
+
+func TestParseInitGenesis(t *testing.T) {
+	confyml := `
+init:
+  genesis:
+    url: "https://example.com/genesis.json"
+    sha256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+  seeds: ["id@seed.example.com:26656"]
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.True(t, conf.Init.Genesis.IsSet())
+	require.Equal(t, []string{"id@seed.example.com:26656"}, conf.Init.Seeds)
+}
+
+func TestParseInitGenesisInvalid(t *testing.T) {
+	confyml := `
+init:
+  genesis:
+    url: "https://example.com/genesis.json"
+`
+
+	_, err := Parse(strings.NewReader(confyml))
+	require.Equal(t, &ValidationError{"init.genesis.sha256 is required to fetch the genesis from a url"}, err)
+}
+
 func TestFaucetHost(t *testing.T) {
 	confyml := `
 accounts:
@@ -139,3 +402,29 @@ faucet:
 	require.NoError(t, err)
 	require.Equal(t, ":4700", FaucetHost(conf))
 }
+
+func TestParseKeyringAccount(t *testing.T) {
+	conf, err := Parse(strings.NewReader(`
+accounts:
+  - name: alice
+    coins: ["1000token"]
+    keyring: true
+validator:
+  name: alice
+  staked: "100token"
+`))
+	require.NoError(t, err)
+	require.True(t, conf.Accounts[0].Keyring)
+
+	_, err = Parse(strings.NewReader(`
+accounts:
+  - name: alice
+    coins: ["1000token"]
+    keyring: true
+    address: cosmos1s39200s6v4c96ml2xzuh389yxpd0guk2mzn3mz
+validator:
+  name: alice
+  staked: "100token"
+`))
+	require.EqualError(t, err, `config is not valid: account "alice" from the keyring can't have a mnemonic or an address`)
+}
@@ -0,0 +1,214 @@
+package chainconfig
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Server is a server started when the chain is served.
+type Server struct {
+	// Name of the server.
+	Name string
+
+	// Address the server listens on.
+	Address string
+
+	// Port of the server.
+	Port int
+
+	// Key is the key of config.yml setting the address of the server, e.g. host.rpc or
+	// init.config.rpc.laddr when the address is overwritten by the node config.
+	Key string
+}
+
+// IsExposed returns true when the server can be reached from other machines,
+// this is the case unless it listens on a loopback interface.
+func (s Server) IsExposed() bool {
+	host, _, err := net.SplitHostPort(hostPort(s.Address))
+	if err != nil || host == "localhost" {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip == nil || !ip.IsLoopback()
+}
+
+// Servers returns the servers started when the chain is served with the config, with their
+// effective addresses: the addresses of host are overwritten by the node config of init.
+// The faucet and the frontend are included, the faucet is only started when it is enabled and
+// the frontend is started with npm run dev. The Rosetta gateway is included when it is enabled.
+func Servers(conf Config) []Server {
+	type serverAddress struct {
+		name    string
+		key     string
+		address string
+
+		// init overwrites the address with a key of the node config.
+		init    map[string]interface{}
+		initKey string
+	}
+	addresses := []serverAddress{
+		{"Tendermint RPC", "host.rpc", conf.Host.RPC, conf.Init.Config, "config.rpc.laddr"},
+		{"Tendermint P2P", "host.p2p", conf.Host.P2P, conf.Init.Config, "config.p2p.laddr"},
+		{"Profiling", "host.prof", conf.Host.Prof, conf.Init.Config, "config.rpc.pprof_laddr"},
+		{"gRPC", "host.grpc", conf.Host.GRPC, conf.Init.App, "app.grpc.address"},
+		{"gRPC-Web", "host.grpc-web", conf.Host.GRPCWeb, conf.Init.App, "app.grpc-web.address"},
+		{"API", "host.api", conf.Host.API, conf.Init.App, "app.api.address"},
+		{"Faucet", "faucet.host", FaucetHost(conf), nil, ""},
+		{"Frontend", "host.frontend", conf.Host.Frontend, nil, ""},
+	}
+	// the Rosetta gateway is only started when it is enabled.
+	if conf.Rosetta.Enabled {
+		addresses = append(addresses, serverAddress{"Rosetta", "host.rosetta", conf.Host.Rosetta, conf.Init.App, "app.rosetta.address"})
+	}
+
+	var servers []Server
+	for _, a := range addresses {
+		address, key := a.address, a.key
+		if a.init != nil {
+			// the keys of the node config are prefixed by the name of their file.
+			path := strings.SplitN(a.initKey, ".", 2)[1]
+			if overwrite, ok := lookupString(a.init, path); ok {
+				address, key = overwrite, "init."+a.initKey
+			}
+		}
+
+		// the addresses are validated when the config is parsed.
+		_, port, _ := net.SplitHostPort(hostPort(address))
+		p, _ := strconv.Atoi(port)
+		servers = append(servers, Server{
+			Name:    a.name,
+			Address: address,
+			Port:    p,
+			Key:     key,
+		})
+	}
+	return servers
+}
+
+// lookupString returns the string of the dotted path in the nested maps of m.
+func lookupString(m map[string]interface{}, path string) (string, bool) {
+	var value interface{} = m
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[key]
+		case map[interface{}]interface{}:
+			value = v[key]
+		default:
+			return "", false
+		}
+	}
+	s, ok := value.(string)
+	return s, ok && s != ""
+}
+
+// validatePorts checks that the servers have valid addresses and that two servers don't listen
+// on the same port of the same interface.
+func validatePorts(conf Config) error {
+	var servers []Server
+	for _, server := range Servers(conf) {
+		// the faucet is only started when it is enabled, the profiling server when it is set.
+		if server.Address == "" || (server.Name == "Faucet" && conf.Faucet.Name == nil) {
+			continue
+		}
+		if server.Port == 0 {
+			return &ValidationError{fmt.Sprintf("invalid %s address %q, the port is missing", server.Key, server.Address)}
+		}
+		servers = append(servers, server)
+	}
+
+	for i, a := range servers {
+		for _, b := range servers[i+1:] {
+			if a.Port == b.Port && sameInterface(a.Address, b.Address) {
+				return &ValidationError{fmt.Sprintf("%s and %s listen on the same port %d", a.Key, b.Key, a.Port)}
+			}
+		}
+	}
+	return nil
+}
+
+// sameInterface returns true when the servers of the addresses can't listen on the same port,
+// the servers listening on all the interfaces share the port with the servers of any interface.
+func sameInterface(a, b string) bool {
+	hostA, _, _ := net.SplitHostPort(hostPort(a))
+	hostB, _, _ := net.SplitHostPort(hostPort(b))
+	hostA, hostB = normalizeHost(hostA), normalizeHost(hostB)
+	return hostA == hostB || hostA == "" || hostB == ""
+}
+
+// normalizeHost returns the IP of the host, an empty host for all the interfaces.
+func normalizeHost(host string) string {
+	if host == "localhost" {
+		return "127.0.0.1"
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return host
+	}
+	if ip.IsUnspecified() {
+		return ""
+	}
+	return ip.String()
+}
+
+// bindHost replaces the host of the server addresses with the bind interface.
+func bindHost(conf Config) (Config, error) {
+	if conf.Host.Bind == "" {
+		return conf, nil
+	}
+
+	bind := strings.TrimSuffix(strings.TrimPrefix(conf.Host.Bind, "["), "]")
+	if bind == "" || strings.ContainsAny(bind, "[]/ ") {
+		return conf, &ValidationError{fmt.Sprintf("invalid host.bind interface %q", conf.Host.Bind)}
+	}
+	conf.Host.Bind = bind
+
+	for _, address := range []*string{
+		&conf.Host.RPC,
+		&conf.Host.P2P,
+		&conf.Host.Prof,
+		&conf.Host.GRPC,
+		&conf.Host.GRPCWeb,
+		&conf.Host.API,
+		&conf.Host.Rosetta,
+		&conf.Host.Frontend,
+	} {
+		bound, err := bindAddress(*address, bind)
+		if err != nil {
+			return conf, err
+		}
+		*address = bound
+	}
+
+	if _, err := bindAddress(FaucetHost(Config{Faucet: conf.Faucet}), bind); err != nil {
+		return conf, err
+	}
+
+	return conf, nil
+}
+
+// bindAddress replaces the host of the address with the bind interface, the scheme of the
+// address is kept. IPv6 interfaces are enclosed in brackets.
+func bindAddress(address, bind string) (string, error) {
+	var scheme string
+	if i := strings.Index(address, "://"); i != -1 {
+		scheme = address[:i+3]
+	}
+
+	_, port, err := net.SplitHostPort(hostPort(address))
+	if err != nil {
+		return "", &ValidationError{fmt.Sprintf("invalid server address %q: %s", address, err)}
+	}
+
+	return scheme + net.JoinHostPort(bind, port), nil
+}
+
+// hostPort returns the host and port of an address with an optional scheme.
+func hostPort(address string) string {
+	if i := strings.Index(address, "://"); i != -1 {
+		return address[i+3:]
+	}
+	return address
+}
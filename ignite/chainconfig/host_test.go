@@ -0,0 +1,193 @@
+package chainconfig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const hostConfig = `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+faucet:
+  name: me
+  port: 4600
+host:
+  rpc: "tcp://127.0.0.1:26658"
+`
+
+func TestParseHostBind(t *testing.T) {
+	cases := []struct {
+		name   string
+		bind   string
+		rpc    string
+		api    string
+		faucet string
+	}{
+		{
+			name:   "no bind",
+			rpc:    "tcp://127.0.0.1:26658",
+			api:    "0.0.0.0:1317",
+			faucet: ":4600",
+		},
+		{
+			name:   "ipv4",
+			bind:   "192.168.1.10",
+			rpc:    "tcp://192.168.1.10:26658",
+			api:    "192.168.1.10:1317",
+			faucet: "192.168.1.10:4600",
+		},
+		{
+			name:   "ipv6",
+			bind:   "::",
+			rpc:    "tcp://[::]:26658",
+			api:    "[::]:1317",
+			faucet: "[::]:4600",
+		},
+		{
+			name:   "ipv6 with brackets",
+			bind:   "[::1]",
+			rpc:    "tcp://[::1]:26658",
+			api:    "[::1]:1317",
+			faucet: "[::1]:4600",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			confyml := hostConfig
+			if tt.bind != "" {
+				confyml += `  bind: "` + tt.bind + `"` + "\n"
+			}
+
+			conf, err := Parse(strings.NewReader(confyml))
+			require.NoError(t, err)
+			require.Equal(t, tt.rpc, conf.Host.RPC)
+			require.Equal(t, tt.api, conf.Host.API)
+			require.Equal(t, tt.faucet, FaucetHost(conf))
+		})
+	}
+}
+
+func TestParseHostBindInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader(hostConfig + "  bind: \"0.0.0.0/8\"\n"))
+	require.Equal(t, &ValidationError{`invalid host.bind interface "0.0.0.0/8"`}, err)
+
+	_, err = Parse(strings.NewReader(hostConfig + "  api: \"localhost\"\n  bind: \"::\"\n"))
+	require.Error(t, err)
+}
+
+func TestServers(t *testing.T) {
+	conf, err := Parse(strings.NewReader(hostConfig))
+	require.NoError(t, err)
+
+	servers := Servers(conf)
+	require.Len(t, servers, 8)
+	require.Equal(t, Server{Name: "Tendermint RPC", Address: "tcp://127.0.0.1:26658", Port: 26658, Key: "host.rpc"}, servers[0])
+	require.False(t, servers[0].IsExposed())
+	require.Equal(t, Server{Name: "Faucet", Address: ":4600", Port: 4600, Key: "faucet.host"}, servers[6])
+	require.True(t, servers[6].IsExposed())
+	require.Equal(t, Server{Name: "Frontend", Address: "localhost:3000", Port: 3000, Key: "host.frontend"}, servers[7])
+	require.False(t, servers[7].IsExposed())
+
+	require.False(t, Server{Address: "[::1]:1317"}.IsExposed())
+	require.False(t, Server{Address: "localhost:1317"}.IsExposed())
+	require.True(t, Server{Address: "[::]:1317"}.IsExposed())
+	require.True(t, Server{Address: "0.0.0.0:1317"}.IsExposed())
+
+	// the Rosetta gateway is only a server of the chain when it is enabled.
+	conf.Rosetta.Enabled = true
+	servers = Servers(conf)
+	require.Len(t, servers, 9)
+	require.Equal(t, Server{Name: "Rosetta", Address: "0.0.0.0:8080", Port: 8080, Key: "host.rosetta"}, servers[8])
+}
+
+func TestParsePortCollision(t *testing.T) {
+	cases := []struct {
+		name string
+		host string
+		err  error
+	}{
+		{
+			name: "same port",
+			host: "  grpc-web: \"0.0.0.0:1317\"\n",
+			err:  &ValidationError{"host.grpc-web and host.api listen on the same port 1317"},
+		},
+		{
+			name: "all interfaces and loopback",
+			host: "  frontend: \"localhost:4600\"\n",
+			err:  &ValidationError{"faucet.host and host.frontend listen on the same port 4600"},
+		},
+		{
+			name: "different interfaces",
+			host: "  api: \"127.0.0.1:9091\"\n  grpc-web: \"192.168.1.10:9091\"\n",
+		},
+		{
+			name: "disabled rosetta",
+			host: "  rosetta: \"0.0.0.0:1317\"\n",
+		},
+		{
+			name: "enabled rosetta",
+			host: "  rosetta: \"0.0.0.0:1317\"\nrosetta:\n  enabled: true\n",
+			err:  &ValidationError{"host.api and host.rosetta listen on the same port 1317"},
+		},
+		{
+			name: "missing port",
+			host: "  frontend: \"localhost\"\n",
+			err:  &ValidationError{`invalid host.frontend address "localhost", the port is missing`},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(hostConfig + tt.host))
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestParsePortCollisionInitOverwrite(t *testing.T) {
+	_, err := Parse(strings.NewReader(hostConfig + `init:
+  config:
+    rpc:
+      pprof_laddr: "localhost:9090"
+`))
+	require.Equal(t, &ValidationError{"init.config.rpc.pprof_laddr and host.grpc listen on the same port 9090"}, err)
+}
+
+func TestParseHostAccess(t *testing.T) {
+	t.Setenv("DEVNET_TOKEN", "secret")
+
+	conf, err := Parse(strings.NewReader(hostConfig + `  access:
+    tokens: ["${DEVNET_TOKEN}"]
+    allowed_ips: ["10.0.0.0/8", "192.168.1.5"]
+`))
+	require.NoError(t, err)
+	require.Equal(t, Access{
+		Tokens:     []string{"secret"},
+		AllowedIPs: []string{"10.0.0.0/8", "192.168.1.5"},
+	}, conf.Host.Access)
+	require.True(t, IsAPIProxied(conf))
+
+	_, err = conf.Host.Access.Control()
+	require.NoError(t, err)
+
+	// the API listening on a loopback interface isn't proxied.
+	conf.Host.API = "127.0.0.1:1317"
+	require.False(t, IsAPIProxied(conf))
+
+	conf, err = Parse(strings.NewReader(hostConfig))
+	require.NoError(t, err)
+	require.False(t, IsAPIProxied(conf))
+}
+
+func TestParseHostAccessInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader(hostConfig + "  access:\n    tokens: [\"${UNSET_DEVNET_TOKEN}\"]\n"))
+	require.Equal(t, &ValidationError{"host.access.tokens[0] is empty, check that its environment variables are set"}, err)
+
+	_, err = Parse(strings.NewReader(hostConfig + "  access:\n    allowed_ips: [\"office\"]\n"))
+	require.Equal(t, &ValidationError{`invalid host.access.allowed_ips: invalid IP "office"`}, err)
+}
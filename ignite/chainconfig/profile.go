@@ -0,0 +1,83 @@
+package chainconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Profile overrides the config when it's selected, e.g. the accounts, the hosts, the faucet and the
+// client generation of a testnet. Its keys are the ones of the config: the maps are merged with the
+// maps of the config and the other values, including the lists, replace the values of the config.
+type Profile map[string]interface{}
+
+// ProfileNames returns the names of the profiles of the config, sorted.
+func (c Config) ProfileNames() []string {
+	var names []string
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyProfile returns the YAML config overridden by the profile.
+func applyProfile(config []byte, name string) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(config, &doc); err != nil {
+		return nil, err
+	}
+
+	profiles, _ := doc["profiles"].(map[string]interface{})
+	profile, ok := profiles[name]
+	if !ok {
+		if len(profiles) == 0 {
+			return nil, &ValidationError{fmt.Sprintf("unknown profile %q, the config has no profiles", name)}
+		}
+		var names []string
+		for name := range profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, &ValidationError{fmt.Sprintf(
+			"unknown profile %q, the profiles of the config are %s",
+			name,
+			strings.Join(names, ", "),
+		)}
+	}
+
+	// a profile can be empty, e.g. a local profile using the shared defaults.
+	if profile == nil {
+		return config, nil
+	}
+	overrides, ok := profile.(map[string]interface{})
+	if !ok {
+		return nil, &ValidationError{fmt.Sprintf("profile %q must be a map of the overridden config keys", name)}
+	}
+	if _, ok := overrides["profiles"]; ok {
+		return nil, &ValidationError{fmt.Sprintf("profile %q can't define profiles", name)}
+	}
+
+	return yaml.Marshal(mergeProfile(doc, overrides))
+}
+
+// mergeProfile merges the overrides of a profile into the values of the config, the maps are merged
+// recursively and the other values are replaced.
+func mergeProfile(values, overrides map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		merged[key] = value
+	}
+	for key, override := range overrides {
+		value, isMap := merged[key].(map[string]interface{})
+		overrideMap, isOverrideMap := override.(map[string]interface{})
+		if isMap && isOverrideMap {
+			merged[key] = mergeProfile(value, overrideMap)
+			continue
+		}
+		merged[key] = override
+	}
+	return merged
+}
@@ -0,0 +1,96 @@
+package chainconfig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const profilesConfig = `
+accounts:
+  - name: alice
+    coins: ["1000token"]
+validator:
+  name: alice
+  staked: "100token"
+faucet:
+  name: alice
+  coins: ["5token"]
+client:
+  vuex:
+    path: "vue/src/store"
+host:
+  rpc: "0.0.0.0:26657"
+  api: "0.0.0.0:1317"
+profiles:
+  local:
+  testnet:
+    accounts:
+      - name: bob
+        coins: ["5000token"]
+    validator:
+      name: bob
+    faucet:
+      coins: ["1token"]
+    client:
+      openapi:
+        path: "docs/static/openapi.yml"
+    host:
+      rpc: "0.0.0.0:36657"
+`
+
+func TestParseProfile(t *testing.T) {
+	conf, err := Parse(strings.NewReader(profilesConfig))
+	require.NoError(t, err)
+	require.Equal(t, []string{"local", "testnet"}, conf.ProfileNames())
+	require.Equal(t, "alice", conf.Accounts[0].Name)
+
+	conf, err = ParseProfile(strings.NewReader(profilesConfig), "local")
+	require.NoError(t, err)
+	require.Equal(t, "alice", conf.Accounts[0].Name)
+
+	conf, err = ParseProfile(strings.NewReader(profilesConfig), "testnet")
+	require.NoError(t, err)
+
+	// the lists are replaced.
+	require.Equal(t, []Account{{Name: "bob", Coins: []string{"5000token"}}}, conf.Accounts)
+
+	// the maps are merged.
+	require.Equal(t, Validator{Name: "bob", Staked: "100token"}, conf.Validator)
+	require.Equal(t, "alice", *conf.Faucet.Name)
+	require.Equal(t, []string{"1token"}, conf.Faucet.Coins)
+	require.Equal(t, "vue/src/store", conf.Client.Vuex.Path)
+	require.Equal(t, "docs/static/openapi.yml", conf.Client.OpenAPI.Path)
+	require.Equal(t, "0.0.0.0:36657", conf.Host.RPC)
+	require.Equal(t, "0.0.0.0:1317", conf.Host.API)
+}
+
+func TestParseProfileInvalid(t *testing.T) {
+	_, err := ParseProfile(strings.NewReader(profilesConfig), "mainnet")
+	require.EqualError(t, err, `config is not valid: unknown profile "mainnet", the profiles of the config are local, testnet`)
+
+	_, err = ParseProfile(strings.NewReader(`
+accounts:
+  - name: alice
+validator:
+  name: alice
+`), "testnet")
+	require.EqualError(t, err, `config is not valid: unknown profile "testnet", the config has no profiles`)
+
+	_, err = ParseProfile(strings.NewReader(`
+profiles:
+  testnet:
+    profiles:
+      mainnet:
+`), "testnet")
+	require.EqualError(t, err, `config is not valid: profile "testnet" can't define profiles`)
+
+	// the config overridden by the profile is validated.
+	_, err = ParseProfile(strings.NewReader(profilesConfig+`
+  mainnet:
+    validator:
+      block_time: "fast"
+`), "mainnet")
+	require.EqualError(t, err, `config is not valid: invalid validator block_time "fast", use a duration, e.g. 1s`)
+}
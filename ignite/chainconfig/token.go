@@ -0,0 +1,164 @@
+package chainconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeNow is the time a relative vesting time is resolved from, e.g. now+720h.
+const timeNow = "now"
+
+// AccountVesting locks a part of the coins of a genesis account until they vest.
+type AccountVesting struct {
+	// Coins are the vesting coins, they're part of the coins of the account.
+	Coins []string `yaml:"coins"`
+
+	// StartTime is the time the coins start vesting linearly until EndTime, the account is a
+	// continuous vesting account. The account is a delayed vesting account when it's not set, all
+	// the coins vest at EndTime.
+	StartTime string `yaml:"start_time,omitempty"`
+
+	// EndTime is the time all the coins are vested.
+	EndTime string `yaml:"end_time"`
+}
+
+// IsContinuous returns true when the coins vest linearly from the start time.
+func (v AccountVesting) IsContinuous() bool {
+	return v.StartTime != ""
+}
+
+// Times returns the start and the end times of the vesting, the relative times are resolved from
+// now. The start time is zero for a delayed vesting.
+func (v AccountVesting) Times(now time.Time) (start, end time.Time, err error) {
+	if v.IsContinuous() {
+		if start, err = ResolveTime(v.StartTime, now); err != nil {
+			return start, end, err
+		}
+	}
+	end, err = ResolveTime(v.EndTime, now)
+	return start, end, err
+}
+
+// Denom is a denomination of the chain with its metadata, added to the denom metadata of the bank
+// module in the genesis.
+type Denom struct {
+	// Base is the denom of the coins, e.g. utoken.
+	Base string `yaml:"base"`
+
+	// Display is the denom displayed to the users, e.g. token. It's the base denom when it's not set.
+	Display string `yaml:"display,omitempty"`
+
+	// Exponent is the power of 10 of the base denom in a display denom, e.g. 6.
+	Exponent uint32 `yaml:"exponent,omitempty"`
+
+	// Name is the name of the token, e.g. Token.
+	Name string `yaml:"name,omitempty"`
+
+	// Symbol is the ticker of the token, e.g. TKN.
+	Symbol string `yaml:"symbol,omitempty"`
+
+	// Description of the token.
+	Description string `yaml:"description,omitempty"`
+}
+
+// DisplayDenom returns the displayed denom, the base denom by default.
+func (d Denom) DisplayDenom() string {
+	if d.Display == "" {
+		return d.Base
+	}
+	return d.Display
+}
+
+// ParseDenom parses a denom with its display denom and its exponent, e.g. utoken:token:6.
+// Only the base denom is required.
+func ParseDenom(s string) (Denom, error) {
+	parts := strings.Split(s, ":")
+	switch len(parts) {
+	case 1:
+		return Denom{Base: parts[0]}, nil
+	case 3:
+		exponent, err := strconv.ParseUint(parts[2], 10, 32)
+		if err != nil {
+			return Denom{}, fmt.Errorf("invalid exponent of the denom %s: %w", s, err)
+		}
+		return Denom{Base: parts[0], Display: parts[1], Exponent: uint32(exponent)}, nil
+	default:
+		return Denom{}, fmt.Errorf("invalid denom %s, use base[:display:exponent], e.g. utoken:token:6", s)
+	}
+}
+
+// ResolveTime returns the time of the value, either a RFC3339 time or a time relative to now like
+// now+720h.
+func ResolveTime(value string, now time.Time) (time.Time, error) {
+	if value == timeNow {
+		return now, nil
+	}
+	if strings.HasPrefix(value, timeNow+"+") || strings.HasPrefix(value, timeNow+"-") {
+		offset, err := time.ParseDuration(strings.TrimPrefix(value, timeNow))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %s: %w", value, err)
+		}
+		return now.Add(offset), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s, use a RFC3339 time or a time relative to now, e.g. now+720h", value)
+	}
+	return t, nil
+}
+
+func validateVesting(account Account) error {
+	vesting := account.Vesting
+	if vesting == nil {
+		return nil
+	}
+	if len(vesting.Coins) == 0 {
+		return &ValidationError{fmt.Sprintf("account %q vesting.coins is required", account.Name)}
+	}
+	if vesting.EndTime == "" {
+		return &ValidationError{fmt.Sprintf("account %q vesting.end_time is required", account.Name)}
+	}
+
+	// the relative times are validated from an arbitrary now, they're resolved from the same now.
+	start, end, err := vesting.Times(time.Now())
+	if err != nil {
+		return &ValidationError{fmt.Sprintf("account %q vesting: %s", account.Name, err)}
+	}
+	if vesting.IsContinuous() && !start.Before(end) {
+		return &ValidationError{fmt.Sprintf("account %q vesting.start_time must be before vesting.end_time", account.Name)}
+	}
+	return nil
+}
+
+func validateDenoms(conf Config) error {
+	bases := make(map[string]bool)
+	for _, denom := range conf.Denoms {
+		if denom.Base == "" {
+			return &ValidationError{"denoms must have a base denom"}
+		}
+		if bases[denom.Base] {
+			return &ValidationError{fmt.Sprintf("denom %q is declared twice", denom.Base)}
+		}
+		bases[denom.Base] = true
+
+		if denom.DisplayDenom() != denom.Base && denom.Exponent == 0 {
+			return &ValidationError{fmt.Sprintf("denom %q exponent must be greater than 0 with the display denom %q", denom.Base, denom.Display)}
+		}
+	}
+
+	if len(conf.Denoms) > 0 && hasGenesisDenomMetadata(conf.Genesis) {
+		return &ValidationError{"denoms can't be set with the denom_metadata of the bank module in genesis"}
+	}
+	return nil
+}
+
+// hasGenesisDenomMetadata returns true when the genesis overrides set the denom metadata of the bank
+// module.
+func hasGenesisDenomMetadata(genesis map[string]interface{}) bool {
+	appState, _ := genesis["app_state"].(map[string]interface{})
+	bank, _ := appState["bank"].(map[string]interface{})
+	_, ok := bank["denom_metadata"]
+	return ok
+}
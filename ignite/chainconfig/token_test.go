@@ -0,0 +1,173 @@
+package chainconfig
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVestingAndDenoms(t *testing.T) {
+	confyml := `
+accounts:
+  - name: alice
+    coins: ["20000000000utoken", "200000000stake"]
+  - name: bob
+    coins: ["10000000000utoken"]
+    vesting:
+      coins: ["5000000000utoken"]
+      end_time: "now+720h"
+  - name: carol
+    coins: ["10000000000utoken"]
+    vesting:
+      coins: ["10000000000utoken"]
+      start_time: "2030-01-01T00:00:00Z"
+      end_time: "2031-01-01T00:00:00Z"
+validator:
+  name: alice
+  staked: "100000000stake"
+denoms:
+  - base: utoken
+    display: token
+    exponent: 6
+    symbol: TKN
+  - base: stake
+`
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Nil(t, conf.Accounts[0].Vesting)
+	require.Equal(t, []Denom{
+		{Base: "utoken", Display: "token", Exponent: 6, Symbol: "TKN"},
+		{Base: "stake"},
+	}, conf.Denoms)
+	require.Equal(t, "stake", conf.Denoms[1].DisplayDenom())
+
+	// a delayed vesting account.
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	vesting := conf.Accounts[1].Vesting
+	require.False(t, vesting.IsContinuous())
+	start, end, err := vesting.Times(now)
+	require.NoError(t, err)
+	require.True(t, start.IsZero())
+	require.Equal(t, now.Add(720*time.Hour), end)
+
+	// a continuous vesting account.
+	vesting = conf.Accounts[2].Vesting
+	require.True(t, vesting.IsContinuous())
+	start, end, err = vesting.Times(now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestParseVestingAndDenomsInvalid(t *testing.T) {
+	accounts := `
+validator:
+  name: alice
+  staked: "100000000stake"
+accounts:
+  - name: alice
+    coins: ["1000token"]
+`
+	tests := []struct {
+		name string
+		yml  string
+		err  string
+	}{
+		{
+			name: "vesting without coins",
+			yml: accounts + `
+    vesting:
+      end_time: "now+1h"
+`,
+			err: `config is not valid: account "alice" vesting.coins is required`,
+		},
+		{
+			name: "vesting without end time",
+			yml: accounts + `
+    vesting:
+      coins: ["1000token"]
+`,
+			err: `config is not valid: account "alice" vesting.end_time is required`,
+		},
+		{
+			name: "invalid vesting time",
+			yml: accounts + `
+    vesting:
+      coins: ["1000token"]
+      end_time: "tomorrow"
+`,
+			err: `config is not valid: account "alice" vesting: invalid time tomorrow, use a RFC3339 time or a time relative to now, e.g. now+720h`,
+		},
+		{
+			name: "vesting ending before its start",
+			yml: accounts + `
+    vesting:
+      coins: ["1000token"]
+      start_time: "now+2h"
+      end_time: "now+1h"
+`,
+			err: `config is not valid: account "alice" vesting.start_time must be before vesting.end_time`,
+		},
+		{
+			name: "denom without base",
+			yml: accounts + `
+denoms:
+  - display: token
+`,
+			err: "config is not valid: denoms must have a base denom",
+		},
+		{
+			name: "denom declared twice",
+			yml: accounts + `
+denoms:
+  - base: token
+  - base: token
+`,
+			err: `config is not valid: denom "token" is declared twice`,
+		},
+		{
+			name: "display denom without exponent",
+			yml: accounts + `
+denoms:
+  - base: utoken
+    display: token
+`,
+			err: `config is not valid: denom "utoken" exponent must be greater than 0 with the display denom "token"`,
+		},
+		{
+			name: "denoms and genesis denom metadata",
+			yml: accounts + `
+denoms:
+  - base: token
+genesis:
+  app_state:
+    bank:
+      denom_metadata: []
+`,
+			err: "config is not valid: denoms can't be set with the denom_metadata of the bank module in genesis",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.yml))
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseDenom(t *testing.T) {
+	denom, err := ParseDenom("token")
+	require.NoError(t, err)
+	require.Equal(t, Denom{Base: "token"}, denom)
+
+	denom, err = ParseDenom("utoken:token:6")
+	require.NoError(t, err)
+	require.Equal(t, Denom{Base: "utoken", Display: "token", Exponent: 6}, denom)
+
+	_, err = ParseDenom("utoken:token")
+	require.Error(t, err)
+	_, err = ParseDenom("utoken:token:six")
+	require.Error(t, err)
+}
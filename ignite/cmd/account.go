@@ -1,14 +1,20 @@
 package ignitecmd
 
 import (
+	"errors"
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/services/chain"
 )
 
 const (
@@ -17,8 +23,17 @@ const (
 	flagNonInteractive = "non-interactive"
 	flagKeyringBackend = "keyring-backend"
 	flagFrom           = "from"
+	flagNamespace      = "namespace"
+	flagGlobal         = "global"
+	flagAddressCodec   = "address-codec"
+	flagLedgerAccount  = "ledger-account"
+	flagLedgerIndex    = "ledger-index"
+	flagRemoteSigner   = "remote-signer"
 )
 
+// envRemoteSignerToken is the environment variable holding the bearer token of the remote signer.
+const envRemoteSignerToken = "REMOTE_SIGNER_TOKEN"
+
 func NewAccount() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "account [command]",
@@ -35,21 +50,52 @@ Ignite CLI uses accounts to interact with the Ignite blockchain, use an IBC rela
 	c.AddCommand(NewAccountList())
 	c.AddCommand(NewAccountImport())
 	c.AddCommand(NewAccountExport())
+	c.AddCommand(NewAccountMigrate())
 
 	return c
 }
 
+var accountsHeader = []string{"name", "address", "public key"}
+
 func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) error {
+	accEntries, err := accountEntries(cmd, accounts...)
+	if err != nil {
+		return err
+	}
+	return entrywriter.MustWrite(os.Stdout, accountsHeader, accEntries...)
+}
+
+// accountEntries returns the table entries of the accounts with their addresses encoded with the
+// address codec of the flags.
+func accountEntries(cmd *cobra.Command, accounts ...cosmosaccount.Account) ([][]string, error) {
+	codec, err := getAddressCodec(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	var accEntries [][]string
 	for _, acc := range accounts {
-		accEntries = append(accEntries, []string{acc.Name, acc.Address(getAddressPrefix(cmd)), acc.PubKey()})
+		address, err := acc.EncodedAddress(codec)
+		if err != nil {
+			return nil, err
+		}
+		accEntries = append(accEntries, []string{acc.Name, address, acc.PubKey()})
 	}
-	return entrywriter.MustWrite(os.Stdout, []string{"name", "address", "public key"}, accEntries...)
+	return accEntries, nil
 }
 
 func flagSetKeyringBackend() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
-	fs.String(flagKeyringBackend, "test", "Keyring backend to store your account keys")
+	fs.String(flagKeyringBackend, "test", "Keyring backend to store your account keys, use ledger to sign with a Ledger device or remote to sign with a remote signer")
+	fs.String(flagRemoteSigner, "", fmt.Sprintf("URL of the remote signer of the remote keyring backend, authenticated with the token in $%s", envRemoteSignerToken))
+	return fs
+}
+
+// flagSetAccountsKeyringBackend is the keyring backend flag of the commands importing the accounts
+// of the Ignite CLI keyring into the keyring of the chain.
+func flagSetAccountsKeyringBackend() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagKeyringBackend, string(cosmosaccount.KeyringTest), "Keyring backend of the Ignite CLI accounts imported into the keyring of the chain")
 	return fs
 }
 
@@ -58,6 +104,129 @@ func getKeyringBackend(cmd *cobra.Command) cosmosaccount.KeyringBackend {
 	return cosmosaccount.KeyringBackend(backend)
 }
 
+// getRemoteSigner returns the remote signer of the remote keyring backend, nil is returned with
+// the other backends.
+func getRemoteSigner(cmd *cobra.Command) cosmosaccount.Signer {
+	url, _ := cmd.Flags().GetString(flagRemoteSigner)
+	if getKeyringBackend(cmd) != cosmosaccount.KeyringRemote || url == "" {
+		return nil
+	}
+	return cosmosaccount.NewRemoteSigner(url, cosmosaccount.WithRemoteSignerToken(os.Getenv(envRemoteSignerToken)))
+}
+
+func flagSetLedgerHDPath() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint32(flagLedgerAccount, 0, "Account of the HD path of the key of the Ledger device")
+	fs.Uint32(flagLedgerIndex, 0, "Address index of the HD path of the key of the Ledger device")
+	return fs
+}
+
+func getLedgerHDPath(cmd *cobra.Command) (account, index uint32) {
+	account, _ = cmd.Flags().GetUint32(flagLedgerAccount)
+	index, _ = cmd.Flags().GetUint32(flagLedgerIndex)
+	return account, index
+}
+
+// printLedgerPrompt asks the user to confirm a tx signed by a Ledger account on the device.
+func printLedgerPrompt(accountName string) {
+	fmt.Printf("🔐 Review and confirm the transaction of %q on your Ledger device\n", accountName)
+}
+
+func flagSetKeyringNamespace() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagNamespace, "", "Namespace of the accounts (default: chain ID of the project in the current directory)")
+	fs.Bool(flagGlobal, false, "Use the accounts shared by all the projects")
+	return fs
+}
+
+// getKeyringNamespace returns the namespace of the accounts.
+// Inside a project the accounts are namespaced by the project chain ID before its variables are
+// replaced, so they're kept when a templated ID like mars-${GIT_SHA} changes,
+// outside of a project or with the global flag the shared accounts are used.
+func getKeyringNamespace(cmd *cobra.Command) (string, error) {
+	if global, _ := cmd.Flags().GetBool(flagGlobal); global {
+		return "", nil
+	}
+	if namespace, _ := cmd.Flags().GetString(flagNamespace); namespace != "" {
+		return namespace, nil
+	}
+
+	c, err := projectChain()
+	if err != nil || c == nil {
+		return "", err
+	}
+	return c.KeyringNamespace()
+}
+
+// getKeyringServiceName returns the name of the OS keyring service of the accounts set in the
+// config of the project, the default name is used for the shared accounts and outside of a project.
+func getKeyringServiceName(cmd *cobra.Command) (string, error) {
+	if global, _ := cmd.Flags().GetBool(flagGlobal); global {
+		return "", nil
+	}
+
+	c, err := projectChain()
+	if err != nil || c == nil {
+		return "", err
+	}
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+	return conf.Init.KeyringService, nil
+}
+
+// projectChain returns the chain of the project in the current directory, nil is returned
+// outside of a project.
+func projectChain() (*chain.Chain, error) {
+	_, appPath, err := gomodulepath.Find(".")
+	if errors.Is(err, gomodule.ErrGoModNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := chainconfig.LocateDefault(appPath); err == chainconfig.ErrCouldntLocateConfig {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return chain.New(appPath)
+}
+
+// newAccountRegistry returns the account registry in the keyring namespace selected by the flags.
+func newAccountRegistry(cmd *cobra.Command) (cosmosaccount.Registry, error) {
+	namespace, err := getKeyringNamespace(cmd)
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+	serviceName, err := getKeyringServiceName(cmd)
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+
+	return newNamespaceAccountRegistry(cmd, namespace, serviceName)
+}
+
+// newNamespaceAccountRegistry returns the account registry in namespace with the keyring options of
+// the flags, the default OS keyring service is used when serviceName is empty.
+func newNamespaceAccountRegistry(cmd *cobra.Command, namespace, serviceName string) (cosmosaccount.Registry, error) {
+	options := []cosmosaccount.Option{
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithNamespace(namespace),
+		cosmosaccount.WithLedgerHDPath(getLedgerHDPath(cmd)),
+	}
+	if signer := getRemoteSigner(cmd); signer != nil {
+		options = append(options, cosmosaccount.WithSigner(signer))
+	}
+	if serviceName != "" {
+		options = append(options, cosmosaccount.WithKeyringServiceName(serviceName))
+	}
+
+	return cosmosaccount.New(options...)
+}
+
 func flagSetAccountPrefixes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(flagAddressPrefix, cosmosaccount.AccountPrefixCosmos, "Account address prefix")
@@ -69,6 +238,22 @@ func getAddressPrefix(cmd *cobra.Command) string {
 	return prefix
 }
 
+func flagSetAddressCodec() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(
+		flagAddressCodec,
+		cosmosaccount.AddressCodecBech32,
+		fmt.Sprintf("Account address codec (%s|%s)", cosmosaccount.AddressCodecBech32, cosmosaccount.AddressCodecHex),
+	)
+	return fs
+}
+
+// getAddressCodec returns the codec of the account addresses, the bech32 codec uses the address prefix.
+func getAddressCodec(cmd *cobra.Command) (cosmosaccount.AddressCodec, error) {
+	name, _ := cmd.Flags().GetString(flagAddressCodec)
+	return cosmosaccount.NewAddressCodec(name, getAddressPrefix(cmd))
+}
+
 func getFrom(cmd *cobra.Command) string {
 	prefix, _ := cmd.Flags().GetString(flagFrom)
 	return prefix
@@ -17,6 +17,8 @@ func NewAccountCreate() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
+	c.Flags().AddFlagSet(flagSetLedgerHDPath())
 
 	return c
 }
@@ -24,18 +26,25 @@ func NewAccountCreate() *cobra.Command {
 func accountCreateHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
 
-	_, mnemonic, err := ca.Create(name)
+	if getKeyringBackend(cmd) == cosmosaccount.KeyringLedger {
+		fmt.Println("🔐 Connect and unlock your Ledger device, and open the Cosmos app")
+	}
+
+	acc, mnemonic, err := ca.Create(name)
 	if err != nil {
 		return err
 	}
 
+	if acc.IsLedger() {
+		fmt.Printf("Account %q created from your Ledger device\n", name)
+		return nil
+	}
+
 	fmt.Printf("Account %q created, keep your mnemonic in a secret place:\n\n%s\n", name, mnemonic)
 	return nil
 }
@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-
-	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 )
 
 func NewAccountDelete() *cobra.Command {
@@ -17,6 +15,7 @@ func NewAccountDelete() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
 
 	return c
 }
@@ -24,9 +23,7 @@ func NewAccountDelete() *cobra.Command {
 func accountDeleteHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,6 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
-
-	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 )
 
 func NewAccountExport() *cobra.Command {
@@ -19,6 +17,7 @@ func NewAccountExport() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
 	c.Flags().AddFlagSet(flagSetAccountImportExport())
 	c.Flags().String(flagPath, "", "path to export private key. default: ./key_[name]")
 
@@ -36,9 +35,7 @@ func accountExportHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
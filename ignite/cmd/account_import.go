@@ -4,27 +4,46 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
-	"github.com/cosmos/go-bip39"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 )
 
-const flagSecret = "secret"
+const (
+	flagSecret   = "secret"
+	flagCoinType = "coin-type"
+)
 
 func NewAccountImport() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "import [name]",
 		Short: "Import an account by using a mnemonic or a private key",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountImportHandler,
+		Long: `Import an account by using a mnemonic or a private key.
+
+The words of a mnemonic are checked against the BIP39 wordlist, the misspelled words are reported
+with their position and the closest words of the wordlist. In interactive mode, the misspelled
+words are corrected one by one.
+
+The passphrase of a mnemonic is its optional BIP39 passphrase, also known as the 25th word. Before
+importing the account, the addresses derived from the mnemonic are previewed for several coin types,
+use --coin-type to import the account of another coin type than the Cosmos one.`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountImportHandler,
 	}
 
 	c.Flags().String(flagSecret, "", "Your mnemonic or path to your private key (use interactive mode instead to securely pass your mnemonic)")
+	c.Flags().Uint32(flagCoinType, sdktypes.GetConfig().GetCoinType(), "Coin type of the HD path of the account imported from a mnemonic")
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
 	c.Flags().AddFlagSet(flagSetAccountImportExport())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAddressCodec())
 
 	return c
 }
@@ -42,33 +61,165 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// a mnemonic has several words, a path to a private key a single one.
+	if len(strings.Fields(secret)) > 1 {
+		return accountImportMnemonic(cmd, name, secret)
+	}
+
 	passphrase, err := getPassphrase(cmd)
 	if err != nil {
 		return err
 	}
 
-	if !bip39.IsMnemonicValid(secret) {
-		privKey, err := os.ReadFile(secret)
-		if os.IsNotExist(err) {
-			return errors.New("mnemonic is not valid or private key not found at path")
-		}
-		if err != nil {
+	privKey, err := os.ReadFile(secret)
+	if os.IsNotExist(err) {
+		return errors.New("mnemonic is not valid or private key not found at path")
+	}
+	if err != nil {
+		return err
+	}
+
+	ca, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ca.Import(name, string(privKey), passphrase); err != nil {
+		return err
+	}
+
+	fmt.Printf("Account %q imported.\n", name)
+	return nil
+}
+
+// accountImportMnemonic imports the account of the mnemonic after a preview of its addresses.
+func accountImportMnemonic(cmd *cobra.Command, name, mnemonic string) error {
+	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
+
+	mnemonic, err := correctMnemonic(cmd, cosmosaccount.NormalizeMnemonic(mnemonic))
+	if err != nil {
+		return err
+	}
+
+	passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+	if passphrase == "" && !getIsNonInteractive(cmd) {
+		if err := cliquiz.Ask(
+			cliquiz.NewQuestion("BIP39 passphrase (25th word), leave empty if none",
+				&passphrase,
+				cliquiz.HideAnswer(),
+			)); err != nil {
 			return err
 		}
-		secret = string(privKey)
 	}
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	if err := printDerivedAddresses(cmd, mnemonic, passphrase, coinType); err != nil {
+		return err
+	}
+
+	if !getIsNonInteractive(cmd) {
+		session := cliui.New()
+		defer session.Cleanup()
+
+		if err := session.AskConfirm(fmt.Sprintf("Import the account of the coin type %d", coinType)); err != nil {
+			return errors.New("account import aborted")
+		}
+	}
+
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
 
-	if _, err := ca.Import(name, secret, passphrase); err != nil {
+	if _, err := ca.ImportMnemonic(name, mnemonic, passphrase, coinType); err != nil {
 		return err
 	}
 
 	fmt.Printf("Account %q imported.\n", name)
 	return nil
 }
+
+// correctMnemonic returns the mnemonic once it's valid. In interactive mode, the user is asked
+// to correct the misspelled words or to enter the mnemonic again.
+func correctMnemonic(cmd *cobra.Command, mnemonic string) (string, error) {
+	for {
+		err := cosmosaccount.ValidateMnemonic(mnemonic)
+		if err == nil {
+			return mnemonic, nil
+		}
+
+		var mnemonicErr *cosmosaccount.InvalidMnemonicError
+		if getIsNonInteractive(cmd) || !errors.As(err, &mnemonicErr) {
+			return "", err
+		}
+
+		fmt.Println(err)
+
+		// the misspelled words are corrected in place, the mnemonic is entered again when the
+		// number of words or the checksum is invalid.
+		if len(mnemonicErr.InvalidWords) == 0 {
+			var answer string
+			if err := cliquiz.Ask(
+				cliquiz.NewQuestion("Your mnemonic", &answer, cliquiz.HideAnswer(), cliquiz.Required())); err != nil {
+				return "", err
+			}
+			mnemonic = cosmosaccount.NormalizeMnemonic(answer)
+			continue
+		}
+
+		words := strings.Fields(mnemonic)
+		for _, w := range mnemonicErr.InvalidWords {
+			var (
+				answer  string
+				options = []cliquiz.Option{cliquiz.Required()}
+			)
+			if len(w.Suggestions) > 0 {
+				options = append(options, cliquiz.DefaultAnswer(w.Suggestions[0]))
+			}
+			question := fmt.Sprintf("Word %d (%s)", w.Index, w.Word)
+			if err := cliquiz.Ask(cliquiz.NewQuestion(question, &answer, options...)); err != nil {
+				return "", err
+			}
+			words[w.Index-1] = cosmosaccount.NormalizeMnemonic(answer)
+		}
+		mnemonic = strings.Join(words, " ")
+	}
+}
+
+// printDerivedAddresses prints the addresses of the mnemonic for the coin type and the common coin types.
+func printDerivedAddresses(cmd *cobra.Command, mnemonic, passphrase string, coinType uint32) error {
+	codec, err := getAddressCodec(cmd)
+	if err != nil {
+		return err
+	}
+
+	coinTypes := []uint32{coinType}
+	for _, ct := range cosmosaccount.CommonCoinTypes {
+		if ct != coinType {
+			coinTypes = append(coinTypes, ct)
+		}
+	}
+
+	keys, err := cosmosaccount.DeriveKeys(mnemonic, passphrase, coinTypes...)
+	if err != nil {
+		return err
+	}
+
+	var entries [][]string
+	for _, key := range keys {
+		address, err := key.EncodedAddress(codec)
+		if err != nil {
+			return err
+		}
+
+		selected := ""
+		if key.CoinType == coinType {
+			selected = "*"
+		}
+		entries = append(entries, []string{selected, strconv.FormatUint(uint64(key.CoinType), 10), key.HDPath, address})
+	}
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	return session.PrintTable([]string{"", "coin type", "hd path", "address"}, entries...)
+}
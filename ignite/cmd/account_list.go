@@ -2,8 +2,6 @@ package ignitecmd
 
 import (
 	"github.com/spf13/cobra"
-
-	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 )
 
 func NewAccountList() *cobra.Command {
@@ -14,15 +12,15 @@ func NewAccountList() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
 	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAddressCodec())
 
 	return c
 }
 
 func accountListHandler(cmd *cobra.Command, args []string) error {
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,90 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func NewAccountMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate [name]...",
+		Short: "Move shared accounts into the namespace of the project",
+		Long: `Move accounts shared by all the projects into the namespace of the project in
+the current directory, or into the one set with --namespace.
+
+All the shared accounts are moved when no account names are given.`,
+		RunE: accountMigrateHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagNamespace, "", "Namespace of the accounts (default: chain ID of the project in the current directory)")
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAddressCodec())
+
+	return c
+}
+
+func accountMigrateHandler(cmd *cobra.Command, args []string) error {
+	namespace, err := getKeyringNamespace(cmd)
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		return errors.New("no project found in the current directory, set the namespace with --namespace")
+	}
+
+	// the shared accounts are in the default OS keyring service, the accounts of the project in the
+	// one of its config, read by the other account commands.
+	serviceName, err := getKeyringServiceName(cmd)
+	if err != nil {
+		return err
+	}
+	global, err := newNamespaceAccountRegistry(cmd, "", "")
+	if err != nil {
+		return err
+	}
+	namespaced, err := newNamespaceAccountRegistry(cmd, namespace, serviceName)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	names := args
+	if len(names) == 0 {
+		accounts, err := global.List()
+		if err != nil {
+			return err
+		}
+		for _, acc := range accounts {
+			names = append(names, acc.Name)
+		}
+	}
+
+	var moved []cosmosaccount.Account
+	for _, name := range names {
+		acc, err := global.Move(name, namespaced)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		moved = append(moved, acc)
+	}
+
+	session.Printf("%s %d account(s) moved to the namespace %q\n", icons.OK, len(moved), namespace)
+	if len(moved) == 0 {
+		return nil
+	}
+
+	entries, err := accountEntries(cmd, moved...)
+	if err != nil {
+		return err
+	}
+	return session.PrintTable(accountsHeader, entries...)
+}
@@ -2,8 +2,6 @@ package ignitecmd
 
 import (
 	"github.com/spf13/cobra"
-
-	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 )
 
 func NewAccountShow() *cobra.Command {
@@ -15,7 +13,9 @@ func NewAccountShow() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
 	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAddressCodec())
 
 	return c
 }
@@ -23,9 +23,7 @@ func NewAccountShow() *cobra.Command {
 func accountShowHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -15,10 +15,24 @@ func NewChain() *cobra.Command {
 
 	c.AddCommand(
 		NewChainServe(),
+		NewChainStatus(),
+		NewChainLogs(),
+		NewChainStop(),
 		NewChainBuild(),
 		NewChainInit(),
 		NewChainFaucet(),
 		NewChainSimulate(),
+		NewChainExportModule(),
+		NewChainTime(),
+		NewChainPorts(),
+		NewChainSeed(),
+		NewChainValidate(),
+		NewChainUpgradeScaffold(),
+		NewChainTest(),
+		NewChainSnapshot(),
+		NewChainState(),
+		NewChainPrune(),
+		NewChainChangelog(),
 	)
 
 	return c
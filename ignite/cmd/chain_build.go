@@ -16,6 +16,9 @@ const (
 	flagRelease        = "release"
 	flagReleaseTargets = "release.targets"
 	flagReleasePrefix  = "release.prefix"
+	flagReleaseVersion = "release.version"
+	flagReleaseDocker  = "release.docker"
+	flagReleaseImage   = "release.image"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -31,9 +34,22 @@ for one or more specified release targets are built in a release/ dir under the
 source. Specify the release targets with GOOS:GOARCH build tags.
 If the optional --release.targets is not specified, a binary is created for your current environment.
 
+The version and the commit of the app are embedded in the binaries, the version is the git tag
+of the app by default, set it with --release.version, e.g. in a CI pipeline. The release dir holds
+a tarball per target and a release_checksum file of the sha256 sums of the tarballs.
+
+To package the release in a Docker image, use the --release.docker flag. A Dockerfile packaging the
+tarballs of the linux targets is added to the release, the arch of the image is selected with the
+platform of the build, e.g. docker buildx build --platform linux/arm64 release/. To build the image
+with docker, set its name with --release.image.
+
+The shell completion scripts and the man pages of the binary are generated in the build/ dir
+under the app's source, or in the --output path when specified. The release tarballs include them.
+
 Sample usages:
 	- ignite chain build
-	- ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64`,
+	- ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+	- ignite chain build --release -t linux:amd64 --release.version v1.0.0 --release.image org/chain:v1.0.0`,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
 	}
@@ -41,10 +57,14 @@ Sample usages:
 	flagSetPath(c)
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetFeatures())
 	c.Flags().AddFlagSet(flagSetProto3rdParty("Available only without the --release flag"))
 	c.Flags().Bool(flagRelease, false, "build for a release")
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
+	c.Flags().String(flagReleaseVersion, "", "version embedded in the release binaries, the git tag by default. Available only with --release flag")
+	c.Flags().Bool(flagReleaseDocker, false, "add a Dockerfile of the linux targets to the release. Available only with --release flag")
+	c.Flags().String(flagReleaseImage, "", "name of the Docker image of the release built with docker. Available only with --release flag")
 	c.Flags().StringP(flagOutput, "o", "", "binary output path")
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 
@@ -56,6 +76,9 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		isRelease, _      = cmd.Flags().GetBool(flagRelease)
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
+		releaseVersion, _ = cmd.Flags().GetString(flagReleaseVersion)
+		releaseDocker, _  = cmd.Flags().GetBool(flagReleaseDocker)
+		releaseImage, _   = cmd.Flags().GetString(flagReleaseImage)
 		output, _         = cmd.Flags().GetString(flagOutput)
 	)
 
@@ -79,7 +102,18 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 	}
 
 	if isRelease {
-		releasePath, err := c.BuildRelease(cmd.Context(), cacheStorage, output, releasePrefix, releaseTargets...)
+		var releaseOptions []chain.ReleaseOption
+		if releaseVersion != "" {
+			releaseOptions = append(releaseOptions, chain.ReleaseWithVersion(releaseVersion))
+		}
+		if releaseDocker {
+			releaseOptions = append(releaseOptions, chain.ReleaseWithDockerfile())
+		}
+		if releaseImage != "" {
+			releaseOptions = append(releaseOptions, chain.ReleaseWithImage(releaseImage))
+		}
+
+		releasePath, err := c.BuildRelease(cmd.Context(), cacheStorage, output, releasePrefix, releaseTargets, releaseOptions...)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,88 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+const flagSince = "since"
+
+// NewChainChangelog creates a new command to draft the changelog of the chain since a release.
+func NewChainChangelog() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "changelog",
+		Short: "Draft the changelog of the chain since a release",
+		Long: `Draft the changelog of the chain since a release, e.g. its tag, ready to paste into the release
+notes for the validators and the client teams.
+
+The working tree of the chain is compared to the release:
+
+  state breaking  the modules whose consensus version is bumped, and the changes of the encoding
+                  of the messages stored in the state, e.g. a removed or retyped field
+  api breaking    the removed RPC funcs and HTTP endpoints, and the changes of the encoding of
+                  their requests and responses
+  new endpoints   the RPC funcs and the HTTP endpoints added to the query services
+  new messages    the RPC funcs added to the Msg services
+  commits         the commits since the release
+
+Use --json to print the changelog as JSON.`,
+		Args: cobra.NoArgs,
+		RunE: chainChangelogHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagSince, "", "Git revision of the release, e.g. its tag")
+	c.Flags().String(flagOut, "", "Path to write the changelog to instead of printing it")
+	c.Flags().Bool(flagJSON, false, "Print the changelog as JSON")
+
+	return c
+}
+
+func chainChangelogHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		since, _  = cmd.Flags().GetString(flagSince)
+		out, _    = cmd.Flags().GetString(flagOut)
+		asJSON, _ = cmd.Flags().GetBool(flagJSON)
+	)
+	if since == "" {
+		return errors.New("the revision of the release is required, set it with --since")
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Comparing with " + since + "...")
+	changelog, err := c.Changelog(cmd.Context(), since)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	content := changelog.Markdown()
+	if asJSON {
+		data, err := json.MarshalIndent(changelog, "", "  ")
+		if err != nil {
+			return err
+		}
+		content = string(data) + "\n"
+	}
+
+	if out == "" {
+		return session.Print(content)
+	}
+	if err := os.WriteFile(out, []byte(content), 0644); err != nil {
+		return err
+	}
+	return session.Printf("%s Changelog written to %s\n", icons.OK, out)
+}
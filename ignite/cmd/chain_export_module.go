@@ -0,0 +1,80 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+const flagHeight = "height"
+
+// NewChainExportModule creates a new command to export the store of a module from a running chain.
+func NewChainExportModule() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export-module [store-key]",
+		Short: "Export the store of a module from the running chain to a snapshot file",
+		Long: `Export the content of the store of a module from the chain served with "ignite chain serve".
+
+The snapshot file can be loaded in the keeper tests with the testutil/state package
+of the chain to test the keepers against a realistic state:
+
+	snapshot := state.Load(t, "testdata/mars.json")
+	state.Restore(t, ctx.KVStore(storeKey), snapshot)
+
+The store key is usually the name of the module.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainExportModuleHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Int64(flagHeight, 0, "Height of the exported state (default: latest height)")
+	c.Flags().String(flagOut, "", "Path of the snapshot file (default: ./[store-key].json)")
+
+	return c
+}
+
+func chainExportModuleHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		storeKey  = args[0]
+		height, _ = cmd.Flags().GetInt64(flagHeight)
+		out, _    = cmd.Flags().GetString(flagOut)
+	)
+	if out == "" {
+		out = fmt.Sprintf("./%s.json", storeKey)
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	state, err := c.ExportModule(cmd.Context(), storeKey, height)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, data, 0644); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Exported %d entries of %s at height %d: %s\n", icons.OK, len(state.Pairs), storeKey, state.Height, out)
+}
@@ -21,6 +21,8 @@ func NewChainInit() *cobra.Command {
 	flagSetPath(c)
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetChainID())
+	c.Flags().AddFlagSet(flagSetAccountsKeyringBackend())
 
 	return c
 }
@@ -29,6 +31,10 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 	chainOption := []chain.Option{
 		chain.LogLevel(logLevel(cmd)),
 		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.AccountsKeyringBackend(getKeyringBackend(cmd)),
+	}
+	if chainID := getChainID(cmd); chainID != "" {
+		chainOption = append(chainOption, chain.ID(chainID))
 	}
 
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
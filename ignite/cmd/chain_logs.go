@@ -0,0 +1,44 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFollow = "follow"
+	flagLines  = "lines"
+)
+
+// NewChainLogs returns a new command to print the logs of the chain served in the background.
+func NewChainLogs() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "logs",
+		Short: "Print the logs of the chain served in the background by 'ignite chain serve --detach'",
+		Long: `Print the output of the chain served in the background by "ignite chain serve --detach".
+
+The logs of the previous runs are kept, each run starts with a line holding its start time and its
+command. Follow the logs with --follow until Ctrl+C.`,
+		Args: cobra.NoArgs,
+		RunE: chainLogsHandler,
+	}
+
+	flagSetDaemon(c)
+	c.Flags().BoolP(flagFollow, "f", false, "Print the logs as they're written")
+	c.Flags().IntP(flagLines, "n", 100, "Number of lines to print from the end of the logs, all the lines when 0")
+
+	return c
+}
+
+func chainLogsHandler(cmd *cobra.Command, _ []string) error {
+	follow, _ := cmd.Flags().GetBool(flagFollow)
+	lines, _ := cmd.Flags().GetInt(flagLines)
+
+	c, err := newDaemonChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.DaemonLogs(cmd.Context(), os.Stdout, lines, follow)
+}
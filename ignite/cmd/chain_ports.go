@@ -0,0 +1,56 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// NewChainPorts creates a new command to document the ports of the servers of the chain.
+func NewChainPorts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ports",
+		Short: "Print the ports of the servers started by 'ignite chain serve'",
+		Long: `Print the markdown documentation of the ports of the servers started by "ignite chain serve".
+
+The documentation lists the effective addresses of the servers, including the faucet and the frontend,
+with the key of config.yml setting them: the addresses of "host" are overwritten by the addresses of
+the node config in "init.app" and "init.config". The ports are applied to the node config each time
+the chain is initialized, so they are kept when the chain is reset, and config.yml is invalid when
+two servers listen on the same port.
+
+It also lists the firewall rules opening the ports of the servers reachable from other machines and
+the ports to forward from a dev container.
+
+Bind all the servers to an interface with host.bind in config.yml, e.g. "0.0.0.0" or "::" to reach
+them from a remote dev server or a dev container.`,
+		Args: cobra.NoArgs,
+		RunE: chainPortsHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().StringP(flagOutput, "o", "", "Path of the file the documentation is written to")
+
+	return c
+}
+
+func chainPortsHandler(cmd *cobra.Command, _ []string) error {
+	output, _ := cmd.Flags().GetString(flagOutput)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	doc, err := c.PortsDoc()
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		fmt.Print(string(doc))
+		return nil
+	}
+	return os.WriteFile(output, doc, 0644)
+}
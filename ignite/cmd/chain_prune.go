@@ -0,0 +1,67 @@
+package ignitecmd
+
+import (
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainPrune returns a new command to prune the data of the chain.
+func NewChainPrune() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the states and the blocks of the chain and compact its data",
+		Long: `Delete the states and the blocks of the node of the chain that aren't kept by the pruning of
+config.yml and compact the databases of its data dir to reclaim the disk space:
+
+  validator:
+    pruning:
+      strategy: custom
+      keep_recent: 100
+      interval: 10
+      min_retain_blocks: 1000
+
+The states are pruned with the strategy of the config, the last 100 states are kept by default.
+The blocks older than the min_retain_blocks latest are pruned, all the blocks are kept by default.
+
+The data of the validators served with the chain are pruned as well. Stop the chain before pruning
+its data, the nodes lock their databases while they run.`,
+		Args: cobra.NoArgs,
+		RunE: chainPruneHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainPruneHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Pruning the data of the chain...")
+
+	result, err := c.Prune()
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf(
+		"%s Pruned %d states and %d blocks, the data of the chain went from %s to %s\n",
+		icons.OK,
+		result.States,
+		result.Blocks,
+		humanize.Bytes(uint64(result.SizeBefore)),
+		humanize.Bytes(uint64(result.SizeAfter)),
+	)
+}
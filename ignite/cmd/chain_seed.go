@@ -0,0 +1,78 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagSeedFile = "file"
+
+// NewChainSeed creates a new command to create the test data of the served chain.
+func NewChainSeed() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "seed",
+		Short: "Create test data on the chain served with 'ignite chain serve'",
+		Long: `Create test data on the chain served with "ignite chain serve" from a seed file,
+to rebuild the data of the chain after a reset:
+
+	ignite chain seed --file seed.yml
+
+The seed file declares the tokens sent between the accounts and the objects of the types
+scaffolded with "ignite scaffold list" or "ignite scaffold map":
+
+	bank:
+	  - name: fund-bob
+	    from: alice
+	    to: bob
+	    amount: 1000token
+	objects:
+	  - name: first-post
+	    module: blog
+	    type: post
+	    from: bob
+	    fields:
+	      title: Hello
+	      body: World
+	    depends_on: [fund-bob]
+
+The objects are created with the create message of their type, the values of the
+indexes and the fields are set by name. The entries are created in the order of their
+dependencies, the sends first when they don't depend on other entries.`,
+		Args: cobra.NoArgs,
+		RunE: chainSeedHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().StringP(flagSeedFile, "f", "seed.yml", "Seed file")
+
+	return c
+}
+
+func chainSeedHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	file, _ := cmd.Flags().GetString(flagSeedFile)
+	seed, err := chain.ParseSeed(file)
+	if err != nil {
+		return err
+	}
+
+	c, err := newChainWithHomeFlags(cmd, chain.KeyringBackend(chaincmd.KeyringBackendTest))
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Seeding...")
+	if err := c.Seed(cmd.Context(), seed); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return session.Printf("%s Created %d sends and %d objects\n", icons.OK, len(seed.Bank), len(seed.Objects))
+}
@@ -1,15 +1,33 @@
 package ignitecmd
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/correlation"
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
 const (
-	flagForceReset = "force-reset"
-	flagResetOnce  = "reset-once"
-	flagConfig     = "config"
+	flagForceReset    = "force-reset"
+	flagResetOnce     = "reset-once"
+	flagConfig        = "config"
+	flagConfigProfile = "config-profile"
+	flagQuiet         = "quiet"
+	flagEndpoints     = "endpoints-file"
+	flagProfile       = "profile"
+	flagProfileInt    = "profile-interval"
+	flagLogLevel      = "log-level"
+	flagValidators    = "validators"
+	flagDetach        = "detach"
+	flagRosetta       = "rosetta"
 )
 
 // NewChainServe creates a new serve command to serve a blockchain.
@@ -25,24 +43,55 @@ func NewChainServe() *cobra.Command {
 	flagSetPath(c)
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetChainID())
+	c.Flags().AddFlagSet(flagSetFeatures())
 	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
+	c.Flags().AddFlagSet(flagSetAccountsKeyringBackend())
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "Force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "Reset of the app state on first start")
+	c.Flags().StringSlice(flagReset, nil, "Reset only parts of the app state on first start: data (keeps keys and genesis), genesis (rebuilds genesis from config, keeps keys) or keys")
 	c.Flags().StringP(flagConfig, "c", "", "Ignite config file (default: ./config.yml)")
+	c.Flags().String(flagConfigProfile, "", "Profile of the config overriding it, e.g. testnet")
+	c.Flags().BoolP(flagQuiet, "q", false, "Print only errors and write the chain endpoints to a JSON file")
+	c.Flags().String(flagEndpoints, "", "JSON file to write the chain endpoints to (default: endpoints.json in the chain state directory)")
+	c.Flags().Bool(flagProfile, false, "Capture the CPU and heap profiles of the node with a report of their top consumers in the profiles directory")
+	c.Flags().Duration(flagProfileInt, chain.DefaultProfileInterval, "Interval between two captures of the profiles")
+	c.Flags().String(flagLogLevel, "", "Write the structured logs of the builds, restarts and failures of the app to stderr as JSON from this level: debug, info or error")
+	c.Flags().Int(flagValidators, 1, "Number of validators of a local network to serve, the validators after the first one run in their own home with the ports of the config shifted by 10 per validator")
+	c.Flags().BoolP(flagDetach, "d", false, "Serve the chain in the background once it answers, manage it with the status, logs and stop commands of the chain")
+	c.Flags().Bool(flagRosetta, false, "Start the Rosetta API gateway with the node on the host.rosetta address, even when it isn't enabled in the config")
+	c.Flags().AddFlagSet(flagSetYes())
 
 	return c
 }
 
 func chainServeHandler(cmd *cobra.Command, args []string) error {
+	quiet, err := cmd.Flags().GetBool(flagQuiet)
+	if err != nil {
+		return err
+	}
+
 	chainOption := []chain.Option{
 		chain.LogLevel(logLevel(cmd)),
+		chain.AccountsKeyringBackend(getKeyringBackend(cmd)),
+	}
+	if quiet {
+		chainOption = append(chainOption, chain.LogLevel(chain.LogQuiet))
 	}
 
 	if flagGetProto3rdParty(cmd) {
 		chainOption = append(chainOption, chain.EnableThirdPartyModuleCodegen())
 	}
 
+	logger, err := getStructuredLogger(cmd)
+	if err != nil {
+		return err
+	}
+	if logger != nil {
+		chainOption = append(chainOption, chain.Logger(logger))
+	}
+
 	// check if custom config is defined
 	config, err := cmd.Flags().GetString(flagConfig)
 	if err != nil {
@@ -52,6 +101,22 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	configProfile, err := cmd.Flags().GetString(flagConfigProfile)
+	if err != nil {
+		return err
+	}
+	if configProfile != "" {
+		chainOption = append(chainOption, chain.ConfigProfile(configProfile))
+	}
+
+	if chainID := getChainID(cmd); chainID != "" {
+		chainOption = append(chainOption, chain.ID(chainID))
+	}
+
+	if rosetta, _ := cmd.Flags().GetBool(flagRosetta); rosetta {
+		chainOption = append(chainOption, chain.EnableRosetta())
+	}
+
 	// create the chain
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
@@ -80,5 +145,180 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		serveOptions = append(serveOptions, chain.ServeResetOnce())
 	}
 
+	resetScopes, err := getResetScopes(cmd)
+	if err != nil {
+		return err
+	}
+	if len(resetScopes) > 0 {
+		if !getYes(cmd) {
+			if err := confirmReset(c, resetScopes); err != nil {
+				return err
+			}
+		}
+		serveOptions = append(serveOptions, chain.ServeResetScopes(resetScopes...))
+	}
+
+	endpointsPath, err := cmd.Flags().GetString(flagEndpoints)
+	if err != nil {
+		return err
+	}
+	if endpointsPath == "" && quiet {
+		if endpointsPath, err = c.DefaultEndpointsPath(); err != nil {
+			return err
+		}
+	}
+	if endpointsPath != "" {
+		serveOptions = append(serveOptions, chain.ServeEndpointsFile(endpointsPath))
+	}
+
+	profile, err := cmd.Flags().GetBool(flagProfile)
+	if err != nil {
+		return err
+	}
+	if profile {
+		interval, err := cmd.Flags().GetDuration(flagProfileInt)
+		if err != nil {
+			return err
+		}
+		if interval < chain.MinProfileInterval {
+			return fmt.Errorf("the profile interval must be at least %s", chain.MinProfileInterval)
+		}
+		serveOptions = append(serveOptions, chain.ServeProfile(interval))
+	}
+
+	validators, err := cmd.Flags().GetInt(flagValidators)
+	if err != nil {
+		return err
+	}
+	if validators < 1 {
+		return errors.New("the local network requires at least one validator")
+	}
+	if validators > 1 {
+		serveOptions = append(serveOptions, chain.ServeValidators(validators))
+	}
+
+	detach, err := cmd.Flags().GetBool(flagDetach)
+	if err != nil {
+		return err
+	}
+	if detach {
+		return chainServeDetach(cmd, c, endpointsPath)
+	}
+
 	return c.Serve(cmd.Context(), cacheStorage, serveOptions...)
 }
+
+// chainServeDetach serves the chain in the background with the arguments of the command, it
+// returns once the chain answers.
+func chainServeDetach(cmd *cobra.Command, c *chain.Chain, endpointsPath string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	// the reset of the state is confirmed before the chain is detached, the daemon can't prompt.
+	command := append([]string{executable}, detachedServeArgs(os.Args[1:])...)
+	if !getYes(cmd) {
+		command = append(command, "--"+flagYes)
+	}
+	if endpointsPath == "" {
+		if endpointsPath, err = c.DefaultEndpointsPath(); err != nil {
+			return err
+		}
+		command = append(command, "--"+flagEndpoints, endpointsPath)
+	}
+
+	session.StartSpinner("Serving the chain in the background...")
+
+	d, err := c.StartDaemon(cmd.Context(), command, endpointsPath)
+	if err != nil {
+		return err
+	}
+	endpoints, err := d.Endpoints()
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf(
+		"%s The chain %s is served in the background by the process %d\n"+
+			"🌍 Tendermint node: %s\n"+
+			"📝 Endpoints: %s\n"+
+			"📜 Logs: %s\n\n"+
+			"Follow the logs with `ignite chain logs -f` and stop the chain with `ignite chain stop`.\n",
+		icons.OK,
+		endpoints.ChainID,
+		d.PID,
+		endpoints.RPC,
+		d.EndpointsFile,
+		d.LogFile,
+	)
+}
+
+// detachedServeArgs returns the arguments of the serve command without the detach flag.
+func detachedServeArgs(args []string) []string {
+	var serveArgs []string
+	for _, arg := range args {
+		switch {
+		case arg == "-d", arg == "--"+flagDetach, strings.HasPrefix(arg, "--"+flagDetach+"="):
+			continue
+		}
+		serveArgs = append(serveArgs, arg)
+	}
+	return serveArgs
+}
+
+// getStructuredLogger returns the JSON logger of the log level flag, nil when the level isn't set.
+// The log lines hold the correlation ID of the command.
+func getStructuredLogger(cmd *cobra.Command) (log.Logger, error) {
+	level, _ := cmd.Flags().GetString(flagLogLevel)
+	if level == "" {
+		return nil, nil
+	}
+
+	allowed, err := log.AllowLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := log.NewFilter(log.NewTMJSONLogger(log.NewSyncWriter(os.Stderr)), allowed)
+	return correlation.Logger(cmd.Context(), logger), nil
+}
+
+func getResetScopes(cmd *cobra.Command) (scopes []chain.ResetScope, err error) {
+	names, err := cmd.Flags().GetStringSlice(flagReset)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		scope, err := chain.ParseResetScope(name)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// confirmReset lists the paths deleted by the reset and asks the user for a confirmation.
+func confirmReset(c *chain.Chain, scopes []chain.ResetScope) error {
+	paths, err := c.ResetPaths(scopes...)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	if err := session.Printf("The following will be deleted:\n\n  %s\n\n", strings.Join(paths, "\n  ")); err != nil {
+		return err
+	}
+	if err := session.AskConfirm("Do you want to proceed"); err != nil {
+		return fmt.Errorf("app state reset aborted")
+	}
+	return nil
+}
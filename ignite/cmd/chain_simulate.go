@@ -1,11 +1,16 @@
 package ignitecmd
 
 import (
+	"errors"
+	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
@@ -26,6 +31,13 @@ const (
 	flagSimappVerbose                = "verbose"
 	flagSimappPeriod                 = "period"
 	flagSimappGenesisTime            = "genesisTime"
+	flagSimappProfile                = "profile"
+	flagSimappReplay                 = "replay"
+	flagSimappFailureOut             = "failure-out"
+	flagSimappExportTest             = "export-test"
+
+	// defaultSimappFailureOut is the failure artifact in the app directory by default.
+	defaultSimappFailureOut = "simulation-failure.json"
 )
 
 // NewChainSimulate creates a new simulation command to run the blockchain simulation.
@@ -33,21 +45,47 @@ func NewChainSimulate() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "simulate",
 		Short: "Run simulation testing for the blockchain",
-		Long:  "Run simulation testing for the blockchain. It sends many randomized-input messages of each module to a simulated node and checks if invariants break",
-		Args:  cobra.NoArgs,
-		RunE:  chainSimulationHandler,
+		Long: `Run simulation testing for the blockchain. It sends many randomized-input messages of each module to a simulated node and checks if invariants break.
+
+Use a profile to set the parameters of the simulation, the flags set overwrite the ones of the profile:
+
+  ignite chain simulate --profile nightly --seed 7
+
+When the simulation fails, the parameters replaying the same operations are saved to simulation-failure.json,
+e.g. to upload it as a CI artifact. Replay the failure locally or export it as a Go test of the app:
+
+  ignite chain simulate --replay simulation-failure.json
+  ignite chain simulate --replay simulation-failure.json --export-test
+`,
+		Args: cobra.NoArgs,
+		RunE: chainSimulationHandler,
 	}
 	simappFlags(c)
 	return c
 }
 
 func chainSimulationHandler(cmd *cobra.Command, args []string) error {
+	var (
+		replay, _     = cmd.Flags().GetString(flagSimappReplay)
+		exportTest, _ = cmd.Flags().GetBool(flagSimappExportTest)
+		failureOut, _ = cmd.Flags().GetString(flagSimappFailureOut)
+		profile, _    = cmd.Flags().GetString(flagSimappProfile)
+		appPath       = flagGetPath(cmd)
+	)
+	if exportTest && replay == "" {
+		return errors.New("--export-test exports the failure of --replay")
+	}
+	if profile != "" {
+		if err := applySimulationProfile(cmd, profile); err != nil {
+			return err
+		}
+	}
+
 	var (
 		verbose, _     = cmd.Flags().GetBool(flagSimappVerbose)
 		period, _      = cmd.Flags().GetUint(flagSimappPeriod)
 		genesisTime, _ = cmd.Flags().GetInt64(flagSimappGenesisTime)
 		config         = newConfigFromFlags(cmd)
-		appPath        = flagGetPath(cmd)
 	)
 	// create the chain with path
 	absPath, err := filepath.Abs(appPath)
@@ -59,17 +97,80 @@ func chainSimulationHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	config.ChainID, err = c.ID()
-	if err != nil {
-		return err
+	if failureOut == "" {
+		failureOut = filepath.Join(absPath, defaultSimappFailureOut)
 	}
-
-	return c.Simulate(cmd.Context(),
+	options := []chain.SimappOption{
 		chain.SimappWithVerbose(verbose),
-		chain.SimappWithPeriod(period),
-		chain.SimappWithGenesisTime(genesisTime),
-		chain.SimappWithConfig(config),
-	)
+		chain.SimappWithFailureOut(failureOut),
+	}
+
+	if replay != "" {
+		failure, err := chain.ReadSimulationFailure(replay)
+		if err != nil {
+			return err
+		}
+		if exportTest {
+			path, name, err := c.ExportSimulationTest(failure)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("🧪 Simulation failure exported to %s, run it with: go test -run %s ./app\n",
+				colors.Info(path),
+				name,
+			)
+			return nil
+		}
+		options = append(options, chain.SimappWithReplay(failure))
+	} else {
+		config.ChainID, err = c.ID()
+		if err != nil {
+			return err
+		}
+		options = append(options,
+			chain.SimappWithPeriod(period),
+			chain.SimappWithGenesisTime(genesisTime),
+			chain.SimappWithConfig(config),
+			chain.SimappWithProfile(profile),
+		)
+	}
+
+	err = c.Simulate(cmd.Context(), options...)
+	var failed *chain.SimulationFailedError
+	if errors.As(err, &failed) {
+		fmt.Printf("💾 Simulation failure saved to %s, replay it with: ignite chain simulate --replay %s\n",
+			colors.Info(failed.Path),
+			failed.Path,
+		)
+	}
+	return err
+}
+
+// applySimulationProfile sets the flags of the simulation parameters to the values of the profile,
+// the flags set by the user are kept.
+func applySimulationProfile(cmd *cobra.Command, name string) error {
+	profile, ok := chain.SimulationProfiles[name]
+	if !ok {
+		return fmt.Errorf("unknown simulation profile %q, use one of: %s", name, strings.Join(chain.SimulationProfileNames(), ", "))
+	}
+
+	values := map[string]string{
+		flagSimappNumBlocks:              strconv.Itoa(profile.NumBlocks),
+		flagSimappBlockSize:              strconv.Itoa(profile.BlockSize),
+		flagSimappPeriod:                 strconv.FormatUint(uint64(profile.Period), 10),
+		flagSimappSimulateEveryOperation: strconv.FormatBool(profile.OnOperation),
+		flagSimappPrintAllInvariants:     strconv.FormatBool(profile.AllInvariants),
+		flagSimappLean:                   strconv.FormatBool(profile.Lean),
+	}
+	for flag, value := range values {
+		if cmd.Flags().Changed(flag) {
+			continue
+		}
+		if err := cmd.Flags().Set(flag, value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // newConfigFromFlags creates a simulation from the retrieved values of the flags.
@@ -127,4 +228,8 @@ func simappFlags(c *cobra.Command) {
 	c.Flags().BoolP(flagSimappVerbose, "v", false, "verbose log output")
 	c.Flags().Uint(flagSimappPeriod, 0, "run slow invariants only once every period assertions")
 	c.Flags().Int64(flagSimappGenesisTime, 0, "override genesis UNIX time instead of using a random UNIX time")
+	c.Flags().String(flagSimappProfile, "", fmt.Sprintf("simulation profile setting the parameters not set by the flags (%s)", strings.Join(chain.SimulationProfileNames(), ", ")))
+	c.Flags().String(flagSimappReplay, "", "replay the simulation of a failure artifact")
+	c.Flags().String(flagSimappFailureOut, "", "path of the failure artifact saved when the simulation fails, "+defaultSimappFailureOut+" of the app by default")
+	c.Flags().Bool(flagSimappExportTest, false, "export the failure of --replay as a Go test of the app instead of running it")
 }
@@ -0,0 +1,28 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainSnapshot returns a new command to manage the snapshots of the chain.
+func NewChainSnapshot() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "snapshot [command]",
+		Short: "Save and restore snapshots of the state of the chain",
+		Long: `Save and restore snapshots of the state of the chain served with "ignite chain serve".
+
+A snapshot checkpoints the exported state of the chain at its current height with the node data and
+the keys of its home, so seed data can be restored after destructive runs like "ignite chain serve
+--reset-once". Stop the chain before saving or restoring a snapshot, the node locks its data while it
+runs.
+
+The snapshots are stored in the state directory of the chain, in ~/.ignite/local-chains.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainSnapshotSave(),
+		NewChainSnapshotRestore(),
+		NewChainSnapshotList(),
+	)
+
+	return c
+}
@@ -0,0 +1,53 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+)
+
+// NewChainSnapshotList returns a new command to list the snapshots of the chain.
+func NewChainSnapshotList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the snapshots of the chain",
+		Args:  cobra.NoArgs,
+		RunE:  chainSnapshotListHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainSnapshotListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := c.Snapshots()
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return session.Println("No snapshot, save one with \"ignite chain snapshot save [name]\"")
+	}
+
+	var entries [][]string
+	for _, s := range snapshots {
+		entries = append(entries, []string{
+			s.Name,
+			strconv.FormatInt(s.Height, 10),
+			s.Created.Local().Format(time.RFC3339),
+		})
+	}
+	return session.PrintTable([]string{"name", "height", "created"}, entries...)
+}
@@ -0,0 +1,64 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainSnapshotRestore returns a new command to restore a snapshot of the chain.
+func NewChainSnapshotRestore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore a snapshot of the chain, replacing its home",
+		Long: `Restore a snapshot of the chain, the home of the chain is replaced with the one of the
+snapshot. Serve the chain without resetting its state to restart it from the snapshot.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainSnapshotRestoreHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetYes())
+
+	return c
+}
+
+func chainSnapshotRestoreHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.Snapshot(args[0])
+	if err != nil {
+		return err
+	}
+
+	if !getYes(cmd) {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		question := fmt.Sprintf("The home of the chain %s will be replaced by the snapshot. Do you want to proceed", home)
+		if err := session.AskConfirm(question); err != nil {
+			return session.PrintSaidNo()
+		}
+	}
+
+	session.StartSpinner("Restoring the snapshot...")
+
+	if _, err := c.RestoreSnapshot(snapshot.Name); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Snapshot %s restored at height %d, run \"ignite chain serve\" to restart the chain\n", icons.OK, snapshot.Name, snapshot.Height)
+}
@@ -0,0 +1,47 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainSnapshotSave returns a new command to save a snapshot of the chain.
+func NewChainSnapshotSave() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "save [name]",
+		Short: "Save a snapshot of the state and the node data of the chain at its current height",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotSaveHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().BoolP(flagForce, "f", false, "Replace the snapshot with the same name")
+
+	return c
+}
+
+func chainSnapshotSaveHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	force, _ := cmd.Flags().GetBool(flagForce)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Saving the snapshot...")
+
+	snapshot, err := c.SaveSnapshot(cmd.Context(), args[0], force)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Snapshot %s saved at height %d\n", icons.OK, snapshot.Name, snapshot.Height)
+}
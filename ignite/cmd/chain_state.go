@@ -0,0 +1,24 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainState returns a new command to inspect the application state of the chain.
+func NewChainState() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "state [command]",
+		Short: "Export and compare the application state of the chain",
+		Long: `Export and compare the application state of the chain served with "ignite chain serve",
+module by module, e.g. to debug the upgrade handlers and the genesis migrations of the chain.
+
+The states are exported with the export command of the chain binary, stop the chain before
+exporting its state, the node locks its data while it runs.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainStateExport(),
+		NewChainStateDiff(),
+	)
+
+	return c
+}
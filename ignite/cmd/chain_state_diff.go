@@ -0,0 +1,102 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cosmosstate"
+)
+
+// NewChainStateDiff returns a new command to compare two application states of the chain.
+func NewChainStateDiff() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "diff [state-a] [state-b]",
+		Short: "Compare two application states of the chain module by module",
+		Long: `Compare two application states of the chain and print the entries added (+), removed (-)
+and changed (~) from the first state to the second one, grouped by module.
+
+A state is either the name of a snapshot saved with "ignite chain snapshot save", or the path of
+a file exported with "ignite chain state export" or of an exported genesis:
+
+	ignite chain state diff before-upgrade after-upgrade --module bank
+
+The entries of the lists are matched by their identifier when they have one, like an address, a
+denom or an id, otherwise by their position. Use --json to print the changes as JSON.`,
+		Args: cobra.ExactArgs(2),
+		RunE: chainStateDiffHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().StringSlice(flagModule, nil, "Modules of the compared states (default: all the modules)")
+	c.Flags().Bool(flagJSON, false, "Print the changes as JSON")
+
+	return c
+}
+
+func chainStateDiffHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		modules, _ = cmd.Flags().GetStringSlice(flagModule)
+		asJSON, _  = cmd.Flags().GetBool(flagJSON)
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	states := make([]cosmosstate.State, len(args))
+	for i, source := range args {
+		state, err := c.LoadState(source)
+		if err != nil {
+			return err
+		}
+		if states[i], err = state.Filter(modules...); err != nil {
+			return err
+		}
+	}
+
+	changes, err := cosmosstate.Diff(states[0], states[1])
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if asJSON {
+		if changes == nil {
+			changes = []cosmosstate.Change{}
+		}
+		data, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return err
+		}
+		return session.Println(string(data))
+	}
+
+	if len(changes) == 0 {
+		return session.Printf("The states at heights %d and %d are the same\n", states[0].Height, states[1].Height)
+	}
+	if err := session.Printf("%d changes from height %d to height %d\n", len(changes), states[0].Height, states[1].Height); err != nil {
+		return err
+	}
+	var module string
+	for _, change := range changes {
+		if change.Module != module {
+			module = change.Module
+			if err := session.Printf("\n%s\n", colors.Info(module)); err != nil {
+				return err
+			}
+		}
+		if err := session.Printf("  %s\n", change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
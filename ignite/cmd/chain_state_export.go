@@ -0,0 +1,73 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainStateExport returns a new command to export the application state of the chain.
+func NewChainStateExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state of the chain at its current height",
+		Long: `Export the application state of the chain at its current height as JSON, printed or written
+to a file with --out. Use --module to only export the state of some modules:
+
+	ignite chain state export --module bank --out bank.json
+
+The file can be compared with another state with "ignite chain state diff".`,
+		Args: cobra.NoArgs,
+		RunE: chainStateExportHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().StringSlice(flagModule, nil, "Modules of the exported state (default: all the modules)")
+	c.Flags().String(flagOut, "", "Path to write the state to instead of printing it")
+
+	return c
+}
+
+func chainStateExportHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		modules, _ = cmd.Flags().GetStringSlice(flagModule)
+		out, _     = cmd.Flags().GetString(flagOut)
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Exporting the state...")
+
+	state, err := c.ExportState(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if state, err = state.Filter(modules...); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if out == "" {
+		return session.Println(string(data))
+	}
+	if err := os.WriteFile(out, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return session.Printf("%s Exported the state at height %d: %s\n", icons.OK, state.Height, out)
+}
@@ -0,0 +1,85 @@
+package ignitecmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainStatus returns a new command to print the status of the chain served in the background.
+func NewChainStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status",
+		Short: "Print the status of the chain served in the background by 'ignite chain serve --detach'",
+		Long: `Print the status of the chain served in the background by "ignite chain serve --detach": the
+process serving it, its uptime, its endpoints and its log file.
+
+The command fails when the chain isn't served in the background, so scripts can check that the chain
+is running before using it.`,
+		Args: cobra.NoArgs,
+		RunE: chainStatusHandler,
+	}
+
+	flagSetDaemon(c)
+
+	return c
+}
+
+func chainStatusHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	c, err := newDaemonChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	// the error of a chain not served in the background fails the scripts checking it.
+	d, err := c.Daemon()
+	if err != nil {
+		return err
+	}
+
+	if err := session.Printf(
+		"%s The chain is served in the background by the process %d for %s\n📜 Logs: %s\n",
+		icons.OK,
+		d.PID,
+		time.Since(d.StartedAt).Round(time.Second),
+		d.LogFile,
+	); err != nil {
+		return err
+	}
+
+	// the endpoints are written once the node answers.
+	endpoints, err := d.Endpoints()
+	if err != nil {
+		return session.Println("⏳ The node doesn't answer yet")
+	}
+	return session.Printf(
+		"🌍 Tendermint node: %s\n🌍 Blockchain API: %s\n🌍 gRPC: %s\n📝 Endpoints: %s\n",
+		endpoints.RPC,
+		endpoints.API,
+		endpoints.GRPC,
+		d.EndpointsFile,
+	)
+}
+
+// flagSetDaemon adds the flags locating the chain served in the background.
+func flagSetDaemon(c *cobra.Command) {
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetChainID())
+}
+
+// newDaemonChain returns the chain served in the background.
+func newDaemonChain(cmd *cobra.Command) (*chain.Chain, error) {
+	var options []chain.Option
+	if chainID := getChainID(cmd); chainID != "" {
+		options = append(options, chain.ID(chainID))
+	}
+	return newChainWithHomeFlags(cmd, options...)
+}
@@ -0,0 +1,47 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainStop returns a new command to stop the chain served in the background.
+func NewChainStop() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the chain served in the background by 'ignite chain serve --detach'",
+		Long: `Stop the chain served in the background by "ignite chain serve --detach".
+
+The chain is interrupted like with Ctrl+C so it saves its state for the next serve, it's killed when
+it doesn't stop within 30 seconds. The logs of the chain are kept.`,
+		Args: cobra.NoArgs,
+		RunE: chainStopHandler,
+	}
+
+	flagSetDaemon(c)
+
+	return c
+}
+
+func chainStopHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	c, err := newDaemonChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Stopping the chain...")
+
+	d, err := c.StopDaemon(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Stopped the chain served in the background by the process %d\n", icons.OK, d.PID)
+}
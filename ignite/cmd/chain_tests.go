@@ -0,0 +1,155 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagPackages     = "packages"
+	flagParallel     = "parallel"
+	flagJobs         = "jobs"
+	flagRace         = "race"
+	flagCoverProfile = "coverprofile"
+	flagTestTimeout  = "timeout"
+)
+
+// NewChainTest creates a new command to run the tests of the chain.
+func NewChainTest() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "test",
+		Short: "Run the tests of the blockchain module by module",
+		Long: `Run the Go tests of the blockchain with "go test", module by module.
+
+The packages of each module of x/ are tested by their own go test process, and so are the packages
+of the other top level directories like app, so the heavy test setups of a module, like the app of
+the simulation tests, don't slow down or break the tests of the other modules.
+
+Use --parallel to test several modules at once, --race to enable the race detector and
+--coverprofile to write the coverage profile aggregated across the modules. A summary of the
+tests of each module is printed with the output of the failed tests.`,
+		Example: "  ignite chain test --parallel --packages ./x/...",
+		Args:    cobra.NoArgs,
+		RunE:    chainTestHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetFeatures())
+	c.Flags().StringSlice(flagPackages, chain.DefaultTestPackages, "Patterns of the tested packages")
+	c.Flags().Bool(flagParallel, false, "Test several modules at once")
+	c.Flags().Int(flagJobs, runtime.NumCPU(), "Number of modules tested at once with --parallel")
+	c.Flags().Bool(flagRace, false, "Enable the race detector")
+	c.Flags().String(flagCoverProfile, "", "Write the coverage profile aggregated across the modules to this file")
+	c.Flags().Duration(flagTestTimeout, 0, "Fail the tests of a module running longer than this duration (default: the timeout of go test)")
+
+	return c
+}
+
+func chainTestHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		packages, _     = cmd.Flags().GetStringSlice(flagPackages)
+		parallel, _     = cmd.Flags().GetBool(flagParallel)
+		jobs, _         = cmd.Flags().GetInt(flagJobs)
+		race, _         = cmd.Flags().GetBool(flagRace)
+		coverProfile, _ = cmd.Flags().GetString(flagCoverProfile)
+		timeout, _      = cmd.Flags().GetDuration(flagTestTimeout)
+	)
+
+	testOptions := []chain.TestOption{chain.TestPackages(packages...)}
+	if parallel {
+		if jobs < 1 {
+			return errors.New("at least one module must be tested at once")
+		}
+		testOptions = append(testOptions, chain.TestParallel(jobs))
+	}
+	if race {
+		testOptions = append(testOptions, chain.TestRace())
+	}
+	if coverProfile != "" {
+		testOptions = append(testOptions, chain.TestCoverProfile(coverProfile))
+	}
+	if timeout > 0 {
+		testOptions = append(testOptions, chain.TestTimeout(timeout))
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Testing the modules...")
+
+	report, err := c.Test(cmd.Context(), testOptions...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var (
+		entries [][]string
+		failed  []chain.ModuleTestResult
+	)
+	for _, m := range report.Modules {
+		status := "ok"
+		if m.Failed {
+			status = "FAIL"
+			failed = append(failed, m)
+		}
+		entries = append(entries, []string{
+			m.Name,
+			status,
+			strconv.Itoa(len(m.Packages)),
+			strconv.Itoa(m.Passed),
+			strconv.Itoa(m.FailedTests),
+			strconv.Itoa(m.Skipped),
+			formatCoverage(m.Coverage),
+			m.Duration.Round(10 * time.Millisecond).String(),
+		})
+	}
+
+	for _, m := range failed {
+		if err := session.Printf("--- %s\n%s\n", m.Name, strings.TrimRight(m.Output, "\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := session.PrintTable(
+		[]string{"module", "status", "packages", "passed", "failed", "skipped", "coverage", "duration"},
+		entries...,
+	); err != nil {
+		return err
+	}
+
+	if report.Coverage != nil {
+		if err := session.Printf("\nTotal coverage: %s, profile written to %s\n", formatCoverage(report.Coverage), coverProfile); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("the tests of %d of %d modules failed", len(failed), len(report.Modules))
+	}
+	return session.Printf("\n%s All the tests passed in %s\n", icons.OK, report.Duration.Round(10*time.Millisecond))
+}
+
+// formatCoverage formats the percentage of coverage, a dash when the coverage isn't collected.
+func formatCoverage(coverage *float64) string {
+	if coverage == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", *coverage)
+}
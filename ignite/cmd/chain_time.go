@@ -0,0 +1,67 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainTime creates a new command to manipulate the block time of the served chain.
+func NewChainTime() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "time [command]",
+		Short: "Manipulate the block time of the chain served with 'ignite chain serve'",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainTimeAdvance())
+
+	return c
+}
+
+// NewChainTimeAdvance creates a new command to fast-forward the block time of the served chain.
+func NewChainTimeAdvance() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "advance [duration]",
+		Short: "Fast-forward the block time of the served chain",
+		Long: `Fast-forward the block time of the chain served with "ignite chain serve".
+
+The duration is added to the time of the next blocks, so vesting, unbonding,
+epochs and deadlines can be tested without waiting in real time:
+
+	ignite chain time advance 24h
+
+The time can't be moved backward, the offset is removed when the chain state is reset.
+Only the binaries built by "ignite chain serve" apply the offset.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainTimeAdvanceHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainTimeAdvanceHandler(cmd *cobra.Command, args []string) error {
+	d, err := time.ParseDuration(args[0])
+	if err != nil {
+		return err
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	offset, err := c.AdvanceTime(d)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Block time advanced by %s, the chain is now %s ahead\n", icons.OK, d, offset)
+	return nil
+}
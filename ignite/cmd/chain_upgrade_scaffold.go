@@ -0,0 +1,73 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+// NewChainUpgradeScaffold creates a new command to migrate a chain scaffolded with an older
+// version of Ignite CLI or of the Cosmos SDK.
+func NewChainUpgradeScaffold() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade-scaffold",
+		Short: "Migrate a chain scaffolded with an older version of Ignite CLI or of the Cosmos SDK",
+		Long: `Migrate a chain scaffolded with an older version of Ignite CLI, Starport or the Cosmos SDK
+to the versions of the chains scaffolded by this version of Ignite CLI:
+
+  imports  the imports of the packages moved from Starport, spm and ibc-go v2 are rewritten
+  go.mod   the dependencies are upgraded to the versions of the scaffolded chains, the
+           modules of the moved packages are dropped
+  app.go   the app is updated for the API changes of the dependencies, e.g. the IBC module
+           of transfer routed by ibc-go v3
+  config   the legacy options of config.yml are migrated, e.g. faucet.port
+
+The changes that can't be migrated automatically are reported, make them by hand and build
+the chain with "ignite chain build".`,
+		Args: cobra.NoArgs,
+		RunE: chainUpgradeScaffoldHandler,
+	}
+
+	flagSetPath(c)
+
+	return addGitChangesVerifier(c)
+}
+
+func chainUpgradeScaffoldHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	// the scaffolder of the older chains is created directly, newApp rejects them
+	sc, err := scaffolder.App(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Migrating...")
+	report, err := sc.UpgradeScaffold()
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	for _, migrated := range report.Migrated {
+		if err := session.Printf("%s %s\n", icons.OK, migrated); err != nil {
+			return err
+		}
+	}
+	for _, manual := range report.Manual {
+		if err := session.Printf("%s %s\n", icons.NotOK, manual); err != nil {
+			return err
+		}
+	}
+
+	if len(report.Modified) == 0 && len(report.Manual) == 0 {
+		return session.Println("The chain is up to date")
+	}
+	if len(report.Manual) > 0 {
+		return session.Printf("\nModified %d files, %d changes are left to migrate by hand\n", len(report.Modified), len(report.Manual))
+	}
+	return session.Printf("\nModified %d files, the chain is migrated\n", len(report.Modified))
+}
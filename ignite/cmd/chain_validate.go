@@ -0,0 +1,104 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosgenesis"
+)
+
+const (
+	flagStrict = "strict"
+	flagJSON   = "json"
+)
+
+// NewChainValidate creates a new command to validate the genesis of the chain.
+func NewChainValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the genesis of the chain",
+		Long: `Validate the genesis of the chain initialized with "ignite chain init" or "ignite chain serve"
+with the validate-genesis command of the chain binary.
+
+With --strict, the genesis is also checked against deterministic rules going beyond the validation
+of the modules, to detect the genesis files that fail or misbehave when the chain starts:
+
+  supply          the supply of each denom is the sum of its balances
+  module-account  the module accounts have the address derived from their name, and the staking
+                  pools hold the tokens of the validators
+  gentx           the gentxs create a validator with a self-delegation held by the delegator, and
+                  they are signed by the delegator for the chain ID of the genesis
+  denom-metadata  the denom units are defined by one metadata, and the base denoms are held
+  params          the params are sane, e.g. the trusting period of the IBC clients is shorter than
+                  their unbonding period and the voting period is shorter than the unbonding time
+
+Use --json to print the findings as JSON. The command fails when a finding is an error, the
+warnings are likely mistakes.`,
+		Args: cobra.NoArgs,
+		RunE: chainValidateHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Bool(flagStrict, false, "Check the genesis against the deterministic rules")
+	c.Flags().Bool(flagJSON, false, "Print the findings of the strict checks as JSON")
+
+	return c
+}
+
+func chainValidateHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		strict, _ = cmd.Flags().GetBool(flagStrict)
+		asJSON, _ = cmd.Flags().GetBool(flagJSON)
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	findings, err := c.Validate(cmd.Context(), strict)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if asJSON {
+		if findings == nil {
+			findings = cosmosgenesis.Findings{}
+		}
+		data, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := session.Println(string(data)); err != nil {
+			return err
+		}
+	} else {
+		for _, f := range findings {
+			icon := icons.Info
+			if f.Severity == cosmosgenesis.SeverityError {
+				icon = icons.NotOK
+			}
+			if err := session.Printf("%s %s [%s] %s: %s\n", icon, f.Severity, f.Rule, f.Path, f.Message); err != nil {
+				return err
+			}
+		}
+	}
+
+	if findings.HasErrors() {
+		return errors.New("the genesis breaks the strict rules")
+	}
+	if asJSON {
+		return nil
+	}
+	return session.Printf("%s The genesis is valid\n", icons.OK)
+}
@@ -0,0 +1,285 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/goenv"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+	"github.com/ignite/cli/ignite/pkg/xos"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagCleanProject  = "project"
+	flagCleanCache    = "cache"
+	flagCleanBinaries = "binaries"
+	flagCleanHomes    = "homes"
+	flagCleanAll      = "all"
+	flagCacheTTL      = "ttl"
+
+	defaultCacheTTL = time.Hour * 24 * 30
+)
+
+// NewClean returns the command to remove the data generated by Ignite CLI.
+func NewClean() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "clean",
+		Short: "Remove build artifacts, stale chain data and expired cache entries",
+		Long: `Remove the data generated by Ignite CLI and report the reclaimed space.
+
+Select what to remove with the flags:
+
+--project   the release and build directories and the installed binary of the project in --path
+--homes     the homes and saved states of the chains whose project has been deleted, unless a
+            project still served uses them
+--binaries  the installed binaries of the chains whose project has been deleted
+--cache     the cache entries older than --ttl
+--all       everything above
+
+Ignite CLI doesn't download toolchains, the build and module caches of Go are removed with
+"go clean -cache -modcache".`,
+		Args: cobra.NoArgs,
+		RunE: cleanHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().Bool(flagCleanProject, false, "Remove the build artifacts of the project")
+	c.Flags().Bool(flagCleanHomes, false, "Remove the chain homes and saved states of deleted projects")
+	c.Flags().Bool(flagCleanBinaries, false, "Remove the installed binaries of deleted projects")
+	c.Flags().Bool(flagCleanCache, false, "Remove the expired cache entries")
+	c.Flags().Bool(flagCleanAll, false, "Remove everything above")
+	c.Flags().Duration(flagCacheTTL, defaultCacheTTL, "Age after which the cache entries expire")
+	c.Flags().AddFlagSet(flagSetYes())
+
+	return c
+}
+
+func cleanHandler(cmd *cobra.Command, args []string) error {
+	var (
+		all, _           = cmd.Flags().GetBool(flagCleanAll)
+		cleanProject, _  = cmd.Flags().GetBool(flagCleanProject)
+		cleanHomes, _    = cmd.Flags().GetBool(flagCleanHomes)
+		cleanBinaries, _ = cmd.Flags().GetBool(flagCleanBinaries)
+		cleanCache, _    = cmd.Flags().GetBool(flagCleanCache)
+		ttl, _           = cmd.Flags().GetDuration(flagCacheTTL)
+	)
+	if !all && !cleanProject && !cleanHomes && !cleanBinaries && !cleanCache {
+		return fmt.Errorf(
+			"select what to clean with --%s, --%s, --%s, --%s or --%s",
+			flagCleanProject,
+			flagCleanHomes,
+			flagCleanBinaries,
+			flagCleanCache,
+			flagCleanAll,
+		)
+	}
+
+	var paths []string
+
+	// build artifacts of the current project
+	if all || cleanProject {
+		projectPaths, err := projectArtifactPaths(cmd, !cleanProject)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, projectPaths...)
+	}
+
+	// data of the deleted projects
+	staleProjects, liveProjects, err := splitStaleProjects()
+	if err != nil {
+		return err
+	}
+	if all || cleanHomes {
+		homePaths, err := staleHomePaths(staleProjects, liveProjects)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, homePaths...)
+	}
+	if all || cleanBinaries {
+		paths = append(paths, staleBinaryPaths(staleProjects, liveProjects)...)
+	}
+	paths = existingPaths(paths)
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	if len(paths) > 0 && !getYes(cmd) {
+		if err := session.Printf("The following will be deleted:\n\n  %s\n\n", strings.Join(paths, "\n  ")); err != nil {
+			return err
+		}
+		if err := session.AskConfirm("Do you want to proceed"); err != nil {
+			return fmt.Errorf("clean aborted")
+		}
+	}
+
+	var reclaimed uint64
+	for _, path := range paths {
+		size, err := xos.Size(path)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		reclaimed += uint64(size)
+		if err := session.Printf("🗑  Removed %s (%s)\n", path, humanize.Bytes(uint64(size))); err != nil {
+			return err
+		}
+	}
+
+	// forget the deleted projects once their data is removed
+	if all || (cleanHomes && cleanBinaries) {
+		if err := chain.SaveLocalProjects(liveProjects); err != nil {
+			return err
+		}
+	}
+
+	if all || cleanCache {
+		size, pruned, err := pruneCache(cmd, ttl)
+		if err != nil {
+			return err
+		}
+		reclaimed += size
+		if err := session.Printf("🗑  Removed %d cache entries older than %s (%s)\n", pruned, ttl, humanize.Bytes(size)); err != nil {
+			return err
+		}
+	}
+
+	return session.Printf("\n🧹 Reclaimed %s\n", humanize.Bytes(reclaimed))
+}
+
+// projectArtifactPaths returns the paths of the build artifacts of the project in the path flag.
+// When optional is true, no paths are returned if there is no project in the path.
+func projectArtifactPaths(cmd *cobra.Command, optional bool) ([]string, error) {
+	c, err := newChainWithHomeFlags(cmd)
+	if errors.Is(err, gomodule.ErrGoModNotFound) && optional {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BuildArtifactPaths()
+}
+
+// splitStaleProjects returns the local projects whose source was deleted and the other ones.
+func splitStaleProjects() (stale, live []chain.LocalProject, err error) {
+	projects, err := chain.LocalProjects()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, p := range projects {
+		isStale, err := p.IsStale()
+		if err != nil {
+			return nil, nil, err
+		}
+		if isStale {
+			stale = append(stale, p)
+		} else {
+			live = append(live, p)
+		}
+	}
+	return stale, live, nil
+}
+
+// staleHomePaths returns the homes and saved states of the stale projects that aren't used by live
+// projects, like the home of a deleted checkout of a chain served again from another path.
+func staleHomePaths(stale, live []chain.LocalProject) (paths []string, err error) {
+	used := make(map[string]bool)
+	for _, p := range live {
+		statePath, err := p.StatePath()
+		if err != nil {
+			return nil, err
+		}
+		used[p.Home] = true
+		used[statePath] = true
+	}
+	for _, p := range stale {
+		statePath, err := p.StatePath()
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range []string{p.Home, statePath} {
+			if !used[path] {
+				paths = append(paths, path)
+				used[path] = true
+			}
+		}
+	}
+	return paths, nil
+}
+
+// staleBinaryPaths returns the installed binaries of the stale projects that aren't used by live projects.
+func staleBinaryPaths(stale, live []chain.LocalProject) (paths []string) {
+	used := make(map[string]bool)
+	for _, p := range live {
+		used[p.Binary] = true
+	}
+	for _, p := range stale {
+		if !used[p.Binary] {
+			paths = append(paths, filepath.Join(goenv.Bin(), gocmd.ExecutableName(p.Binary, runtime.GOOS)))
+			used[p.Binary] = true
+		}
+	}
+	return paths
+}
+
+// existingPaths returns the paths that exist without duplicates.
+func existingPaths(paths []string) (existing []string) {
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		if _, err := os.Stat(path); err == nil {
+			existing = append(existing, path)
+		}
+	}
+	return existing
+}
+
+// pruneCache removes the cache entries older than ttl and returns the reclaimed space.
+func pruneCache(cmd *cobra.Command, ttl time.Duration) (reclaimed uint64, pruned int, err error) {
+	storage, err := newCache(cmd)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sizeBefore, err := xos.Size(storage.Path())
+	if err != nil {
+		return 0, 0, err
+	}
+	if sizeBefore == 0 {
+		return 0, 0, nil
+	}
+
+	if pruned, err = storage.Prune(ttl); err != nil {
+		return 0, 0, err
+	}
+	if err := storage.Compact(); err != nil {
+		return 0, 0, err
+	}
+
+	sizeAfter, err := xos.Size(storage.Path())
+	if err != nil {
+		return 0, 0, err
+	}
+	if sizeAfter < sizeBefore {
+		reclaimed = uint64(sizeBefore - sizeAfter)
+	}
+	return reclaimed, pruned, nil
+}
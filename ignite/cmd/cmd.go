@@ -32,6 +32,7 @@ const (
 	flagProto3rdParty = "proto-all-modules"
 	flagYes           = "yes"
 	flagClearCache    = "clear-cache"
+	flagFeatures      = "features"
 
 	checkVersionTimeout = time.Millisecond * 600
 	cacheFileName       = "ignite_cache.db"
@@ -70,7 +71,9 @@ ignite scaffold chain github.com/username/mars`,
 	c.AddCommand(NewNetwork())
 	c.AddCommand(NewAccount())
 	c.AddCommand(NewRelayer())
+	c.AddCommand(NewNode())
 	c.AddCommand(NewTools())
+	c.AddCommand(NewClean())
 	c.AddCommand(NewDocs())
 	c.AddCommand(NewVersion())
 	c.AddCommand(deprecated()...)
@@ -112,6 +115,17 @@ func getHome(cmd *cobra.Command) (home string) {
 	return
 }
 
+func flagSetChainID() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagChainID, "", "Chain ID overriding the one of the config, it can be a template, e.g. mychain-ci-${GIT_SHA}")
+	return fs
+}
+
+func getChainID(cmd *cobra.Command) (chainID string) {
+	chainID, _ = cmd.Flags().GetString(flagChainID)
+	return
+}
+
 func flagSetYes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.BoolP(flagYes, "y", false, "Answers interactive yes/no questions with yes")
@@ -149,12 +163,28 @@ func flagGetClearCache(cmd *cobra.Command) bool {
 	return clearCache
 }
 
+func flagSetFeatures() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringSlice(flagFeatures, []string{}, "Build tags of the feature modules to include in the app (e.g. --features experimental)")
+	return fs
+}
+
+func flagGetFeatures(cmd *cobra.Command) []string {
+	features, _ := cmd.Flags().GetStringSlice(flagFeatures)
+	return features
+}
+
 func newChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*chain.Chain, error) {
 	// Check if custom home is provided
 	if home := getHome(cmd); home != "" {
 		chainOption = append(chainOption, chain.HomePath(home))
 	}
 
+	// Check if feature modules must be included
+	if features := flagGetFeatures(cmd); len(features) > 0 {
+		chainOption = append(chainOption, chain.BuildTags(features...))
+	}
+
 	appPath := flagGetPath(cmd)
 	absPath, err := filepath.Abs(appPath)
 	if err != nil {
@@ -270,7 +300,7 @@ func newApp(appPath string) (scaffolder.Scaffolder, error) {
 	if sc.Version.LT(cosmosver.StargateFortyFourVersion) {
 		return sc, fmt.Errorf(
 			`⚠️ Your chain has been scaffolded with an old version of Cosmos SDK: %[1]v.
-Please, follow the migration guide to upgrade your chain to the latest version:
+Please, migrate your chain with "ignite chain upgrade-scaffold" or follow the migration guide to upgrade your chain to the latest version:
 
 https://docs.ignite.com/migration`, sc.Version.String(),
 		)
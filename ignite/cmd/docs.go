@@ -1,29 +1,131 @@
 package ignitecmd
 
 import (
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/docs"
-	"github.com/ignite/cli/ignite/pkg/localfs"
-	"github.com/ignite/cli/ignite/pkg/markdownviewer"
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/docserver"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
 )
 
+const (
+	flagAddress = "address"
+	flagExplain = "explain"
+
+	defaultDocsAddress = "localhost:7575"
+
+	// docsPrefix is the path of the Ignite CLI docs on the docs server.
+	docsPrefix = "ignite"
+
+	// appDocsPrefix is the path of the docs of the app on the docs server.
+	appDocsPrefix = "app"
+)
+
+// NewDocs creates a new docs command to browse the docs of Ignite CLI and of the app.
 func NewDocs() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "docs",
-		Short: "Show Ignite CLI docs",
-		Args:  cobra.NoArgs,
-		RunE:  docsHandler,
+		Short: "Browse Ignite CLI docs",
+		Long: `Browse Ignite CLI docs, and the ones of your app, on a local website with a full-text search.
+
+The docs of the app are its markdown files, such as its readme and the READMEs of its modules. They
+are served when the command runs in the directory of an app or with the --path flag.
+
+Errors of Ignite CLI print a code linking them to the page of the docs explaining them. Open the page
+with the --explain flag:
+
+  ignite docs --explain IGN0001
+`,
+		Args: cobra.NoArgs,
+		RunE: docsServeHandler,
 	}
+
+	flagSetPath(c)
+	c.PersistentFlags().String(flagAddress, defaultDocsAddress, "address of the docs server")
+	c.PersistentFlags().String(flagExplain, "", "explain an error code with its page of the docs, e.g. IGN0001")
+
+	c.AddCommand(NewDocsServe())
+
 	return c
 }
 
-func docsHandler(cmd *cobra.Command, args []string) error {
-	path, cleanup, err := localfs.SaveTemp(docs.Docs)
+// NewDocsServe creates a new command to serve the docs locally.
+func NewDocsServe() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Serve Ignite CLI docs and the ones of your app locally",
+		Args:  cobra.NoArgs,
+		RunE:  docsServeHandler,
+	}
+}
+
+func docsServeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		appPath    = flagGetPath(cmd)
+		address, _ = cmd.Flags().GetString(flagAddress)
+		code, _    = cmd.Flags().GetString(flagExplain)
+	)
+
+	var exp explanation
+	if code != "" {
+		var ok bool
+		if exp, ok = findExplanation(code); !ok {
+			return fmt.Errorf("unknown error code %s", code)
+		}
+	}
+
+	sources, err := docsSources(appPath)
+	if err != nil {
+		return err
+	}
+	server, err := docserver.New(sources...)
 	if err != nil {
 		return err
 	}
-	defer cleanup()
 
-	return markdownviewer.View(path)
+	url := "http://" + address
+	fmt.Printf("🌍 Docs: %s\n", url)
+	if code != "" {
+		fmt.Printf("\n%s: %s\n📖 %s/%s\n", exp.code, exp.summary, url, exp.link())
+	}
+
+	return xhttp.Serve(cmd.Context(), &http.Server{
+		Addr:    address,
+		Handler: server,
+	})
+}
+
+// docsSources returns the docs of Ignite CLI and the ones of the app at appPath when there is one.
+func docsSources(appPath string) ([]docserver.Source, error) {
+	ignite, err := fs.Sub(docs.Docs, "docs")
+	if err != nil {
+		return nil, err
+	}
+	sources := []docserver.Source{
+		{Name: "Ignite CLI", Prefix: docsPrefix, FS: ignite},
+	}
+
+	if _, err := chainconfig.LocateDefault(appPath); err == chainconfig.ErrCouldntLocateConfig {
+		return sources, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	absPath, err := filepath.Abs(appPath)
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, docserver.Source{
+		Name:   filepath.Base(absPath),
+		Prefix: appDocsPrefix,
+		FS:     os.DirFS(absPath),
+	})
+	return sources, nil
 }
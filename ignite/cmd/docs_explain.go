@@ -0,0 +1,124 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// explanation links a class of errors to the page of the docs explaining them.
+type explanation struct {
+	code    string
+	summary string
+
+	// page is the path of the page in the Ignite CLI docs and anchor the heading of its section.
+	page   string
+	anchor string
+
+	match func(err error) bool
+}
+
+// link returns the path of the page of the explanation on the docs server.
+func (e explanation) link() string {
+	link := docsPrefix + "/" + e.page
+	if e.anchor != "" {
+		link += "#" + e.anchor
+	}
+	return link
+}
+
+// explanations are the errors explained by the docs, their codes must never change since they're
+// referenced by the users.
+var explanations = []explanation{
+	{
+		code:    "IGN0001",
+		summary: "the config.yml of the app is not valid",
+		page:    "kb/03-config",
+		match: func(err error) bool {
+			var validationErr *chainconfig.ValidationError
+			return errors.As(err, &validationErr)
+		},
+	},
+	{
+		code:    "IGN0002",
+		summary: "the config.yml of the app is not found",
+		page:    "kb/03-config",
+		match: func(err error) bool {
+			return errors.Is(err, chainconfig.ErrCouldntLocateConfig)
+		},
+	},
+	{
+		code:    "IGN0003",
+		summary: "the faucet is not configured",
+		page:    "kb/03-config",
+		anchor:  "faucet",
+		match: func(err error) bool {
+			return errors.Is(err, chain.ErrFaucetIsNotEnabled) || errors.Is(err, chain.ErrFaucetAccountDoesNotExist)
+		},
+	},
+	{
+		code:    "IGN0004",
+		summary: "the app cannot be built",
+		page:    "kb/03-config",
+		anchor:  "build",
+		match: func(err error) bool {
+			var buildErr *chain.CannotBuildAppError
+			return errors.As(err, &buildErr)
+		},
+	},
+	{
+		code:    "IGN0005",
+		summary: "the blockchain panicked",
+		page:    "kb/02-serve",
+		anchor:  "panics-of-the-blockchain",
+		match: func(err error) bool {
+			var startErr *chain.CannotStartAppError
+			if !errors.As(err, &startErr) {
+				return false
+			}
+			_, ok := startErr.ParsePanic()
+			return ok
+		},
+	},
+	{
+		code:    "IGN0006",
+		summary: "the chain doesn't support fast-forwarding the block time",
+		page:    "kb/02-serve",
+		anchor:  "fast-forward-the-block-time",
+		match: func(err error) bool {
+			return errors.Is(err, chain.ErrTimeTravelNotSupported)
+		},
+	},
+	{
+		code:    "IGN0007",
+		summary: "the snapshot of the chain is missing or already exists",
+		page:    "kb/17-state-snapshots",
+		anchor:  "snapshots-of-the-chain",
+		match: func(err error) bool {
+			return errors.Is(err, chain.ErrSnapshotNotFound) || errors.Is(err, chain.ErrSnapshotExists)
+		},
+	},
+}
+
+func findExplanation(code string) (explanation, bool) {
+	for _, exp := range explanations {
+		if strings.EqualFold(exp.code, code) {
+			return exp, true
+		}
+	}
+	return explanation{}, false
+}
+
+// ExplainError returns a hint to open the page of the docs explaining the error, it's empty when
+// the docs don't explain the error.
+func ExplainError(err error) string {
+	for _, exp := range explanations {
+		if exp.match(err) {
+			return fmt.Sprintf("💡 %s: learn more with ignite docs --explain %s", exp.code, exp.code)
+		}
+	}
+	return ""
+}
@@ -1,6 +1,17 @@
 package ignitecmd
 
-import "github.com/spf13/cobra"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagCheck = "check"
 
 // NewGenerate returns a command that groups code generation related sub commands.
 func NewGenerate() *cobra.Command {
@@ -11,17 +22,76 @@ func NewGenerate() *cobra.Command {
 
 Such as compiling protocol buffer files into Go or implement particular functionality, for example, generating an OpenAPI spec.
 
-Produced source code can be regenerated by running a command again and is not meant to be edited by hand.`,
+Produced source code can be regenerated by running a command again and is not meant to be edited by hand.
+
+Use the --check flag, e.g. in CI, to fail when the regeneration would change the generated code, without changing it.`,
 		Aliases: []string{"g"},
 		Args:    cobra.ExactArgs(1),
 	}
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	c.PersistentFlags().Bool(flagCheck, false, "Fail if the regeneration changes the generated code, without changing it")
 	c.AddCommand(addGitChangesVerifier(NewGenerateGo()))
 	c.AddCommand(addGitChangesVerifier(NewGenerateVuex()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateReact()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateComponents()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateE2E()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateAdmin()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateWalletConfig()))
 	c.AddCommand(addGitChangesVerifier(NewGenerateDart()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateGoClient()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateORM()))
 	c.AddCommand(addGitChangesVerifier(NewGenerateOpenAPI()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateDocs()))
 
 	return c
 }
+
+func flagGetCheck(cmd *cobra.Command) (check bool) {
+	check, _ = cmd.Flags().GetBool(flagCheck)
+	return
+}
+
+// generate generates the code of target and prints done, or only checks that the generated
+// code is up to date when the check flag is set.
+func generate(
+	cmd *cobra.Command,
+	s *clispinner.Spinner,
+	c *chain.Chain,
+	cacheStorage cache.Storage,
+	target chain.GenerateTarget,
+	done string,
+) error {
+	if !flagGetCheck(cmd) {
+		if err := c.Generate(cmd.Context(), cacheStorage, target); err != nil {
+			return err
+		}
+
+		s.Stop()
+		fmt.Println(done)
+
+		return nil
+	}
+
+	s.SetText("Checking...")
+
+	changes, err := c.CheckGenerated(cmd.Context(), target)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(changes) == 0 {
+		fmt.Println("✅ Generated code is up to date.")
+		return nil
+	}
+
+	fmt.Println("The regeneration changes the following files:")
+	for _, path := range changes {
+		fmt.Printf("  %s\n", path)
+	}
+
+	return errors.New("generated code is not up to date, run the command without --check to regenerate it")
+}
@@ -0,0 +1,66 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagDevKeys = "dev-keys"
+
+const devKeysWarning = `⚠️ The private keys of the accounts of config.yml are written to vue/admin/accounts.ts.
+Never use these accounts outside of development.
+`
+
+func NewGenerateAdmin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "admin",
+		Short: "Generate a dev admin page to change the params of the modules with gov proposals",
+		Long: `Generate an admin page in the vue/admin directory, served by the dev server of the Vue app at
+/admin/ and left out of its production build.
+
+The page displays the params of the modules, submits parameter change proposals prefilled with the
+current values of the params and votes on the proposals with the accounts of config.yml.
+
+The txs are signed with the accounts imported in the page, their mnemonics are kept in the memory
+of the page only. Use --dev-keys to sign with the accounts of config.yml instead: their private keys
+are read from the keyring of the chain, once it's initialized, and written to vue/admin/accounts.ts
+which is ignored by git. Use the page in development only.
+
+The Vuex stores are generated along with the page.`,
+		RunE: generateAdminHandler,
+	}
+	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Bool(flagDevKeys, false, "Write the private keys of the accounts of config.yml to the page, for development only")
+	return c
+}
+
+func generateAdminHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	target := chain.GenerateAdmin()
+	if devKeys, _ := cmd.Flags().GetBool(flagDevKeys); devKeys {
+		s.Stop()
+		fmt.Print(devKeysWarning)
+		s.Start()
+
+		target = chain.GenerateAdminWithDevKeys()
+	}
+
+	return generate(cmd, s, c, cacheStorage, target, "⛏️  Generated the admin page.")
+}
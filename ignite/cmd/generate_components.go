@@ -0,0 +1,48 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagFramework = "framework"
+
+func NewGenerateComponents() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "components",
+		Short: "Generate UI components for the types stored by your chain",
+		Long: `Generate a create form and a paginated table for each type stored by the modules of your chain.
+
+The components are generated for the types listed by a paginated query, such as the list and map
+types scaffolded with "ignite scaffold list" and "ignite scaffold map". The form validates the fields
+of the message creating the type and fills its creator with the address of the wallet.
+
+Vue components are bound to the generated Vuex stores, React components to the generated JS clients.
+The framework and the output path can be configured in the client.components section of config.yml.`,
+		RunE: generateComponentsHandler,
+	}
+	c.Flags().String(flagFramework, "", "Frontend framework of the components, vue or react (default from config.yml or vue)")
+	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
+	return c
+}
+
+func generateComponentsHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	framework, _ := cmd.Flags().GetString(flagFramework)
+
+	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	return generate(cmd, s, c, cacheStorage, chain.GenerateComponents(framework), "⛏️  Generated components.")
+}
@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
@@ -32,12 +30,5 @@ func generateDartHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateDart()); err != nil {
-		return err
-	}
-
-	s.Stop()
-	fmt.Println("⛏️  Generated Dart client.")
-
-	return nil
+	return generate(cmd, s, c, cacheStorage, chain.GenerateDart(), "⛏️  Generated Dart client.")
 }
@@ -0,0 +1,49 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagFormat = "format"
+
+func NewGenerateDocs() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "docs",
+		Short: "Generate the reference docs of the modules",
+		Long: `Generate a reference page of each module of your chain listing its messages, queries, events,
+params and keeper methods, and an index of the modules.
+
+The docs are generated from the comments of the proto files of the modules and of their keepers:
+a message or a query without comment is described by the comment of its handler. The events are
+the proto messages prefixed by Event and the EventType and AttributeKey constants of the module types.
+
+The docs generated before are replaced, the files written by hand in the same directory are kept.
+Set the client.docs section of config.yml to generate them again with the code on serve and build,
+so they don't drift from the modules.`,
+		RunE: generateDocsHandler,
+	}
+	c.Flags().String(flagFormat, "", "Format of the docs, markdown or html (default from config.yml or markdown)")
+	return c
+}
+
+func generateDocsHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	format, _ := cmd.Flags().GetString(flagFormat)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	return generate(cmd, s, c, cacheStorage, chain.GenerateDocs(format), "📖 Generated module docs.")
+}
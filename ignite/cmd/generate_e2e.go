@@ -0,0 +1,50 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateE2E() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "e2e",
+		Short: "Generate an end-to-end browser test suite for your Vue app",
+		Long: `Generate a Playwright test suite in the vue/e2e directory, testing the Vue components of the
+types stored by your chain from a browser.
+
+The suite serves the chain with "ignite chain serve --reset-once" and the Vue app with its dev server.
+For each type that can be created, such as the list and map types, a test opens a page mounting the
+generated components of the type, creates a wallet, funds it with the faucet, submits the create form
+and checks that the item appears in the list.
+
+The Vue components are generated along with the suite. The faucet must be enabled in config.yml.
+
+Run the suite from the vue/e2e directory:
+
+  npm install
+  npx playwright install
+  npm test`,
+		RunE: generateE2EHandler,
+	}
+	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
+	return c
+}
+
+func generateE2EHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	return generate(cmd, s, c, cacheStorage, chain.GenerateE2E(), "⛏️  Generated end-to-end tests.")
+}
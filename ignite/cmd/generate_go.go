@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
@@ -10,11 +8,19 @@ import (
 )
 
 func NewGenerateGo() *cobra.Command {
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:   "proto-go",
 		Short: "Generate proto based Go code needed for the app's source code",
-		RunE:  generateGoHandler,
+		Long: `Generate proto based Go code needed for the app's source code.
+
+Only the proto packages changed since their last generation are generated again: the cache of
+a package is keyed on the content of its proto files and of the proto files they import, and on
+the versions of the protoc plugins. A package is generated again as well when its generated
+files are changed or removed. Use --force to generate all the packages.`,
+		RunE: generateGoHandler,
 	}
+	c.Flags().BoolP(flagForce, "f", false, "Generate all the proto packages, bypassing the cache of the packages generated")
+	return c
 }
 
 func generateGoHandler(cmd *cobra.Command, args []string) error {
@@ -31,12 +37,10 @@ func generateGoHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateGo()); err != nil {
-		return err
+	target := chain.GenerateGo()
+	if force, _ := cmd.Flags().GetBool(flagForce); force {
+		target = chain.GenerateGoForce()
 	}
 
-	s.Stop()
-	fmt.Println("⛏️  Generated go code.")
-
-	return nil
+	return generate(cmd, s, c, cacheStorage, target, "⛏️  Generated go code.")
 }
@@ -0,0 +1,39 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateGoClient() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "go-client",
+		Short: "Generate a typed Go client package of the chain",
+		Long: `Generate a Go package wrapping cosmosclient with the queries and the messages of the
+modules of the chain, so backend services can integrate with the chain without importing the app.
+
+The package is generated in the "goclient" directory of the chain unless client.go.path is set in
+config.yml, it's then regenerated with the code of the chain when the proto files change.`,
+		RunE: generateGoClientHandler,
+	}
+	return c
+}
+
+func generateGoClientHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	return generate(cmd, s, c, cacheStorage, chain.GenerateGoClient(), "⛏️  Generated Go client.")
+}
@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
@@ -31,12 +29,5 @@ func generateOpenAPIHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateOpenAPI()); err != nil {
-		return err
-	}
-
-	s.Stop()
-	fmt.Println("⛏️  Generated OpenAPI spec.")
-
-	return nil
+	return generate(cmd, s, c, cacheStorage, chain.GenerateOpenAPI(), "⛏️  Generated OpenAPI spec.")
 }
@@ -0,0 +1,59 @@
+package ignitecmd
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagTemplates = "templates"
+
+func NewGenerateORM() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "orm",
+		Short: "Generate a typed data-access layer of the stored types",
+		Long: `Generate a data-access layer of the types scaffolded as lists and maps in the orm package of
+each module, e.g. x/blog/orm, so the business logic can be written against the repositories of the
+types and unit tested without setting up a keeper.
+
+The repository of a type gets, sets, removes and iterates its values by their id or index fields.
+New<Type>Store returns the repository backed by the store of the module, shared with the keeper,
+and NewMemory<Type>Repository the one backed by memory for the unit tests.
+
+The layer uses Go generics, "go 1.18" must be set in the go.mod of the chain.
+
+Use --templates to generate the layer from your own templates: the store.go.tpl and type.go.tpl
+files of the dir replace the built-in ones, the other .tpl files of the dir can define shared blocks.`,
+		RunE: generateORMHandler,
+	}
+	c.Flags().String(flagTemplates, "", "Directory of the templates replacing the built-in ones")
+	return c
+}
+
+func generateORMHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	templatesPath, _ := cmd.Flags().GetString(flagTemplates)
+	if templatesPath != "" {
+		var err error
+		if templatesPath, err = filepath.Abs(templatesPath); err != nil {
+			return err
+		}
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	return generate(cmd, s, c, cacheStorage, chain.GenerateORM(templatesPath), "⛏️  Generated data-access layer.")
+}
@@ -0,0 +1,41 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func NewGenerateReact() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "react",
+		Short: "Generate React Query hooks for your chain's frontend from your config.yml",
+		Long: `Generate React Query hooks for the queries and the messages of the modules of the chain,
+such as useQueryBalance and useTxSend, bound to the generated TypeScript clients.
+
+The hooks are generated in the "generated" directory of the path of the "client.react" target
+of config.yml, "react/src/hooks" by default. The ChainProvider of the generated context sets the
+addresses of the node and the wallet used by the hooks.`,
+		RunE: generateReactHandler,
+	}
+	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
+	return c
+}
+
+func generateReactHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	return generate(cmd, s, c, cacheStorage, chain.GenerateReact(), "⛏️  Generated React hooks.")
+}
@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
@@ -33,12 +31,5 @@ func generateVuexHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateVuex()); err != nil {
-		return err
-	}
-
-	s.Stop()
-	fmt.Println("⛏️  Generated vuex stores.")
-
-	return nil
+	return generate(cmd, s, c, cacheStorage, chain.GenerateVuex(), "⛏️  Generated vuex stores.")
 }
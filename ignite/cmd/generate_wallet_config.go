@@ -0,0 +1,115 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+)
+
+const (
+	flagRPC  = "rpc"
+	flagREST = "rest"
+)
+
+func NewGenerateWalletConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "wallet-config",
+		Short: "Generate the chain info suggested to Keplr and Leap and an \"Add to wallet\" helper",
+		Long: `Generate the chain info of your chain suggested to the Keplr and Leap browser wallets, and an
+"Add to wallet" helper in the vue/src/wallet directory of the Vue app.
+
+The chain info holds the chain id, the bech32 prefixes of the addresses, the coins of the chain and
+the gas prices paying the fees. The coins are the coins of the accounts of config.yml, and the coins
+of the genesis once the chain is initialized; the metadata of the coins registered in the genesis set
+their display units. The staking coin pays the fees, with gas prices above the minimum gas price of
+the node.
+
+The endpoints of the chain are the local servers of config.yml by default, set --rpc and --rest to
+use the public endpoints of a testnet.
+
+Import the chainInfo.json file in your own frontend, or the addToWallet function and the AddToWallet
+component in the Vue app.`,
+		RunE: generateWalletConfigHandler,
+	}
+	c.Flags().String(flagRPC, "", "Tendermint RPC endpoint of the chain, e.g. https://rpc.mychain.com")
+	c.Flags().String(flagREST, "", "REST API endpoint of the chain, e.g. https://api.mychain.com")
+	c.Flags().AddFlagSet(flagSetHome())
+	return c
+}
+
+func generateWalletConfigHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	var (
+		rpc, _  = cmd.Flags().GetString(flagRPC)
+		rest, _ = cmd.Flags().GetString(flagREST)
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	files, err := c.WalletFiles(rpc, rest)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	appPath := flagGetPath(cmd)
+
+	if !flagGetCheck(cmd) {
+		for _, path := range paths {
+			out := filepath.Join(appPath, path)
+			if err := os.MkdirAll(filepath.Dir(out), 0766); err != nil {
+				return err
+			}
+			if err := os.WriteFile(out, files[path], 0644); err != nil {
+				return err
+			}
+		}
+
+		s.Stop()
+		fmt.Println("⛏️  Generated the wallet config.")
+
+		return nil
+	}
+
+	var changes []string
+	for _, path := range paths {
+		content, err := os.ReadFile(filepath.Join(appPath, path))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !bytes.Equal(content, files[path]) {
+			changes = append(changes, path)
+		}
+	}
+
+	s.Stop()
+
+	if len(changes) == 0 {
+		fmt.Println("✅ Generated code is up to date.")
+		return nil
+	}
+
+	fmt.Println("The regeneration changes the following files:")
+	for _, path := range changes {
+		fmt.Printf("  %s\n", path)
+	}
+
+	return errors.New("generated code is not up to date, run the command without --check to regenerate it")
+}
@@ -8,13 +8,18 @@ import (
 
 	ignitecmd "github.com/ignite/cli/ignite/cmd"
 	"github.com/ignite/cli/ignite/pkg/clictx"
+	"github.com/ignite/cli/ignite/pkg/correlation"
 	"github.com/ignite/cli/ignite/pkg/validation"
 )
 
 func main() {
-	ctx := clictx.From(context.Background())
+	// the correlation ID of the invocation is shared with the services and the processes it runs.
+	ctx := correlation.FromEnv(clictx.From(context.Background()))
 
-	err := ignitecmd.New().ExecuteContext(ctx)
+	cmd := ignitecmd.New()
+	cleanup := ignitecmd.LinkPlugins(ctx, cmd)
+	err := cmd.ExecuteContext(ctx)
+	cleanup()
 
 	if ctx.Err() == context.Canceled || err == context.Canceled {
 		fmt.Println("aborted")
@@ -29,6 +34,9 @@ func main() {
 		} else {
 			fmt.Println(err)
 		}
+		if hint := ignitecmd.ExplainError(err); hint != "" {
+			fmt.Println(hint)
+		}
 
 		os.Exit(1)
 	}
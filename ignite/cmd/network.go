@@ -60,6 +60,7 @@ func NewNetwork() *cobra.Command {
 		NewNetworkRequest(),
 		NewNetworkReward(),
 		NewNetworkClient(),
+		NewNetworkNode(),
 	)
 
 	return c
@@ -126,7 +127,7 @@ func (n NetworkBuilder) Network(options ...network.Option) (network.Network, err
 		account = cosmosaccount.Account{}
 	)
 	if from != "" {
-		account, err = cosmos.AccountRegistry.GetByName(getFrom(n.cmd))
+		account, err = cosmos.Account(from)
 		if err != nil {
 			return network.Network{}, errors.Wrap(err, "make sure that this account exists, use 'ignite account -h' to manage accounts")
 		}
@@ -156,6 +157,7 @@ func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 		cosmosclient.WithAddressPrefix(networktypes.SPN),
 		cosmosclient.WithUseFaucet(spnFaucetAddress, networktypes.SPNDenom, 5),
 		cosmosclient.WithKeyringServiceName(cosmosaccount.KeyringServiceName),
+		cosmosclient.WithLedgerPrompt(printLedgerPrompt),
 	}
 
 	keyringBackend := getKeyringBackend(cmd)
@@ -169,6 +171,9 @@ func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 	if keyringBackend != "" {
 		cosmosOptions = append(cosmosOptions, cosmosclient.WithKeyringBackend(keyringBackend))
 	}
+	if signer := getRemoteSigner(cmd); signer != nil {
+		cosmosOptions = append(cosmosOptions, cosmosclient.WithSigner(signer))
+	}
 
 	// init cosmos client only once on start in order to spnclient to
 	// reuse unlocked keyring in the following steps.
@@ -180,7 +185,7 @@ func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 		cosmos = &client
 	}
 
-	if err := cosmos.AccountRegistry.EnsureDefaultAccount(); err != nil {
+	if err := cosmos.EnsureDefaultAccount(); err != nil {
 		return cosmosclient.Client{}, err
 	}
 
@@ -22,6 +22,10 @@ func NewNetworkChain() *cobra.Command {
 		NewNetworkChainShow(),
 		NewNetworkChainLaunch(),
 		NewNetworkChainRevertLaunch(),
+		NewNetworkChainSignArtifacts(),
+		NewNetworkChainVerifyArtifacts(),
+		NewNetworkChainStart(),
+		NewNetworkChainUpgrade(),
 	)
 
 	return c
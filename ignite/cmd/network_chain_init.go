@@ -68,7 +68,7 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 
 	// check if the provided account for the validator exists.
 	validatorAccount, _ := cmd.Flags().GetString(flagValidatorAccount)
-	if _, err = nb.AccountRegistry.GetByName(validatorAccount); err != nil {
+	if _, err = nb.cc.Account(validatorAccount); err != nil {
 		return err
 	}
 
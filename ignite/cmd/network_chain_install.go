@@ -2,12 +2,14 @@ package ignitecmd
 
 import (
 	"path/filepath"
+	"runtime"
 
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/goenv"
 	"github.com/ignite/cli/ignite/services/network"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
@@ -66,7 +68,7 @@ func networkChainInstallHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	binaryPath := filepath.Join(goenv.Bin(), binaryName)
+	binaryPath := filepath.Join(goenv.Bin(), gocmd.ExecutableName(binaryName, runtime.GOOS))
 
 	session.StopSpinner()
 	session.Printf("%s Binary installed\n", icons.OK)
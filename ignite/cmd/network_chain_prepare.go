@@ -2,16 +2,19 @@ package ignitecmd
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/goenv"
 	"github.com/ignite/cli/ignite/services/network"
 	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
 )
 
 const (
@@ -78,35 +81,7 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// fetch the information to construct genesis
-	genesisInformation, err := n.GenesisInformation(cmd.Context(), launchID)
-	if err != nil {
-		return err
-	}
-
-	rewardsInfo, lastBlockHeight, unboundingTime, err := n.RewardsInfo(
-		cmd.Context(),
-		launchID,
-		chainLaunch.ConsumerRevisionHeight,
-	)
-	if err != nil {
-		return err
-	}
-
-	spnChainID, err := n.ChainID(cmd.Context())
-	if err != nil {
-		return err
-	}
-
-	if err := c.Prepare(
-		cmd.Context(),
-		cacheStorage,
-		genesisInformation,
-		rewardsInfo,
-		spnChainID,
-		lastBlockHeight,
-		unboundingTime,
-	); err != nil {
+	if err := prepareChain(cmd, n, c, chainLaunch, cacheStorage); err != nil {
 		return err
 	}
 
@@ -128,3 +103,74 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// prepareGenesisInTempHome prepares the genesis of the chain in a temporary home and returns its path.
+// cleanup removes the temporary home once the genesis isn't used anymore.
+func prepareGenesisInTempHome(
+	cmd *cobra.Command,
+	n network.Network,
+	c *networkchain.Chain,
+	chainLaunch networktypes.ChainLaunch,
+	cacheStorage cache.Storage,
+) (genesisPath string, cleanup func(), err error) {
+	// create the chain in a temp dir
+	tmpHome, err := os.MkdirTemp("", "*-spn")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup = func() { os.RemoveAll(tmpHome) }
+
+	c.SetHome(tmpHome)
+
+	if err = prepareChain(cmd, n, c, chainLaunch, cacheStorage); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	// get the new genesis path
+	if genesisPath, err = c.GenesisPath(); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	return genesisPath, cleanup, nil
+}
+
+// prepareChain prepares the genesis of the chain from the launch information.
+func prepareChain(
+	cmd *cobra.Command,
+	n network.Network,
+	c *networkchain.Chain,
+	chainLaunch networktypes.ChainLaunch,
+	cacheStorage cache.Storage,
+) error {
+	spnChainID, err := n.ChainID(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	// fetch the information to construct genesis
+	genesisInformation, err := n.GenesisInformation(cmd.Context(), chainLaunch.ID)
+	if err != nil {
+		return err
+	}
+
+	rewardsInfo, lastBlockHeight, unboundingTime, err := n.RewardsInfo(
+		cmd.Context(),
+		chainLaunch.ID,
+		chainLaunch.ConsumerRevisionHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Prepare(
+		cmd.Context(),
+		cacheStorage,
+		genesisInformation,
+		rewardsInfo,
+		spnChainID,
+		lastBlockHeight,
+		unboundingTime,
+	)
+}
@@ -60,54 +60,14 @@ func networkChainShowGenesisHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	spnChainID, err := n.ChainID(cmd.Context())
-	if err != nil {
-		return err
-	}
-
 	// check if the genesis already exists
 	if _, err = os.Stat(genesisPath); os.IsNotExist(err) {
-		// fetch the information to construct genesis
-		genesisInformation, err := n.GenesisInformation(cmd.Context(), launchID)
-		if err != nil {
-			return err
-		}
-
-		// create the chain in a temp dir
-		tmpHome, err := os.MkdirTemp("", "*-spn")
-		if err != nil {
-			return err
-		}
-		defer os.RemoveAll(tmpHome)
-
-		c.SetHome(tmpHome)
-
-		rewardsInfo, lastBlockHeight, unboundingTime, err := n.RewardsInfo(
-			cmd.Context(),
-			launchID,
-			chainLaunch.ConsumerRevisionHeight,
-		)
-		if err != nil {
-			return err
-		}
-
-		if err = c.Prepare(
-			cmd.Context(),
-			cacheStorage,
-			genesisInformation,
-			rewardsInfo,
-			spnChainID,
-			lastBlockHeight,
-			unboundingTime,
-		); err != nil {
-			return err
-		}
-
-		// get the new genesis path
-		genesisPath, err = c.GenesisPath()
+		var cleanup func()
+		genesisPath, cleanup, err = prepareGenesisInTempHome(cmd, n, c, chainLaunch, cacheStorage)
 		if err != nil {
 			return err
 		}
+		defer cleanup()
 	}
 
 	if err := os.MkdirAll(filepath.Dir(out), 0744); err != nil {
@@ -0,0 +1,119 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+const (
+	flagManifest = "manifest"
+
+	defaultManifestPath = "./launch-manifest.json"
+)
+
+// NewNetworkChainSignArtifacts returns a new command to sign the launch artifacts of a chain.
+func NewNetworkChainSignArtifacts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sign-artifacts [launch-id]",
+		Short: "Sign the launch artifacts of the chain as its coordinator",
+		Long: `Sign the launch artifacts of the chain with the key of its coordinator.
+
+The genesis of the chain is prepared from the launch information, and its hash is
+signed along with the checksum of the chain binary and the list of the peers.
+Share the manifest with the validators so they can check their artifacts with
+"ignite network chain verify-artifacts" before joining the launch.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainSignArtifactsHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().String(flagOut, defaultManifestPath, "Path to output the signed manifest")
+	c.Flags().BoolP(flagForce, "f", false, "Sign the artifacts even if the chain launch is not triggered")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainSignArtifactsHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		out, _   = cmd.Flags().GetString(flagOut)
+		force, _ = cmd.Flags().GetBool(flagForce)
+	)
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	// the genesis time is only known once the launch is triggered
+	if !force && !chainLaunch.LaunchTriggered {
+		return fmt.Errorf("chain %d launch has not been triggered yet. use --force to sign anyway", launchID)
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	genesisPath, cleanup, err := prepareGenesisInTempHome(cmd, n, c, chainLaunch, cacheStorage)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	binaryName, err := c.BinaryName()
+	if err != nil {
+		return err
+	}
+
+	manifest, err := n.LaunchManifest(cmd.Context(), chainLaunch, genesisPath, binaryName)
+	if err != nil {
+		return err
+	}
+
+	signed, err := n.SignLaunchManifest(cmd.Context(), manifest)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(signed, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0744); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, data, 0644); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Launch manifest signed: %s\n", icons.OK, out)
+}
@@ -0,0 +1,221 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	flagDaemon       = "daemon"
+	flagRetries      = "retries"
+	flagRetryDelay   = "retry-delay"
+	flagAlertWebhook = "alert-webhook"
+
+	launchPollInterval  = 30 * time.Second
+	alertWebhookTimeout = 10 * time.Second
+)
+
+// NewNetworkChainStart returns a new command to start the node of a chain at its launch time.
+func NewNetworkChainStart() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start [launch-id]",
+		Short: "Start the node of the chain at its launch time",
+		Long: `Prepare the genesis of the chain and start its node at the launch time.
+
+The command counts down until the launch time recorded on SPN and starts the node at T-0.
+When the node fails, it is restarted until the number of --retries is reached and each
+failure is posted to the --alert-webhook URL as a JSON payload.
+
+With --manifest, the launch artifacts are verified against the manifest signed by the
+coordinator of the chain before the node is started.
+
+With --daemon, the command waits for the launch to be triggered instead of failing when
+it isn't, it can be run in the background as soon as the chain is joined.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainStartHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().Bool(flagDaemon, false, "Wait for the launch to be triggered")
+	c.Flags().Int(flagRetries, 3, "Number of times the node is restarted after a failure")
+	c.Flags().Duration(flagRetryDelay, 10*time.Second, "Delay before restarting a failed node")
+	c.Flags().String(flagAlertWebhook, "", "URL the node failures are posted to")
+	c.Flags().String(flagManifest, "", "Path of the signed manifest to verify the launch artifacts with")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkChainStartHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		daemon, _       = cmd.Flags().GetBool(flagDaemon)
+		retries, _      = cmd.Flags().GetInt(flagRetries)
+		retryDelay, _   = cmd.Flags().GetDuration(flagRetryDelay)
+		alertWebhook, _ = cmd.Flags().GetString(flagAlertWebhook)
+		manifestPath, _ = cmd.Flags().GetString(flagManifest)
+	)
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	var signed networktypes.SignedLaunchManifest
+	if manifestPath != "" {
+		if signed, err = readSignedManifest(manifestPath); err != nil {
+			return err
+		}
+		if signed.Manifest.LaunchID != launchID {
+			return fmt.Errorf("manifest is signed for the chain %d instead of %d", signed.Manifest.LaunchID, launchID)
+		}
+		if err := n.VerifyLaunchManifest(cmd.Context(), signed); err != nil {
+			return err
+		}
+	}
+
+	chainLaunch, err := waitLaunchTriggered(cmd.Context(), n, session.EventBus(), launchID, daemon)
+	if err != nil {
+		return err
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	if err := prepareChain(cmd, n, c, chainLaunch, cacheStorage); err != nil {
+		return err
+	}
+
+	if manifestPath != "" {
+		genesisPath, err := c.GenesisPath()
+		if err != nil {
+			return err
+		}
+		if err := compareLaunchArtifacts(cmd, n, c, chainLaunch, genesisPath, signed); err != nil {
+			return err
+		}
+		session.EventBus().Send(events.New(events.StatusDone, "Launch artifacts match the signed manifest"))
+	}
+
+	onFailure := func(attempt int, err error) {
+		session.Printf("%s The node failed (attempt %d): %s\n", icons.NotOK, attempt, err)
+		if alertWebhook == "" {
+			return
+		}
+		alert := launchAlert{
+			LaunchID: launchID,
+			ChainID:  chainLaunch.ChainID,
+			Attempt:  attempt,
+			Error:    err.Error(),
+		}
+		if err := postLaunchAlert(cmd.Context(), alertWebhook, alert); err != nil {
+			session.Printf("%s Cannot post the alert to %s: %s\n", icons.NotOK, alertWebhook, err)
+		}
+	}
+
+	return c.Start(
+		cmd.Context(),
+		time.Unix(chainLaunch.LaunchTime, 0),
+		networkchain.StartRetries(retries, retryDelay),
+		networkchain.StartOnFailure(onFailure),
+	)
+}
+
+// waitLaunchTriggered returns the chain launch once its launch is triggered.
+// When wait is false, an error is returned if the launch isn't triggered yet.
+func waitLaunchTriggered(
+	ctx context.Context,
+	n network.Network,
+	ev events.Bus,
+	launchID uint64,
+	wait bool,
+) (networktypes.ChainLaunch, error) {
+	for {
+		chainLaunch, err := n.ChainLaunch(ctx, launchID)
+		if err != nil {
+			return networktypes.ChainLaunch{}, err
+		}
+		if chainLaunch.LaunchTriggered {
+			return chainLaunch, nil
+		}
+		if !wait {
+			return networktypes.ChainLaunch{}, fmt.Errorf(
+				"chain %d launch has not been triggered yet. use --%s to wait for it",
+				launchID,
+				flagDaemon,
+			)
+		}
+
+		ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Waiting for the launch of the chain %d to be triggered", launchID)))
+		select {
+		case <-ctx.Done():
+			return networktypes.ChainLaunch{}, ctx.Err()
+		case <-time.After(launchPollInterval):
+		}
+	}
+}
+
+// launchAlert is the payload posted to the alert webhook when the node fails.
+type launchAlert struct {
+	LaunchID uint64 `json:"launch_id"`
+	ChainID  string `json:"chain_id"`
+	Attempt  int    `json:"attempt"`
+	Error    string `json:"error"`
+}
+
+// postLaunchAlert posts the alert to the webhook URL.
+func postLaunchAlert(ctx context.Context, url string, alert launchAlert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, alertWebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewNetworkChainUpgrade creates a new upgrade command that holds the sub commands
+// coordinating a software upgrade of a launched chain.
+func NewNetworkChainUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Coordinate the upgrades of a launched chain",
+		Long: `Coordinate a software upgrade of a launched chain with its validators.
+
+The coordinator proposes the upgrade plan, published on Ignite Network. The
+validators signal they are ready for it, the coordinator collects their signals
+and checks the readiness of the chain. Then the validators prepare their nodes:
+
+- for an in place upgrade, the x/upgrade plan is written for the software upgrade
+  proposal of the chain, the nodes halt at the upgrade height once it passes.
+- when the chain is restarted with a new chain id, the nodes halt at the upgrade
+  height and the validators migrate the genesis they export.`,
+		Args: cobra.NoArgs,
+	}
+
+	c.AddCommand(
+		NewNetworkChainUpgradePropose(),
+		NewNetworkChainUpgradeSignal(),
+		NewNetworkChainUpgradeStatus(),
+		NewNetworkChainUpgradePrepare(),
+		NewNetworkChainUpgradeMigrate(),
+	)
+
+	return c
+}
@@ -0,0 +1,70 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+// NewNetworkChainUpgradeMigrate creates a new command to migrate the genesis of a node halted for the upgrade of a chain.
+func NewNetworkChainUpgradeMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate [launch-id]",
+		Short: "Migrate the genesis of your node halted for the upgrade of the chain",
+		Long: `Export the genesis of your node halted at the upgrade height and migrate it to
+the new chain id of the upgrade plan.
+
+The hash of the migrated genesis is the same for all the validators, compare it
+with the coordinator before starting the new chain from it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainUpgradeMigrateHandler,
+	}
+
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkChainUpgradeMigrateHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+	if chainLaunch.Upgrade == nil {
+		return fmt.Errorf("no upgrade proposed for the chain %d", launchID)
+	}
+	plan := *chainLaunch.Upgrade
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	genesisPath, hash, err := c.MigrateGenesis(cmd.Context(), plan)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Genesis migrated to %s: %s\n", icons.OK, plan.NewChainID, genesisPath)
+
+	return session.Printf("Genesis hash: %s\n", hash)
+}
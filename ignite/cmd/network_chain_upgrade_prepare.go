@@ -0,0 +1,79 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+)
+
+// NewNetworkChainUpgradePrepare creates a new command to prepare the node of a validator for the upgrade of a chain.
+func NewNetworkChainUpgradePrepare() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "prepare [launch-id]",
+		Short: "Prepare your node for the upgrade of the chain",
+		Long: `Prepare your node for the upgrade plan of the chain.
+
+The x/upgrade plan of the upgrade is written in the upgrade dir of the chain home,
+it's the content of the software upgrade proposal of an in place upgrade. When the
+chain is restarted with a new chain id, the node is configured to halt at the
+upgrade height, then migrate its genesis with "ignite network chain upgrade migrate".`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainUpgradePrepareHandler,
+	}
+
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkChainUpgradePrepareHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+	if chainLaunch.Upgrade == nil {
+		return fmt.Errorf("no upgrade proposed for the chain %d", launchID)
+	}
+	plan := *chainLaunch.Upgrade
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	upgradePath, err := c.PrepareUpgrade(plan)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Node prepared for the upgrade %s at height %d\n", icons.OK, plan.Name, plan.Height)
+
+	if plan.NewChainID == "" {
+		session.Println("\nThe plan of the software upgrade proposal is:")
+		return session.Printf("\t%s\n", colors.Info(filepath.Join(upgradePath, "plan.json")))
+	}
+
+	session.Println("\nOnce your node halts, migrate its genesis by running the following command:")
+	return session.Printf("\t%s\n", colors.Info(fmt.Sprintf("ignite network chain upgrade migrate %d", launchID)))
+}
@@ -0,0 +1,69 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	flagUpgradeName       = "name"
+	flagUpgradeHeight     = "height"
+	flagUpgradeInfo       = "info"
+	flagUpgradeNewChainID = "new-chain-id"
+)
+
+// NewNetworkChainUpgradePropose creates a new command to propose an upgrade plan for a launched chain.
+func NewNetworkChainUpgradePropose() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "propose [launch-id]",
+		Short: "Propose an upgrade plan for the chain as its coordinator",
+		Long: `Propose an upgrade plan for the launched chain as its coordinator, the plan
+replaces the one previously proposed.
+
+The name of the upgrade is the name of the upgrade handler of the new binary. Set
+--new-chain-id to restart the chain from its genesis exported at the upgrade
+height instead of upgrading it in place.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainUpgradeProposeHandler,
+	}
+
+	c.Flags().String(flagUpgradeName, "", "Name of the upgrade")
+	c.Flags().Int64(flagUpgradeHeight, 0, "Height the chain halts at for the upgrade")
+	c.Flags().String(flagUpgradeInfo, "", "Information about the new binary, e.g. its source and checksum")
+	c.Flags().String(flagUpgradeNewChainID, "", "Chain ID of the genesis migrated at the upgrade height")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainUpgradeProposeHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		name, _       = cmd.Flags().GetString(flagUpgradeName)
+		height, _     = cmd.Flags().GetInt64(flagUpgradeHeight)
+		info, _       = cmd.Flags().GetString(flagUpgradeInfo)
+		newChainID, _ = cmd.Flags().GetString(flagUpgradeNewChainID)
+	)
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	return n.ProposeUpgrade(cmd.Context(), networktypes.UpgradePlan{
+		LaunchID:   launchID,
+		Name:       name,
+		Height:     height,
+		Info:       info,
+		NewChainID: newChainID,
+	})
+}
@@ -0,0 +1,75 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewNetworkChainUpgradeSignal creates a new command to signal that a validator is ready for the upgrade of a chain.
+func NewNetworkChainUpgradeSignal() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "signal [launch-id]",
+		Short: "Signal you are ready for the upgrade of the chain as a validator",
+		Long: `Sign the upgrade plan of the chain with the key of your validator to signal
+you are ready for it. Share the signal with the coordinator of the chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainUpgradeSignalHandler,
+	}
+
+	c.Flags().String(flagOut, "", "Path to output the signal (default: ./upgrade-signal-[address].json)")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainUpgradeSignalHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	out, _ := cmd.Flags().GetString(flagOut)
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	plan, err := n.UpgradePlan(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	signal, err := n.SignalUpgrade(cmd.Context(), plan)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		out = fmt.Sprintf("upgrade-signal-%s.json", signal.Signer)
+	}
+	data, err := json.MarshalIndent(signal, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0744); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, data, 0644); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Ready for the upgrade %s, share the signal with the coordinator: %s\n", icons.OK, plan.Name, out)
+}
@@ -0,0 +1,130 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const flagUpgradeSignals = "signals"
+
+var upgradeStatusHeader = []string{"Validator", "Status"}
+
+// NewNetworkChainUpgradeStatus creates a new command to show the readiness of the validators for the upgrade of a chain.
+func NewNetworkChainUpgradeStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [launch-id]",
+		Short: "Show the upgrade plan of the chain and the validators ready for it",
+		Long: `Show the upgrade plan of the chain and the validators ready for it from the
+signals collected in the --signals dir. The signals of the accounts that aren't
+validators of the chain or signing another plan are ignored.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainUpgradeStatusHandler,
+	}
+
+	c.Flags().String(flagUpgradeSignals, ".", "Dir of the upgrade signals of the validators")
+	c.Flags().AddFlagSet(flagSetSPNAccountPrefixes())
+
+	return c
+}
+
+func networkChainUpgradeStatusHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		signalsDir, _ = cmd.Flags().GetString(flagUpgradeSignals)
+		addressPrefix = getAddressPrefix(cmd)
+	)
+
+	signals, err := readUpgradeSignals(signalsDir)
+	if err != nil {
+		return err
+	}
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	readiness, err := n.UpgradeReadiness(cmd.Context(), launchID, signals)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	plan := readiness.Plan
+	session.Printf("Upgrade %s at height %d\n", plan.Name, plan.Height)
+	if plan.Info != "" {
+		session.Printf("Info: %s\n", plan.Info)
+	}
+	if plan.NewChainID != "" {
+		session.Printf("New chain ID: %s\n", plan.NewChainID)
+	}
+	session.Println()
+
+	var entries [][]string
+	addEntries := func(addresses []string, status string) error {
+		for _, address := range addresses {
+			address, err := cosmosutil.ChangeAddressPrefix(address, addressPrefix)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, []string{address, status})
+		}
+		return nil
+	}
+	if err := addEntries(readiness.Ready, "ready"); err != nil {
+		return err
+	}
+	if err := addEntries(readiness.Pending, "pending"); err != nil {
+		return err
+	}
+	if err := session.PrintTable(upgradeStatusHeader, entries...); err != nil {
+		return err
+	}
+
+	for _, invalid := range readiness.Invalid {
+		session.Printf("%s Ignored signal %s\n", icons.NotOK, invalid)
+	}
+
+	return session.Printf("\n%d/%d validators ready, %s/%s of the self delegations\n",
+		len(readiness.Ready), len(readiness.Ready)+len(readiness.Pending),
+		readiness.ReadyPower, readiness.TotalPower,
+	)
+}
+
+// readUpgradeSignals reads the upgrade signals in the JSON files of dir.
+func readUpgradeSignals(dir string) ([]networktypes.UpgradeSignal, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var signals []networktypes.UpgradeSignal
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var signal networktypes.UpgradeSignal
+		// the other JSON files of the dir aren't signals.
+		if err := json.Unmarshal(data, &signal); err != nil || signal.Signer == "" {
+			continue
+		}
+		signals = append(signals, signal)
+	}
+	return signals, nil
+}
@@ -0,0 +1,134 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/network"
+	"github.com/ignite/cli/ignite/services/network/networkchain"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// NewNetworkChainVerifyArtifacts returns a new command to verify the launch artifacts of a chain.
+func NewNetworkChainVerifyArtifacts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "verify-artifacts [launch-id]",
+		Short: "Verify the launch artifacts of the chain against the manifest signed by its coordinator",
+		Long: `Verify the launch artifacts of the chain before joining its launch.
+
+The manifest signature is checked against the key of the chain coordinator, then
+the genesis is prepared locally and its hash, the checksum of the chain binary and
+the list of the peers are compared with the signed ones.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainVerifyArtifactsHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().String(flagManifest, defaultManifestPath, "Path of the signed manifest")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainVerifyArtifactsHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	manifestPath, _ := cmd.Flags().GetString(flagManifest)
+
+	signed, err := readSignedManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	if signed.Manifest.LaunchID != launchID {
+		return fmt.Errorf("manifest is signed for the chain %d instead of %d", signed.Manifest.LaunchID, launchID)
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	if err := n.VerifyLaunchManifest(cmd.Context(), signed); err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	genesisPath, cleanup, err := prepareGenesisInTempHome(cmd, n, c, chainLaunch, cacheStorage)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if err := compareLaunchArtifacts(cmd, n, c, chainLaunch, genesisPath, signed); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Launch artifacts match the manifest signed by %s\n", icons.OK, signed.Signer)
+}
+
+// readSignedManifest reads the signed launch manifest at path.
+func readSignedManifest(path string) (networktypes.SignedLaunchManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return networktypes.SignedLaunchManifest{}, err
+	}
+	var signed networktypes.SignedLaunchManifest
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return networktypes.SignedLaunchManifest{}, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	return signed, nil
+}
+
+// compareLaunchArtifacts returns an error if the genesis at genesisPath, the chain binary
+// or the peers don't match the signed manifest.
+func compareLaunchArtifacts(
+	cmd *cobra.Command,
+	n network.Network,
+	c *networkchain.Chain,
+	chainLaunch networktypes.ChainLaunch,
+	genesisPath string,
+	signed networktypes.SignedLaunchManifest,
+) error {
+	binaryName, err := c.BinaryName()
+	if err != nil {
+		return err
+	}
+
+	manifest, err := n.LaunchManifest(cmd.Context(), chainLaunch, genesisPath, binaryName)
+	if err != nil {
+		return err
+	}
+
+	if diffs := signed.Manifest.Compare(manifest); len(diffs) > 0 {
+		return fmt.Errorf("launch artifacts don't match the signed manifest:\n\n  %s", strings.Join(diffs, "\n  "))
+	}
+	return nil
+}
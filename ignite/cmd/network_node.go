@@ -0,0 +1,20 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewNetworkNode creates a new node command that holds some other sub commands
+// related to running the nodes of a launched chain.
+func NewNetworkNode() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "node",
+		Short: "Run the nodes of launched chains",
+	}
+
+	c.AddCommand(
+		NewNetworkNodeMonitor(),
+	)
+
+	return c
+}
@@ -0,0 +1,159 @@
+package ignitecmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/services/network"
+)
+
+const (
+	flagInterval        = "interval"
+	flagMaxMissedBlocks = "max-missed-blocks"
+	flagMinPeers        = "min-peers"
+	flagWebhook         = "webhook"
+	flagReferenceNode   = "reference-node"
+	flagMaxBlockGap     = "max-block-gap"
+)
+
+// NewNetworkNodeMonitor monitors the liveness of a validator of a launched chain
+func NewNetworkNodeMonitor() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "monitor [node-api-url] [validator-address]",
+		Short: "Monitor the liveness of a validator of a launched chain",
+		Long: `Monitor the liveness of a validator of a launched chain and raise alerts when the
+validator is out of the validator set, jailed, tombstoned, misses too many blocks or when
+the node has too few peers.
+
+The alerts are printed when an issue starts and when it's resolved. Use the --webhook flag
+to post them as JSON to a webhook as well:
+
+  ignite network node monitor http://localhost:26657 cosmosvaloper1... --webhook https://alerts.example.com
+
+Use the --reference-node flag to watch the chain as well with a second node of the chain:
+the alerts are raised when no block is produced for longer than --max-block-gap, when the
+validator set changes and when the app hashes of both nodes diverge at the same height.`,
+		Args: cobra.ExactArgs(2),
+		RunE: networkNodeMonitorHandler,
+	}
+	c.Flags().Duration(flagInterval, 30*time.Second, "Interval between two checks of the validator")
+	c.Flags().Int64(flagMaxMissedBlocks, 10, "Number of missed blocks in the signed blocks window raising an alert")
+	c.Flags().Int(flagMinPeers, 1, "Minimum number of peers of the node")
+	c.Flags().String(flagWebhook, "", "URL of a webhook receiving the alerts")
+	c.Flags().String(flagReferenceNode, "", "RPC address of a second node of the chain compared with the node to detect forks")
+	c.Flags().Duration(flagMaxBlockGap, time.Minute, "Time without a new block raising an alert, with --reference-node")
+	return c
+}
+
+func networkNodeMonitorHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		nodeAPI            = args[0]
+		valAddress         = args[1]
+		interval, _        = cmd.Flags().GetDuration(flagInterval)
+		maxMissedBlocks, _ = cmd.Flags().GetInt64(flagMaxMissedBlocks)
+		minPeers, _        = cmd.Flags().GetInt(flagMinPeers)
+		webhook, _         = cmd.Flags().GetString(flagWebhook)
+		referenceNode, _   = cmd.Flags().GetString(flagReferenceNode)
+		maxBlockGap, _     = cmd.Flags().GetDuration(flagMaxBlockGap)
+	)
+
+	nodeClient, err := cosmosclient.New(cmd.Context(), cosmosclient.WithNodeAddress(nodeAPI))
+	if err != nil {
+		return err
+	}
+	node, err := network.NewNodeClient(nodeClient)
+	if err != nil {
+		return err
+	}
+
+	liveness, err := node.ValidatorLiveness(cmd.Context(), valAddress)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Monitoring the validator %s at height %d\n", icons.Info, liveness.Moniker, liveness.Height)
+
+	channels := []network.AlertChannel{
+		network.AlertChannelFunc(func(_ context.Context, alert network.Alert) error {
+			if alert.Resolved {
+				return session.Printf("%s %s %s: %s\n", icons.OK, alert.Time.Format(time.RFC3339), alert.Kind, alert.Message)
+			}
+			return session.Printf("%s %s %s: %s\n", icons.NotOK, alert.Time.Format(time.RFC3339), alert.Kind, alert.Message)
+		}),
+	}
+	if webhook != "" {
+		channels = append(channels, network.WebhookAlertChannel{URL: webhook})
+	}
+
+	thresholds := network.MonitorThresholds{
+		MaxMissedBlocks: maxMissedBlocks,
+		MinPeers:        minPeers,
+	}
+
+	g, ctx := errgroup.WithContext(cmd.Context())
+	g.Go(func() error {
+		return node.MonitorValidator(ctx, valAddress, interval, thresholds, channels...)
+	})
+	if referenceNode != "" {
+		session.Printf("%s Comparing the node with the reference node %s\n", icons.Info, referenceNode)
+
+		alerts := make(chan cosmosclient.LivenessAlert)
+		g.Go(func() error {
+			return nodeClient.WatchLiveness(
+				ctx,
+				alerts,
+				cosmosclient.WithLivenessInterval(interval),
+				cosmosclient.WithMaxBlockGap(maxBlockGap),
+				cosmosclient.WithReferenceNode(referenceNode),
+			)
+		})
+		g.Go(func() error {
+			for {
+				select {
+				case alert := <-alerts:
+					if err := sendLivenessAlert(ctx, alert, valAddress, channels); err != nil {
+						return err
+					}
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		})
+	}
+
+	err = g.Wait()
+	if err == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+// sendLivenessAlert sends an alert on the liveness of the chain to the alert channels of the validator.
+func sendLivenessAlert(ctx context.Context, alert cosmosclient.LivenessAlert, valAddress string, channels []network.AlertChannel) error {
+	networkAlert := network.Alert{
+		Time:      alert.Time,
+		Validator: valAddress,
+		Height:    alert.Height,
+		Kind:      alert.Kind,
+		Message:   fmt.Sprintf("%s: %s", alert.Node, alert.Message),
+		Resolved:  alert.Resolved,
+	}
+	for _, channel := range channels {
+		if err := channel.Send(ctx, networkAlert); err != nil {
+			return errors.Wrapf(err, "cannot send the %s alert", alert.Kind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+const flagNode = "node"
+
+// NewNode creates a new node command that holds the sub commands inspecting a running node.
+func NewNode() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "node [command]",
+		Short: "Inspect a running node of a chain",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.PersistentFlags().AddFlagSet(flagSetNode())
+
+	c.AddCommand(NewNodeMempool())
+
+	return c
+}
+
+func flagSetNode() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagNode, "http://localhost:26657", "RPC address of the node")
+	return fs
+}
+
+// newNodeClient returns a client of the node set by the flags.
+func newNodeClient(cmd *cobra.Command, options ...cosmosclient.Option) (cosmosclient.Client, error) {
+	node, _ := cmd.Flags().GetString(flagNode)
+	return cosmosclient.New(cmd.Context(), append([]cosmosclient.Option{
+		cosmosclient.WithNodeAddress(node),
+	}, options...)...)
+}
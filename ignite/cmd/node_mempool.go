@@ -0,0 +1,273 @@
+package ignitecmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+const (
+	flagStuckAfter    = "stuck-after"
+	flagRebroadcast   = "rebroadcast"
+	flagFeeMultiplier = "fee-multiplier"
+)
+
+// mempoolDir is the directory within the Ignite config dir holding the first time the
+// unconfirmed txs of each chain were seen.
+const mempoolDir = "mempool"
+
+// NewNodeMempool creates a new command to inspect the unconfirmed txs of a node.
+func NewNodeMempool() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "mempool",
+		Short: "List the unconfirmed transactions of a node",
+		Long: `List the unconfirmed transactions of the mempool of a node with their messages,
+gas and fees.
+
+The messages of the standard modules are always decoded. Inside a chain project, the
+messages of its custom modules are decoded with the installed binary of the chain.
+
+The first time a transaction is seen is kept between the runs of the command. A
+transaction signed by one of your accounts is stuck when it stays unconfirmed longer
+than --stuck-after. Use --rebroadcast to sign the stuck transactions again with
+higher fees:
+
+	ignite node mempool --node http://localhost:26657 --rebroadcast --fee-multiplier 2
+
+The rebroadcasted transaction uses the same sequence as the stuck one, the node only
+accepts it once the stuck transaction is dropped from its mempool.`,
+		Args: cobra.NoArgs,
+		RunE: nodeMempoolHandler,
+	}
+
+	c.Flags().Int(flagLimit, 100, "Maximum number of transactions listed, the node returns at most 100 transactions")
+	c.Flags().Duration(flagStuckAfter, time.Minute, "Time after which an unconfirmed transaction of your accounts is stuck")
+	c.Flags().Bool(flagRebroadcast, false, "Rebroadcast the stuck transactions with higher fees")
+	c.Flags().Float64(flagFeeMultiplier, 1.5, "Multiplier of the fees of the rebroadcasted transactions")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringNamespace())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+
+	return c
+}
+
+func nodeMempoolHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		limit, _         = cmd.Flags().GetInt(flagLimit)
+		stuckAfter, _    = cmd.Flags().GetDuration(flagStuckAfter)
+		rebroadcast, _   = cmd.Flags().GetBool(flagRebroadcast)
+		feeMultiplier, _ = cmd.Flags().GetFloat64(flagFeeMultiplier)
+		addressPrefix    = getAddressPrefix(cmd)
+	)
+
+	session.StartSpinner("Fetching the unconfirmed transactions...")
+
+	// the stuck txs are signed by the accounts of the keyring of the commands.
+	registry, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := newNodeClient(cmd,
+		cosmosclient.WithAddressPrefix(addressPrefix),
+		cosmosclient.WithAccountRegistry(registry),
+	)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := client.Accounts()
+	if err != nil {
+		return err
+	}
+	accountNames := make(map[string]string)
+	for _, account := range accounts {
+		accountNames[account.Address(addressPrefix)] = account.Name
+	}
+
+	txs, total, err := client.UnconfirmedTXs(cmd.Context(), limit)
+	if err != nil {
+		return err
+	}
+
+	chainID := client.Context().ChainID
+	firstSeen, err := loadMempoolFirstSeen(chainID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	seen := make(map[string]time.Time)
+	for _, tx := range txs {
+		seen[tx.Hash] = now
+		if t, ok := firstSeen[tx.Hash]; ok {
+			seen[tx.Hash] = t
+		}
+	}
+	// the txs beyond the limit aren't listed, their first time seen is kept.
+	if len(txs) < total {
+		for hash, t := range firstSeen {
+			if _, ok := seen[hash]; !ok {
+				seen[hash] = t
+			}
+		}
+	}
+	if err := saveMempoolFirstSeen(chainID, seen); err != nil {
+		return err
+	}
+
+	decodeMessages := newProjectMessagesDecoder(cmd.Context())
+
+	session.StopSpinner()
+	session.Printf("%s %d unconfirmed transactions on %s, %d listed\n\n", icons.Info, total, chainID, len(txs))
+
+	var stuck []cosmosclient.UnconfirmedTX
+	for _, tx := range txs {
+		age := now.Sub(seen[tx.Hash]).Round(time.Second)
+
+		var signers []string
+		isStuck := false
+		for _, signer := range tx.Signers {
+			address, err := sdktypes.Bech32ifyAddressBytes(addressPrefix, signer.Address)
+			if err != nil {
+				return err
+			}
+			if address == "" {
+				address = "unknown"
+			}
+			if name, ok := accountNames[address]; ok {
+				address = fmt.Sprintf("%s (%s)", address, name)
+				isStuck = age >= stuckAfter
+			}
+			signers = append(signers, fmt.Sprintf("%s sequence %d", address, signer.Sequence))
+		}
+
+		status := icons.OK
+		if isStuck {
+			status = icons.NotOK
+			stuck = append(stuck, tx)
+		}
+		session.Printf("%s %s\n", status, tx.Hash)
+		session.Printf("   gas: %d, fee: %s, unconfirmed for %s\n", tx.Gas, tx.Fee, age)
+		session.Printf("   signers: %s\n", strings.Join(signers, ", "))
+		if tx.Memo != "" {
+			session.Printf("   memo: %s\n", tx.Memo)
+		}
+
+		projectMessages := decodeMessages(tx)
+		for i, typeURL := range tx.MessageTypes {
+			var message []byte
+			switch {
+			case tx.Messages[i] != nil:
+				message, _ = client.Context().Codec.MarshalJSON(tx.Messages[i])
+			case i < len(projectMessages):
+				message = projectMessages[i]
+			}
+			if message == nil {
+				session.Printf("   %s\n", typeURL)
+				continue
+			}
+			session.Printf("   %s %s\n", typeURL, message)
+		}
+		session.Println()
+	}
+
+	if len(stuck) == 0 {
+		return nil
+	}
+	if !rebroadcast {
+		return session.Printf("%s %d transactions of your accounts are stuck, use --%s to rebroadcast them with higher fees\n",
+			icons.NotOK, len(stuck), flagRebroadcast)
+	}
+
+	for _, tx := range stuck {
+		address, err := sdktypes.Bech32ifyAddressBytes(addressPrefix, tx.Signers[0].Address)
+		if err != nil {
+			return err
+		}
+		accountName := accountNames[address]
+		resp, err := client.RebroadcastTX(accountName, tx, feeMultiplier)
+		if err != nil {
+			session.Printf("%s Cannot rebroadcast %s: %s\n", icons.NotOK, tx.Hash, err)
+			continue
+		}
+		session.Printf("%s %s rebroadcasted as %s\n", icons.OK, tx.Hash, resp.TxHash)
+	}
+
+	return nil
+}
+
+// newProjectMessagesDecoder returns the function decoding the messages of a tx with the binary of
+// the chain project in the current directory, including the messages of its custom modules.
+// The messages aren't decoded outside of a project or when the binary isn't installed.
+func newProjectMessagesDecoder(ctx context.Context) func(cosmosclient.UnconfirmedTX) []json.RawMessage {
+	noDecode := func(cosmosclient.UnconfirmedTX) []json.RawMessage { return nil }
+
+	c, err := projectChain()
+	if err != nil || c == nil {
+		return noDecode
+	}
+	r, err := c.Commands(ctx)
+	if err != nil {
+		return noDecode
+	}
+
+	return func(tx cosmosclient.UnconfirmedTX) []json.RawMessage {
+		for _, msg := range tx.Messages {
+			if msg != nil {
+				continue
+			}
+			messages, err := r.DecodeTxMessages(ctx, tx.Raw)
+			if err != nil {
+				return nil
+			}
+			return messages
+		}
+		return nil
+	}
+}
+
+// mempoolFirstSeenPath returns the path of the file holding the first time the unconfirmed txs
+// of the chain were seen.
+func mempoolFirstSeenPath(chainID string) (string, error) {
+	confPath, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(confPath, mempoolDir, chainID+".yml"), nil
+}
+
+func loadMempoolFirstSeen(chainID string) (map[string]time.Time, error) {
+	path, err := mempoolFirstSeenPath(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSeen := make(map[string]time.Time)
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, path).Load(&firstSeen); err != nil {
+		return nil, err
+	}
+	return firstSeen, nil
+}
+
+func saveMempoolFirstSeen(chainID string, firstSeen map[string]time.Time) error {
+	path, err := mempoolFirstSeenPath(chainID)
+	if err != nil {
+		return err
+	}
+	return confile.New(confile.DefaultYAMLEncodingCreator, path).Save(firstSeen)
+}
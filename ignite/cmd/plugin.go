@@ -0,0 +1,137 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// LinkPlugins loads the plugins declared in the config of the app of the current dir, it adds their
+// commands to the root command c and runs their hooks around the scaffold and the serve commands.
+// The plugins failing to load are reported and skipped. cleanup ends the plugins.
+func LinkPlugins(ctx context.Context, c *cobra.Command) (cleanup func()) {
+	plugins := loadPlugins(ctx)
+
+	for _, p := range plugins {
+		for _, command := range p.Manifest.Commands {
+			if cmd, _, err := c.Find([]string{commandName(command.Use)}); err == nil && cmd != c {
+				printPluginWarning(fmt.Errorf("command %s of plugin %s already exists", commandName(command.Use), p.Manifest.Name))
+				continue
+			}
+			c.AddCommand(newPluginCommand(p, command))
+		}
+	}
+
+	if scaffold, _, err := c.Find([]string{"scaffold"}); err == nil && scaffold != c {
+		linkHooks(scaffold, plugins, plugin.HookPreScaffold, plugin.HookPostScaffold, false)
+	}
+	if serve, _, err := c.Find([]string{"chain", "serve"}); err == nil && serve != c {
+		linkHooks(serve, plugins, plugin.HookPreServe, plugin.HookPostServe, true)
+	}
+
+	return func() {
+		for _, p := range plugins {
+			p.Close()
+		}
+	}
+}
+
+func loadPlugins(ctx context.Context) (plugins []*plugin.Plugin) {
+	configPath, err := chainconfig.LocateDefault(".")
+	if errors.Is(err, chainconfig.ErrCouldntLocateConfig) {
+		return nil
+	}
+	if err != nil {
+		printPluginWarning(err)
+		return nil
+	}
+
+	conf, err := chainconfig.ParseFile(configPath)
+	if err != nil {
+		printPluginWarning(err)
+		return nil
+	}
+
+	appPath, err := filepath.Abs(filepath.Dir(configPath))
+	if err != nil {
+		printPluginWarning(err)
+		return nil
+	}
+
+	for _, pluginConf := range conf.Plugins {
+		p, err := plugin.Load(ctx, pluginConf, appPath)
+		if err != nil {
+			printPluginWarning(err)
+			continue
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins
+}
+
+func newPluginCommand(p *plugin.Plugin, command plugin.Command) *cobra.Command {
+	c := &cobra.Command{
+		Use:   command.Use,
+		Short: command.Short,
+		Long:  command.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := make(map[string]string)
+			for _, f := range command.Flags {
+				flags[f.Name], _ = cmd.Flags().GetString(f.Name)
+			}
+			return p.Execute(cmd.Context(), commandName(command.Use), args, flags)
+		},
+	}
+	for _, f := range command.Flags {
+		c.Flags().StringP(f.Name, f.Shorthand, f.Default, f.Usage)
+	}
+	return c
+}
+
+// linkHooks runs the pre and post hooks of the plugins around the commands of c and its sub commands.
+// The post hooks run only when the commands succeed, unless always is true.
+func linkHooks(c *cobra.Command, plugins []*plugin.Plugin, pre, post string, always bool) {
+	for _, sub := range c.Commands() {
+		linkHooks(sub, plugins, pre, post, always)
+	}
+	if c.RunE == nil {
+		return
+	}
+
+	run := c.RunE
+	c.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := plugin.RunHooks(cmd.Context(), plugins, pre, cmd.CommandPath(), args); err != nil {
+			return err
+		}
+
+		runErr := run(cmd, args)
+		if runErr != nil && !always {
+			return runErr
+		}
+
+		// the command may end with its context canceled, e.g. when the serve is interrupted.
+		if err := plugin.RunHooks(context.Background(), plugins, post, cmd.CommandPath(), args); err != nil && runErr == nil {
+			return err
+		}
+		return runErr
+	}
+}
+
+// commandName returns the name of a command from its usage.
+func commandName(use string) string {
+	name, _, _ := strings.Cut(use, " ")
+	return name
+}
+
+func printPluginWarning(err error) {
+	fmt.Fprintf(os.Stderr, "%s %s\n", icons.NotOK, err)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/relayer"
 )
 
 // NewRelayer returns a new relayer command.
@@ -18,6 +19,9 @@ func NewRelayer() *cobra.Command {
 	c.AddCommand(
 		NewRelayerConfigure(),
 		NewRelayerConnect(),
+		NewRelayerKeys(),
+		NewRelayerClearPackets(),
+		NewRelayerRecoverClient(),
 	)
 
 	return c
@@ -29,5 +33,11 @@ func handleRelayerAccountErr(err error) error {
 		return err
 	}
 
-	return errors.Wrap(accountErr, `make sure to create or import your account through "ignite account" commands`)
+	return errors.Wrap(accountErr, `make sure to create or import the relayer key through "ignite relayer keys add" command, the relayer does not use the accounts of "ignite account" commands`)
+}
+
+// newRelayerKeyring returns the registry of the relayer keys, isolated from the accounts of the
+// other commands.
+func newRelayerKeyring(cmd *cobra.Command) (cosmosaccount.Registry, error) {
+	return relayer.NewKeyring(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
 }
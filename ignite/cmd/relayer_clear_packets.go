@@ -0,0 +1,97 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+var relayerClearPacketsHeader = []string{"path", "chain", "client", "client status", "unrelayed packets", "unrelayed acks"}
+
+// NewRelayerClearPackets returns a new command to relay the packets stuck on the paths of the relayer.
+func NewRelayerClearPackets() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "clear-packets [<path>,...]",
+		Short: "Relay the packets and the acknowledgements stuck on the linked paths",
+		Long: `Relay the packets and the acknowledgements stuck on the linked paths, e.g. the packets sent
+while the relayer was stopped. The packets whose timeout is reached are timed out on their source
+chain instead, the tokens of a timed out transfer are refunded to the sender.
+
+All the linked paths are cleared when none is given.
+
+The packets of a path can't be relayed when the client of one of its chains is expired or frozen,
+recover the client with "ignite relayer recover-client".`,
+		RunE: relayerClearPacketsHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerClearPacketsHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	ca, err := newRelayerKeyring(cmd)
+	if err != nil {
+		return err
+	}
+
+	r := relayer.New(ca)
+
+	ids := args
+	if len(ids) == 0 {
+		paths, err := r.ListPaths(cmd.Context())
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if path.Src.ChannelID != "" {
+				ids = append(ids, path.ID)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		session.Println("No linked paths found to clear.")
+		return nil
+	}
+
+	session.StartSpinner("Clearing packets...")
+
+	statuses, err := r.ClearPackets(cmd.Context(), ids...)
+	if errors.Is(err, relayer.ErrClientNotActive) {
+		return fmt.Errorf(`%w, recover it with "ignite relayer recover-client"`, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var entries [][]string
+	for _, s := range statuses {
+		for _, end := range []relayer.PathEndStatus{s.Src, s.Dst} {
+			entries = append(entries, []string{
+				s.PathID,
+				end.ChainID,
+				end.ClientID,
+				string(end.ClientStatus),
+				strconv.Itoa(len(end.UnrelayedPackets)),
+				strconv.Itoa(len(end.UnrelayedAcks)),
+			})
+		}
+	}
+
+	return session.PrintTable(relayerClearPacketsHeader, entries...)
+}
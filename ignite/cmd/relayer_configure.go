@@ -95,9 +95,7 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 	session := cliui.New()
 	defer session.Cleanup()
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newRelayerKeyring(cmd)
 	if err != nil {
 		return err
 	}
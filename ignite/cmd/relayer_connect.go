@@ -8,7 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/relayer"
 )
 
@@ -23,6 +23,7 @@ func NewRelayerConnect() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagLogLevel, "", "Write the structured logs of the links and the relays of the paths to stderr as JSON from this level: debug, info or error")
 
 	return c
 }
@@ -35,9 +36,7 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 	session := cliui.New()
 	defer session.Cleanup()
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newRelayerKeyring(cmd)
 	if err != nil {
 		return err
 	}
@@ -46,10 +45,27 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	options := []relayer.RelayOption{
+		relayer.WithLowBalanceWarning(func(b relayer.KeyBalance) {
+			session.Printf(
+				"%s The balance of the relayer key %q (%s) on %q is low: %s, fund it with at least %s to keep relaying\n",
+				icons.NotOK, b.Key, b.Address, b.ChainID, b.GasBalance, b.Threshold,
+			)
+		}),
+	}
+
+	logger, err := getStructuredLogger(cmd)
+	if err != nil {
+		return err
+	}
+	if logger != nil {
+		options = append(options, relayer.WithLogger(logger))
+	}
+
 	var (
 		use []string
 		ids = args
-		r   = relayer.New(ca)
+		r   = relayer.New(ca, options...)
 	)
 
 	all, err := r.ListPaths(cmd.Context())
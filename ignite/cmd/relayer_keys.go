@@ -0,0 +1,24 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewRelayerKeys returns a new command to manage the relayer keys.
+func NewRelayerKeys() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "keys [command]",
+		Short: "Manage the keys used by the relayer to sign transactions",
+		Long: `Manage the keys used by the relayer to sign transactions.
+
+The relayer keys are stored in a dedicated keyring, isolated from the accounts of the "ignite
+account" commands, so the relayer never pays its fees with a coordinator or faucet account.
+Use a key per relayer, and fund it on each chain of its paths.`,
+	}
+
+	c.AddCommand(
+		NewRelayerKeysAdd(),
+		NewRelayerKeysRotate(),
+		NewRelayerKeysBalance(),
+	)
+
+	return c
+}
@@ -0,0 +1,85 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// NewRelayerKeysAdd returns a new command to add a relayer key.
+func NewRelayerKeysAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add [name]",
+		Short: "Create a relayer key or import it with a mnemonic or a private key",
+		Args:  cobra.ExactArgs(1),
+		RunE:  relayerKeysAddHandler,
+	}
+
+	c.Flags().String(flagSecret, "", "Mnemonic or path to the private key of the key to import")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountImportExport())
+
+	return c
+}
+
+func relayerKeysAddHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name      = args[0]
+		secret, _ = cmd.Flags().GetString(flagSecret)
+	)
+
+	ca, err := newRelayerKeyring(cmd)
+	if err != nil {
+		return err
+	}
+
+	if secret == "" {
+		_, mnemonic, err := ca.Create(name)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Relayer key %q created, keep your mnemonic in a secret place:\n\n%s\n", name, mnemonic)
+		return nil
+	}
+
+	// a mnemonic has several words, a path to a private key a single one.
+	if len(strings.Fields(secret)) > 1 {
+		mnemonic := cosmosaccount.NormalizeMnemonic(secret)
+		if err := cosmosaccount.ValidateMnemonic(mnemonic); err != nil {
+			return err
+		}
+		if _, err := ca.ImportMnemonic(name, mnemonic, "", sdktypes.GetConfig().GetCoinType()); err != nil {
+			return err
+		}
+
+		fmt.Printf("Relayer key %q imported.\n", name)
+		return nil
+	}
+
+	passphrase, err := getPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+
+	privKey, err := os.ReadFile(secret)
+	if os.IsNotExist(err) {
+		return errors.New("mnemonic is not valid or private key not found at path")
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := ca.Import(name, string(privKey), passphrase); err != nil {
+		return err
+	}
+
+	fmt.Printf("Relayer key %q imported.\n", name)
+	return nil
+}
@@ -0,0 +1,66 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+var relayerKeysBalanceHeader = []string{"chain", "key", "address", "balance", ""}
+
+// NewRelayerKeysBalance returns a new command to show the balances of the relayer keys.
+func NewRelayerKeysBalance() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "balance [name]",
+		Short: "Show the balances of the relayer keys on the chains of the relayer",
+		Long: `Show the balances of the relayer keys on the chains of the relayer.
+
+A balance is low when it can't pay the fees of the relayer for long, fund the key before it runs out.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: relayerKeysBalanceHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerKeysBalanceHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	ca, err := newRelayerKeyring(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Querying balances...")
+
+	balances, err := relayer.New(ca).KeyBalances(cmd.Context(), name)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	var entries [][]string
+	for _, b := range balances {
+		low := ""
+		if b.IsLow() {
+			low = "low"
+		}
+		entries = append(entries, []string{b.ChainID, b.Key, b.Address, b.Balances.String(), low})
+	}
+
+	return session.PrintTable(relayerKeysBalanceHeader, entries...)
+}
@@ -0,0 +1,59 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// NewRelayerKeysRotate returns a new command to rotate a relayer key.
+func NewRelayerKeysRotate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rotate [name]",
+		Short: "Replace a relayer key by a new key",
+		Long: `Replace a relayer key by a new key with the same name.
+
+The previous key is kept under a new name so its tokens can be withdrawn. Fund the new key on the
+chains of the relayer and restart the relayer to sign with it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerKeysRotateHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerKeysRotateHandler(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	ca, err := newRelayerKeyring(cmd)
+	if err != nil {
+		return err
+	}
+
+	key, mnemonic, previous, err := relayer.RotateKey(ca, name)
+	if err != nil {
+		return handleRelayerAccountErr(err)
+	}
+
+	fmt.Printf("Relayer key %q rotated, keep your mnemonic in a secret place:\n\n%s\n\n", name, mnemonic)
+	fmt.Printf("The previous key is kept as %q, withdraw its tokens once the new key is funded.\n", previous)
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	for _, chain := range conf.Chains {
+		if chain.Account != name {
+			continue
+		}
+		fmt.Printf("Fund %s on %q\n", key.Address(chain.AddressPrefix), chain.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package ignitecmd
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+const flagDeposit = "deposit"
+
+// NewRelayerRecoverClient returns a new command to recover an expired or frozen client of a chain of
+// the relayer.
+func NewRelayerRecoverClient() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "recover-client [chain-id] [client-id] [substitute-client-id]",
+		Short: "Submit a proposal to recover an expired or frozen client with a substitute client",
+		Long: `Submit a client update proposal on the chain, from the relayer key of the chain, to replace the
+state of the expired or frozen client by the one of an active substitute client tracking the same
+chain, e.g. the client of a new path connected between the chains.
+
+The client is recovered once the proposal passes, its paths are relayed again and their stuck
+packets can be cleared with "ignite relayer clear-packets".`,
+		Args: cobra.ExactArgs(3),
+		RunE: relayerRecoverClientHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagDeposit, "", "Initial deposit of the proposal, e.g. 10000000stake")
+
+	return c
+}
+
+func relayerRecoverClientHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	deposit, err := sdk.ParseCoinsNormalized(getDeposit(cmd))
+	if err != nil {
+		return err
+	}
+
+	ca, err := newRelayerKeyring(cmd)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Submitting the proposal...")
+
+	var (
+		chainID            = args[0]
+		clientID           = args[1]
+		substituteClientID = args[2]
+	)
+	res, err := relayer.New(ca).RecoverClient(cmd.Context(), chainID, clientID, substituteClientID, deposit)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf(
+		"%s Submitted the proposal recovering the client %s on %q in the tx %s, the client is recovered once it passes\n",
+		icons.OK, clientID, chainID, res.TxHash,
+	)
+}
+
+func getDeposit(cmd *cobra.Command) string {
+	deposit, _ := cmd.Flags().GetString(flagDeposit)
+	return deposit
+}
@@ -37,17 +37,29 @@ CRUD stands for "create, read, update, delete".`,
 
 	c.AddCommand(NewScaffoldChain())
 	c.AddCommand(addGitChangesVerifier(NewScaffoldModule()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldEscrow()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldFeeMarket()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldList()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldMap()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldSingle()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldFromStruct()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldType()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldTypeMigration()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldMessage()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldQuery()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldAggregate()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldTask()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldRateLimit()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldICQ()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldAppInfo()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldPacket()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldMiddleware()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldBandchain()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldVue()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldOpenAPI()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldFlutter()))
-	// c.AddCommand(NewScaffoldWasm())
+	c.AddCommand(addGitChangesVerifier(NewScaffoldDevcontainer()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldWasm()))
 
 	return c
 }
@@ -58,33 +70,15 @@ func scaffoldType(
 	kind scaffolder.AddTypeKind,
 ) error {
 	var (
-		typeName          = args[0]
-		fields            = args[1:]
-		moduleName        = flagGetModule(cmd)
-		withoutMessage    = flagGetNoMessage(cmd)
-		withoutSimulation = flagGetNoSimulation(cmd)
-		signer            = flagGetSigner(cmd)
-		appPath           = flagGetPath(cmd)
+		typeName = args[0]
+		fields   = args[1:]
+		appPath  = flagGetPath(cmd)
+		options  = scaffoldTypeOptions(cmd)
 	)
 
-	var options []scaffolder.AddTypeOption
-
 	if len(fields) > 0 {
 		options = append(options, scaffolder.TypeWithFields(fields...))
 	}
-	if moduleName != "" {
-		options = append(options, scaffolder.TypeWithModule(moduleName))
-	}
-	if withoutMessage {
-		options = append(options, scaffolder.TypeWithoutMessage())
-	} else {
-		if signer != "" {
-			options = append(options, scaffolder.TypeWithSigner(signer))
-		}
-		if withoutSimulation {
-			options = append(options, scaffolder.TypeWithoutSimulation())
-		}
-	}
 
 	s := clispinner.New().SetText("Scaffolding...")
 	defer s.Stop()
@@ -117,6 +111,33 @@ func scaffoldType(
 	return nil
 }
 
+// scaffoldTypeOptions returns the options of the scaffolded type set by the flags of the command.
+func scaffoldTypeOptions(cmd *cobra.Command) []scaffolder.AddTypeOption {
+	var (
+		moduleName        = flagGetModule(cmd)
+		withoutMessage    = flagGetNoMessage(cmd)
+		withoutSimulation = flagGetNoSimulation(cmd)
+		signer            = flagGetSigner(cmd)
+	)
+
+	var options []scaffolder.AddTypeOption
+
+	if moduleName != "" {
+		options = append(options, scaffolder.TypeWithModule(moduleName))
+	}
+	if withoutMessage {
+		options = append(options, scaffolder.TypeWithoutMessage())
+	} else {
+		if signer != "" {
+			options = append(options, scaffolder.TypeWithSigner(signer))
+		}
+		if withoutSimulation {
+			options = append(options, scaffolder.TypeWithoutSimulation())
+		}
+	}
+	return options
+}
+
 func addGitChangesVerifier(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().AddFlagSet(flagSetYes())
 
@@ -135,7 +156,8 @@ func addGitChangesVerifier(cmd *cobra.Command) *cobra.Command {
 			return err
 		}
 
-		if !getYes(cmd) && !changesCommitted {
+		// the check mode of the generate commands doesn't change the source code.
+		if !getYes(cmd) && !flagGetCheck(cmd) && !changesCommitted {
 			var confirmed bool
 			prompt := &survey.Confirm{
 				Message: "Your saved project changes have not been committed. To enable reverting to your current state, commit your saved changes. Do you want to proceed with scaffolding without committing your saved changes",
@@ -0,0 +1,87 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+// NewScaffoldAggregate command creates a new query aggregating the data of several modules
+func NewScaffoldAggregate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "aggregate [name] [module1] [module2] ...",
+		Short: "Query to get data joined from several modules",
+		Long: `Scaffold a query that aggregates the data of other modules of the app.
+
+The data is joined in the query server from the queries of the source modules, nothing is
+stored in the state of the module. The queries of each source module are defined in the
+expected keepers of the module and the keepers of the source modules are set in the app.
+
+The source modules are the modules registered in the app: the modules of the app and the
+bank, staking, mint, distribution and gov modules of the Cosmos SDK. The scaffolded query
+returns the list queries of the types of each module of the app, e.g. PostAll, and the
+total supply, the validators, the inflation, the community pool or the proposals of the
+SDK modules. It is exposed in the OpenAPI specification of the chain. Add the other
+queries of the source modules you need to the expected keepers to join more data.
+
+The source modules must be created before the module the query is added to, a module of
+the app must have a list or a map type.
+
+  ignite scaffold aggregate dashboard mars bank --module mercury`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: aggregateHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagModule, "", "Module to add the query into. Default: app's main module")
+	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
+
+	return c
+}
+
+func aggregateHandler(cmd *cobra.Command, args []string) error {
+	var (
+		appPath   = flagGetPath(cmd)
+		module, _ = cmd.Flags().GetString(flagModule)
+		desc, _   = cmd.Flags().GetString(flagDescription)
+	)
+	if desc == "" {
+		// Use a default description
+		desc = fmt.Sprintf("Query %s aggregated from %s", args[0], strings.Join(args[1:], ", "))
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddAggregate(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], desc, args[1:])
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created a query `%[1]v` aggregating %[2]v.\n\n", args[0], strings.Join(args[1:], ", "))
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+// NewScaffoldAppInfo returns the command to scaffold the query returning the information of the app
+func NewScaffoldAppInfo() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "app-info",
+		Short: "Query to get the metadata and the capabilities of the app",
+		Long: `Scaffold the app-info query returning in one response the information wallets and
+explorers need to integrate the chain:
+
+- the name and the version of the app, set at build time in the version package of the Cosmos SDK
+- the modules of the app and its IBC apps
+- the metadata of the denoms registered in the bank module
+
+The query is served at /app/info by the API, it's exposed in the OpenAPI specification
+and in the generated clients of the chain. An app can only have one app-info query.
+
+  ignite scaffold app-info --module mars`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldAppInfoHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagModule, "", "Module to add the query into. Default: app's main module")
+
+	return c
+}
+
+func scaffoldAppInfoHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module, _ = cmd.Flags().GetString(flagModule)
+		appPath   = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddAppInfo(cacheStorage, placeholder.New(), module)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Print("\n🎉 Created the app-info query served at /app/info.\n\n")
+
+	return nil
+}
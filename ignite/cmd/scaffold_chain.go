@@ -5,13 +5,18 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
 const (
 	flagNoDefaultModule = "no-module"
+	flagMinimal         = "minimal"
+	flagTemplate        = "template"
+	flagTemplateVar     = "template-var"
 )
 
 // NewScaffoldChain creates new command to scaffold a Comos-SDK based blockchain.
@@ -40,17 +45,43 @@ Account addresses on Cosmos SDK-based blockchains have string prefixes. For exam
 
   ignite scaffold chain foo --address-prefix bar
 
+The account addresses are encoded with bech32 by default. To encode them as hex, like EVM compatible chains, use the "--address-codec" flag. The codec is written to the "config.yml" of the blockchain, the scaffolded modules and the generated TypeScript client encode and decode the addresses with it:
+
+  ignite scaffold chain foo --address-codec hex
+
+To create only the shell of the blockchain, without the default module, the Vue.js app, the client config and the OpenAPI docs embedded in the app, use the "--minimal" flag. The pieces can be added back later with the "ignite scaffold module", "ignite scaffold vue" and "ignite scaffold openapi" commands:
+
+  ignite scaffold chain foo --minimal
+
+To create a blockchain inside an existing repository, like a monorepo, use the "--module" flag to set the Go module path of the blockchain instead of a name. The blockchain is created directly in the "--path" directory, which must be empty. If the directory is inside another Go module or a Go workspace, the blockchain is added to the workspace, and no git repository is initialized when the directory is already part of one:
+
+  ignite scaffold chain --path ./chains/foo --module github.com/org/mono/chains/foo
+
+To create a blockchain from a project template of a git repository instead of the built-in template, use the "--template" flag. A version of the template can be selected with a tag or a branch after "@". The template declares its variables in an "ignite-template.yml" manifest at the root of the repository, their values are set with the "--template-var" flag:
+
+  ignite scaffold chain foo --template github.com/org/template@v1.0.0 --template-var Denom=uorg
+
+By default the accounts and the faucet of the "config.yml" of the blockchain hold coins of the "token" denom. To use other denoms, with their metadata in the genesis of the bank module, use the "--denom" flag. A denom is a base denom, optionally followed by its display denom and the exponent of the base denom in the display denom:
+
+  ignite scaffold chain foo --denom ufoo:foo:6 --denom ubar
+
 By default when compiling a blockchain's source code Ignite creates a cache to speed up the build process. To clear the cache when building a blockchain use the "--clear-cache" flag. It is very unlikely you will ever need to use this flag.
 
 The blockchain is using the Cosmos SDK modular blockchain framework. Learn more about Cosmos SDK on https://docs.cosmos.network`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: scaffoldChainHandler,
 	}
 
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAddressCodec())
 	c.Flags().StringP(flagPath, "p", ".", "Create a project in a specific path")
 	c.Flags().Bool(flagNoDefaultModule, false, "Create a project without a default module")
+	c.Flags().Bool(flagMinimal, false, "Create only the app shell, without a default module, frontend and OpenAPI docs")
+	c.Flags().String(flagModule, "", "Go module path of the project, used instead of the name to create the project directly in --path")
+	c.Flags().String(flagTemplate, "", "Git repository of the project template, e.g. github.com/org/template@v1.0.0")
+	c.Flags().StringToString(flagTemplateVar, nil, "Values of the variables of the project template (e.g. Denom=uorg)")
+	c.Flags().StringSlice(flagDenom, nil, "Denoms of the chain with their display denom and exponent (e.g. utoken:token:6)")
 
 	return c
 }
@@ -60,18 +91,80 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 	defer s.Stop()
 
 	var (
-		name               = args[0]
 		addressPrefix      = getAddressPrefix(cmd)
 		appPath            = flagGetPath(cmd)
 		noDefaultModule, _ = cmd.Flags().GetBool(flagNoDefaultModule)
+		minimal, _         = cmd.Flags().GetBool(flagMinimal)
+		modulePath, _      = cmd.Flags().GetString(flagModule)
+		template, _        = cmd.Flags().GetString(flagTemplate)
+		templateVars, _    = cmd.Flags().GetStringToString(flagTemplateVar)
+		denomFlags, _      = cmd.Flags().GetStringSlice(flagDenom)
+		addressCodec, _    = cmd.Flags().GetString(flagAddressCodec)
+		name               string
+		initOptions        []scaffolder.InitOption
 	)
 
+	switch {
+	case len(args) == 1 && modulePath != "":
+		return fmt.Errorf("a name can't be used with --%s, the name of the project is the last element of the module path", flagModule)
+	case len(args) == 1:
+		name = args[0]
+	case modulePath != "":
+		initOptions = append(initOptions, scaffolder.InitWithModulePath(modulePath))
+	default:
+		return fmt.Errorf("a name or a --%s is required", flagModule)
+	}
+
+	switch {
+	case minimal && template != "":
+		return fmt.Errorf("--%s can't be used with --%s", flagMinimal, flagTemplate)
+	case minimal:
+		initOptions = append(initOptions, scaffolder.InitMinimal())
+	}
+
+	switch {
+	case template != "":
+		initOptions = append(initOptions, scaffolder.InitWithTemplate(template, templateVars))
+	case len(templateVars) > 0:
+		return fmt.Errorf("--%s requires a --%s", flagTemplateVar, flagTemplate)
+	}
+
+	if len(denomFlags) > 0 {
+		if template != "" {
+			return fmt.Errorf("--%s can't be used with --%s, the denoms are set by the template", flagDenom, flagTemplate)
+		}
+		var denoms []chainconfig.Denom
+		for _, flag := range denomFlags {
+			denom, err := chainconfig.ParseDenom(flag)
+			if err != nil {
+				return err
+			}
+			denoms = append(denoms, denom)
+		}
+		initOptions = append(initOptions, scaffolder.InitWithDenoms(denoms))
+	}
+
+	if _, err := cosmosaccount.NewAddressCodec(addressCodec, addressPrefix); err != nil {
+		return err
+	}
+	if addressCodec != cosmosaccount.AddressCodecBech32 {
+		initOptions = append(initOptions, scaffolder.InitWithAddressCodec(addressCodec))
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
 	}
 
-	appdir, err := scaffolder.Init(cacheStorage, placeholder.New(), appPath, name, addressPrefix, noDefaultModule)
+	appdir, err := scaffolder.Init(
+		cacheStorage,
+		placeholder.New(),
+		appPath,
+		name,
+		addressPrefix,
+		noDefaultModule,
+		initOptions...,
+	)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,64 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/version"
+)
+
+// NewScaffoldDevcontainer returns the command to scaffold the development environments of a chain
+func NewScaffoldDevcontainer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "devcontainer",
+		Short: "Dev container and Gitpod config for one-click development environments",
+		Long: `Scaffold a .devcontainer directory and a .gitpod.yml config to develop the chain in a reproducible
+environment, opened in one click from VS Code, GitHub Codespaces or Gitpod.
+
+The environments use the Go version of the go.mod of the chain and have Node.js and the current
+version of Ignite CLI installed. The ports of the Tendermint RPC, the API, gRPC and the faucet from
+config.yml are forwarded, as well as the port of the frontend when the chain has a Vue app.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldDevcontainerHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func scaffoldDevcontainerHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	var igniteVersion string
+	if version.IsRelease() {
+		igniteVersion = version.Version
+	}
+
+	sm, err := sc.AddDevcontainer(igniteVersion)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Development environments created.\n\n")
+
+	return nil
+}
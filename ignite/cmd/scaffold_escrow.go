@@ -0,0 +1,82 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const defaultEscrowModule = "escrow"
+
+// NewScaffoldEscrow returns the command to scaffold a module holding funds in escrows
+func NewScaffoldEscrow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "escrow [module]",
+		Short: "Module locking funds until they are released or refunded",
+		Long: `Scaffold a new module holding funds in escrows, as a reference for the modules holding value.
+
+The funds of an escrow are sent by its sender to the module account and locked until:
+
+- the sender releases them to the receiver with the release-escrow message
+- the receiver gives them up with the refund-escrow message
+- the timeout of the escrow is reached: the funds are refunded to the sender in the end blocker
+
+The escrow timeout is a Unix time in seconds, the escrows without timeout are only released or
+refunded by a message. An invariant checks that the module account holds the locked funds.
+
+The module is named "escrow" by default:
+
+  ignite scaffold escrow
+  ignite scaffold escrow payment`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: scaffoldEscrowHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	return c
+}
+
+func scaffoldEscrowHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name    = defaultEscrowModule
+		appPath = flagGetPath(cmd)
+	)
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddEscrow(cacheStorage, placeholder.New(), name)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Escrow module created %s.\n\n", name)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const (
+	defaultFeeMarketModule = "feemarket"
+
+	flagDenom      = "denom"
+	flagMinBaseFee = "min-base-fee"
+)
+
+// NewScaffoldFeeMarket returns the command to scaffold a module pricing the gas with a dynamic base fee
+func NewScaffoldFeeMarket() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "fee-market [module]",
+		Short: "Module adjusting the price of the gas with the fullness of the blocks",
+		Long: `Scaffold a new module pricing the gas of the txs with a base fee, as specified by EIP-1559,
+instead of the static minimum gas prices of the validators.
+
+The base fee is adjusted at the end of each block with the gas used by the block:
+
+- it increases when the block uses more gas than the target, half of the maximum gas of the blocks
+- it decreases when the block uses less gas than the target, down to the minimum base fee
+
+The base fee changes by 1/8 at most between two blocks. The fees of the txs must pay their gas
+limit at the base fee, they're checked by the ante handler of the app before they're deducted.
+The denom and the minimum base fee are params of the module, so are the change denominator, the
+elasticity multiplier dividing the maximum gas into the target gas and the maximum gas used when
+the consensus params don't limit the gas of the blocks.
+
+The base fee is returned by the "base-fee" query of the module, the clients can query it to set
+the fees of their txs. The module is named "feemarket" by default:
+
+  ignite scaffold fee-market --denom stake --min-base-fee 0.0025`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: scaffoldFeeMarketHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagDenom, "stake", "Denom of the fees paying the gas at the base fee")
+	c.Flags().String(flagMinBaseFee, "0", "Minimum base fee, the price of a unit of gas in the denom")
+
+	return c
+}
+
+func scaffoldFeeMarketHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name          = defaultFeeMarketModule
+		denom, _      = cmd.Flags().GetString(flagDenom)
+		minBaseFee, _ = cmd.Flags().GetString(flagMinBaseFee)
+		appPath       = flagGetPath(cmd)
+	)
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddFeeMarket(cacheStorage, placeholder.New(), name, denom, minBaseFee)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Fee market module created %s.\n\n", name)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const (
+	flagStorage = "storage"
+
+	storageList   = "list"
+	storageMap    = "map"
+	storageSingle = "single"
+)
+
+// NewScaffoldFromStruct returns a new command to scaffold a stored type from a Go struct.
+func NewScaffoldFromStruct() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "from-struct [file] [struct]",
+		Short: "CRUD for data stored with the fields of an existing Go struct",
+		Long: `Scaffold a stored type with the fields of a struct declared in a Go file, instead
+of listing the fields on the command line:
+
+  ignite scaffold from-struct ./types/order.go Order --module market
+
+The fields are named after their JSON name when they have one. The Go types are
+converted to the types of the scaffolded fields: the strings, booleans, integers,
+unsigned integers, sdk.Coin and their slices. The other types are custom types, they
+must be declared in the proto files of the module.
+
+The ID and the signer generated by the scaffolder and the unexported fields are
+skipped.
+
+The type is stored as a list by default. Use --storage to store it as a map or as a
+single entry, the indexes of a map are fields of the struct:
+
+  ignite scaffold from-struct ./types/order.go Order --module market --storage map --index orderId`,
+		Args: cobra.ExactArgs(2),
+		RunE: scaffoldFromStructHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().String(flagStorage, storageList, "Storage of the type: list, map or single")
+	c.Flags().StringSlice(FlagIndexes, nil, "Fields of the struct that index the value of a map")
+
+	return c
+}
+
+func scaffoldFromStructHandler(cmd *cobra.Command, args []string) error {
+	var (
+		path, structName = args[0], args[1]
+		appPath          = flagGetPath(cmd)
+		storage, _       = cmd.Flags().GetString(flagStorage)
+		indexes, _       = cmd.Flags().GetStringSlice(FlagIndexes)
+	)
+
+	var kind scaffolder.AddTypeKind
+	switch storage {
+	case storageList:
+		kind = scaffolder.ListType()
+	case storageMap:
+		kind = scaffolder.MapType(indexes...)
+	case storageSingle:
+		kind = scaffolder.SingletonType()
+	default:
+		return fmt.Errorf("unknown storage %s, use %s, %s or %s", storage, storageList, storageMap, storageSingle)
+	}
+	if storage != storageMap && len(indexes) > 0 {
+		return fmt.Errorf("--%s can only be used with --%s %s", FlagIndexes, flagStorage, storageMap)
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sm, skipped, err := sc.AddTypeFromStruct(
+		cmd.Context(),
+		cacheStorage,
+		placeholder.New(),
+		path,
+		structName,
+		kind,
+		scaffoldTypeOptions(cmd)...,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	if len(skipped) > 0 {
+		fmt.Printf("\nSkipped fields: %s\n", strings.Join(skipped, ", "))
+	}
+	fmt.Printf("\n🎉 %s added. \n\n", structName)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const flagStore = "store"
+
+// NewScaffoldICQ returns the command to scaffold an interchain query
+func NewScaffoldICQ() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "icq [name]",
+		Short: "Query the store of another chain with a proof verified by its IBC client",
+		Long: `Scaffold an interchain query reading a key of the store of a host chain connected with IBC.
+
+  ignite scaffold icq balance --store bank --module loan
+
+The keeper of the module gets a RequestBalanceICQuery method issuing a query on an IBC
+connection, and an onBalanceICQueryResponse callback receiving the value of the key. The
+queries are emitted as "icq_request" events, a relayer answers them by submitting the value
+and its proof with the SubmitICQResponse message of the module. The proof is verified against
+the consensus state of the IBC client of the connection before the callback is called.
+
+The store is added to the allowlist of the host stores of the module, only the keys of the
+allowed stores can be queried. The first interchain query of a module also scaffolds mocks of
+the IBC keepers in testutil/icq to test the callbacks with proofs of a local store.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldICQHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagModule, "", "Module to add the interchain query into. Default: app's main module")
+	c.Flags().String(flagStore, "", "Store of the host chain read by the query (e.g. bank)")
+
+	return c
+}
+
+func scaffoldICQHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name      = args[0]
+		module, _ = cmd.Flags().GetString(flagModule)
+		store, _  = cmd.Flags().GetString(flagStore)
+		appPath   = flagGetPath(cmd)
+	)
+	if store == "" {
+		return fmt.Errorf("the --%s of the host chain is required", flagStore)
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddICQuery(cacheStorage, placeholder.New(), module, name, store)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created the interchain query `%[1]v` of the `%[2]v` store.\n\n", name, store)
+
+	return nil
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
-const flagSigner = "signer"
+const (
+	flagSigner     = "signer"
+	flagPermission = "permission"
+)
 
 // NewScaffoldMessage returns the command to scaffold messages
 func NewScaffoldMessage() *cobra.Command {
@@ -28,6 +31,7 @@ func NewScaffoldMessage() *cobra.Command {
 	c.Flags().Bool(flagNoSimulation, false, "Disable CRUD simulation scaffolding")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
+	c.Flags().String(flagPermission, "", "Restrict the message to the module admin (admin) or to the module allowlist (allowlist)")
 
 	return c
 }
@@ -37,6 +41,7 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		module, _         = cmd.Flags().GetString(flagModule)
 		resFields, _      = cmd.Flags().GetStringSlice(flagResponse)
 		desc, _           = cmd.Flags().GetString(flagDescription)
+		permission, _     = cmd.Flags().GetString(flagPermission)
 		signer            = flagGetSigner(cmd)
 		appPath           = flagGetPath(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
@@ -67,6 +72,11 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithoutSimulation())
 	}
 
+	// Restrict the message
+	if permission != "" {
+		options = append(options, scaffolder.WithPermission(permission))
+	}
+
 	sc, err := newApp(appPath)
 	if err != nil {
 		return err
@@ -0,0 +1,83 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/ibc"
+)
+
+const flagWrap = "wrap"
+
+// NewScaffoldMiddleware returns the command to scaffold an IBC middleware
+func NewScaffoldMiddleware() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "middleware [name]",
+		Short: "IBC middleware wrapping the transfer module or an IBC module",
+		Long: `Scaffold an ICS-30 IBC middleware wrapping the IBC route of a module.
+
+  ignite scaffold middleware ratelimit --wrap transfer
+
+The middleware is created in x/ratelimit with an IBCMiddleware type implementing the callbacks
+of the channels and the packets of the wrapped module, and a keeper implementing the ICS4
+wrapper sending its packets. The callbacks forward to the wrapped module by default, add the
+logic of the middleware where the TODO comments are.
+
+The keeper of the middleware is added to app.go and the IBC route of the wrapped module is
+wrapped with the middleware. The wrapped module is the transfer module by default, or a module
+scaffolded with "ignite scaffold module --ibc". Middlewares scaffolded for the same module wrap
+the previous ones.
+
+The packets sent by the wrapped module go through the middleware once the middleware keeper is
+passed to the keeper of the wrapped module as its ICS4 wrapper instead of the IBC channel keeper.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldMiddlewareHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagWrap, ibc.MiddlewareWrapTransfer, "IBC module wrapped by the middleware")
+
+	return c
+}
+
+func scaffoldMiddlewareHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name    = args[0]
+		wrap, _ = cmd.Flags().GetString(flagWrap)
+		appPath = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.CreateMiddleware(cacheStorage, placeholder.New(), name, wrap)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created the IBC middleware `%[1]v` wrapping the `%[2]v` module.\n\n", name, wrap)
+
+	return nil
+}
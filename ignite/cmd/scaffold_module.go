@@ -21,7 +21,10 @@ const (
 	flagIBC                 = "ibc"
 	flagParams              = "params"
 	flagIBCOrdering         = "ordering"
+	flagIBCVersion          = "channel-version"
+	flagIBCUpgradable       = "upgradable"
 	flagRequireRegistration = "require-registration"
+	flagFeature             = "feature"
 )
 
 // NewScaffoldModule returns the command to scaffold a Cosmos SDK module
@@ -39,8 +42,11 @@ func NewScaffoldModule() *cobra.Command {
 	c.Flags().StringSlice(flagDep, []string{}, "module dependencies (e.g. --dep account,bank)")
 	c.Flags().Bool(flagIBC, false, "scaffold an IBC module")
 	c.Flags().String(flagIBCOrdering, "none", "channel ordering of the IBC module [none|ordered|unordered]")
+	c.Flags().StringSlice(flagIBCVersion, []string{}, "channel versions negotiated by the IBC module by order of preference, the first one is proposed (default [name]-1)")
+	c.Flags().Bool(flagIBCUpgradable, false, "scaffold the channel upgradability callbacks of the IBC module")
 	c.Flags().Bool(flagRequireRegistration, false, "if true command will fail if module can't be registered")
 	c.Flags().StringSlice(flagParams, []string{}, "scaffold module params")
+	c.Flags().String(flagFeature, "", "build tag required to include the module in the app (e.g. --feature experimental)")
 
 	return c
 }
@@ -81,9 +87,31 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		scaffolder.WithParams(params),
 	}
 
+	feature, err := cmd.Flags().GetString(flagFeature)
+	if err != nil {
+		return err
+	}
+	if feature != "" {
+		options = append(options, scaffolder.WithFeature(feature))
+	}
+
 	// Check if the module must be an IBC module
 	if ibcModule {
 		options = append(options, scaffolder.WithIBCChannelOrdering(ibcOrdering), scaffolder.WithIBC())
+
+		ibcVersions, err := cmd.Flags().GetStringSlice(flagIBCVersion)
+		if err != nil {
+			return err
+		}
+		options = append(options, scaffolder.WithIBCChannelVersions(ibcVersions))
+
+		ibcUpgradable, err := cmd.Flags().GetBool(flagIBCUpgradable)
+		if err != nil {
+			return err
+		}
+		if ibcUpgradable {
+			options = append(options, scaffolder.WithIBCChannelUpgrades())
+		}
 	}
 
 	// Get module dependencies
@@ -143,6 +171,10 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		dependencyWarning(dependencies)
 	}
 
+	if feature != "" {
+		fmt.Fprintf(&msg, "The module is only included in the app when built with: ignite chain build --features %s\n\n", feature)
+	}
+
 	io.Copy(cmd.OutOrStdout(), &msg)
 	return nil
 }
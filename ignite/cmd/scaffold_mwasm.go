@@ -9,13 +9,22 @@ import (
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 )
 
+// NewScaffoldWasm returns the command to import the wasm module to the app.
 func NewScaffoldWasm() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "wasm",
 		Short: "Import the wasm module to your app",
-		Long:  "Add support for WebAssembly smart contracts to your blockchain",
-		Args:  cobra.NoArgs,
-		RunE:  scaffoldWasmHandler,
+		Long: `Add support for CosmWasm smart contracts to your blockchain.
+
+The wasm module is registered in app.go with its keeper, the route of its IBC packets and
+its gov proposals, disabled by default. The start command of the chain gets the flags of
+the wasm module, and the params of the module in the genesis of config.yml let anyone
+upload and instantiate contracts.
+
+A sample test in app/wasm_test.go deploys the contract compiled at app/testdata/contract.wasm
+on a network of the chain.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldWasmHandler,
 	}
 
 	flagSetPath(c)
@@ -0,0 +1,64 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+// NewScaffoldOpenAPI returns the command to embed the OpenAPI docs in an app scaffolded without them.
+func NewScaffoldOpenAPI() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "openapi",
+		Short: "OpenAPI docs embedded in the app and served by its API",
+		Long: `Embed the OpenAPI docs in a chain scaffolded with --minimal.
+
+The OpenAPI spec of the chain is generated in docs/static/openapi.yml, it's embedded in the app
+and served by the API with a console at its root. The generation of the spec is enabled in the
+config of the chain.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldOpenAPIHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	return c
+}
+
+func scaffoldOpenAPIHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddOpenAPI(cacheStorage, placeholder.New())
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Print("\n🎉 Embedded the OpenAPI docs in the app.\n\n")
+
+	return nil
+}
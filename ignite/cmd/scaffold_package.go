@@ -12,7 +12,9 @@ import (
 )
 
 const (
-	flagAck = "ack"
+	flagAck    = "ack"
+	flagCall   = "call"
+	flagRefund = "refund"
 )
 
 // NewScaffoldPacket creates a new packet in the module
@@ -31,6 +33,8 @@ func NewScaffoldPacket() *cobra.Command {
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
 	c.Flags().Bool(flagNoMessage, false, "Disable send message scaffolding")
+	c.Flags().Bool(flagCall, false, "Scaffold the packet as a call to the module of the counterparty chain, compensated on failure or timeout")
+	c.Flags().Bool(flagRefund, false, "Scaffold the packet as a call escrowing the coins of its coin fields, refunded to the signer on failure or timeout")
 
 	return c
 }
@@ -64,6 +68,16 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	call, err := cmd.Flags().GetBool(flagCall)
+	if err != nil {
+		return err
+	}
+
+	refund, err := cmd.Flags().GetBool(flagRefund)
+	if err != nil {
+		return err
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
@@ -75,6 +89,12 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 	} else if signer != "" {
 		options = append(options, scaffolder.PacketWithSigner(signer))
 	}
+	if call {
+		options = append(options, scaffolder.PacketAsCall())
+	}
+	if refund {
+		options = append(options, scaffolder.PacketWithRefund())
+	}
 
 	sc, err := newApp(appPath)
 	if err != nil {
@@ -7,10 +7,12 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
 const (
 	flagPaginated = "paginated"
+	flagFilter    = "filter"
 )
 
 // NewScaffoldQuery command creates a new type command to scaffold queries
@@ -18,8 +20,17 @@ func NewScaffoldQuery() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "query [name] [request_field1] [request_field2] ...",
 		Short: "Query to get data from the blockchain",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  queryHandler,
+		Long: `Query to get data from the blockchain.
+
+A paginated query iterating the store of a list or a map of the module is scaffolded with --store,
+it returns the values of the store with the page request and response plumbing:
+
+  ignite scaffold query posts-by-author --store post --filter author:string
+
+The filters are the request fields filtering a map by the leading indexes of its keys, in their
+order, only the store prefix of the filtered keys is iterated.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: queryHandler,
 	}
 
 	flagSetPath(c)
@@ -28,6 +39,8 @@ func NewScaffoldQuery() *cobra.Command {
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().Bool(flagPaginated, false, "Define if the request can be paginated")
+	c.Flags().String(flagStore, "", "List or map type whose store is iterated by the paginated query")
+	c.Flags().StringSlice(flagFilter, []string{}, "Filter fields of the request, the leading indexes of the map of --store")
 
 	return c
 }
@@ -65,6 +78,18 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	var (
+		store, _   = cmd.Flags().GetString(flagStore)
+		filters, _ = cmd.Flags().GetStringSlice(flagFilter)
+		options    []scaffolder.QueryOption
+	)
+	if store != "" {
+		options = append(options, scaffolder.QueryWithStore(store))
+	}
+	if len(filters) != 0 {
+		options = append(options, scaffolder.QueryWithFilters(filters...))
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
@@ -75,7 +100,7 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.AddQuery(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], desc, args[1:], resFields, paginated)
+	sm, err := sc.AddQuery(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], desc, args[1:], resFields, paginated, options...)
 	if err != nil {
 		return err
 	}
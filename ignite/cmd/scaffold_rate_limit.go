@@ -0,0 +1,88 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const (
+	flagLimit = "limit"
+	flagPer   = "per"
+)
+
+// NewScaffoldRateLimit returns the command to rate limit the messages sent by the addresses
+func NewScaffoldRateLimit() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rate-limit [message]",
+		Short: "Limit the number of messages an address can send per window of blocks",
+		Long: `Rate limit a message of a module to prevent the spam of the chain.
+
+An address can send up to --limit messages per window of --per blocks:
+
+  ignite scaffold rate-limit create-post --limit 10 --per 100blocks --module blog
+
+The limit and the window are params of the module, their default values are set by the flags
+and they can be updated with a governance proposal. A zero limit disables the rate limit.
+
+The limit is checked by the handler of the message, which must be scaffolded with the
+"ignite scaffold message" command. Only the successful messages are counted, the counts are
+reset at the first block of each window.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldRateLimitHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagModule, "", "Module of the message. Default: app's main module")
+	c.Flags().Uint64(flagLimit, 0, "Number of messages an address can send per window")
+	c.Flags().String(flagPer, "1block", "Window of the rate limit, a number of blocks (e.g. 100blocks)")
+
+	return c
+}
+
+func scaffoldRateLimitHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name      = args[0]
+		module, _ = cmd.Flags().GetString(flagModule)
+		limit, _  = cmd.Flags().GetUint64(flagLimit)
+		per, _    = cmd.Flags().GetString(flagPer)
+		appPath   = flagGetPath(cmd)
+	)
+	if limit == 0 {
+		return fmt.Errorf("the --%s number of messages is required", flagLimit)
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddRateLimit(cacheStorage, placeholder.New(), module, name, limit, per)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Rate limited `%[1]v` to %[2]v messages per %[3]v.\n\n", name, limit, per)
+
+	return nil
+}
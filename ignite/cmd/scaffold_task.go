@@ -0,0 +1,86 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const flagEvery = "every"
+
+// NewScaffoldTask returns the command to scaffold a task executed at a regular interval
+func NewScaffoldTask() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "task [name]",
+		Short: "Task executed in the begin blocker at a regular interval",
+		Long: `Scaffold a task of a module executed in the begin blocker at a regular interval.
+
+The interval is either a number of blocks or a duration measured with the block time:
+
+  ignite scaffold task cleanup --every 100blocks
+  ignite scaffold task rewards --every 1h --module mars
+
+The task is implemented in the keeper of the module, its writes are discarded when it returns
+an error. The first task of a module scaffolds the task registry of the module:
+
+- the schedules of the tasks stored in the module, with their last run, and their queries
+- the pause-task, resume-task and reschedule-task messages, restricted to the module admin
+
+A task is due once its interval is elapsed since the block of its last run, its first run is
+one interval after the first block of the chain running the task.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldTaskHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagModule, "", "Module to add the task into. Default: app's main module")
+	c.Flags().String(flagEvery, "", "Interval of the task, a number of blocks (e.g. 100blocks) or a duration (e.g. 1h)")
+
+	return c
+}
+
+func scaffoldTaskHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name      = args[0]
+		module, _ = cmd.Flags().GetString(flagModule)
+		every, _  = cmd.Flags().GetString(flagEvery)
+		appPath   = flagGetPath(cmd)
+	)
+	if every == "" {
+		return fmt.Errorf("the --%s interval is required", flagEvery)
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddTask(cacheStorage, placeholder.New(), module, name, every)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created a task `%[1]v` running %[2]v.\n\n", name, every)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const (
+	flagAddField = "add-field"
+	flagDefault  = "default"
+)
+
+// NewScaffoldTypeMigration returns the command to add a field to a stored type and migrate the store
+func NewScaffoldTypeMigration() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "type-migration [type]",
+		Short: "Add a field to a stored type and migrate the existing records",
+		Long: `Add a field to a type stored by a module and migrate the records stored by the running chains.
+
+The field is added to the proto message of the type, the existing records are migrated to
+the --default value of the field when the chain is upgraded:
+
+  ignite scaffold type-migration post --add-field score:uint --default 0 --module blog
+
+The migration is a method of the Migrator of the keeper, registered in the RegisterServices
+method of the module, and the consensus version of the module is bumped. A test of the
+migration over a populated store is scaffolded as well.
+
+The type must be stored by the module, e.g. scaffolded with "ignite scaffold list", "map" or
+"single". String, bool, int and uint fields are supported, their zero value is the default.
+The messages creating and updating the type don't set the new field.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldTypeMigrationHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().String(flagModule, "", "Module of the type. Default: app's main module")
+	c.Flags().String(flagAddField, "", "Field added to the type (e.g. score:uint)")
+	c.Flags().String(flagDefault, "", "Value of the field in the existing records. Default: zero value of the field")
+
+	return c
+}
+
+func scaffoldTypeMigrationHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name            = args[0]
+		module, _       = cmd.Flags().GetString(flagModule)
+		addField, _     = cmd.Flags().GetString(flagAddField)
+		defaultValue, _ = cmd.Flags().GetString(flagDefault)
+		appPath         = flagGetPath(cmd)
+	)
+	if addField == "" {
+		return fmt.Errorf("the --%s field is required", flagAddField)
+	}
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddTypeMigration(cacheStorage, placeholder.New(), module, name, addField, defaultValue)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Added `%[1]v` to `%[2]v` and migrated the existing records.\n\n", addField, name)
+
+	return nil
+}
@@ -14,8 +14,12 @@ func NewScaffoldVue() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "vue",
 		Short: "Vue 3 web app template",
-		Args:  cobra.NoArgs,
-		RunE:  scaffoldVueHandler,
+		Long: `Scaffold a Vue 3 web app for the chain.
+
+When run in the directory of a chain whose config doesn't generate the Vuex stores, like a chain
+scaffolded with --minimal, the generation of the stores of the app is enabled in the config.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldVueHandler,
 	}
 
 	c.Flags().StringP(flagPath, "p", "./vue", "path to scaffold content of the Vue.js app")
@@ -31,6 +35,9 @@ func scaffoldVueHandler(cmd *cobra.Command, args []string) error {
 	if err := scaffolder.Vue(path); err != nil {
 		return err
 	}
+	if err := scaffolder.EnableVuex(".", path); err != nil {
+		return err
+	}
 
 	s.Stop()
 	fmt.Printf("\n🎉 Scaffold a Vue.js app.\n\n")
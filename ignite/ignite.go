@@ -0,0 +1,304 @@
+// Package ignite is the Go API of Ignite CLI, to scaffold, build and serve chains from other
+// tools like IDE plugins and web builders without running the ignite binary.
+//
+// The API follows semantic versioning with the module of Ignite CLI: its exported identifiers
+// aren't removed nor changed in a backward incompatible way within a major version. The options
+// are structs whose zero values are the defaults, the new options are added as fields keeping the
+// behavior of their zero value. The packages under ignite/ aren't covered by these guarantees.
+package ignite
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const (
+	// cacheFileName is the name of the cache of the builds and the code generation, shared
+	// with the ignite binary.
+	cacheFileName = "ignite_cache.db"
+
+	// defaultAddressPrefix is the account address prefix of the scaffolded chains.
+	defaultAddressPrefix = "cosmos"
+)
+
+// LogLevel is the level of the logs of the operations on a chain.
+type LogLevel int
+
+const (
+	// LogSilent discards the logs, it's the default.
+	LogSilent LogLevel = iota
+
+	// LogRegular prints the progress of the operations on the standard output.
+	LogRegular
+
+	// LogVerbose prints the progress of the operations and the output of the commands they run.
+	LogVerbose
+)
+
+// chainLogLevels maps the log levels to the ones of the chains.
+var chainLogLevels = map[LogLevel]chain.LogLvl{
+	LogSilent:  chain.LogSilent,
+	LogRegular: chain.LogRegular,
+	LogVerbose: chain.LogVerbose,
+}
+
+// ScaffoldChainOptions are the options of ScaffoldChain.
+type ScaffoldChainOptions struct {
+	// Name is the name of the chain or its Go module path, e.g. github.com/foo/mars. The chain
+	// is created in a dir named after it.
+	Name string
+
+	// ModulePath is the Go module path of the chain, the chain is created in Root itself instead
+	// of a dir named after the chain. It replaces Name.
+	ModulePath string
+
+	// Root is the dir the chain is created in, the working dir by default.
+	Root string
+
+	// AddressPrefix is the account address prefix of the chain, cosmos by default.
+	AddressPrefix string
+
+	// NoDefaultModule skips the scaffolding of the module named after the chain.
+	NoDefaultModule bool
+
+	// Minimal only scaffolds the shell of the chain, without the default module, the Vue.js app
+	// and the OpenAPI docs.
+	Minimal bool
+
+	// Template is the project template the chain is created from, instead of the built-in one.
+	Template string
+
+	// TemplateVars are the values of the variables of Template.
+	TemplateVars map[string]string
+
+	// CacheDir is the dir of the cache shared with the ignite binary, ~/.ignite by default.
+	CacheDir string
+}
+
+// ScaffoldChain scaffolds a new chain and returns its path.
+func ScaffoldChain(ctx context.Context, o ScaffoldChainOptions) (path string, err error) {
+	if o.Name == "" && o.ModulePath == "" {
+		return "", errors.New("a name or a module path is required")
+	}
+	if o.Name != "" && o.ModulePath != "" {
+		return "", errors.New("a name can't be used with a module path")
+	}
+	if o.Minimal && o.Template != "" {
+		return "", errors.New("a minimal chain can't be created from a template")
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	var options []scaffolder.InitOption
+	if o.ModulePath != "" {
+		options = append(options, scaffolder.InitWithModulePath(o.ModulePath))
+	}
+	if o.Minimal {
+		options = append(options, scaffolder.InitMinimal())
+	}
+	if o.Template != "" {
+		options = append(options, scaffolder.InitWithTemplate(o.Template, o.TemplateVars))
+	}
+
+	addressPrefix := o.AddressPrefix
+	if addressPrefix == "" {
+		addressPrefix = defaultAddressPrefix
+	}
+	root := o.Root
+	if root == "" {
+		root = "."
+	}
+
+	cacheStorage, err := newCache(o.CacheDir)
+	if err != nil {
+		return "", err
+	}
+	return scaffolder.Init(cacheStorage, placeholder.New(), root, o.Name, addressPrefix, o.NoDefaultModule, options...)
+}
+
+// ChainOptions are the options of the operations on an existing chain.
+type ChainOptions struct {
+	// Path is the path of the chain, the working dir by default.
+	Path string
+
+	// ConfigFile is the config of the chain, the config.yml of the chain by default.
+	ConfigFile string
+
+	// ConfigProfile is the profile of the config overriding it, e.g. testnet.
+	ConfigProfile string
+
+	// Home is the home of the node, the one of the config by default.
+	Home string
+
+	// ChainID is the chain id, the one of the config by default.
+	ChainID string
+
+	// Log is the level of the logs of the operations.
+	Log LogLevel
+
+	// Logger receives the structured logs of the operations.
+	Logger log.Logger
+
+	// CacheDir is the dir of the cache shared with the ignite binary, ~/.ignite by default.
+	CacheDir string
+}
+
+// newChain returns the chain and the cache of the options.
+func (o ChainOptions) newChain() (*chain.Chain, cache.Storage, error) {
+	path := o.Path
+	if path == "" {
+		path = "."
+	}
+
+	options := []chain.Option{chain.LogLevel(chainLogLevels[o.Log])}
+	if o.ConfigFile != "" {
+		options = append(options, chain.ConfigFile(o.ConfigFile))
+	}
+	if o.ConfigProfile != "" {
+		options = append(options, chain.ConfigProfile(o.ConfigProfile))
+	}
+	if o.Home != "" {
+		options = append(options, chain.HomePath(o.Home))
+	}
+	if o.ChainID != "" {
+		options = append(options, chain.ID(o.ChainID))
+	}
+	if o.Logger != nil {
+		options = append(options, chain.Logger(o.Logger))
+	}
+
+	c, err := chain.New(path, options...)
+	if err != nil {
+		return nil, cache.Storage{}, err
+	}
+	cacheStorage, err := newCache(o.CacheDir)
+	if err != nil {
+		return nil, cache.Storage{}, err
+	}
+	return c, cacheStorage, nil
+}
+
+// BuildOptions are the options of Build.
+type BuildOptions struct {
+	ChainOptions
+
+	// Output is the dir the binary is built in, the Go bin dir by default.
+	Output string
+}
+
+// Build generates the code of the chain and builds its binary, it returns the name of the binary.
+func Build(ctx context.Context, o BuildOptions) (binaryName string, err error) {
+	c, cacheStorage, err := o.newChain()
+	if err != nil {
+		return "", err
+	}
+	return c.Build(ctx, cacheStorage, o.Output)
+}
+
+// GenerateOptions are the options of Generate. The Go code of the chain is always generated.
+type GenerateOptions struct {
+	ChainOptions
+
+	// Vuex generates the Vuex stores of the modules.
+	Vuex bool
+
+	// React generates the React hooks of the modules.
+	React bool
+
+	// Dart generates the Dart client of the modules.
+	Dart bool
+
+	// OpenAPI generates the OpenAPI spec of the chain.
+	OpenAPI bool
+
+	// GoClient generates the Go client of the chain.
+	GoClient bool
+}
+
+// Generate generates the code of the chain from its proto files.
+func Generate(ctx context.Context, o GenerateOptions) error {
+	c, cacheStorage, err := o.newChain()
+	if err != nil {
+		return err
+	}
+
+	var targets []chain.GenerateTarget
+	if o.Vuex {
+		targets = append(targets, chain.GenerateVuex())
+	}
+	if o.React {
+		targets = append(targets, chain.GenerateReact())
+	}
+	if o.Dart {
+		targets = append(targets, chain.GenerateDart())
+	}
+	if o.OpenAPI {
+		targets = append(targets, chain.GenerateOpenAPI())
+	}
+	if o.GoClient {
+		targets = append(targets, chain.GenerateGoClient())
+	}
+	return c.Generate(ctx, cacheStorage, chain.GenerateGo(), targets...)
+}
+
+// ServeOptions are the options of Serve.
+type ServeOptions struct {
+	ChainOptions
+
+	// ResetOnce resets the state of the chain when it's served, the state is kept by default.
+	ResetOnce bool
+
+	// ForceReset resets the state of the chain when it's served and on every source change.
+	ForceReset bool
+
+	// Validators is the number of validators of the local network, one by default.
+	Validators int
+
+	// EndpointsFile is the JSON file the endpoints of the chain are written to when it starts.
+	EndpointsFile string
+}
+
+// Serve builds, initializes and starts the chain, and restarts it on every change of its source
+// until ctx is canceled.
+func Serve(ctx context.Context, o ServeOptions) error {
+	c, cacheStorage, err := o.newChain()
+	if err != nil {
+		return err
+	}
+
+	var options []chain.ServeOption
+	if o.ResetOnce {
+		options = append(options, chain.ServeResetOnce())
+	}
+	if o.ForceReset {
+		options = append(options, chain.ServeForceReset())
+	}
+	if o.Validators > 1 {
+		options = append(options, chain.ServeValidators(o.Validators))
+	}
+	if o.EndpointsFile != "" {
+		options = append(options, chain.ServeEndpointsFile(o.EndpointsFile))
+	}
+	return c.Serve(ctx, cacheStorage, options...)
+}
+
+// newCache returns the cache in dir, ~/.ignite by default.
+func newCache(dir string) (cache.Storage, error) {
+	if dir == "" {
+		var err error
+		if dir, err = chainconfig.ConfigDirPath(); err != nil {
+			return cache.Storage{}, err
+		}
+	}
+	return cache.NewStorage(filepath.Join(dir, cacheFileName))
+}
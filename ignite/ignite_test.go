@@ -0,0 +1,33 @@
+package ignite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffoldChainInvalidOptions(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := ScaffoldChain(ctx, ScaffoldChainOptions{})
+	require.EqualError(t, err, "a name or a module path is required")
+
+	_, err = ScaffoldChain(ctx, ScaffoldChainOptions{Name: "mars", ModulePath: "github.com/foo/mars"})
+	require.EqualError(t, err, "a name can't be used with a module path")
+
+	_, err = ScaffoldChain(ctx, ScaffoldChainOptions{Name: "mars", Minimal: true, Template: "github.com/foo/template"})
+	require.Error(t, err)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = ScaffoldChain(canceled, ScaffoldChainOptions{Name: "mars", Root: t.TempDir()})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBuildMissingChain(t *testing.T) {
+	_, err := Build(context.Background(), BuildOptions{
+		ChainOptions: ChainOptions{Path: t.TempDir(), CacheDir: t.TempDir()},
+	})
+	require.Error(t, err)
+}
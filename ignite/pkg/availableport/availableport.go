@@ -19,13 +19,13 @@ func Find(n int) (ports []int, err error) {
 			rand.Seed(time.Now().UnixNano())
 			port := rand.Intn(max-min+1) + min
 
-			conn, err := net.Dial("tcp", fmt.Sprintf(":%d", port))
-			// if there is an error, this might mean that no one is listening from this port
-			// which is what we need.
-			if err == nil {
-				conn.Close()
+			// the port is unused when it can be listened. it's not dialed, dialing a port
+			// nobody listens to takes seconds to fail on Windows.
+			l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+			if err != nil {
 				continue
 			}
+			l.Close()
 			ports = append(ports, port)
 			break
 		}
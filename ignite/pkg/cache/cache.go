@@ -14,6 +14,9 @@ import (
 
 var ErrorNotFound = errors.New("no value was found with the provided key")
 
+// timestampsBucket is the bucket storing the time each value was put in the cache
+const timestampsBucket = "__timestamps"
+
 // Storage is meant to be passed around and used by the New function (which provides namespacing and type-safety)
 type Storage struct {
 	storagePath string
@@ -44,6 +47,11 @@ func New[T any](storage Storage, namespace string) Cache[T] {
 	}
 }
 
+// Path returns the path of the database file
+func (s Storage) Path() string {
+	return s.storagePath
+}
+
 // Key creates a single composite key from a list of keyParts
 func Key(keyParts ...string) string {
 	return strings.Join(keyParts, "")
@@ -85,7 +93,10 @@ func (c Cache[T]) Put(key string, value T) error {
 		if err != nil {
 			return err
 		}
-		return b.Put([]byte(key), result)
+		if err := b.Put([]byte(key), result); err != nil {
+			return err
+		}
+		return putTimestamp(tx, c.namespace, key, time.Now())
 	})
 }
 
@@ -139,9 +150,137 @@ func (c Cache[T]) Delete(key string) error {
 		if b == nil {
 			return nil
 		}
+		if err := b.Delete([]byte(key)); err != nil {
+			return err
+		}
+		return deleteTimestamp(tx, c.namespace, key)
+	})
+}
+
+// Prune deletes the cached values put more than ttl ago and returns the number of deleted values.
+// Values without put time, cached by the previous versions, are deleted too.
+func (s Storage) Prune(ttl time.Duration) (pruned int, err error) {
+	db, err := openDb(s.storagePath)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	expiry := time.Now().Add(-ttl)
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		var namespaces [][]byte
+		if err := tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			if string(name) != timestampsBucket {
+				namespaces = append(namespaces, name)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, namespace := range namespaces {
+			b := tx.Bucket(namespace)
+
+			// keys are collected first since a bucket can't be modified while iterated
+			var (
+				expired [][]byte
+				total   int
+			)
+			if err := b.ForEach(func(k, _ []byte) error {
+				total++
+				if t, ok := getTimestamp(tx, string(namespace), string(k)); !ok || t.Before(expiry) {
+					expired = append(expired, k)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
 
-		return b.Delete([]byte(key))
+			for _, k := range expired {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+				if err := deleteTimestamp(tx, string(namespace), string(k)); err != nil {
+					return err
+				}
+			}
+			pruned += len(expired)
+
+			if total == len(expired) {
+				if err := tx.DeleteBucket(namespace); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
 	})
+
+	return pruned, err
+}
+
+// Compact rewrites the database file to release the space of the deleted values.
+func (s Storage) Compact() error {
+	src, err := openDb(s.storagePath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	compactPath := s.storagePath + ".compact"
+	dst, err := openDb(compactPath)
+	if err != nil {
+		return err
+	}
+	if err := bolt.Compact(dst, src, 0); err != nil {
+		dst.Close()
+		os.Remove(compactPath)
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(compactPath, s.storagePath)
+}
+
+func timestampKey(namespace, key string) []byte {
+	return []byte(namespace + "/" + key)
+}
+
+func putTimestamp(tx *bolt.Tx, namespace, key string, t time.Time) error {
+	b, err := tx.CreateBucketIfNotExists([]byte(timestampsBucket))
+	if err != nil {
+		return err
+	}
+	value, err := t.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return b.Put(timestampKey(namespace, key), value)
+}
+
+func getTimestamp(tx *bolt.Tx, namespace, key string) (t time.Time, ok bool) {
+	b := tx.Bucket([]byte(timestampsBucket))
+	if b == nil {
+		return t, false
+	}
+	value := b.Get(timestampKey(namespace, key))
+	if value == nil {
+		return t, false
+	}
+	if err := t.UnmarshalBinary(value); err != nil {
+		return t, false
+	}
+	return t, true
+}
+
+func deleteTimestamp(tx *bolt.Tx, namespace, key string) error {
+	b := tx.Bucket([]byte(timestampsBucket))
+	if b == nil {
+		return nil
+	}
+	return b.Delete(timestampKey(namespace, key))
 }
 
 func openDb(path string) (*bolt.DB, error) {
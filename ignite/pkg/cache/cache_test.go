@@ -3,6 +3,7 @@ package cache_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -168,3 +169,33 @@ func TestKey(t *testing.T) {
 	multiKey := cache.Key("test1", "test2", "test3")
 	require.Equal(t, "test1test2test3", multiKey)
 }
+
+func TestPrune(t *testing.T) {
+	tmpDir := t.TempDir()
+	cacheStorage, err := cache.NewStorage(filepath.Join(tmpDir, "testdbfile.db"))
+	require.NoError(t, err)
+
+	strNamespace := cache.New[string](cacheStorage, "myNameSpace")
+	require.NoError(t, strNamespace.Put("myKey", "myValue"))
+
+	pruned, err := cacheStorage.Prune(time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 0, pruned)
+
+	val, err := strNamespace.Get("myKey")
+	require.NoError(t, err)
+	require.Equal(t, "myValue", val)
+
+	pruned, err = cacheStorage.Prune(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	_, err = strNamespace.Get("myKey")
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+
+	require.NoError(t, cacheStorage.Compact())
+	require.NoError(t, strNamespace.Put("myKey", "myValue"))
+	val, err = strNamespace.Get("myKey")
+	require.NoError(t, err)
+	require.Equal(t, "myValue", val)
+}
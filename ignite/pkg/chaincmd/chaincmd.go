@@ -46,7 +46,10 @@ const (
 	optionCoinType                         = "--coin-type"
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
+	optionVestingStartTime                 = "--vesting-start-time"
 	optionBroadcastMode                    = "--broadcast-mode"
+	optionFrom                             = "--from"
+	optionOutputDocument                   = "--output-document"
 
 	constTendermint = "tendermint"
 	constJSON       = "json"
@@ -299,6 +302,30 @@ func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins
 	return c.daemonCommand(command)
 }
 
+// AddContinuousVestingAccountCommand returns the command to add a continuous vesting account in the genesis file
+// of the chain, its vesting coins vest linearly from the start time to the end time
+func (c ChainCmd) AddContinuousVestingAccountCommand(
+	address,
+	originalCoins,
+	vestingCoins string,
+	vestingStartTime,
+	vestingEndTime int64,
+) step.Option {
+	command := []string{
+		commandAddGenesisAccount,
+		address,
+		originalCoins,
+		optionVestingAmount,
+		vestingCoins,
+		optionVestingStartTime,
+		fmt.Sprintf("%d", vestingStartTime),
+		optionVestingEndTime,
+		fmt.Sprintf("%d", vestingEndTime),
+	}
+
+	return c.daemonCommand(command)
+}
+
 // GentxOption for the GentxCommand
 type GentxOption func([]string) []string
 
@@ -540,6 +567,78 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 	return c.cliCommand(command)
 }
 
+// TxCommand returns the command to broadcast the tx of a module command signed by fromAccount,
+// e.g. `tx blog create-post [title] [body]`.
+func (c ChainCmd) TxCommand(fromAccount, module, moduleCommand string, args ...string) step.Option {
+	command := []string{
+		commandTx,
+		module,
+		moduleCommand,
+	}
+	command = append(command, args...)
+	command = append(command,
+		optionFrom,
+		fromAccount,
+		optionBroadcastMode,
+		constSync,
+		optionYes,
+	)
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
+
+// SignTxCommand returns the command to sign the unsigned tx in txFile with fromAccount, the signed
+// tx is written in signedTxFile.
+func (c ChainCmd) SignTxCommand(fromAccount, txFile, signedTxFile string) step.Option {
+	command := []string{
+		commandTx,
+		"sign",
+		txFile,
+		optionFrom,
+		fromAccount,
+		optionOutputDocument,
+		signedTxFile,
+	}
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
+
+// BroadcastTxCommand returns the command to broadcast the signed tx in txFile.
+func (c ChainCmd) BroadcastTxCommand(txFile string) step.Option {
+	command := []string{
+		commandTx,
+		"broadcast",
+		txFile,
+		optionBroadcastMode,
+		constSync,
+	}
+
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
+
+// DecodeTxCommand returns the command to decode the base64 encoded tx bytes into JSON.
+func (c ChainCmd) DecodeTxCommand(txBase64 string) step.Option {
+	command := []string{
+		commandTx,
+		"decode",
+		txBase64,
+		optionOutput,
+		constJSON,
+	}
+
+	return c.cliCommand(command)
+}
+
 // QueryTxCommand returns the command to query tx
 func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	command := []string{
@@ -180,3 +180,21 @@ func (r Runner) AddVestingAccount(
 ) error {
 	return r.run(ctx, runOptions{}, r.chainCmd.AddVestingAccountCommand(address, originalCoins, vestingCoins, vestingEndTime))
 }
+
+// AddContinuousVestingAccount adds continuous vesting account to genesis by its address.
+func (r Runner) AddContinuousVestingAccount(
+	ctx context.Context,
+	address,
+	originalCoins,
+	vestingCoins string,
+	vestingStartTime,
+	vestingEndTime int64,
+) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.AddContinuousVestingAccountCommand(
+		address,
+		originalCoins,
+		vestingCoins,
+		vestingStartTime,
+		vestingEndTime,
+	))
+}
@@ -3,6 +3,7 @@ package chaincmdrunner
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
@@ -202,6 +204,97 @@ func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount str
 	return txResult.TxHash, nil
 }
 
+// BankOutput is an output of a multi-send, it receives amount.
+type BankOutput struct {
+	Address string
+	Amount  string
+}
+
+// BankMultiSend sends the amounts of the outputs from fromAccount, whose address is fromAddress,
+// in a single MsgMultiSend tx and returns its hash.
+func (r Runner) BankMultiSend(ctx context.Context, fromAccount, fromAddress string, outputs []BankOutput) (string, error) {
+	if !r.chainCmd.SDKVersion().IsFamily(cosmosver.Stargate) {
+		return "", errors.New("multi-send is only supported by Stargate chains")
+	}
+
+	dir, err := os.MkdirTemp("", "multisend")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		unsignedTxFile = filepath.Join(dir, "unsigned.json")
+		signedTxFile   = filepath.Join(dir, "signed.json")
+	)
+
+	unsignedTx, err := newMultiSendTx(fromAddress, outputs)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(unsignedTxFile, unsignedTx, 0644); err != nil {
+		return "", err
+	}
+
+	signOpt := []step.Option{
+		r.chainCmd.SignTxCommand(fromAccount, unsignedTxFile, signedTxFile),
+	}
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		signOpt = append(signOpt, step.Write(input.Bytes()))
+	}
+	if err := r.run(ctx, runOptions{}, signOpt...); err != nil {
+		return "", err
+	}
+
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.BroadcastTxCommand(signedTxFile)); err != nil {
+		return "", err
+	}
+
+	txResult, err := decodeTxResult(b)
+	if err != nil {
+		return "", err
+	}
+
+	if txResult.Code > 0 {
+		return "", fmt.Errorf("cannot send tokens (SDK code %d): %s", txResult.Code, txResult.RawLog)
+	}
+
+	return txResult.TxHash, nil
+}
+
+// Tx broadcasts the tx of a module command signed by fromAccount and returns its hash,
+// e.g. `Tx(ctx, "alice", "blog", "create-post", "title", "body")`.
+func (r Runner) Tx(ctx context.Context, fromAccount, module, moduleCommand string, args ...string) (string, error) {
+	b := newBuffer()
+	opt := []step.Option{
+		r.chainCmd.TxCommand(fromAccount, module, moduleCommand, args...),
+	}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		return "", err
+	}
+
+	txResult, err := decodeTxResult(b)
+	if err != nil {
+		return "", err
+	}
+
+	if txResult.Code > 0 {
+		return "", fmt.Errorf("cannot broadcast %s %s (SDK code %d): %s", module, moduleCommand, txResult.Code, txResult.RawLog)
+	}
+
+	return txResult.TxHash, nil
+}
+
 // WaitTx waits until a tx is successfully added to a block and can be queried
 func (r Runner) WaitTx(ctx context.Context, txHash string, retryDelay time.Duration, maxRetry int) error {
 	retry := 0
@@ -235,6 +328,30 @@ func (r Runner) WaitTx(ctx context.Context, txHash string, retryDelay time.Durat
 	return backoff.Retry(checkTx, backoff.WithContext(backoff.NewConstantBackOff(retryDelay), ctx))
 }
 
+// DecodeTxMessages decodes the messages of the tx bytes with the codec of the chain, including
+// the messages of its custom modules, and returns them as JSON.
+func (r Runner) DecodeTxMessages(ctx context.Context, tx []byte) ([]json.RawMessage, error) {
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.DecodeTxCommand(base64.StdEncoding.EncodeToString(tx))); err != nil {
+		return nil, err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Body struct {
+			Messages []json.RawMessage `json:"messages"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out.Body.Messages, nil
+}
+
 // Export exports the state of the chain into the specified file
 func (r Runner) Export(ctx context.Context, exportedFile string) error {
 	// Make sure the path exists
@@ -361,3 +478,60 @@ func (r Runner) QueryTxEvents(
 
 	return events, nil
 }
+
+const (
+	// multiSendBaseGas is the gas limit of a multi-send tx without outputs.
+	multiSendBaseGas = 100000
+
+	// multiSendOutputGas is the gas limit added to a multi-send tx for each output.
+	multiSendOutputGas = 30000
+)
+
+// newMultiSendTx returns the unsigned tx sending the amounts of the outputs from fromAddress
+// in the JSON format of the tx sign command.
+func newMultiSendTx(fromAddress string, outputs []BankOutput) ([]byte, error) {
+	type bankIO struct {
+		Address string    `json:"address"`
+		Coins   sdk.Coins `json:"coins"`
+	}
+
+	var (
+		total       sdk.Coins
+		bankOutputs []bankIO
+	)
+	for _, output := range outputs {
+		coins, err := sdk.ParseCoinsNormalized(output.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for %s: %w", output.Address, err)
+		}
+		total = total.Add(coins...)
+		bankOutputs = append(bankOutputs, bankIO{Address: output.Address, Coins: coins})
+	}
+
+	tx := map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages": []interface{}{
+				map[string]interface{}{
+					"@type":   "/cosmos.bank.v1beta1.MsgMultiSend",
+					"inputs":  []bankIO{{Address: fromAddress, Coins: total}},
+					"outputs": bankOutputs,
+				},
+			},
+			"memo":                           "",
+			"timeout_height":                 "0",
+			"extension_options":              []interface{}{},
+			"non_critical_extension_options": []interface{}{},
+		},
+		"auth_info": map[string]interface{}{
+			"signer_infos": []interface{}{},
+			"fee": map[string]interface{}{
+				"amount":    []interface{}{},
+				"gas_limit": fmt.Sprint(multiSendBaseGas + multiSendOutputGas*len(outputs)),
+				"payer":     "",
+				"granter":   "",
+			},
+		},
+		"signatures": []interface{}{},
+	}
+	return json.Marshal(tx)
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 
 	"github.com/ghodss/yaml"
@@ -118,6 +119,9 @@ func (r Runner) run(ctx context.Context, runOptions runOptions, stepOptions ...s
 		// therefore if the app successfully starts, the written logs can become extensive
 		errb = truncatedbuffer.NewTruncatedBuffer(runOptions.wrappedStdErrMaxLen)
 
+		// the last logs are kept as well since they contain the panics of the app
+		tailb = truncatedbuffer.NewTailBuffer(runOptions.wrappedStdErrMaxLen)
+
 		// add optional prefixes to output streams.
 		stdout io.Writer = lineprefixer.NewWriter(r.stdout,
 			func() string { return r.daemonLogPrefix },
@@ -136,6 +140,9 @@ func (r Runner) run(ctx context.Context, runOptions runOptions, stepOptions ...s
 	}
 
 	stderr = io.MultiWriter(stderr, errb)
+	if runOptions.wrappedStdErrMaxLen > 0 {
+		stderr = io.MultiWriter(stderr, tailb)
+	}
 
 	runnerOptions := []cmdrunner.Option{
 		cmdrunner.DefaultStdout(stdout),
@@ -151,7 +158,25 @@ func (r Runner) run(ctx context.Context, runOptions runOptions, stepOptions ...s
 		New(runnerOptions...).
 		Run(ctx, step.New(stepOptions...))
 
-	return errors.Wrap(err, errb.GetBuffer().String())
+	return errors.Wrap(err, wrappedStdErr(errb, tailb))
+}
+
+// wrappedStdErr returns the first logs and, if they are truncated, the last ones that are not
+// part of the first logs.
+func wrappedStdErr(errb *truncatedbuffer.TruncatedBuffer, tailb *truncatedbuffer.TailBuffer) string {
+	logs := errb.GetBuffer().String()
+
+	truncated := tailb.Written() - errb.GetBuffer().Len()
+	if tailb.GetCap() == 0 || truncated <= 0 {
+		return logs
+	}
+
+	tail := tailb.GetBuffer().Bytes()
+	if truncated < len(tail) {
+		tail = tail[len(tail)-truncated:]
+	}
+
+	return fmt.Sprintf("%s\n...\n%s", logs, tail)
 }
 
 func newBuffer() *buffer {
@@ -62,6 +62,7 @@ func RunParallel() Option {
 }
 
 // EndSignal configures s to be signaled to the processes to end them.
+// The processes are killed on Windows, where they can't be signaled.
 func EndSignal(s os.Signal) Option {
 	return func(r *Runner) {
 		r.endSignal = s
@@ -170,7 +171,7 @@ type cmdSignal struct {
 	*exec.Cmd
 }
 
-func (e *cmdSignal) Signal(s os.Signal) { e.Cmd.Process.Signal(s) }
+func (e *cmdSignal) Signal(s os.Signal) { endProcess(e.Cmd.Process, s) }
 
 func (e *cmdSignal) Write(data []byte) (n int, err error) { return 0, nil }
 
@@ -180,7 +181,7 @@ type cmdSignalWithWriter struct {
 	w io.WriteCloser
 }
 
-func (e *cmdSignalWithWriter) Signal(s os.Signal) { e.Cmd.Process.Signal(s) }
+func (e *cmdSignalWithWriter) Signal(s os.Signal) { endProcess(e.Cmd.Process, s) }
 
 func (e *cmdSignalWithWriter) Write(data []byte) (n int, err error) {
 	defer e.w.Close()
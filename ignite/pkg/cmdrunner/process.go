@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package cmdrunner
+
+import "os"
+
+// endProcess signals s to the process to end it.
+func endProcess(p *os.Process, s os.Signal) error {
+	return p.Signal(s)
+}
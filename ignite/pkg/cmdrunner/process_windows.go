@@ -0,0 +1,17 @@
+package cmdrunner
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+)
+
+// endProcess ends the process and its child processes. Windows can't signal an interrupt to a
+// process, the processes are killed instead of being signaled s.
+func endProcess(p *os.Process, _ os.Signal) error {
+	// taskkill kills the child processes of the process as well, like the ones started by go run.
+	if err := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(p.Pid)).Run(); err != nil {
+		return p.Kill()
+	}
+	return nil
+}
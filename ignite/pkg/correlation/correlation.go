@@ -0,0 +1,130 @@
+// Package correlation propagates the correlation ID of a request across the CLI, the services and
+// the HTTP servers, so the log lines of the components handling the same request can be followed
+// end to end.
+package correlation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"regexp"
+	"sync/atomic"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// Header is the HTTP header holding the correlation ID of the requests and the responses.
+	Header = "X-Request-ID"
+
+	// LogKey is the key of the correlation ID in the structured log lines.
+	LogKey = "request_id"
+
+	// EnvVar is the environment variable passing the correlation ID of a CLI invocation to the
+	// processes it runs.
+	EnvVar = "IGNITE_REQUEST_ID"
+)
+
+// validIDRe matches the IDs accepted from the headers of the requests and the environment.
+var validIDRe = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+var (
+	// randRead reads the random bytes of the new IDs.
+	randRead = rand.Read
+
+	// fallbackCount counts the IDs created without the random source.
+	fallbackCount uint32
+)
+
+type contextKey struct{}
+
+// New returns a new random correlation ID. When the random source fails, the ID is made of the
+// current time and a counter instead, so it's still unique in the process.
+func New() string {
+	b := make([]byte, 8)
+	if _, err := randRead(b); err != nil {
+		binary.BigEndian.PutUint32(b, uint32(time.Now().Unix()))
+		binary.BigEndian.PutUint32(b[4:], atomic.AddUint32(&fallbackCount, 1))
+	}
+	return hex.EncodeToString(b)
+}
+
+// WithID returns a copy of ctx holding the correlation ID id.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// ID returns the correlation ID of ctx, it's empty when ctx has none.
+func ID(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// FromEnv returns a copy of ctx holding the correlation ID of the EnvVar environment variable, or a
+// new one when it isn't set, and sets the variable so the processes started with the environment
+// share the ID.
+func FromEnv(ctx context.Context) context.Context {
+	id := os.Getenv(EnvVar)
+	if !validIDRe.MatchString(id) {
+		id = New()
+		os.Setenv(EnvVar, id)
+	}
+	return WithID(ctx, id)
+}
+
+// Logger returns logger adding the correlation ID of ctx to the log lines, logger is returned as
+// is when ctx has no ID.
+func Logger(ctx context.Context, logger log.Logger) log.Logger {
+	if id := ID(ctx); id != "" {
+		return logger.With(LogKey, id)
+	}
+	return logger
+}
+
+// Middleware sets the correlation ID of the requests to the ID of their header, or to a new one
+// when the header is missing or invalid, and responds with the ID in the header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if !validIDRe.MatchString(id) {
+			id = New()
+		}
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(WithID(r.Context(), id)))
+	})
+}
+
+// SetHeader sets the correlation ID of the context of req to its header.
+func SetHeader(req *http.Request) {
+	if id := ID(req.Context()); id != "" {
+		req.Header.Set(Header, id)
+	}
+}
+
+// Transport sets the correlation ID of the context of the requests to their header before sending
+// them with Next, http.DefaultTransport when it's nil.
+type Transport struct {
+	Next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	id := ID(req.Context())
+	if id == "" || req.Header.Get(Header) != "" {
+		return next.RoundTrip(req)
+	}
+
+	// a RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, id)
+	return next.RoundTrip(req)
+}
@@ -0,0 +1,91 @@
+package correlation_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ignite/cli/ignite/pkg/correlation"
+)
+
+func TestMiddleware(t *testing.T) {
+	var ids []string
+	handler := correlation.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, correlation.ID(r.Context()))
+	}))
+
+	// the ID of the request is kept.
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(correlation.Header, "cli-42")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, "cli-42", w.Header().Get(correlation.Header))
+
+	// the requests without a valid ID get a new one.
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(correlation.Header, "invalid id\n")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Len(t, w.Header().Get(correlation.Header), 16)
+
+	require.Equal(t, []string{"cli-42", w.Header().Get(correlation.Header)}, ids)
+}
+
+func TestTransport(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(correlation.Header))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: correlation.Transport{}}
+	for _, ctx := range []context.Context{
+		correlation.WithID(context.Background(), "cli-42"),
+		context.Background(),
+	} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		// the request of the caller isn't modified.
+		require.Empty(t, req.Header.Get(correlation.Header))
+	}
+	require.Equal(t, []string{"cli-42", ""}, received)
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.NewTMJSONLogger(&buf)
+
+	correlation.Logger(correlation.WithID(context.Background(), "cli-42"), logger).Info("transfer")
+	require.Contains(t, buf.String(), `"request_id":"cli-42"`)
+
+	// the ID of a request handled during the command replaces the ID of the command.
+	buf.Reset()
+	cmdLogger := correlation.Logger(correlation.WithID(context.Background(), "cli-42"), logger)
+	correlation.Logger(correlation.WithID(context.Background(), "http-43"), cmdLogger).Info("transfer")
+	require.Contains(t, buf.String(), `"request_id":"http-43"`)
+	require.NotContains(t, buf.String(), "cli-42")
+
+	buf.Reset()
+	correlation.Logger(context.Background(), logger).Info("transfer")
+	require.NotContains(t, buf.String(), "request_id")
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(correlation.EnvVar, "cli-42")
+	require.Equal(t, "cli-42", correlation.ID(correlation.FromEnv(context.Background())))
+
+	// a new ID is shared with the processes started by the CLI.
+	t.Setenv(correlation.EnvVar, "")
+	id := correlation.ID(correlation.FromEnv(context.Background()))
+	require.Len(t, id, 16)
+	require.Equal(t, id, correlation.ID(correlation.FromEnv(context.Background())))
+}
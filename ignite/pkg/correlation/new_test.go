@@ -0,0 +1,23 @@
+package correlation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRandomSourceFailure(t *testing.T) {
+	defer func(read func([]byte) (int, error)) { randRead = read }(randRead)
+	randRead = func([]byte) (int, error) { return 0, errors.New("no entropy") }
+
+	// the IDs are still valid and unique without the random source.
+	ids := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := New()
+		require.Regexp(t, validIDRe, id)
+		require.Len(t, id, 16)
+		require.False(t, ids[id], "duplicated ID %s", id)
+		ids[id] = true
+	}
+}
@@ -0,0 +1,87 @@
+package cosmosaccount
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	// AddressCodecBech32 is the name of the bech32 address codec used by default by Cosmos SDK chains.
+	AddressCodecBech32 = "bech32"
+
+	// AddressCodecHex is the name of the hex address codec used by EVM compatible chains.
+	AddressCodecHex = "hex"
+
+	hexAddressPrefix = "0x"
+)
+
+// AddressCodec encodes and decodes the bytes of the account addresses.
+type AddressCodec interface {
+	// EncodeAddress returns the string representation of the address bytes.
+	EncodeAddress(addr []byte) (string, error)
+
+	// DecodeAddress returns the address bytes of the string representation.
+	DecodeAddress(addr string) ([]byte, error)
+}
+
+// Bech32Codec encodes the addresses with bech32 and a human readable prefix.
+type Bech32Codec struct {
+	Prefix string
+}
+
+// NewBech32Codec returns a bech32 address codec using prefix.
+func NewBech32Codec(prefix string) Bech32Codec {
+	if prefix == "" {
+		prefix = AccountPrefixCosmos
+	}
+	return Bech32Codec{Prefix: prefix}
+}
+
+// EncodeAddress implements AddressCodec.
+func (c Bech32Codec) EncodeAddress(addr []byte) (string, error) {
+	return bech32.ConvertAndEncode(c.Prefix, addr)
+}
+
+// DecodeAddress implements AddressCodec.
+func (c Bech32Codec) DecodeAddress(addr string) ([]byte, error) {
+	prefix, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != c.Prefix {
+		return nil, fmt.Errorf("invalid address prefix: expected %s, got %s", c.Prefix, prefix)
+	}
+	return bz, nil
+}
+
+// HexCodec encodes the addresses in hex with a 0x prefix.
+type HexCodec struct{}
+
+// EncodeAddress implements AddressCodec.
+func (HexCodec) EncodeAddress(addr []byte) (string, error) {
+	return hexAddressPrefix + hex.EncodeToString(addr), nil
+}
+
+// DecodeAddress implements AddressCodec.
+func (HexCodec) DecodeAddress(addr string) ([]byte, error) {
+	if !strings.HasPrefix(addr, hexAddressPrefix) {
+		return nil, fmt.Errorf("invalid address %s: missing %s prefix", addr, hexAddressPrefix)
+	}
+	return hex.DecodeString(strings.TrimPrefix(addr, hexAddressPrefix))
+}
+
+// NewAddressCodec returns the address codec with name,
+// prefix is the human readable prefix used by the bech32 codec.
+func NewAddressCodec(name, prefix string) (AddressCodec, error) {
+	switch name {
+	case "", AddressCodecBech32:
+		return NewBech32Codec(prefix), nil
+	case AddressCodecHex:
+		return HexCodec{}, nil
+	default:
+		return nil, fmt.Errorf("unknown address codec %s, use %s or %s", name, AddressCodecBech32, AddressCodecHex)
+	}
+}
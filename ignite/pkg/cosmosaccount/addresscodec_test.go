@@ -0,0 +1,61 @@
+package cosmosaccount_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestAddressCodec(t *testing.T) {
+	addr := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a}
+
+	tests := []struct {
+		name    string
+		codec   string
+		prefix  string
+		encoded string
+	}{
+		{
+			name:    "bech32 with default prefix",
+			codec:   cosmosaccount.AddressCodecBech32,
+			encoded: "cosmos1qypqxpq9qcrsszg2789qmz",
+		},
+		{
+			name:    "bech32 with custom prefix",
+			codec:   cosmosaccount.AddressCodecBech32,
+			prefix:  "mars",
+			encoded: "mars1qypqxpq9qcrsszg2lmpd87",
+		},
+		{
+			name:    "hex",
+			codec:   cosmosaccount.AddressCodecHex,
+			encoded: "0x0102030405060708090a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := cosmosaccount.NewAddressCodec(tt.codec, tt.prefix)
+			require.NoError(t, err)
+
+			encoded, err := codec.EncodeAddress(addr)
+			require.NoError(t, err)
+			require.Equal(t, tt.encoded, encoded)
+
+			decoded, err := codec.DecodeAddress(encoded)
+			require.NoError(t, err)
+			require.Equal(t, addr, decoded)
+		})
+	}
+
+	t.Run("bech32 with wrong prefix", func(t *testing.T) {
+		_, err := cosmosaccount.NewBech32Codec("mars").DecodeAddress("cosmos1qypqxpq9qcrsszg2789qmz")
+		require.Error(t, err)
+	})
+
+	t.Run("unknown codec", func(t *testing.T) {
+		_, err := cosmosaccount.NewAddressCodec("base58", "")
+		require.Error(t, err)
+	})
+}
@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	dkeyring "github.com/99designs/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -27,8 +30,17 @@ var KeyringHome = os.ExpandEnv("$HOME/.ignite/accounts")
 
 var (
 	ErrAccountExists = errors.New("account already exists")
+
+	// ErrInvalidNamespace is returned when the namespace can't be used as a directory name.
+	ErrInvalidNamespace = errors.New("namespace must be a valid directory name")
 )
 
+// namespacesDir is the directory within the keyring home holding the namespaced keyrings.
+const namespacesDir = "namespaces"
+
+// movePassphrase encrypts the private keys moved between registries, the keys never leave the memory.
+const movePassphrase = "move"
+
 const (
 	AccountPrefixCosmos = "cosmos"
 )
@@ -47,6 +59,16 @@ const (
 
 	// KeyringMemory is in memory keyring backend, your keys will be stored in application memory.
 	KeyringMemory KeyringBackend = "memory"
+
+	// KeyringLedger is the Ledger keyring backend. With this backend, your keys will be
+	// stored on your Ledger device and the txs signed by it, only the references to the keys
+	// of the device are stored under your app's data dir.
+	KeyringLedger KeyringBackend = "ledger"
+
+	// KeyringRemote is the remote signer keyring backend. With this backend, your keys are
+	// held by a remote signing service and the txs signed by it, only the public keys of the
+	// service are kept in memory.
+	KeyringRemote KeyringBackend = "remote"
 )
 
 // Registry for accounts.
@@ -54,6 +76,21 @@ type Registry struct {
 	homePath           string
 	keyringServiceName string
 	keyringBackend     KeyringBackend
+	namespace          string
+
+	// ledgerAccount and ledgerIndex are the account and the address index of the HD path of
+	// the keys of the Ledger device.
+	ledgerAccount uint32
+	ledgerIndex   uint32
+
+	// coinType, hdAccount and hdIndex are the HD path of the keys derived from the mnemonics,
+	// the coin type of the SDK config is used when coinType is nil.
+	coinType  *uint32
+	hdAccount uint32
+	hdIndex   uint32
+
+	// signer signs the txs of the accounts of the remote keyring backend.
+	signer Signer
 
 	Keyring keyring.Keyring
 }
@@ -79,6 +116,25 @@ func WithKeyringBackend(backend KeyringBackend) Option {
 	}
 }
 
+// WithHDPath sets the HD path of the keys derived from the mnemonics of the accounts created or
+// imported, m/44'/coin type'/account'/0/index. By default, the coin type is the one of the SDK
+// config and both the account and the index are 0.
+func WithHDPath(coinType, account, index uint32) Option {
+	return func(c *Registry) {
+		c.coinType = &coinType
+		c.hdAccount = account
+		c.hdIndex = index
+	}
+}
+
+// WithNamespace isolates the accounts of the registry in namespace,
+// accounts with the same name can exist in different namespaces.
+func WithNamespace(namespace string) Option {
+	return func(c *Registry) {
+		c.namespace = namespace
+	}
+}
+
 // New creates a new registry to manage accounts.
 func New(options ...Option) (Registry, error) {
 	r := Registry{
@@ -91,9 +147,32 @@ func New(options ...Option) (Registry, error) {
 		apply(&r)
 	}
 
+	if r.namespace != "" {
+		if r.namespace != filepath.Base(r.namespace) || r.namespace == "." || r.namespace == ".." {
+			return Registry{}, ErrInvalidNamespace
+		}
+		r.homePath = filepath.Join(r.homePath, namespacesDir, r.namespace)
+		r.keyringServiceName = fmt.Sprintf("%s-%s", r.keyringServiceName, r.namespace)
+	}
+
+	if r.keyringBackend == KeyringRemote {
+		kr, err := newSignerKeyring(r.signer)
+		if err != nil {
+			return Registry{}, err
+		}
+		r.Keyring = kr
+		return r, nil
+	}
+
+	// the references to the keys of a Ledger device hold no secret.
+	backend := r.keyringBackend
+	if backend == KeyringLedger {
+		backend = KeyringTest
+	}
+
 	var err error
 
-	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, os.Stdin)
+	r.Keyring, err = keyring.New(r.keyringServiceName, string(backend), r.homePath, os.Stdin)
 	if err != nil {
 		return Registry{}, err
 	}
@@ -136,6 +215,11 @@ func (a Account) Address(accPrefix string) string {
 	return toBench32(accPrefix, a.Info.GetPubKey().Address())
 }
 
+// EncodedAddress returns the address of the account encoded with the address codec.
+func (a Account) EncodedAddress(codec AddressCodec) (string, error) {
+	return codec.EncodeAddress(a.Info.GetPubKey().Address())
+}
+
 // PubKey returns a public key for account.
 func (a Account) PubKey() string {
 	return a.Info.GetPubKey().String()
@@ -163,6 +247,8 @@ func (r Registry) EnsureDefaultAccount() error {
 }
 
 // Create creates a new account with name.
+// With the Ledger keyring backend, the account is the key of the Ledger device at the HD path of
+// the registry and it has no mnemonic.
 func (r Registry) Create(name string) (acc Account, mnemonic string, err error) {
 	acc, err = r.GetByName(name)
 	if err == nil {
@@ -173,6 +259,11 @@ func (r Registry) Create(name string) (acc Account, mnemonic string, err error)
 		return Account{}, "", err
 	}
 
+	if r.keyringBackend == KeyringLedger {
+		acc, err = r.createLedger(name)
+		return acc, "", err
+	}
+
 	entropySeed, err := bip39.NewEntropy(256)
 	if err != nil {
 		return Account{}, "", err
@@ -212,36 +303,138 @@ func (r Registry) Import(name, secret, passphrase string) (Account, error) {
 	}
 
 	if bip39.IsMnemonicValid(secret) {
-		algo, err := r.algo()
-		if err != nil {
-			return Account{}, err
-		}
-		_, err = r.Keyring.NewAccount(name, secret, passphrase, r.hdPath(), algo)
-		if err != nil {
-			return Account{}, err
-		}
-	} else if err := r.Keyring.ImportPrivKey(name, secret, passphrase); err != nil {
+		return r.ImportMnemonic(name, secret, passphrase, r.CoinType())
+	}
+	if err := r.Keyring.ImportPrivKey(name, secret, passphrase); err != nil {
+		return Account{}, err
+	}
+
+	return r.GetByName(name)
+}
+
+// ImportMnemonic imports an existing account with name from a mnemonic, its BIP39 passphrase and
+// the coin type of its HD path, the account and the index of the path are the ones of the registry.
+// Use DeriveKeys to preview the addresses of the coin types.
+func (r Registry) ImportMnemonic(name, mnemonic, bip39Passphrase string, coinType uint32) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return Account{}, err
+	}
+
+	algo, err := r.algo()
+	if err != nil {
+		return Account{}, err
+	}
+	hdPath := hd.CreateHDPath(coinType, r.hdAccount, r.hdIndex).String()
+	if _, err := r.Keyring.NewAccount(name, mnemonic, bip39Passphrase, hdPath, algo); err != nil {
 		return Account{}, err
 	}
 
 	return r.GetByName(name)
 }
 
+// CreateMultisig creates a new multisig account with name, the txs of the account are signed by
+// threshold keys out of pubKeys. The account holds no private key, its txs are signed by the
+// accounts of the keys.
+func (r Registry) CreateMultisig(name string, threshold int, pubKeys []cryptotypes.PubKey) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	if threshold <= 0 || threshold > len(pubKeys) {
+		return Account{}, fmt.Errorf("invalid threshold %d for %d keys", threshold, len(pubKeys))
+	}
+
+	info, err := r.Keyring.SaveMultisig(name, multisig.NewLegacyAminoPubKey(threshold, pubKeys))
+	if err != nil {
+		return Account{}, err
+	}
+
+	return Account{
+		Name: name,
+		Info: info,
+	}, nil
+}
+
 // Export exports an account as a private key.
 func (r Registry) Export(name, passphrase string) (key string, err error) {
-	if _, err = r.GetByName(name); err != nil {
+	acc, err := r.GetByName(name)
+	if err != nil {
 		return "", err
 	}
+	if acc.IsLedger() {
+		return "", ErrLedgerExport
+	}
 
 	return r.Keyring.ExportPrivKeyArmor(name, passphrase)
 
 }
 
+// Move moves the account with name to the registry to.
+func (r Registry) Move(name string, to Registry) (Account, error) {
+	armored, err := r.Export(name, movePassphrase)
+	if err != nil {
+		return Account{}, err
+	}
+
+	acc, err := to.Import(name, armored, movePassphrase)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return acc, r.DeleteByName(name)
+}
+
+// Rename renames the account with name to newName.
+func (r Registry) Rename(name, newName string) (Account, error) {
+	if _, err := r.GetByName(newName); err == nil {
+		return Account{}, ErrAccountExists
+	}
+
+	armored, err := r.Export(name, movePassphrase)
+	if err != nil {
+		return Account{}, err
+	}
+
+	// the keyring doesn't hold the same key twice, the account is deleted before its import
+	// under the new name and restored when the import fails.
+	if err := r.DeleteByName(name); err != nil {
+		return Account{}, err
+	}
+
+	acc, err := r.Import(newName, armored, movePassphrase)
+	if err != nil {
+		if _, errRestore := r.Import(name, armored, movePassphrase); errRestore != nil {
+			return Account{}, fmt.Errorf("%w, cannot restore the account %q: %v", err, name, errRestore)
+		}
+		return Account{}, err
+	}
+
+	return acc, nil
+}
+
 // ExportHex exports an account as a private key in hex.
 func (r Registry) ExportHex(name, passphrase string) (hex string, err error) {
-	if _, err = r.GetByName(name); err != nil {
+	acc, err := r.GetByName(name)
+	if err != nil {
 		return "", err
 	}
+	if acc.IsLedger() {
+		return "", ErrLedgerExport
+	}
 
 	return keyring.NewUnsafe(r.Keyring).UnsafeExportPrivKeyHex(name)
 }
@@ -292,8 +485,16 @@ func (r Registry) DeleteByName(name string) error {
 	return err
 }
 
+// CoinType returns the coin type of the HD path of the keys of the registry.
+func (r Registry) CoinType() uint32 {
+	if r.coinType != nil {
+		return *r.coinType
+	}
+	return sdktypes.GetConfig().GetCoinType()
+}
+
 func (r Registry) hdPath() string {
-	return hd.CreateHDPath(sdktypes.GetConfig().GetCoinType(), 0, 0).String()
+	return hd.CreateHDPath(r.CoinType(), r.hdAccount, r.hdIndex).String()
 }
 
 func (r Registry) algo() (keyring.SignatureAlgo, error) {
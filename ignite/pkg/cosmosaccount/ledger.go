@@ -0,0 +1,56 @@
+package cosmosaccount
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrLedgerExport is returned when the private key of a Ledger account is exported, it never
+// leaves the device.
+var ErrLedgerExport = errors.New("the private key of a Ledger account can't be exported")
+
+// WithLedgerHDPath sets the account and the address index of the HD path of the keys of the
+// Ledger device, m/44'/coin type'/account'/0/index. By default, both are 0.
+func WithLedgerHDPath(account, index uint32) Option {
+	return func(c *Registry) {
+		c.ledgerAccount = account
+		c.ledgerIndex = index
+	}
+}
+
+// IsLedger returns true when the account is a key of a Ledger device.
+func (a Account) IsLedger() bool {
+	return a.Info.GetType() == keyring.TypeLedger
+}
+
+// createLedger saves the reference to the key of the Ledger device at the HD path of the
+// registry. The device must be connected and unlocked, with the Cosmos app opened.
+//
+// The Ledger devices are supported by the executables built with the ledger build tag.
+func (r Registry) createLedger(name string) (Account, error) {
+	algo, err := r.algo()
+	if err != nil {
+		return Account{}, err
+	}
+
+	config := sdktypes.GetConfig()
+	info, err := r.Keyring.SaveLedgerKey(
+		name,
+		algo,
+		config.GetBech32AccountAddrPrefix(),
+		r.CoinType(),
+		r.ledgerAccount,
+		r.ledgerIndex,
+	)
+	if err != nil {
+		return Account{}, fmt.Errorf("cannot read the key of the Ledger device: %w", err)
+	}
+
+	return Account{
+		Name: name,
+		Info: info,
+	}, nil
+}
@@ -0,0 +1,223 @@
+package cosmosaccount
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// maxWordSuggestions is the maximum number of words suggested for a misspelled word.
+	maxWordSuggestions = 3
+
+	// maxWordDistance is the maximum edit distance between a misspelled word and its suggestions.
+	maxWordDistance = 2
+
+	// wordPrefixLen is the length of the prefix identifying a word of the BIP39 wordlist.
+	wordPrefixLen = 4
+)
+
+// CommonCoinTypes are the coin types of the derivation preview, the Cosmos coin type first.
+var CommonCoinTypes = []uint32{sdktypes.CoinType, 60, 330, 459}
+
+// mnemonicWordCounts are the numbers of words of a valid BIP39 mnemonic.
+var mnemonicWordCounts = []int{12, 15, 18, 21, 24}
+
+// InvalidWord is a word of a mnemonic missing from the BIP39 wordlist.
+type InvalidWord struct {
+	// Index is the position of the word in the mnemonic, starting at 1.
+	Index int
+
+	// Word is the invalid word.
+	Word string
+
+	// Suggestions are the closest words of the BIP39 wordlist, the closest first.
+	Suggestions []string
+}
+
+// InvalidMnemonicError is returned when a mnemonic is not a valid BIP39 mnemonic.
+type InvalidMnemonicError struct {
+	// WordCount is the number of words of the mnemonic.
+	WordCount int
+
+	// InvalidWords are the words missing from the BIP39 wordlist.
+	InvalidWords []InvalidWord
+
+	// InvalidChecksum is true when all the words are valid but the checksum of the mnemonic
+	// doesn't match, a word is missing, misplaced or mistyped into another word of the wordlist.
+	InvalidChecksum bool
+}
+
+func (e *InvalidMnemonicError) Error() string {
+	var problems []string
+
+	if !isValidWordCount(e.WordCount) {
+		problems = append(problems, fmt.Sprintf(
+			"the mnemonic has %d words instead of 12, 15, 18, 21 or 24 words",
+			e.WordCount,
+		))
+	}
+
+	for _, w := range e.InvalidWords {
+		problem := fmt.Sprintf("word %d %q is not in the BIP39 wordlist", w.Index, w.Word)
+		if len(w.Suggestions) > 0 {
+			problem += fmt.Sprintf(", did you mean %s?", strings.Join(w.Suggestions, ", "))
+		}
+		problems = append(problems, problem)
+	}
+
+	if e.InvalidChecksum {
+		problems = append(problems, "the checksum of the mnemonic doesn't match, a word is missing, misplaced or mistyped")
+	}
+
+	return fmt.Sprintf("invalid mnemonic:\n- %s", strings.Join(problems, "\n- "))
+}
+
+// NormalizeMnemonic returns the mnemonic in lowercase with its words separated by a single space.
+func NormalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+}
+
+// ValidateMnemonic returns an *InvalidMnemonicError describing the problems of the mnemonic
+// if it's not a valid BIP39 mnemonic. The mnemonic is expected to be normalized.
+func ValidateMnemonic(mnemonic string) error {
+	words := strings.Fields(mnemonic)
+	mnemonicErr := &InvalidMnemonicError{WordCount: len(words)}
+
+	for i, word := range words {
+		if _, ok := bip39.ReverseWordMap[word]; !ok {
+			mnemonicErr.InvalidWords = append(mnemonicErr.InvalidWords, InvalidWord{
+				Index:       i + 1,
+				Word:        word,
+				Suggestions: suggestWords(word),
+			})
+		}
+	}
+
+	if !isValidWordCount(len(words)) || len(mnemonicErr.InvalidWords) > 0 {
+		return mnemonicErr
+	}
+
+	// the checksum is only verified by the conversion of the mnemonic to its entropy.
+	if _, err := bip39.MnemonicToByteArray(strings.Join(words, " ")); err != nil {
+		mnemonicErr.InvalidChecksum = true
+		return mnemonicErr
+	}
+
+	return nil
+}
+
+// DerivedKey is a key derived from a mnemonic.
+type DerivedKey struct {
+	// CoinType is the coin type of the HD path of the key.
+	CoinType uint32
+
+	// HDPath is the HD path of the key.
+	HDPath string
+
+	// PubKey is the public key of the key.
+	PubKey cryptotypes.PubKey
+}
+
+// EncodedAddress returns the address of the key encoded with codec.
+func (k DerivedKey) EncodedAddress(codec AddressCodec) (string, error) {
+	return codec.EncodeAddress(k.PubKey.Address())
+}
+
+// DeriveKeys derives the secp256k1 keys of the first account of the mnemonic for each of the coin
+// types. The BIP39 passphrase, also known as the 25th word, is optional.
+func DeriveKeys(mnemonic, bip39Passphrase string, coinTypes ...uint32) ([]DerivedKey, error) {
+	keys := make([]DerivedKey, 0, len(coinTypes))
+	for _, coinType := range coinTypes {
+		hdPath := hd.CreateHDPath(coinType, 0, 0).String()
+
+		derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, bip39Passphrase, hdPath)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, DerivedKey{
+			CoinType: coinType,
+			HDPath:   hdPath,
+			PubKey:   hd.Secp256k1.Generate()(derivedPriv).PubKey(),
+		})
+	}
+	return keys, nil
+}
+
+func isValidWordCount(count int) bool {
+	for _, c := range mnemonicWordCounts {
+		if c == count {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestWords returns the words of the BIP39 wordlist closest to word. The words of the wordlist
+// are identified by their first four letters, so the words with the same prefix are suggested first.
+func suggestWords(word string) []string {
+	type suggestion struct {
+		word     string
+		distance int
+	}
+
+	var suggestions []suggestion
+	for _, candidate := range bip39.WordList {
+		distance := editDistance(word, candidate)
+		if len(word) >= wordPrefixLen && strings.HasPrefix(candidate, word[:wordPrefixLen]) {
+			distance = 0
+		}
+		if distance <= maxWordDistance {
+			suggestions = append(suggestions, suggestion{candidate, distance})
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].distance < suggestions[j].distance
+	})
+
+	var words []string
+	for i := 0; i < len(suggestions) && i < maxWordSuggestions; i++ {
+		words = append(words, suggestions[i].word)
+	}
+	return words
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,71 @@
+package cosmosaccount_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestValidateMnemonic(t *testing.T) {
+	require.NoError(t, cosmosaccount.ValidateMnemonic(testMnemonic))
+
+	var mnemonicErr *cosmosaccount.InvalidMnemonicError
+
+	err := cosmosaccount.ValidateMnemonic(strings.Replace(testMnemonic, "about", "abuot", 1))
+	require.True(t, errors.As(err, &mnemonicErr))
+	require.Len(t, mnemonicErr.InvalidWords, 1)
+	require.Equal(t, 12, mnemonicErr.InvalidWords[0].Index)
+	require.Equal(t, "abuot", mnemonicErr.InvalidWords[0].Word)
+	require.Contains(t, mnemonicErr.InvalidWords[0].Suggestions, "about")
+	require.Contains(t, err.Error(), `word 12 "abuot" is not in the BIP39 wordlist, did you mean`)
+
+	err = cosmosaccount.ValidateMnemonic(strings.TrimSuffix(testMnemonic, " about"))
+	require.True(t, errors.As(err, &mnemonicErr))
+	require.Equal(t, 11, mnemonicErr.WordCount)
+	require.Contains(t, err.Error(), "has 11 words")
+
+	err = cosmosaccount.ValidateMnemonic(strings.Replace(testMnemonic, "about", "abandon", 1))
+	require.True(t, errors.As(err, &mnemonicErr))
+	require.True(t, mnemonicErr.InvalidChecksum)
+}
+
+func TestNormalizeMnemonic(t *testing.T) {
+	require.Equal(t, "abandon about", cosmosaccount.NormalizeMnemonic("  Abandon\n ABOUT "))
+}
+
+func TestDeriveKeys(t *testing.T) {
+	keys, err := cosmosaccount.DeriveKeys(testMnemonic, "", 118, 60)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, "m/44'/118'/0'/0/0", keys[0].HDPath)
+	require.Equal(t, "m/44'/60'/0'/0/0", keys[1].HDPath)
+
+	address, err := keys[0].EncodedAddress(cosmosaccount.NewBech32Codec("cosmos"))
+	require.NoError(t, err)
+	require.Equal(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", address)
+
+	withPassphrase, err := cosmosaccount.DeriveKeys(testMnemonic, "secret", 118)
+	require.NoError(t, err)
+	require.NotEqual(t, keys[0].PubKey, withPassphrase[0].PubKey)
+}
+
+func TestImportMnemonicHDPath(t *testing.T) {
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	account, err := r.ImportMnemonic("alice", testMnemonic, "", 118)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", account.Address("cosmos"))
+
+	r, err = cosmosaccount.NewInMemory(cosmosaccount.WithHDPath(118, 0, 1))
+	require.NoError(t, err)
+	require.EqualValues(t, 118, r.CoinType())
+	account, err = r.Import("alice", testMnemonic, "")
+	require.NoError(t, err)
+	require.NotEqual(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", account.Address("cosmos"))
+}
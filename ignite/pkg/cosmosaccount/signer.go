@@ -0,0 +1,247 @@
+package cosmosaccount
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrRemoteSignerKeys is returned when the keys of a remote signer are created, imported or
+// deleted, they're managed by the signing service.
+var ErrRemoteSignerKeys = errors.New("the keys of a remote signer are managed by the signing service")
+
+// ErrNoRemoteSigner is returned when the remote keyring backend is used without signer.
+var ErrNoRemoteSigner = errors.New("the remote keyring backend needs a remote signer")
+
+// defaultRemoteSignerTimeout is the timeout of the requests to a remote signer.
+const defaultRemoteSignerTimeout = 30 * time.Second
+
+// Signer signs the txs with keys held outside of the registry, by a remote signing service
+// like a KMS. The private keys never reach the registry.
+type Signer interface {
+	// PubKeys returns the public keys of the accounts of the signer by account name.
+	PubKeys(ctx context.Context) (map[string]cryptotypes.PubKey, error)
+
+	// Sign signs msg with the key of the account name.
+	Sign(ctx context.Context, name string, msg []byte) ([]byte, error)
+}
+
+// WithSigner signs the txs of the accounts with signer, the accounts of the registry are the
+// accounts of the signer. It sets the remote keyring backend.
+func WithSigner(signer Signer) Option {
+	return func(c *Registry) {
+		c.keyringBackend = KeyringRemote
+		c.signer = signer
+	}
+}
+
+// newSignerKeyring returns a keyring holding the public keys of the accounts of signer and
+// signing with it.
+func newSignerKeyring(signer Signer) (keyring.Keyring, error) {
+	if signer == nil {
+		return nil, ErrNoRemoteSigner
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRemoteSignerTimeout)
+	defer cancel()
+
+	pubKeys, err := signer.PubKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch the keys of the remote signer: %w", err)
+	}
+
+	kr := keyring.NewInMemory()
+	for name, pubKey := range pubKeys {
+		if _, err := kr.SavePubKey(name, pubKey, hd.Secp256k1Type); err != nil {
+			return nil, err
+		}
+	}
+	return signerKeyring{Keyring: kr, signer: signer}, nil
+}
+
+// signerKeyring is a keyring signing with a remote signer, the keyring holds only the public
+// keys of the signer.
+type signerKeyring struct {
+	keyring.Keyring
+	signer Signer
+}
+
+// Sign signs msg with the remote signer and verifies the signature with the public key of the
+// account.
+func (k signerKeyring) Sign(uid string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	info, err := k.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRemoteSignerTimeout)
+	defer cancel()
+
+	sig, err := k.signer.Sign(ctx, uid, msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("the remote signer cannot sign with %s: %w", uid, err)
+	}
+	if !info.GetPubKey().VerifySignature(msg, sig) {
+		return nil, nil, fmt.Errorf("the remote signer returned an invalid signature for %s", uid)
+	}
+	return sig, info.GetPubKey(), nil
+}
+
+// SignByAddress signs msg with the key of the account of address.
+func (k signerKeyring) SignByAddress(address sdktypes.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	info, err := k.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k.Sign(info.GetName(), msg)
+}
+
+func (k signerKeyring) NewMnemonic(string, keyring.Language, string, string, keyring.SignatureAlgo) (keyring.Info, string, error) {
+	return nil, "", ErrRemoteSignerKeys
+}
+
+func (k signerKeyring) NewAccount(string, string, string, string, keyring.SignatureAlgo) (keyring.Info, error) {
+	return nil, ErrRemoteSignerKeys
+}
+
+func (k signerKeyring) SaveLedgerKey(string, keyring.SignatureAlgo, string, uint32, uint32, uint32) (keyring.Info, error) {
+	return nil, ErrRemoteSignerKeys
+}
+
+func (k signerKeyring) ImportPrivKey(string, string, string) error {
+	return ErrRemoteSignerKeys
+}
+
+func (k signerKeyring) Delete(string) error {
+	return ErrRemoteSignerKeys
+}
+
+func (k signerKeyring) DeleteByAddress(sdktypes.Address) error {
+	return ErrRemoteSignerKeys
+}
+
+// RemoteSigner is a signer backed by a signing service over HTTP. The service lists its keys
+// with GET /keys:
+//
+//	{"keys": [{"name": "alice", "pub_key": "<base64 compressed secp256k1 public key>"}]}
+//
+// and signs the bytes of a tx with POST /sign {"name": "alice", "sign_bytes": "<base64>"}:
+//
+//	{"signature": "<base64 secp256k1 signature>"}
+type RemoteSigner struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// RemoteSignerOption configures a remote signer.
+type RemoteSignerOption func(*RemoteSigner)
+
+// WithRemoteSignerToken authenticates the requests to the signing service with a bearer token.
+func WithRemoteSignerToken(token string) RemoteSignerOption {
+	return func(s *RemoteSigner) {
+		s.token = token
+	}
+}
+
+// WithRemoteSignerHTTPClient sets the HTTP client of the requests to the signing service.
+func WithRemoteSignerHTTPClient(client *http.Client) RemoteSignerOption {
+	return func(s *RemoteSigner) {
+		s.client = client
+	}
+}
+
+// NewRemoteSigner returns a signer backed by the signing service at url.
+func NewRemoteSigner(url string, options ...RemoteSignerOption) RemoteSigner {
+	s := RemoteSigner{
+		url:    strings.TrimSuffix(url, "/"),
+		client: http.DefaultClient,
+	}
+	for _, apply := range options {
+		apply(&s)
+	}
+	return s
+}
+
+type remoteSignerKeysResponse struct {
+	Keys []struct {
+		Name   string `json:"name"`
+		PubKey []byte `json:"pub_key"`
+	} `json:"keys"`
+}
+
+type remoteSignerSignRequest struct {
+	Name      string `json:"name"`
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+type remoteSignerSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// PubKeys implements Signer.
+func (s RemoteSigner) PubKeys(ctx context.Context) (map[string]cryptotypes.PubKey, error) {
+	var res remoteSignerKeysResponse
+	if err := s.do(ctx, http.MethodGet, "/keys", nil, &res); err != nil {
+		return nil, err
+	}
+
+	pubKeys := make(map[string]cryptotypes.PubKey)
+	for _, key := range res.Keys {
+		if len(key.PubKey) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("the public key of %s is not a compressed secp256k1 key", key.Name)
+		}
+		pubKeys[key.Name] = &secp256k1.PubKey{Key: key.PubKey}
+	}
+	return pubKeys, nil
+}
+
+// Sign implements Signer.
+func (s RemoteSigner) Sign(ctx context.Context, name string, msg []byte) ([]byte, error) {
+	var res remoteSignerSignResponse
+	req := remoteSignerSignRequest{Name: name, SignBytes: msg}
+	if err := s.do(ctx, http.MethodPost, "/sign", req, &res); err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+func (s RemoteSigner) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.url+path, &reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("the signing service responded %s", res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(result)
+}
@@ -0,0 +1,75 @@
+package cosmosaccount_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	const token = "secret"
+	privKey := secp256k1.GenPrivKey()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/keys":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]interface{}{
+					{"name": "alice", "pub_key": privKey.PubKey().Bytes()},
+				},
+			})
+		case "/sign":
+			var req struct {
+				Name      string `json:"name"`
+				SignBytes []byte `json:"sign_bytes"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, "alice", req.Name)
+			sig, err := privKey.Sign(req.SignBytes)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(map[string]interface{}{"signature": sig})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	signer := cosmosaccount.NewRemoteSigner(server.URL, cosmosaccount.WithRemoteSignerToken(token))
+	r, err := cosmosaccount.New(cosmosaccount.WithSigner(signer))
+	require.NoError(t, err)
+
+	// the accounts of the registry are the keys of the signing service
+	account, err := r.GetByName("alice")
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey().Address().Bytes(), account.Info.GetAddress().Bytes())
+
+	msg := []byte("tx")
+	sig, pubKey, err := r.Keyring.Sign("alice", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	sig, _, err = r.Keyring.SignByAddress(account.Info.GetAddress(), msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+
+	// the keys are managed by the signing service
+	_, _, err = r.Create("bob")
+	require.ErrorIs(t, err, cosmosaccount.ErrRemoteSignerKeys)
+	require.ErrorIs(t, r.DeleteByName("alice"), cosmosaccount.ErrRemoteSignerKeys)
+
+	_, err = cosmosaccount.New(cosmosaccount.WithSigner(cosmosaccount.NewRemoteSigner(server.URL)))
+	require.Error(t, err)
+
+	_, err = cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringRemote))
+	require.ErrorIs(t, err, cosmosaccount.ErrNoRemoteSigner)
+}
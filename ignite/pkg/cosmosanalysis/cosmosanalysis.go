@@ -9,6 +9,7 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/pkg/errors"
 	"golang.org/x/mod/modfile"
@@ -75,6 +76,9 @@ func FindImplementation(modulePath string, interfaceList []string) (found []stri
 		found = append(found, findImplementationInFiles(files, interfaceList)...)
 	}
 
+	// sort the types to not depend on the iteration order of the parsed packages.
+	sort.Strings(found)
+
 	return found, nil
 }
 
@@ -93,9 +93,7 @@ func TestFindImplementation(t *testing.T) {
 	// find in dir
 	found, err := cosmosanalysis.FindImplementation(tmpDir, expectedinterface)
 	require.NoError(t, err)
-	require.Len(t, found, 2)
-	require.Contains(t, found, "Foo")
-	require.Contains(t, found, "Foobar")
+	require.Equal(t, []string{"Foo", "Foobar"}, found)
 
 	// empty directory
 	emptyDir := t.TempDir()
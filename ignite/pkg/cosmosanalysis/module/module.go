@@ -46,6 +46,12 @@ type Msg struct {
 
 	// FilePath is the path of the .proto file where message is defined at.
 	FilePath string
+
+	// Int64Fields is the list of the singular 64-bit integer fields of the message.
+	Int64Fields []string
+
+	// Fields is the list of the fields of the message.
+	Fields []protoanalysis.Field
 }
 
 // HTTPQuery is an sdk Query.
@@ -66,6 +72,12 @@ type Type struct {
 
 	// FilePath is the path of the .proto file where message is defined at.
 	FilePath string
+
+	// Int64Fields is the list of the singular 64-bit integer fields of the message.
+	Int64Fields []string
+
+	// Fields is the list of the fields of the message.
+	Fields []protoanalysis.Field
 }
 
 type moduleDiscoverer struct {
@@ -182,9 +194,11 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 		}
 
 		m.Msgs = append(m.Msgs, Msg{
-			Name:     msg,
-			URI:      fmt.Sprintf("%s.%s", pkg.Name, msg),
-			FilePath: pkgmsg.Path,
+			Name:        msg,
+			URI:         fmt.Sprintf("%s.%s", pkg.Name, msg),
+			FilePath:    pkgmsg.Path,
+			Int64Fields: pkgmsg.Int64Fields,
+			Fields:      pkgmsg.Fields,
 		})
 	}
 
@@ -221,8 +235,10 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 		}
 
 		m.Types = append(m.Types, Type{
-			Name:     protomsg.Name,
-			FilePath: protomsg.Path,
+			Name:        protomsg.Name,
+			FilePath:    protomsg.Path,
+			Int64Fields: protomsg.Int64Fields,
+			Fields:      protomsg.Fields,
 		})
 	}
 
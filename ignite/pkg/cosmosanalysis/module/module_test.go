@@ -18,12 +18,18 @@ var testModule = Module{
 		Files:        protoanalysis.Files{protoanalysis.File{Path: "testdata/planet/proto/planet/planet.proto", Dependencies: []string{"google/api/annotations.proto"}}},
 		GoImportName: "github.com/tendermint/planet/x/planet/types",
 		Messages: []protoanalysis.Message{
-			{Name: "QueryMyQueryRequest", Path: "testdata/planet/proto/planet/planet.proto", HighestFieldNumber: 1},
+			{
+				Name:               "QueryMyQueryRequest",
+				Path:               "testdata/planet/proto/planet/planet.proto",
+				HighestFieldNumber: 1,
+				Fields:             []protoanalysis.Field{{Name: "mytypefield", Number: 1, Type: "string"}},
+			},
 			{Name: "QueryMyQueryResponse", Path: "testdata/planet/proto/planet/planet.proto", HighestFieldNumber: 0},
 		},
 		Services: []protoanalysis.Service{
 			{
 				Name: "Query",
+				Path: "testdata/planet/proto/planet/planet.proto",
 				RPCFuncs: []protoanalysis.RPCFunc{
 					{
 						Name:        "MyQuery",
@@ -31,6 +37,7 @@ var testModule = Module{
 						ReturnsType: "QueryMyQueryResponse",
 						HTTPRules: []protoanalysis.HTTPRule{
 							{
+								Endpoint: "GET /tendermint/planet/withoutmsg/my_query/{mytypefield}",
 								Params:   []string{"mytypefield"},
 								HasQuery: false, HasBody: false},
 						},
@@ -46,6 +53,7 @@ var testModule = Module{
 			FullName: "QueryMyQuery",
 			Rules: []protoanalysis.HTTPRule{
 				{
+					Endpoint: "GET /tendermint/planet/withoutmsg/my_query/{mytypefield}",
 					Params:   []string{"mytypefield"},
 					HasQuery: false,
 					HasBody:  false},
@@ -0,0 +1,329 @@
+// Package sourcechange classifies the changes of the source code of a Cosmos SDK app by their
+// impact on the state of a running chain, to tell if the chain can be restarted with its state.
+package sourcechange
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Kind is the kind of a change, the kinds are ordered by their impact on the state.
+type Kind int
+
+const (
+	// KindQuery is a change of the code not running in the state machine, like the queries and
+	// the commands, the chain restarts with its state.
+	KindQuery Kind = iota + 1
+
+	// KindLogic is a change of the logic of the state machine that keeps the layout of the
+	// stored data, like the handlers of the messages, the chain restarts with its state.
+	KindLogic
+
+	// KindParams is a change of the default params of a module, the params of the state of the
+	// chain are replaced by the new defaults.
+	KindParams
+
+	// KindStore is a change of the layout of the stored data, like the store keys, the encoding
+	// of the messages or the genesis of a module, the state of the chain must be reset.
+	KindStore
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindQuery:
+		return "query"
+	case KindLogic:
+		return "logic"
+	case KindParams:
+		return "params"
+	case KindStore:
+		return "store"
+	default:
+		return "none"
+	}
+}
+
+// Change is the change of a declaration of a source file.
+type Change struct {
+	Kind Kind
+
+	// File is the path of the file relative to the app.
+	File string
+
+	// Decl is the name of the declaration, e.g. Keeper.SetPost or DefaultParams, it's empty when
+	// the file is compared as a whole.
+	Decl string
+}
+
+// Module returns the name of the module of the changed file, it's empty for the files outside
+// of the modules.
+func (c Change) Module() string {
+	parts := strings.Split(c.File, "/")
+	if len(parts) > 2 && parts[0] == modulesDir {
+		return parts[1]
+	}
+	return ""
+}
+
+func (c Change) String() string {
+	if c.Decl == "" {
+		return c.File
+	}
+	return fmt.Sprintf("%s: %s", c.File, c.Decl)
+}
+
+// Snapshot holds the checksums of the declarations of the source files of an app, keyed by the
+// path of the file and the name of the declaration.
+type Snapshot map[string]string
+
+const (
+	// modulesDir is the dir of the modules of the app.
+	modulesDir = "x"
+
+	// declSeparator separates the path of a file and the name of a declaration in the keys of a
+	// snapshot.
+	declSeparator = "#"
+)
+
+// ignoredSuffixes are the generated files, their sources are compared instead.
+var ignoredSuffixes = []string{".pb.go", ".pb.gw.go"}
+
+// Take takes the snapshot of the Go and proto files in the dirs of the app at root. The
+// declarations of the Go files are compared separately and their comments are ignored.
+func Take(root string, dirs ...string) (Snapshot, error) {
+	s := make(Snapshot)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(filepath.Join(root, dir), func(p string, d fs.DirEntry, err error) error {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if p != filepath.Join(root, dir) && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !isSourceFile(d.Name()) {
+				return nil
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			content, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			s.add(filepath.ToSlash(rel), content)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// isSourceFile returns true for the Go and proto files that aren't generated.
+func isSourceFile(name string) bool {
+	for _, suffix := range ignoredSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".proto")
+}
+
+// add adds the checksums of the declarations of a file, the files that aren't valid Go files
+// are added as a whole.
+func (s Snapshot) add(file string, content []byte) {
+	if strings.HasSuffix(file, ".go") {
+		fset := token.NewFileSet()
+		if f, err := parser.ParseFile(fset, "", content, 0); err == nil {
+			for name, node := range decls(f) {
+				var b bytes.Buffer
+				if err := printer.Fprint(&b, fset, node); err == nil {
+					s[file+declSeparator+name] = checksum(b.Bytes())
+				}
+			}
+			return
+		}
+	}
+	s[file+declSeparator] = checksum(content)
+}
+
+// decls returns the declarations of the file by name, the methods are named after their receiver,
+// e.g. Keeper.SetPost.
+func decls(f *ast.File) map[string]ast.Node {
+	nodes := make(map[string]ast.Node)
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				name = receiverName(d.Recv.List[0].Type) + "." + name
+			}
+			nodes[name] = d
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				continue
+			}
+			for _, spec := range d.Specs {
+				switch sp := spec.(type) {
+				case *ast.TypeSpec:
+					nodes[sp.Name.Name] = sp
+				case *ast.ValueSpec:
+					for _, n := range sp.Names {
+						nodes[n.Name] = sp
+					}
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+// receiverName returns the name of the type of a receiver.
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	default:
+		return ""
+	}
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Compare returns the changes of the declarations from the old snapshot to the new one, sorted by
+// file and declaration.
+func Compare(old, new Snapshot) []Change {
+	keys := make(map[string]bool)
+	for key, sum := range new {
+		if old[key] != sum {
+			keys[key] = true
+		}
+	}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			keys[key] = true
+		}
+	}
+
+	changes := make([]Change, 0, len(keys))
+	for key := range keys {
+		file, decl, _ := strings.Cut(key, declSeparator)
+		changes = append(changes, Change{
+			Kind: classify(file, decl),
+			File: file,
+			Decl: decl,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].File != changes[j].File {
+			return changes[i].File < changes[j].File
+		}
+		return changes[i].Decl < changes[j].Decl
+	})
+	return changes
+}
+
+// Widest returns the first change with the widest impact on the state, ok is false when there
+// isn't any change.
+func Widest(changes []Change) (widest Change, ok bool) {
+	for _, change := range changes {
+		if change.Kind > widest.Kind {
+			widest = change
+		}
+	}
+	return widest, len(changes) > 0
+}
+
+// classify returns the kind of the change of the declaration of a file, the files follow the
+// layout of the apps scaffolded with Ignite.
+func classify(file, decl string) Kind {
+	var (
+		base   = path.Base(file)
+		parts  = strings.Split(file, "/")
+		module = len(parts) > 2 && parts[0] == modulesDir
+		// dir is the dir of the file in its module, e.g. keeper.
+		dir = ""
+	)
+	if module && len(parts) > 3 {
+		dir = parts[2]
+	}
+
+	switch {
+	case strings.HasSuffix(file, ".proto"):
+		switch base {
+		case "query.proto":
+			return KindQuery
+		case "tx.proto":
+			return KindLogic
+		}
+		// the other messages are stored or imported with the genesis.
+		return KindStore
+
+	case parts[0] == "cmd":
+		return KindQuery
+
+	case parts[0] == "app":
+		// the app wires the modules and their store keys.
+		if base == "app.go" {
+			return KindStore
+		}
+		return KindLogic
+
+	case !module:
+		return KindLogic
+
+	case dir == "client":
+		return KindQuery
+
+	case dir == "keeper" && (strings.HasPrefix(base, "grpc_query") || strings.HasPrefix(base, "query")):
+		return KindQuery
+
+	case dir == "types" && base == "params.go":
+		switch {
+		case strings.HasPrefix(decl, "Default"):
+			return KindParams
+		case strings.HasPrefix(decl, "Key") || strings.HasPrefix(decl, "Params.ParamSetPairs") || decl == "ParamKeyTable":
+			// the keys of the params are stored.
+			return KindStore
+		}
+		return KindLogic
+
+	case dir == "types" && strings.HasPrefix(base, "key"):
+		return KindStore
+
+	case base == "genesis.go":
+		return KindStore
+
+	case dir == "" && base == "module.go" && strings.HasSuffix(decl, ".ConsensusVersion"):
+		// the state is migrated.
+		return KindStore
+	}
+	return KindLogic
+}
@@ -0,0 +1,84 @@
+package sourcechange
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, root, file, content string) {
+	t.Helper()
+	p := filepath.Join(root, file)
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+}
+
+func TestCompare(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "x/blog/types/params.go", "package types\n\nconst DefaultMaxTitleLength = 100\n")
+	writeFile(t, root, "x/blog/keeper/grpc_query_post.go", "package keeper\n\nfunc (k Keeper) Post() {}\n")
+	writeFile(t, root, "x/blog/types/query.pb.go", "package types\n")
+	writeFile(t, root, "proto/blog/post.proto", "message Post { string title = 1; }\n")
+
+	old, err := Take(root, "x", "proto", "cmd")
+	require.NoError(t, err)
+
+	// the comments aren't compared.
+	writeFile(t, root, "x/blog/types/params.go", "package types\n\n// DefaultMaxTitleLength is the max length.\nconst DefaultMaxTitleLength = 100\n")
+	writeFile(t, root, "x/blog/types/query.pb.go", "package types\n\nvar _ = 1\n")
+	s, err := Take(root, "x", "proto", "cmd")
+	require.NoError(t, err)
+	require.Empty(t, Compare(old, s))
+	_, ok := Widest(Compare(old, s))
+	require.False(t, ok)
+
+	writeFile(t, root, "x/blog/keeper/grpc_query_post.go", "package keeper\n\nfunc (k *Keeper) Post() { _ = 1 }\n")
+	s, err = Take(root, "x", "proto", "cmd")
+	require.NoError(t, err)
+	require.Equal(t, []Change{{KindQuery, "x/blog/keeper/grpc_query_post.go", "Keeper.Post"}}, Compare(old, s))
+
+	writeFile(t, root, "x/blog/types/params.go", "package types\n\nconst DefaultMaxTitleLength = 200\n")
+	s, err = Take(root, "x", "proto", "cmd")
+	require.NoError(t, err)
+	widest, ok := Widest(Compare(old, s))
+	require.True(t, ok)
+	require.Equal(t, Change{KindParams, "x/blog/types/params.go", "DefaultMaxTitleLength"}, widest)
+	require.Equal(t, "blog", widest.Module())
+
+	writeFile(t, root, "proto/blog/post.proto", "message Post { string title = 1; string body = 2; }\n")
+	s, err = Take(root, "x", "proto", "cmd")
+	require.NoError(t, err)
+	widest, _ = Widest(Compare(old, s))
+	require.Equal(t, Change{Kind: KindStore, File: "proto/blog/post.proto"}, widest)
+}
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		file, decl string
+		kind       Kind
+	}{
+		{"cmd/blogd/main.go", "main", KindQuery},
+		{"x/blog/client/cli/query_post.go", "CmdListPost", KindQuery},
+		{"x/blog/keeper/grpc_query_post.go", "Keeper.PostAll", KindQuery},
+		{"proto/blog/query.proto", "", KindQuery},
+		{"x/blog/keeper/msg_server_post.go", "msgServer.CreatePost", KindLogic},
+		{"proto/blog/tx.proto", "", KindLogic},
+		{"app/export.go", "App.ExportAppStateAndValidators", KindLogic},
+		{"x/blog/types/params.go", "DefaultParams", KindParams},
+		{"x/blog/types/params.go", "KeyMaxTitleLength", KindStore},
+		{"x/blog/types/params.go", "Params.Validate", KindLogic},
+		{"x/blog/types/keys.go", "StoreKey", KindStore},
+		{"x/blog/types/key_post.go", "PostKey", KindStore},
+		{"x/blog/genesis.go", "InitGenesis", KindStore},
+		{"x/blog/types/genesis.go", "DefaultGenesis", KindStore},
+		{"x/blog/module.go", "AppModule.ConsensusVersion", KindStore},
+		{"x/blog/module.go", "AppModule.EndBlock", KindLogic},
+		{"app/app.go", "New", KindStore},
+		{"proto/blog/post.proto", "", KindStore},
+	}
+	for _, tt := range cases {
+		require.Equal(t, tt.kind, classify(tt.file, tt.decl), "%s: %s", tt.file, tt.decl)
+	}
+}
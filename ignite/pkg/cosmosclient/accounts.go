@@ -0,0 +1,67 @@
+package cosmosclient
+
+import (
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// WithAccountRegistry sets the registry of the accounts of the client, e.g. to share the keyring of
+// the accounts between the clients of several chains. When this option is not provided, the registry
+// is created from the keyring options of the client.
+func WithAccountRegistry(registry cosmosaccount.Registry) Option {
+	return func(c *Client) {
+		c.AccountRegistry = registry
+		c.hasAccountRegistry = true
+	}
+}
+
+// WithHDPath sets the HD path of the keys derived from the mnemonics of the accounts created or imported
+// by the client, m/44'/coin type'/account'/0/index. By default, the coin type is the one of the SDK
+// config and both the account and the index are 0.
+func WithHDPath(coinType, account, index uint32) Option {
+	return func(c *Client) {
+		c.accountOptions = append(c.accountOptions, cosmosaccount.WithHDPath(coinType, account, index))
+	}
+}
+
+// AccountAddress returns the address of the account with the address prefix of the chain of the client.
+func (c Client) AccountAddress(accountName string) (string, error) {
+	account, err := c.Account(accountName)
+	if err != nil {
+		return "", err
+	}
+	return account.Address(c.addressPrefix), nil
+}
+
+// Accounts returns the accounts of the keyring of the client.
+func (c Client) Accounts() ([]cosmosaccount.Account, error) {
+	return c.AccountRegistry.List()
+}
+
+// CreateAccount creates an account with name and returns its mnemonic, the key is derived at the HD
+// path of the client.
+func (c Client) CreateAccount(accountName string) (account cosmosaccount.Account, mnemonic string, err error) {
+	return c.AccountRegistry.Create(accountName)
+}
+
+// ImportAccount imports an account with name from a secret, either a mnemonic or an armored private
+// key encrypted with passphrase. The key of a mnemonic is derived at the HD path of the client and the
+// passphrase is its BIP39 passphrase.
+func (c Client) ImportAccount(accountName, secret, passphrase string) (cosmosaccount.Account, error) {
+	return c.AccountRegistry.Import(accountName, secret, passphrase)
+}
+
+// ExportAccount exports the private key of the account armored and encrypted with passphrase.
+func (c Client) ExportAccount(accountName, passphrase string) (key string, err error) {
+	return c.AccountRegistry.Export(accountName, passphrase)
+}
+
+// DeleteAccount deletes the account from the keyring of the client.
+func (c Client) DeleteAccount(accountName string) error {
+	return c.AccountRegistry.DeleteByName(accountName)
+}
+
+// EnsureDefaultAccount creates the default account of the keyring of the client when it doesn't
+// exist yet.
+func (c Client) EnsureDefaultAccount() error {
+	return c.AccountRegistry.EnsureDefaultAccount()
+}
@@ -0,0 +1,44 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestAccounts(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	var c Client
+	WithAccountRegistry(registry)(&c)
+	WithAddressPrefix("mars")(&c)
+
+	account, mnemonic, err := c.CreateAccount("alice")
+	require.NoError(t, err)
+	require.NotEmpty(t, mnemonic)
+
+	address, err := c.AccountAddress("alice")
+	require.NoError(t, err)
+	require.Equal(t, account.Address("mars"), address)
+	require.Regexp(t, "^mars1", address)
+
+	key, err := c.ExportAccount("alice", "secret")
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteAccount("alice"))
+	_, err = c.ImportAccount("alice", key, "secret")
+	require.NoError(t, err)
+
+	accounts, err := c.Accounts()
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.NoError(t, c.DeleteAccount("alice"))
+
+	_, err = c.ImportAccount("bob", mnemonic, "")
+	require.NoError(t, err)
+	bob, err := c.AccountAddress("bob")
+	require.NoError(t, err)
+	require.Equal(t, address, bob, "the key of the mnemonic must be derived at the same HD path")
+}
@@ -0,0 +1,108 @@
+package cosmosclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/pkg/errors"
+)
+
+// BroadcastOption configures the tx to broadcast.
+type BroadcastOption func(*broadcastOptions)
+
+// BroadcastMode defines when the broadcast of a tx returns.
+type BroadcastMode string
+
+const (
+	// BroadcastBlock returns once the tx is committed in a block, it's the default mode.
+	BroadcastBlock BroadcastMode = flags.BroadcastBlock
+
+	// BroadcastSync returns once the tx passed the checks of the node and is added to its mempool,
+	// use WaitForTx to wait for the tx to be committed.
+	BroadcastSync BroadcastMode = flags.BroadcastSync
+
+	// BroadcastAsync returns right after the tx is sent to the node, use WaitForTx to wait for the tx
+	// to be committed.
+	BroadcastAsync BroadcastMode = flags.BroadcastAsync
+)
+
+type broadcastOptions struct {
+	mode                        BroadcastMode
+	gasLimit                    uint64
+	fees                        sdktypes.Coins
+	memo                        string
+	timeoutHeight               uint64
+	extensionOptions            []*codectypes.Any
+	nonCriticalExtensionOptions []*codectypes.Any
+}
+
+// WithBroadcastMode sets when the broadcast of the tx returns, see BroadcastMode.
+func WithBroadcastMode(mode BroadcastMode) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.mode = mode
+	}
+}
+
+// WithGasLimit sets the gas limit of the tx. By default, the broadcasted txs set their gas limit
+// with a simulation and the offline txs use the gas of the factory of the client.
+func WithGasLimit(gas uint64) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.gasLimit = gas
+	}
+}
+
+// WithFees sets the fees of the tx, they replace the gas prices of the client. The gas prices of
+// the client are resolved with a query, the offline txs pay the fees set by this option only.
+func WithFees(fees sdktypes.Coins) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.fees = fees
+	}
+}
+
+// WithMemo sets the memo of the tx.
+func WithMemo(memo string) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.memo = memo
+	}
+}
+
+// WithTimeoutHeight sets the block height after which the tx is not processed by the chain.
+func WithTimeoutHeight(height uint64) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.timeoutHeight = height
+	}
+}
+
+// WithExtensionOptions adds extension options to the tx.
+// the tx is rejected by the chain when it doesn't handle one of the options.
+func WithExtensionOptions(options ...*codectypes.Any) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.extensionOptions = append(o.extensionOptions, options...)
+	}
+}
+
+// WithNonCriticalExtensionOptions adds extension options to the tx
+// that are ignored by the chain when it doesn't handle them.
+func WithNonCriticalExtensionOptions(options ...*codectypes.Any) BroadcastOption {
+	return func(o *broadcastOptions) {
+		o.nonCriticalExtensionOptions = append(o.nonCriticalExtensionOptions, options...)
+	}
+}
+
+// setExtensionOptions sets the extension options to the tx builder if there are any.
+func (o broadcastOptions) setExtensionOptions(txBuilder client.TxBuilder) error {
+	if len(o.extensionOptions) == 0 && len(o.nonCriticalExtensionOptions) == 0 {
+		return nil
+	}
+
+	extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return errors.New("tx builder does not support extension options")
+	}
+	extBuilder.SetExtensionOptions(o.extensionOptions...)
+	extBuilder.SetNonCriticalExtensionOptions(o.nonCriticalExtensionOptions...)
+
+	return nil
+}
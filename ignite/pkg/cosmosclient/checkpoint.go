@@ -0,0 +1,97 @@
+package cosmosclient
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Checkpointer stores the height of the last block whose transactions are collected, so the
+// collection of the transactions resumes after it once restarted, e.g. after a crash.
+type Checkpointer interface {
+	// LastHeight returns the height of the last collected block, zero when none is collected.
+	LastHeight() (int64, error)
+
+	// Save stores the height of the last collected block.
+	Save(height int64) error
+}
+
+// WithCheckpointer resumes the collection of the transactions after the last block saved by
+// cp when it's higher than the start height, and saves the height of each block once its
+// transactions are sent. The transactions sent but not processed yet when the collector stops
+// aren't collected again.
+func WithCheckpointer(cp Checkpointer) TXsOption {
+	return func(o *txsOptions) {
+		o.checkpointer = cp
+	}
+}
+
+// resumeHeight returns the height the collection starts from, the block after the checkpoint
+// when it's higher than fromHeight.
+func (o txsOptions) resumeHeight(fromHeight int64) (int64, error) {
+	if o.checkpointer == nil {
+		return fromHeight, nil
+	}
+	last, err := o.checkpointer.LastHeight()
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot read the checkpoint")
+	}
+	if last >= fromHeight {
+		return last + 1, nil
+	}
+	return fromHeight, nil
+}
+
+// checkpoint saves the height of the last collected block.
+func (o txsOptions) checkpoint(height int64) error {
+	if o.checkpointer == nil {
+		return nil
+	}
+	if err := o.checkpointer.Save(height); err != nil {
+		return errors.Wrapf(err, "cannot save the checkpoint of block %d", height)
+	}
+	return nil
+}
+
+// FileCheckpointer is a Checkpointer storing the height in a file.
+type FileCheckpointer struct {
+	path string
+}
+
+// NewFileCheckpointer returns a checkpointer storing the height in the file at path, the file
+// is created on the first save.
+func NewFileCheckpointer(path string) FileCheckpointer {
+	return FileCheckpointer{path: path}
+}
+
+// LastHeight returns the height stored in the file, zero when the file doesn't exist.
+func (f FileCheckpointer) LastHeight() (int64, error) {
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	height, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid checkpoint %s", f.path)
+	}
+	return height, nil
+}
+
+// Save stores the height in the file. The file is replaced at once, so a crash during the save
+// keeps the previous height.
+func (f FileCheckpointer) Save(height int64) error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	tmp := f.path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path)
+}
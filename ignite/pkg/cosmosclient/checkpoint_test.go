@@ -0,0 +1,50 @@
+package cosmosclient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCheckpointer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "collector", "checkpoint")
+	cp := NewFileCheckpointer(path)
+
+	height, err := cp.LastHeight()
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	require.NoError(t, cp.Save(42))
+	height, err = cp.LastHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 42, height)
+
+	require.NoError(t, os.WriteFile(path, []byte("forty-two"), 0644))
+	_, err = cp.LastHeight()
+	require.Error(t, err)
+}
+
+func TestResumeHeight(t *testing.T) {
+	cp := NewFileCheckpointer(filepath.Join(t.TempDir(), "checkpoint"))
+	o := newTXsOptions([]TXsOption{WithCheckpointer(cp)})
+
+	height, err := o.resumeHeight(10)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, height)
+
+	require.NoError(t, o.checkpoint(20))
+	height, err = o.resumeHeight(10)
+	require.NoError(t, err)
+	require.EqualValues(t, 21, height)
+
+	// the collection doesn't go back to the checkpoint.
+	height, err = o.resumeHeight(30)
+	require.NoError(t, err)
+	require.EqualValues(t, 30, height)
+
+	height, err = newTXsOptions(nil).resumeHeight(10)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, height)
+}
@@ -0,0 +1,85 @@
+package cosmosclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashing "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibccore "github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// codecOptions configures the codec of the client.
+type codecOptions struct {
+	interfaceRegistry  codectypes.InterfaceRegistry
+	modules            []module.AppModuleBasic
+	registerInterfaces []func(registry codectypes.InterfaceRegistry)
+}
+
+// WithModules registers the interfaces, the messages and the amino types of the modules in the
+// codec of the client, e.g. the basics of the custom modules of the app. The messages and the
+// transactions of the modules are then decoded by all the features of the client.
+func WithModules(modules ...module.AppModuleBasic) Option {
+	return func(c *Client) {
+		c.codec.modules = append(c.codec.modules, modules...)
+	}
+}
+
+// WithInterfaceRegistry sets the interface registry of the codec of the client, e.g. the registry
+// of the app where its modules are already registered. The interfaces of the standard modules of
+// the Cosmos SDK and IBC are registered in it too.
+func WithInterfaceRegistry(registry codectypes.InterfaceRegistry) Option {
+	return func(c *Client) {
+		c.codec.interfaceRegistry = registry
+	}
+}
+
+// newCodec returns the amino codec and the interface registry of the client with the interfaces of
+// the standard modules and the ones of the options registered.
+func newCodec(options codecOptions) (*codec.LegacyAmino, codectypes.InterfaceRegistry) {
+	var (
+		amino             = codec.NewLegacyAmino()
+		interfaceRegistry = options.interfaceRegistry
+	)
+	if interfaceRegistry == nil {
+		interfaceRegistry = codectypes.NewInterfaceRegistry()
+	}
+
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	sdktypes.RegisterInterfaces(interfaceRegistry)
+	staking.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	distribution.RegisterInterfaces(interfaceRegistry)
+	feegrant.RegisterInterfaces(interfaceRegistry)
+	gov.RegisterInterfaces(interfaceRegistry)
+	paramproposal.RegisterInterfaces(interfaceRegistry)
+	upgradetypes.RegisterInterfaces(interfaceRegistry)
+	slashing.RegisterInterfaces(interfaceRegistry)
+	vesting.RegisterInterfaces(interfaceRegistry)
+	ibctransfer.RegisterInterfaces(interfaceRegistry)
+	ibccore.RegisterInterfaces(interfaceRegistry)
+
+	for _, m := range options.modules {
+		m.RegisterInterfaces(interfaceRegistry)
+		m.RegisterLegacyAminoCodec(amino)
+	}
+	for _, register := range options.registerInterfaces {
+		register(interfaceRegistry)
+	}
+
+	return amino, interfaceRegistry
+}
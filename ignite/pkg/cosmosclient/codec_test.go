@@ -0,0 +1,62 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/stretchr/testify/require"
+)
+
+const crisisMsgTypeURL = "/cosmos.crisis.v1beta1.MsgVerifyInvariant"
+
+func TestCodecWithModules(t *testing.T) {
+	var c Client
+	WithModules(crisis.AppModuleBasic{})(&c)
+	ctx := newContext(nil, nil, "chain", "", c.codec)
+
+	// the messages of the module are decoded.
+	msg := crisistypes.NewMsgVerifyInvariant(
+		sdktypes.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		"bank",
+		"total-supply",
+	)
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	bz, err := ctx.Codec.Marshal(msgAny)
+	require.NoError(t, err)
+	var decoded codectypes.Any
+	require.NoError(t, ctx.Codec.Unmarshal(bz, &decoded))
+	var decodedMsg sdktypes.Msg
+	require.NoError(t, ctx.InterfaceRegistry.UnpackAny(&decoded, &decodedMsg))
+	require.Equal(t, msg, decodedMsg)
+
+	// the amino types of the module are registered.
+	bz, err = ctx.LegacyAmino.MarshalJSON(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "cosmos-sdk/MsgVerifyInvariant")
+
+	// the modules aren't registered by default.
+	ctx = newContext(nil, nil, "chain", "", codecOptions{})
+	_, err = ctx.InterfaceRegistry.Resolve(crisisMsgTypeURL)
+	require.Error(t, err)
+}
+
+func TestCodecWithInterfaceRegistry(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	crisistypes.RegisterInterfaces(registry)
+
+	var c Client
+	WithInterfaceRegistry(registry)(&c)
+	ctx := newContext(nil, nil, "chain", "", c.codec)
+	require.Equal(t, registry, ctx.InterfaceRegistry)
+
+	// the messages of the registry and of the standard modules are resolved.
+	_, err := ctx.InterfaceRegistry.Resolve(crisisMsgTypeURL)
+	require.NoError(t, err)
+	_, err = ctx.InterfaceRegistry.Resolve("/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+}
@@ -7,9 +7,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
@@ -18,25 +18,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	"github.com/gogo/protobuf/proto"
 	prototypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	lrpc "github.com/tendermint/tendermint/light/rpc"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/ignite/cli/ignite/pkg/correlation"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 )
@@ -73,12 +74,21 @@ type Client struct {
 	// AccountRegistry is the retistry to access accounts.
 	AccountRegistry cosmosaccount.Registry
 
+	// hasAccountRegistry is true when the registry is set by an option, otherwise the registry is
+	// created with the keyring options and the accountOptions.
+	hasAccountRegistry bool
+	accountOptions     []cosmosaccount.Option
+
 	addressPrefix string
 
 	nodeAddress string
+	httpClient  *http.Client
 	out         io.Writer
 	chainID     string
 
+	expectedChainID     string
+	expectedGenesisHash string
+
 	useFaucet       bool
 	faucetAddress   string
 	faucetDenom     string
@@ -87,6 +97,48 @@ type Client struct {
 	homePath           string
 	keyringServiceName string
 	keyringBackend     cosmosaccount.KeyringBackend
+
+	ledgerAccount uint32
+	ledgerIndex   uint32
+	ledgerPrompt  func(accountName string)
+
+	signer cosmosaccount.Signer
+
+	trustChainID string
+	trustOptions *light.TrustOptions
+	witnesses    []string
+	lightClient  *light.Client
+	lightRPC     *lrpc.Client
+
+	retryMaxAttempts int
+	retryBackoff     time.Duration
+
+	nodeAddresses       []string
+	nodeSelector        NodeSelector
+	healthCheckInterval time.Duration
+	maxBlocksBehind     int64
+	nodes               *nodePool
+
+	codec codecOptions
+
+	grpcAddress string
+	grpc        *grpcConn
+
+	gasAdjustment     float64
+	gasPrices         sdktypes.DecCoins
+	feeMarketStoreKey string
+
+	sequences           *sequenceManager
+	sequenceMaxAttempts int
+	serializeBroadcast  bool
+
+	offline bool
+
+	queryHeight int64
+
+	txsOptions []TXsOption
+
+	logger log.Logger
 }
 
 // Option configures your client.
@@ -110,12 +162,22 @@ func WithKeyringServiceName(name string) Option {
 }
 
 // WithKeyringBackend sets your keyring backend. By default, it is `test`.
+// With the `ledger` backend, the txs are signed by a Ledger device.
+// With the `remote` backend, the txs are signed by the signer set with WithSigner.
 func WithKeyringBackend(backend cosmosaccount.KeyringBackend) Option {
 	return func(c *Client) {
 		c.keyringBackend = backend
 	}
 }
 
+// WithSigner signs the txs with a remote signer, like a signing service or a KMS, instead of
+// the local keys. The accounts of the client are the keys of the signer.
+func WithSigner(signer cosmosaccount.Signer) Option {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
 // WithNodeAddress sets the node address of your chain. When this option is not provided
 // `http://localhost:26657` is used as default.
 func WithNodeAddress(addr string) Option {
@@ -146,13 +208,19 @@ func WithUseFaucet(faucetAddress, denom string, minAmount uint64) Option {
 // New creates a new client with given options.
 func New(ctx context.Context, options ...Option) (Client, error) {
 	c := Client{
-		nodeAddress:     defaultNodeAddress,
-		keyringBackend:  cosmosaccount.KeyringTest,
-		addressPrefix:   "cosmos",
-		faucetAddress:   defaultFaucetAddress,
-		faucetDenom:     defaultFaucetDenom,
-		faucetMinAmount: defaultFaucetMinAmount,
-		out:             io.Discard,
+		nodeAddress:         defaultNodeAddress,
+		keyringBackend:      cosmosaccount.KeyringTest,
+		addressPrefix:       "cosmos",
+		faucetAddress:       defaultFaucetAddress,
+		faucetDenom:         defaultFaucetDenom,
+		faucetMinAmount:     defaultFaucetMinAmount,
+		out:                 io.Discard,
+		healthCheckInterval: defaultHealthCheckInterval,
+		maxBlocksBehind:     defaultMaxBlocksBehind,
+		grpc:                &grpcConn{},
+		gasAdjustment:       defaultGasAdjustment,
+		sequences:           newSequenceManager(),
+		sequenceMaxAttempts: defaultSequenceMaxAttempts,
 	}
 
 	var err error
@@ -161,16 +229,37 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		apply(&c)
 	}
 
-	if c.RPC, err = rpchttp.New(c.nodeAddress, "/websocket"); err != nil {
-		return Client{}, err
+	if len(c.nodeAddresses) > 0 {
+		if c.nodes, err = newNodePool(c.nodeAddresses, c.nodeSelector, c.maxBlocksBehind, c.newHTTPClient); err != nil {
+			return Client{}, err
+		}
+		c.nodes.check(ctx)
 	}
 
-	statusResp, err := c.RPC.Status(ctx)
-	if err != nil {
+	if c.RPC, err = c.newRPC(); err != nil {
 		return Client{}, err
 	}
 
-	c.chainID = statusResp.NodeInfo.Network
+	// the offline clients don't query the node, the chain ID is set by the option.
+	if !c.offline {
+		statusResp, err := c.RPC.Status(ctx)
+		if err != nil {
+			return Client{}, err
+		}
+
+		c.chainID = statusResp.NodeInfo.Network
+
+		if err := c.verifyNetwork(ctx, c.RPC); err != nil {
+			return Client{}, err
+		}
+	}
+
+	if c.trustOptions != nil {
+		if c.lightClient, err = c.newLightClient(ctx); err != nil {
+			return Client{}, err
+		}
+		c.lightRPC = newLightRPC(c.RPC, c.lightClient)
+	}
 
 	if c.homePath == "" {
 		home, err := os.UserHomeDir()
@@ -180,21 +269,64 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		c.homePath = filepath.Join(home, "."+c.chainID)
 	}
 
-	c.AccountRegistry, err = cosmosaccount.New(
-		cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
-		cosmosaccount.WithKeyringBackend(c.keyringBackend),
-		cosmosaccount.WithHome(c.homePath),
-	)
-	if err != nil {
-		return Client{}, err
+	if !c.hasAccountRegistry {
+		registryOptions := []cosmosaccount.Option{
+			cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
+			cosmosaccount.WithKeyringBackend(c.keyringBackend),
+			cosmosaccount.WithHome(c.homePath),
+			cosmosaccount.WithLedgerHDPath(c.ledgerAccount, c.ledgerIndex),
+		}
+		if c.signer != nil {
+			registryOptions = append(registryOptions, cosmosaccount.WithSigner(c.signer))
+		}
+		c.AccountRegistry, err = cosmosaccount.New(append(registryOptions, c.accountOptions...)...)
+		if err != nil {
+			return Client{}, err
+		}
 	}
 
-	c.context = newContext(c.RPC, c.out, c.chainID, c.homePath).WithKeyring(c.AccountRegistry.Keyring)
-	c.Factory = newFactory(c.context)
+	c.context = newContext(c.RPC, c.out, c.chainID, c.homePath, c.codec).WithKeyring(c.AccountRegistry.Keyring)
+	c.Factory = newFactory(c.context).WithGasAdjustment(c.gasAdjustment)
+
+	if c.nodes != nil {
+		go c.nodes.run(ctx, c.healthCheckInterval)
+	}
 
 	return c, nil
 }
 
+// newRPC returns the Tendermint RPC of the client, the calls are sent to the selected node
+// when the client has several nodes and they're retried with the retry policy of the client.
+func (c Client) newRPC() (*rpchttp.HTTP, error) {
+	httpClient, err := c.newHTTPClient(c.nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if c.nodes != nil {
+		httpClient.Transport = c.nodes
+	}
+	// the calls hold the correlation ID of their context, so they can be matched with the request
+	// of the CLI or of the service sending them.
+	httpClient.Transport = correlation.Transport{Next: httpClient.Transport}
+	// each attempt of a retried call is logged.
+	if c.logger != nil {
+		httpClient.Transport = logTransport{
+			next:   httpClient.Transport,
+			logger: c.logger,
+		}
+	}
+	if c.retryMaxAttempts > 1 {
+		httpClient.Transport = retryTransport{
+			next:           httpClient.Transport,
+			maxAttempts:    c.retryMaxAttempts,
+			initialBackoff: c.retryBackoff,
+			logger:         c.log(),
+		}
+	}
+
+	return rpchttp.NewWithClient(c.nodeAddress, "/websocket", httpClient)
+}
+
 func (c Client) Account(accountName string) (cosmosaccount.Account, error) {
 	return c.AccountRegistry.GetByName(accountName)
 }
@@ -208,11 +340,17 @@ func (c Client) Address(accountName string) (sdktypes.AccAddress, error) {
 	return account.Info.GetAddress(), nil
 }
 
+// AddressPrefix returns the prefix of the account addresses of the chain.
+func (c Client) AddressPrefix() string {
+	return c.addressPrefix
+}
+
 func (c Client) Context() client.Context {
 	return c.context
 }
 
 // Response of your broadcasted transaction.
+// The gas consumed by the tx is its GasUsed and its events are returned by Events.
 type Response struct {
 	Codec codec.Codec
 
@@ -322,8 +460,28 @@ func (c Client) BroadcastTx(accountName string, msgs ...sdktypes.Msg) (Response,
 // protects sdktypes.Config.
 var mconf sync.Mutex
 
+// BroadcastTxWithOptions creates and broadcasts a tx with given messages for account,
+// the options set the optional fields of the tx like its memo or timeout height.
+func (c Client) BroadcastTxWithOptions(accountName string, msgs []sdktypes.Msg, options ...BroadcastOption) (Response, error) {
+	_, broadcast, err := c.broadcastTxWithProvision(accountName, msgs, options)
+	if err != nil {
+		return Response{}, err
+	}
+	return broadcast()
+}
+
 func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Msg) (
 	gas uint64, broadcast func() (Response, error), err error) {
+	return c.broadcastTxWithProvision(accountName, msgs, nil)
+}
+
+func (c Client) broadcastTxWithProvision(accountName string, msgs []sdktypes.Msg, options []BroadcastOption) (
+	gas uint64, broadcast func() (Response, error), err error) {
+	var o broadcastOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
 	if err := c.prepareBroadcast(context.Background(), accountName, msgs); err != nil {
 		return 0, nil, err
 	}
@@ -342,46 +500,77 @@ func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Ms
 	ctx := c.context.
 		WithFromName(accountName).
 		WithFromAddress(accountAddress)
+	if o.mode != "" {
+		ctx = ctx.WithBroadcastMode(string(o.mode))
+	}
 
 	txf, err := prepareFactory(ctx, c.Factory)
 	if err != nil {
 		return 0, nil, err
 	}
+	txf = txf.
+		WithSequence(c.sequences.next(accountAddress.String(), txf.Sequence())).
+		WithMemo(o.memo).
+		WithTimeoutHeight(o.timeoutHeight)
 
-	_, gas, err = tx.CalculateGas(ctx, txf, msgs...)
-	if err != nil {
+	// the fees of the options replace the gas prices of the client.
+	if !o.fees.IsZero() {
+		txf = txf.WithGasPrices("").WithFees(o.fees.String())
+	}
+	if txf, err = c.withGasPrices(txf); err != nil {
 		return 0, nil, err
 	}
-	// the simulated gas can vary from the actual gas needed for a real transaction
-	// we add an additional amount to endure sufficient gas is provided
-	gas += 10000
-	txf = txf.WithGas(gas)
 
-	// Return the provision function
-	return gas, func() (Response, error) {
-		txUnsigned, err := tx.BuildUnsignedTx(txf, msgs...)
+	if gas = o.gasLimit; gas == 0 {
+		_, gas, err = tx.CalculateGas(ctx, txf, msgs...)
 		if err != nil {
-			return Response{}, err
+			return 0, nil, ParseError(err)
 		}
+		// the simulated gas can vary from the actual gas needed for a real transaction
+		// we add an additional amount to endure sufficient gas is provided
+		gas += 10000
+	}
+	txf = txf.WithGas(gas)
 
-		txUnsigned.SetFeeGranter(ctx.GetFeeGranterAddress())
-		if err := tx.Sign(txf, accountName, txUnsigned, true); err != nil {
-			return Response{}, err
+	// Return the provision function
+	return gas, func() (Response, error) {
+		address := accountAddress.String()
+		if c.serializeBroadcast {
+			unlock := c.sequences.lock(address)
+			defer unlock()
 		}
 
-		txBytes, err := ctx.TxConfig.TxEncoder()(txUnsigned.GetTx())
-		if err != nil {
-			return Response{}, err
+		// the txs broadcasted since the provision increased the sequence of the account, the
+		// sequence is reserved until the broadcast completes.
+		txf = txf.WithSequence(c.sequences.reserve(address, txf.Sequence()))
+
+		var (
+			resp *sdktypes.TxResponse
+			err  error
+		)
+		for attempt := 1; ; attempt++ {
+			resp, err = c.signAndBroadcast(ctx, txf, accountName, msgs, o)
+			if attempt >= c.sequenceMaxAttempts {
+				break
+			}
+			sequence, mismatch, refreshErr := c.expectedSequence(ctx, resp, err)
+			if refreshErr != nil {
+				return Response{}, refreshErr
+			}
+			if !mismatch {
+				break
+			}
+			c.log().Info("account sequence mismatch, broadcasting the tx again",
+				"account", accountName, "sequence", txf.Sequence(), "expected", sequence, "attempt", attempt)
+			txf = txf.WithSequence(c.sequences.reserve(address, sequence))
 		}
 
-		resp, err := ctx.BroadcastTx(txBytes)
-		if err == sdkerrors.ErrInsufficientFunds {
-			err = c.makeSureAccountHasTokens(context.Background(), accountAddress.String())
-			if err != nil {
-				return Response{}, err
-			}
-			resp, err = ctx.BroadcastTx(txBytes)
+		if err == nil && resp.Code == 0 {
+			c.sequences.advance(address, txf.Sequence()+1)
+		} else {
+			c.sequences.release(address, txf.Sequence())
 		}
+		c.logBroadcastResult(accountName, resp, err)
 
 		return Response{
 			Codec:      ctx.Codec,
@@ -390,8 +579,54 @@ func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Ms
 	}, nil
 }
 
+// signAndBroadcast builds, signs and broadcasts a tx with given messages for account.
+func (c Client) signAndBroadcast(
+	ctx client.Context,
+	txf tx.Factory,
+	accountName string,
+	msgs []sdktypes.Msg,
+	o broadcastOptions,
+) (*sdktypes.TxResponse, error) {
+	txUnsigned, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	txUnsigned.SetFeeGranter(ctx.GetFeeGranterAddress())
+	if err := o.setExtensionOptions(txUnsigned); err != nil {
+		return nil, err
+	}
+	account, err := c.Account(accountName)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(c.ledgerFactory(txf, account), accountName, txUnsigned, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := ctx.TxConfig.TxEncoder()(txUnsigned.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ctx.BroadcastTx(txBytes)
+	if err == sdkerrors.ErrInsufficientFunds {
+		err = c.makeSureAccountHasTokens(context.Background(), ctx.GetFromAddress().String())
+		if err != nil {
+			return nil, err
+		}
+		resp, err = ctx.BroadcastTx(txBytes)
+	}
+	return resp, err
+}
+
 // prepareBroadcast performs checks and operations before broadcasting messages
 func (c *Client) prepareBroadcast(ctx context.Context, accountName string, _ []sdktypes.Msg) error {
+	// the account and its sequence would be fetched at the past height.
+	if c.queryHeight > 0 {
+		return errHistoricalBroadcast
+	}
+
 	// TODO uncomment after https://github.com/tendermint/spn/issues/363
 	// validate msgs.
 	//  for _, msg := range msgs {
@@ -423,6 +658,7 @@ func (c *Client) makeSureAccountHasTokens(ctx context.Context, address string) e
 	}
 
 	// request coins from the faucet.
+	c.log().Info("requesting tokens from the faucet", "faucet", c.faucetAddress, "address", address)
 	fc := cosmosfaucet.NewClient(c.faucetAddress)
 	faucetResp, err := fc.Transfer(ctx, cosmosfaucet.TransferRequest{AccountAddress: address})
 	if err != nil {
@@ -460,31 +696,51 @@ func (c *Client) checkAccountBalance(ctx context.Context, address string) error
 // handleBroadcastResult handles the result of broadcast messages result and checks if an error occurred
 func handleBroadcastResult(resp *sdktypes.TxResponse, err error) error {
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return errors.New("make sure that your SPN account has enough balance")
+		err = ParseError(err)
+		if errors.Is(err, ErrAccountNotFound) {
+			return errors.Wrap(err, "make sure that your SPN account has enough balance")
 		}
 
 		return err
 	}
 
 	if resp.Code > 0 {
-		return fmt.Errorf("SPN error with '%d' code: %s", resp.Code, resp.RawLog)
+		return &BroadcastError{
+			TxHash:    resp.TxHash,
+			Codespace: resp.Codespace,
+			Code:      resp.Code,
+			Log:       resp.RawLog,
+		}
 	}
 	return nil
 }
 
+// logBroadcastResult logs the result of the broadcast of a tx signed by account.
+func (c Client) logBroadcastResult(accountName string, resp *sdktypes.TxResponse, err error) {
+	switch {
+	case err != nil:
+		c.log().Error("tx broadcast failed", "account", accountName, "err", err)
+	case resp.Code > 0:
+		c.log().Error("tx failed",
+			"account", accountName, "hash", resp.TxHash, "codespace", resp.Codespace, "code", resp.Code, "log", resp.RawLog)
+	default:
+		c.log().Info("tx broadcasted",
+			"account", accountName, "hash", resp.TxHash, "height", resp.Height, "gas_wanted", resp.GasWanted, "gas_used", resp.GasUsed)
+	}
+}
+
 func prepareFactory(clientCtx client.Context, txf tx.Factory) (tx.Factory, error) {
 	from := clientCtx.GetFromAddress()
 
 	if err := txf.AccountRetriever().EnsureExists(clientCtx, from); err != nil {
-		return txf, err
+		return txf, ParseError(err)
 	}
 
 	initNum, initSeq := txf.AccountNumber(), txf.Sequence()
 	if initNum == 0 || initSeq == 0 {
 		num, seq, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
 		if err != nil {
-			return txf, err
+			return txf, ParseError(err)
 		}
 
 		if initNum == 0 {
@@ -504,20 +760,14 @@ func newContext(
 	out io.Writer,
 	chainID,
 	home string,
+	options codecOptions,
 ) client.Context {
 	var (
-		amino             = codec.NewLegacyAmino()
-		interfaceRegistry = codectypes.NewInterfaceRegistry()
-		marshaler         = codec.NewProtoCodec(interfaceRegistry)
-		txConfig          = authtx.NewTxConfig(marshaler, authtx.DefaultSignModes)
+		amino, interfaceRegistry = newCodec(options)
+		marshaler                = codec.NewProtoCodec(interfaceRegistry)
+		txConfig                 = authtx.NewTxConfig(marshaler, authtx.DefaultSignModes)
 	)
 
-	authtypes.RegisterInterfaces(interfaceRegistry)
-	cryptocodec.RegisterInterfaces(interfaceRegistry)
-	sdktypes.RegisterInterfaces(interfaceRegistry)
-	staking.RegisterInterfaces(interfaceRegistry)
-	cryptocodec.RegisterInterfaces(interfaceRegistry)
-
 	return client.Context{}.
 		WithChainID(chainID).
 		WithInterfaceRegistry(interfaceRegistry).
@@ -0,0 +1,147 @@
+package cosmosclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Common errors of the broadcasted txs, a BroadcastError or a TxFailedError matches them with
+// errors.Is, e.g. `errors.Is(err, cosmosclient.ErrInsufficientFunds)`.
+var (
+	ErrInsufficientFunds = sdkerrors.ErrInsufficientFunds
+	ErrInsufficientFee   = sdkerrors.ErrInsufficientFee
+	ErrUnauthorized      = sdkerrors.ErrUnauthorized
+	ErrWrongSequence     = sdkerrors.ErrWrongSequence
+	ErrOutOfGas          = sdkerrors.ErrOutOfGas
+	ErrTxInMempoolCache  = sdkerrors.ErrTxInMempoolCache
+	ErrMempoolIsFull     = sdkerrors.ErrMempoolIsFull
+)
+
+// Errors of the node parsed from the codes and the logs of the RPC and ABCI errors, the errors
+// returned by the client match them with errors.Is. Use ParseError for the errors of the RPC and
+// the query clients called directly.
+var (
+	// ErrTxNotFound is returned when the node doesn't know a tx, e.g. it isn't committed yet or
+	// the tx indexer of the node is disabled.
+	ErrTxNotFound = errors.New("tx not found")
+
+	// ErrAccountNotFound is returned when an account doesn't exist on chain, e.g. it never
+	// received tokens.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrNodeCatchingUp is returned when the node is still syncing the blocks of the network, so
+	// its state is behind the one of the network.
+	ErrNodeCatchingUp = errors.New("node is catching up")
+)
+
+var (
+	// txNotFoundRe matches the log of the RPC of a tx unknown to the node.
+	txNotFoundRe = regexp.MustCompile(`tx \([0-9A-Fa-f]*\) not found`)
+
+	// accountNotFoundRe matches the logs of the queries and the txs of an account missing on chain.
+	accountNotFoundRe = regexp.MustCompile(`account \S+ (not found|does not exist)`)
+)
+
+// nodeError is an error of the node parsed as an error of the taxonomy, it matches kind with
+// errors.Is and keeps the message and the chain of the error of the node.
+type nodeError struct {
+	err  error
+	kind error
+}
+
+func (e *nodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *nodeError) Unwrap() error {
+	return e.err
+}
+
+func (e *nodeError) Is(target error) bool {
+	return target == e.kind
+}
+
+// ParseError returns err matching the error of the taxonomy of the client parsed from its code or
+// its log with errors.Is, e.g. `errors.Is(cosmosclient.ParseError(err), cosmosclient.ErrTxNotFound)`.
+// The other errors are returned as is.
+func ParseError(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	kind := errorKind(err)
+	if kind == nil || errors.Is(err, kind) {
+		return err
+	}
+	return &nodeError{err: err, kind: kind}
+}
+
+// errorKind returns the error of the taxonomy of err, nil when none matches.
+func errorKind(err error) error {
+	// the registered code is preferred to the log when the error has one.
+	if errors.Is(err, sdkerrors.ErrUnknownAddress) {
+		return ErrAccountNotFound
+	}
+
+	log := err.Error()
+	switch {
+	case txNotFoundRe.MatchString(log):
+		return ErrTxNotFound
+	case accountNotFoundRe.MatchString(log):
+		return ErrAccountNotFound
+	case strings.Contains(log, "out of gas"):
+		return ErrOutOfGas
+	case strings.Contains(log, "insufficient fee"):
+		return ErrInsufficientFee
+	case strings.Contains(log, "catching up"):
+		return ErrNodeCatchingUp
+	}
+	return nil
+}
+
+// BroadcastError is returned by the broadcast of a tx rejected or failed with a non-zero code.
+//
+// The error unwraps to the error registered with the codespace and the code of the result, so
+// callers can branch on the cause with errors.Is. The errors of the modules are registered by
+// their types package with sdkerrors.Register, e.g. `errors.Is(err, blogtypes.ErrPostNotFound)`
+// matches once the package is imported.
+type BroadcastError struct {
+	TxHash    string
+	Codespace string
+	Code      uint32
+	Log       string
+}
+
+func (e *BroadcastError) Error() string {
+	return fmt.Sprintf("tx %s failed with code %d in %s: %s", e.TxHash, e.Code, e.Codespace, e.Log)
+}
+
+// Unwrap returns the registered error of the code.
+func (e *BroadcastError) Unwrap() error {
+	return decodeABCIError(e.Codespace, e.Code, e.Log)
+}
+
+// WrongNetworkError is returned by New when the node doesn't belong to the network expected by the
+// WithExpectedChainID and WithExpectedGenesisHash options.
+type WrongNetworkError struct {
+	Node     string
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e *WrongNetworkError) Error() string {
+	return fmt.Sprintf("node %s belongs to the wrong network: expected %s %s, got %s", e.Node, e.Field, e.Expected, e.Actual)
+}
+
+// decodeABCIError returns the error registered with the codespace and the code, wrapped with
+// the log of the result and parsed with ParseError. The unknown codes return an error matching
+// no other error than the ones of the log.
+func decodeABCIError(codespace string, code uint32, log string) error {
+	return ParseError(sdkerrors.ABCIError(codespace, code, log))
+}
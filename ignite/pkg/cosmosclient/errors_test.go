@@ -0,0 +1,86 @@
+package cosmosclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "tx not found",
+			err:  &rpctypes.RPCError{Code: -32603, Message: "Internal error", Data: "tx (5A3F0C) not found"},
+			want: ErrTxNotFound,
+		},
+		{
+			name: "account not found",
+			err:  status.Error(codes.NotFound, "account cosmos1abc not found: key not found"),
+			want: ErrAccountNotFound,
+		},
+		{
+			name: "unknown address code",
+			err:  sdkerrors.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrUnknownAddress.ABCICode(), "account cosmos1abc does not exist"),
+			want: ErrAccountNotFound,
+		},
+		{
+			name: "out of gas in a simulation",
+			err:  status.Error(codes.Unknown, "out of gas in location: ReadFlat; gasWanted: 100, gasUsed: 1000: out of gas"),
+			want: ErrOutOfGas,
+		},
+		{
+			name: "insufficient fee",
+			err:  errors.New("insufficient fees; got: 1stake required: 200stake: insufficient fee"),
+			want: ErrInsufficientFee,
+		},
+		{
+			name: "node catching up",
+			err:  errors.New("the node is catching up"),
+			want: ErrNodeCatchingUp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ParseError(tt.err)
+			require.ErrorIs(t, err, tt.want)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.err.Error(), err.Error())
+		})
+	}
+
+	require.NoError(t, ParseError(nil))
+
+	// the errors matching no error of the taxonomy and the errors of the context are kept.
+	err := errors.New("connection refused")
+	require.Equal(t, err, ParseError(err))
+	require.Equal(t, context.Canceled, ParseError(context.Canceled))
+
+	// the errors already matching their error aren't wrapped again.
+	err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "out of gas")
+	require.Equal(t, err, ParseError(err))
+}
+
+func TestBroadcastErrorTaxonomy(t *testing.T) {
+	err := handleBroadcastResult(nil, status.Error(codes.NotFound, "account cosmos1abc not found"))
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	err = handleBroadcastResult(&sdktypes.TxResponse{
+		TxHash:    "5A3F0C",
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrUnknownAddress.ABCICode(),
+		RawLog:    "account cosmos1abc does not exist: unknown address",
+	}, nil)
+	require.ErrorIs(t, err, ErrAccountNotFound)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+}
@@ -0,0 +1,179 @@
+package cosmosclient
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// eventsEndpoint is the WebSocket endpoint of the Tendermint RPC.
+	eventsEndpoint = "/websocket"
+
+	// eventsReconnectInterval is the interval between the attempts to subscribe again
+	// once the connection to the node is lost.
+	eventsReconnectInterval = time.Second
+
+	// eventsMaxReconnectAttempts is the number of attempts of the WebSocket client to reconnect
+	// before subscribing again from scratch.
+	eventsMaxReconnectAttempts = 5
+)
+
+// Event is an event of the blockchain received by a subscription.
+type Event struct {
+	// Query is the query of the subscription.
+	Query string
+
+	// Data is the data of the event, e.g. tmtypes.EventDataNewBlock or tmtypes.EventDataTx.
+	Data tmtypes.TMEventData
+
+	// Events are the attributes of the ABCI events indexed by their composite keys,
+	// e.g. `transfer.recipient`.
+	Events map[string][]string
+}
+
+// SubscribeEvents subscribes to the events of the blockchain matching the query, e.g.
+// `tm.event='Tx' AND transfer.recipient='cosmos1...'`, and streams them over the returned
+// channel until ctx is canceled, the channel is then closed.
+//
+// The subscription opens a WebSocket connection to the node that is automatically reconnected
+// when it's lost, the events emitted while the client is disconnected are missed. The node
+// cancels the subscriptions of the slow clients, so the events must be read without delay.
+func (c Client) SubscribeEvents(ctx context.Context, query string) (<-chan Event, error) {
+	if _, err := tmquery.New(query); err != nil {
+		return nil, errors.Wrapf(err, "invalid query %q", query)
+	}
+
+	ws, err := c.subscribe(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		for {
+			forwardEvents(ctx, ws, query, events)
+			_ = ws.Stop()
+
+			// the connection is lost, subscribe again until ctx is canceled.
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(eventsReconnectInterval):
+				}
+
+				if ws, err = c.subscribe(ctx, query); err == nil {
+					break
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// SubscribeNewBlocks streams the new blocks of the blockchain over the returned channel until ctx
+// is canceled, see SubscribeEvents.
+func (c Client) SubscribeNewBlocks(ctx context.Context) (<-chan *tmtypes.Block, error) {
+	events, err := c.SubscribeEvents(ctx, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(chan *tmtypes.Block)
+
+	go func() {
+		defer close(blocks)
+
+		for e := range events {
+			data, ok := e.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+
+			select {
+			case blocks <- data.Block:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return blocks, nil
+}
+
+// subscribe opens a WebSocket connection to the node and subscribes to the events matching the query.
+// The node is subscribed again each time the connection is reestablished.
+func (c Client) subscribe(ctx context.Context, query string) (*jsonrpcclient.WSClient, error) {
+	var ws *jsonrpcclient.WSClient
+
+	ws, err := jsonrpcclient.NewWS(
+		c.currentNodeAddress(),
+		eventsEndpoint,
+		jsonrpcclient.MaxReconnectAttempts(eventsMaxReconnectAttempts),
+		jsonrpcclient.OnReconnect(func() {
+			_ = ws.Subscribe(context.Background(), query)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ws.Start(); err != nil {
+		return nil, errors.Wrap(err, "cannot connect to the node")
+	}
+
+	if err := ws.Subscribe(ctx, query); err != nil {
+		_ = ws.Stop()
+		return nil, errors.Wrapf(err, "cannot subscribe to %q", query)
+	}
+
+	return ws, nil
+}
+
+// forwardEvents sends the events received by ws to events, it returns when ctx is canceled
+// or when the subscription is lost.
+func forwardEvents(ctx context.Context, ws *jsonrpcclient.WSClient, query string, events chan<- Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case res, ok := <-ws.ResponsesCh:
+			if !ok {
+				return
+			}
+
+			if res.Error != nil {
+				if strings.Contains(res.Error.Error(), tmpubsub.ErrAlreadySubscribed.Error()) {
+					continue
+				}
+				return
+			}
+
+			var result ctypes.ResultEvent
+			if err := tmjson.Unmarshal(res.Result, &result); err != nil || result.Query != query {
+				// skip the response of the subscription request.
+				continue
+			}
+
+			select {
+			case events <- Event{Query: result.Query, Data: result.Data, Events: result.Events}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
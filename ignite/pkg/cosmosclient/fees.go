@@ -0,0 +1,227 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+)
+
+// baseFeeKey is the key of the base fee in the store of the fee market module.
+const baseFeeKey = "BaseFee"
+
+// FeeEstimate is the gas and the fees needed by a tx.
+type FeeEstimate struct {
+	// GasUsed is the gas used by the simulation of the tx.
+	GasUsed uint64
+
+	// GasLimit is the gas used by the simulation multiplied by the gas adjustment of the client.
+	GasLimit uint64
+
+	// Fees are the fees paying the gas limit at the gas prices of the client, they're empty
+	// when the client has no gas prices.
+	Fees sdktypes.Coins
+}
+
+// WithGasAdjustment sets the multiplier applied to the gas used by the simulation of a tx to
+// set its gas limit, the gas used can vary between the simulation and the actual execution.
+// By default, it is 1.0.
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *Client) {
+		c.gasAdjustment = adjustment
+	}
+}
+
+// WithGasPrices sets the gas prices paying the fees of the broadcasted txs, e.g. `0.025stake`.
+// A price can be set in any unit of a denom, e.g. `0.000001atom` instead of `1uatom`, it's
+// converted to the base denom with the denom metadata of the bank module.
+// By default, the txs pay no fees.
+func WithGasPrices(prices sdktypes.DecCoins) Option {
+	return func(c *Client) {
+		c.gasPrices = prices
+	}
+}
+
+// WithFeeMarket prices the gas of the broadcasted txs at the base fee of the fee market module
+// of the chain with the store key, e.g. `feemarket` for a module scaffolded with
+// `ignite scaffold fee-market`. The base fee is queried every time the gas prices are needed,
+// the gas prices set with WithGasPrices are used instead when they're higher.
+func WithFeeMarket(storeKey string) Option {
+	return func(c *Client) {
+		c.feeMarketStoreKey = storeKey
+	}
+}
+
+// SimulateTx simulates a tx with given messages for account and returns the gas it uses.
+func (c Client) SimulateTx(accountName string, msgs ...sdktypes.Msg) (gasUsed uint64, err error) {
+	mconf.Lock()
+	defer mconf.Unlock()
+	config := sdktypes.GetConfig()
+	config.SetBech32PrefixForAccount(c.addressPrefix, c.addressPrefix+"pub")
+
+	accountAddress, err := c.Address(accountName)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := c.context.
+		WithFromName(accountName).
+		WithFromAddress(accountAddress)
+
+	txf, err := prepareFactory(ctx, c.Factory)
+	if err != nil {
+		return 0, err
+	}
+
+	simRes, _, err := tx.CalculateGas(ctx, txf, msgs...)
+	if err != nil {
+		return 0, errors.Wrap(ParseError(err), "cannot simulate the tx")
+	}
+	return simRes.GasInfo.GasUsed, nil
+}
+
+// EstimateFees simulates a tx with given messages for account and returns its gas limit and
+// the fees paying it at the gas prices of the client.
+func (c Client) EstimateFees(ctx context.Context, accountName string, msgs ...sdktypes.Msg) (FeeEstimate, error) {
+	gasUsed, err := c.SimulateTx(accountName, msgs...)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	estimate := FeeEstimate{
+		GasUsed:  gasUsed,
+		GasLimit: uint64(c.Factory.GasAdjustment() * float64(gasUsed)),
+	}
+
+	prices, err := c.GasPrices(ctx)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	estimate.Fees = gasFees(prices, estimate.GasLimit)
+
+	return estimate, nil
+}
+
+// GasPrices returns the gas prices of the client in base denoms, the price of the denom of the
+// base fee is the base fee at least when the client has a fee market.
+func (c Client) GasPrices(ctx context.Context) (sdktypes.DecCoins, error) {
+	var prices sdktypes.DecCoins
+	if !c.gasPrices.IsZero() {
+		res, err := c.BankQueryClient().DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot fetch the denoms metadata")
+		}
+		prices = baseGasPrices(c.gasPrices, res.Metadatas)
+	}
+
+	if c.feeMarketStoreKey == "" {
+		return prices, nil
+	}
+	baseFee, err := c.BaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withBaseFee(prices, baseFee), nil
+}
+
+// BaseFee returns the base fee of the fee market of the chain set with WithFeeMarket.
+func (c Client) BaseFee(ctx context.Context) (sdktypes.DecCoin, error) {
+	if c.feeMarketStoreKey == "" {
+		return sdktypes.DecCoin{}, errors.New("the client has no fee market")
+	}
+
+	path := fmt.Sprintf("/store/%s/key", c.feeMarketStoreKey)
+	value, _, err := c.ABCIQuery(ctx, path, []byte(baseFeeKey), 0)
+	if err != nil {
+		return sdktypes.DecCoin{}, errors.Wrap(err, "cannot fetch the base fee")
+	}
+	if len(value) == 0 {
+		return sdktypes.DecCoin{}, errors.Errorf("the base fee is not set in the store %s", c.feeMarketStoreKey)
+	}
+
+	var baseFee sdktypes.DecCoin
+	if err := baseFee.Unmarshal(value); err != nil {
+		return sdktypes.DecCoin{}, errors.Wrap(err, "cannot decode the base fee")
+	}
+	return baseFee, nil
+}
+
+// withGasPrices sets the gas prices of the client to the factory, the fees or the gas prices
+// set by the factory take precedence over the gas prices of the client.
+func (c Client) withGasPrices(txf tx.Factory) (tx.Factory, error) {
+	if !txf.Fees().IsZero() || !txf.GasPrices().IsZero() {
+		return txf, nil
+	}
+
+	prices, err := c.GasPrices(context.Background())
+	if err != nil {
+		return txf, err
+	}
+	if !prices.IsZero() {
+		txf = txf.WithGasPrices(prices.String())
+	}
+	return txf, nil
+}
+
+// baseGasPrices converts the gas prices set in a unit of a denom to its base denom, the prices
+// of the denoms without metadata are kept as is.
+func baseGasPrices(prices sdktypes.DecCoins, metadatas []banktypes.Metadata) sdktypes.DecCoins {
+	var basePrices sdktypes.DecCoins
+	for _, price := range prices {
+		basePrice := price
+		for _, metadata := range metadatas {
+			if exponent, ok := denomExponent(metadata, price.Denom); ok {
+				amount := price.Amount.Mul(sdktypes.NewDecFromInt(sdktypes.NewIntWithDecimal(1, int(exponent))))
+				basePrice = sdktypes.NewDecCoinFromDec(metadata.Base, amount)
+				break
+			}
+		}
+		basePrices = basePrices.Add(basePrice)
+	}
+	return basePrices
+}
+
+// withBaseFee returns the gas prices with the price of the denom of the base fee raised to the base fee.
+func withBaseFee(prices sdktypes.DecCoins, baseFee sdktypes.DecCoin) sdktypes.DecCoins {
+	if !baseFee.IsPositive() || prices.AmountOf(baseFee.Denom).GTE(baseFee.Amount) {
+		return prices
+	}
+
+	updated := sdktypes.NewDecCoins(baseFee)
+	for _, price := range prices {
+		if price.Denom != baseFee.Denom {
+			updated = updated.Add(price)
+		}
+	}
+	return updated
+}
+
+// denomExponent returns the exponent of the unit of the metadata named denom, or one of its aliases.
+func denomExponent(metadata banktypes.Metadata, denom string) (uint32, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom {
+			return unit.Exponent, true
+		}
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit.Exponent, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// gasFees returns the fees paying the gas limit at the gas prices, rounded up.
+func gasFees(prices sdktypes.DecCoins, gasLimit uint64) sdktypes.Coins {
+	limit := sdktypes.NewDec(int64(gasLimit))
+
+	var fees sdktypes.Coins
+	for _, price := range prices {
+		fee := price.Amount.Mul(limit).Ceil().RoundInt()
+		fees = fees.Add(sdktypes.NewCoin(price.Denom, fee))
+	}
+	return fees
+}
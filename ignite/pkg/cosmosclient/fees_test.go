@@ -0,0 +1,36 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithBaseFee(t *testing.T) {
+	decCoins := func(s string) sdktypes.DecCoins {
+		coins, err := sdktypes.ParseDecCoins(s)
+		require.NoError(t, err)
+		return coins
+	}
+	baseFee := sdktypes.NewDecCoinFromDec("stake", sdktypes.MustNewDecFromStr("0.5"))
+
+	tests := []struct {
+		name    string
+		prices  string
+		baseFee sdktypes.DecCoin
+		want    string
+	}{
+		{name: "no gas prices", prices: "", baseFee: baseFee, want: "0.5stake"},
+		{name: "lower gas price", prices: "0.1stake", baseFee: baseFee, want: "0.5stake"},
+		{name: "higher gas price", prices: "1stake", baseFee: baseFee, want: "1stake"},
+		{name: "other denoms", prices: "0.1stake,2token", baseFee: baseFee, want: "0.5stake,2token"},
+		{name: "zero base fee", prices: "0.1stake", baseFee: sdktypes.NewDecCoin("stake", sdktypes.ZeroInt()), want: "0.1stake"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices := withBaseFee(decCoins(tt.prices), tt.baseFee)
+			require.Equal(t, decCoins(tt.want).String(), prices.String())
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package cosmosclient
+
+import (
+	"context"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/pkg/errors"
+)
+
+// Proposal is a gov proposal with the tally of its votes.
+type Proposal struct {
+	gov.Proposal
+
+	// Tally is the current tally of the votes while the proposal is in its voting period, the final
+	// tally once the voting period is over.
+	Tally gov.TallyResult
+}
+
+// SubmitProposalTx submits the gov proposal of content with the initial deposit from the account in
+// a tx. The options set the optional fields of the tx like its memo or fees.
+//
+// The content is created by the constructors of the modules, e.g. gov.NewTextProposal, or with
+// SubmitTextProposalTx, SubmitParamChangeProposalTx and SubmitSoftwareUpgradeProposalTx.
+// The ID of the proposal is in the "submit_proposal" event of the response.
+func (c Client) SubmitProposalTx(proposerAccountName string, content gov.Content, deposit sdktypes.Coins, options ...BroadcastOption) (Response, error) {
+	proposer, err := c.Address(proposerAccountName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	msg, err := gov.NewMsgSubmitProposal(content, deposit, proposer)
+	if err != nil {
+		return Response{}, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return Response{}, err
+	}
+	return c.BroadcastTxWithOptions(proposerAccountName, []sdktypes.Msg{msg}, options...)
+}
+
+// SubmitTextProposalTx submits a text proposal with the initial deposit from the account in a tx.
+func (c Client) SubmitTextProposalTx(proposerAccountName, title, description string, deposit sdktypes.Coins, options ...BroadcastOption) (Response, error) {
+	return c.SubmitProposalTx(proposerAccountName, gov.NewTextProposal(title, description), deposit, options...)
+}
+
+// SubmitParamChangeProposalTx submits a proposal changing the params of the modules with the
+// initial deposit from the account in a tx. The values of the changes are JSON encoded,
+// e.g. `{"subspace":"staking","key":"MaxValidators","value":"105"}`.
+func (c Client) SubmitParamChangeProposalTx(
+	proposerAccountName,
+	title,
+	description string,
+	changes []paramproposal.ParamChange,
+	deposit sdktypes.Coins,
+	options ...BroadcastOption,
+) (Response, error) {
+	content := paramproposal.NewParameterChangeProposal(title, description, changes)
+	return c.SubmitProposalTx(proposerAccountName, content, deposit, options...)
+}
+
+// SubmitSoftwareUpgradeProposalTx submits a proposal upgrading the software of the chain with the
+// plan, at the height of the plan, with the initial deposit from the account in a tx.
+func (c Client) SubmitSoftwareUpgradeProposalTx(
+	proposerAccountName,
+	title,
+	description string,
+	plan upgradetypes.Plan,
+	deposit sdktypes.Coins,
+	options ...BroadcastOption,
+) (Response, error) {
+	content := upgradetypes.NewSoftwareUpgradeProposal(title, description, plan)
+	return c.SubmitProposalTx(proposerAccountName, content, deposit, options...)
+}
+
+// DepositTx deposits amount from the account on the gov proposal in a tx. The options set the
+// optional fields of the tx like its memo or fees.
+func (c Client) DepositTx(depositorAccountName string, proposalID uint64, amount sdktypes.Coins, options ...BroadcastOption) (Response, error) {
+	depositor, err := c.Address(depositorAccountName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	msg := gov.NewMsgDeposit(depositor, proposalID, amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return Response{}, err
+	}
+	return c.BroadcastTxWithOptions(depositorAccountName, []sdktypes.Msg{msg}, options...)
+}
+
+// QueryProposal returns the gov proposal with the tally of its votes, the tally is queried while
+// the proposal is in its voting period.
+func (c Client) QueryProposal(ctx context.Context, proposalID uint64) (Proposal, error) {
+	res, err := c.GovQueryClient().Proposal(ctx, &gov.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return Proposal{}, errors.Wrapf(err, "proposal %d", proposalID)
+	}
+
+	p := Proposal{
+		Proposal: res.Proposal,
+		Tally:    res.Proposal.FinalTallyResult,
+	}
+	if p.Status != gov.StatusVotingPeriod {
+		return p, nil
+	}
+
+	tally, err := c.GovQueryClient().TallyResult(ctx, &gov.QueryTallyResultRequest{ProposalId: proposalID})
+	if err != nil {
+		return Proposal{}, errors.Wrapf(err, "tally of proposal %d", proposalID)
+	}
+	p.Tally = tally.Tally
+	return p, nil
+}
@@ -0,0 +1,71 @@
+package cosmosclient
+
+import (
+	"io"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestGovInvalidProposals(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	var c Client
+	WithAccountRegistry(registry)(&c)
+	WithAddressPrefix("mars")(&c)
+
+	_, _, err = c.CreateAccount("alice")
+	require.NoError(t, err)
+
+	deposit := sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1))
+
+	// the proposals are validated before being broadcasted.
+	_, err = c.SubmitTextProposalTx("alice", "", "description", deposit)
+	require.ErrorIs(t, err, gov.ErrInvalidProposalContent)
+
+	_, err = c.SubmitParamChangeProposalTx("alice", "title", "description", nil, deposit)
+	require.ErrorContains(t, err, "submitted parameter changes are empty")
+
+	_, err = c.SubmitSoftwareUpgradeProposalTx("alice", "title", "description", upgradetypes.Plan{Name: "v2"}, deposit)
+	require.ErrorContains(t, err, "height must be greater than 0")
+
+	_, err = c.DepositTx("alice", 1, sdktypes.Coins{{Denom: "token", Amount: sdktypes.NewInt(-1)}})
+	require.Error(t, err)
+
+	_, err = c.DepositTx("bob", 1, deposit)
+	require.Error(t, err)
+}
+
+func TestGovProposalsCodec(t *testing.T) {
+	var (
+		ctx      = newContext(nil, io.Discard, "mars", "", codecOptions{})
+		proposer = sdktypes.AccAddress("proposer")
+		deposit  = sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1))
+	)
+
+	// the contents of the param change and software upgrade proposals are decoded by the client.
+	for _, content := range []gov.Content{
+		gov.NewTextProposal("title", "description"),
+		paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange("staking", "MaxValidators", `"105"`),
+		}),
+		upgradetypes.NewSoftwareUpgradeProposal("title", "description", upgradetypes.Plan{Name: "v2", Height: 100}),
+	} {
+		msg, err := gov.NewMsgSubmitProposal(content, deposit, proposer)
+		require.NoError(t, err)
+
+		bz, err := ctx.Codec.MarshalJSON(msg)
+		require.NoError(t, err)
+
+		var decoded gov.MsgSubmitProposal
+		require.NoError(t, ctx.Codec.UnmarshalJSON(bz, &decoded))
+		require.Equal(t, content, decoded.GetContent())
+	}
+}
@@ -0,0 +1,141 @@
+package cosmosclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// defaultGRPCPort is the port of the gRPC server of the nodes by default.
+	defaultGRPCPort = "9090"
+
+	// grpcProbeTimeout is the time given to the gRPC server to answer before the queries fall
+	// back to the RPC.
+	grpcProbeTimeout = 2 * time.Second
+)
+
+// grpcConn is the gRPC connection of the client, it's dialed once by the first query.
+type grpcConn struct {
+	once sync.Once
+	conn *grpc.ClientConn
+}
+
+// WithGRPCAddress sets the address of the gRPC server of the node, e.g. `localhost:9090` or
+// `https://grpc.mychain.com:443`. When this option is not provided, the gRPC server is looked
+// up on the port 9090 of the host of the node address.
+func WithGRPCAddress(addr string) Option {
+	return func(c *Client) {
+		c.grpcAddress = addr
+	}
+}
+
+// QueryConn returns the connection querying the modules of the chain, it's the gRPC connection
+// of the node when its gRPC server answers, otherwise the queries are sent to the RPC as ABCI
+// queries. The gRPC server is checked once by the first call. The queries are sent at the
+// height of the client set by AtHeight.
+//
+// The connection is used to create the query client of any module, e.g.
+// `banktypes.NewQueryClient(client.QueryConn())`.
+func (c Client) QueryConn() gogogrpc.ClientConn {
+	c.grpc.once.Do(func() {
+		c.grpc.conn = c.dialGRPC()
+	})
+	if c.grpc.conn != nil {
+		if c.queryHeight > 0 {
+			return heightConn{c.grpc.conn, c.queryHeight}
+		}
+		return c.grpc.conn
+	}
+	// the queries of the context are sent at its height.
+	return c.context
+}
+
+// BankQueryClient returns the query client of the bank module.
+func (c Client) BankQueryClient() banktypes.QueryClient {
+	return banktypes.NewQueryClient(c.QueryConn())
+}
+
+// StakingQueryClient returns the query client of the staking module.
+func (c Client) StakingQueryClient() staking.QueryClient {
+	return staking.NewQueryClient(c.QueryConn())
+}
+
+// GovQueryClient returns the query client of the gov module.
+func (c Client) GovQueryClient() gov.QueryClient {
+	return gov.NewQueryClient(c.QueryConn())
+}
+
+// AuthQueryClient returns the query client of the auth module.
+func (c Client) AuthQueryClient() authtypes.QueryClient {
+	return authtypes.NewQueryClient(c.QueryConn())
+}
+
+// dialGRPC returns the gRPC connection of the node or nil when its gRPC server doesn't answer.
+func (c Client) dialGRPC() *grpc.ClientConn {
+	addr := c.grpcAddress
+	if addr == "" {
+		addr = discoverGRPCAddress(c.nodeAddress)
+	}
+	if addr == "" {
+		return nil
+	}
+
+	target, secure := grpcTarget(addr)
+	creds := grpc.WithInsecure()
+	if secure {
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+
+	conn, err := grpc.Dial(target, creds)
+	if err != nil {
+		return nil
+	}
+
+	// the connection is lazy, a cheap query checks that the server answers.
+	ctx, cancel := context.WithTimeout(context.Background(), grpcProbeTimeout)
+	defer cancel()
+
+	if _, err := tmservice.NewServiceClient(conn).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{}); err != nil {
+		conn.Close()
+		return nil
+	}
+
+	return conn
+}
+
+// discoverGRPCAddress returns the address of the gRPC server on the default port of the host of
+// the node address.
+func discoverGRPCAddress(nodeAddress string) string {
+	u, err := url.Parse(nodeAddress)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	return net.JoinHostPort(u.Hostname(), defaultGRPCPort)
+}
+
+// grpcTarget returns the dial target of a gRPC address and whether TLS is used, the addresses
+// using the https scheme are secure.
+func grpcTarget(addr string) (target string, secure bool) {
+	switch {
+	case strings.HasPrefix(addr, "https://"):
+		return strings.TrimPrefix(addr, "https://"), true
+	case strings.HasPrefix(addr, "http://"):
+		return strings.TrimPrefix(addr, "http://"), false
+	default:
+		return addr, false
+	}
+}
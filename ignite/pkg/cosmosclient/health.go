@@ -0,0 +1,113 @@
+package cosmosclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// waitNodePollInterval is the interval between two checks of the status of a node waited for.
+var waitNodePollInterval = time.Second
+
+// NodeHealth is the health of the node of the client.
+type NodeHealth struct {
+	// Height is the height of the latest block of the node.
+	Height int64
+
+	// BlockTime is the time of the latest block of the node.
+	BlockTime time.Time
+
+	// BlockAge is the time since the latest block of the node, a node stuck on an old block is
+	// either catching up or its chain is halted.
+	BlockAge time.Duration
+
+	// CatchingUp is true while the node syncs the blocks of the network, its state isn't the
+	// latest one and the txs broadcasted to it are rejected.
+	CatchingUp bool
+}
+
+// IsSynced returns true when the node has blocks and isn't catching up.
+func (h NodeHealth) IsSynced() bool {
+	return h.Height > 0 && !h.CatchingUp
+}
+
+// newNodeHealth returns the health of the node of the status at now.
+func newNodeHealth(status *ctypes.ResultStatus, now time.Time) NodeHealth {
+	return NodeHealth{
+		Height:     status.SyncInfo.LatestBlockHeight,
+		BlockTime:  status.SyncInfo.LatestBlockTime,
+		BlockAge:   now.Sub(status.SyncInfo.LatestBlockTime),
+		CatchingUp: status.SyncInfo.CatchingUp,
+	}
+}
+
+// Health returns the health of the node of the client from its status.
+func (c Client) Health(ctx context.Context) (NodeHealth, error) {
+	status, err := c.RPC.Status(ctx)
+	if err != nil {
+		return NodeHealth{}, errors.Wrap(err, "cannot fetch the status of the node")
+	}
+	return newNodeHealth(status, time.Now()), nil
+}
+
+// IsSyncing returns true while the node of the client is catching up with the network.
+func (c Client) IsSyncing(ctx context.Context) (bool, error) {
+	health, err := c.Health(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !health.IsSynced(), nil
+}
+
+// WaitUntilSynced waits for the node of the client to be synced with the network, e.g. a node
+// just started or catching up, before broadcasting txs or querying its latest state. The node
+// is checked every second until ctx is canceled, the node is waited for while it's unreachable.
+func (c Client) WaitUntilSynced(ctx context.Context) (NodeHealth, error) {
+	return c.waitForNode(ctx, NodeHealth.IsSynced)
+}
+
+// WaitForBlockHeight waits for the node of the client to have the block at height, the node
+// is checked every second until ctx is canceled.
+func (c Client) WaitForBlockHeight(ctx context.Context, height int64) (NodeHealth, error) {
+	return c.waitForNode(ctx, func(h NodeHealth) bool {
+		return h.Height >= height
+	})
+}
+
+// WaitForNextBlock waits for the block following the latest block of the node of the client.
+func (c Client) WaitForNextBlock(ctx context.Context) (NodeHealth, error) {
+	health, err := c.Health(ctx)
+	if err != nil {
+		return NodeHealth{}, err
+	}
+	return c.WaitForBlockHeight(ctx, health.Height+1)
+}
+
+// waitForNode checks the health of the node until it's ready or ctx is canceled, the last health
+// of the node is then returned with the error of ctx and the error of the last check.
+func (c Client) waitForNode(ctx context.Context, ready func(NodeHealth) bool) (NodeHealth, error) {
+	ticker := time.NewTicker(waitNodePollInterval)
+	defer ticker.Stop()
+
+	var last NodeHealth
+	for {
+		health, err := c.Health(ctx)
+		if err == nil {
+			if ready(health) {
+				return health, nil
+			}
+			last = health
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if err != nil && !errors.Is(err, ctx.Err()) {
+				return last, errors.Wrap(ctx.Err(), err.Error())
+			}
+			return last, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+// statusNode is a node answering the status requests with the sync infos of statuses in order,
+// the last one is repeated.
+type statusNode struct {
+	mu       sync.Mutex
+	statuses []ctypes.SyncInfo
+}
+
+func (n *statusNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpctypes.RPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	info := n.statuses[0]
+	if len(n.statuses) > 1 {
+		n.statuses = n.statuses[1:]
+	}
+	n.mu.Unlock()
+
+	result, err := tmjson.Marshal(ctypes.ResultStatus{SyncInfo: info})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func newStatusClient(t *testing.T, statuses ...ctypes.SyncInfo) Client {
+	server := httptest.NewServer(&statusNode{statuses: statuses})
+	t.Cleanup(server.Close)
+
+	rpc, err := rpchttp.New(server.URL, "/websocket")
+	require.NoError(t, err)
+	return Client{RPC: rpc}
+}
+
+func TestNewNodeHealth(t *testing.T) {
+	var (
+		now       = time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC)
+		blockTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	health := newNodeHealth(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+		LatestBlockHeight: 10,
+		LatestBlockTime:   blockTime,
+		CatchingUp:        true,
+	}}, now)
+	require.Equal(t, NodeHealth{Height: 10, BlockTime: blockTime, BlockAge: time.Minute, CatchingUp: true}, health)
+	require.False(t, health.IsSynced())
+
+	health.CatchingUp = false
+	require.True(t, health.IsSynced())
+
+	// a node without blocks isn't synced.
+	require.False(t, NodeHealth{}.IsSynced())
+}
+
+func TestWaitForNode(t *testing.T) {
+	waitNodePollInterval = time.Millisecond
+	t.Cleanup(func() { waitNodePollInterval = time.Second })
+
+	ctx := context.Background()
+
+	c := newStatusClient(t,
+		ctypes.SyncInfo{LatestBlockHeight: 1, CatchingUp: true},
+		ctypes.SyncInfo{LatestBlockHeight: 2, CatchingUp: true},
+		ctypes.SyncInfo{LatestBlockHeight: 3},
+	)
+	syncing, err := c.IsSyncing(ctx)
+	require.NoError(t, err)
+	require.True(t, syncing)
+	health, err := c.WaitUntilSynced(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, health.Height)
+
+	c = newStatusClient(t,
+		ctypes.SyncInfo{LatestBlockHeight: 1},
+		ctypes.SyncInfo{LatestBlockHeight: 1},
+		ctypes.SyncInfo{LatestBlockHeight: 2},
+		ctypes.SyncInfo{LatestBlockHeight: 4},
+	)
+	health, err = c.WaitForBlockHeight(ctx, 3)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, health.Height)
+
+	c = newStatusClient(t,
+		ctypes.SyncInfo{LatestBlockHeight: 5},
+		ctypes.SyncInfo{LatestBlockHeight: 5},
+		ctypes.SyncInfo{LatestBlockHeight: 6},
+	)
+	health, err = c.WaitForNextBlock(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, health.Height)
+
+	// the wait ends with ctx.
+	c = newStatusClient(t, ctypes.SyncInfo{LatestBlockHeight: 1})
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	health, err = c.WaitForBlockHeight(ctx, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.EqualValues(t, 1, health.Height)
+}
@@ -0,0 +1,45 @@
+package cosmosclient
+
+import (
+	"context"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var errHistoricalBroadcast = errors.New("a client querying a past height can't broadcast txs")
+
+// AtHeight returns a copy of the client querying the state of the chain as of the block at height,
+// e.g. to read the balances, the accounts or the params of the modules at a past block. The height
+// is set on the ABCI queries and on the gRPC queries of QueryConn and of the query clients, the
+// latest height is queried when height is zero.
+//
+// The node must still have the state at height, the pruned nodes only keep the recent states.
+// The client at a height only queries, it can't broadcast txs.
+func (c Client) AtHeight(height int64) Client {
+	c.queryHeight = height
+	c.context = c.context.WithHeight(height)
+	return c
+}
+
+// QueryHeight returns the height of the block queried by the client, it's zero when the latest
+// height is queried.
+func (c Client) QueryHeight() int64 {
+	return c.queryHeight
+}
+
+// heightConn is a gRPC connection querying the state at a height.
+type heightConn struct {
+	gogogrpc.ClientConn
+	height int64
+}
+
+// Invoke implements the gogogrpc.ClientConn interface, the height is sent in the header of the query.
+func (c heightConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(c.height, 10))
+	return c.ClientConn.Invoke(ctx, method, args, reply, opts...)
+}
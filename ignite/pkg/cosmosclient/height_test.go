@@ -0,0 +1,45 @@
+package cosmosclient
+
+import (
+	"context"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// recordConn is a gRPC connection recording the outgoing metadata of the queries.
+type recordConn struct {
+	md metadata.MD
+}
+
+func (c *recordConn) Invoke(ctx context.Context, _ string, _, _ interface{}, _ ...grpc.CallOption) error {
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	return nil
+}
+
+func (c *recordConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, nil
+}
+
+func TestAtHeight(t *testing.T) {
+	var c Client
+
+	past := c.AtHeight(42)
+	require.EqualValues(t, 42, past.QueryHeight())
+	require.EqualValues(t, 42, past.Context().Height)
+	require.Zero(t, c.QueryHeight(), "the client must not be changed")
+
+	_, err := past.RebroadcastTX("alice", UnconfirmedTX{}, 2)
+	require.ErrorIs(t, err, errHistoricalBroadcast)
+}
+
+func TestHeightConn(t *testing.T) {
+	conn := &recordConn{}
+
+	err := heightConn{conn, 42}.Invoke(context.Background(), "/cosmos.bank.v1beta1.Query/Balance", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"42"}, conn.md.Get(grpctypes.GRPCBlockHeightHeader))
+}
@@ -0,0 +1,89 @@
+package cosmosclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultHTTPTimeout bounds the RPC calls without deadline, the calls with a context deadline
+	// end at the earliest of both.
+	defaultHTTPTimeout = time.Minute
+
+	defaultDialTimeout         = 10 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 32
+)
+
+// WithHTTPClient sets the HTTP client of the RPC calls, e.g. to share a pool of connections
+// between clients or to tune its transport. The client is copied, the transports of the other
+// options like WithRetry wrap its transport.
+//
+// By default, the client reuses its connections to the nodes instead of opening new ones for
+// the concurrent calls, and bounds the calls without context deadline to a minute.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// newHTTPClient returns the HTTP client of the RPC calls to the node at remote.
+func (c Client) newHTTPClient(remote string) (*http.Client, error) {
+	if c.httpClient == nil {
+		return newDefaultHTTPClient(remote)
+	}
+
+	httpClient := *c.httpClient
+	if httpClient.Transport == nil {
+		httpClient.Transport = http.DefaultTransport
+	}
+	return &httpClient, nil
+}
+
+// newDefaultHTTPClient returns an HTTP client keeping its connections to the node at remote
+// alive. The default client of Tendermint only keeps two idle connections by host, the other
+// connections of the concurrent calls are closed after each call and exhaust the ephemeral
+// ports of the long running clients.
+func newDefaultHTTPClient(remote string) (*http.Client, error) {
+	u, err := url.Parse(remote)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid node address %s", remote)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   defaultDialTimeout,
+		KeepAlive: defaultKeepAlive,
+	}
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: defaultTLSHandshakeTimeout,
+		IdleConnTimeout:     defaultIdleConnTimeout,
+		MaxIdleConns:        defaultMaxIdleConns,
+		MaxIdleConnsPerHost: defaultMaxIdleConnsPerHost,
+		// like Tendermint, the responses aren't compressed to prevent gzip bombs.
+		DisableCompression: true,
+	}
+
+	// the requests to a unix socket address the path of the socket as host.
+	if u.Scheme == "unix" {
+		socket := u.Host + u.Path
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   defaultHTTPTimeout,
+	}, nil
+}
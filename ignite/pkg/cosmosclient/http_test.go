@@ -0,0 +1,49 @@
+package cosmosclient
+
+import (
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	var c Client
+
+	httpClient, err := c.newHTTPClient("http://localhost:26657")
+	require.NoError(t, err)
+	require.Equal(t, defaultHTTPTimeout, httpClient.Timeout)
+	transport, ok := httpClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	require.True(t, transport.DisableCompression)
+
+	custom := &http.Client{Timeout: time.Second}
+	WithHTTPClient(custom)(&c)
+	httpClient, err = c.newHTTPClient("http://localhost:26657")
+	require.NoError(t, err)
+	require.Equal(t, time.Second, httpClient.Timeout)
+	require.Equal(t, http.DefaultTransport, httpClient.Transport)
+	require.Nil(t, custom.Transport, "the client of the option must not be changed")
+}
+
+func TestDefaultHTTPClientUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "node.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})}
+	go func() { _ = server.Serve(l) }()
+	defer server.Close()
+
+	httpClient, err := newDefaultHTTPClient("unix://" + socket)
+	require.NoError(t, err)
+	resp, err := httpClient.Get("http://node/status")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTeapot, resp.StatusCode)
+}
@@ -0,0 +1,324 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	ibctransfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultIBCTransferTimeout is the time given to an IBC transfer to be received by default.
+	defaultIBCTransferTimeout = 10 * time.Minute
+
+	// defaultPacketInterval is the interval between two checks of a tracked packet by default.
+	defaultPacketInterval = time.Second
+)
+
+// PacketStatus is the delivery status of an IBC packet.
+type PacketStatus string
+
+const (
+	// PacketPending is the status of a packet sent and not received by the destination chain yet.
+	PacketPending PacketStatus = "pending"
+
+	// PacketReceived is the status of a packet received by the destination chain whose
+	// acknowledgement isn't relayed to the source chain yet.
+	PacketReceived PacketStatus = "received"
+
+	// PacketAcknowledged is the final status of a packet successfully processed by the destination
+	// chain, e.g. the tokens of a transfer are received.
+	PacketAcknowledged PacketStatus = "acknowledged"
+
+	// PacketFailed is the final status of a packet whose processing failed on the destination chain,
+	// e.g. the tokens of a transfer are refunded to the sender.
+	PacketFailed PacketStatus = "failed"
+
+	// PacketTimedOut is the final status of a packet not received before its timeout, e.g. the
+	// tokens of a transfer are refunded to the sender.
+	PacketTimedOut PacketStatus = "timed_out"
+)
+
+// IsFinal checks if the packet won't be relayed anymore.
+func (s PacketStatus) IsFinal() bool {
+	return s == PacketAcknowledged || s == PacketFailed || s == PacketTimedOut
+}
+
+// PacketDelivery is the delivery of an IBC packet.
+type PacketDelivery struct {
+	// Status is the delivery status of the packet.
+	Status PacketStatus
+
+	// Error is the error of the acknowledgement of a failed packet.
+	Error string
+}
+
+// PacketOption configures the tracking of a packet.
+type PacketOption func(*packetOptions)
+
+type packetOptions struct {
+	interval time.Duration
+	onStatus func(PacketStatus)
+}
+
+// WithPacketInterval sets the interval between two checks of the packet, 1s by default.
+func WithPacketInterval(interval time.Duration) PacketOption {
+	return func(o *packetOptions) {
+		if interval > 0 {
+			o.interval = interval
+		}
+	}
+}
+
+// WithPacketStatus calls onStatus each time the status of the packet changes.
+func WithPacketStatus(onStatus func(PacketStatus)) PacketOption {
+	return func(o *packetOptions) {
+		o.onStatus = onStatus
+	}
+}
+
+// IBCTransferTx transfers amount from the account to the receiver address of the chain at the
+// other end of the channel, e.g. channel-0, with an ICS-20 transfer in a tx. The transfer times
+// out when it isn't received within timeout, 10m when it's zero. The options set the optional
+// fields of the tx like its memo or fees.
+//
+// The packet of the transfer is returned by the SentPackets method of the response, track its
+// delivery with TrackPacket.
+func (c Client) IBCTransferTx(
+	senderAccountName,
+	channelID,
+	receiver string,
+	amount sdktypes.Coin,
+	timeout time.Duration,
+	options ...BroadcastOption,
+) (Response, error) {
+	sender, err := c.AccountAddress(senderAccountName)
+	if err != nil {
+		return Response{}, err
+	}
+	// the receiver is an address of another chain, its prefix can't be checked.
+	if _, _, err := bech32.DecodeAndConvert(receiver); err != nil {
+		return Response{}, errors.Wrapf(err, "invalid address %s", receiver)
+	}
+	if timeout <= 0 {
+		timeout = defaultIBCTransferTimeout
+	}
+
+	msg := ibctransfer.NewMsgTransfer(
+		ibctransfer.PortID,
+		channelID,
+		amount,
+		sender,
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(time.Now().Add(timeout).UnixNano()),
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return Response{}, err
+	}
+	return c.BroadcastTxWithOptions(senderAccountName, []sdktypes.Msg{msg}, options...)
+}
+
+// SentPackets returns the IBC packets sent by the tx, e.g. the packet of an ICS-20 transfer.
+func (r Response) SentPackets() ([]channeltypes.Packet, error) {
+	var packets []channeltypes.Packet
+	for _, log := range r.Logs {
+		for _, event := range log.Events {
+			if event.Type != channeltypes.EventTypeSendPacket {
+				continue
+			}
+
+			attributes := make(map[string]string)
+			for _, attribute := range event.Attributes {
+				attributes[attribute.Key] = attribute.Value
+			}
+			packet, err := sentPacket(attributes)
+			if err != nil {
+				return nil, err
+			}
+			packets = append(packets, packet)
+		}
+	}
+	return packets, nil
+}
+
+// sentPacket returns the packet of the attributes of a send_packet event.
+func sentPacket(attributes map[string]string) (channeltypes.Packet, error) {
+	sequence, err := strconv.ParseUint(attributes[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return channeltypes.Packet{}, errors.Wrap(err, "invalid packet sequence")
+	}
+	data, err := hex.DecodeString(attributes[channeltypes.AttributeKeyDataHex])
+	if err != nil {
+		return channeltypes.Packet{}, errors.Wrap(err, "invalid packet data")
+	}
+	timeoutHeight, err := clienttypes.ParseHeight(attributes[channeltypes.AttributeKeyTimeoutHeight])
+	if err != nil {
+		return channeltypes.Packet{}, errors.Wrap(err, "invalid packet timeout height")
+	}
+	timeoutTimestamp, err := strconv.ParseUint(attributes[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+	if err != nil {
+		return channeltypes.Packet{}, errors.Wrap(err, "invalid packet timeout timestamp")
+	}
+
+	return channeltypes.NewPacket(
+		data,
+		sequence,
+		attributes[channeltypes.AttributeKeySrcPort],
+		attributes[channeltypes.AttributeKeySrcChannel],
+		attributes[channeltypes.AttributeKeyDstPort],
+		attributes[channeltypes.AttributeKeyDstChannel],
+		timeoutHeight,
+		timeoutTimestamp,
+	), nil
+}
+
+// TrackPacket follows the delivery of the packet sent by the chain of the client to the chain of
+// the destination client until its status is final or ctx is canceled. The packet is acknowledged
+// or timed out once the relayer relays the result of its delivery back to the source chain.
+//
+// The delivery is checked from the state of the channel on both chains, the error of a failed
+// packet is read from the acknowledgement events of the destination chain, which must index its
+// transactions. Only the packets of unordered channels can be tracked, e.g. ICS-20 transfers.
+func (c Client) TrackPacket(ctx context.Context, destination Client, packet channeltypes.Packet, options ...PacketOption) (PacketDelivery, error) {
+	o := packetOptions{interval: defaultPacketInterval}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	var last PacketStatus
+	for {
+		delivery, err := c.packetDelivery(ctx, destination, packet)
+		if err != nil {
+			return PacketDelivery{}, err
+		}
+		if delivery.Status != last {
+			last = delivery.Status
+			if o.onStatus != nil {
+				o.onStatus(last)
+			}
+		}
+		if delivery.Status.IsFinal() {
+			return delivery, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return delivery, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// packetDelivery returns the current delivery of the packet.
+func (c Client) packetDelivery(ctx context.Context, destination Client, packet channeltypes.Packet) (PacketDelivery, error) {
+	// the commitment of the packet is deleted from the source chain once it's acknowledged or
+	// timed out.
+	_, err := channeltypes.NewQueryClient(c.QueryConn()).PacketCommitment(ctx, &channeltypes.QueryPacketCommitmentRequest{
+		PortId:    packet.SourcePort,
+		ChannelId: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+	})
+	committed := err == nil
+	if err != nil && status.Code(err) != codes.NotFound {
+		return PacketDelivery{}, errors.Wrapf(err, "cannot query the commitment of the packet %d", packet.Sequence)
+	}
+
+	receipt, err := channeltypes.NewQueryClient(destination.QueryConn()).PacketReceipt(ctx, &channeltypes.QueryPacketReceiptRequest{
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Sequence:  packet.Sequence,
+	})
+	if err != nil {
+		return PacketDelivery{}, errors.Wrapf(err, "cannot query the receipt of the packet %d", packet.Sequence)
+	}
+
+	switch {
+	case !receipt.Received && committed:
+		return PacketDelivery{Status: PacketPending}, nil
+	case !receipt.Received:
+		return PacketDelivery{Status: PacketTimedOut}, nil
+	case committed:
+		return PacketDelivery{Status: PacketReceived}, nil
+	}
+
+	ack, err := destination.packetAcknowledgement(ctx, packet)
+	if err != nil {
+		return PacketDelivery{}, err
+	}
+	return acknowledgedDelivery(ack)
+}
+
+// packetAcknowledgement returns the acknowledgement of the packet written by the chain of the
+// client, it's nil when the acknowledgement isn't found.
+func (c Client) packetAcknowledgement(ctx context.Context, packet channeltypes.Packet) ([]byte, error) {
+	query := fmt.Sprintf(
+		"%[1]s.%[2]s='%[3]s' AND %[1]s.%[4]s='%[5]s' AND %[1]s.%[6]s='%[7]d'",
+		channeltypes.EventTypeWriteAck,
+		channeltypes.AttributeKeyDstPort, packet.DestinationPort,
+		channeltypes.AttributeKeyDstChannel, packet.DestinationChannel,
+		channeltypes.AttributeKeySequence, packet.Sequence,
+	)
+	res, err := c.RPC.TxSearch(ctx, query, false, nil, nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot search the acknowledgement of the packet %d", packet.Sequence)
+	}
+
+	for _, tx := range res.Txs {
+		if ack, ok := writtenAcknowledgement(tx.TxResult.Events, packet); ok {
+			return hex.DecodeString(ack)
+		}
+	}
+	return nil, nil
+}
+
+// writtenAcknowledgement returns the hex encoded acknowledgement of the packet in the events.
+func writtenAcknowledgement(events []abci.Event, packet channeltypes.Packet) (string, bool) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		if attributes[channeltypes.AttributeKeyDstPort] == packet.DestinationPort &&
+			attributes[channeltypes.AttributeKeyDstChannel] == packet.DestinationChannel &&
+			attributes[channeltypes.AttributeKeySequence] == strconv.FormatUint(packet.Sequence, 10) {
+			return attributes[channeltypes.AttributeKeyAckHex], true
+		}
+	}
+	return "", false
+}
+
+// acknowledgedDelivery returns the delivery of an acknowledged packet, the packet is considered
+// acknowledged when its acknowledgement isn't found.
+func acknowledgedDelivery(ack []byte) (PacketDelivery, error) {
+	if ack == nil {
+		return PacketDelivery{Status: PacketAcknowledged}, nil
+	}
+
+	var a channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(ack, &a); err != nil {
+		return PacketDelivery{}, errors.Wrap(err, "invalid packet acknowledgement")
+	}
+	if !a.Success() {
+		return PacketDelivery{Status: PacketFailed, Error: a.GetError()}, nil
+	}
+	return PacketDelivery{Status: PacketAcknowledged}, nil
+}
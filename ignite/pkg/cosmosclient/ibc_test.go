@@ -0,0 +1,108 @@
+package cosmosclient
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestSentPackets(t *testing.T) {
+	res := Response{TxResponse: &sdktypes.TxResponse{
+		Logs: sdktypes.ABCIMessageLogs{{
+			Events: sdktypes.StringEvents{
+				{Type: "transfer", Attributes: []sdktypes.Attribute{{Key: "amount", Value: "10token"}}},
+				{Type: channeltypes.EventTypeSendPacket, Attributes: []sdktypes.Attribute{
+					{Key: channeltypes.AttributeKeyDataHex, Value: hex.EncodeToString([]byte(`{"amount":"10"}`))},
+					{Key: channeltypes.AttributeKeyTimeoutHeight, Value: "0-0"},
+					{Key: channeltypes.AttributeKeyTimeoutTimestamp, Value: "1700000000000000000"},
+					{Key: channeltypes.AttributeKeySequence, Value: "7"},
+					{Key: channeltypes.AttributeKeySrcPort, Value: "transfer"},
+					{Key: channeltypes.AttributeKeySrcChannel, Value: "channel-0"},
+					{Key: channeltypes.AttributeKeyDstPort, Value: "transfer"},
+					{Key: channeltypes.AttributeKeyDstChannel, Value: "channel-3"},
+				}},
+			},
+		}},
+	}}
+
+	packets, err := res.SentPackets()
+	require.NoError(t, err)
+	require.Equal(t, []channeltypes.Packet{channeltypes.NewPacket(
+		[]byte(`{"amount":"10"}`),
+		7,
+		"transfer",
+		"channel-0",
+		"transfer",
+		"channel-3",
+		clienttypes.ZeroHeight(),
+		1700000000000000000,
+	)}, packets)
+
+	res.Logs[0].Events[1].Attributes[3].Value = "seven"
+	_, err = res.SentPackets()
+	require.ErrorContains(t, err, "invalid packet sequence")
+}
+
+func TestPacketAcknowledgement(t *testing.T) {
+	packet := channeltypes.Packet{Sequence: 7, DestinationPort: "transfer", DestinationChannel: "channel-3"}
+	writeAck := func(sequence string, ack channeltypes.Acknowledgement) abci.Event {
+		return abci.Event{Type: channeltypes.EventTypeWriteAck, Attributes: []abci.EventAttribute{
+			{Key: []byte(channeltypes.AttributeKeyDstPort), Value: []byte("transfer")},
+			{Key: []byte(channeltypes.AttributeKeyDstChannel), Value: []byte("channel-3")},
+			{Key: []byte(channeltypes.AttributeKeySequence), Value: []byte(sequence)},
+			{Key: []byte(channeltypes.AttributeKeyAckHex), Value: []byte(hex.EncodeToString(ack.Acknowledgement()))},
+		}}
+	}
+
+	// the acknowledgement of the packet is picked among the ones of the tx.
+	failed := channeltypes.NewErrorAcknowledgement("invalid receiver")
+	ack, ok := writtenAcknowledgement([]abci.Event{
+		writeAck("6", channeltypes.NewResultAcknowledgement([]byte{1})),
+		writeAck("7", failed),
+	}, packet)
+	require.True(t, ok)
+	require.Equal(t, hex.EncodeToString(failed.Acknowledgement()), ack)
+
+	_, ok = writtenAcknowledgement([]abci.Event{writeAck("6", failed)}, packet)
+	require.False(t, ok)
+
+	delivery, err := acknowledgedDelivery(failed.Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, PacketDelivery{Status: PacketFailed, Error: "invalid receiver"}, delivery)
+
+	delivery, err = acknowledgedDelivery(channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, PacketDelivery{Status: PacketAcknowledged}, delivery)
+
+	delivery, err = acknowledgedDelivery(nil)
+	require.NoError(t, err)
+	require.Equal(t, PacketDelivery{Status: PacketAcknowledged}, delivery)
+
+	require.False(t, PacketReceived.IsFinal())
+	require.True(t, PacketTimedOut.IsFinal())
+}
+
+func TestIBCTransferInvalid(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	var c Client
+	WithAccountRegistry(registry)(&c)
+	WithAddressPrefix("mars")(&c)
+
+	account, _, err := c.CreateAccount("alice")
+	require.NoError(t, err)
+
+	_, err = c.IBCTransferTx("alice", "channel-0", "venus1invalid", sdktypes.NewInt64Coin("token", 1), 0)
+	require.ErrorContains(t, err, "invalid address")
+
+	_, err = c.IBCTransferTx("alice", "", account.Address("venus"), sdktypes.NewInt64Coin("token", 1), 0)
+	require.Error(t, err)
+}
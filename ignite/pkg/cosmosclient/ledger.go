@@ -0,0 +1,39 @@
+package cosmosclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// WithLedgerHDPath sets the account and the address index of the HD path of the accounts created
+// on the Ledger device with the Ledger keyring backend. By default, both are 0.
+func WithLedgerHDPath(account, index uint32) Option {
+	return func(c *Client) {
+		c.ledgerAccount = account
+		c.ledgerIndex = index
+	}
+}
+
+// WithLedgerPrompt sets the prompt called before a tx of a Ledger account is sent to the device
+// to be signed, e.g. to ask the user to review and confirm the tx on the device.
+func WithLedgerPrompt(prompt func(accountName string)) Option {
+	return func(c *Client) {
+		c.ledgerPrompt = prompt
+	}
+}
+
+// ledgerFactory sets the sign mode supported by the Ledger devices to the factory of a tx signed by
+// a Ledger account, and prompts the user to confirm the tx. The factory of the other accounts is
+// returned as is.
+func (c Client) ledgerFactory(txf tx.Factory, account cosmosaccount.Account) tx.Factory {
+	if !account.IsLedger() {
+		return txf
+	}
+
+	if c.ledgerPrompt != nil {
+		c.ledgerPrompt(account.Name)
+	}
+	return txf.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
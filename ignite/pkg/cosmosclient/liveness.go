@@ -0,0 +1,320 @@
+package cosmosclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+)
+
+const (
+	defaultLivenessInterval = 10 * time.Second
+	defaultMaxBlockGap      = time.Minute
+
+	// validatorsPerPage is the maximum number of validators returned by a page of the node.
+	validatorsPerPage = 100
+)
+
+// Kinds of liveness alerts.
+const (
+	// LivenessAlertBlockGap is raised when the chain produces no block for longer than the max block gap.
+	LivenessAlertBlockGap = "block-gap"
+
+	// LivenessAlertValidatorSetChanged is raised when the validators or their voting power change.
+	LivenessAlertValidatorSetChanged = "validator-set-changed"
+
+	// LivenessAlertAppHashDivergence is raised when the node and the reference node have different
+	// app hashes at the same height, one of them forked or has a non-deterministic state.
+	LivenessAlertAppHashDivergence = "app-hash-divergence"
+
+	// LivenessAlertUnreachable is raised when a node can't be reached.
+	LivenessAlertUnreachable = "unreachable"
+)
+
+// LivenessAlert is an alert raised by WatchLiveness. The alerts of issues are raised when an issue
+// starts and when it's resolved, the validator set changes are raised once.
+type LivenessAlert struct {
+	Time     time.Time `json:"time"`
+	Node     string    `json:"node"`
+	Height   int64     `json:"height"`
+	Kind     string    `json:"kind"`
+	Message  string    `json:"message"`
+	Resolved bool      `json:"resolved"`
+}
+
+// LivenessOption configures the watch of the liveness of the chain.
+type LivenessOption func(*livenessOptions)
+
+type livenessOptions struct {
+	interval      time.Duration
+	maxBlockGap   time.Duration
+	referenceNode string
+}
+
+func newLivenessOptions(options []LivenessOption) livenessOptions {
+	o := livenessOptions{
+		interval:    defaultLivenessInterval,
+		maxBlockGap: defaultMaxBlockGap,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+	return o
+}
+
+// WithLivenessInterval sets the interval between two checks of the liveness, 10s by default.
+func WithLivenessInterval(interval time.Duration) LivenessOption {
+	return func(o *livenessOptions) {
+		o.interval = interval
+	}
+}
+
+// WithMaxBlockGap sets the time without a new block after which the chain is considered halted,
+// 1m by default.
+func WithMaxBlockGap(gap time.Duration) LivenessOption {
+	return func(o *livenessOptions) {
+		o.maxBlockGap = gap
+	}
+}
+
+// WithReferenceNode sets the RPC address of a second node of the chain, the app hashes of the
+// node of the client and the reference node are compared at each check to detect forks.
+func WithReferenceNode(addr string) LivenessOption {
+	return func(o *livenessOptions) {
+		o.referenceNode = addr
+	}
+}
+
+// nodeStatus is the state of a node at a check of the liveness.
+type nodeStatus struct {
+	height    int64
+	blockTime time.Time
+	appHash   []byte
+}
+
+// WatchLiveness checks the liveness of the chain at each interval until ctx is canceled and
+// sends the alerts over alerts: the gaps in the block production, the changes of the validator
+// set and, with WithReferenceNode, the divergences of the app hash of the node and a reference
+// node.
+func (c Client) WatchLiveness(ctx context.Context, alerts chan<- LivenessAlert, options ...LivenessOption) error {
+	o := newLivenessOptions(options)
+
+	var reference *rpchttp.HTTP
+	if o.referenceNode != "" {
+		httpClient, err := c.newHTTPClient(o.referenceNode)
+		if err != nil {
+			return errors.Wrapf(err, "invalid reference node %s", o.referenceNode)
+		}
+		if reference, err = rpchttp.NewWithClient(o.referenceNode, "/websocket", httpClient); err != nil {
+			return errors.Wrapf(err, "invalid reference node %s", o.referenceNode)
+		}
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	var (
+		active     = make(map[string]LivenessAlert)
+		validators map[string]int64
+	)
+	for {
+		var (
+			now    = time.Now()
+			issues []LivenessAlert
+			events []LivenessAlert
+		)
+
+		status, err := c.livenessStatus(ctx, c.RPC, 0)
+		if err != nil {
+			issues = append(issues, LivenessAlert{
+				Node:    c.nodeAddress,
+				Kind:    LivenessAlertUnreachable,
+				Message: err.Error(),
+			})
+		} else {
+			if gap := now.Sub(status.blockTime); gap > o.maxBlockGap {
+				issues = append(issues, LivenessAlert{
+					Node:    c.nodeAddress,
+					Height:  status.height,
+					Kind:    LivenessAlertBlockGap,
+					Message: fmt.Sprintf("no block since %s", gap.Round(time.Second)),
+				})
+			}
+
+			next, err := c.validatorPowers(ctx, status.height)
+			if err != nil {
+				issues = append(issues, LivenessAlert{
+					Node:    c.nodeAddress,
+					Kind:    LivenessAlertUnreachable,
+					Message: err.Error(),
+				})
+			} else {
+				if validators != nil {
+					if changes := validatorSetChanges(validators, next); changes != "" {
+						events = append(events, LivenessAlert{
+							Node:    c.nodeAddress,
+							Height:  status.height,
+							Kind:    LivenessAlertValidatorSetChanged,
+							Message: changes,
+						})
+					}
+				}
+				validators = next
+			}
+		}
+
+		if reference != nil && err == nil {
+			issues = append(issues, c.compareAppHash(ctx, reference, o.referenceNode, status)...)
+		}
+
+		for _, alert := range append(changedLivenessAlerts(active, issues), events...) {
+			alert.Time = now
+			select {
+			case alerts <- alert:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// compareAppHash returns the issue of the divergence of the app hashes of the node and the
+// reference node at the latest height of both nodes.
+func (c Client) compareAppHash(ctx context.Context, reference *rpchttp.HTTP, referenceAddr string, status nodeStatus) []LivenessAlert {
+	refStatus, err := c.livenessStatus(ctx, reference, 0)
+	if err != nil {
+		return []LivenessAlert{{Node: referenceAddr, Kind: LivenessAlertUnreachable, Message: err.Error()}}
+	}
+
+	// the app hashes are compared at the same height.
+	switch {
+	case refStatus.height > status.height:
+		refStatus, err = c.livenessStatus(ctx, reference, status.height)
+	case refStatus.height < status.height:
+		status, err = c.livenessStatus(ctx, c.RPC, refStatus.height)
+	}
+	if err != nil {
+		return []LivenessAlert{{Node: referenceAddr, Kind: LivenessAlertUnreachable, Message: err.Error()}}
+	}
+
+	if bytes.Equal(status.appHash, refStatus.appHash) {
+		return nil
+	}
+	return []LivenessAlert{{
+		Node:   c.nodeAddress,
+		Height: status.height,
+		Kind:   LivenessAlertAppHashDivergence,
+		Message: fmt.Sprintf(
+			"the app hash is %X on the node and %X on the reference node %s",
+			status.appHash,
+			refStatus.appHash,
+			referenceAddr,
+		),
+	}}
+}
+
+// livenessStatus returns the status of the node at height, or at its latest height when height is 0.
+func (c Client) livenessStatus(ctx context.Context, node *rpchttp.HTTP, height int64) (nodeStatus, error) {
+	var h *int64
+	if height > 0 {
+		h = &height
+	}
+	commit, err := node.Commit(ctx, h)
+	if err != nil {
+		return nodeStatus{}, errors.Wrap(err, "cannot fetch the latest block")
+	}
+	return nodeStatus{
+		height:    commit.Height,
+		blockTime: commit.Time,
+		appHash:   commit.AppHash,
+	}, nil
+}
+
+// validatorPowers returns the voting power of the validators at height by address.
+func (c Client) validatorPowers(ctx context.Context, height int64) (map[string]int64, error) {
+	powers := make(map[string]int64)
+	for page := 1; ; page++ {
+		perPage := validatorsPerPage
+		res, err := c.RPC.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot fetch the validators at height %d", height)
+		}
+		for _, v := range res.Validators {
+			powers[v.Address.String()] = v.VotingPower
+		}
+		if len(res.Validators) == 0 || len(powers) >= res.Total {
+			return powers, nil
+		}
+	}
+}
+
+// validatorSetChanges describes the validators added, removed and whose voting power changed
+// between two validator sets, it's empty when the sets are the same.
+func validatorSetChanges(prev, next map[string]int64) string {
+	var changes []string
+	for address, power := range next {
+		prevPower, ok := prev[address]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("%s added with power %d", address, power))
+		case prevPower != power:
+			changes = append(changes, fmt.Sprintf("%s power changed from %d to %d", address, prevPower, power))
+		}
+	}
+	for address := range prev {
+		if _, ok := next[address]; !ok {
+			changes = append(changes, fmt.Sprintf("%s removed", address))
+		}
+	}
+
+	sort.Strings(changes)
+	return strings.Join(changes, ", ")
+}
+
+// changedLivenessAlerts returns the alerts of the issues that started or were resolved since the
+// last check and updates the active issues. The issues are identified by their kind and node.
+func changedLivenessAlerts(active map[string]LivenessAlert, issues []LivenessAlert) []LivenessAlert {
+	var (
+		alerts  []LivenessAlert
+		current = make(map[string]bool)
+	)
+	for _, issue := range issues {
+		key := issue.Kind + " " + issue.Node
+		current[key] = true
+		if _, ok := active[key]; !ok {
+			active[key] = issue
+			alerts = append(alerts, issue)
+		}
+	}
+	for key, issue := range active {
+		if !current[key] {
+			delete(active, key)
+			alerts = append(alerts, LivenessAlert{
+				Node:     issue.Node,
+				Height:   issue.Height,
+				Kind:     issue.Kind,
+				Message:  "resolved",
+				Resolved: true,
+			})
+		}
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Kind != alerts[j].Kind {
+			return alerts[i].Kind < alerts[j].Kind
+		}
+		return alerts[i].Node < alerts[j].Node
+	})
+	return alerts
+}
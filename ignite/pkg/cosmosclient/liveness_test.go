@@ -0,0 +1,60 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorSetChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		prev map[string]int64
+		next map[string]int64
+		want string
+	}{
+		{
+			name: "same set",
+			prev: map[string]int64{"A": 10, "B": 20},
+			next: map[string]int64{"A": 10, "B": 20},
+			want: "",
+		},
+		{
+			name: "added, removed and power changed",
+			prev: map[string]int64{"A": 10, "B": 20},
+			next: map[string]int64{"A": 15, "C": 5},
+			want: "A power changed from 10 to 15, B removed, C added with power 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, validatorSetChanges(tt.prev, tt.next))
+		})
+	}
+}
+
+func TestChangedLivenessAlerts(t *testing.T) {
+	active := make(map[string]LivenessAlert)
+
+	gap := LivenessAlert{Node: "node", Kind: LivenessAlertBlockGap, Height: 5, Message: "no block since 2m0s"}
+	unreachable := LivenessAlert{Node: "reference", Kind: LivenessAlertUnreachable, Message: "timeout"}
+
+	// the issues are raised when they start.
+	alerts := changedLivenessAlerts(active, []LivenessAlert{unreachable, gap})
+	require.Equal(t, []LivenessAlert{gap, unreachable}, alerts)
+
+	// the active issues aren't raised again.
+	alerts = changedLivenessAlerts(active, []LivenessAlert{gap, unreachable})
+	require.Empty(t, alerts)
+
+	// the issues are raised when they're resolved.
+	alerts = changedLivenessAlerts(active, []LivenessAlert{unreachable})
+	require.Equal(t, []LivenessAlert{{
+		Node:     "node",
+		Kind:     LivenessAlertBlockGap,
+		Height:   5,
+		Message:  "resolved",
+		Resolved: true,
+	}}, alerts)
+	require.Len(t, active, 1)
+}
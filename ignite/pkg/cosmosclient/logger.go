@@ -0,0 +1,76 @@
+package cosmosclient
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ignite/cli/ignite/pkg/correlation"
+)
+
+// WithLogger logs the RPC calls, their retries, the faucet requests and the broadcast results of
+// the client with levels and fields: the RPC calls are logged at the debug level, the retries and
+// results at the info level and the failures at the error level.
+// Use log.NewFilter to select the level of the logs. By default, the client doesn't log.
+func WithLogger(logger log.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// log returns the logger of the client.
+func (c Client) log() log.Logger {
+	if c.logger == nil {
+		return log.NewNopLogger()
+	}
+	return c.logger
+}
+
+// logTransport is an HTTP transport logging the RPC calls.
+type logTransport struct {
+	next   http.RoundTripper
+	logger log.Logger
+}
+
+func (t logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		start   = time.Now()
+		keyvals = []interface{}{"node", req.URL.Host, "method", rpcMethod(req)}
+		logger  = correlation.Logger(req.Context(), t.logger)
+	)
+
+	resp, err := t.next.RoundTrip(req)
+	keyvals = append(keyvals, "duration", time.Since(start))
+	if err != nil {
+		logger.Error("rpc call failed", append(keyvals, "err", err)...)
+		return nil, err
+	}
+
+	logger.Debug("rpc call", append(keyvals, "status", resp.StatusCode)...)
+	return resp, nil
+}
+
+// rpcMethod returns the method of the JSON-RPC call of the request, the path of the request
+// when its body can't be read again.
+func rpcMethod(req *http.Request) string {
+	if req.GetBody == nil {
+		return req.URL.Path
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return req.URL.Path
+	}
+	defer body.Close()
+
+	var call struct {
+		Method string `json:"method"`
+	}
+	data, err := io.ReadAll(body)
+	if err != nil || json.Unmarshal(data, &call) != nil || call.Method == "" {
+		return req.URL.Path
+	}
+	return call.Method
+}
@@ -0,0 +1,304 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/pkg/errors"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// defaultMempoolInterval is the interval between two polls of the mempool by default.
+	defaultMempoolInterval = time.Second
+
+	// maxUnconfirmedTXs is the maximum number of transactions of the mempool returned by the node.
+	maxUnconfirmedTXs = 100
+)
+
+// MempoolOption configures the streaming of the transactions of the mempool.
+type MempoolOption func(*mempoolOptions)
+
+type mempoolOptions struct {
+	interval time.Duration
+}
+
+// WithMempoolInterval sets the interval between two polls of the mempool, 1s by default.
+func WithMempoolInterval(interval time.Duration) MempoolOption {
+	return func(o *mempoolOptions) {
+		if interval > 0 {
+			o.interval = interval
+		}
+	}
+}
+
+// UnconfirmedTX is a transaction waiting in the mempool of the node.
+type UnconfirmedTX struct {
+	// Hash is the hash of the transaction.
+	Hash string
+
+	// Raw is the transaction bytes.
+	Raw tmtypes.Tx
+
+	// MessageTypes are the type URLs of the messages of the transaction.
+	MessageTypes []string
+
+	// Messages are the messages of the transaction decoded with the codec of the client, the
+	// messages of the modules whose interfaces aren't registered are nil, see WithRegisterInterfaces.
+	Messages []sdktypes.Msg
+
+	// Fee is the fee paid for the transaction.
+	Fee sdktypes.Coins
+
+	// Gas is the gas limit of the transaction.
+	Gas uint64
+
+	// Memo is the memo of the transaction.
+	Memo string
+
+	// Signers are the accounts signing the transaction, in the order of their signatures.
+	Signers []UnconfirmedTXSigner
+}
+
+// UnconfirmedTXSigner is an account signing an unconfirmed transaction.
+type UnconfirmedTXSigner struct {
+	// Address is the address of the account, it's empty when the signature has no public key.
+	Address sdktypes.AccAddress
+
+	// Sequence is the sequence of the account used by the signature.
+	Sequence uint64
+}
+
+// UnconfirmedTXs returns up to limit transactions of the mempool of the node, and the total
+// number of transactions of the mempool. The node returns at most 100 transactions.
+func (c Client) UnconfirmedTXs(ctx context.Context, limit int) (txs []UnconfirmedTX, total int, err error) {
+	res, err := c.RPC.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "cannot fetch the unconfirmed transactions")
+	}
+
+	for _, raw := range res.Txs {
+		tx, err := decodeUnconfirmedTX(c.context.InterfaceRegistry, raw)
+		if err != nil {
+			return nil, 0, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, res.Total, nil
+}
+
+// StreamUnconfirmedTXs sends the transactions entering the mempool of the node over tc until ctx is
+// canceled. The node doesn't publish the transactions entering its mempool, so the mempool is
+// polled every second, see WithMempoolInterval, and each transaction is sent once while it's
+// pending. Only the first 100 transactions of the mempool are observed, the limit of the node,
+// and the transactions committed between two polls are missed.
+func (c Client) StreamUnconfirmedTXs(ctx context.Context, tc chan<- UnconfirmedTX, options ...MempoolOption) error {
+	o := mempoolOptions{interval: defaultMempoolInterval}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	var seen map[string]bool
+	for {
+		txs, _, err := c.UnconfirmedTXs(ctx, maxUnconfirmedTXs)
+		if err != nil {
+			return err
+		}
+
+		var fresh []UnconfirmedTX
+		fresh, seen = newUnconfirmedTXs(seen, txs)
+		for _, tx := range fresh {
+			select {
+			case tc <- tx:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// newUnconfirmedTXs returns the transactions of the mempool that aren't seen by the previous poll,
+// and the hashes of the transactions of the mempool seen by the next poll. A transaction leaving
+// the mempool is forgotten, it's sent again if it enters the mempool again.
+func newUnconfirmedTXs(seen map[string]bool, txs []UnconfirmedTX) (fresh []UnconfirmedTX, next map[string]bool) {
+	next = make(map[string]bool, len(txs))
+	for _, tx := range txs {
+		if !seen[tx.Hash] {
+			fresh = append(fresh, tx)
+		}
+		next[tx.Hash] = true
+	}
+	return fresh, next
+}
+
+// decodeUnconfirmedTX decodes the transaction bytes, the messages that can't be unpacked with
+// the registry are kept as type URLs so the fees and the signers of any transaction are decoded.
+func decodeUnconfirmedTX(registry codectypes.InterfaceRegistry, raw tmtypes.Tx) (UnconfirmedTX, error) {
+	tx := UnconfirmedTX{
+		Hash: fmt.Sprintf("%X", raw.Hash()),
+		Raw:  raw,
+	}
+
+	var (
+		txRaw    txtypes.TxRaw
+		body     txtypes.TxBody
+		authInfo txtypes.AuthInfo
+	)
+	if err := txRaw.Unmarshal(raw); err != nil {
+		return UnconfirmedTX{}, errors.Wrapf(err, "cannot decode the transaction %s", tx.Hash)
+	}
+	if err := body.Unmarshal(txRaw.BodyBytes); err != nil {
+		return UnconfirmedTX{}, errors.Wrapf(err, "cannot decode the body of the transaction %s", tx.Hash)
+	}
+	if err := authInfo.Unmarshal(txRaw.AuthInfoBytes); err != nil {
+		return UnconfirmedTX{}, errors.Wrapf(err, "cannot decode the auth info of the transaction %s", tx.Hash)
+	}
+
+	tx.Memo = body.Memo
+	for _, any := range body.Messages {
+		var msg sdktypes.Msg
+		if err := registry.UnpackAny(any, &msg); err != nil {
+			msg = nil
+		}
+		tx.MessageTypes = append(tx.MessageTypes, any.TypeUrl)
+		tx.Messages = append(tx.Messages, msg)
+	}
+
+	if authInfo.Fee != nil {
+		tx.Fee = authInfo.Fee.Amount
+		tx.Gas = authInfo.Fee.GasLimit
+	}
+
+	for _, info := range authInfo.SignerInfos {
+		signer := UnconfirmedTXSigner{Sequence: info.Sequence}
+		var pubKey cryptotypes.PubKey
+		if info.PublicKey != nil && registry.UnpackAny(info.PublicKey, &pubKey) == nil {
+			signer.Address = sdktypes.AccAddress(pubKey.Address())
+		}
+		tx.Signers = append(tx.Signers, signer)
+	}
+
+	return tx, nil
+}
+
+// RebroadcastTX signs the unconfirmed tx again for account with its fees multiplied by
+// feeMultiplier and broadcasts it in sync mode. The tx keeps its messages and the sequence of
+// the account, so it replaces the unconfirmed tx. Tendermint rejects a tx using the sequence of
+// a tx of its mempool, the new tx is only accepted once the node drops the unconfirmed tx.
+// The tx must have a single signer and the account can't be a Ledger account, the tx is signed
+// in direct mode.
+func (c Client) RebroadcastTX(accountName string, tx UnconfirmedTX, feeMultiplier float64) (Response, error) {
+	if c.queryHeight > 0 {
+		return Response{}, errHistoricalBroadcast
+	}
+
+	account, err := c.Account(accountName)
+	if err != nil {
+		return Response{}, err
+	}
+	if account.IsLedger() {
+		return Response{}, errors.New("the txs of a Ledger account can't be rebroadcasted")
+	}
+
+	var (
+		txRaw    txtypes.TxRaw
+		authInfo txtypes.AuthInfo
+	)
+	if err := txRaw.Unmarshal(tx.Raw); err != nil {
+		return Response{}, errors.Wrapf(err, "cannot decode the transaction %s", tx.Hash)
+	}
+	if err := authInfo.Unmarshal(txRaw.AuthInfoBytes); err != nil {
+		return Response{}, errors.Wrapf(err, "cannot decode the auth info of the transaction %s", tx.Hash)
+	}
+	if len(authInfo.SignerInfos) != 1 || len(tx.Signers) != 1 || authInfo.Fee == nil {
+		return Response{}, errors.Errorf("the transaction %s must have a single signer to be rebroadcasted", tx.Hash)
+	}
+	address := account.Info.GetAddress()
+	if !address.Equals(tx.Signers[0].Address) {
+		return Response{}, errors.Errorf("the transaction %s isn't signed by %s", tx.Hash, accountName)
+	}
+
+	fees, err := multiplyFees(tx.Fee, feeMultiplier)
+	if err != nil {
+		return Response{}, err
+	}
+	if !fees.IsAnyGT(tx.Fee) {
+		return Response{}, errors.Errorf("the fees of the transaction %s must increase to rebroadcast it", tx.Hash)
+	}
+
+	accountNumber, _, err := c.context.AccountRetriever.GetAccountNumberSequence(c.context, address)
+	if err != nil {
+		return Response{}, err
+	}
+
+	authInfo.Fee.Amount = fees
+	authInfo.SignerInfos[0].ModeInfo = &txtypes.ModeInfo{
+		Sum: &txtypes.ModeInfo_Single_{
+			Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+		},
+	}
+	if txRaw.AuthInfoBytes, err = authInfo.Marshal(); err != nil {
+		return Response{}, err
+	}
+
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: txRaw.AuthInfoBytes,
+		ChainId:       c.chainID,
+		AccountNumber: accountNumber,
+	}
+	signBytes, err := signDoc.Marshal()
+	if err != nil {
+		return Response{}, err
+	}
+	signature, _, err := c.AccountRegistry.Keyring.Sign(accountName, signBytes)
+	if err != nil {
+		return Response{}, err
+	}
+	txRaw.Signatures = [][]byte{signature}
+
+	txBytes, err := txRaw.Marshal()
+	if err != nil {
+		return Response{}, err
+	}
+
+	resp, err := c.context.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(txBytes)
+	c.logBroadcastResult(accountName, resp, err)
+
+	return Response{
+		Codec:      c.context.Codec,
+		TxResponse: resp,
+	}, handleBroadcastResult(resp, err)
+}
+
+// multiplyFees multiplies the amounts of the fees by multiplier, rounded up.
+func multiplyFees(fees sdktypes.Coins, multiplier float64) (sdktypes.Coins, error) {
+	m, err := sdktypes.NewDecFromStr(strconv.FormatFloat(multiplier, 'f', -1, 64))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid fee multiplier %v", multiplier)
+	}
+
+	multiplied := make(sdktypes.Coins, len(fees))
+	for i, fee := range fees {
+		amount := fee.Amount.ToDec().Mul(m).Ceil().TruncateInt()
+		multiplied[i] = sdktypes.NewCoin(fee.Denom, amount)
+	}
+	return multiplied, nil
+}
@@ -0,0 +1,71 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeUnconfirmedTX(t *testing.T) {
+	var (
+		ctx     = newContext(nil, nil, "chain", "", codecOptions{})
+		pubKey  = secp256k1.GenPrivKey().PubKey()
+		from    = sdktypes.AccAddress(pubKey.Address())
+		fee     = sdktypes.NewCoins(sdktypes.NewInt64Coin("stake", 200))
+		builder = ctx.TxConfig.NewTxBuilder()
+	)
+	msg := banktypes.NewMsgSend(from, from, sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)))
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(100000)
+	builder.SetMemo("memo")
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: 7,
+	}))
+	raw, err := ctx.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	tx, err := decodeUnconfirmedTX(ctx.InterfaceRegistry, raw)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, tx.MessageTypes)
+	require.Equal(t, msg, tx.Messages[0])
+	require.Equal(t, fee, tx.Fee)
+	require.EqualValues(t, 100000, tx.Gas)
+	require.Equal(t, "memo", tx.Memo)
+	require.Equal(t, []UnconfirmedTXSigner{{Address: from, Sequence: 7}}, tx.Signers)
+
+	// the messages of the modules that aren't registered are kept as type URLs.
+	tx, err = decodeUnconfirmedTX(codectypes.NewInterfaceRegistry(), raw)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, tx.MessageTypes)
+	require.Nil(t, tx.Messages[0])
+	require.Equal(t, fee, tx.Fee)
+}
+
+func TestNewUnconfirmedTXs(t *testing.T) {
+	a, b, c := UnconfirmedTX{Hash: "A"}, UnconfirmedTX{Hash: "B"}, UnconfirmedTX{Hash: "C"}
+
+	fresh, seen := newUnconfirmedTXs(nil, []UnconfirmedTX{a, b})
+	require.Equal(t, []UnconfirmedTX{a, b}, fresh)
+
+	// the transactions still pending aren't sent again.
+	fresh, seen = newUnconfirmedTXs(seen, []UnconfirmedTX{b, c})
+	require.Equal(t, []UnconfirmedTX{c}, fresh)
+
+	// a transaction entering the mempool again is sent again.
+	fresh, _ = newUnconfirmedTXs(seen, []UnconfirmedTX{a, c})
+	require.Equal(t, []UnconfirmedTX{a}, fresh)
+}
+
+func TestMultiplyFees(t *testing.T) {
+	fees, err := multiplyFees(sdktypes.NewCoins(sdktypes.NewInt64Coin("stake", 15), sdktypes.NewInt64Coin("token", 3)), 1.5)
+	require.NoError(t, err)
+	require.Equal(t, sdktypes.NewCoins(sdktypes.NewInt64Coin("stake", 23), sdktypes.NewInt64Coin("token", 5)), fees)
+}
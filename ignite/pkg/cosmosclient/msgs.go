@@ -0,0 +1,71 @@
+package cosmosclient
+
+import (
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+)
+
+// BankSendTx sends amount from the account to the address in a tx, the gas of the tx is simulated
+// unless it's set by the options. The options set the optional fields of the tx like its memo or fees.
+func (c Client) BankSendTx(fromAccountName, toAddress string, amount sdktypes.Coins, options ...BroadcastOption) (Response, error) {
+	from, err := c.AccountAddress(fromAccountName)
+	if err != nil {
+		return Response{}, err
+	}
+	if err := checkAddress(toAddress, c.addressPrefix); err != nil {
+		return Response{}, err
+	}
+
+	msg := &banktypes.MsgSend{
+		FromAddress: from,
+		ToAddress:   toAddress,
+		Amount:      amount,
+	}
+	return c.BroadcastTxWithOptions(fromAccountName, []sdktypes.Msg{msg}, options...)
+}
+
+// DelegateTx delegates amount from the account to the validator address, e.g. cosmosvaloper1...,
+// in a tx. The options set the optional fields of the tx like its memo or fees.
+func (c Client) DelegateTx(delegatorAccountName, validatorAddress string, amount sdktypes.Coin, options ...BroadcastOption) (Response, error) {
+	delegator, err := c.AccountAddress(delegatorAccountName)
+	if err != nil {
+		return Response{}, err
+	}
+	if err := checkAddress(validatorAddress, c.addressPrefix+sdktypes.PrefixValidator+sdktypes.PrefixOperator); err != nil {
+		return Response{}, err
+	}
+
+	msg := &staking.MsgDelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}
+	return c.BroadcastTxWithOptions(delegatorAccountName, []sdktypes.Msg{msg}, options...)
+}
+
+// VoteTx votes option on the gov proposal with the account in a tx. The options set the optional
+// fields of the tx like its memo or fees.
+func (c Client) VoteTx(voterAccountName string, proposalID uint64, option gov.VoteOption, options ...BroadcastOption) (Response, error) {
+	voter, err := c.AccountAddress(voterAccountName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	msg := &gov.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+		Option:     option,
+	}
+	return c.BroadcastTxWithOptions(voterAccountName, []sdktypes.Msg{msg}, options...)
+}
+
+// checkAddress checks that the address is a bech32 address with the prefix.
+func checkAddress(address, prefix string) error {
+	if _, err := sdktypes.GetFromBech32(address, prefix); err != nil {
+		return errors.Wrapf(err, "invalid address %s", address)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestMsgsInvalidAddress(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	var c Client
+	WithAccountRegistry(registry)(&c)
+	WithAddressPrefix("mars")(&c)
+
+	account, _, err := c.CreateAccount("alice")
+	require.NoError(t, err)
+
+	// the address of another chain.
+	_, err = c.BankSendTx("alice", account.Address("cosmos"), sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)))
+	require.ErrorContains(t, err, "invalid address")
+
+	// an account address instead of a validator address.
+	_, err = c.DelegateTx("alice", account.Address("mars"), sdktypes.NewInt64Coin("token", 1))
+	require.ErrorContains(t, err, "invalid address")
+
+	_, err = c.BankSendTx("bob", account.Address("mars"), nil)
+	require.Error(t, err)
+}
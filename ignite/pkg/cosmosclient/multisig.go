@@ -0,0 +1,159 @@
+package cosmosclient
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// MultisigTx is a tx of a multisig account signed by the signers of the account.
+//
+// The tx is created with NewMultisigTx, each signer signs it with SignMultisigTx, the signatures
+// are combined with CombineSignatures and the tx is broadcasted with BroadcastMultisigTx.
+type MultisigTx struct {
+	// Builder is the builder of the tx.
+	Builder client.TxBuilder
+
+	// factory holds the account number and the sequence of the multisig account signed by the
+	// signers.
+	factory tx.Factory
+
+	pubKey *multisig.LegacyAminoPubKey
+}
+
+// CreateMultisigAccount creates a multisig account with name in the keyring of the client, its
+// txs are signed by threshold accounts out of the accounts of pubKeys.
+func (c Client) CreateMultisigAccount(name string, threshold int, pubKeys ...cryptotypes.PubKey) (cosmosaccount.Account, error) {
+	return c.AccountRegistry.CreateMultisig(name, threshold, pubKeys)
+}
+
+// NewMultisigTx creates an unsigned tx with given messages for the multisig account.
+// The gas limit of the tx is the gas of the factory of the client, the multisig txs aren't
+// simulated. The options set the optional fields of the tx like its memo or timeout height.
+func (c Client) NewMultisigTx(multisigName string, msgs []sdktypes.Msg, options ...BroadcastOption) (MultisigTx, error) {
+	if c.queryHeight > 0 {
+		return MultisigTx{}, errHistoricalBroadcast
+	}
+
+	var o broadcastOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	account, err := c.Account(multisigName)
+	if err != nil {
+		return MultisigTx{}, err
+	}
+
+	mconf.Lock()
+	defer mconf.Unlock()
+	config := sdktypes.GetConfig()
+	config.SetBech32PrefixForAccount(c.addressPrefix, c.addressPrefix+"pub")
+
+	pubKey, ok := account.Info.GetPubKey().(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return MultisigTx{}, fmt.Errorf("the account %s is not a multisig account", multisigName)
+	}
+
+	ctx := c.context.
+		WithFromName(multisigName).
+		WithFromAddress(account.Info.GetAddress())
+
+	txf, err := prepareFactory(ctx, c.Factory)
+	if err != nil {
+		return MultisigTx{}, err
+	}
+	// the signatures of a multisig tx don't depend on the signer infos of the tx.
+	txf = txf.
+		WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).
+		WithMemo(o.memo).
+		WithTimeoutHeight(o.timeoutHeight)
+
+	if txf, err = c.withGasPrices(txf); err != nil {
+		return MultisigTx{}, err
+	}
+
+	builder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return MultisigTx{}, err
+	}
+	builder.SetFeeGranter(ctx.GetFeeGranterAddress())
+	if err := o.setExtensionOptions(builder); err != nil {
+		return MultisigTx{}, err
+	}
+
+	return MultisigTx{
+		Builder: builder,
+		factory: txf,
+		pubKey:  pubKey,
+	}, nil
+}
+
+// SignMultisigTx signs the multisig tx with the account of a signer and returns its partial
+// signature. The account must be in the keyring of the client.
+func (c Client) SignMultisigTx(signerName string, mtx MultisigTx) (signing.SignatureV2, error) {
+	signer, err := c.Account(signerName)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if !isMultisigKey(mtx.pubKey, signer.Info.GetPubKey()) {
+		return signing.SignatureV2{}, fmt.Errorf("the account %s is not a signer of the multisig account", signerName)
+	}
+
+	// the signature is set on the builder, the signatures of the signers are combined later.
+	txf := c.ledgerFactory(mtx.factory.WithKeybase(c.AccountRegistry.Keyring), signer)
+	if err := tx.Sign(txf, signerName, mtx.Builder, true); err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	sigs, err := mtx.Builder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	return sigs[0], nil
+}
+
+// CombineSignatures combines the partial signatures of the signers into the signature of the
+// multisig account, the threshold of the account must be reached.
+func (c Client) CombineSignatures(mtx MultisigTx, sigs ...signing.SignatureV2) error {
+	if len(sigs) < int(mtx.pubKey.Threshold) {
+		return fmt.Errorf("%d signatures out of the %d required", len(sigs), mtx.pubKey.Threshold)
+	}
+
+	multisigData := multisigtypes.NewMultisig(len(mtx.pubKey.PubKeys))
+	for _, sig := range sigs {
+		if err := multisigtypes.AddSignatureV2(multisigData, sig, mtx.pubKey.GetPubKeys()); err != nil {
+			return errors.Wrap(err, "cannot add the signature")
+		}
+	}
+
+	return mtx.Builder.SetSignatures(signing.SignatureV2{
+		PubKey:   mtx.pubKey,
+		Data:     multisigData,
+		Sequence: mtx.factory.Sequence(),
+	})
+}
+
+// BroadcastMultisigTx broadcasts the multisig tx once its signatures are combined.
+func (c Client) BroadcastMultisigTx(mtx MultisigTx) (Response, error) {
+	return c.BroadcastSignedTx(mtx.Builder)
+}
+
+// isMultisigKey returns true when pubKey is one of the keys of the multisig key.
+func isMultisigKey(multisigKey *multisig.LegacyAminoPubKey, pubKey cryptotypes.PubKey) bool {
+	for _, key := range multisigKey.GetPubKeys() {
+		if key.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
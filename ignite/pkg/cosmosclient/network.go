@@ -0,0 +1,84 @@
+package cosmosclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// WithExpectedChainID makes New fail with a WrongNetworkError when the node doesn't belong to the
+// chain chainID, e.g. when the RPC address of a localnet points to a testnet instead. The offline
+// clients aren't verified.
+func WithExpectedChainID(chainID string) Option {
+	return func(c *Client) {
+		c.expectedChainID = chainID
+	}
+}
+
+// WithExpectedGenesisHash makes New fail with a WrongNetworkError when the genesis of the node
+// doesn't have the hash, the hex encoded SHA-256 of the genesis served by the node. It tells apart
+// the networks reusing the same chain ID, like the restarted devnets.
+func WithExpectedGenesisHash(hash string) Option {
+	return func(c *Client) {
+		c.expectedGenesisHash = hash
+	}
+}
+
+// genesisChunker fetches the genesis of a node by chunks.
+type genesisChunker interface {
+	GenesisChunked(ctx context.Context, chunk uint) (*ctypes.ResultGenesisChunk, error)
+}
+
+// verifyNetwork checks that the node belongs to the expected network of the client. The genesis
+// is only fetched when its hash is expected.
+func (c Client) verifyNetwork(ctx context.Context, node genesisChunker) error {
+	if c.expectedChainID != "" && c.expectedChainID != c.chainID {
+		return &WrongNetworkError{
+			Node:     c.nodeAddress,
+			Field:    "chain id",
+			Expected: c.expectedChainID,
+			Actual:   c.chainID,
+		}
+	}
+
+	if c.expectedGenesisHash == "" {
+		return nil
+	}
+	hash, err := genesisHash(ctx, node)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(strings.TrimPrefix(c.expectedGenesisHash, "0x"), hash) {
+		return &WrongNetworkError{
+			Node:     c.nodeAddress,
+			Field:    "genesis hash",
+			Expected: c.expectedGenesisHash,
+			Actual:   hash,
+		}
+	}
+	return nil
+}
+
+// genesisHash returns the hex encoded SHA-256 of the genesis of the node. The genesis is fetched
+// by chunks since the large ones aren't served at once.
+func genesisHash(ctx context.Context, node genesisChunker) (string, error) {
+	h := sha256.New()
+	for chunk, total := uint(0), uint(1); chunk < total; chunk++ {
+		res, err := node.GenesisChunked(ctx, chunk)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot fetch the genesis")
+		}
+		data, err := base64.StdEncoding.DecodeString(res.Data)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid genesis chunk")
+		}
+		h.Write(data)
+		total = uint(res.TotalChunks)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,54 @@
+package cosmosclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// chunkedGenesis serves a genesis by chunks.
+type chunkedGenesis [][]byte
+
+func (g chunkedGenesis) GenesisChunked(_ context.Context, chunk uint) (*ctypes.ResultGenesisChunk, error) {
+	if int(chunk) >= len(g) {
+		return nil, errors.New("invalid chunk")
+	}
+	return &ctypes.ResultGenesisChunk{
+		ChunkNumber: int(chunk),
+		TotalChunks: len(g),
+		Data:        base64.StdEncoding.EncodeToString(g[chunk]),
+	}, nil
+}
+
+func TestVerifyNetwork(t *testing.T) {
+	var (
+		genesis = chunkedGenesis{[]byte(`{"chain_id":`), []byte(`"mars-1"}`)}
+		sum     = sha256.Sum256([]byte(`{"chain_id":"mars-1"}`))
+		hash    = hex.EncodeToString(sum[:])
+		c       = Client{chainID: "mars-1", nodeAddress: "http://localhost:26657"}
+	)
+
+	require.NoError(t, c.verifyNetwork(context.Background(), genesis))
+
+	c.expectedChainID = "mars-1"
+	c.expectedGenesisHash = "0x" + hash
+	require.NoError(t, c.verifyNetwork(context.Background(), genesis))
+
+	c.expectedChainID = "venus-1"
+	var wrongNetwork *WrongNetworkError
+	require.ErrorAs(t, c.verifyNetwork(context.Background(), genesis), &wrongNetwork)
+	require.Equal(t, "chain id", wrongNetwork.Field)
+	require.Equal(t, "mars-1", wrongNetwork.Actual)
+
+	c.expectedChainID = "mars-1"
+	c.expectedGenesisHash = hex.EncodeToString(make([]byte, sha256.Size))
+	require.ErrorAs(t, c.verifyNetwork(context.Background(), genesis), &wrongNetwork)
+	require.Equal(t, "genesis hash", wrongNetwork.Field)
+	require.Equal(t, hash, wrongNetwork.Actual)
+}
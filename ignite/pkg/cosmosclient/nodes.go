@@ -0,0 +1,330 @@
+package cosmosclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultMaxBlocksBehind     = 5
+
+	// healthCheckTimeout is the maximum duration of the status call checking the health of a node.
+	healthCheckTimeout = 5 * time.Second
+)
+
+// NodeStatus is the health of a node of the client.
+type NodeStatus struct {
+	// Address is the RPC address of the node.
+	Address string
+
+	// Healthy is true when the node is reachable, synced and not behind the other nodes.
+	Healthy bool
+
+	// Height is the latest block height of the node, it's zero when the node is unreachable.
+	Height int64
+
+	// Latency is the duration of the last health check of the node.
+	Latency time.Duration
+
+	// Err is the error of the last call to the node, if any.
+	Err error
+}
+
+// NodeSelector returns the indexes of the nodes in the order they're tried by the RPC calls,
+// the nodes are in the order given to WithNodes.
+// The next node is tried when a node is unreachable, the nodes missing from the indexes aren't used.
+type NodeSelector func(nodes []NodeStatus) []int
+
+// SelectByPriority tries the healthy nodes in the order given to WithNodes, then the unhealthy ones.
+// This is the default node selector, the first node is used as long as it's healthy.
+func SelectByPriority(nodes []NodeStatus) []int {
+	return healthyFirst(nodes)
+}
+
+// SelectByLatency tries the healthy nodes from the fastest to the slowest, then the unhealthy ones.
+func SelectByLatency(nodes []NodeStatus) []int {
+	indexes := healthyFirst(nodes)
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := nodes[indexes[i]], nodes[indexes[j]]
+		return a.Healthy && b.Healthy && a.Latency < b.Latency
+	})
+	return indexes
+}
+
+func healthyFirst(nodes []NodeStatus) []int {
+	indexes := make([]int, 0, len(nodes))
+	for i, n := range nodes {
+		if n.Healthy {
+			indexes = append(indexes, i)
+		}
+	}
+	for i, n := range nodes {
+		if !n.Healthy {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// WithNodes sets several RPC nodes of the same chain, the RPC calls fail over to the next node
+// when a node is unreachable or when it falls behind the other nodes.
+// The health of the nodes is checked when the client is created and periodically until the
+// context of New is canceled. It replaces WithNodeAddress.
+func WithNodes(addrs ...string) Option {
+	return func(c *Client) {
+		c.nodeAddresses = addrs
+		if len(addrs) > 0 {
+			c.nodeAddress = addrs[0]
+		}
+	}
+}
+
+// WithNodeSelector sets the strategy selecting the node of the RPC calls when the client has
+// several nodes, SelectByPriority is used by default.
+func WithNodeSelector(selector NodeSelector) Option {
+	return func(c *Client) {
+		c.nodeSelector = selector
+	}
+}
+
+// WithNodeHealthCheck sets the interval between the health checks of the nodes and the maximum
+// number of blocks a node can be behind the highest node before it's considered unhealthy.
+func WithNodeHealthCheck(interval time.Duration, maxBlocksBehind int64) Option {
+	return func(c *Client) {
+		c.healthCheckInterval = interval
+		c.maxBlocksBehind = maxBlocksBehind
+	}
+}
+
+// Nodes returns the health of the nodes of the client from the last health check.
+// It returns nil when the client isn't created with WithNodes.
+func (c Client) Nodes() []NodeStatus {
+	if c.nodes == nil {
+		return nil
+	}
+	return c.nodes.snapshot()
+}
+
+// currentNodeAddress returns the address of the node the RPC calls are sent to.
+func (c Client) currentNodeAddress() string {
+	if c.nodes == nil {
+		return c.nodeAddress
+	}
+	return c.nodes.current()
+}
+
+// node is an RPC node of a node pool.
+type node struct {
+	address   string
+	url       *url.URL
+	transport http.RoundTripper
+	rpc       *rpchttp.HTTP
+}
+
+// nodePool is an HTTP transport sending the RPC calls to the node selected among its nodes.
+type nodePool struct {
+	nodes           []node
+	selector        NodeSelector
+	maxBlocksBehind int64
+
+	mu       sync.RWMutex
+	statuses []NodeStatus
+}
+
+func newNodePool(
+	addrs []string,
+	selector NodeSelector,
+	maxBlocksBehind int64,
+	newHTTPClient func(remote string) (*http.Client, error),
+) (*nodePool, error) {
+	if selector == nil {
+		selector = SelectByPriority
+	}
+
+	p := &nodePool{
+		selector:        selector,
+		maxBlocksBehind: maxBlocksBehind,
+		statuses:        make([]NodeStatus, len(addrs)),
+	}
+
+	for i, addr := range addrs {
+		u, err := url.Parse(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid node address %s", addr)
+		}
+		// the RPC calls are sent over HTTP to tcp addresses
+		if u.Scheme == "tcp" {
+			u.Scheme = "http"
+		}
+
+		httpClient, err := newHTTPClient(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid node address %s", addr)
+		}
+
+		rpc, err := rpchttp.NewWithClient(addr, "/websocket", httpClient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid node address %s", addr)
+		}
+
+		p.nodes = append(p.nodes, node{
+			address:   addr,
+			url:       u,
+			transport: httpClient.Transport,
+			rpc:       rpc,
+		})
+		p.statuses[i] = NodeStatus{Address: addr, Healthy: true}
+	}
+
+	return p, nil
+}
+
+func (p *nodePool) snapshot() []NodeStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return append([]NodeStatus(nil), p.statuses...)
+}
+
+// order returns the indexes of the nodes in the order they're tried.
+func (p *nodePool) order() []int {
+	return p.selector(p.snapshot())
+}
+
+func (p *nodePool) current() string {
+	if order := p.order(); len(order) > 0 {
+		return p.nodes[order[0]].address
+	}
+	return p.nodes[0].address
+}
+
+// markUnhealthy marks the node as unhealthy until the next health check.
+func (p *nodePool) markUnhealthy(i int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.statuses[i].Healthy = false
+	p.statuses[i].Err = err
+}
+
+// check checks the health of the nodes with their status, a node is healthy when it's reachable,
+// synced and at most maxBlocksBehind blocks behind the highest node.
+func (p *nodePool) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var (
+		statuses = make([]NodeStatus, len(p.nodes))
+		wg       sync.WaitGroup
+	)
+	for i, n := range p.nodes {
+		wg.Add(1)
+		go func(i int, n node) {
+			defer wg.Done()
+
+			start := time.Now()
+			status, err := n.rpc.Status(ctx)
+			statuses[i] = NodeStatus{
+				Address: n.address,
+				Latency: time.Since(start),
+				Err:     err,
+			}
+			if err == nil {
+				statuses[i].Height = status.SyncInfo.LatestBlockHeight
+				statuses[i].Healthy = !status.SyncInfo.CatchingUp
+			}
+		}(i, n)
+	}
+	wg.Wait()
+
+	var maxHeight int64
+	for _, s := range statuses {
+		if s.Height > maxHeight {
+			maxHeight = s.Height
+		}
+	}
+	for i := range statuses {
+		if maxHeight-statuses[i].Height > p.maxBlocksBehind {
+			statuses[i].Healthy = false
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.statuses = statuses
+}
+
+// run checks the health of the nodes at interval until ctx is canceled.
+func (p *nodePool) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.check(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RoundTrip sends the request to the selected node, it fails over to the next node when the node
+// is unreachable or responds with a transient error.
+func (p *nodePool) RoundTrip(req *http.Request) (*http.Response, error) {
+	order := p.order()
+	if len(order) == 0 {
+		return nil, errors.New("no node selected")
+	}
+
+	// the body of a request is read when it's sent, the request can't fail over without recreating it.
+	if req.Body != nil && req.GetBody == nil {
+		order = order[:1]
+	}
+
+	var lastErr error
+	for attempt, i := range order {
+		last := attempt == len(order)-1
+		n := p.nodes[i]
+
+		u := *n.url
+		r := req.Clone(req.Context())
+		r.URL = &u
+		r.Host = ""
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		resp, err := n.transport.RoundTrip(r)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			p.markUnhealthy(i, err)
+			lastErr = err
+			continue
+		}
+
+		if !last && retryableStatusCodes[resp.StatusCode] {
+			resp.Body.Close()
+			p.markUnhealthy(i, errors.Errorf("node responded with %s", resp.Status))
+			continue
+		}
+		return resp, nil
+	}
+
+	return nil, lastErr
+}
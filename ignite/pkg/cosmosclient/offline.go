@@ -0,0 +1,148 @@
+package cosmosclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/pkg/errors"
+)
+
+// OfflineSignMode is the sign mode of the offline txs. The signatures in this mode only depend
+// on the JSON of the tx, they're supported by the hardware wallets. The textual sign mode isn't
+// supported by the Cosmos SDK version of the client.
+const OfflineSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// SignerAccount is the account number and the sequence of a signer of an offline tx, they're
+// fetched from the chain by the online clients.
+type SignerAccount struct {
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// WithOffline creates a client that doesn't connect to the node, to build and sign txs on an
+// airgapped machine. The chain ID of the txs must be set since it can't be fetched from the node.
+// The signed txs are broadcasted by an online client with BroadcastSignedTx.
+func WithOffline(chainID string) Option {
+	return func(c *Client) {
+		c.offline = true
+		c.chainID = chainID
+	}
+}
+
+// BuildUnsignedTx builds a tx with given messages without querying the node, the account number
+// and the sequence of the signer are provided by signer. The gas limit and the fees are set
+// with the WithGasLimit and WithFees options.
+func (c Client) BuildUnsignedTx(signer SignerAccount, msgs []sdktypes.Msg, options ...BroadcastOption) (client.TxBuilder, error) {
+	var o broadcastOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	txf := c.offlineFactory(signer).
+		WithMemo(o.memo).
+		WithTimeoutHeight(o.timeoutHeight)
+	if o.gasLimit != 0 {
+		txf = txf.WithGas(o.gasLimit)
+	}
+	if !o.fees.IsZero() {
+		txf = txf.WithFees(o.fees.String())
+	}
+
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.setExtensionOptions(txBuilder); err != nil {
+		return nil, err
+	}
+	return txBuilder, nil
+}
+
+// ExportTx encodes the tx in JSON, to move it between the online and the offline machines.
+func (c Client) ExportTx(txBuilder client.TxBuilder) ([]byte, error) {
+	return c.context.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+}
+
+// ImportTx decodes a tx encoded in JSON by ExportTx.
+func (c Client) ImportTx(data []byte) (client.TxBuilder, error) {
+	decoded, err := c.context.TxConfig.TxJSONDecoder()(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode the tx")
+	}
+	return c.context.TxConfig.WrapTxBuilder(decoded)
+}
+
+// SignBytes returns the bytes of the tx signed by a signer in the offline sign mode, to sign
+// the tx with an external signer like a hardware wallet.
+func (c Client) SignBytes(txBuilder client.TxBuilder, signer SignerAccount) ([]byte, error) {
+	return c.context.TxConfig.SignModeHandler().GetSignBytes(OfflineSignMode, authsigning.SignerData{
+		ChainID:       c.chainID,
+		AccountNumber: signer.AccountNumber,
+		Sequence:      signer.Sequence,
+	}, txBuilder.GetTx())
+}
+
+// AddSignature adds the signature of the sign bytes produced by an external signer with the
+// private key of pubKey.
+func (c Client) AddSignature(txBuilder client.TxBuilder, pubKey cryptotypes.PubKey, signature []byte, signer SignerAccount) error {
+	signBytes, err := c.SignBytes(txBuilder, signer)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return errors.New("the signature doesn't match the tx")
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	sigs = append(sigs, signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  OfflineSignMode,
+			Signature: signature,
+		},
+		Sequence: signer.Sequence,
+	})
+	return txBuilder.SetSignatures(sigs...)
+}
+
+// SignTxOffline signs the tx with an account of the keyring of the client without querying
+// the node. The signature is added to the signatures of the tx.
+func (c Client) SignTxOffline(accountName string, txBuilder client.TxBuilder, signer SignerAccount) error {
+	account, err := c.Account(accountName)
+	if err != nil {
+		return err
+	}
+	return tx.Sign(c.ledgerFactory(c.offlineFactory(signer), account), accountName, txBuilder, false)
+}
+
+// BroadcastSignedTx broadcasts a tx signed offline.
+func (c Client) BroadcastSignedTx(txBuilder client.TxBuilder) (Response, error) {
+	if c.offline {
+		return Response{}, errors.New("an offline client can't broadcast txs")
+	}
+
+	txBytes, err := c.context.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return Response{}, err
+	}
+
+	resp, err := c.context.BroadcastTx(txBytes)
+	return Response{
+		Codec:      c.context.Codec,
+		TxResponse: resp,
+	}, handleBroadcastResult(resp, err)
+}
+
+// offlineFactory returns the factory of the offline txs of signer.
+func (c Client) offlineFactory(signer SignerAccount) tx.Factory {
+	return c.Factory.
+		WithAccountNumber(signer.AccountNumber).
+		WithSequence(signer.Sequence).
+		WithSignMode(OfflineSignMode)
+}
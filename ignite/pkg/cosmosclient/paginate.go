@@ -0,0 +1,160 @@
+package cosmosclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// defaultPageSize is the number of items requested by page by default.
+const defaultPageSize = 100
+
+// PageQuery queries the page of a list query, e.g. a query method of a generated gRPC query client
+// with a pagination. It returns the items of the page and the page response of the query.
+type PageQuery[T any] func(ctx context.Context, page *query.PageRequest) ([]T, *query.PageResponse, error)
+
+// PaginateOption configures the pagination of a list query.
+type PaginateOption func(*paginateOptions)
+
+type paginateOptions struct {
+	pageSize uint64
+	key      []byte
+}
+
+// WithPageSize sets the number of items requested by page, 100 by default.
+func WithPageSize(size uint64) PaginateOption {
+	return func(o *paginateOptions) {
+		if size > 0 {
+			o.pageSize = size
+		}
+	}
+}
+
+// WithPageKey starts the pagination at key, the next key of a page previously returned by the query.
+func WithPageKey(key []byte) PaginateOption {
+	return func(o *paginateOptions) {
+		o.key = key
+	}
+}
+
+// Paginator iterates over the items of a list query, the pages are queried with the next key of
+// the previous page until the last one.
+//
+// e.g., to iterate over the denoms metadata of the bank module:
+//
+//	p := cosmosclient.Paginate(func(ctx context.Context, page *query.PageRequest) ([]banktypes.Metadata, *query.PageResponse, error) {
+//		res, err := banktypes.NewQueryClient(client.Context()).DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{Pagination: page})
+//		if err != nil {
+//			return nil, nil, err
+//		}
+//		return res.Metadatas, res.Pagination, nil
+//	})
+//	for p.Next(ctx) {
+//		fmt.Println(p.Item().Base)
+//	}
+//	if err := p.Err(); err != nil {
+//		return err
+//	}
+type Paginator[T any] struct {
+	query    PageQuery[T]
+	pageSize uint64
+	key      []byte
+	items    []T
+	item     T
+	done     bool
+	err      error
+}
+
+// Paginate returns a paginator iterating over the items of the list query.
+func Paginate[T any](q PageQuery[T], options ...PaginateOption) *Paginator[T] {
+	o := paginateOptions{pageSize: defaultPageSize}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	return &Paginator[T]{
+		query:    q,
+		pageSize: o.pageSize,
+		key:      o.key,
+	}
+}
+
+// Next moves to the next item, it queries the next page when the items of the current page are
+// iterated. It returns false once all the items are iterated or when a query fails, Err returns
+// the error of the query.
+func (p *Paginator[T]) Next(ctx context.Context) bool {
+	// the pages can be empty while there are more pages.
+	for len(p.items) == 0 {
+		if p.done || p.err != nil {
+			return false
+		}
+		p.nextPage(ctx)
+	}
+
+	p.item, p.items = p.items[0], p.items[1:]
+	return true
+}
+
+func (p *Paginator[T]) nextPage(ctx context.Context) {
+	items, res, err := p.query(ctx, &query.PageRequest{
+		Key:   p.key,
+		Limit: p.pageSize,
+	})
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	p.items = items
+	if res == nil || len(res.NextKey) == 0 {
+		p.done = true
+		return
+	}
+	p.key = res.NextKey
+}
+
+// Item returns the current item.
+func (p *Paginator[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error of the query of a page, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// All returns the remaining items of the list.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
+
+// Chan sends the remaining items of the list to the returned channel, it's closed once all the
+// items are sent, when a query fails or when ctx is canceled. Err returns the error of the query
+// once the channel is closed.
+func (p *Paginator[T]) Chan(ctx context.Context) <-chan T {
+	items := make(chan T)
+
+	go func() {
+		defer close(items)
+
+		for p.Next(ctx) {
+			// the cancellation is checked first, the select picks any ready case.
+			if err := ctx.Err(); err != nil {
+				p.err = err
+				return
+			}
+			select {
+			case items <- p.Item():
+			case <-ctx.Done():
+				p.err = ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return items
+}
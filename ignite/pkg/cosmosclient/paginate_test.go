@@ -0,0 +1,113 @@
+package cosmosclient
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+// listQuery returns a query paginating over the items, the keys of the pages are the indexes of
+// their first item. The sizes of the pages are recorded.
+func listQuery(items []int, sizes *[]uint64) PageQuery[int] {
+	return func(_ context.Context, page *query.PageRequest) ([]int, *query.PageResponse, error) {
+		*sizes = append(*sizes, page.Limit)
+
+		start := 0
+		if len(page.Key) > 0 {
+			start, _ = strconv.Atoi(string(page.Key))
+		}
+		end := start + int(page.Limit)
+		if end >= len(items) {
+			return items[start:], &query.PageResponse{}, nil
+		}
+		return items[start:end], &query.PageResponse{NextKey: []byte(strconv.Itoa(end))}, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		items = []int{0, 1, 2, 3, 4, 5, 6}
+		sizes []uint64
+	)
+
+	all, err := Paginate(listQuery(items, &sizes), WithPageSize(3)).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, items, all)
+	require.Equal(t, []uint64{3, 3, 3}, sizes)
+
+	sizes = nil
+	all, err = Paginate(listQuery(items, &sizes), WithPageKey([]byte("5"))).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int{5, 6}, all)
+	require.Equal(t, []uint64{defaultPageSize}, sizes)
+
+	all, err = Paginate(listQuery(nil, &sizes)).All(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
+
+func TestPaginateEmptyPages(t *testing.T) {
+	pages := 0
+	q := func(_ context.Context, page *query.PageRequest) ([]string, *query.PageResponse, error) {
+		pages++
+		if pages < 3 {
+			return nil, &query.PageResponse{NextKey: []byte{byte(pages)}}, nil
+		}
+		return []string{"last"}, nil, nil
+	}
+
+	all, err := Paginate[string](q).All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"last"}, all)
+}
+
+func TestPaginateError(t *testing.T) {
+	errQuery := errors.New("query failed")
+	sizes := new([]uint64)
+	list := listQuery([]int{0, 1, 2}, sizes)
+	q := func(ctx context.Context, page *query.PageRequest) ([]int, *query.PageResponse, error) {
+		if len(page.Key) > 0 {
+			return nil, nil, errQuery
+		}
+		return list(ctx, page)
+	}
+
+	p := Paginate(q, WithPageSize(2))
+	var items []int
+	for p.Next(context.Background()) {
+		items = append(items, p.Item())
+	}
+	require.Equal(t, []int{0, 1}, items)
+	require.ErrorIs(t, p.Err(), errQuery)
+	require.False(t, p.Next(context.Background()))
+}
+
+func TestPaginateChan(t *testing.T) {
+	var (
+		items = []int{0, 1, 2, 3, 4}
+		sizes []uint64
+	)
+
+	p := Paginate(listQuery(items, &sizes), WithPageSize(2))
+	var received []int
+	for item := range p.Chan(context.Background()) {
+		received = append(received, item)
+	}
+	require.NoError(t, p.Err())
+	require.Equal(t, items, received)
+
+	// the channel is closed once ctx is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	p = Paginate(listQuery(items, &sizes), WithPageSize(2))
+	ch := p.Chan(ctx)
+	require.Equal(t, 0, <-ch)
+	cancel()
+	for range ch {
+	}
+	require.ErrorIs(t, p.Err(), context.Canceled)
+}
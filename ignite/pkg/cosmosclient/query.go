@@ -0,0 +1,70 @@
+package cosmosclient
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// ABCIQuery sends a raw ABCI query with data to the path at height, the latest height is used
+// when height is zero, or the height of the client set by AtHeight. The path can be any path handled by the app, such as the path of a
+// module query method, e.g. `/cosmos.bank.v1beta1.Query/Balance`.
+//
+// The query is sent to the node of the client and the response isn't verified, use QueryStore
+// to verify the values of a store in verifying mode.
+func (c Client) ABCIQuery(ctx context.Context, path string, data []byte, height int64) (value []byte, queryHeight int64, err error) {
+	if height == 0 {
+		height = c.queryHeight
+	}
+	opts := rpcclient.ABCIQueryOptions{Height: height}
+
+	res, err := c.RPC.ABCIQueryWithOptions(ctx, path, data, opts)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "cannot query %s", path)
+	}
+	if !res.Response.IsOK() {
+		return nil, 0, errors.Errorf("cannot query %s: %s", path, res.Response.Log)
+	}
+
+	return res.Response.Value, res.Response.Height, nil
+}
+
+// QueryModule queries the module query method at path with req and decodes the response
+// into a new T, T needs be a pointer to the response message of the method.
+// This allows to query the methods of custom modules without their generated gRPC client.
+//
+// e.g., to query the balance of an account:
+//
+//	res, err := cosmosclient.QueryModule[*banktypes.QueryBalanceResponse](
+//		ctx,
+//		client,
+//		"/cosmos.bank.v1beta1.Query/Balance",
+//		&banktypes.QueryBalanceRequest{Address: address, Denom: "token"},
+//		0,
+//	)
+func QueryModule[T proto.Message](ctx context.Context, c Client, path string, req proto.Message, height int64) (res T, err error) {
+	resType := reflect.TypeOf(res)
+	if resType == nil || resType.Kind() != reflect.Ptr {
+		return res, errors.Errorf("cannot decode the response of %s: %T is not a pointer", path, res)
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return res, errors.Wrapf(err, "cannot encode the request of %s", path)
+	}
+
+	value, _, err := c.ABCIQuery(ctx, path, data, height)
+	if err != nil {
+		return res, err
+	}
+
+	res = reflect.New(resType.Elem()).Interface().(T)
+	if err := proto.Unmarshal(value, res); err != nil {
+		return res, errors.Wrapf(err, "cannot decode the response of %s", path)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,148 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+// abciQueryParams are the params of an abci_query request.
+type abciQueryParams struct {
+	Path   string           `json:"path"`
+	Data   tmbytes.HexBytes `json:"data"`
+	Height int64            `json:"height"`
+}
+
+// abciNode is a node answering the abci_query requests with res and recording their params.
+type abciNode struct {
+	res    abci.ResponseQuery
+	params abciQueryParams
+}
+
+func (n *abciNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpctypes.RPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := tmjson.Unmarshal(req.Params, &n.params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := tmjson.Marshal(ctypes.ResultABCIQuery{Response: n.res})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func newABCIClient(t *testing.T, node *abciNode) Client {
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	rpc, err := rpchttp.New(server.URL, "/websocket")
+	require.NoError(t, err)
+	return Client{RPC: rpc}
+}
+
+func TestQueryModule(t *testing.T) {
+	const path = "/cosmos.bank.v1beta1.Query/Balance"
+
+	var (
+		req     = &banktypes.QueryBalanceRequest{Address: "cosmos1x", Denom: "token"}
+		balance = sdk.NewInt64Coin("token", 42)
+	)
+	reqData, err := proto.Marshal(req)
+	require.NoError(t, err)
+	resData, err := proto.Marshal(&banktypes.QueryBalanceResponse{Balance: &balance})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		res         abci.ResponseQuery
+		clientAt    int64
+		height      int64
+		wantHeight  int64
+		wantBalance sdk.Coin
+		err         string
+	}{
+		{
+			name:        "latest height",
+			res:         abci.ResponseQuery{Value: resData, Height: 10},
+			wantBalance: balance,
+		},
+		{
+			name:        "query height",
+			res:         abci.ResponseQuery{Value: resData, Height: 5},
+			height:      5,
+			wantHeight:  5,
+			wantBalance: balance,
+		},
+		{
+			name:        "client height",
+			res:         abci.ResponseQuery{Value: resData, Height: 7},
+			clientAt:    7,
+			wantHeight:  7,
+			wantBalance: balance,
+		},
+		{
+			name:        "query height over client height",
+			res:         abci.ResponseQuery{Value: resData, Height: 5},
+			clientAt:    7,
+			height:      5,
+			wantHeight:  5,
+			wantBalance: balance,
+		},
+		{
+			name: "failed query",
+			res:  abci.ResponseQuery{Code: 1, Log: "unknown query path"},
+			err:  "cannot query " + path + ": unknown query path",
+		},
+		{
+			name: "invalid response",
+			res:  abci.ResponseQuery{Value: []byte{0xff}},
+			err:  "cannot decode the response of " + path,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &abciNode{res: tt.res}
+			c := newABCIClient(t, node)
+			if tt.clientAt != 0 {
+				c = c.AtHeight(tt.clientAt)
+			}
+
+			res, err := QueryModule[*banktypes.QueryBalanceResponse](context.Background(), c, path, req, tt.height)
+			require.Equal(t, path, node.params.Path)
+			require.Equal(t, reqData, []byte(node.params.Data))
+			require.Equal(t, tt.wantHeight, node.params.Height)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantBalance, *res.Balance)
+		})
+	}
+}
+
+func TestQueryModuleNotPointer(t *testing.T) {
+	// the response type must be a pointer to a message to be decoded.
+	_, err := QueryModule[proto.Message](context.Background(), Client{}, "/cosmos.bank.v1beta1.Query/Balance", &banktypes.QueryBalanceRequest{}, 0)
+	require.EqualError(t, err, "cannot decode the response of /cosmos.bank.v1beta1.Query/Balance: <nil> is not a pointer")
+}
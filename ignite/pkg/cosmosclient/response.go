@@ -0,0 +1,123 @@
+package cosmosclient
+
+import (
+	"context"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// TxEvent is an event emitted by a tx, e.g. a transfer.
+type TxEvent struct {
+	// Type is the type of the event, e.g. transfer.
+	Type string
+
+	// Attributes are the values of the attributes of the event indexed by their keys, a key can
+	// have several values, e.g. the recipients of the transfers of a tx.
+	Attributes map[string][]string
+}
+
+// TxEvents are the events emitted by a tx in their order of emission.
+type TxEvents []TxEvent
+
+// ByType returns the events indexed by their types.
+func (e TxEvents) ByType() map[string][]TxEvent {
+	events := make(map[string][]TxEvent)
+	for _, event := range e {
+		events[event.Type] = append(events[event.Type], event)
+	}
+	return events
+}
+
+// FindAttributes returns the values of the attribute key of the events of type eventType,
+// e.g. the amounts of the transfer events.
+func (e TxEvents) FindAttributes(eventType, key string) []string {
+	var values []string
+	for _, event := range e {
+		if event.Type == eventType {
+			values = append(values, event.Attributes[key]...)
+		}
+	}
+	return values
+}
+
+// FindAttribute returns the first value of the attribute key of the events of type eventType,
+// false when no event has the attribute.
+func (e TxEvents) FindAttribute(eventType, key string) (string, bool) {
+	values := e.FindAttributes(eventType, key)
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// Events returns the events emitted by the tx. The responses of the txs broadcasted with
+// BroadcastSync or BroadcastAsync have no events, use WaitForResponse to get them once the tx
+// is committed.
+func (r Response) Events() TxEvents {
+	if r.TxResponse == nil {
+		return nil
+	}
+	if len(r.TxResponse.Events) > 0 {
+		return newTxEvents(r.TxResponse.Events)
+	}
+
+	// the responses of the nodes that don't return the ABCI events only have the events of the
+	// messages in their logs.
+	var events TxEvents
+	for _, log := range r.Logs {
+		for _, event := range log.Events {
+			e := TxEvent{Type: event.Type, Attributes: make(map[string][]string)}
+			for _, attribute := range event.Attributes {
+				e.Attributes[attribute.Key] = append(e.Attributes[attribute.Key], attribute.Value)
+			}
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// FindEventAttribute returns the first value of the attribute key of the events of type
+// eventType emitted by the tx, e.g. FindEventAttribute("transfer", "amount").
+func (r Response) FindEventAttribute(eventType, key string) (string, bool) {
+	return r.Events().FindAttribute(eventType, key)
+}
+
+// Events returns the events emitted by the tx.
+func (t TX) Events() TxEvents {
+	return newTxEvents(t.Raw.TxResult.Events)
+}
+
+// WaitForResponse waits for the tx of the response to be committed and returns the response with
+// the result of the tx, e.g. its events and its consumed gas. It's meant for the responses of
+// the txs broadcasted with BroadcastSync or BroadcastAsync, see WaitForTx.
+func (c Client) WaitForResponse(ctx context.Context, r Response, timeout time.Duration) (Response, error) {
+	tx, err := c.WaitForTx(ctx, r.TxHash, timeout)
+	if err != nil {
+		return Response{}, err
+	}
+
+	codec := r.Codec
+	if codec == nil {
+		codec = c.context.Codec
+	}
+	return Response{
+		Codec:      codec,
+		TxResponse: sdktypes.NewResponseResultTx(&tx.Raw, nil, tx.BlockTime.Format(time.RFC3339)),
+	}, nil
+}
+
+// newTxEvents returns the events of the ABCI events of a tx.
+func newTxEvents(abciEvents []abci.Event) TxEvents {
+	events := make(TxEvents, 0, len(abciEvents))
+	for _, event := range abciEvents {
+		e := TxEvent{Type: event.Type, Attributes: make(map[string][]string)}
+		for _, attribute := range event.Attributes {
+			key := string(attribute.Key)
+			e.Attributes[key] = append(e.Attributes[key], string(attribute.Value))
+		}
+		events = append(events, e)
+	}
+	return events
+}
@@ -0,0 +1,70 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+func TestResponseEvents(t *testing.T) {
+	abciEvents := []abci.Event{
+		{Type: "tx", Attributes: []abci.EventAttribute{{Key: []byte("fee"), Value: []byte("200stake")}}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: []byte("recipient"), Value: []byte("cosmos1a")},
+			{Key: []byte("amount"), Value: []byte("10token")},
+			{Key: []byte("recipient"), Value: []byte("cosmos1b")},
+			{Key: []byte("amount"), Value: []byte("20token")},
+		}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("30token")}}},
+	}
+	transfers := []TxEvent{
+		{Type: "transfer", Attributes: map[string][]string{
+			"recipient": {"cosmos1a", "cosmos1b"},
+			"amount":    {"10token", "20token"},
+		}},
+		{Type: "transfer", Attributes: map[string][]string{"amount": {"30token"}}},
+	}
+
+	res := Response{TxResponse: &sdktypes.TxResponse{GasUsed: 70000, Events: abciEvents}}
+	events := res.Events()
+	require.Len(t, events, 3)
+	require.Equal(t, transfers, events.ByType()["transfer"])
+	require.Equal(t, []string{"10token", "20token", "30token"}, events.FindAttributes("transfer", "amount"))
+
+	amount, ok := res.FindEventAttribute("transfer", "amount")
+	require.True(t, ok)
+	require.Equal(t, "10token", amount)
+	_, ok = res.FindEventAttribute("transfer", "sender")
+	require.False(t, ok)
+	_, ok = res.FindEventAttribute("send_packet", "packet_sequence")
+	require.False(t, ok)
+
+	// the events of the tx are the same.
+	tx := TX{Raw: ctypes.ResultTx{TxResult: abci.ResponseDeliverTx{Events: abciEvents}}}
+	require.Equal(t, events, tx.Events())
+}
+
+func TestResponseEventsFromLogs(t *testing.T) {
+	res := Response{TxResponse: &sdktypes.TxResponse{
+		Logs: sdktypes.ABCIMessageLogs{{
+			Events: sdktypes.StringEvents{
+				{Type: "transfer", Attributes: []sdktypes.Attribute{
+					{Key: "recipient", Value: "cosmos1a"},
+					{Key: "amount", Value: "10token"},
+				}},
+			},
+		}},
+	}}
+	require.Equal(t, TxEvents{
+		{Type: "transfer", Attributes: map[string][]string{
+			"recipient": {"cosmos1a"},
+			"amount":    {"10token"},
+		}},
+	}, res.Events())
+
+	// a response without tx response has no events.
+	require.Empty(t, Response{}.Events())
+}
@@ -0,0 +1,208 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// retryMaxBackoff is the maximum wait between two attempts of an RPC call.
+	retryMaxBackoff = 30 * time.Second
+
+	// retryJitter is the randomization factor of the wait between two attempts, a wait of 1s
+	// is randomized between 0.5s and 1.5s, so the clients don't retry all at once.
+	retryJitter = 0.5
+
+	// broadcastMethodPrefix is the prefix of the RPC methods broadcasting a tx or an evidence.
+	broadcastMethodPrefix = "broadcast_"
+)
+
+// retryableStatusCodes are the HTTP status codes of the transient failures of a node, it's rate
+// limiting the calls or its proxy can't reach it.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// unprocessedStatusCodes are the retryable HTTP status codes of the calls rejected before reaching
+// the node, a broadcast is only retried with them.
+var unprocessedStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusServiceUnavailable: true,
+}
+
+// WithRetry retries the Tendermint RPC calls failing with a transient error, e.g. a rate limited
+// call or a connection reset, up to maxAttempts attempts in total. The broadcasts can reach the node
+// before failing, they're only retried when the connection to the node can't be established or
+// when the call is rejected before reaching it, so a tx isn't broadcasted twice.
+// The wait between two attempts starts at initialBackoff and doubles after each attempt with
+// a random jitter. By default, the calls aren't retried.
+func WithRetry(maxAttempts int, initialBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.retryMaxAttempts = maxAttempts
+		c.retryBackoff = initialBackoff
+	}
+}
+
+// retryTransport is an HTTP transport retrying the requests failing with a transient error.
+type retryTransport struct {
+	next           http.RoundTripper
+	maxAttempts    int
+	initialBackoff time.Duration
+	logger         log.Logger
+}
+
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the body of a request is read when it's sent, it must be recreated for the next attempts.
+	if req.Body != nil && req.GetBody == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	broadcast := isBroadcast(req)
+	statusCodes := retryableStatusCodes
+	if broadcast {
+		statusCodes = unprocessedStatusCodes
+	}
+
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = t.initialBackoff
+	b.RandomizationFactor = retryJitter
+	b.MaxInterval = retryMaxBackoff
+	b.MaxElapsedTime = 0
+
+	var (
+		resp    *http.Response
+		attempt int
+	)
+	roundTrip := func() error {
+		attempt++
+		last := attempt == t.maxAttempts
+
+		r := req
+		if attempt > 1 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		var err error
+		resp, err = t.next.RoundTrip(r)
+		if err != nil {
+			if last || !isRetryableError(req.Context(), err, broadcast) {
+				return backoff.Permanent(err)
+			}
+			return err
+		}
+
+		// the response of the last attempt is returned as is.
+		if !last && statusCodes[resp.StatusCode] {
+			resp.Body.Close()
+			return errors.Errorf("node responded with %s", resp.Status)
+		}
+		return nil
+	}
+
+	notify := func(err error, wait time.Duration) {
+		t.logger.Info("retrying the rpc call", "node", req.URL.Host, "attempt", attempt, "wait", wait, "err", err)
+	}
+	err := backoff.RetryNotify(
+		roundTrip,
+		backoff.WithContext(backoff.WithMaxRetries(b, uint64(t.maxAttempts-1)), req.Context()),
+		notify,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// isBroadcast returns true when the request calls an RPC method broadcasting a tx, which isn't
+// idempotent. The method is read from the path of the URI calls and from the body of the JSON-RPC
+// calls, a single call or a batch.
+func isBroadcast(req *http.Request) bool {
+	if strings.HasPrefix(path.Base(req.URL.Path), broadcastMethodPrefix) {
+		return true
+	}
+	if req.Body == nil || req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return true
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return true
+	}
+
+	type call struct {
+		Method string `json:"method"`
+	}
+	var calls []call
+	if err := json.Unmarshal(data, &calls); err != nil {
+		var c call
+		if err := json.Unmarshal(data, &c); err != nil {
+			return false
+		}
+		calls = append(calls, c)
+	}
+	for _, c := range calls {
+		if strings.HasPrefix(c.Method, broadcastMethodPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableError returns true when the error of an RPC call is a transient failure of the
+// connection. When broadcast is true, only the failures to establish the connection are retryable,
+// the other ones can happen after the node received the call.
+func isRetryableError(ctx context.Context, err error, broadcast bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	if broadcast {
+		return false
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.EPIPE),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return true
+	}
+	for _, connErr := range platformConnErrors {
+		if errors.Is(err, connErr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+//go:build !windows
+// +build !windows
+
+package cosmosclient
+
+// platformConnErrors are the transient connection errors specific to the platform.
+var platformConnErrors []error
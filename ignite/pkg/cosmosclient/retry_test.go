@@ -0,0 +1,327 @@
+package cosmosclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	statusCall    = `{"jsonrpc":"2.0","id":1,"method":"status","params":{}}`
+	broadcastCall = `{"jsonrpc":"2.0","id":1,"method":"broadcast_tx_sync","params":{"tx":"AA=="}}`
+)
+
+// roundTripFunc is an http.RoundTripper calling the function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// timeoutError is a network error timing out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+// attemptResult is the result of an attempt of an RPC call, an error or a response with a status code.
+type attemptResult struct {
+	status int
+	err    error
+}
+
+func TestRetryTransport(t *testing.T) {
+	var (
+		refused = &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+		reset   = &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	)
+
+	tests := []struct {
+		name         string
+		body         string
+		maxAttempts  int
+		results      []attemptResult
+		wantAttempts int
+		wantStatus   int
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			body:         statusCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{status: http.StatusOK}},
+			wantAttempts: 1,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "rate limited then success",
+			body:         statusCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{status: http.StatusTooManyRequests}, {status: http.StatusOK}},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "bad gateway then success",
+			body:         statusCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{status: http.StatusBadGateway}, {status: http.StatusOK}},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "not retryable status",
+			body:         statusCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{status: http.StatusInternalServerError}},
+			wantAttempts: 1,
+			wantStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:        "last attempt response returned as is",
+			body:        statusCall,
+			maxAttempts: 3,
+			results: []attemptResult{
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusServiceUnavailable},
+			},
+			wantAttempts: 3,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:         "connection reset then success",
+			body:         statusCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{err: reset}, {status: http.StatusOK}},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "attempts exhausted",
+			body:         statusCall,
+			maxAttempts:  2,
+			results:      []attemptResult{{err: reset}, {err: reset}, {status: http.StatusOK}},
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "broadcast connection refused then success",
+			body:         broadcastCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{err: refused}, {status: http.StatusOK}},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "broadcast rate limited then success",
+			body:         broadcastCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{status: http.StatusTooManyRequests}, {status: http.StatusOK}},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "broadcast timeout not retried",
+			body:         broadcastCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{err: &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}}},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "broadcast eof not retried",
+			body:         broadcastCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{err: io.EOF}},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "broadcast gateway timeout not retried",
+			body:         broadcastCall,
+			maxAttempts:  3,
+			results:      []attemptResult{{status: http.StatusGatewayTimeout}},
+			wantAttempts: 1,
+			wantStatus:   http.StatusGatewayTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				attempts int
+				bodies   []string
+			)
+			transport := retryTransport{
+				next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					result := tt.results[attempts]
+					attempts++
+
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					bodies = append(bodies, string(body))
+
+					if result.err != nil {
+						return nil, result.err
+					}
+					return &http.Response{
+						StatusCode: result.status,
+						Status:     http.StatusText(result.status),
+						Body:       io.NopCloser(strings.NewReader("")),
+					}, nil
+				}),
+				maxAttempts:    tt.maxAttempts,
+				initialBackoff: time.Millisecond,
+				logger:         log.NewNopLogger(),
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "http://localhost:26657", strings.NewReader(tt.body))
+			require.NoError(t, err)
+			resp, err := transport.RoundTrip(req)
+			require.Equal(t, tt.wantAttempts, attempts)
+
+			// the body of the request is sent again with each attempt.
+			for _, body := range bodies {
+				require.Equal(t, tt.body, body)
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		err           error
+		wantQuery     bool
+		wantBroadcast bool
+	}{
+		{
+			name:          "connection refused",
+			err:           &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+			wantQuery:     true,
+			wantBroadcast: true,
+		},
+		{
+			name:          "dial timeout",
+			err:           &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}},
+			wantQuery:     true,
+			wantBroadcast: true,
+		},
+		{
+			name:      "connection reset",
+			err:       &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET},
+			wantQuery: true,
+		},
+		{
+			name:      "broken pipe",
+			err:       &net.OpError{Op: "write", Net: "tcp", Err: syscall.EPIPE},
+			wantQuery: true,
+		},
+		{
+			name:      "eof",
+			err:       io.EOF,
+			wantQuery: true,
+		},
+		{
+			name:      "unexpected eof",
+			err:       io.ErrUnexpectedEOF,
+			wantQuery: true,
+		},
+		{
+			name:      "read timeout",
+			err:       &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}},
+			wantQuery: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("malformed response"),
+		},
+		{
+			name: "canceled call",
+			ctx:  canceled,
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			require.Equal(t, tt.wantQuery, isRetryableError(ctx, tt.err, false))
+			require.Equal(t, tt.wantBroadcast, isRetryableError(ctx, tt.err, true))
+		})
+	}
+}
+
+func TestIsBroadcast(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		body string
+		want bool
+	}{
+		{
+			name: "query call",
+			url:  "http://localhost:26657",
+			body: statusCall,
+		},
+		{
+			name: "broadcast call",
+			url:  "http://localhost:26657",
+			body: broadcastCall,
+			want: true,
+		},
+		{
+			name: "batch with a broadcast",
+			url:  "http://localhost:26657",
+			body: "[" + statusCall + "," + broadcastCall + "]",
+			want: true,
+		},
+		{
+			name: "batch of queries",
+			url:  "http://localhost:26657",
+			body: "[" + statusCall + "," + statusCall + "]",
+		},
+		{
+			name: "uri broadcast call",
+			url:  "http://localhost:26657/broadcast_tx_commit?tx=0x00",
+			want: true,
+		},
+		{
+			name: "uri query call",
+			url:  "http://localhost:26657/status",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, body := http.MethodGet, io.Reader(nil)
+			if tt.body != "" {
+				method, body = http.MethodPost, strings.NewReader(tt.body)
+			}
+			req, err := http.NewRequest(method, tt.url, body)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, isBroadcast(req))
+		})
+	}
+}
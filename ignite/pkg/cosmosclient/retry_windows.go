@@ -0,0 +1,15 @@
+package cosmosclient
+
+import "syscall"
+
+// wsaeconnrefused is the error of the connections refused by Windows sockets, it's not declared
+// by syscall.
+const wsaeconnrefused syscall.Errno = 10061
+
+// platformConnErrors are the transient connection errors specific to the platform, the
+// connections fail with the errors of Windows sockets instead of the POSIX ones.
+var platformConnErrors = []error{
+	syscall.WSAECONNRESET,
+	syscall.WSAECONNABORTED,
+	wsaeconnrefused,
+}
@@ -0,0 +1,161 @@
+package cosmosclient
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+)
+
+// defaultSequenceMaxAttempts is the number of attempts of a broadcast failing with a sequence
+// mismatch by default.
+const defaultSequenceMaxAttempts = 3
+
+// sequenceMismatchRe matches the sequence expected by the node in the log of a sequence mismatch,
+// e.g. `account sequence mismatch, expected 5, got 3: incorrect account sequence`.
+var sequenceMismatchRe = regexp.MustCompile(`expected (\d+), got \d+`)
+
+// WithSequenceRetry sets the number of attempts of a broadcast failing because the sequence of
+// the account changed since the tx was signed, e.g. when several txs are broadcasted quickly.
+// The tx is signed again with the sequence expected by the node before each new attempt.
+// By default, the broadcasts are attempted 3 times, 1 disables the retries.
+func WithSequenceRetry(maxAttempts int) Option {
+	return func(c *Client) {
+		c.sequenceMaxAttempts = maxAttempts
+	}
+}
+
+// WithSerializedBroadcast signs and broadcasts the txs of an account one at a time. The txs
+// broadcasted concurrently by an account reserve different sequences anyway, serializing them keeps
+// a failed tx from making the txs signed after it fail with a sequence mismatch.
+func WithSerializedBroadcast() Option {
+	return func(c *Client) {
+		c.serializeBroadcast = true
+	}
+}
+
+// sequenceManager tracks the sequences of the accounts broadcasting with the client. The node
+// returns the sequence of an account once its txs are committed, the next sequence of an account
+// is tracked locally to broadcast several txs in the same block.
+type sequenceManager struct {
+	mu        sync.Mutex
+	sequences map[string]uint64
+	locks     map[string]*sync.Mutex
+}
+
+func newSequenceManager() *sequenceManager {
+	return &sequenceManager{
+		sequences: make(map[string]uint64),
+		locks:     make(map[string]*sync.Mutex),
+	}
+}
+
+// next returns the next sequence of the address, the sequence fetched from the node is used
+// when it's ahead of the tracked sequence.
+func (m *sequenceManager) next(address string, fetched uint64) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if tracked := m.sequences[address]; tracked > fetched {
+		return tracked
+	}
+	return fetched
+}
+
+// reserve returns the next sequence of the address like next and tracks the following one, so the
+// txs signed concurrently by the address don't use the same sequence.
+func (m *sequenceManager) reserve(address string, fetched uint64) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sequence := fetched
+	if tracked := m.sequences[address]; tracked > sequence {
+		sequence = tracked
+	}
+	m.sequences[address] = sequence + 1
+	return sequence
+}
+
+// release gives back the sequence reserved by a tx that failed to be broadcasted, unless the
+// following sequence has been reserved since then.
+func (m *sequenceManager) release(address string, sequence uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.sequences[address] == sequence+1 {
+		m.sequences[address] = sequence
+	}
+}
+
+// set sets the next sequence of the address.
+func (m *sequenceManager) set(address string, sequence uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sequences[address] = sequence
+}
+
+// advance sets the next sequence of the address when it's ahead of the tracked sequence, the
+// txs broadcasted concurrently by the address can complete in any order.
+func (m *sequenceManager) advance(address string, sequence uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sequence > m.sequences[address] {
+		m.sequences[address] = sequence
+	}
+}
+
+// lock locks the broadcasts of the address and returns the function unlocking them.
+func (m *sequenceManager) lock(address string) (unlock func()) {
+	m.mu.Lock()
+	l, ok := m.locks[address]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[address] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// expectedSequence returns the sequence expected by the node when the broadcast failed with
+// a sequence mismatch. The sequence is read from the log of the result and is fetched from the
+// node when the log doesn't include it.
+func (c Client) expectedSequence(ctx client.Context, resp *sdktypes.TxResponse, err error) (
+	sequence uint64, mismatch bool, fetchErr error) {
+	var log string
+	switch {
+	case err != nil:
+		if !errors.Is(err, sdkerrors.ErrWrongSequence) {
+			return 0, false, nil
+		}
+		log = err.Error()
+	case resp.Code != 0:
+		if !errors.Is(decodeABCIError(resp.Codespace, resp.Code, resp.RawLog), sdkerrors.ErrWrongSequence) {
+			return 0, false, nil
+		}
+		log = resp.RawLog
+	default:
+		return 0, false, nil
+	}
+
+	if match := sequenceMismatchRe.FindStringSubmatch(log); match != nil {
+		if sequence, err := strconv.ParseUint(match[1], 10, 64); err == nil {
+			c.sequences.set(ctx.GetFromAddress().String(), sequence)
+			return sequence, true, nil
+		}
+	}
+
+	_, sequence, fetchErr = ctx.AccountRetriever.GetAccountNumberSequence(ctx, ctx.GetFromAddress())
+	if fetchErr != nil {
+		return 0, false, fetchErr
+	}
+	c.sequences.set(ctx.GetFromAddress().String(), sequence)
+	return sequence, true, nil
+}
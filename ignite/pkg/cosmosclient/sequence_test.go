@@ -0,0 +1,93 @@
+package cosmosclient
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceManager(t *testing.T) {
+	const address = "cosmos1x"
+
+	tests := []struct {
+		name     string
+		tracked  uint64
+		advance  uint64
+		fetched  uint64
+		wantNext uint64
+	}{
+		{name: "advance ahead", tracked: 3, advance: 5, fetched: 1, wantNext: 5},
+		{name: "advance behind", tracked: 5, advance: 3, fetched: 1, wantNext: 5},
+		{name: "fetched ahead", tracked: 3, advance: 4, fetched: 6, wantNext: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSequenceManager()
+			m.set(address, tt.tracked)
+			m.advance(address, tt.advance)
+			require.Equal(t, tt.wantNext, m.next(address, tt.fetched))
+		})
+	}
+
+	// the sequence expected by the node after a mismatch replaces the tracked one.
+	m := newSequenceManager()
+	m.advance(address, 7)
+	m.set(address, 4)
+	require.EqualValues(t, 4, m.next(address, 0))
+}
+
+func TestSequenceManagerRelease(t *testing.T) {
+	const address = "cosmos1x"
+	m := newSequenceManager()
+
+	// the sequence of a failed tx is reused by the next tx.
+	sequence := m.reserve(address, 3)
+	require.EqualValues(t, 3, sequence)
+	m.release(address, sequence)
+	require.EqualValues(t, 3, m.reserve(address, 0))
+
+	// the sequence isn't given back once the following one is reserved.
+	require.EqualValues(t, 4, m.reserve(address, 0))
+	m.release(address, 3)
+	require.EqualValues(t, 5, m.reserve(address, 0))
+}
+
+func TestSequenceManagerConcurrentBroadcasts(t *testing.T) {
+	const (
+		address    = "cosmos1x"
+		broadcasts = 50
+	)
+	m := newSequenceManager()
+
+	// the txs reserve their sequence without being serialized, like the provisioned broadcasts, and
+	// their broadcasts complete in any order.
+	var (
+		wg      sync.WaitGroup
+		signed  = make(chan uint64)
+		release = make(chan struct{})
+	)
+	for i := 0; i < broadcasts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sequence := m.reserve(address, 0)
+			signed <- sequence
+
+			<-release
+			m.advance(address, sequence+1)
+		}()
+	}
+
+	seen := make(map[uint64]bool)
+	for i := 0; i < broadcasts; i++ {
+		sequence := <-signed
+		require.False(t, seen[sequence], "sequence %d used twice", sequence)
+		seen[sequence] = true
+	}
+	close(release)
+	wg.Wait()
+
+	// the tracked sequence is the one following the last tx, whatever the order of the broadcasts.
+	require.EqualValues(t, broadcasts, m.next(address, 0))
+}
@@ -0,0 +1,461 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// TX is a transaction of a block with its result.
+type TX struct {
+	// BlockTime is the time of the block including the transaction.
+	BlockTime time.Time
+
+	// Raw is the transaction with its result, e.g. its events.
+	Raw ctypes.ResultTx
+
+	// decoder decodes the transaction bytes with the codec of the client.
+	decoder sdktypes.TxDecoder
+}
+
+// BlockEvents are the events emitted by a block outside of its transactions, e.g. the staking
+// rewards or the slashing of the validators. These events aren't returned by the searches of
+// transactions.
+type BlockEvents struct {
+	// Height is the height of the block.
+	Height int64
+
+	// BlockTime is the time of the block.
+	BlockTime time.Time
+
+	// BeginBlock are the events emitted by the begin blocker of the modules.
+	BeginBlock []abci.Event
+
+	// EndBlock are the events emitted by the end blocker of the modules.
+	EndBlock []abci.Event
+}
+
+// GetBlockEvents returns the begin block and the end block events of the block at height.
+func (c Client) GetBlockEvents(ctx context.Context, height int64) (BlockEvents, error) {
+	block, err := c.RPC.Block(ctx, &height)
+	if err != nil {
+		return BlockEvents{}, errors.Wrapf(err, "cannot get the block %d", height)
+	}
+
+	results, err := c.RPC.BlockResults(ctx, &height)
+	if err != nil {
+		return BlockEvents{}, errors.Wrapf(err, "cannot get the results of the block %d", height)
+	}
+
+	return newBlockEvents(block.Block.Time, results), nil
+}
+
+func newBlockEvents(blockTime time.Time, results *ctypes.ResultBlockResults) BlockEvents {
+	return BlockEvents{
+		Height:     results.Height,
+		BlockTime:  blockTime,
+		BeginBlock: results.BeginBlockEvents,
+		EndBlock:   results.EndBlockEvents,
+	}
+}
+
+// CollectTXs sends the transactions of the blocks from fromHeight to the latest block over tc,
+// the transactions of a block are sent together. It returns once the latest block is collected,
+// use StreamTXs to keep collecting the new blocks.
+// Use WithConcurrency to fetch several blocks at the same time from remote nodes.
+// Use WithBlockEvents to also collect the begin block and end block events of the blocks.
+// Use WithMessageType, WithSender and WithEventAttribute to only collect the matching transactions.
+// Use WithCheckpointer to resume the collection after the last collected block once restarted.
+// Use WithTXOrder to collect the blocks from the latest one and WithTXsPerPage to set the size of
+// the pages of the searches.
+func (c Client) CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []TX, options ...TXsOption) error {
+	o, err := c.newTXsOptions(options)
+	if err != nil {
+		return err
+	}
+
+	fromHeight, err = o.resumeHeight(fromHeight)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.RPC.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.collectTXs(ctx, fromHeight, status.SyncInfo.LatestBlockHeight, tc, o)
+	return err
+}
+
+// CollectTXsRange sends the transactions of the blocks from fromHeight to toHeight over tc like
+// CollectTXs, toHeight must not be higher than the latest block.
+func (c Client) CollectTXsRange(ctx context.Context, fromHeight, toHeight int64, tc chan<- []TX, options ...TXsOption) error {
+	o, err := c.newTXsOptions(options)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.RPC.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	if latest := status.SyncInfo.LatestBlockHeight; toHeight > latest {
+		return fmt.Errorf("block %d isn't produced yet, the latest block is %d", toHeight, latest)
+	}
+
+	if fromHeight, err = o.resumeHeight(fromHeight); err != nil {
+		return err
+	}
+
+	_, err = c.collectTXs(ctx, fromHeight, toHeight, tc, o)
+	return err
+}
+
+// StreamTXs sends the transactions of the blocks from fromHeight over tc like CollectTXs, then it
+// keeps sending the transactions of the new blocks as they are produced until ctx is canceled.
+// It's the tail mode of CollectTXs: the new blocks are received from a subscription instead of
+// polling the node, see SubscribeNewBlocks, the blocks missed while the client is disconnected are
+// collected once a new block is received.
+// The blocks are final once committed, so the streamed transactions are never reverted.
+// The transactions are streamed in ascending order only.
+func (c Client) StreamTXs(ctx context.Context, fromHeight int64, tc chan<- []TX, options ...TXsOption) error {
+	o, err := c.newTXsOptions(options)
+	if err != nil {
+		return err
+	}
+	if o.order != TXOrderAsc {
+		return errors.New("the transactions can only be streamed in ascending order")
+	}
+
+	fromHeight, err = o.resumeHeight(fromHeight)
+	if err != nil {
+		return err
+	}
+
+	// subscribe before collecting the past blocks, so no block is missed in between.
+	blocks, err := c.SubscribeNewBlocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.RPC.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	next, err := c.collectTXs(ctx, fromHeight, status.SyncInfo.LatestBlockHeight, tc, o)
+	if err != nil {
+		return err
+	}
+
+	for height := range latestHeights(blocks) {
+		if next, err = c.collectTXs(ctx, next, height, tc, o); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// latestHeights sends the heights of the blocks over the returned channel, a height not read yet is
+// replaced by the one of the next block. The blocks are read without delay while the transactions are
+// collected, so the node doesn't cancel the subscription of a slow client. The channel is closed once
+// blocks is closed.
+func latestHeights(blocks <-chan *tmtypes.Block) <-chan int64 {
+	heights := make(chan int64, 1)
+
+	go func() {
+		defer close(heights)
+
+		for block := range blocks {
+			select {
+			case <-heights:
+			default:
+			}
+			heights <- block.Height
+		}
+	}()
+
+	return heights
+}
+
+// collectTXs sends the transactions of the blocks from fromHeight to toHeight over tc and returns
+// the height of the next block to collect.
+// Up to o.concurrency blocks are fetched at the same time, their transactions are sent in the
+// order of the blocks, from toHeight to fromHeight when the order is descending.
+func (c Client) collectTXs(
+	ctx context.Context,
+	fromHeight,
+	toHeight int64,
+	tc chan<- []TX,
+	o txsOptions,
+) (next int64, err error) {
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+	if toHeight < fromHeight {
+		return fromHeight, nil
+	}
+	if len(o.filters) > 0 {
+		return c.searchTXs(ctx, fromHeight, toHeight, tc, o)
+	}
+
+	type result struct {
+		txs    []TX
+		events BlockEvents
+		err    error
+	}
+
+	// stop fetching the blocks on the first error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the results are queued in the order of the blocks, the block of the result received
+	// plus the ones queued are the blocks being fetched.
+	results := make(chan chan result, o.concurrency-1)
+	go func() {
+		defer close(results)
+
+		for i := int64(0); i <= toHeight-fromHeight; i++ {
+			height := blockHeight(fromHeight, toHeight, i, o.order)
+			rc := make(chan result, 1)
+			select {
+			case results <- rc:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(height int64) {
+				txs, events, err := c.blockTXs(ctx, height, o.blockEvents != nil)
+				rc <- result{txs, events, err}
+			}(height)
+		}
+	}()
+
+	var i int64
+	for rc := range results {
+		r := <-rc
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		// the events of a block are sent before its transactions.
+		if o.blockEvents != nil {
+			select {
+			case o.blockEvents <- r.events:
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+
+		if len(r.txs) > 0 {
+			select {
+			case tc <- r.txs:
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+
+		if err := o.checkpoint(blockHeight(fromHeight, toHeight, i, o.order)); err != nil {
+			return 0, err
+		}
+		i++
+	}
+
+	// the queue is closed early when ctx is canceled.
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return toHeight + 1, nil
+}
+
+// blockHeight returns the height of the i-th block collected from the blocks between fromHeight and
+// toHeight in order.
+func blockHeight(fromHeight, toHeight, i int64, order TXOrder) int64 {
+	if order == TXOrderDesc {
+		return toHeight - i
+	}
+	return fromHeight + i
+}
+
+// searchTXs sends the transactions of the blocks from fromHeight to toHeight matching the filters
+// of o over tc and returns the height of the next block to collect.
+// The transactions are searched by the node, so only the blocks including matching transactions
+// are fetched to get their time.
+func (c Client) searchTXs(
+	ctx context.Context,
+	fromHeight,
+	toHeight int64,
+	tc chan<- []TX,
+	o txsOptions,
+) (next int64, err error) {
+	query := txsQuery(fromHeight, toHeight, o.filters)
+	if _, err := tmquery.New(query); err != nil {
+		return 0, errors.Wrapf(err, "invalid query %q", query)
+	}
+
+	var (
+		txs     []TX
+		decoder = c.context.TxConfig.TxDecoder()
+	)
+
+	// flush sends the transactions of the current block.
+	flush := func() error {
+		if len(txs) == 0 {
+			return nil
+		}
+
+		select {
+		case tc <- txs:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		height := txs[0].Raw.Height
+		txs = nil
+		return o.checkpoint(height)
+	}
+
+	var (
+		page, perPage = 1, o.perPage
+		searched      int
+	)
+	for {
+		res, err := c.RPC.TxSearch(ctx, query, false, &page, &perPage, string(o.order))
+		if err != nil {
+			return 0, errors.Wrapf(err, "cannot search the transactions with query %q", query)
+		}
+
+		// the transactions are sorted by height and index, the ones of the same block are grouped.
+		for _, tx := range res.Txs {
+			if len(txs) > 0 && txs[0].Raw.Height != tx.Height {
+				if err := flush(); err != nil {
+					return 0, err
+				}
+			}
+
+			var blockTime time.Time
+			if len(txs) > 0 {
+				blockTime = txs[0].BlockTime
+			} else if blockTime, err = c.searchedBlockTime(ctx, tx.Height, o); err != nil {
+				return 0, err
+			}
+
+			txs = append(txs, TX{
+				BlockTime: blockTime,
+				Raw:       *tx,
+				decoder:   decoder,
+			})
+		}
+
+		// the node returns smaller pages when its limit is lower than perPage, the next pages are
+		// computed with the same limit so the search goes on until all the transactions are returned.
+		searched += len(res.Txs)
+		if searchDone(len(res.Txs), searched, res.TotalCount) {
+			break
+		}
+		page++
+	}
+
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	// the blocks without matching transactions are collected too.
+	if err := o.checkpoint(toHeight); err != nil {
+		return 0, err
+	}
+	return toHeight + 1, nil
+}
+
+// searchDone returns true when the last page of a search is returned, pageSize is the number of
+// transactions of the page and searched the number of transactions returned so far.
+func searchDone(pageSize, searched, total int) bool {
+	return pageSize == 0 || searched >= total
+}
+
+// searchedBlockTime returns the time of the block at height including searched transactions and
+// sends the events of the block when they're collected.
+func (c Client) searchedBlockTime(ctx context.Context, height int64, o txsOptions) (time.Time, error) {
+	if o.blockEvents == nil {
+		block, err := c.RPC.Block(ctx, &height)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "cannot get the block %d", height)
+		}
+		return block.Block.Time, nil
+	}
+
+	events, err := c.GetBlockEvents(ctx, height)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// the events of a block are sent before its transactions.
+	select {
+	case o.blockEvents <- events:
+	case <-ctx.Done():
+		return time.Time{}, ctx.Err()
+	}
+	return events.BlockTime, nil
+}
+
+// txsQuery returns the query searching the transactions of the blocks from fromHeight to toHeight
+// matching all the filters.
+func txsQuery(fromHeight, toHeight int64, filters []string) string {
+	conditions := append([]string{
+		fmt.Sprintf("tx.height>=%d", fromHeight),
+		fmt.Sprintf("tx.height<=%d", toHeight),
+	}, filters...)
+	return strings.Join(conditions, " AND ")
+}
+
+// blockTXs returns the transactions of the block at height with their result, and the events of
+// the block when withEvents is true.
+func (c Client) blockTXs(ctx context.Context, height int64, withEvents bool) ([]TX, BlockEvents, error) {
+	block, err := c.RPC.Block(ctx, &height)
+	if err != nil {
+		return nil, BlockEvents{}, errors.Wrapf(err, "cannot get the block %d", height)
+	}
+	if len(block.Block.Txs) == 0 && !withEvents {
+		return nil, BlockEvents{}, nil
+	}
+
+	results, err := c.RPC.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, BlockEvents{}, errors.Wrapf(err, "cannot get the results of the block %d", height)
+	}
+
+	var events BlockEvents
+	if withEvents {
+		events = newBlockEvents(block.Block.Time, results)
+	}
+
+	var (
+		txs     = make([]TX, len(block.Block.Txs))
+		decoder = c.context.TxConfig.TxDecoder()
+	)
+	for i, tx := range block.Block.Txs {
+		txs[i] = TX{
+			BlockTime: block.Block.Time,
+			decoder:   decoder,
+			Raw: ctypes.ResultTx{
+				Hash:     tx.Hash(),
+				Height:   height,
+				Index:    uint32(i),
+				TxResult: *results.TxsResults[i],
+				Tx:       tx,
+			},
+		}
+	}
+
+	return txs, events, nil
+}
@@ -0,0 +1,92 @@
+package cosmosclient
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/pkg/errors"
+)
+
+// errNoTXDecoder is returned when a transaction isn't collected by a client.
+var errNoTXDecoder = errors.New("the transaction has no decoder, it must be collected with a client")
+
+// DecodedTX is a transaction decoded with the codec of the client.
+type DecodedTX struct {
+	// Messages are the messages of the transaction.
+	Messages []sdktypes.Msg
+
+	// Fee is the fee paid for the transaction.
+	Fee sdktypes.Coins
+
+	// Gas is the gas limit of the transaction.
+	Gas uint64
+
+	// FeePayer is the account paying the fee, it's the first signer unless set.
+	FeePayer sdktypes.AccAddress
+
+	// FeeGranter is the account granting the fee, it's empty when the fee isn't granted.
+	FeeGranter sdktypes.AccAddress
+
+	// Memo is the memo of the transaction.
+	Memo string
+
+	// Signers are the accounts required to sign the transaction, in the order of their signatures.
+	Signers []sdktypes.AccAddress
+
+	// Events are the events emitted by the transaction.
+	Events sdktypes.StringEvents
+}
+
+// WithRegisterInterfaces registers the interfaces and the messages of the custom modules of the
+// chain in the codec of the client, e.g. `marstypes.RegisterInterfaces`, so their transactions
+// can be decoded. The interfaces of the standard modules of the Cosmos SDK and IBC are
+// registered by default.
+func WithRegisterInterfaces(register ...func(registry codectypes.InterfaceRegistry)) Option {
+	return func(c *Client) {
+		c.codec.registerInterfaces = append(c.codec.registerInterfaces, register...)
+	}
+}
+
+// Decode decodes the transaction bytes and the events of the transaction.
+// The messages of the custom modules can only be decoded when their interfaces are registered
+// with WithRegisterInterfaces.
+func (t TX) Decode() (DecodedTX, error) {
+	if t.decoder == nil {
+		return DecodedTX{}, errNoTXDecoder
+	}
+
+	tx, err := t.decoder(t.Raw.Tx)
+	if err != nil {
+		return DecodedTX{}, errors.Wrapf(err, "cannot decode the transaction %s", t.Raw.Hash)
+	}
+
+	stx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return DecodedTX{}, errors.Errorf("unsupported transaction type %T", tx)
+	}
+
+	events := make(sdktypes.StringEvents, len(t.Raw.TxResult.Events))
+	for i, e := range t.Raw.TxResult.Events {
+		events[i] = sdktypes.StringifyEvent(e)
+	}
+
+	return DecodedTX{
+		Messages:   stx.GetMsgs(),
+		Fee:        stx.GetFee(),
+		Gas:        stx.GetGas(),
+		FeePayer:   stx.FeePayer(),
+		FeeGranter: stx.FeeGranter(),
+		Memo:       stx.GetMemo(),
+		Signers:    stx.GetSigners(),
+		Events:     events,
+	}, nil
+}
+
+// Messages decodes the messages of the transaction, see Decode.
+func (t TX) Messages() ([]sdktypes.Msg, error) {
+	tx, err := t.Decode()
+	if err != nil {
+		return nil, err
+	}
+	return tx.Messages, nil
+}
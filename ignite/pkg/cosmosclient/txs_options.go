@@ -0,0 +1,149 @@
+package cosmosclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// defaultTXsConcurrency is the number of blocks fetched at the same time by default.
+	defaultTXsConcurrency = 1
+
+	// maxTXsPerPage is the maximum number of transactions returned by each page of a search,
+	// the nodes cap the larger pages to this size.
+	maxTXsPerPage = 100
+)
+
+// TXOrder is the order of the collected transactions, by height and index.
+type TXOrder string
+
+const (
+	// TXOrderAsc collects the transactions from the oldest to the newest.
+	TXOrderAsc TXOrder = "asc"
+
+	// TXOrderDesc collects the transactions from the newest to the oldest.
+	TXOrderDesc TXOrder = "desc"
+)
+
+// TXsOption configures the collection of the transactions of the blocks.
+type TXsOption func(*txsOptions)
+
+type txsOptions struct {
+	concurrency int
+	blockEvents chan<- BlockEvents
+	filters     []string
+	perPage     int
+	order       TXOrder
+
+	checkpointer Checkpointer
+}
+
+func newTXsOptions(options []TXsOption) txsOptions {
+	o := txsOptions{
+		concurrency: defaultTXsConcurrency,
+		perPage:     maxTXsPerPage,
+		order:       TXOrderAsc,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+	return o
+}
+
+// newTXsOptions returns the options of a collection, the options passed to the collection
+// override the default ones of the client.
+func (c Client) newTXsOptions(options []TXsOption) (txsOptions, error) {
+	all := make([]TXsOption, 0, len(c.txsOptions)+len(options))
+	all = append(all, c.txsOptions...)
+	all = append(all, options...)
+
+	o := newTXsOptions(all)
+	return o, o.validate()
+}
+
+// validate checks the options against the limits of the nodes.
+func (o txsOptions) validate() error {
+	if o.perPage < 1 || o.perPage > maxTXsPerPage {
+		return fmt.Errorf("the transactions per page must be between 1 and %d, got %d", maxTXsPerPage, o.perPage)
+	}
+
+	switch o.order {
+	case TXOrderAsc:
+	case TXOrderDesc:
+		if o.checkpointer != nil {
+			return errors.New("the checkpoints require the transactions in ascending order")
+		}
+	default:
+		return fmt.Errorf("invalid order of the transactions %q, use %q or %q", o.order, TXOrderAsc, TXOrderDesc)
+	}
+	return nil
+}
+
+// WithTXsDefaults sets the options of the collections of the transactions of the client, the
+// options passed to a collection override them.
+func WithTXsDefaults(options ...TXsOption) Option {
+	return func(c *Client) {
+		c.txsOptions = append(c.txsOptions, options...)
+	}
+}
+
+// WithConcurrency sets the number of blocks fetched at the same time from the node.
+// the transactions are still sent in the order of the blocks.
+func WithConcurrency(n int) TXsOption {
+	return func(o *txsOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithBlockEvents sends the begin block and the end block events of each collected block over
+// ec, including the blocks without transactions. The events of a block are sent before its
+// transactions, so ec and the channel of the transactions must be read concurrently.
+func WithBlockEvents(ec chan<- BlockEvents) TXsOption {
+	return func(o *txsOptions) {
+		o.blockEvents = ec
+	}
+}
+
+// WithMessageType only collects the transactions including a message of typeURL, e.g.
+// `/cosmos.bank.v1beta1.MsgSend`.
+func WithMessageType(typeURL string) TXsOption {
+	return WithEventAttribute("message", "action", typeURL)
+}
+
+// WithSender only collects the transactions including a message sent by address.
+func WithSender(address string) TXsOption {
+	return WithEventAttribute("message", "sender", address)
+}
+
+// WithEventAttribute only collects the transactions emitting an event of eventType with the
+// attribute key set to value, e.g. `transfer`, `recipient` and `cosmos1...`.
+//
+// The filters are combined together and pushed down to the node with a search of the
+// transactions, so the transaction indexer of the node must be enabled. Only the blocks
+// including matching transactions are fetched, the block events are sent for these blocks only.
+func WithEventAttribute(eventType, key, value string) TXsOption {
+	return func(o *txsOptions) {
+		o.filters = append(o.filters, fmt.Sprintf("%s.%s='%s'", eventType, key, value))
+	}
+}
+
+// WithTXsPerPage sets the number of transactions returned by each page of the searches of the
+// transactions filtered by WithMessageType, WithSender or WithEventAttribute, 100 by default.
+// n must be between 1 and 100, the maximum of the nodes. The nodes returning smaller pages are
+// still searched until the last transaction.
+func WithTXsPerPage(n int) TXsOption {
+	return func(o *txsOptions) {
+		o.perPage = n
+	}
+}
+
+// WithTXOrder sets the order of the collected transactions, TXOrderAsc by default. The blocks are
+// collected in the same order, so TXOrderDesc collects the blocks from the highest to the lowest
+// height; it can't be used to stream the new blocks or with a checkpointer.
+func WithTXOrder(order TXOrder) TXsOption {
+	return func(o *txsOptions) {
+		o.order = order
+	}
+}
@@ -0,0 +1,109 @@
+package cosmosclient
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestTXsOptions(t *testing.T) {
+	c := Client{txsOptions: []TXsOption{WithTXsPerPage(30), WithTXOrder(TXOrderDesc)}}
+
+	// the options of the collection override the ones of the client.
+	o, err := c.newTXsOptions([]TXsOption{WithTXsPerPage(50)})
+	require.NoError(t, err)
+	require.Equal(t, 50, o.perPage)
+	require.Equal(t, TXOrderDesc, o.order)
+
+	o, err = Client{}.newTXsOptions(nil)
+	require.NoError(t, err)
+	require.Equal(t, maxTXsPerPage, o.perPage)
+	require.Equal(t, TXOrderAsc, o.order)
+
+	tests := []struct {
+		name    string
+		options []TXsOption
+		err     string
+	}{
+		{
+			name:    "empty page",
+			options: []TXsOption{WithTXsPerPage(0)},
+			err:     "the transactions per page must be between 1 and 100, got 0",
+		},
+		{
+			name:    "page larger than the node limit",
+			options: []TXsOption{WithTXsPerPage(101)},
+			err:     "the transactions per page must be between 1 and 100, got 101",
+		},
+		{
+			name:    "invalid order",
+			options: []TXsOption{WithTXOrder("newest")},
+			err:     `invalid order of the transactions "newest", use "asc" or "desc"`,
+		},
+		{
+			name: "descending order with a checkpointer",
+			options: []TXsOption{
+				WithTXOrder(TXOrderDesc),
+				WithCheckpointer(NewFileCheckpointer(filepath.Join(t.TempDir(), "checkpoint"))),
+			},
+			err: "the checkpoints require the transactions in ascending order",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Client{}.newTXsOptions(tt.options)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestBlockHeight(t *testing.T) {
+	var asc, desc []int64
+	for i := int64(0); i < 3; i++ {
+		asc = append(asc, blockHeight(5, 7, i, TXOrderAsc))
+		desc = append(desc, blockHeight(5, 7, i, TXOrderDesc))
+	}
+	require.Equal(t, []int64{5, 6, 7}, asc)
+	require.Equal(t, []int64{7, 6, 5}, desc)
+}
+
+func TestSearchDone(t *testing.T) {
+	// a node capping the pages to 30 transactions returns the 250 transactions in 9 pages.
+	var pages, searched int
+	for {
+		pageSize := 30
+		if rest := 250 - searched; rest < pageSize {
+			pageSize = rest
+		}
+		searched += pageSize
+		pages++
+		if searchDone(pageSize, searched, 250) {
+			break
+		}
+	}
+	require.Equal(t, 9, pages)
+	require.Equal(t, 250, searched)
+
+	require.True(t, searchDone(0, 0, 10))
+}
+
+func TestLatestHeights(t *testing.T) {
+	blocks := make(chan *tmtypes.Block)
+	heights := latestHeights(blocks)
+
+	// the blocks are read while the heights aren't, the heights not read are replaced by the
+	// latest one, which is always received.
+	for h := int64(1); h <= 3; h++ {
+		blocks <- &tmtypes.Block{Header: tmtypes.Header{Height: h}}
+	}
+	close(blocks)
+
+	var received []int64
+	for h := range heights {
+		received = append(received, h)
+	}
+	require.LessOrEqual(t, len(received), 2)
+	require.Equal(t, int64(3), received[len(received)-1])
+}
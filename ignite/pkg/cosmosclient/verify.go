@@ -0,0 +1,164 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	lrpc "github.com/tendermint/tendermint/light/rpc"
+	dbs "github.com/tendermint/tendermint/light/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// WithTrustOptions enables the verifying mode of the client: the store queries of QueryStore
+// request proofs that are verified against the headers of the chain chainID tracked by a light
+// client before returning data, and LightRPC fetches the blocks, the txs and the validators
+// verified by the light client. The other calls, like Status, the ABCI and gRPC queries of the
+// modules and the calls of RPC, aren't verified.
+// The light client trusts the header described by the trust options and cross-checks
+// the headers with the witnesses nodes, the node of the client is used as witness when
+// no witnesses are provided. New fails when the node doesn't belong to the chain chainID.
+func WithTrustOptions(chainID string, trustOptions light.TrustOptions, witnesses ...string) Option {
+	return func(c *Client) {
+		c.trustChainID = chainID
+		c.trustOptions = &trustOptions
+		c.witnesses = witnesses
+	}
+}
+
+// newLightClient returns the light client verifying the headers of the chain from the trust
+// options. The chain ID of the trust options is used, the chain ID returned by the node isn't trusted.
+func (c Client) newLightClient(ctx context.Context) (*light.Client, error) {
+	if err := c.trustOptions.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "invalid trust options")
+	}
+	if c.trustChainID == "" {
+		return nil, errors.New("invalid trust options: the chain id is empty")
+	}
+	if c.chainID != c.trustChainID {
+		return nil, &WrongNetworkError{
+			Node:     c.nodeAddress,
+			Field:    "chain id",
+			Expected: c.trustChainID,
+			Actual:   c.chainID,
+		}
+	}
+
+	witnesses := c.witnesses
+	if len(witnesses) == 0 {
+		witnesses = []string{c.nodeAddress}
+		if len(c.nodeAddresses) > 1 {
+			witnesses = c.nodeAddresses[1:]
+		}
+	}
+
+	lc, err := light.NewHTTPClient(
+		ctx,
+		c.trustChainID,
+		*c.trustOptions,
+		c.nodeAddress,
+		witnesses,
+		dbs.New(dbm.NewMemDB(), c.trustChainID),
+		light.Logger(log.NewNopLogger()),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the light client")
+	}
+	return lc, nil
+}
+
+// newLightRPC returns an RPC client verifying the blocks, the txs and the validators of rpc with the
+// light client.
+func newLightRPC(rpc rpcclient.Client, lc *light.Client) *lrpc.Client {
+	lightRPC := lrpc.NewClient(rpc, lc, lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
+
+	// the proofs of the stores of the app are ICS-23 commitment proofs.
+	lightRPC.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
+	lightRPC.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
+	return lightRPC
+}
+
+// IsVerifying returns true when the client verifies the proofs of the store queries.
+func (c Client) IsVerifying() bool {
+	return c.lightClient != nil
+}
+
+// LightRPC returns the RPC client verifying the blocks, the txs and the validators with the light
+// client of the verifying mode, nil is returned when the client isn't verifying. Like RPC, the calls
+// not provable, e.g. Status and the broadcasts, are sent as is. Use QueryStore to verify the values
+// of the stores.
+func (c Client) LightRPC() rpcclient.Client {
+	if c.lightRPC == nil {
+		return nil
+	}
+	return c.lightRPC
+}
+
+// QueryStore queries the value of key in the store of a module at height, the latest height is
+// used when height is zero. In verifying mode, the value or its absence is proven against the
+// app hash of a header verified by the light client.
+//
+// Unlike store queries, the ABCI and gRPC queries of the modules don't return proofs and aren't
+// verified.
+func (c Client) QueryStore(ctx context.Context, storeKey string, key []byte, height int64) (value []byte, queryHeight int64, err error) {
+	path := fmt.Sprintf("/store/%s/key", storeKey)
+	opts := rpcclient.ABCIQueryOptions{Height: height, Prove: c.IsVerifying()}
+
+	res, err := c.RPC.ABCIQueryWithOptions(ctx, path, key, opts)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "cannot query the %s store", storeKey)
+	}
+	if !res.Response.IsOK() {
+		return nil, 0, errors.Errorf("cannot query the %s store: %s", storeKey, res.Response.Log)
+	}
+	if c.IsVerifying() {
+		if err := c.verifyStoreProof(ctx, storeKey, res.Response); err != nil {
+			return nil, 0, errors.Wrapf(err, "cannot verify the value of the %s store", storeKey)
+		}
+	}
+
+	return res.Response.Value, res.Response.Height, nil
+}
+
+// verifyStoreProof verifies the proof of the value of a store query, or of its absence, against
+// the app hash of the header following the height of the query verified by the light client.
+func (c Client) verifyStoreProof(ctx context.Context, storeKey string, res abci.ResponseQuery) error {
+	if len(res.Key) == 0 {
+		return errors.New("empty key")
+	}
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return errors.New("no proof ops")
+	}
+	if res.Height <= 0 {
+		return errors.Errorf("invalid height %d", res.Height)
+	}
+
+	// the app hash of a height is in the header of the next height.
+	block, err := c.lightClient.VerifyLightBlockAtHeight(ctx, res.Height+1, time.Now())
+	if err != nil {
+		return err
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(res.Key, merkle.KeyEncodingURL)
+	prt := rootmulti.DefaultProofRuntime()
+	if res.Value != nil {
+		if err := prt.VerifyValue(res.ProofOps, block.AppHash, keyPath.String(), res.Value); err != nil {
+			return errors.Wrap(err, "verify value proof")
+		}
+		return nil
+	}
+	if err := prt.VerifyAbsence(res.ProofOps, block.AppHash, keyPath.String()); err != nil {
+		return errors.Wrap(err, "verify absence proof")
+	}
+	return nil
+}
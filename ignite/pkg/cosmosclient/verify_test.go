@@ -0,0 +1,257 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/light"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	lightChainID  = "mars"
+	lightStoreKey = "bank"
+)
+
+// lightNode is a node of a chain of two blocks signed by a single validator, the app hash of the
+// second block is the hash of a store holding the values of the node. The responses of the store
+// queries are changed by tamper when it's set.
+type lightNode struct {
+	headers    []*types.SignedHeader
+	validators *types.ValidatorSet
+	store      *rootmulti.Store
+	tamper     func(*abci.ResponseQuery)
+}
+
+// newLightNode returns a node whose first block is created at start.
+func newLightNode(t *testing.T, start time.Time, values map[string]string) *lightNode {
+	key := storetypes.NewKVStoreKey(lightStoreKey)
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	kv := store.GetKVStore(key)
+	for k, v := range values {
+		kv.Set([]byte(k), []byte(v))
+	}
+	commit := store.Commit()
+
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	validators := types.NewValidatorSet([]*types.Validator{types.NewValidator(pubKey, 10)})
+
+	// the app hash of a height is in the header of the next height.
+	n := &lightNode{validators: validators, store: store}
+	for i, appHash := range [][]byte{nil, commit.Hash} {
+		height := int64(i + 1)
+		header := &types.Header{
+			Version:            tmversion.Consensus{Block: version.BlockProtocol},
+			ChainID:            lightChainID,
+			Height:             height,
+			Time:               start.Add(time.Duration(i) * time.Second),
+			ValidatorsHash:     validators.Hash(),
+			NextValidatorsHash: validators.Hash(),
+			AppHash:            appHash,
+			ProposerAddress:    validators.Proposer.Address,
+		}
+		blockID := types.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+		}
+		voteSet := types.NewVoteSet(lightChainID, height, 0, tmproto.PrecommitType, validators)
+		commit, err := types.MakeCommit(blockID, height, 0, voteSet, []types.PrivValidator{pv}, header.Time)
+		require.NoError(t, err)
+		n.headers = append(n.headers, &types.SignedHeader{Header: header, Commit: commit})
+	}
+	return n
+}
+
+func (n *lightNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpctypes.RPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var params struct {
+		Height int64            `json:"height"`
+		Path   string           `json:"path"`
+		Data   tmbytes.HexBytes `json:"data"`
+	}
+	if err := tmjson.Unmarshal(req.Params, &params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if params.Height == 0 {
+		params.Height = int64(len(n.headers))
+	}
+
+	var result interface{}
+	switch req.Method {
+	case "commit":
+		result = ctypes.ResultCommit{SignedHeader: *n.headers[params.Height-1], CanonicalCommit: true}
+	case "validators":
+		result = ctypes.ResultValidators{
+			BlockHeight: params.Height,
+			Validators:  n.validators.Validators,
+			Count:       n.validators.Size(),
+			Total:       n.validators.Size(),
+		}
+	case "abci_query":
+		res := n.store.Query(abci.RequestQuery{
+			Path:  strings.TrimPrefix(params.Path, "/store"),
+			Data:  params.Data,
+			Prove: true,
+		})
+		if n.tamper != nil {
+			n.tamper(&res)
+		}
+		result = ctypes.ResultABCIQuery{Response: res}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	data, err := tmjson.Marshal(result)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: data})
+}
+
+// newVerifyingClient returns a client of the node trusting its first header for trustPeriod.
+func newVerifyingClient(t *testing.T, node *lightNode, chainID string, trustPeriod time.Duration) (Client, error) {
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	rpc, err := rpchttp.New(server.URL, "/websocket")
+	require.NoError(t, err)
+
+	c := Client{RPC: rpc, nodeAddress: server.URL, chainID: lightChainID}
+	WithTrustOptions(chainID, light.TrustOptions{
+		Period: trustPeriod,
+		Height: 1,
+		Hash:   node.headers[0].Hash(),
+	})(&c)
+	if c.lightClient, err = c.newLightClient(context.Background()); err != nil {
+		return Client{}, err
+	}
+	c.lightRPC = newLightRPC(c.RPC, c.lightClient)
+	return c, nil
+}
+
+func TestQueryStoreVerified(t *testing.T) {
+	start := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		key         string
+		trustPeriod time.Duration
+		tamper      func(*abci.ResponseQuery)
+		wantValue   string
+		err         string
+	}{
+		{
+			name:      "valid proof",
+			key:       "alice",
+			wantValue: "42",
+		},
+		{
+			name: "valid absence proof",
+			key:  "carol",
+		},
+		{
+			name:   "tampered value",
+			key:    "alice",
+			tamper: func(res *abci.ResponseQuery) { res.Value = []byte("1000000") },
+			err:    "verify value proof",
+		},
+		{
+			name:   "value hidden with an absence proof",
+			key:    "alice",
+			tamper: func(res *abci.ResponseQuery) { res.Value = nil },
+			err:    "verify absence proof",
+		},
+		{
+			name:        "expired trust period",
+			key:         "alice",
+			trustPeriod: 30 * time.Second,
+			err:         "expired",
+		},
+		{
+			name:   "missing proof",
+			key:    "alice",
+			tamper: func(res *abci.ResponseQuery) { res.ProofOps = nil },
+			err:    "no proof ops",
+		},
+		{
+			name:   "missing absence proof",
+			key:    "carol",
+			tamper: func(res *abci.ResponseQuery) { res.ProofOps = nil },
+			err:    "no proof ops",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := newLightNode(t, start, map[string]string{"alice": "42", "bob": "7"})
+			node.tamper = tt.tamper
+			trustPeriod := tt.trustPeriod
+			if trustPeriod == 0 {
+				trustPeriod = time.Hour
+			}
+			c, err := newVerifyingClient(t, node, lightChainID, trustPeriod)
+			require.NoError(t, err)
+			require.True(t, c.IsVerifying())
+
+			value, height, err := c.QueryStore(context.Background(), lightStoreKey, []byte(tt.key), 0)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, 1, height)
+			if tt.wantValue == "" {
+				require.Empty(t, value)
+				return
+			}
+			require.Equal(t, tt.wantValue, string(value))
+		})
+	}
+}
+
+func TestNewLightClient(t *testing.T) {
+	// the chain ID of the node isn't trusted.
+	node := newLightNode(t, time.Now().Add(-time.Minute), nil)
+	_, err := newVerifyingClient(t, node, "venus", time.Hour)
+	var wrongNetwork *WrongNetworkError
+	require.ErrorAs(t, err, &wrongNetwork)
+	require.Equal(t, "venus", wrongNetwork.Expected)
+
+	// the verified blocks are fetched with the light client.
+	c, err := newVerifyingClient(t, node, lightChainID, time.Hour)
+	require.NoError(t, err)
+	height := int64(2)
+	commit, err := c.LightRPC().Commit(context.Background(), &height)
+	require.NoError(t, err)
+	require.Equal(t, node.headers[1].Hash(), commit.Hash())
+}
@@ -0,0 +1,107 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// waitTxPollInterval is the interval between two queries of a tx waiting to be committed.
+var waitTxPollInterval = time.Second
+
+// TxTimeoutError is returned by WaitForTx when the tx isn't committed before the timeout.
+type TxTimeoutError struct {
+	Hash    string
+	Timeout time.Duration
+}
+
+func (e *TxTimeoutError) Error() string {
+	return fmt.Sprintf("tx %s not committed after %s", e.Hash, e.Timeout)
+}
+
+// TxFailedError is returned by WaitForTx when the tx is committed with a non-zero code.
+type TxFailedError struct {
+	// TX is the failed tx with its result.
+	TX TX
+
+	Codespace string
+	Code      uint32
+	Log       string
+}
+
+func (e *TxFailedError) Error() string {
+	return fmt.Sprintf("tx %X failed with code %d in %s: %s", e.TX.Raw.Hash, e.Code, e.Codespace, e.Log)
+}
+
+// Unwrap returns the registered error of the code, see BroadcastError.
+func (e *TxFailedError) Unwrap() error {
+	return decodeABCIError(e.Codespace, e.Code, e.Log)
+}
+
+// WaitForTx waits for the tx with the hex encoded hash to be committed and returns it with its
+// result, the transactions broadcasted with BroadcastSync or BroadcastAsync return before
+// they're committed. The node is queried every second until timeout, a TxTimeoutError is
+// returned when the tx isn't committed in time and a TxFailedError when it failed.
+func (c Client) WaitForTx(ctx context.Context, hash string, timeout time.Duration) (TX, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return TX{}, errors.Wrapf(err, "invalid tx hash %s", hash)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(waitTxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.RPC.Tx(ctx, hashBytes, false)
+		switch {
+		case err == nil:
+			return c.committedTX(ctx, *res)
+
+		// the node doesn't know the tx until it's committed.
+		case !errors.Is(ParseError(err), ErrTxNotFound):
+			if ctx.Err() == nil {
+				return TX{}, errors.Wrapf(err, "cannot query the tx %s", hash)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return TX{}, &TxTimeoutError{Hash: hash, Timeout: timeout}
+			}
+			return TX{}, ctx.Err()
+		}
+	}
+}
+
+// committedTX returns the committed tx with the time of its block, or a TxFailedError when the
+// tx failed.
+func (c Client) committedTX(ctx context.Context, res ctypes.ResultTx) (TX, error) {
+	block, err := c.RPC.Block(ctx, &res.Height)
+	if err != nil {
+		return TX{}, errors.Wrapf(err, "cannot fetch the block %d of the tx", res.Height)
+	}
+
+	tx := TX{
+		BlockTime: block.Block.Time,
+		Raw:       res,
+		decoder:   c.context.TxConfig.TxDecoder(),
+	}
+	if res.TxResult.Code != 0 {
+		return tx, &TxFailedError{
+			TX:        tx,
+			Codespace: res.TxResult.Codespace,
+			Code:      res.TxResult.Code,
+			Log:       res.TxResult.Log,
+		}
+	}
+	return tx, nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -27,6 +28,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
 	"github.com/spf13/pflag"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
@@ -210,6 +212,7 @@ func initRootCmd(
 		genutilcli.ValidateGenesisCmd(moduleBasics),
 		AddGenesisAccountCmd(defaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
+		manCommand(rootCmd),
 		debug.Cmd(),
 		config.Cmd(),
 	)
@@ -242,12 +245,35 @@ func initRootCmd(
 		keys.Commands(defaultNodeHome),
 	)
 
+	// add the standalone Rosetta API gateway
+	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
+
 	// add user given sub commands.
 	for _, cmd := range options.addSubCmds {
 		rootCmd.AddCommand(cmd)
 	}
 }
 
+// manCommand returns the hidden sub-command generating the man pages of the app commands in a directory
+func manCommand(rootCmd *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:    "man [dir]",
+		Short:  "Generate the man pages of the commands in a directory",
+		Args:   cobra.ExactArgs(1),
+		Hidden: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := os.MkdirAll(args[0], 0755); err != nil {
+				return err
+			}
+			header := &doc.GenManHeader{
+				Title:   strings.ToUpper(rootCmd.Name()),
+				Section: "1",
+			}
+			return doc.GenManTree(rootCmd, header, args[0])
+		},
+	}
+}
+
 // queryCommand returns the sub-command to send queries to the app
 func queryCommand(moduleBasics module.BasicManager) *cobra.Command {
 	cmd := &cobra.Command{
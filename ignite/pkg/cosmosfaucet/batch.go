@@ -0,0 +1,207 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+)
+
+// DefaultBatchSize is the default maximum number of claims sent in a batch.
+const DefaultBatchSize = 100
+
+// Batch sends the claims of the faucet in batches instead of one tx per claim. The claims received
+// during window are sent together in a single multi-send tx, a batch is sent before the end of the
+// window when it has maxSize claims. Batches are faster than sequential sends when many users
+// claim tokens at the same time, and they don't fail on sequence mismatches.
+func Batch(window time.Duration, maxSize int) Option {
+	return func(f *Faucet) {
+		f.batchWindow = window
+		f.batchSize = maxSize
+	}
+}
+
+// claim is a transfer request waiting to be sent in a batch.
+type claim struct {
+	address string
+	coins   sdk.Coins
+	result  chan claimResult
+}
+
+// claimResult is the result of the transfer of a claim.
+type claimResult struct {
+	txHash string
+	err    error
+}
+
+// batcher collects the claims during a window and sends them in batches.
+type batcher struct {
+	mu      sync.Mutex
+	window  time.Duration
+	maxSize int
+	claims  []claim
+	timer   *time.Timer
+
+	// pending are the amounts of the claims that aren't confirmed yet, by address and denom.
+	pending map[string]map[string]uint64
+
+	// sendMu ensures that a batch is sent after the previous one is confirmed, so the txs don't
+	// have the same sequence.
+	sendMu sync.Mutex
+
+	// send sends the claims of a batch and reports the result of each claim.
+	send func(claims []claim)
+}
+
+func newBatcher(window time.Duration, maxSize int, send func([]claim)) *batcher {
+	if maxSize <= 0 {
+		maxSize = DefaultBatchSize
+	}
+	return &batcher{
+		window:  window,
+		maxSize: maxSize,
+		pending: make(map[string]map[string]uint64),
+		send:    send,
+	}
+}
+
+// add adds a claim to the current batch and returns the channel receiving the result of the claim.
+func (b *batcher) add(address string, coins sdk.Coins) <-chan claimResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := claim{
+		address: address,
+		coins:   coins,
+		result:  make(chan claimResult, 1),
+	}
+	b.claims = append(b.claims, c)
+	if b.pending[address] == nil {
+		b.pending[address] = make(map[string]uint64)
+	}
+	for _, coin := range coins {
+		b.pending[address][coin.Denom] += coin.Amount.Uint64()
+	}
+
+	switch {
+	case len(b.claims) >= b.maxSize:
+		b.flushLocked()
+	case len(b.claims) == 1:
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+
+	return c.result
+}
+
+// pendingAmount returns the amount of denom claimed by address that isn't confirmed yet.
+func (b *batcher) pendingAmount(address, denom string) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pending[address][denom]
+}
+
+func (b *batcher) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.flushLocked()
+}
+
+// flushLocked sends the current batch, the batcher must be locked.
+func (b *batcher) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	if len(b.claims) == 0 {
+		return
+	}
+
+	claims := b.claims
+	b.claims = nil
+
+	go func() {
+		b.sendMu.Lock()
+		b.send(claims)
+		b.sendMu.Unlock()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		for _, c := range claims {
+			for _, coin := range c.coins {
+				b.pending[c.address][coin.Denom] -= coin.Amount.Uint64()
+			}
+		}
+		for address, amounts := range b.pending {
+			if isZero(amounts) {
+				delete(b.pending, address)
+			}
+		}
+	}()
+}
+
+// sendBatch sends the claims in a single multi-send tx. When the tx can't be broadcasted, the
+// claims are sent one by one, so an invalid claim only fails its own request.
+func (f Faucet) sendBatch(claims []claim) {
+	// the batch is sent on behalf of several requests, it isn't canceled with one of them.
+	ctx := context.Background()
+
+	if len(claims) == 1 {
+		txHash, err := f.send(ctx, claims[0].address, claims[0].coins)
+		claims[0].result <- claimResult{txHash, err}
+		return
+	}
+
+	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		for _, c := range claims {
+			c.result <- claimResult{err: err}
+		}
+		return
+	}
+
+	outputs := make([]chaincmdrunner.BankOutput, len(claims))
+	for i, c := range claims {
+		outputs[i] = chaincmdrunner.BankOutput{
+			Address: c.address,
+			Amount:  coinsString(c.coins),
+		}
+	}
+
+	txHash, err := f.runner.BankMultiSend(ctx, f.accountName, fromAccount.Address, outputs)
+	if err != nil {
+		for _, c := range claims {
+			txHash, err := f.send(ctx, c.address, c.coins)
+			c.result <- claimResult{txHash, err}
+		}
+		return
+	}
+
+	err = f.runner.WaitTx(ctx, txHash, time.Second, 30)
+	for _, c := range claims {
+		c.result <- claimResult{txHash, err}
+	}
+}
+
+func isZero(amounts map[string]uint64) bool {
+	for _, amount := range amounts {
+		if amount != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func coinsString(coins sdk.Coins) string {
+	var coinsStr []string
+	for _, c := range coins {
+		coinsStr = append(coinsStr, c.String())
+	}
+	return strings.Join(coinsStr, ",")
+}
@@ -0,0 +1,75 @@
+package cosmosfaucet
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcher(t *testing.T) {
+	var (
+		batches = make(chan []claim, 2)
+		release = make(chan struct{})
+	)
+	b := newBatcher(time.Hour, 2, func(claims []claim) {
+		batches <- claims
+		<-release
+		for i, c := range claims {
+			var err error
+			if i == 1 {
+				err = errors.New("invalid address")
+			}
+			c.result <- claimResult{"hash", err}
+		}
+	})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	first := b.add("cosmos1a", coins)
+	require.EqualValues(t, 10, b.pendingAmount("cosmos1a", "token"))
+
+	// the batch is full, it's sent before the end of the window.
+	second := b.add("cosmos1b", coins)
+	claims := <-batches
+	require.Len(t, claims, 2)
+	require.Equal(t, "cosmos1a", claims[0].address)
+	require.Equal(t, "cosmos1b", claims[1].address)
+	require.EqualValues(t, 10, b.pendingAmount("cosmos1b", "token"))
+
+	// each claim gets its own result.
+	close(release)
+	require.Equal(t, claimResult{"hash", nil}, <-first)
+	require.EqualError(t, (<-second).err, "invalid address")
+
+	require.Eventually(t, func() bool {
+		return b.pendingAmount("cosmos1a", "token") == 0 && b.pendingAmount("cosmos1b", "token") == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestBatcherWindow(t *testing.T) {
+	b := newBatcher(10*time.Millisecond, 0, func(claims []claim) {
+		for _, c := range claims {
+			c.result <- claimResult{txHash: "hash"}
+		}
+	})
+	require.Equal(t, DefaultBatchSize, b.maxSize)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	results := []<-chan claimResult{
+		b.add("cosmos1a", coins),
+		b.add("cosmos1a", coins),
+	}
+	require.EqualValues(t, 20, b.pendingAmount("cosmos1a", "token"))
+
+	// the batch is sent at the end of the window.
+	for _, result := range results {
+		select {
+		case r := <-result:
+			require.Equal(t, "hash", r.txHash)
+		case <-time.After(time.Second):
+			t.Fatal("the batch isn't sent at the end of the window")
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+
+	"github.com/ignite/cli/ignite/pkg/correlation"
 )
 
 // ErrTransferRequest is a error that occurs when a transfer request fails
@@ -39,6 +41,7 @@ func (c HTTPClient) Transfer(ctx context.Context, req TransferRequest) (Transfer
 	if err != nil {
 		return TransferResponse{}, err
 	}
+	correlation.SetHeader(hreq)
 
 	hres, err := http.DefaultClient.Do(hreq)
 	if err != nil {
@@ -62,6 +65,7 @@ func (c HTTPClient) FaucetInfo(ctx context.Context) (FaucetInfoResponse, error)
 	if err != nil {
 		return FaucetInfoResponse{}, err
 	}
+	correlation.SetHeader(hreq)
 
 	hres, err := http.DefaultClient.Do(hreq)
 	if err != nil {
@@ -6,8 +6,11 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/tendermint/tendermint/libs/log"
 
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
 )
 
 const (
@@ -56,8 +59,45 @@ type Faucet struct {
 
 	limitRefreshWindow time.Duration
 
+	// testAccounts enables the endpoint creating funded test accounts.
+	testAccounts bool
+
+	// addressPrefix is the address prefix of the chain used for the test accounts.
+	addressPrefix string
+
+	// batchWindow and batchSize configure the batches of claims, the claims are sent one by one
+	// when batchWindow is zero.
+	batchWindow time.Duration
+	batchSize   int
+
+	// batcher sends the claims in batches when batching is enabled.
+	batcher *batcher
+
+	// queue processes the transfers of the requests, the transfers failing with an error of the
+	// node are retried.
+	queue        *jobqueue.Queue
+	queueOptions []jobqueue.Option
+
+	// limiter limits the requests per address and per IP.
+	limiter *rateLimiter
+
+	// captchaProvider, captchaSiteKey and captchaSecret configure the CAPTCHA verifying the requests.
+	captchaProvider  CaptchaProvider
+	captchaSiteKey   string
+	captchaSecret    string
+	captchaVerifyURL string
+
+	// claimKey is the key signing the claim tokens verifying the requests.
+	claimKey []byte
+
+	// adminToken enables the admin endpoints, authenticated by the token.
+	adminToken string
+
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
 	openAPIData openAPIData
+
+	// logger logs the transfers with the correlation ID of their request.
+	logger log.Logger
 }
 
 // Option configures the faucetOptions.
@@ -107,13 +147,41 @@ func OpenAPI(apiAddress string) Option {
 	}
 }
 
+// TestAccounts enables the endpoint creating and funding test accounts.
+// the endpoint returns the mnemonic of the accounts so it should only be enabled on dev chains.
+func TestAccounts() Option {
+	return func(f *Faucet) {
+		f.testAccounts = true
+		f.openAPIData.TestAccounts = true
+	}
+}
+
+// AdminToken enables the admin endpoints to inspect and reset the rate limits of the faucet.
+// the requests to the endpoints must be authenticated with the token as a bearer token.
+func AdminToken(token string) Option {
+	return func(f *Faucet) {
+		f.adminToken = token
+		f.openAPIData.Admin = token != ""
+	}
+}
+
+// Logger sets the structured logger of the faucet, the log lines of the transfers hold the
+// correlation ID of their request.
+func Logger(logger log.Logger) Option {
+	return func(f *Faucet) {
+		f.logger = logger
+	}
+}
+
 // New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
 func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
 	f := Faucet{
 		runner:      ccr,
 		accountName: DefaultAccountName,
 		coinsMax:    make(map[string]uint64),
-		openAPIData: openAPIData{"Blockchain", "http://localhost:1317"},
+		limiter:     newRateLimiter(),
+		logger:      log.NewNopLogger(),
+		openAPIData: openAPIData{ChainID: "Blockchain", APIAddress: "http://localhost:1317"},
 	}
 
 	for _, apply := range options {
@@ -128,6 +196,11 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		RefreshWindow(DefaultRefreshWindow)(&f)
 	}
 
+	// restore the limits of the previous runs.
+	if err := f.limiter.load(); err != nil {
+		return Faucet{}, err
+	}
+
 	// import the account if mnemonic is provided.
 	if f.accountMnemonic != "" {
 		_, err := f.runner.AddAccount(ctx, f.accountName, f.accountMnemonic, f.coinType)
@@ -136,6 +209,17 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		}
 	}
 
+	// test accounts are created with the address prefix of the faucet account.
+	if f.testAccounts {
+		account, err := f.runner.ShowAccount(ctx, f.accountName)
+		if err != nil {
+			return Faucet{}, err
+		}
+		if f.addressPrefix, _, err = bech32.DecodeAndConvert(account.Address); err != nil {
+			return Faucet{}, err
+		}
+	}
+
 	if f.chainID == "" {
 		status, err := f.runner.Status(ctx)
 		if err != nil {
@@ -146,5 +230,18 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		f.openAPIData.ChainID = status.ChainID
 	}
 
+	if f.batchWindow > 0 {
+		f.batcher = newBatcher(f.batchWindow, f.batchSize, f.sendBatch)
+	}
+
+	// the transfers are processed until ctx is canceled, the pending ones are resumed by the next run
+	// when the queue is persisted.
+	queue, err := f.newTransferQueue()
+	if err != nil {
+		return Faucet{}, err
+	}
+	f.queue = queue
+	go func() { _ = f.queue.Run(ctx) }()
+
 	return f, nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 
+	"github.com/ignite/cli/ignite/pkg/correlation"
 	"github.com/ignite/cli/ignite/pkg/openapiconsole"
 )
 
@@ -20,11 +21,30 @@ func (f Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	router.Handle("/info", cors.Default().Handler(http.HandlerFunc(f.faucetInfoHandler))).
 		Methods(http.MethodGet)
 
+	if f.testAccounts {
+		router.Handle("/account", cors.Default().Handler(http.HandlerFunc(f.testAccountHandler))).
+			Methods(http.MethodPost)
+	}
+
+	if f.adminToken != "" {
+		router.Handle("/admin/limits", f.adminHandler(f.adminLimitsHandler)).
+			Methods(http.MethodGet)
+		router.Handle("/admin/limits", f.adminHandler(f.adminResetLimitsHandler)).
+			Methods(http.MethodDelete)
+		router.Handle("/admin/limits/{key}", f.adminHandler(f.adminResetLimitsHandler)).
+			Methods(http.MethodDelete)
+		router.Handle("/admin/queue", f.adminHandler(f.adminQueueHandler)).
+			Methods(http.MethodGet)
+		router.Handle("/admin/queue/{id}", f.adminHandler(f.adminRequeueHandler)).
+			Methods(http.MethodPost)
+	}
+
 	router.HandleFunc("/", openapiconsole.Handler("Faucet", "openapi.yml")).
 		Methods(http.MethodGet)
 
 	router.HandleFunc("/openapi.yml", f.openAPISpecHandler).
 		Methods(http.MethodGet)
 
-	router.ServeHTTP(w, r)
+	// the responses hold the correlation ID of the requests, used by the log lines of the transfers.
+	correlation.Middleware(router).ServeHTTP(w, r)
 }
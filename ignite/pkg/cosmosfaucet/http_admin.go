@@ -0,0 +1,97 @@
+package cosmosfaucet
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+var errUnauthorized = errors.New("invalid admin token")
+
+type LimitsResponse struct {
+	// Limits are the rate limits of the addresses and the IPs in their current window.
+	Limits []Limit `json:"limits"`
+
+	Error string `json:"error,omitempty"`
+}
+
+type QueueResponse struct {
+	// Status is the state of the queue of the transfers.
+	Status jobqueue.Status `json:"status"`
+
+	// DeadLetters are the transfers that failed all their attempts.
+	DeadLetters []jobqueue.Job `json:"dead_letters"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// adminHandler authenticates the requests to the admin endpoints with the admin token.
+func (f Faucet) adminHandler(h http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(f.adminToken)) != 1 {
+			responseError(w, http.StatusUnauthorized, errUnauthorized)
+			return
+		}
+		h(w, r)
+	})
+}
+
+func (f Faucet) adminLimitsHandler(w http.ResponseWriter, r *http.Request) {
+	xhttp.ResponseJSON(w, http.StatusOK, LimitsResponse{
+		Limits: f.limiter.list(),
+	})
+}
+
+// adminResetLimitsHandler resets the limit of the key in the path, all the limits without a key.
+func (f Faucet) adminResetLimitsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := f.limiter.reset(mux.Vars(r)["key"]); err != nil {
+		responseError(w, http.StatusInternalServerError, err)
+		return
+	}
+	responseSuccess(w)
+}
+
+func (f Faucet) adminQueueHandler(w http.ResponseWriter, r *http.Request) {
+	xhttp.ResponseJSON(w, http.StatusOK, QueueResponse{
+		Status:      f.queue.Status(),
+		DeadLetters: f.queue.DeadLetters(),
+	})
+}
+
+// adminRequeueHandler moves the dead letter in the path back to the queue of the transfers.
+func (f Faucet) adminRequeueHandler(w http.ResponseWriter, r *http.Request) {
+	if err := f.queue.Requeue(mux.Vars(r)["id"]); err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, jobqueue.ErrNotFound) {
+			code = http.StatusNotFound
+		}
+		responseError(w, code, err)
+		return
+	}
+	responseSuccess(w)
+}
+
+// rateLimitStatus returns the status code of the error of the rate limiter, and sets the
+// Retry-After header when a limit is exceeded.
+func rateLimitStatus(w http.ResponseWriter, err error) int {
+	var limitErr *RateLimitError
+	if !errors.As(err, &limitErr) {
+		return http.StatusInternalServerError
+	}
+
+	retryAfter := time.Until(limitErr.Limit.ResetAt).Round(time.Second)
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	return http.StatusTooManyRequests
+}
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
 	"github.com/ignite/cli/ignite/pkg/xhttp"
 )
 
@@ -17,6 +18,9 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins"`
+
+	// Verification proves the request is allowed when the faucet requires a verification.
+	Verification
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -27,6 +31,10 @@ func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
 }
 
 type TransferResponse struct {
+	// TxHash is the hash of the tx sending the coins, the tx sends the coins of other requests
+	// as well when the faucet sends the requests in batches.
+	TxHash string `json:"tx_hash,omitempty"`
+
 	Error string `json:"error,omitempty"`
 }
 
@@ -46,14 +54,26 @@ func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// try performing the transfer
-	if err := f.Transfer(r.Context(), req.AccountAddress, coins); err != nil {
+	if err := f.verify(r.Context(), requestIP(r), req.AccountAddress, req.Verification); err != nil {
+		responseError(w, verifyStatus(err), err)
+		return
+	}
+
+	if err := f.limiter.allow(requestIP(r), req.AccountAddress); err != nil {
+		responseError(w, rateLimitStatus(w, err), err)
+		return
+	}
+
+	// the transfer is queued and retried on the errors of the node, it completes even if the
+	// client disconnects.
+	txHash, err := f.enqueueTransfer(r.Context(), req.AccountAddress, coins)
+	if err != nil {
 		if err == context.Canceled {
 			return
 		}
 		responseError(w, http.StatusInternalServerError, err)
 	} else {
-		responseSuccess(w)
+		xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{TxHash: txHash})
 	}
 }
 
@@ -65,13 +85,29 @@ type FaucetInfoResponse struct {
 
 	// ChainID is chain id of the chain that faucet is running for.
 	ChainID string `json:"chain_id"`
+
+	// Verifications are the verifications accepted by the faucet, one of them is required when set.
+	Verifications []string `json:"verifications,omitempty"`
+
+	// CaptchaSiteKey is the site key of the CAPTCHA widget.
+	CaptchaSiteKey string `json:"captcha_site_key,omitempty"`
+
+	// Queue is the state of the queue of the transfers.
+	Queue *jobqueue.Status `json:"queue,omitempty"`
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, r *http.Request) {
-	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
-		IsAFaucet: true,
-		ChainID:   f.chainID,
-	})
+	info := FaucetInfoResponse{
+		IsAFaucet:      true,
+		ChainID:        f.chainID,
+		Verifications:  f.verifications(),
+		CaptchaSiteKey: f.captchaSiteKey,
+	}
+	if f.queue != nil {
+		status := f.queue.Status()
+		info.Queue = &status
+	}
+	xhttp.ResponseJSON(w, http.StatusOK, info)
 }
 
 // coinsFromRequest determines tokens to transfer from transfer request.
@@ -18,6 +18,10 @@ var tmplOpenAPISpec = template.Must(template.New(fileNameOpenAPISpec).Parse(stri
 type openAPIData struct {
 	ChainID    string
 	APIAddress string
+
+	TestAccounts bool
+	Admin        bool
+	Verification bool
 }
 
 func (f Faucet) openAPISpecHandler(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,93 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+// testAccountName is the name of the test accounts in the keyring they're created with.
+const testAccountName = "test"
+
+type TestAccountRequest struct {
+	// Coins that are requested for the account.
+	// default ones used when this one isn't provided.
+	Coins []string `json:"coins"`
+
+	// Verification proves the request is allowed when the faucet requires a verification.
+	Verification
+}
+
+type TestAccountResponse struct {
+	// Address of the created account.
+	Address string `json:"address,omitempty"`
+
+	// Mnemonic of the created account.
+	Mnemonic string `json:"mnemonic,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
+func (f Faucet) testAccountHandler(w http.ResponseWriter, r *http.Request) {
+	var req TestAccountRequest
+
+	// the body is optional, default coins are sent when it's empty.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		responseTestAccountError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	coins, err := f.coinsFromRequest(TransferRequest{Coins: req.Coins})
+	if err != nil {
+		responseTestAccountError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := f.verify(r.Context(), requestIP(r), "", req.Verification); err != nil {
+		responseTestAccountError(w, verifyStatus(err), err)
+		return
+	}
+
+	// the address is new, only the IP is limited.
+	if err := f.limiter.allow(requestIP(r), ""); err != nil {
+		responseTestAccountError(w, rateLimitStatus(w, err), err)
+		return
+	}
+
+	// the account is created in a throwaway keyring, only the caller knows its mnemonic.
+	registry, err := cosmosaccount.NewInMemory()
+	if err != nil {
+		responseTestAccountError(w, http.StatusInternalServerError, err)
+		return
+	}
+	account, mnemonic, err := registry.Create(testAccountName)
+	if err != nil {
+		responseTestAccountError(w, http.StatusInternalServerError, err)
+		return
+	}
+	address := account.Address(f.addressPrefix)
+
+	if _, err := f.enqueueTransfer(r.Context(), address, coins); err != nil {
+		if err == context.Canceled {
+			return
+		}
+		responseTestAccountError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, TestAccountResponse{
+		Address:  address,
+		Mnemonic: mnemonic,
+	})
+}
+
+func responseTestAccountError(w http.ResponseWriter, code int, err error) {
+	xhttp.ResponseJSON(w, code, TestAccountResponse{
+		Error: err.Error(),
+	})
+}
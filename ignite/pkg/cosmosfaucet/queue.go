@@ -0,0 +1,112 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/correlation"
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+)
+
+// defaultTransferWorkers is the default number of transfers processed concurrently.
+const defaultTransferWorkers = 10
+
+// transferJob is the payload of the jobs transferring the coins of the requests.
+type transferJob struct {
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+
+	// RequestID is the correlation ID of the request of the transfer.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// TransferRetry retries the transfers failing with an error of the node up to maxAttempts times in
+// total, the wait between two attempts starts at initialBackoff and doubles after each attempt.
+// The transfers failing all their attempts are moved to the dead letters of the transfer queue.
+// By default, a transfer is attempted up to 5 times starting with a 1s wait.
+func TransferRetry(maxAttempts int, initialBackoff time.Duration) Option {
+	return func(f *Faucet) {
+		f.queueOptions = append(f.queueOptions, jobqueue.WithRetry(maxAttempts, initialBackoff))
+	}
+}
+
+// TransferQueueStore persists the transfer queue in store, so the pending transfers and the dead
+// letters survive restarts. The store must not be shared by faucets running at the same time.
+// By default, the queue is kept in memory.
+func TransferQueueStore(store jobqueue.Store) Option {
+	return func(f *Faucet) {
+		f.queueOptions = append(f.queueOptions, jobqueue.WithStore(store))
+	}
+}
+
+// newTransferQueue creates the queue of the transfers, the claims of a batch are transferred
+// concurrently so they are sent together.
+func (f Faucet) newTransferQueue() (*jobqueue.Queue, error) {
+	workers := defaultTransferWorkers
+	if f.batchWindow > 0 {
+		workers = f.batchSize
+		if workers <= 0 {
+			workers = DefaultBatchSize
+		}
+	}
+
+	options := append([]jobqueue.Option{jobqueue.WithWorkers(workers)}, f.queueOptions...)
+	return jobqueue.New(f.handleTransferJob, options...)
+}
+
+// enqueueTransfer transfers the coins to toAccountAddress through the transfer queue and returns
+// the hash of the tx. The transfer goes on when ctx is canceled, e.g. when the client disconnects.
+func (f Faucet) enqueueTransfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
+	outcome, err := f.queue.Enqueue(ctx, "", transferJob{
+		Address:   toAccountAddress,
+		Coins:     coins.String(),
+		RequestID: correlation.ID(ctx),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	select {
+	case o := <-outcome:
+		return o.Job.Result, o.Err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// handleTransferJob transfers the coins of a job, the requests exceeding the max amounts fail
+// without being retried.
+func (f Faucet) handleTransferJob(ctx context.Context, job *jobqueue.Job) error {
+	var t transferJob
+	if err := job.Decode(&t); err != nil {
+		return jobqueue.Permanent(err)
+	}
+	coins, err := sdk.ParseCoinsNormalized(t.Coins)
+	if err != nil {
+		return jobqueue.Permanent(err)
+	}
+
+	// the job can be run after the request is done, e.g. on retries or by the next run of the faucet.
+	if t.RequestID != "" {
+		ctx = correlation.WithID(ctx, t.RequestID)
+	}
+	logger := correlation.Logger(ctx, f.logger).With("address", t.Address, "coins", t.Coins, "attempt", job.Attempts)
+
+	txHash, err := f.transfer(ctx, t.Address, coins)
+	var maxErr maxAmountError
+	if errors.As(err, &maxErr) {
+		logger.Info("transfer rejected", "err", err)
+		return jobqueue.Permanent(err)
+	}
+	if err != nil {
+		logger.Error("transfer failed", "err", err)
+		return err
+	}
+
+	logger.Info("transferred", "tx_hash", txHash)
+	job.Result = txHash
+	return nil
+}
@@ -0,0 +1,80 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/correlation"
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+)
+
+func TestAdminQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := make(chan struct{}, 10)
+	queue, err := jobqueue.New(func(ctx context.Context, job *jobqueue.Job) error {
+		attempts <- struct{}{}
+		return errors.New("node unavailable")
+	}, jobqueue.WithRetry(2, time.Millisecond))
+	require.NoError(t, err)
+	go queue.Run(ctx)
+
+	f := Faucet{limiter: newRateLimiter(), queue: queue}
+	AdminToken("secret")(&f)
+
+	// the transfer fails all its attempts and is moved to the dead letters.
+	_, err = f.enqueueTransfer(correlation.WithID(ctx, "cli-42"), "cosmos1a", f.coins)
+	require.EqualError(t, err, "node unavailable")
+
+	r := httptest.NewRequest(http.MethodGet, "/admin/queue", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	r.Header.Set(correlation.Header, "cli-43")
+	w := httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "cli-43", w.Header().Get(correlation.Header))
+
+	var res QueueResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, 1, res.Status.Dead)
+	require.Len(t, res.DeadLetters, 1)
+	require.Equal(t, 2, res.DeadLetters[0].Attempts)
+	require.Equal(t, "node unavailable", res.DeadLetters[0].LastError)
+
+	var job transferJob
+	require.NoError(t, res.DeadLetters[0].Decode(&job))
+	require.Equal(t, "cosmos1a", job.Address)
+	require.Equal(t, "cli-42", job.RequestID)
+
+	// the requeued transfer is attempted again.
+	r = httptest.NewRequest(http.MethodPost, "/admin/queue/"+res.DeadLetters[0].ID, nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Eventually(t, func() bool { return len(attempts) == 4 }, time.Second, time.Millisecond)
+
+	r = httptest.NewRequest(http.MethodPost, "/admin/queue/unknown", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	// the info of the faucet shows the state of the queue.
+	r = httptest.NewRequest(http.MethodGet, "/info", nil)
+	w = httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+
+	var info FaucetInfoResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&info))
+	require.NotNil(t, info.Queue)
+	require.Equal(t, uint64(2), info.Queue.Retries)
+}
@@ -0,0 +1,286 @@
+package cosmosfaucet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// limitKindAddress prefixes the keys of the limits of the receiver addresses.
+	limitKindAddress = "address"
+
+	// limitKindIP prefixes the keys of the limits of the IPs of the requests.
+	limitKindIP = "ip"
+)
+
+// Limit is the usage of a rate limit by an address or an IP in the current window.
+type Limit struct {
+	// Key identifies the limited address or IP, e.g. address:cosmos1... or ip:203.0.113.7.
+	Key string `json:"key"`
+
+	// Requests is the number of requests in the window.
+	Requests int `json:"requests"`
+
+	// Max is the maximum number of requests in the window.
+	Max int `json:"max"`
+
+	// WindowStart is the time of the first request of the window.
+	WindowStart time.Time `json:"window_start"`
+
+	// ResetAt is the time the window ends and the requests are allowed again.
+	ResetAt time.Time `json:"reset_at"`
+}
+
+// LimitStore persists the limits of the faucet, so they survive restarts.
+type LimitStore interface {
+	// LoadLimits returns the saved limits.
+	LoadLimits() ([]Limit, error)
+
+	// SaveLimits replaces the saved limits.
+	SaveLimits(limits []Limit) error
+}
+
+// FileLimitStore is a limit store saving the limits in a JSON file.
+type FileLimitStore struct {
+	path string
+}
+
+// NewFileLimitStore creates a new limit store saving the limits in the file at path, the file and
+// its directory are created on the first save.
+func NewFileLimitStore(path string) FileLimitStore {
+	return FileLimitStore{path: path}
+}
+
+// LoadLimits returns the limits saved in the file, none when the file doesn't exist.
+func (s FileLimitStore) LoadLimits() ([]Limit, error) {
+	b, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var limits []Limit
+	if err := json.Unmarshal(b, &limits); err != nil {
+		return nil, fmt.Errorf("invalid faucet limits %s: %w", s.path, err)
+	}
+	return limits, nil
+}
+
+// SaveLimits saves the limits in the file, the file is replaced atomically so an interrupted save
+// keeps the previous limits.
+func (s FileLimitStore) SaveLimits(limits []Limit) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// RateLimit limits the requests of the faucet to maxPerAddress requests per receiver address and
+// maxPerIP requests per IP in each window, a zero maximum disables the limit. The window of an
+// address or an IP starts with its first request.
+// The IP of a request is its remote address, put the faucet behind a proxy forwarding the IPs of
+// the clients only if the proxy is the one limiting the requests.
+func RateLimit(window time.Duration, maxPerAddress, maxPerIP int) Option {
+	return func(f *Faucet) {
+		f.limiter.window = window
+		f.limiter.maxPerAddress = maxPerAddress
+		f.limiter.maxPerIP = maxPerIP
+	}
+}
+
+// RateLimitStore persists the limits of the faucet in store, so they survive restarts.
+// By default, the limits are kept in memory.
+func RateLimitStore(store LimitStore) Option {
+	return func(f *Faucet) {
+		f.limiter.store = store
+	}
+}
+
+// RateLimitError is returned when a request exceeds a rate limit of the faucet.
+type RateLimitError struct {
+	Limit Limit
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("too many requests for %s, %d requests allowed until %s",
+		e.Limit.Key, e.Limit.Max, e.Limit.ResetAt.Format(time.RFC3339))
+}
+
+// rateLimiter counts the requests of the addresses and the IPs in fixed windows.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	window        time.Duration
+	maxPerAddress int
+	maxPerIP      int
+
+	limits map[string]Limit
+	store  LimitStore
+	now    func() time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		limits: make(map[string]Limit),
+		now:    time.Now,
+	}
+}
+
+// enabled returns true when the requests are limited.
+func (l *rateLimiter) enabled() bool {
+	return l.window > 0 && (l.maxPerAddress > 0 || l.maxPerIP > 0)
+}
+
+// load loads the limits saved in the store.
+func (l *rateLimiter) load() error {
+	if l.store == nil {
+		return nil
+	}
+
+	limits, err := l.store.LoadLimits()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, limit := range limits {
+		l.limits[limit.Key] = limit
+	}
+	return nil
+}
+
+// allow counts a request of the IP for the address, the address is empty when the request creates
+// a test account. The request isn't counted when it exceeds a limit.
+func (l *rateLimiter) allow(ip, address string) error {
+	if !l.enabled() {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	var keys []string
+	if l.maxPerIP > 0 && ip != "" {
+		keys = append(keys, limitKey(limitKindIP, ip))
+	}
+	if l.maxPerAddress > 0 && address != "" {
+		keys = append(keys, limitKey(limitKindAddress, address))
+	}
+
+	for _, key := range keys {
+		if limit, ok := l.limits[key]; ok && limit.Requests >= limit.Max {
+			return &RateLimitError{Limit: limit}
+		}
+	}
+
+	for _, key := range keys {
+		limit, ok := l.limits[key]
+		if !ok {
+			limit = Limit{
+				Key:         key,
+				Max:         l.max(key),
+				WindowStart: now,
+				ResetAt:     now.Add(l.window),
+			}
+		}
+		limit.Requests++
+		l.limits[key] = limit
+	}
+
+	return l.save()
+}
+
+// list returns the limits of the current windows sorted by key.
+func (l *rateLimiter) list() []Limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(l.now())
+
+	limits := make([]Limit, 0, len(l.limits))
+	for _, limit := range l.limits {
+		limits = append(limits, limit)
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Key < limits[j].Key })
+	return limits
+}
+
+// reset resets the limit of key, all the limits when key is empty.
+func (l *rateLimiter) reset(key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if key == "" {
+		l.limits = make(map[string]Limit)
+	} else {
+		delete(l.limits, key)
+	}
+	return l.save()
+}
+
+// prune removes the limits of the ended windows.
+func (l *rateLimiter) prune(now time.Time) {
+	for key, limit := range l.limits {
+		if !now.Before(limit.ResetAt) {
+			delete(l.limits, key)
+		}
+	}
+}
+
+// save saves the limits in the store, the limiter must be locked.
+func (l *rateLimiter) save() error {
+	if l.store == nil {
+		return nil
+	}
+
+	limits := make([]Limit, 0, len(l.limits))
+	for _, limit := range l.limits {
+		limits = append(limits, limit)
+	}
+	return l.store.SaveLimits(limits)
+}
+
+func (l *rateLimiter) max(key string) int {
+	if strings.HasPrefix(key, limitKindIP+":") {
+		return l.maxPerIP
+	}
+	return l.maxPerAddress
+}
+
+func limitKey(kind, value string) string {
+	return kind + ":" + value
+}
+
+// requestIP returns the IP of the remote address of the request.
+func requestIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,117 @@
+package cosmosfaucet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter()
+	l.now = func() time.Time { return now }
+	RateLimit(time.Hour, 2, 3)(&Faucet{limiter: l})
+
+	require.NoError(t, l.allow("10.0.0.1", "cosmos1a"))
+	require.NoError(t, l.allow("10.0.0.1", "cosmos1a"))
+
+	// the address exceeds its limit.
+	var limitErr *RateLimitError
+	require.ErrorAs(t, l.allow("10.0.0.2", "cosmos1a"), &limitErr)
+	require.Equal(t, "address:cosmos1a", limitErr.Limit.Key)
+	require.Equal(t, now.Add(time.Hour), limitErr.Limit.ResetAt)
+
+	// the IP exceeds its limit, the rejected request isn't counted.
+	require.NoError(t, l.allow("10.0.0.1", "cosmos1b"))
+	require.ErrorAs(t, l.allow("10.0.0.1", "cosmos1c"), &limitErr)
+	require.Equal(t, "ip:10.0.0.1", limitErr.Limit.Key)
+	require.Len(t, l.list(), 3)
+
+	// the limits are refreshed with the window.
+	now = now.Add(time.Hour)
+	require.Empty(t, l.list())
+	require.NoError(t, l.allow("10.0.0.1", "cosmos1a"))
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	l := newRateLimiter()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.allow("10.0.0.1", "cosmos1a"))
+	}
+	require.Empty(t, l.list())
+}
+
+func TestFileLimitStore(t *testing.T) {
+	store := NewFileLimitStore(filepath.Join(t.TempDir(), "faucet", "limits.json"))
+
+	limits, err := store.LoadLimits()
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	l := newRateLimiter()
+	f := &Faucet{limiter: l}
+	RateLimit(time.Hour, 1, 0)(f)
+	RateLimitStore(store)(f)
+	require.NoError(t, l.allow("10.0.0.1", "cosmos1a"))
+
+	// the limits survive a restart.
+	restored := newRateLimiter()
+	f = &Faucet{limiter: restored}
+	RateLimit(time.Hour, 1, 0)(f)
+	RateLimitStore(store)(f)
+	require.NoError(t, restored.load())
+	require.Equal(t, l.list()[0].Key, restored.list()[0].Key)
+	require.Error(t, restored.allow("10.0.0.1", "cosmos1a"))
+}
+
+func TestServeHTTPRateLimit(t *testing.T) {
+	f := Faucet{limiter: newRateLimiter(), coinsMax: make(map[string]uint64)}
+	RateLimit(time.Hour, 0, 1)(&f)
+	require.NoError(t, f.limiter.allow("192.0.2.1", ""))
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"address":"cosmos1a"}`))
+	w := httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "3600", w.Header().Get("Retry-After"))
+}
+
+func TestServeHTTPAdmin(t *testing.T) {
+	f := Faucet{limiter: newRateLimiter()}
+	RateLimit(time.Hour, 1, 1)(&f)
+	AdminToken("secret")(&f)
+	require.NoError(t, f.limiter.allow("192.0.2.1", "cosmos1a"))
+
+	request := func(method, path, token string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		f.ServeHTTP(w, r)
+		return w
+	}
+
+	require.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "/admin/limits", "").Code)
+	require.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "/admin/limits", "wrong").Code)
+
+	w := request(http.MethodGet, "/admin/limits", "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var res LimitsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Len(t, res.Limits, 2)
+	require.Equal(t, "address:cosmos1a", res.Limits[0].Key)
+
+	require.Equal(t, http.StatusOK, request(http.MethodDelete, "/admin/limits/address:cosmos1a", "secret").Code)
+	require.Len(t, f.limiter.list(), 1)
+
+	require.Equal(t, http.StatusOK, request(http.MethodDelete, "/admin/limits", "secret").Code)
+	require.Empty(t, f.limiter.list())
+}
@@ -3,7 +3,6 @@ package cosmosfaucet
 import (
 	"context"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -12,6 +11,11 @@ import (
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 )
 
+// maxAmountError is returned when a transfer exceeds the max amount of a coin sent to an account.
+type maxAmountError string
+
+func (e maxAmountError) Error() string { return string(e) }
+
 // transferMutex is a mutex used for keeping transfer requests in a queue so checking account balance and sending tokens is atomic
 var transferMutex = &sync.Mutex{}
 
@@ -53,49 +57,82 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 
 // Transfer transfer amount of tokens from the faucet account to toAccountAddress.
 func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+	_, err := f.transfer(ctx, toAccountAddress, coins)
+	return err
+}
+
+// transfer transfers the coins to toAccountAddress and returns the hash of the tx, the transfer is
+// sent in a batch when batching is enabled.
+func (f *Faucet) transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
 	transferMutex.Lock()
-	defer transferMutex.Unlock()
 
-	var coinsStr []string
+	if err := f.checkMaxAmounts(ctx, toAccountAddress, coins); err != nil {
+		transferMutex.Unlock()
+		return "", err
+	}
+
+	if f.batcher == nil {
+		defer transferMutex.Unlock()
+		return f.send(ctx, toAccountAddress, coins)
+	}
+
+	// the claim is added under the lock, so the next transfers count its coins as pending.
+	result := f.batcher.add(toAccountAddress, coins)
+	transferMutex.Unlock()
 
-	// check for each coin, the max transferred amount hasn't been reached
+	select {
+	case r := <-result:
+		return r.txHash, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// checkMaxAmounts checks for each coin, the max transferred amount hasn't been reached.
+func (f *Faucet) checkMaxAmounts(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
 	for _, c := range coins {
+		if f.coinsMax[c.Denom] == 0 {
+			continue
+		}
+
 		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
 		if err != nil {
 			return err
 		}
+		if f.batcher != nil {
+			totalSent += f.batcher.pendingAmount(toAccountAddress, c.Denom)
+		}
 
-		if f.coinsMax[c.Denom] != 0 {
-			if totalSent >= f.coinsMax[c.Denom] {
-				return fmt.Errorf(
-					"account has reached to the max. allowed amount (%d) for %q denom",
-					f.coinsMax[c.Denom],
-					c.Denom,
-				)
-			}
-
-			if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
-				return fmt.Errorf(
-					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
-					c.Denom,
-					f.coinsMax[c.Denom],
-				)
-			}
+		if totalSent >= f.coinsMax[c.Denom] {
+			return maxAmountError(fmt.Sprintf(
+				"account has reached to the max. allowed amount (%d) for %q denom",
+				f.coinsMax[c.Denom],
+				c.Denom,
+			))
 		}
 
-		coinsStr = append(coinsStr, c.String())
+		if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
+			return maxAmountError(fmt.Sprintf(
+				`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
+				c.Denom,
+				f.coinsMax[c.Denom],
+			))
+		}
 	}
+	return nil
+}
 
-	// perform transfer for all coins
+// send sends the coins to toAccountAddress in a single tx and waits for its confirmation.
+func (f Faucet) send(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
 	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
 	if err != nil {
-		return err
+		return "", err
 	}
-	txHash, err := f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, strings.Join(coinsStr, ","))
+	txHash, err := f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, coinsString(coins))
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// wait for the send tx to be confirmed
-	return f.runner.WaitTx(ctx, txHash, time.Second, 30)
+	return txHash, f.runner.WaitTx(ctx, txHash, time.Second, 30)
 }
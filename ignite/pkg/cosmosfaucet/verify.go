@@ -0,0 +1,208 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CaptchaProvider is a CAPTCHA service verifying the requests of the faucet.
+type CaptchaProvider string
+
+const (
+	// HCaptcha verifies the requests with hCaptcha.
+	HCaptcha CaptchaProvider = "hcaptcha"
+
+	// ReCaptcha verifies the requests with Google reCAPTCHA.
+	ReCaptcha CaptchaProvider = "recaptcha"
+
+	// verificationClaimToken is the name of the claim token verification in the faucet info.
+	verificationClaimToken = "claim_token"
+)
+
+// captchaVerifyURLs are the endpoints verifying the CAPTCHA responses of the providers.
+var captchaVerifyURLs = map[CaptchaProvider]string{
+	HCaptcha:  "https://hcaptcha.com/siteverify",
+	ReCaptcha: "https://www.google.com/recaptcha/api/siteverify",
+}
+
+// ErrNotVerified is returned when a request isn't verified by a CAPTCHA or a claim token.
+var ErrNotVerified = errors.New("the request isn't verified")
+
+// Verification holds the proofs that a request is made by a human or an allowed client, one proof
+// is enough when the faucet accepts both.
+type Verification struct {
+	// Captcha is the response of the CAPTCHA widget.
+	Captcha string `json:"captcha,omitempty"`
+
+	// ClaimToken is a claim token signed by the key of the faucet.
+	ClaimToken string `json:"claim_token,omitempty"`
+}
+
+// Captcha requires the requests to be verified by the CAPTCHA provider, siteKey is the public key
+// of the site given to the frontends with the faucet info and secret is used to verify the
+// CAPTCHA responses.
+func Captcha(provider CaptchaProvider, siteKey, secret string) Option {
+	return func(f *Faucet) {
+		f.captchaProvider = provider
+		f.captchaSiteKey = siteKey
+		f.captchaSecret = secret
+		f.captchaVerifyURL = captchaVerifyURLs[provider]
+		f.openAPIData.Verification = true
+	}
+}
+
+// ClaimTokens requires the requests to have a claim token signed with key, the tokens are issued
+// with NewClaimToken by a service gating the faucet, e.g. after a login.
+func ClaimTokens(key []byte) Option {
+	return func(f *Faucet) {
+		f.claimKey = key
+		f.openAPIData.Verification = true
+	}
+}
+
+// NewClaimToken creates a claim token signed with key, allowing requests for address until
+// expiresAt. The token allows requests for any address, including the test accounts, when address
+// is empty.
+//
+// The token is the base64url encoded "<address>|<expiration unix time>" payload and its base64url
+// encoded HMAC-SHA256 signature, joined by a dot.
+func NewClaimToken(key []byte, address string, expiresAt time.Time) string {
+	payload := []byte(address + "|" + strconv.FormatInt(expiresAt.Unix(), 10))
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(signClaim(key, payload))
+}
+
+// verificationEnabled returns true when the requests must be verified.
+func (f Faucet) verificationEnabled() bool {
+	return f.captchaProvider != "" || len(f.claimKey) > 0
+}
+
+// verifications returns the names of the verifications accepted by the faucet.
+func (f Faucet) verifications() []string {
+	var names []string
+	if f.captchaProvider != "" {
+		names = append(names, string(f.captchaProvider))
+	}
+	if len(f.claimKey) > 0 {
+		names = append(names, verificationClaimToken)
+	}
+	return names
+}
+
+// verify checks that the request of the IP for the address is verified, the address is empty when
+// the request creates a test account.
+func (f Faucet) verify(ctx context.Context, ip, address string, v Verification) error {
+	if !f.verificationEnabled() {
+		return nil
+	}
+
+	if v.ClaimToken != "" && len(f.claimKey) > 0 {
+		return verifyClaimToken(f.claimKey, v.ClaimToken, address, time.Now())
+	}
+	if v.Captcha != "" && f.captchaProvider != "" {
+		return f.verifyCaptcha(ctx, ip, v.Captcha)
+	}
+
+	return fmt.Errorf("%w, send one of: %s", ErrNotVerified, strings.Join(f.verifications(), ", "))
+}
+
+// captchaResponse is the response of the CAPTCHA providers.
+type captchaResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (f Faucet) verifyCaptcha(ctx context.Context, ip, response string) error {
+	form := url.Values{
+		"secret":   {f.captchaSecret},
+		"response": {response},
+		"remoteip": {ip},
+	}
+	if f.captchaSiteKey != "" {
+		form.Set("sitekey", f.captchaSiteKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.captchaVerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot verify the captcha: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot verify the captcha: %s", res.Status)
+	}
+
+	var captcha captchaResponse
+	if err := json.NewDecoder(res.Body).Decode(&captcha); err != nil {
+		return fmt.Errorf("cannot verify the captcha: %w", err)
+	}
+	if !captcha.Success {
+		return fmt.Errorf("%w, invalid captcha %s", ErrNotVerified, strings.Join(captcha.ErrorCodes, ", "))
+	}
+	return nil
+}
+
+func verifyClaimToken(key []byte, token, address string, now time.Time) error {
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return fmt.Errorf("%w, malformed claim token", ErrNotVerified)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return fmt.Errorf("%w, malformed claim token", ErrNotVerified)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil {
+		return fmt.Errorf("%w, malformed claim token", ErrNotVerified)
+	}
+	if !hmac.Equal(sig, signClaim(key, payload)) {
+		return fmt.Errorf("%w, invalid claim token signature", ErrNotVerified)
+	}
+
+	claimAddress, expiration, ok := strings.Cut(string(payload), "|")
+	if !ok {
+		return fmt.Errorf("%w, malformed claim token", ErrNotVerified)
+	}
+	expiresAt, err := strconv.ParseInt(expiration, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w, malformed claim token", ErrNotVerified)
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return fmt.Errorf("%w, the claim token is expired", ErrNotVerified)
+	}
+	if claimAddress != "" && claimAddress != address {
+		return fmt.Errorf("%w, the claim token is for another address", ErrNotVerified)
+	}
+	return nil
+}
+
+func signClaim(key, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// verifyStatus returns the status code of the error of the verification.
+func verifyStatus(err error) int {
+	if errors.Is(err, ErrNotVerified) {
+		return http.StatusForbidden
+	}
+	return http.StatusBadGateway
+}
@@ -0,0 +1,120 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyClaimToken(t *testing.T) {
+	var (
+		key       = []byte("key")
+		now       = time.Now()
+		expiresAt = now.Add(time.Hour)
+	)
+
+	tests := []struct {
+		name    string
+		token   string
+		address string
+		err     string
+	}{
+		{
+			name:    "valid token",
+			token:   NewClaimToken(key, "cosmos1a", expiresAt),
+			address: "cosmos1a",
+		},
+		{
+			name:    "token for any address",
+			token:   NewClaimToken(key, "", expiresAt),
+			address: "cosmos1a",
+		},
+		{
+			name:    "token for another address",
+			token:   NewClaimToken(key, "cosmos1b", expiresAt),
+			address: "cosmos1a",
+			err:     "the claim token is for another address",
+		},
+		{
+			name:    "expired token",
+			token:   NewClaimToken(key, "cosmos1a", now.Add(-time.Second)),
+			address: "cosmos1a",
+			err:     "the claim token is expired",
+		},
+		{
+			name:    "token signed by another key",
+			token:   NewClaimToken([]byte("other"), "cosmos1a", expiresAt),
+			address: "cosmos1a",
+			err:     "invalid claim token signature",
+		},
+		{
+			name:    "malformed token",
+			token:   "token",
+			address: "cosmos1a",
+			err:     "malformed claim token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyClaimToken(key, tt.token, tt.address, now)
+			if tt.err != "" {
+				require.ErrorIs(t, err, ErrNotVerified)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyCaptcha(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		require.Equal(t, "192.0.2.1", r.PostForm.Get("remoteip"))
+
+		res := captchaResponse{Success: r.PostForm.Get("response") == "human"}
+		if !res.Success {
+			res.ErrorCodes = []string{"invalid-input-response"}
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	defer server.Close()
+
+	f := Faucet{}
+	Captcha(HCaptcha, "site", "secret")(&f)
+	f.captchaVerifyURL = server.URL
+
+	require.NoError(t, f.verify(context.Background(), "192.0.2.1", "cosmos1a", Verification{Captcha: "human"}))
+
+	err := f.verify(context.Background(), "192.0.2.1", "cosmos1a", Verification{Captcha: "bot"})
+	require.ErrorIs(t, err, ErrNotVerified)
+	require.Contains(t, err.Error(), "invalid-input-response")
+
+	err = f.verify(context.Background(), "192.0.2.1", "cosmos1a", Verification{})
+	require.ErrorIs(t, err, ErrNotVerified)
+}
+
+func TestServeHTTPVerification(t *testing.T) {
+	f := Faucet{limiter: newRateLimiter(), chainID: "mars"}
+	ClaimTokens([]byte("key"))(&f)
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"address":"cosmos1a"}`))
+	w := httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/info", nil)
+	w = httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+
+	var info FaucetInfoResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&info))
+	require.Equal(t, []string{"claim_token"}, info.Verifications)
+}
@@ -13,18 +13,44 @@ import (
 
 // generateOptions used to configure code generation.
 type generateOptions struct {
-	includeDirs []string
-	gomodPath   string
+	includeDirs  []string
+	gomodPath    string
+	pulsar       bool
+	goForce      bool
+	addressCodec string
 
 	jsOut               func(module.Module) string
 	jsIncludeThirdParty bool
 	vuexStoreRootPath   string
 
-	specOut string
+	specOut       string
+	specV3        bool
+	specModuleOut ModulePathFunc
 
 	dartOut               func(module.Module) string
 	dartIncludeThirdParty bool
 	dartRootPath          string
+
+	componentsFramework string
+	componentsOut       func(module.Module) string
+
+	e2eFrontendPath string
+	e2eFrontendPort int
+	e2eFaucetURL    string
+
+	adminFrontendPath string
+	adminAccounts     []AdminAccount
+
+	goClientOut string
+
+	ormOut         ModulePathFunc
+	ormTemplateDir string
+
+	reactOut      ModulePathFunc
+	reactRootPath string
+
+	docsOut    string
+	docsFormat string
 }
 
 // TODO add WithInstall.
@@ -65,6 +91,53 @@ func WithDartGeneration(includeThirdPartyModules bool, out ModulePathFunc, rootP
 	}
 }
 
+// WithComponentsGeneration adds the generation of the UI components of the types stored by the app
+// modules, a create form and a paginated table for each of them. framework is either FrameworkVue or
+// FrameworkReact, out hook is called for each module to retrieve the path of its components.
+// The vue components are bound to the Vuex stores and the react ones to the JS clients, their
+// generation must be enabled as well.
+func WithComponentsGeneration(framework string, out ModulePathFunc) Option {
+	return func(o *generateOptions) {
+		o.componentsFramework = framework
+		o.componentsOut = out
+	}
+}
+
+// WithReactGeneration adds the generation of React Query hooks for the queries and the messages of
+// the modules, e.g. useQueryBalance and useTxSend. out hook is called for each module to retrieve
+// the path of its hooks, the context of the hooks and the index exporting them are generated in rootPath.
+// The hooks are bound to the JS clients, their generation must be enabled as well.
+func WithReactGeneration(out ModulePathFunc, rootPath string) Option {
+	return func(o *generateOptions) {
+		o.reactOut = out
+		o.reactRootPath = rootPath
+	}
+}
+
+// WithE2EGeneration adds the generation of a Playwright end-to-end test suite in the e2e dir of the
+// Vue app at frontendPath. The suite serves the chain and the app on frontendPort, funds a wallet
+// with the faucet at faucetURL and, for each type that can be created, submits its form and checks
+// that the created item is listed. The generation of the Vue components must be enabled as well.
+func WithE2EGeneration(frontendPath string, frontendPort int, faucetURL string) Option {
+	return func(o *generateOptions) {
+		o.e2eFrontendPath = frontendPath
+		o.e2eFrontendPort = frontendPort
+		o.e2eFaucetURL = faucetURL
+	}
+}
+
+// WithAdminGeneration adds the generation of a dev admin page in the admin dir of the Vue app at
+// frontendPath. The page displays the params of the modules, submits parameter change proposals and
+// votes on the proposals with the accounts imported in it. The private keys of accounts are written
+// to the page, leave it empty outside of development. The generation of the Vuex stores must be
+// enabled as well.
+func WithAdminGeneration(frontendPath string, accounts []AdminAccount) Option {
+	return func(o *generateOptions) {
+		o.adminFrontendPath = frontendPath
+		o.adminAccounts = accounts
+	}
+}
+
 // WithGoGeneration adds Go code generation.
 func WithGoGeneration(gomodPath string) Option {
 	return func(o *generateOptions) {
@@ -72,6 +145,54 @@ func WithGoGeneration(gomodPath string) Option {
 	}
 }
 
+// WithGoForceGeneration generates the Go code of all the proto packages, by default only the packages
+// changed since their last generation are generated.
+func WithGoForceGeneration() Option {
+	return func(o *generateOptions) {
+		o.goForce = true
+	}
+}
+
+// WithGoClientGeneration adds the generation of a typed Go client package of the chain in out,
+// wrapping cosmosclient with the queries and the messages of the app modules. The package
+// only imports the types of the modules, not the app.
+func WithGoClientGeneration(out string) Option {
+	return func(o *generateOptions) {
+		o.goClientOut = out
+	}
+}
+
+// WithORMGeneration adds the generation of a data-access layer of the types stored by the app
+// modules, a repository of each type backed by the store of the module or by memory, so the business
+// logic can be unit tested without a keeper. out hook is called for each module to retrieve the path
+// of its layer. The templates of templateDir, when not empty, replace the built-in ones with the same
+// name, i.e. store.go.tpl and type.go.tpl.
+func WithORMGeneration(out ModulePathFunc, templateDir string) Option {
+	return func(o *generateOptions) {
+		o.ormOut = out
+		o.ormTemplateDir = templateDir
+	}
+}
+
+// WithDocsGeneration adds the generation of the reference docs of the app modules in out, a page of
+// each module listing its messages, queries, events, params and keeper methods from the comments of
+// its proto files and of its keeper. format is either DocsFormatMarkdown or DocsFormatHTML.
+// The docs generated before are replaced, the other files of out are kept.
+func WithDocsGeneration(out, format string) Option {
+	return func(o *generateOptions) {
+		o.docsOut = out
+		o.docsFormat = format
+	}
+}
+
+// WithPulsarGeneration adds the Go code generation with the pulsar plugin in the api directory
+// of the app, in addition to the gogo generation enabled by WithGoGeneration.
+func WithPulsarGeneration() Option {
+	return func(o *generateOptions) {
+		o.pulsar = true
+	}
+}
+
 // WithOpenAPIGeneration adds OpenAPI spec generation.
 func WithOpenAPIGeneration(out string) Option {
 	return func(o *generateOptions) {
@@ -79,6 +200,23 @@ func WithOpenAPIGeneration(out string) Option {
 	}
 }
 
+// WithOpenAPIV3 generates the OpenAPI specs in version 3 instead of version 2. The schemas of the
+// messages of the modules are included in the specs.
+func WithOpenAPIV3() Option {
+	return func(o *generateOptions) {
+		o.specV3 = true
+	}
+}
+
+// WithOpenAPIModuleSpecs generates the OpenAPI spec of each module of the app in addition to the combined
+// spec. out returns the path of the spec of a module relative to the app, the spec isn't generated when
+// it's empty.
+func WithOpenAPIModuleSpecs(out ModulePathFunc) Option {
+	return func(o *generateOptions) {
+		o.specModuleOut = out
+	}
+}
+
 // IncludeDirs configures the third party proto dirs that used by app's proto.
 // relative to the projectPath.
 func IncludeDirs(dirs []string) Option {
@@ -87,6 +225,14 @@ func IncludeDirs(dirs []string) Option {
 	}
 }
 
+// WithAddressCodec sets the codec of the account addresses of the chain, the JS clients encode the
+// addresses of the wallets with it. The addresses are bech32 encoded when it's not set.
+func WithAddressCodec(codec string) Option {
+	return func(o *generateOptions) {
+		o.addressCodec = codec
+	}
+}
+
 // generator generates code for sdk and sdk apps.
 type generator struct {
 	ctx          context.Context
@@ -126,6 +272,20 @@ func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir
 		}
 	}
 
+	// the Go client is built on the generated Go types.
+	if g.o.goClientOut != "" {
+		if err := g.generateGoClient(); err != nil {
+			return err
+		}
+	}
+
+	// the data-access layer is built on the generated Go types.
+	if g.o.ormOut != nil {
+		if err := g.generateORM(); err != nil {
+			return err
+		}
+	}
+
 	// js generation requires Go types to be existent in the source code. because
 	// sdk.Msg implementations defined on the generated Go types.
 	// so it needs to run after Go code gen.
@@ -135,6 +295,34 @@ func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir
 		}
 	}
 
+	// components are bound to the generated JS clients.
+	if g.o.componentsOut != nil {
+		if err := g.generateComponents(); err != nil {
+			return err
+		}
+	}
+
+	// react hooks are bound to the generated JS clients.
+	if g.o.reactOut != nil {
+		if err := g.generateReact(); err != nil {
+			return err
+		}
+	}
+
+	// end-to-end tests drive the generated components.
+	if g.o.e2eFrontendPath != "" {
+		if err := g.generateE2E(); err != nil {
+			return err
+		}
+	}
+
+	// the admin page queries the params with the generated JS clients.
+	if g.o.adminFrontendPath != "" {
+		if err := g.generateAdmin(); err != nil {
+			return err
+		}
+	}
+
 	if g.o.dartOut != nil {
 		if err := g.generateDart(); err != nil {
 			return err
@@ -147,6 +335,12 @@ func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir
 		}
 	}
 
+	if g.o.docsOut != "" {
+		if err := g.generateDocs(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 
 }
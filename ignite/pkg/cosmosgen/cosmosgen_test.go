@@ -57,3 +57,37 @@ func TestVuexStoreModulePath(t *testing.T) {
 		})
 	}
 }
+
+func TestPulsarGoPackage(t *testing.T) {
+	cases := []struct {
+		name         string
+		module       string
+		protoPkgName string
+		want         string
+	}{
+		{
+			name:         "unversioned package",
+			module:       "github.com/username/mars/api",
+			protoPkgName: "username.mars.mars",
+			want:         "github.com/username/mars/api/username/mars/mars;mars",
+		},
+		{
+			name:         "versioned package",
+			module:       "github.com/username/mars/api",
+			protoPkgName: "mars.mars.v1",
+			want:         "github.com/username/mars/api/mars/mars/v1;marsv1",
+		},
+		{
+			name:         "sdk package",
+			module:       "cosmossdk.io/api",
+			protoPkgName: "cosmos.bank.v1beta1",
+			want:         "cosmossdk.io/api/cosmos/bank/v1beta1;bankv1beta1",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, pulsarGoPackage(tt.module, tt.protoPkgName))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package cosmosgen
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+// update writes the generated files to their golden files instead of comparing them, run
+// `go test ./ignite/pkg/cosmosgen -update` after changing the templates and review the diff.
+var update = flag.Bool("update", false, "update the golden files")
+
+// requireGolden compares the generated file at name in dir to its golden file, at name in the
+// testdata dir of the test.
+func requireGolden(t *testing.T, dir, name string) {
+	t.Helper()
+
+	got, err := os.ReadFile(filepath.Join(dir, name))
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", t.Name(), name+".golden")
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(golden), 0755))
+		require.NoError(t, os.WriteFile(golden, got, 0644))
+	}
+
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got), "the generated %s differs from %s", name, golden)
+}
+
+// blogModule returns the blog module of an app, storing posts created with a message and listed
+// with a query. The tests of the generators extend it with the definitions they need.
+func blogModule() module.Module {
+	return module.Module{
+		Name:         "blog",
+		GoModulePath: "github.com/owner/app",
+		Pkg: protoanalysis.Package{
+			Name: "owner.app.blog",
+			Messages: []protoanalysis.Message{
+				{
+					Name: "QueryAllPostResponse",
+					Fields: []protoanalysis.Field{
+						{Name: "Post", Type: "Post", Repeated: true},
+						{Name: "pagination", Type: "cosmos.base.query.v1beta1.PageResponse"},
+					},
+				},
+			},
+			Services: []protoanalysis.Service{
+				{
+					Name: "Query",
+					RPCFuncs: []protoanalysis.RPCFunc{
+						{Name: "PostAll", RequestType: "QueryAllPostRequest", ReturnsType: "QueryAllPostResponse"},
+					},
+				},
+			},
+		},
+		Msgs: []module.Msg{
+			{
+				Name: "MsgCreatePost",
+				Fields: []protoanalysis.Field{
+					{Name: "creator", Type: "string"},
+					{Name: "title", Type: "string"},
+					{Name: "likes", Type: "uint64"},
+					{Name: "tips", Type: "cosmos.base.v1beta1.Coin", Repeated: true},
+				},
+			},
+		},
+		HTTPQueries: []module.HTTPQuery{
+			{Name: "PostAll", FullName: "QueryPostAll", Rules: []protoanalysis.HTTPRule{{HasQuery: true}}},
+			{Name: "Post", FullName: "QueryPost", Rules: []protoanalysis.HTTPRule{{Params: []string{"id"}}}},
+		},
+		Types: []module.Type{
+			{
+				Name: "Post",
+				Fields: []protoanalysis.Field{
+					{Name: "id", Type: "uint64"},
+					{Name: "title", Type: "string"},
+					{Name: "likes", Type: "uint64"},
+					{Name: "tips", Type: "cosmos.base.v1beta1.Coin", Repeated: true},
+					{Name: "creator", Type: "string"},
+				},
+			},
+			{Name: "Comment"},
+		},
+	}
+}
+
+// adminBlogModule returns the blog module with a Params query.
+func adminBlogModule() module.Module {
+	m := blogModule()
+	m.HTTPQueries = append(m.HTTPQueries, module.HTTPQuery{
+		Name:     "Params",
+		FullName: "QueryParams",
+		Rules:    []protoanalysis.HTTPRule{{}},
+	})
+	return m
+}
+
+// openapiBlogModule returns the blog module with the messages of its Msg service.
+func openapiBlogModule() module.Module {
+	m := blogModule()
+	m.Pkg.Messages = append(m.Pkg.Messages,
+		protoanalysis.Message{
+			Name:        "MsgCreatePost",
+			Description: "MsgCreatePost creates a post.",
+			Fields: []protoanalysis.Field{
+				{Name: "creator", Type: "string"},
+				{Name: "likes", Type: "uint64", Description: "Likes of the post.", Example: "10"},
+				{Name: "tags", Type: "string", Repeated: true},
+				{Name: "post", Type: "Post"},
+			},
+		},
+		protoanalysis.Message{Name: "MsgCreatePostResponse", Fields: []protoanalysis.Field{{Name: "id", Type: "uint64"}}},
+	)
+	m.Pkg.Services = append(m.Pkg.Services, protoanalysis.Service{
+		Name:        "Msg",
+		Description: "Msg defines the messages of the blog.",
+		RPCFuncs: []protoanalysis.RPCFunc{
+			{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+		},
+	})
+	return m
+}
+
+// ormBlogModule returns the blog module storing the posts in a list and the tags in a map.
+func ormBlogModule() module.Module {
+	m := blogModule()
+	m.Pkg.GoImportName = "github.com/owner/app/x/blog/types"
+	m.Pkg.Messages = append(m.Pkg.Messages,
+		protoanalysis.Message{Name: "QueryGetPostRequest", Fields: []protoanalysis.Field{{Name: "id", Type: "uint64"}}},
+		protoanalysis.Message{Name: "QueryGetTagRequest", Fields: []protoanalysis.Field{{Name: "name", Type: "string"}, {Name: "type", Type: "int32"}}},
+		protoanalysis.Message{Name: "QueryGetParamsRequest"},
+	)
+	m.HTTPQueries = append(m.HTTPQueries,
+		module.HTTPQuery{Name: "TagAll", FullName: "QueryTagAll", Rules: []protoanalysis.HTTPRule{{HasQuery: true}}},
+		module.HTTPQuery{Name: "ParamsAll", FullName: "QueryParamsAll", Rules: []protoanalysis.HTTPRule{{HasQuery: true}}},
+	)
+	m.Types = append(m.Types, module.Type{Name: "Tag"}, module.Type{Name: "Params"})
+	return m
+}
+
+// docsBlogModule returns the blog module with its services, events and params documented.
+func docsBlogModule() module.Module {
+	m := blogModule()
+	m.Pkg.GoImportName = "github.com/owner/app/x/blog/types"
+	m.Pkg.Messages = append(m.Pkg.Messages,
+		protoanalysis.Message{Name: "MsgCreatePost", Fields: []protoanalysis.Field{{Name: "title", Type: "string", Description: "Title of the post."}}},
+		protoanalysis.Message{Name: "MsgCreatePostResponse", Fields: []protoanalysis.Field{{Name: "id", Type: "uint64"}}},
+		protoanalysis.Message{Name: "EventPostCreated", Description: "EventPostCreated is emitted when a post is created."},
+		protoanalysis.Message{Name: "Params", Description: "Params defines the parameters of the module.", Fields: []protoanalysis.Field{{Name: "max_title", Type: "uint64"}}},
+	)
+	m.Pkg.Services[0].RPCFuncs[0].HTTPRules = []protoanalysis.HTTPRule{{Endpoint: "GET /owner/app/blog/post"}}
+	m.Pkg.Services = append(m.Pkg.Services, protoanalysis.Service{
+		Name: "Msg",
+		RPCFuncs: []protoanalysis.RPCFunc{
+			{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+		},
+	})
+	return m
+}
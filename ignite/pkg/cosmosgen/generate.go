@@ -2,6 +2,7 @@ package cosmosgen
 
 import (
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
@@ -109,6 +110,17 @@ func (g *generator) setup() (err error) {
 	return nil
 }
 
+// thirdModulePaths returns the sorted source paths of the third party modules, so the code generated
+// from all of them doesn't depend on the iteration order of the modules.
+func (g *generator) thirdModulePaths() []string {
+	paths := make([]string, 0, len(g.thirdModules))
+	for path := range g.thirdModules {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
 func (g *generator) resolveInclude(path string) (paths []string, err error) {
 	paths = append(paths, filepath.Join(path, g.protoDir))
 	for _, p := range g.o.includeDirs {
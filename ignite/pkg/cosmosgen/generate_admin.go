@@ -0,0 +1,112 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+)
+
+const (
+	// adminDir is the dir of the admin page in the Vue app.
+	adminDir = "admin"
+
+	// adminAccountsFile is the file of the admin page holding the private keys of the dev accounts,
+	// it's ignored by git.
+	adminAccountsFile = "accounts.ts"
+)
+
+// AdminAccount is a dev account of the chain signing the txs of the admin page.
+type AdminAccount struct {
+	// Name of the account.
+	Name string
+
+	// PrivKey is the secp256k1 private key of the account in hex.
+	PrivKey string
+}
+
+// adminModule describes a module whose params are displayed by the admin page.
+type adminModule struct {
+	// Name of the module, it's the subspace of its params.
+	Name string
+
+	// Ident is the identifier of the JS client of the module imported by the page.
+	Ident string
+
+	// ClientPath is the import path of the JS client of the module relative to the page.
+	ClientPath string
+}
+
+func (g *generator) generateAdmin() error {
+	if g.o.jsOut == nil || g.o.vuexStoreRootPath == "" {
+		return errors.New("admin page generation requires the Vuex generation")
+	}
+
+	out := filepath.Join(g.o.adminFrontendPath, adminDir)
+
+	var modules []adminModule
+	add := func(ms []module.Module) error {
+		for _, m := range ms {
+			if !hasParamsQuery(m) {
+				continue
+			}
+			clientPath, err := relImportPath(out, g.o.jsOut(m))
+			if err != nil {
+				return err
+			}
+			modules = append(modules, adminModule{
+				Name:       m.Name,
+				Ident:      strcase.ToLowerCamel(strings.ReplaceAll(m.Pkg.Name, ".", "_")),
+				ClientPath: clientPath,
+			})
+		}
+		return nil
+	}
+
+	if err := add(g.appModules); err != nil {
+		return err
+	}
+
+	// the params of the third party modules are displayed with their JS clients.
+	if g.o.jsIncludeThirdParty {
+		for _, sourcePath := range g.thirdModulePaths() {
+			if err := add(g.thirdModules[sourcePath]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	data := struct {
+		Modules  []adminModule
+		Accounts []AdminAccount
+	}{modules, g.o.adminAccounts}
+	if err := templateAdmin.Write(out, "", data); err != nil {
+		return err
+	}
+
+	// the private keys of the dev accounts are never committed.
+	return os.WriteFile(filepath.Join(out, ".gitignore"), []byte(adminAccountsFile+"\n"), 0644)
+}
+
+// hasParamsQuery returns true when the module has a Params query without params served by the API.
+func hasParamsQuery(m module.Module) bool {
+	for _, q := range m.HTTPQueries {
+		if q.Name != "Params" {
+			continue
+		}
+		for _, rule := range q.Rules {
+			if len(rule.Params) == 0 && !rule.HasBody {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package cosmosgen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestHasParamsQuery(t *testing.T) {
+	require.False(t, hasParamsQuery(blogModule()))
+	require.True(t, hasParamsQuery(adminBlogModule()))
+
+	m := blogModule()
+	m.HTTPQueries = append(m.HTTPQueries, module.HTTPQuery{
+		Name:  "Params",
+		Rules: []protoanalysis.HTTPRule{{Params: []string{"subspace"}}},
+	})
+	require.False(t, hasParamsQuery(m))
+}
+
+func TestGenerateAdmin(t *testing.T) {
+	cases := []struct {
+		name     string
+		accounts []AdminAccount
+	}{
+		{
+			name: "imported accounts",
+		},
+		{
+			name:     "dev keys",
+			accounts: []AdminAccount{{Name: "alice", PrivKey: "0a1b"}},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			g := &generator{
+				appPath: root,
+				o: &generateOptions{
+					jsOut:             VuexStoreModulePath(filepath.Join(root, "vue", "src", "store")),
+					vuexStoreRootPath: filepath.Join(root, "vue", "src", "store"),
+					adminFrontendPath: filepath.Join(root, "vue"),
+					adminAccounts:     tt.accounts,
+				},
+				appModules: []module.Module{adminBlogModule(), blogModule()},
+			}
+			require.NoError(t, g.generateAdmin())
+
+			// the private keys of accounts.ts are ignored by git.
+			out := filepath.Join(root, "vue", "admin")
+			for _, file := range []string{"index.html", "main.ts", "Admin.vue", adminAccountsFile, ".gitignore"} {
+				requireGolden(t, out, file)
+			}
+
+			g.o.vuexStoreRootPath = ""
+			require.Error(t, g.generateAdmin())
+		})
+	}
+}
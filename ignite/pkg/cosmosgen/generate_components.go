@@ -0,0 +1,269 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+	"github.com/takuoki/gocase"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+// Frameworks of the generated UI components.
+const (
+	FrameworkVue   = "vue"
+	FrameworkReact = "react"
+)
+
+const coinType = "cosmos.base.v1beta1.Coin"
+
+// component describes the UI components of a type stored by a module.
+type component struct {
+	// Name of the stored type.
+	Name string
+
+	// Namespace of the Vuex store of the module.
+	Namespace string
+
+	// ClientPath is the import path of the JS client of the module relative to the components.
+	ClientPath string
+
+	// ListQuery is the name of the query listing the stored type.
+	ListQuery string
+
+	// ListMethod is the name of the method of the REST client listing the stored type.
+	ListMethod string
+
+	// ListField is the field of the query response holding the list.
+	ListField string
+
+	// Fields of the stored type displayed by the table.
+	Fields []componentField
+
+	// CreateMsg is the name of the message creating the stored type, empty when there is none.
+	CreateMsg string
+
+	// CreateMethod is the name of the method of the JS client creating the message.
+	CreateMethod string
+
+	// Signer is the field of the message set to the address of the wallet.
+	Signer string
+
+	// CreateFields are the fields of the message filled by the form.
+	CreateFields []componentField
+}
+
+// componentField describes the input or the column of a field.
+type componentField struct {
+	Name     string
+	Label    string
+	Kind     string
+	Repeated bool
+}
+
+func (g *generator) generateComponents() error {
+	if g.o.jsOut == nil {
+		return errors.New("components generation requires the JS client generation")
+	}
+	if g.o.componentsFramework == FrameworkVue && g.o.vuexStoreRootPath == "" {
+		return errors.New("vue components generation requires the Vuex generation")
+	}
+
+	for _, m := range g.appModules {
+		if err := g.generateModuleComponents(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateModuleComponents generates the form and the table of each type stored by the module.
+func (g *generator) generateModuleComponents(m module.Module) error {
+	out := g.o.componentsOut(m)
+
+	clientPath, err := relImportPath(out, g.o.jsOut(m))
+	if err != nil {
+		return err
+	}
+
+	components := moduleComponents(m, clientPath)
+	if len(components) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	if err := templateComponentsCommon.Write(out, "", nil); err != nil {
+		return err
+	}
+
+	for _, c := range components {
+		var err error
+		switch g.o.componentsFramework {
+		case FrameworkVue:
+			err = writeComponent(templateComponentsVue, out, c, "vue")
+		case FrameworkReact:
+			err = writeComponent(templateComponentsReact, out, c, "tsx")
+		default:
+			err = fmt.Errorf("unknown components framework %q", g.o.componentsFramework)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeComponent writes the table and, if the type can be created, the form of the component.
+func writeComponent(t templateWriter, out string, c component, ext string) error {
+	list := filepath.Join(out, fmt.Sprintf("%sList.%s", c.Name, ext))
+	if err := t.WriteFile(list, fmt.Sprintf("list.%s.tpl", ext), "", c); err != nil {
+		return err
+	}
+
+	if c.CreateMsg == "" {
+		return nil
+	}
+
+	form := filepath.Join(out, fmt.Sprintf("%sForm.%s", c.Name, ext))
+	return t.WriteFile(form, fmt.Sprintf("form.%s.tpl", ext), "", c)
+}
+
+// relImportPath returns the relative import path of path from the dir.
+func relImportPath(dir, path string) (string, error) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return "", err
+	}
+
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, ".") {
+		rel = "./" + rel
+	}
+	return rel, nil
+}
+
+// moduleComponents returns the components of the types stored by the module.
+// A type is stored when it is listed by a paginated query named after it, e.g. PostAll,
+// and it can be created when the module has a message named after it, e.g. MsgCreatePost.
+// The signer of the create message is its first field, such as in the scaffolded messages.
+func moduleComponents(m module.Module, clientPath string) (components []component) {
+	for _, t := range m.Types {
+		query, ok := findHTTPQuery(m, t.Name+"All")
+		if !ok {
+			continue
+		}
+
+		listField, ok := listResponseField(m, query, t.Name)
+		if !ok {
+			continue
+		}
+
+		c := component{
+			Name:       t.Name,
+			Namespace:  m.Pkg.Name,
+			ClientPath: clientPath,
+			ListQuery:  query.FullName,
+			ListMethod: gocase.Revert(strcase.ToLowerCamel(query.FullName)),
+			ListField:  listField,
+			Fields:     componentFields(t.Fields),
+		}
+
+		for _, msg := range m.Msgs {
+			if msg.Name != "MsgCreate"+t.Name || len(msg.Fields) == 0 || msg.Fields[0].Type != "string" {
+				continue
+			}
+			c.CreateMsg = msg.Name
+			c.CreateMethod = strcase.ToLowerCamel(msg.Name)
+			c.Signer = msg.Fields[0].Name
+			c.CreateFields = componentFields(msg.Fields[1:])
+		}
+
+		components = append(components, c)
+	}
+
+	return components
+}
+
+// findHTTPQuery finds the paginated query of the module by its name.
+func findHTTPQuery(m module.Module, name string) (module.HTTPQuery, bool) {
+	for _, q := range m.HTTPQueries {
+		if q.Name != name {
+			continue
+		}
+		for _, rule := range q.Rules {
+			if rule.HasQuery && len(rule.Params) == 0 {
+				return q, true
+			}
+		}
+	}
+	return module.HTTPQuery{}, false
+}
+
+// listResponseField returns the field of the query response holding the list of the type.
+func listResponseField(m module.Module, query module.HTTPQuery, typeName string) (string, bool) {
+	for _, s := range m.Pkg.Services {
+		for _, rpc := range s.RPCFuncs {
+			if s.Name+rpc.Name != query.FullName {
+				continue
+			}
+			res, err := m.Pkg.MessageByName(rpc.ReturnsType)
+			if err != nil {
+				return "", false
+			}
+			for _, f := range res.Fields {
+				if f.Repeated && f.Type == typeName {
+					return f.Name, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+func componentFields(fields []protoanalysis.Field) []componentField {
+	var cfs []componentField
+	for _, f := range fields {
+		label := strcase.ToDelimited(f.Name, ' ')
+		cfs = append(cfs, componentField{
+			Name:     f.Name,
+			Label:    strings.ToUpper(label[:1]) + label[1:],
+			Kind:     fieldKind(f.Type),
+			Repeated: f.Repeated,
+		})
+	}
+	return cfs
+}
+
+// fieldKind returns the kind of the input validating and parsing a field of the proto type.
+// 64-bit integers are kept as strings to match the JSON encoding of the JS client.
+func fieldKind(protoType string) string {
+	switch protoType {
+	case "string", "bytes":
+		return "string"
+	case "bool":
+		return "bool"
+	case "int32", "sint32", "sfixed32":
+		return "int"
+	case "uint32", "fixed32":
+		return "uint"
+	case "int64", "sint64", "sfixed64":
+		return "long"
+	case "uint64", "fixed64":
+		return "ulong"
+	case "double", "float":
+		return "number"
+	case coinType:
+		return "coin"
+	}
+	return "json"
+}
@@ -0,0 +1,109 @@
+package cosmosgen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+)
+
+func TestModuleComponents(t *testing.T) {
+	components := moduleComponents(blogModule(), "../module")
+
+	require.Equal(t, []component{
+		{
+			Name:       "Post",
+			Namespace:  "owner.app.blog",
+			ClientPath: "../module",
+			ListQuery:  "QueryPostAll",
+			ListMethod: "queryPostAll",
+			ListField:  "Post",
+			Fields: []componentField{
+				{Name: "id", Label: "Id", Kind: "ulong"},
+				{Name: "title", Label: "Title", Kind: "string"},
+				{Name: "likes", Label: "Likes", Kind: "ulong"},
+				{Name: "tips", Label: "Tips", Kind: "coin", Repeated: true},
+				{Name: "creator", Label: "Creator", Kind: "string"},
+			},
+			CreateMsg:    "MsgCreatePost",
+			CreateMethod: "msgCreatePost",
+			Signer:       "creator",
+			CreateFields: []componentField{
+				{Name: "title", Label: "Title", Kind: "string"},
+				{Name: "likes", Label: "Likes", Kind: "ulong"},
+				{Name: "tips", Label: "Tips", Kind: "coin", Repeated: true},
+			},
+		},
+	}, components)
+}
+
+func TestGenerateModuleComponents(t *testing.T) {
+	cases := []struct {
+		framework string
+		files     []string
+	}{
+		{
+			framework: FrameworkVue,
+			files:     []string{"fields.ts", "PostList.vue", "PostForm.vue"},
+		},
+		{
+			framework: FrameworkReact,
+			files:     []string{"fields.ts", "PostList.tsx", "PostForm.tsx"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.framework, func(t *testing.T) {
+			root := t.TempDir()
+			g := &generator{
+				o: &generateOptions{
+					jsOut:               VuexStoreModulePath(filepath.Join(root, "store")),
+					vuexStoreRootPath:   filepath.Join(root, "store"),
+					componentsFramework: tt.framework,
+					componentsOut: func(module.Module) string {
+						return filepath.Join(root, "components", "blog")
+					},
+				},
+				appModules: []module.Module{blogModule()},
+			}
+
+			require.NoError(t, g.generateComponents())
+
+			for _, file := range tt.files {
+				requireGolden(t, filepath.Join(root, "components", "blog"), file)
+			}
+		})
+	}
+}
+
+func TestGenerateE2E(t *testing.T) {
+	root := t.TempDir()
+	g := &generator{
+		appPath: root,
+		o: &generateOptions{
+			componentsFramework: FrameworkVue,
+			componentsOut: func(module.Module) string {
+				return filepath.Join(root, "vue", "src", "components", "generated", "blog")
+			},
+			e2eFrontendPath: filepath.Join(root, "vue"),
+			e2eFrontendPort: 3001,
+			e2eFaucetURL:    "http://localhost:4500/",
+		},
+		appModules: []module.Module{blogModule()},
+	}
+
+	require.NoError(t, g.generateE2E())
+
+	out := filepath.Join(root, "vue", "e2e")
+	for _, file := range []string{
+		"package.json",
+		"playwright.config.ts",
+		"fixtures.ts",
+		filepath.Join("harness", "Harness.vue"),
+		"owner.app.blog.post.spec.ts",
+	} {
+		requireGolden(t, out, file)
+	}
+}
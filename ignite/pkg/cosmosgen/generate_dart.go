@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/mattn/go-zglob"
 	"github.com/pkg/errors"
@@ -60,8 +61,8 @@ func (g *dartGenerator) generateModules() error {
 	add(g.g.appPath, g.g.appModules)
 
 	if g.g.o.dartIncludeThirdParty {
-		for sourcePath, modules := range g.g.thirdModules {
-			add(sourcePath, modules)
+		for _, sourcePath := range g.g.thirdModulePaths() {
+			add(sourcePath, g.g.thirdModules[sourcePath])
 		}
 	}
 
@@ -106,6 +107,7 @@ func (g *dartGenerator) generateModule(ctx context.Context, plugin, appPath stri
 	if err != nil {
 		return err
 	}
+	sort.Strings(generatedFiles)
 
 	var exportContent bytes.Buffer
 	for _, file := range generatedFiles {
@@ -113,7 +115,7 @@ func (g *dartGenerator) generateModule(ctx context.Context, plugin, appPath stri
 		if err != nil {
 			return err
 		}
-		exportContent.WriteString(fmt.Sprintf("export '%s';\n", path))
+		exportContent.WriteString(fmt.Sprintf("export '%s';\n", filepath.ToSlash(path)))
 	}
 
 	err = os.WriteFile(exportOut, exportContent.Bytes(), 0644)
@@ -0,0 +1,385 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+// Formats of the generated module docs.
+const (
+	DocsFormatMarkdown = "markdown"
+	DocsFormatHTML     = "html"
+)
+
+// generatedDocsHeader is the header of the generated docs, only these files are removed when the
+// docs are generated again.
+const generatedDocsHeader = "<!-- Code generated by Ignite CLI. DO NOT EDIT. -->"
+
+// docsFileExts are the extensions of the generated docs by format.
+var docsFileExts = map[string]string{
+	DocsFormatMarkdown: ".md",
+	DocsFormatHTML:     ".html",
+}
+
+// docsIndexFiles are the files of the index of the generated docs by format.
+var docsIndexFiles = map[string]string{
+	DocsFormatMarkdown: "README.md",
+	DocsFormatHTML:     "index.html",
+}
+
+// docsModule describes the reference documentation of a module.
+type docsModule struct {
+	// Name of the module.
+	Name string
+
+	// Package is the proto package of the module.
+	Package string
+
+	// Messages are the RPCs of the Msg service of the module.
+	Messages []docsRPC
+
+	// Queries are the RPCs of the Query service of the module.
+	Queries []docsRPC
+
+	// Events are the typed events of the module, the proto messages prefixed by Event.
+	Events []docsMessage
+
+	// EventTypes are the EventType constants of the Go types of the module.
+	EventTypes []docsConst
+
+	// Attributes are the AttributeKey constants of the Go types of the module.
+	Attributes []docsConst
+
+	// Params are the params of the module, nil when it has none.
+	Params *docsMessage
+
+	// Keeper are the exported methods of the keeper of the module, the query handlers excluded.
+	Keeper []docsFunc
+}
+
+// docsRPC describes a message or a query of a module.
+type docsRPC struct {
+	Name        string
+	Description string
+
+	// Endpoints are the HTTP endpoints of a query, e.g. GET /blog/posts/{id}.
+	Endpoints []string
+
+	Request  docsMessage
+	Response docsMessage
+}
+
+// docsMessage describes a proto message.
+type docsMessage struct {
+	Name        string
+	Description string
+	Fields      []protoanalysis.Field
+}
+
+// docsConst describes a string constant of the Go code of a module.
+type docsConst struct {
+	Name        string
+	Value       string
+	Description string
+}
+
+// docsFunc describes a method of the keeper of a module.
+type docsFunc struct {
+	Name        string
+	Signature   string
+	Description string
+}
+
+// goDocs holds the doc comments of the Go code of a module.
+type goDocs struct {
+	// keeper are the exported methods of Keeper.
+	keeper []docsFunc
+
+	// msgServer are the doc comments of the methods of msgServer by name.
+	msgServer map[string]string
+
+	eventTypes, attributes []docsConst
+}
+
+func (g *generator) generateDocs() error {
+	ext, ok := docsFileExts[g.o.docsFormat]
+	if !ok {
+		return fmt.Errorf("unknown docs format %q, use %s or %s", g.o.docsFormat, DocsFormatMarkdown, DocsFormatHTML)
+	}
+
+	out := g.o.docsOut
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+	if err := removeGeneratedDocs(out); err != nil {
+		return err
+	}
+
+	var modules []docsModule
+	for _, m := range g.appModules {
+		docs, err := parseGoDocs(filepath.Join(g.appPath, strings.TrimPrefix(m.Pkg.GoImportPath(), m.GoModulePath)))
+		if err != nil {
+			return err
+		}
+		dm := docsModuleOf(m, docs)
+
+		path := filepath.Join(out, dm.Name+ext)
+		if err := templateDocs.WriteFile(path, "module"+ext+".tpl", "", dm); err != nil {
+			return err
+		}
+		modules = append(modules, dm)
+	}
+
+	return templateDocs.WriteFile(filepath.Join(out, docsIndexFiles[g.o.docsFormat]), "index"+ext+".tpl", "", modules)
+}
+
+// docsModuleOf returns the documentation of the module from its proto package and the doc
+// comments of its Go code.
+func docsModuleOf(m module.Module, docs goDocs) docsModule {
+	dm := docsModule{
+		Name:       m.Name,
+		Package:    m.Pkg.Name,
+		EventTypes: docs.eventTypes,
+		Attributes: docs.attributes,
+	}
+
+	// the doc comments of the handlers describe the RPCs without comment.
+	queryHandlers := make(map[string]string)
+	for _, s := range m.Pkg.Services {
+		for _, rpc := range s.RPCFuncs {
+			d := docsRPC{
+				Name:        rpc.Name,
+				Description: rpc.Description,
+				Request:     docsMessageOf(m.Pkg, rpc.RequestType),
+				Response:    docsMessageOf(m.Pkg, rpc.ReturnsType),
+			}
+
+			switch s.Name {
+			case "Msg":
+				if d.Description == "" {
+					d.Description = docs.msgServer[rpc.Name]
+				}
+				dm.Messages = append(dm.Messages, d)
+			case "Query":
+				queryHandlers[rpc.Name] = ""
+				for _, rule := range rpc.HTTPRules {
+					d.Endpoints = append(d.Endpoints, rule.Endpoint)
+				}
+				if d.Description == "" {
+					d.Description = keeperDoc(docs.keeper, rpc.Name)
+				}
+				dm.Queries = append(dm.Queries, d)
+			}
+		}
+	}
+
+	for _, f := range docs.keeper {
+		if _, ok := queryHandlers[f.Name]; !ok {
+			dm.Keeper = append(dm.Keeper, f)
+		}
+	}
+
+	for _, msg := range m.Pkg.Messages {
+		switch {
+		case msg.Name == "Params":
+			params := docsMessageOf(m.Pkg, msg.Name)
+			dm.Params = &params
+		case strings.HasPrefix(msg.Name, "Event"):
+			dm.Events = append(dm.Events, docsMessageOf(m.Pkg, msg.Name))
+		}
+	}
+
+	return dm
+}
+
+// docsMessageOf returns the documentation of the message of the package, only its name is known
+// when it's defined by another package.
+func docsMessageOf(pkg protoanalysis.Package, name string) docsMessage {
+	msg, err := pkg.MessageByName(name)
+	if err != nil {
+		return docsMessage{Name: name}
+	}
+	return docsMessage{
+		Name:        msg.Name,
+		Description: msg.Description,
+		Fields:      msg.Fields,
+	}
+}
+
+func keeperDoc(funcs []docsFunc, name string) string {
+	for _, f := range funcs {
+		if f.Name == name {
+			return f.Description
+		}
+	}
+	return ""
+}
+
+// parseGoDocs parses the doc comments of the keeper and the constants of the events of the module
+// whose Go types are in typesDir, the keeper is in the sibling keeper dir.
+func parseGoDocs(typesDir string) (goDocs, error) {
+	docs := goDocs{msgServer: make(map[string]string)}
+	fset := token.NewFileSet()
+
+	keeperFiles, err := parseGoDir(fset, filepath.Join(filepath.Dir(typesDir), "keeper"))
+	if err != nil {
+		return goDocs{}, err
+	}
+	for _, f := range keeperFiles {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || !fn.Name.IsExported() {
+				continue
+			}
+
+			switch receiverName(fn.Recv.List[0].Type) {
+			case "Keeper":
+				signature, err := funcSignature(fset, fn)
+				if err != nil {
+					return goDocs{}, err
+				}
+				docs.keeper = append(docs.keeper, docsFunc{
+					Name:        fn.Name.Name,
+					Signature:   signature,
+					Description: strings.TrimSpace(fn.Doc.Text()),
+				})
+			case "msgServer":
+				docs.msgServer[fn.Name.Name] = strings.TrimSpace(fn.Doc.Text())
+			}
+		}
+	}
+	sort.Slice(docs.keeper, func(i, j int) bool { return docs.keeper[i].Name < docs.keeper[j].Name })
+
+	typesFiles, err := parseGoDir(fset, typesDir)
+	if err != nil {
+		return goDocs{}, err
+	}
+	for _, f := range typesFiles {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					c, ok := stringConst(name, vs, i)
+					if !ok {
+						continue
+					}
+					c.Description = strings.TrimSpace(vs.Doc.Text())
+					if c.Description == "" && len(gd.Specs) == 1 {
+						c.Description = strings.TrimSpace(gd.Doc.Text())
+					}
+
+					switch {
+					case strings.HasPrefix(c.Name, "EventType"):
+						docs.eventTypes = append(docs.eventTypes, c)
+					case strings.HasPrefix(c.Name, "AttributeKey"):
+						docs.attributes = append(docs.attributes, c)
+					}
+				}
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+// parseGoDir parses the Go files of the dir with their comments, the tests excluded. The files are
+// sorted by name, none are returned when the dir doesn't exist.
+func parseGoDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// receiverName returns the name of the type of a method receiver.
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// funcSignature returns the source of the signature of the func, without its doc and body.
+func funcSignature(fset *token.FileSet, fn *ast.FuncDecl) (string, error) {
+	signature := *fn
+	signature.Doc, signature.Body = nil, nil
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, &signature); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// stringConst returns the i-th constant of the spec when its value is a string literal.
+func stringConst(name *ast.Ident, vs *ast.ValueSpec, i int) (docsConst, bool) {
+	if i >= len(vs.Values) {
+		return docsConst{}, false
+	}
+	lit, ok := vs.Values[i].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return docsConst{}, false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return docsConst{}, false
+	}
+	return docsConst{Name: name.Name, Value: value}, true
+}
+
+// removeGeneratedDocs removes the docs previously generated in the dir, e.g. the docs of a removed
+// module, the files added by hand are kept.
+func removeGeneratedDocs(dir string) error {
+	for _, ext := range docsFileExts {
+		files, err := filepath.Glob(filepath.Join(dir, "*"+ext))
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			content, err := os.ReadFile(f)
+			if err != nil {
+				return err
+			}
+			if !bytes.HasPrefix(content, []byte(generatedDocsHeader)) {
+				continue
+			}
+			if err := os.Remove(f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+)
+
+const docsKeeper = `package keeper
+
+type Keeper struct{}
+
+type msgServer struct{ Keeper }
+
+// CreatePost creates a post, its id is returned.
+func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	return nil, nil
+}
+
+// PostAll lists the posts.
+func (k Keeper) PostAll(c context.Context, req *types.QueryAllPostRequest) (*types.QueryAllPostResponse, error) {
+	return nil, nil
+}
+
+// SetPost stores the post.
+func (k Keeper) SetPost(ctx sdk.Context, post types.Post) {}
+
+func (k Keeper) getPost(ctx sdk.Context, id uint64) types.Post { return types.Post{} }
+`
+
+const docsTypes = `package types
+
+const (
+	// EventTypeCreatePost is emitted when a post is created.
+	EventTypeCreatePost = "create_post"
+
+	// AttributeKeyPostID is the id of the post | created.
+	AttributeKeyPostID = "post_id"
+
+	ModuleName = "blog"
+)
+`
+
+func TestGenerateDocs(t *testing.T) {
+	appPath := t.TempDir()
+	for path, content := range map[string]string{
+		"x/blog/keeper/keeper.go": docsKeeper,
+		"x/blog/types/events.go":  docsTypes,
+	} {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	out := filepath.Join(appPath, "docs", "modules")
+	g := &generator{
+		appPath:    appPath,
+		appModules: []module.Module{docsBlogModule()},
+		o:          &generateOptions{docsOut: out, docsFormat: "pdf"},
+	}
+	require.ErrorContains(t, g.generateDocs(), `unknown docs format "pdf"`)
+
+	// the docs of a removed module are removed, the docs written by hand are kept.
+	require.NoError(t, os.MkdirAll(out, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(out, "forum.md"), []byte(generatedDocsHeader+"\n# forum\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(out, "guide.md"), []byte("# guide\n"), 0644))
+
+	g.o.docsFormat = DocsFormatMarkdown
+	require.NoError(t, g.generateDocs())
+	require.NoFileExists(t, filepath.Join(out, "forum.md"))
+	require.FileExists(t, filepath.Join(out, "guide.md"))
+
+	// the handlers describe the RPCs without comment, the query handlers and the unexported methods
+	// aren't listed in the keeper methods.
+	requireGolden(t, out, "README.md")
+	requireGolden(t, out, "blog.md")
+
+	g.o.docsFormat = DocsFormatHTML
+	require.NoError(t, g.generateDocs())
+	require.NoFileExists(t, filepath.Join(out, "blog.md"))
+	requireGolden(t, out, "index.html")
+	requireGolden(t, out, "blog.html")
+}
@@ -0,0 +1,166 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+// e2eUniqueVar is the variable of the spec holding the unique value of the created item.
+const e2eUniqueVar = "unique"
+
+// e2eSpec describes the end-to-end test of a type that can be created.
+type e2eSpec struct {
+	component
+
+	// Key identifies the components of the type in the harness, e.g. owner.app.blog/Post.
+	Key string
+
+	// Ident prefixes the identifiers of the components of the type imported by the harness.
+	Ident string
+
+	// ComponentPath is the import path of the components of the type relative to the harness.
+	ComponentPath string
+
+	// Inputs are the values filled in the form by the test.
+	Inputs []e2eInput
+
+	// Unique is true when a string field is filled with a unique value found in the list once
+	// the item is created, otherwise the test checks that the list has one more item.
+	Unique bool
+}
+
+// e2eInput is the value of an input of the form of a type.
+type e2eInput struct {
+	// ID is the id of the input.
+	ID string
+
+	// Value is the TypeScript expression of the value filled.
+	Value string
+
+	// Check is true when the input is a checkbox checked instead of filled.
+	Check bool
+}
+
+func (g *generator) generateE2E() error {
+	if g.o.componentsOut == nil || g.o.componentsFramework != FrameworkVue {
+		return errors.New("e2e tests generation requires the vue components generation")
+	}
+
+	out := filepath.Join(g.o.e2eFrontendPath, "e2e")
+	harnessOut := filepath.Join(out, "harness")
+
+	var specs []e2eSpec
+	for _, m := range g.appModules {
+		componentPath, err := filepath.Rel(harnessOut, g.o.componentsOut(m))
+		if err != nil {
+			return err
+		}
+
+		for _, c := range moduleComponents(m, "") {
+			if c.CreateMsg == "" {
+				continue
+			}
+			specs = append(specs, newE2ESpec(c, filepath.ToSlash(componentPath)))
+		}
+	}
+
+	if len(specs) == 0 {
+		return errors.New("no types can be created from the frontend, scaffold a list or a map type first")
+	}
+
+	appPath, err := filepath.Rel(out, g.appPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(harnessOut, 0766); err != nil {
+		return err
+	}
+
+	data := struct {
+		AppPath      string
+		FaucetURL    string
+		FrontendPort int
+		Specs        []e2eSpec
+	}{
+		AppPath:      filepath.ToSlash(appPath),
+		FaucetURL:    strings.TrimSuffix(g.o.e2eFaucetURL, "/"),
+		FrontendPort: g.o.e2eFrontendPort,
+		Specs:        specs,
+	}
+
+	if err := templateE2ESuite.Write(out, "", data); err != nil {
+		return err
+	}
+	if err := templateE2EHarness.Write(harnessOut, "", data); err != nil {
+		return err
+	}
+
+	for _, spec := range specs {
+		path := filepath.Join(out, fmt.Sprintf("%s.%s.spec.ts", spec.Namespace, strcase.ToKebab(spec.Name)))
+		if err := templateE2ESpec.WriteFile(path, "spec.ts.tpl", "", spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newE2ESpec returns the test of the component of a type, the form of the type is filled with
+// a value valid for the kind of each field.
+func newE2ESpec(c component, componentPath string) e2eSpec {
+	spec := e2eSpec{
+		component:     c,
+		Key:           fmt.Sprintf("%s/%s", c.Namespace, c.Name),
+		Ident:         strcase.ToCamel(strings.ReplaceAll(c.Namespace, ".", "_")) + c.Name,
+		ComponentPath: componentPath,
+	}
+
+	for _, f := range c.CreateFields {
+		input := e2eInput{ID: fmt.Sprintf("%s-%s", strcase.ToLowerCamel(c.Name), f.Name)}
+
+		switch {
+		case f.Kind == "bool" && !f.Repeated:
+			input.Check = true
+		case f.Kind == "string":
+			input.Value = e2eUniqueVar
+			spec.Unique = true
+		default:
+			input.Value = fmt.Sprintf("'%s'", e2eValue(f.Kind, f.Repeated))
+		}
+
+		spec.Inputs = append(spec.Inputs, input)
+	}
+
+	return spec
+}
+
+// e2eValue returns a valid input of a field of the kind.
+func e2eValue(kind string, repeated bool) string {
+	var item string
+	switch kind {
+	case "bool":
+		item = "true"
+	case "int", "uint", "long", "ulong":
+		item = "1"
+	case "number":
+		item = "1.5"
+	case "coin":
+		item = "10token"
+	case "json":
+		if repeated {
+			return "[]"
+		}
+		return "{}"
+	}
+
+	if repeated {
+		return item + ", " + item
+	}
+	return item
+}
@@ -1,12 +1,23 @@
 package cosmosgen
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
+	gomodmodule "golang.org/x/mod/module"
 
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/dirchange"
 	"github.com/ignite/cli/ignite/pkg/protoanalysis"
 	"github.com/ignite/cli/ignite/pkg/protoc"
 )
@@ -16,6 +27,38 @@ var (
 		"--gocosmos_out=plugins=interfacetype+grpc,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
 		"--grpc-gateway_out=logtostderr=true:.",
 	}
+
+	pulsarPlugins = []string{"go-pulsar", "go-grpc"}
+
+	// versionRegexp matches the version element of a proto package name.
+	versionRegexp = regexp.MustCompile(`^v[0-9]+`)
+)
+
+// goPackageCache is the cache of the Go code generated for a proto package of the app.
+type goPackageCache struct {
+	// Checksum of the inputs of the generation of the package.
+	Checksum []byte
+
+	// Files generated for the package, relative to the app.
+	Files []string
+
+	// FilesChecksum is the checksum of the generated files, the package is generated again when
+	// they're changed or removed.
+	FilesChecksum []byte
+}
+
+const (
+	// goCacheNamespace is the namespace of the cache of the Go code generated for the proto packages.
+	goCacheNamespace = "generate.go.packages"
+
+	// apiDir is the directory of the app where the pulsar code is generated.
+	apiDir = "api"
+
+	// sdkAPIModule is the Go module hosting the pulsar code of the Cosmos SDK proto files.
+	sdkAPIModule = "cosmossdk.io/api"
+
+	// sdkProtoPrefix is the prefix of the paths of the Cosmos SDK proto files.
+	sdkProtoPrefix = "cosmos/"
 )
 
 func (g *generator) generateGo() error {
@@ -24,15 +67,6 @@ func (g *generator) generateGo() error {
 		return err
 	}
 
-	// created a temporary dir to locate generated code under which later only some of them will be moved to the
-	// app's source code. this also prevents having leftover files in the app's source code or its parent dir -when
-	// command executed directly there- in case of an interrupt.
-	tmp, err := os.MkdirTemp("", "")
-	if err != nil {
-		return err
-	}
-	defer os.RemoveAll(tmp)
-
 	// discover proto packages in the app.
 	pp := filepath.Join(g.appPath, g.protoDir)
 	pkgs, err := protoanalysis.Parse(g.ctx, nil, pp)
@@ -40,25 +74,259 @@ func (g *generator) generateGo() error {
 		return err
 	}
 
-	// code generate for each module.
+	outs := goOuts
+
+	// code generate with the pulsar plugin in the api directory as well.
+	if g.o.pulsar {
+		pulsarOuts, err := g.pulsarOuts(pp, pkgs)
+		if err != nil {
+			return err
+		}
+		outs = append(append([]string{}, outs...), pulsarOuts...)
+	}
+
+	var includeDirs []string
+	for _, dir := range g.o.includeDirs {
+		includeDirs = append(includeDirs, filepath.Join(g.appPath, dir))
+	}
+
+	var (
+		goCache   = cache.New[goPackageCache](g.cacheStorage, goCacheNamespace)
+		generated = goGenerationChecksum(g.o.gomodPath, outs, g.deps)
+	)
+
+	// code generate for each package changed since its last generation.
 	for _, pkg := range pkgs {
-		if err := protoc.Generate(g.ctx, tmp, pkg.Path, includePaths, goOuts); err != nil {
+		checksum, err := goPackageChecksum(generated, pp, includeDirs, pkgs, pkg)
+		if err != nil {
+			return err
+		}
+
+		if !g.o.goForce {
+			changed, err := g.hasGoPackageChanged(goCache, pkg.Name, checksum)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+		}
+
+		files, err := g.generateGoPackage(pkg, includePaths, outs)
+		if err != nil {
 			return err
 		}
+
+		c := goPackageCache{Checksum: checksum, Files: files}
+		if len(files) > 0 {
+			if c.FilesChecksum, err = dirchange.ChecksumFromPaths(g.appPath, files...); err != nil {
+				return err
+			}
+		}
+		if err := goCache.Put(pkg.Name, c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasGoPackageChanged returns true when the package or the options of the generation changed since
+// the Go code of the package was generated, or when the generated files changed.
+func (g *generator) hasGoPackageChanged(goCache cache.Cache[goPackageCache], key string, checksum []byte) (bool, error) {
+	c, err := goCache.Get(key)
+	if err == cache.ErrorNotFound {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(c.Checksum, checksum) {
+		return true, nil
+	}
+	if len(c.Files) == 0 {
+		return false, nil
+	}
+
+	filesChecksum, err := dirchange.ChecksumFromPaths(g.appPath, c.Files...)
+	if errors.Is(err, dirchange.ErrNoFile) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(c.FilesChecksum, filesChecksum), nil
+}
+
+// generateGoPackage generates the Go code of the package and returns the paths of the generated
+// files relative to the app.
+func (g *generator) generateGoPackage(pkg protoanalysis.Package, includePaths, outs []string) ([]string, error) {
+	// created a temporary dir to locate generated code under which later only some of them will be moved to the
+	// app's source code. this also prevents having leftover files in the app's source code or its parent dir -when
+	// command executed directly there- in case of an interrupt.
+	tmp, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := protoc.Generate(g.ctx, tmp, pkg.Path, includePaths, outs); err != nil {
+		return nil, err
 	}
 
 	// move generated code for the app under the relative locations in its source code.
 	generatedPath := filepath.Join(tmp, g.o.gomodPath)
 
 	_, err = os.Stat(generatedPath)
-	if err == nil {
-		err = copy.Copy(generatedPath, g.appPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	err = filepath.WalkDir(generatedPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(generatedPath, path)
 		if err != nil {
-			return errors.Wrap(err, "cannot copy path")
+			return err
 		}
-	} else if !os.IsNotExist(err) {
-		return err
+		files = append(files, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	if err := copy.Copy(generatedPath, g.appPath); err != nil {
+		return nil, errors.Wrap(err, "cannot copy path")
+	}
+
+	return files, nil
+}
+
+// goGenerationChecksum returns the checksum of the options of the generation of the Go code shared
+// by the packages: the Go module of the app, the outs of the protoc plugins and the versions of the
+// dependencies of the app, they include the protoc plugins installed from the app and the modules
+// hosting the imported proto files.
+func goGenerationChecksum(gomodPath string, outs []string, deps []gomodmodule.Version) []byte {
+	h := sha256.New()
+	fmt.Fprintln(h, gomodPath)
+	for _, out := range outs {
+		fmt.Fprintln(h, out)
+	}
+	for _, dep := range deps {
+		fmt.Fprintln(h, dep.Path, dep.Version)
+	}
+	return h.Sum(nil)
+}
+
+// goPackageChecksum returns the checksum of the inputs of the generation of the Go code of the package:
+// the content of its proto files and of the proto files they import from the app and from the
+// include dirs of the app, and the checksum of the options of the generation.
+// The proto files imported from the app are followed transitively.
+func goPackageChecksum(generation []byte, protoPath string, includeDirs []string, pkgs protoanalysis.Packages, pkg protoanalysis.Package) ([]byte, error) {
+	appFiles := make(map[string]protoanalysis.File)
+	for _, f := range pkgs.Files() {
+		rel, err := filepath.Rel(protoPath, f.Path)
+		if err != nil {
+			return nil, err
+		}
+		appFiles[filepath.ToSlash(rel)] = f
+	}
+
+	var (
+		paths = make(map[string]bool)
+		visit func(f protoanalysis.File)
+	)
+	visit = func(f protoanalysis.File) {
+		if paths[f.Path] {
+			return
+		}
+		paths[f.Path] = true
+		for _, dep := range f.Dependencies {
+			if depFile, ok := appFiles[dep]; ok {
+				visit(depFile)
+				continue
+			}
+			for _, dir := range includeDirs {
+				path := filepath.Join(dir, dep)
+				if _, err := os.Stat(path); err == nil {
+					paths[path] = true
+					break
+				}
+			}
+		}
+	}
+	for _, f := range pkg.Files {
+		visit(f)
+	}
+
+	var sorted []string
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+
+	h := sha256.New()
+	h.Write(generation)
+	for _, path := range sorted {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintln(h, path, len(content))
+		h.Write(content)
+	}
+	return h.Sum(nil), nil
+}
+
+// pulsarOuts returns the protoc outs of the pulsar plugins. The Go package of each app proto file
+// is overridden to the api directory of the app, and the Cosmos SDK proto files to the SDK api module.
+func (g *generator) pulsarOuts(protoPath string, pkgs protoanalysis.Packages) ([]string, error) {
+	mappings := make(map[string]string)
+	for _, pkg := range pkgs {
+		goPackage := pulsarGoPackage(path.Join(g.o.gomodPath, apiDir), pkg.Name)
+		for _, f := range pkg.Files {
+			rel, err := filepath.Rel(protoPath, f.Path)
+			if err != nil {
+				return nil, err
+			}
+			mappings[filepath.ToSlash(rel)] = goPackage
+		}
+	}
+	for _, f := range pkgs.Files() {
+		for _, dep := range f.Dependencies {
+			if _, ok := mappings[dep]; !ok && strings.HasPrefix(dep, sdkProtoPrefix) {
+				pkgName := strings.ReplaceAll(path.Dir(dep), "/", ".")
+				mappings[dep] = pulsarGoPackage(sdkAPIModule, pkgName)
+			}
+		}
+	}
+
+	var params []string
+	for file, goPackage := range mappings {
+		params = append(params, fmt.Sprintf("M%s=%s", file, goPackage))
+	}
+	sort.Strings(params)
+
+	var outs []string
+	for _, plugin := range pulsarPlugins {
+		outs = append(outs, fmt.Sprintf("--%s_out=%s:.", plugin, strings.Join(params, ",")))
+	}
+	return outs, nil
+}
+
+// pulsarGoPackage returns the Go package of the pulsar code of a proto package in the module.
+// e.g. the proto package mars.mars.v1 is generated in the Go package <module>/mars/mars/v1;marsv1.
+func pulsarGoPackage(module, protoPkgName string) string {
+	elems := strings.Split(protoPkgName, ".")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && versionRegexp.MatchString(name) {
+		name = elems[len(elems)-2] + name
+	}
+	return fmt.Sprintf("%s;%s", path.Join(append([]string{module}, elems...)...), name)
 }
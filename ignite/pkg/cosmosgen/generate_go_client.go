@@ -0,0 +1,201 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+)
+
+// generatedGoHeader is the header of the generated Go files, such as the files of the Go client,
+// only these files are removed when the code is generated again.
+const generatedGoHeader = "// Code generated by Ignite CLI. DO NOT EDIT."
+
+// goClient describes the Go client package of the chain.
+type goClient struct {
+	// Header of the generated files.
+	Header string
+
+	// Package is the name of the Go package of the client.
+	Package string
+
+	// Modules of the chain with queries or messages.
+	Modules []goClientModule
+}
+
+// goClientModule describes the client of a module.
+type goClientModule struct {
+	// Name of the client of the module in Go, e.g. Blog.
+	Name string
+
+	// TypesAlias is the import alias of the Go types of the module.
+	TypesAlias string
+
+	// TypesImport is the import path of the Go types of the module.
+	TypesImport string
+
+	// Queries of the query service of the module.
+	Queries []goClientQuery
+
+	// Msgs of the module.
+	Msgs []goClientMsg
+}
+
+// goClientQuery describes an rpc of the query service of a module.
+type goClientQuery struct {
+	Name     string
+	Request  string
+	Response string
+}
+
+// goClientMsg describes a message of a module.
+type goClientMsg struct {
+	// Name of the message without the Msg prefix, e.g. CreatePost.
+	Name string
+
+	// Type of the message, e.g. MsgCreatePost.
+	Type string
+
+	// Signer is the Go field of the message set to the address of the account, empty when there is none.
+	Signer string
+}
+
+func (g *generator) generateGoClient() error {
+	out := g.o.goClientOut
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+	if err := removeGeneratedGoFiles(out); err != nil {
+		return err
+	}
+
+	c := goClient{
+		Header:  generatedGoHeader,
+		Package: goClientPackage(out),
+	}
+	for _, m := range g.appModules {
+		if cm, ok := goClientModuleOf(m); ok {
+			c.Modules = append(c.Modules, cm)
+		}
+	}
+
+	if err := writeGoFile(templateGoClient, filepath.Join(out, "client.go"), "client.go.tpl", c); err != nil {
+		return err
+	}
+
+	for _, m := range c.Modules {
+		data := struct {
+			goClientModule
+			Header  string
+			Package string
+		}{m, c.Header, c.Package}
+
+		path := filepath.Join(out, strcase.ToSnake(m.Name)+".go")
+		if err := writeGoFile(templateGoClient, path, "module.go.tpl", data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeGoFile renders the template file of the Go code to path and formats it.
+func writeGoFile(t templateWriter, path, file string, data interface{}) error {
+	if err := t.WriteFile(path, file, "", data); err != nil {
+		return err
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("cannot format the generated Go file %s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0644)
+}
+
+// removeGeneratedGoFiles removes the Go files previously generated in the dir, such as the files
+// of the Go client, the files added by hand are kept.
+func removeGeneratedGoFiles(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		content, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		if !bytes.HasPrefix(content, []byte(generatedGoHeader)) {
+			continue
+		}
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// goClientPackage returns the name of the Go package of the client from its dir, e.g. goclient.
+func goClientPackage(dir string) string {
+	name := strings.ToLower(strcase.ToCamel(filepath.Base(dir)))
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "client" + name
+	}
+	return name
+}
+
+// goClientModuleOf returns the client of the module, it's false when the module has no queries
+// and no messages. The messages not prefixed with Msg and the queries with request or response
+// types of other proto packages are skipped. The signer of a message is its first field when it's a string, such as in the scaffolded messages.
+func goClientModuleOf(m module.Module) (goClientModule, bool) {
+	name := strcase.ToCamel(m.Name)
+	cm := goClientModule{
+		Name:        name,
+		TypesAlias:  strings.ToLower(name) + "types",
+		TypesImport: m.Pkg.GoImportPath(),
+	}
+
+	for _, s := range m.Pkg.Services {
+		if s.Name != "Query" {
+			continue
+		}
+		for _, rpc := range s.RPCFuncs {
+			if strings.Contains(rpc.RequestType, ".") || strings.Contains(rpc.ReturnsType, ".") {
+				continue
+			}
+			cm.Queries = append(cm.Queries, goClientQuery{
+				Name:     rpc.Name,
+				Request:  rpc.RequestType,
+				Response: rpc.ReturnsType,
+			})
+		}
+	}
+
+	for _, msg := range m.Msgs {
+		if !strings.HasPrefix(msg.Name, "Msg") {
+			continue
+		}
+		cmsg := goClientMsg{
+			Name: strings.TrimPrefix(msg.Name, "Msg"),
+			Type: msg.Name,
+		}
+		if len(msg.Fields) > 0 && msg.Fields[0].Type == "string" && !msg.Fields[0].Repeated {
+			cmsg.Signer = strcase.ToCamel(msg.Fields[0].Name)
+		}
+		cm.Msgs = append(cm.Msgs, cmsg)
+	}
+
+	if cm.TypesImport == "" || len(cm.Queries) == 0 && len(cm.Msgs) == 0 {
+		return goClientModule{}, false
+	}
+	return cm, true
+}
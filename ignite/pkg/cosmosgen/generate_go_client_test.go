@@ -0,0 +1,65 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestGoClientModuleOf(t *testing.T) {
+	m := blogModule()
+	m.Pkg.GoImportName = "github.com/owner/app/x/blog/types"
+	m.Pkg.Services[0].RPCFuncs = append(m.Pkg.Services[0].RPCFuncs, protoanalysis.RPCFunc{Name: "Other", RequestType: "cosmos.Request", ReturnsType: "QueryOtherResponse"})
+	m.Msgs = append(m.Msgs, module.Msg{Name: "MsgDeletePost"}, module.Msg{Name: "Transfer"})
+
+	cm, ok := goClientModuleOf(m)
+	require.True(t, ok)
+	require.Equal(t, goClientModule{
+		Name:        "Blog",
+		TypesAlias:  "blogtypes",
+		TypesImport: "github.com/owner/app/x/blog/types",
+		Queries: []goClientQuery{
+			{Name: "PostAll", Request: "QueryAllPostRequest", Response: "QueryAllPostResponse"},
+		},
+		Msgs: []goClientMsg{
+			{Name: "CreatePost", Type: "MsgCreatePost", Signer: "Creator"},
+			{Name: "DeletePost", Type: "MsgDeletePost"},
+		},
+	}, cm)
+
+	_, ok = goClientModuleOf(module.Module{Name: "empty", Pkg: m.Pkg})
+	require.True(t, ok)
+	_, ok = goClientModuleOf(module.Module{Name: "empty"})
+	require.False(t, ok)
+}
+
+func TestGenerateGoClient(t *testing.T) {
+	m := blogModule()
+	m.Pkg.GoImportName = "github.com/owner/app/x/blog/types"
+
+	out := filepath.Join(t.TempDir(), "goclient")
+	require.NoError(t, os.MkdirAll(out, 0766))
+
+	// files added by hand are kept, the generated ones are replaced.
+	custom := filepath.Join(out, "custom.go")
+	stale := filepath.Join(out, "stale.go")
+	require.NoError(t, os.WriteFile(custom, []byte("package goclient\n"), 0644))
+	require.NoError(t, os.WriteFile(stale, []byte(generatedGoHeader+"\n\npackage goclient\n"), 0644))
+
+	g := &generator{
+		appModules: []module.Module{m},
+		o:          &generateOptions{goClientOut: out},
+	}
+	require.NoError(t, g.generateGoClient())
+
+	require.FileExists(t, custom)
+	require.NoFileExists(t, stale)
+
+	requireGolden(t, out, "client.go")
+	requireGolden(t, out, "blog.go")
+}
@@ -0,0 +1,119 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomodmodule "golang.org/x/mod/module"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/dirchange"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestGoPackageChecksum(t *testing.T) {
+	var (
+		dir        = t.TempDir()
+		protoPath  = filepath.Join(dir, "proto")
+		includeDir = filepath.Join(dir, "third_party")
+		write      = func(path, content string) {
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		}
+	)
+
+	var (
+		blog    = filepath.Join(protoPath, "blog", "tx.proto")
+		post    = filepath.Join(protoPath, "blog", "post.proto")
+		comment = filepath.Join(protoPath, "comment", "comment.proto")
+		common  = filepath.Join(protoPath, "common", "common.proto")
+		extra   = filepath.Join(includeDir, "extra", "extra.proto")
+	)
+	write(blog, "blog")
+	write(post, "post")
+	write(comment, "comment")
+	write(common, "common")
+	write(extra, "extra")
+
+	pkgs := protoanalysis.Packages{
+		{
+			Name: "blog",
+			Files: protoanalysis.Files{
+				{Path: blog, Dependencies: []string{"blog/post.proto", "comment/comment.proto", "extra/extra.proto", "gogoproto/gogo.proto"}},
+				{Path: post},
+			},
+		},
+		{Name: "comment", Files: protoanalysis.Files{{Path: comment, Dependencies: []string{"common/common.proto"}}}},
+		{Name: "common", Files: protoanalysis.Files{{Path: common}}},
+	}
+
+	generation := goGenerationChecksum("github.com/owner/app", goOuts, []gomodmodule.Version{{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.45.4"}})
+	checksum := func(generation []byte, pkg protoanalysis.Package) []byte {
+		c, err := goPackageChecksum(generation, protoPath, []string{includeDir}, pkgs, pkg)
+		require.NoError(t, err)
+		return c
+	}
+
+	var (
+		blogChecksum   = checksum(generation, pkgs[0])
+		commonChecksum = checksum(generation, pkgs[2])
+	)
+	require.Equal(t, blogChecksum, checksum(generation, pkgs[0]))
+
+	// the packages importing a changed file transitively are changed, the other ones aren't.
+	write(common, "common changed")
+	require.NotEqual(t, blogChecksum, checksum(generation, pkgs[0]))
+	require.NotEqual(t, commonChecksum, checksum(generation, pkgs[2]))
+	blogChecksum = checksum(generation, pkgs[0])
+
+	write(post, "post changed")
+	require.NotEqual(t, blogChecksum, checksum(generation, pkgs[0]))
+	blogChecksum = checksum(generation, pkgs[0])
+
+	write(extra, "extra changed")
+	require.NotEqual(t, blogChecksum, checksum(generation, pkgs[0]))
+	blogChecksum = checksum(generation, pkgs[0])
+
+	// the packages are changed when the versions of the plugins change.
+	generation = goGenerationChecksum("github.com/owner/app", goOuts, []gomodmodule.Version{{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.45.5"}})
+	require.NotEqual(t, blogChecksum, checksum(generation, pkgs[0]))
+}
+
+func TestHasGoPackageChanged(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := cache.NewStorage(filepath.Join(dir, "cache.db"))
+	require.NoError(t, err)
+
+	var (
+		g       = &generator{appPath: dir}
+		goCache = cache.New[goPackageCache](storage, goCacheNamespace)
+		file    = filepath.Join("x", "blog", "types", "tx.pb.go")
+		changed = func(checksum string) bool {
+			changed, err := g.hasGoPackageChanged(goCache, "blog", []byte(checksum))
+			require.NoError(t, err)
+			return changed
+		}
+	)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "x", "blog", "types"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte("generated"), 0644))
+
+	require.True(t, changed("checksum"))
+
+	filesChecksum, err := dirchange.ChecksumFromPaths(dir, file)
+	require.NoError(t, err)
+	require.NoError(t, goCache.Put("blog", goPackageCache{
+		Checksum:      []byte("checksum"),
+		Files:         []string{file},
+		FilesChecksum: filesChecksum,
+	}))
+	require.False(t, changed("checksum"))
+	require.True(t, changed("other"))
+
+	// the package is generated again when its generated files are changed or removed.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte("edited"), 0644))
+	require.True(t, changed("checksum"))
+	require.NoError(t, os.Remove(filepath.Join(dir, file)))
+	require.True(t, changed("checksum"))
+}
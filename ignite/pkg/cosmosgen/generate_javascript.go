@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	gopath "path"
 	"path/filepath"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
@@ -26,6 +28,9 @@ var (
 		"--ts_proto_out=.",
 	}
 
+	// int64 fields are strings to match the proto3 JSON encoding of the gRPC gateway.
+	tsProtoOpt = "--ts_proto_opt=snakeToCamel=false,forceLong=string"
+
 	jsOpenAPIOut = []string{
 		"--openapiv2_out=logtostderr=true,allow_merge=true,json_names_for_fields=false,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
 	}
@@ -36,6 +41,17 @@ const (
 	dirchangeCacheNamespace = "generate.javascript.dirchange"
 )
 
+// jsClientData is the data of the templates of the JS client of a module.
+type jsClientData struct {
+	Module module.Module
+
+	// AppModule is true when the module is a module of the app, not of a dependency.
+	AppModule bool
+
+	// AddressCodec is the codec of the account addresses of the chain.
+	AddressCodec string
+}
+
 type jsGenerator struct {
 	g *generator
 }
@@ -53,6 +69,10 @@ func (g *generator) generateJS() error {
 		return err
 	}
 
+	if g.o.vuexStoreRootPath == "" {
+		return nil
+	}
+
 	return jsg.generateVuexModuleLoader()
 }
 
@@ -93,8 +113,8 @@ func (g *jsGenerator) generateModules() error {
 	add(g.g.appPath, g.g.appModules)
 
 	if g.g.o.jsIncludeThirdParty {
-		for sourcePath, modules := range g.g.thirdModules {
-			add(sourcePath, modules)
+		for _, sourcePath := range g.g.thirdModulePaths() {
+			add(sourcePath, g.g.thirdModules[sourcePath])
 		}
 	}
 
@@ -125,7 +145,7 @@ func (g *jsGenerator) generateModule(ctx context.Context, tsprotoPluginPath, app
 		m.Pkg.Path,
 		includePaths,
 		tsOut,
-		protoc.Plugin(tsprotoPluginPath, "--ts_proto_opt=snakeToCamel=false"),
+		protoc.Plugin(tsprotoPluginPath, tsProtoOpt),
 		protoc.Env("NODE_OPTIONS="), // unset nodejs options to avoid unexpected issues with vercel "pkg"
 	)
 	if err != nil {
@@ -162,7 +182,16 @@ func (g *jsGenerator) generateModule(ctx context.Context, tsprotoPluginPath, app
 
 	// generate the js client wrapper.
 	pp := filepath.Join(appPath, g.g.protoDir)
-	if err := templateJSClient.Write(out, pp, struct{ Module module.Module }{m}); err != nil {
+	// the amino names of the messages follow the convention of the modules scaffolded in the app.
+	data := jsClientData{
+		Module:       m,
+		AppModule:    appPath == g.g.appPath,
+		AddressCodec: g.g.o.addressCodec,
+	}
+	if data.AddressCodec == "" {
+		data.AddressCodec = cosmosaccount.AddressCodecBech32
+	}
+	if err := templateJSClient.Write(out, pp, data); err != nil {
 		return err
 	}
 
@@ -210,10 +239,11 @@ func (g *jsGenerator) generateVuexModuleLoader() error {
 			return err
 		}
 
+		// use the same import paths on all platforms.
 		var (
-			fullPath = filepath.Dir(pathrel)
+			fullPath = filepath.ToSlash(filepath.Dir(pathrel))
 			fullName = xstrings.FormatUsername(strcase.ToCamel(strings.ReplaceAll(fullPath, "/", "_")))
-			path     = filepath.Base(fullPath)
+			path     = gopath.Base(fullPath)
 			name     = strcase.ToCamel(path)
 		)
 		data.Modules = append(data.Modules, module{
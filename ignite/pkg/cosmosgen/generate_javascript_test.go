@@ -0,0 +1,49 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestJSClientSigning(t *testing.T) {
+	root := t.TempDir()
+	m := blogModule()
+	m.Msgs[0].URI = "owner.app.blog.MsgCreatePost"
+	m.Msgs[0].FilePath = filepath.Join(root, "proto", "blog", "tx.proto")
+	m.Msgs[0].Int64Fields = []string{"likes"}
+
+	// the composers and the signing helpers are generated for all the modules, the amino converters
+	// only for the modules of the app.
+	for _, appModule := range []bool{false, true} {
+		t.Run(fmt.Sprintf("app module %t", appModule), func(t *testing.T) {
+			out := t.TempDir()
+			data := jsClientData{Module: m, AppModule: appModule, AddressCodec: cosmosaccount.AddressCodecBech32}
+			require.NoError(t, templateJSClient.Write(out, filepath.Join(root, "proto"), data))
+
+			requireGolden(t, out, "index.ts")
+			requireGolden(t, out, "signing.ts")
+		})
+	}
+}
+
+func TestJSClientAddressCodec(t *testing.T) {
+	root := t.TempDir()
+	m := blogModule()
+	m.Msgs[0].FilePath = filepath.Join(root, "proto", "blog", "tx.proto")
+
+	// the bech32 addresses of the wallets are kept as they are or hex encoded.
+	for _, codec := range []string{cosmosaccount.AddressCodecBech32, cosmosaccount.AddressCodecHex} {
+		t.Run(codec, func(t *testing.T) {
+			out := t.TempDir()
+			data := jsClientData{Module: m, AppModule: true, AddressCodec: codec}
+			require.NoError(t, templateJSClient.Write(out, filepath.Join(root, "proto"), data))
+
+			requireGolden(t, out, "index.ts")
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cosmosgen
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -19,17 +20,24 @@ var openAPIOut = []string{
 	"--openapiv2_out=logtostderr=true,allow_merge=true,json_names_for_fields=false,fqn_for_openapi_name=true,simple_operation_ids=true,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
 }
 
-const specCacheNamespace = "generate.openapi.spec"
+const (
+	specCacheNamespace = "generate.openapi.spec"
+
+	// openAPITitle is the title of the combined spec.
+	openAPITitle = "HTTP API Console"
+)
 
 func generateOpenAPISpec(g *generator) error {
 	out := filepath.Join(g.appPath, g.o.specOut)
 
 	var (
-		specDirs []string
-		conf     = swaggercombine.Config{
+		specDirs    []string
+		specsV3     = make(map[string]map[string]interface{})
+		moduleSpecs = make(map[string]interface{}) // module specs by path.
+		conf        = swaggercombine.Config{
 			Swagger: "2.0",
 			Info: swaggercombine.Info{
-				Title: "HTTP API Console",
+				Title: openAPITitle,
 			},
 		}
 	)
@@ -97,7 +105,48 @@ func generateOpenAPISpec(g *generator) error {
 
 		specDirs = append(specDirs, dir)
 
-		return conf.AddSpec(strcase.ToCamel(m.Pkg.Name), specPath)
+		// tag the operations with the module and document them from the proto files.
+		if err := decorateSpecFile(specPath, m); err != nil {
+			return err
+		}
+		conf.AddTag(moduleTag(m), moduleDescription(m))
+
+		id := strcase.ToCamel(m.Pkg.Name)
+		var moduleSpec interface{}
+		if g.o.specV3 {
+			spec, err := os.ReadFile(specPath)
+			if err != nil {
+				return err
+			}
+			specV3, err := convertSpecV3(spec, m)
+			if err != nil {
+				return err
+			}
+			specsV3[id] = specV3
+			moduleSpec = specV3
+		} else if err := conf.AddSpec(id, specPath); err != nil {
+			return err
+		}
+
+		// the specs of the modules of the app are written as well when they're configured.
+		if src != g.appPath || g.o.specModuleOut == nil {
+			return nil
+		}
+		moduleOut := g.o.specModuleOut(m)
+		if moduleOut == "" {
+			return nil
+		}
+		if moduleSpec == nil {
+			spec, err := os.ReadFile(specPath)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(spec, &moduleSpec); err != nil {
+				return err
+			}
+		}
+		moduleSpecs[filepath.Join(g.appPath, moduleOut)] = moduleSpec
+		return nil
 	}
 
 	// generate specs for each module and persist them in the file system
@@ -119,25 +168,59 @@ func generateOpenAPISpec(g *generator) error {
 		return err
 	}
 
-	for src, modules := range g.thirdModules {
-		if err := add(src, modules); err != nil {
+	for _, src := range g.thirdModulePaths() {
+		if err := add(src, g.thirdModules[src]); err != nil {
 			return err
 		}
 	}
 
+	// the outputs are checksummed by version, the specs are generated again when the version changes.
+	var (
+		checksumKey   = out
+		checksumPaths = []string{out}
+	)
+	if g.o.specV3 {
+		checksumKey += "@v3"
+	}
+	for path := range moduleSpecs {
+		checksumPaths = append(checksumPaths, path)
+	}
+	sort.Strings(checksumPaths)
+
 	if !hasAnySpecChanged {
 		// In case the generated output has been changed
-		changed, err := dirchange.HasDirChecksumChanged(specCache, out, g.appPath, out)
+		changed, err := dirchange.HasDirChecksumChanged(specCache, checksumKey, g.appPath, checksumPaths...)
 		if err != nil {
 			return err
 		}
 
+		// the specs of the modules added to the config don't exist yet.
+		for path := range moduleSpecs {
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				changed = true
+			}
+		}
+
 		if !changed {
 			return nil
 		}
 	}
 
-	sort.Slice(conf.APIs, func(a, b int) bool { return conf.APIs[a].ID < conf.APIs[b].ID })
+	// the module specs are written before the operation ids are prefixed by the combination.
+	for path, spec := range moduleSpecs {
+		if err := writeSpec(path, spec); err != nil {
+			return err
+		}
+	}
+
+	if g.o.specV3 {
+		if err := writeSpec(out, combineSpecsV3(openAPITitle, specsV3)); err != nil {
+			return err
+		}
+		return dirchange.SaveDirChecksum(specCache, checksumKey, g.appPath, checksumPaths...)
+	}
+
+	sort.SliceStable(conf.APIs, func(a, b int) bool { return conf.APIs[a].ID < conf.APIs[b].ID })
 
 	// ensure out dir exists.
 	outDir := filepath.Dir(out)
@@ -150,5 +233,5 @@ func generateOpenAPISpec(g *generator) error {
 		return err
 	}
 
-	return dirchange.SaveDirChecksum(specCache, out, g.appPath, out)
+	return dirchange.SaveDirChecksum(specCache, checksumKey, g.appPath, checksumPaths...)
 }
@@ -0,0 +1,203 @@
+package cosmosgen
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/iancoleman/strcase"
+	"golang.org/x/mod/semver"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+)
+
+const (
+	// ormPackage is the name of the Go package of the data-access layer of a module.
+	ormPackage = "orm"
+
+	// ormGoVersion is the Go version required by the data-access layer, it uses generics.
+	ormGoVersion = "1.18"
+)
+
+// ormGoTypes are the Go types of the proto types of the index fields of the stored types.
+var ormGoTypes = map[string]string{
+	"string": "string",
+	"bool":   "bool",
+	"int32":  "int32",
+	"int64":  "int64",
+	"uint32": "uint32",
+	"uint64": "uint64",
+}
+
+// ormType describes the repository of a type stored by a module.
+type ormType struct {
+	// Name of the type in Go, e.g. Post.
+	Name string
+
+	// Var is the prefix of the unexported variables of the type, e.g. post.
+	Var string
+
+	// Prefix is the constant of the types package prefixing the keys of the type in the store.
+	Prefix string
+
+	// Indexes are the index fields of a map, none for a list whose values are stored by their id.
+	Indexes []ormIndex
+}
+
+// ormIndex describes an index field of a stored type.
+type ormIndex struct {
+	// Name of the field in Go, e.g. Index.
+	Name string
+
+	// Arg is the name of the field as a function argument, e.g. index.
+	Arg string
+
+	// Type of the field in Go, e.g. string.
+	Type string
+}
+
+// ormModule describes the data-access layer of a module.
+type ormModule struct {
+	// Header of the generated files.
+	Header string
+
+	// Package is the name of the Go package of the data-access layer.
+	Package string
+
+	// Module is the name of the module.
+	Module string
+
+	// TypesImport is the import path of the Go types of the module.
+	TypesImport string
+
+	// Types stored by the module.
+	Types []ormType
+}
+
+func (g *generator) generateORM() error {
+	if err := checkORMGoVersion(g.appPath); err != nil {
+		return err
+	}
+
+	tpl := templateORM
+	if g.o.ormTemplateDir != "" {
+		tpl = tpl.Override(g.o.ormTemplateDir)
+	}
+
+	for _, m := range g.appModules {
+		om, ok := ormModuleOf(m)
+		if !ok {
+			continue
+		}
+
+		out := g.o.ormOut(m)
+		if err := os.MkdirAll(out, 0766); err != nil {
+			return err
+		}
+		if err := removeGeneratedGoFiles(out); err != nil {
+			return err
+		}
+
+		if err := writeGoFile(tpl, filepath.Join(out, "store.go"), "store.go.tpl", om); err != nil {
+			return err
+		}
+
+		for _, t := range om.Types {
+			data := struct {
+				ormType
+				Header      string
+				Package     string
+				TypesImport string
+			}{t, om.Header, om.Package, om.TypesImport}
+
+			path := filepath.Join(out, strcase.ToSnake(t.Name)+".go")
+			if err := writeGoFile(tpl, path, "type.go.tpl", data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkORMGoVersion checks that the Go version of the app at appPath supports the generics used by
+// the data-access layer.
+func checkORMGoVersion(appPath string) error {
+	f, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return err
+	}
+	if f.Go == nil || semver.Compare("v"+f.Go.Version, "v"+ormGoVersion) < 0 {
+		return fmt.Errorf("the data-access layer uses Go generics, set `go %s` in the go.mod of the app", ormGoVersion)
+	}
+	return nil
+}
+
+// ormModuleOf returns the data-access layer of the module, it's false when the module stores no
+// types. The types are the ones scaffolded as lists or maps: they are listed by a paginated query
+// named after them, e.g. PostAll, and their get query is requested by their id or their index fields.
+func ormModuleOf(m module.Module) (ormModule, bool) {
+	om := ormModule{
+		Header:      generatedGoHeader,
+		Package:     ormPackage,
+		Module:      m.Name,
+		TypesImport: m.Pkg.GoImportPath(),
+	}
+
+	for _, t := range m.Types {
+		if _, ok := findHTTPQuery(m, t.Name+"All"); !ok {
+			continue
+		}
+		if ot, err := ormTypeOf(m, t); err == nil {
+			om.Types = append(om.Types, ot)
+		}
+	}
+
+	if om.TypesImport == "" || len(om.Types) == 0 {
+		return ormModule{}, false
+	}
+	return om, true
+}
+
+// ormTypeOf returns the repository of the stored type from the request of its get query.
+func ormTypeOf(m module.Module, t module.Type) (ormType, error) {
+	req, err := m.Pkg.MessageByName("QueryGet" + t.Name + "Request")
+	if err != nil {
+		return ormType{}, err
+	}
+
+	name := strcase.ToCamel(t.Name)
+	ot := ormType{
+		Name: name,
+		Var:  strcase.ToLowerCamel(t.Name),
+	}
+
+	switch {
+	case len(req.Fields) == 0:
+		return ormType{}, errors.New("the type is a singleton")
+	case len(req.Fields) == 1 && req.Fields[0].Name == "id" && req.Fields[0].Type == "uint64" && !req.Fields[0].Repeated:
+		ot.Prefix = name + "Key"
+		return ot, nil
+	}
+
+	ot.Prefix = name + "KeyPrefix"
+	for _, f := range req.Fields {
+		goType, ok := ormGoTypes[f.Type]
+		if !ok || f.Repeated {
+			return ormType{}, fmt.Errorf("unsupported index field %s", f.Name)
+		}
+		arg := strcase.ToLowerCamel(f.Name)
+		if token.IsKeyword(arg) || arg == "ctx" || arg == "r" || arg == "value" || arg == "types" {
+			arg += "Index"
+		}
+		ot.Indexes = append(ot.Indexes, ormIndex{
+			Name: strcase.ToCamel(f.Name),
+			Arg:  arg,
+			Type: goType,
+		})
+	}
+	return ot, nil
+}
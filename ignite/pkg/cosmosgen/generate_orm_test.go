@@ -0,0 +1,79 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestORMModuleOf(t *testing.T) {
+	om, ok := ormModuleOf(ormBlogModule())
+	require.True(t, ok)
+	require.Equal(t, ormModule{
+		Header:      generatedGoHeader,
+		Package:     "orm",
+		Module:      "blog",
+		TypesImport: "github.com/owner/app/x/blog/types",
+		Types: []ormType{
+			{Name: "Post", Var: "post", Prefix: "PostKey"},
+			{Name: "Tag", Var: "tag", Prefix: "TagKeyPrefix", Indexes: []ormIndex{
+				{Name: "Name", Arg: "name", Type: "string"},
+				{Name: "Type", Arg: "typeIndex", Type: "int32"},
+			}},
+		},
+	}, om)
+
+	// the types with unsupported index fields are skipped.
+	m := ormBlogModule()
+	m.Pkg.Messages[1].Fields = []protoanalysis.Field{{Name: "id", Type: "uint64", Repeated: true}}
+	om, ok = ormModuleOf(m)
+	require.True(t, ok)
+	require.Len(t, om.Types, 1)
+
+	_, ok = ormModuleOf(blogModule())
+	require.False(t, ok)
+}
+
+func TestGenerateORM(t *testing.T) {
+	appPath := t.TempDir()
+	out := filepath.Join(appPath, "x", "blog", "orm")
+	g := &generator{
+		appPath:    appPath,
+		appModules: []module.Module{ormBlogModule()},
+		o: &generateOptions{
+			ormOut: func(module.Module) string { return out },
+		},
+	}
+
+	// the layer uses generics.
+	gomod := filepath.Join(appPath, "go.mod")
+	require.NoError(t, os.WriteFile(gomod, []byte("module github.com/owner/app\n\ngo 1.16\n"), 0644))
+	require.ErrorContains(t, g.generateORM(), "set `go 1.18`")
+
+	require.NoError(t, os.WriteFile(gomod, []byte("module github.com/owner/app\n\ngo 1.18\n"), 0644))
+	require.NoError(t, g.generateORM())
+
+	for _, file := range []string{"store.go", "post.go", "tag.go"} {
+		requireGolden(t, out, file)
+	}
+
+	// the user-defined templates replace the built-in ones with the same name.
+	templateDir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(templateDir, "type.go.tpl"),
+		[]byte("{{ .Header }}\n\npackage {{ .Package }}\n\n// {{ .Name }} is custom.\n"),
+		0644,
+	))
+	g.o.ormTemplateDir = templateDir
+	require.NoError(t, g.generateORM())
+
+	post, err := os.ReadFile(filepath.Join(out, "post.go"))
+	require.NoError(t, err)
+	require.Equal(t, generatedGoHeader+"\n\npackage orm\n\n// Post is custom.\n", string(post))
+	require.FileExists(t, filepath.Join(out, "store.go"))
+}
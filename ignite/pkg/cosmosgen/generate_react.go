@@ -0,0 +1,203 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+	"github.com/takuoki/gocase"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+)
+
+// reactHooks describes the React Query hooks of a module.
+type reactHooks struct {
+	// Namespace prefixes the query keys of the module, it's the name of its proto package.
+	Namespace string
+
+	// ClientPath is the import path of the JS client of the module relative to the hooks.
+	ClientPath string
+
+	// RootPath is the import path of the root of the hooks relative to the hooks of the module.
+	RootPath string
+
+	// Queries of the module served by the API.
+	Queries []reactQuery
+
+	// Msgs of the module.
+	Msgs []reactMsg
+}
+
+// reactQuery describes the hook of a query, e.g. useQueryBalance.
+type reactQuery struct {
+	// Name of the query with its service name, e.g. QueryBalance.
+	Name string
+
+	// Method is the name of the method of the REST client sending the query.
+	Method string
+
+	// Params are the params of the path of the query, in order.
+	Params []string
+
+	// HasQuery is true when the query has params in the query string.
+	HasQuery bool
+}
+
+// reactMsg describes the hook of a message, e.g. useTxSend.
+type reactMsg struct {
+	// Name of the message without the Msg prefix, e.g. Send.
+	Name string
+
+	// Type of the message, e.g. MsgSend.
+	Type string
+
+	// Method is the name of the method of the JS client encoding the message.
+	Method string
+
+	// FilePath is the path of the proto file of the message.
+	FilePath string
+
+	// Signer is the field of the message set to the address of the wallet, empty when there is none.
+	Signer string
+}
+
+// reactHooksModule is a module exported by the root of the hooks.
+type reactHooksModule struct {
+	// Name of the namespace of the hooks of the module, e.g. CosmosBankV1beta1.
+	Name string
+
+	// Path of the hooks of the module relative to the root.
+	Path string
+}
+
+func (g *generator) generateReact() error {
+	if g.o.jsOut == nil {
+		return errors.New("react hooks generation requires the JS client generation")
+	}
+
+	var modules []reactHooksModule
+	add := func(appPath string, ms []module.Module) error {
+		for _, m := range ms {
+			ok, err := g.generateModuleReactHooks(appPath, m)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			path, err := filepath.Rel(g.o.reactRootPath, g.o.reactOut(m))
+			if err != nil {
+				return err
+			}
+			modules = append(modules, reactHooksModule{
+				Name: strcase.ToCamel(m.Pkg.Name),
+				Path: filepath.ToSlash(path),
+			})
+		}
+		return nil
+	}
+
+	if err := add(g.appPath, g.appModules); err != nil {
+		return err
+	}
+
+	// the hooks of the third party modules are bound to their JS clients.
+	if g.o.jsIncludeThirdParty {
+		for _, sourcePath := range g.thirdModulePaths() {
+			if err := add(sourcePath, g.thirdModules[sourcePath]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.MkdirAll(g.o.reactRootPath, 0766); err != nil {
+		return err
+	}
+
+	return templateReactRoot.Write(g.o.reactRootPath, "", struct{ Modules []reactHooksModule }{modules})
+}
+
+// generateModuleReactHooks generates the hooks of the queries and the messages of the module,
+// it's false when the module has none.
+func (g *generator) generateModuleReactHooks(appPath string, m module.Module) (bool, error) {
+	out := g.o.reactOut(m)
+
+	clientPath, err := relImportPath(out, g.o.jsOut(m))
+	if err != nil {
+		return false, err
+	}
+	rootPath, err := relImportPath(out, g.o.reactRootPath)
+	if err != nil {
+		return false, err
+	}
+
+	hooks := moduleReactHooks(m, clientPath, rootPath)
+	if len(hooks.Queries) == 0 && len(hooks.Msgs) == 0 {
+		return false, nil
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return false, err
+	}
+
+	pp := filepath.Join(appPath, g.protoDir)
+	return true, templateReactModule.Write(out, pp, hooks)
+}
+
+// moduleReactHooks returns the hooks of the module. The queries with a request body aren't
+// served by the API and are skipped. The signer of a message is its first field when it's a
+// string, such as in the scaffolded messages and in the ones of the SDK.
+func moduleReactHooks(m module.Module, clientPath, rootPath string) reactHooks {
+	hooks := reactHooks{
+		Namespace:  m.Pkg.Name,
+		ClientPath: clientPath,
+		RootPath:   rootPath,
+	}
+
+	for _, q := range m.HTTPQueries {
+		if len(q.Rules) == 0 || q.Rules[0].HasBody {
+			continue
+		}
+
+		rq := reactQuery{
+			Name:     q.FullName,
+			Method:   gocase.Revert(strcase.ToLowerCamel(q.FullName)),
+			HasQuery: q.Rules[0].HasQuery,
+		}
+		for _, p := range q.Rules[0].Params {
+			rq.Params = append(rq.Params, strcase.ToLowerCamel(strings.ReplaceAll(p, ".", "_")))
+		}
+		hooks.Queries = append(hooks.Queries, rq)
+	}
+
+	for _, msg := range m.Msgs {
+		if !strings.HasPrefix(msg.Name, "Msg") {
+			continue
+		}
+		rm := reactMsg{
+			Name:     strings.TrimPrefix(msg.Name, "Msg"),
+			Type:     msg.Name,
+			Method:   strcase.ToLowerCamel(msg.Name),
+			FilePath: msg.FilePath,
+		}
+		if len(msg.Fields) > 0 && msg.Fields[0].Type == "string" && !msg.Fields[0].Repeated {
+			rm.Signer = msg.Fields[0].Name
+		}
+		hooks.Msgs = append(hooks.Msgs, rm)
+	}
+
+	return hooks
+}
+
+// ReactHooksModulePath generates the paths of the React hooks of Cosmos SDK modules.
+// The root path is used as prefix for the generated paths.
+func ReactHooksModulePath(rootPath string) ModulePathFunc {
+	return func(m module.Module) string {
+		appModulePath := gomodulepath.ExtractAppPath(m.GoModulePath)
+		return filepath.Join(rootPath, appModulePath, m.Pkg.Name)
+	}
+}
@@ -0,0 +1,59 @@
+package cosmosgen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestModuleReactHooks(t *testing.T) {
+	m := blogModule()
+	m.HTTPQueries = append(m.HTTPQueries, module.HTTPQuery{
+		Name:     "Simulate",
+		FullName: "QuerySimulate",
+		Rules:    []protoanalysis.HTTPRule{{HasBody: true}},
+	})
+	m.Msgs = append(m.Msgs, module.Msg{Name: "Transfer"})
+
+	hooks := moduleReactHooks(m, "../module", "../../..")
+	require.Equal(t, reactHooks{
+		Namespace:  "owner.app.blog",
+		ClientPath: "../module",
+		RootPath:   "../../..",
+		Queries: []reactQuery{
+			{Name: "QueryPostAll", Method: "queryPostAll", HasQuery: true},
+			{Name: "QueryPost", Method: "queryPost", Params: []string{"id"}},
+		},
+		Msgs: []reactMsg{
+			{Name: "CreatePost", Type: "MsgCreatePost", Method: "msgCreatePost", Signer: "creator"},
+		},
+	}, hooks)
+}
+
+func TestGenerateReact(t *testing.T) {
+	root := t.TempDir()
+	m := blogModule()
+	m.Msgs[0].FilePath = filepath.Join(root, "proto", "blog", "tx.proto")
+
+	g := &generator{
+		appPath:  root,
+		protoDir: "proto",
+		o: &generateOptions{
+			jsOut:         VuexStoreModulePath(filepath.Join(root, "client")),
+			reactOut:      ReactHooksModulePath(filepath.Join(root, "hooks")),
+			reactRootPath: filepath.Join(root, "hooks"),
+		},
+		appModules: []module.Module{m, {Name: "empty", GoModulePath: "github.com/owner/app"}},
+	}
+	require.NoError(t, g.generateReact())
+
+	// the modules without queries and messages are skipped.
+	out := filepath.Join(root, "hooks")
+	for _, file := range []string{"index.ts", "context.ts", filepath.Join("owner", "app", "owner.app.blog", "index.ts")} {
+		requireGolden(t, out, file)
+	}
+}
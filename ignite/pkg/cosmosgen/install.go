@@ -37,3 +37,26 @@ func InstallDependencies(ctx context.Context, appPath string) error {
 		)
 	return errors.Wrap(err, errb.String())
 }
+
+// InstallPulsarDependencies installs the protoc plugins needed to generate the Go code with the pulsar layout.
+func InstallPulsarDependencies(ctx context.Context, appPath string) error {
+	plugins := []string{
+		// install the pulsar plugin.
+		"github.com/cosmos/cosmos-proto/cmd/protoc-gen-go-pulsar",
+
+		// install the gRPC plugin of the Go protobuf API v2.
+		"google.golang.org/grpc/cmd/protoc-gen-go-grpc",
+	}
+
+	errb := &bytes.Buffer{}
+	err := cmdrunner.
+		New(
+			cmdrunner.DefaultStderr(errb),
+			cmdrunner.DefaultWorkdir(appPath),
+		).
+		Run(ctx,
+			step.New(step.Exec("go", append([]string{"get"}, plugins...)...)),
+			step.New(step.Exec("go", append([]string{"install"}, plugins...)...)),
+		)
+	return errors.Wrap(err, errb.String())
+}
@@ -0,0 +1,164 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+)
+
+var (
+	specExampleLineRe = regexp.MustCompile(`(?m)^\s*Example:.*$`)
+	specBlankLinesRe  = regexp.MustCompile(`\n{3,}`)
+)
+
+// moduleTag returns the tag grouping the operations of the module in the OpenAPI spec.
+func moduleTag(m module.Module) string {
+	return m.Pkg.Name
+}
+
+// moduleDescription returns the description of the module tag from the comments of its services.
+func moduleDescription(m module.Module) string {
+	var descriptions []string
+	for _, s := range m.Pkg.Services {
+		if s.Description != "" {
+			descriptions = append(descriptions, s.Description)
+		}
+	}
+	if len(descriptions) == 0 {
+		return fmt.Sprintf("Queries and messages of the %s module.", m.Name)
+	}
+	return strings.Join(descriptions, " ")
+}
+
+// decorateSpecFile decorates the OpenAPI spec of the module at path.
+func decorateSpecFile(path string, m module.Module) error {
+	spec, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if spec, err = decorateSpec(spec, m); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, spec, 0644)
+}
+
+// decorateSpec tags the operations of the OpenAPI spec of the module with the module tag, so
+// they are grouped by module once the specs are combined, and documents the spec from the proto
+// files: the operations without a comment are summarized by their name and the properties of the
+// schemas get the examples of the fields.
+func decorateSpec(spec []byte, m module.Module) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+
+	// the tags of the services are replaced by the module tag, added to the combined spec.
+	delete(doc, "tags")
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	for _, path := range paths {
+		methods, _ := path.(map[string]interface{})
+		for _, method := range methods {
+			op, ok := method.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			op["tags"] = []string{moduleTag(m)}
+
+			if op["summary"] == nil && op["description"] == nil {
+				if id, ok := op["operationId"].(string); ok {
+					op["summary"] = humanize(id)
+				}
+			}
+		}
+	}
+
+	definitions, _ := doc["definitions"].(map[string]interface{})
+	for _, msg := range m.Pkg.Messages {
+		name := fmt.Sprintf("%s.%s", m.Pkg.Name, strings.ReplaceAll(msg.Name, "_", "."))
+		definition, _ := definitions[name].(map[string]interface{})
+		properties, _ := definition["properties"].(map[string]interface{})
+
+		for _, f := range msg.Fields {
+			property, ok := properties[f.Name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			removeExampleLines(property)
+
+			if f.Example != "" {
+				if example, ok := propertyExample(property, f.Example); ok {
+					property["example"] = example
+				}
+			}
+		}
+	}
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// propertyExample returns the example of the property from the JSON encoded example of the field.
+// The example of a string property, such as a 64-bit integer, is kept as a string and the example
+// of a list property is a list.
+func propertyExample(property map[string]interface{}, example string) (interface{}, bool) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(example), &value); err != nil {
+		return nil, false
+	}
+
+	switch property["type"] {
+	case "string":
+		if _, ok := value.(string); !ok {
+			return example, true
+		}
+	case "array":
+		if _, ok := value.([]interface{}); !ok {
+			return []interface{}{value}, true
+		}
+	}
+
+	return value, true
+}
+
+// removeExampleLines removes the examples of the proto comments from the docs of the property.
+func removeExampleLines(property map[string]interface{}) {
+	for _, key := range []string{"title", "description"} {
+		text, ok := property[key].(string)
+		if !ok {
+			continue
+		}
+		text = specExampleLineRe.ReplaceAllString(text, "")
+		text = strings.TrimSpace(specBlankLinesRe.ReplaceAllString(text, "\n\n"))
+		if text == "" {
+			delete(property, key)
+		} else {
+			property[key] = text
+		}
+	}
+}
+
+// humanize turns the name of an RPC func into a sentence, e.g. PostAll to Post all.
+func humanize(name string) string {
+	s := strcase.ToDelimited(name, ' ')
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
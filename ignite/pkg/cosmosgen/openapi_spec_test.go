@@ -0,0 +1,74 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+func TestDecorateSpec(t *testing.T) {
+	spec := []byte(`{
+  "tags": [{"name": "Query"}],
+  "paths": {
+    "/owner/app/blog/post": {
+      "get": {"operationId": "PostAll", "tags": ["Query"]}
+    },
+    "/owner/app/blog/post/{id}": {
+      "get": {"summary": "Queries a post by id.", "operationId": "Post", "tags": ["Query"]}
+    }
+  },
+  "definitions": {
+    "owner.app.blog.Post": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "string", "format": "uint64", "description": "The id of the post.\n\nExample: 42"},
+        "title": {"type": "string"},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    }
+  }
+}`)
+	m := module.Module{
+		Name: "blog",
+		Pkg: protoanalysis.Package{
+			Name: "owner.app.blog",
+			Messages: []protoanalysis.Message{
+				{
+					Name: "Post",
+					Fields: []protoanalysis.Field{
+						{Name: "id", Type: "uint64", Example: "42"},
+						{Name: "title", Type: "string", Example: `"Hello <world>"`},
+						{Name: "tags", Type: "string", Repeated: true, Example: `"news"`},
+					},
+				},
+			},
+		},
+	}
+
+	decorated, err := decorateSpec(spec, m)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "paths": {
+    "/owner/app/blog/post": {
+      "get": {"summary": "Post all", "operationId": "PostAll", "tags": ["owner.app.blog"]}
+    },
+    "/owner/app/blog/post/{id}": {
+      "get": {"summary": "Queries a post by id.", "operationId": "Post", "tags": ["owner.app.blog"]}
+    }
+  },
+  "definitions": {
+    "owner.app.blog.Post": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "string", "format": "uint64", "description": "The id of the post.", "example": "42"},
+        "title": {"type": "string", "example": "Hello <world>"},
+        "tags": {"type": "array", "items": {"type": "string"}, "example": ["news"]}
+      }
+    }
+  }
+}`, string(decorated))
+	require.Contains(t, string(decorated), "Hello <world>")
+}
@@ -0,0 +1,334 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+const (
+	// openAPIV3Version is the version of the OpenAPI v3 specs.
+	openAPIV3Version = "3.0.3"
+
+	jsonMediaType = "application/json"
+
+	definitionsRefPrefix = "#/definitions/"
+	schemasRefPrefix     = "#/components/schemas/"
+)
+
+// convertSpecV3 converts the OpenAPI v2 spec of the module to an OpenAPI v3 spec. The paths are
+// tagged with the module by the decorated v2 spec, and the schemas of the messages of the Msg
+// service of the module, which aren't served by the API, are added from the proto files.
+func convertSpecV3(spec []byte, m module.Module) (map[string]interface{}, error) {
+	var v2 map[string]interface{}
+	if err := json.Unmarshal(spec, &v2); err != nil {
+		return nil, err
+	}
+	v2 = replaceRefs(v2).(map[string]interface{})
+
+	paths := make(map[string]interface{})
+	v2Paths, _ := v2["paths"].(map[string]interface{})
+	for path, item := range v2Paths {
+		methods, _ := item.(map[string]interface{})
+		v3Methods := make(map[string]interface{})
+		for method, op := range methods {
+			if op, ok := op.(map[string]interface{}); ok {
+				v3Methods[method] = convertOperationV3(op)
+			}
+		}
+		paths[path] = v3Methods
+	}
+
+	schemas, _ := v2["definitions"].(map[string]interface{})
+	if schemas == nil {
+		schemas = make(map[string]interface{})
+	}
+	addMsgSchemas(schemas, m)
+
+	info, _ := v2["info"].(map[string]interface{})
+	if info == nil {
+		info = make(map[string]interface{})
+	}
+	info["title"] = m.Pkg.Name
+	if info["version"] == nil {
+		info["version"] = "version not set"
+	}
+
+	return map[string]interface{}{
+		"openapi": openAPIV3Version,
+		"info":    info,
+		"tags": []interface{}{
+			map[string]interface{}{"name": moduleTag(m), "description": moduleDescription(m)},
+		},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": schemas},
+	}, nil
+}
+
+// convertOperationV3 converts an operation of an OpenAPI v2 spec: the body parameter becomes the
+// request body and the schemas of the parameters and of the responses are moved to their content.
+func convertOperationV3(op map[string]interface{}) map[string]interface{} {
+	v3 := make(map[string]interface{})
+	for key, value := range op {
+		switch key {
+		case "parameters", "responses", "consumes", "produces":
+		default:
+			v3[key] = value
+		}
+	}
+
+	params, _ := op["parameters"].([]interface{})
+	var v3Params []interface{}
+	for _, p := range params {
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if param["in"] == "body" {
+			body := map[string]interface{}{
+				"content": map[string]interface{}{
+					jsonMediaType: map[string]interface{}{"schema": param["schema"]},
+				},
+			}
+			if param["required"] == true {
+				body["required"] = true
+			}
+			if param["description"] != nil {
+				body["description"] = param["description"]
+			}
+			v3["requestBody"] = body
+			continue
+		}
+
+		v3Param := make(map[string]interface{})
+		schema := make(map[string]interface{})
+		for key, value := range param {
+			switch key {
+			case "name", "in", "description", "required":
+				v3Param[key] = value
+			case "collectionFormat":
+				// the repeated query parameters are exploded by default in OpenAPI v3.
+			default:
+				schema[key] = value
+			}
+		}
+		v3Param["schema"] = schema
+		v3Params = append(v3Params, v3Param)
+	}
+	if len(v3Params) > 0 {
+		v3["parameters"] = v3Params
+	}
+
+	responses, _ := op["responses"].(map[string]interface{})
+	v3Responses := make(map[string]interface{})
+	for code, r := range responses {
+		response, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		v3Response := map[string]interface{}{"description": response["description"]}
+		if v3Response["description"] == nil {
+			v3Response["description"] = ""
+		}
+		if response["schema"] != nil {
+			v3Response["content"] = map[string]interface{}{
+				jsonMediaType: map[string]interface{}{"schema": response["schema"]},
+			}
+		}
+		v3Responses[code] = v3Response
+	}
+	v3["responses"] = v3Responses
+
+	return v3
+}
+
+// replaceRefs replaces the references to the definitions of an OpenAPI v2 spec by references to
+// the schemas of the components.
+func replaceRefs(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if ref, ok := elem.(string); ok && key == "$ref" {
+				v[key] = schemasRefPrefix + strings.TrimPrefix(ref, definitionsRefPrefix)
+				continue
+			}
+			v[key] = replaceRefs(elem)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = replaceRefs(elem)
+		}
+	}
+	return value
+}
+
+// addMsgSchemas adds the schemas of the requests and the responses of the Msg service of the module,
+// documented with the comments of the proto files. The existing schemas are kept.
+func addMsgSchemas(schemas map[string]interface{}, m module.Module) {
+	for _, s := range m.Pkg.Services {
+		if s.Name != "Msg" {
+			continue
+		}
+		for _, rpc := range s.RPCFuncs {
+			for _, name := range []string{rpc.RequestType, rpc.ReturnsType} {
+				msg, err := m.Pkg.MessageByName(name)
+				if err != nil {
+					continue
+				}
+				key := schemaName(m.Pkg.Name, msg.Name)
+				if _, ok := schemas[key]; !ok {
+					schemas[key] = messageSchema(schemas, m.Pkg.Name, msg)
+				}
+			}
+		}
+	}
+}
+
+// schemaName returns the name of the schema of a message of the proto package.
+func schemaName(pkgName, msgName string) string {
+	return fmt.Sprintf("%s.%s", pkgName, strings.ReplaceAll(msgName, "_", "."))
+}
+
+// messageSchema returns the schema of the message, the fields of message types reference the
+// existing schemas.
+func messageSchema(schemas map[string]interface{}, pkgName string, msg protoanalysis.Message) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for _, f := range msg.Fields {
+		property := fieldSchema(schemas, pkgName, f.Type)
+		if f.Repeated {
+			property = map[string]interface{}{"type": "array", "items": property}
+		}
+		if f.Description != "" {
+			property["description"] = f.Description
+		}
+		if f.Example != "" {
+			if example, ok := propertyExample(property, f.Example); ok {
+				property["example"] = example
+			}
+		}
+		properties[f.Name] = property
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if msg.Description != "" {
+		schema["description"] = msg.Description
+	}
+	return schema
+}
+
+// fieldSchema returns the schema of a field of the proto type, the 64-bit integers are strings
+// as in the proto3 JSON encoding.
+func fieldSchema(schemas map[string]interface{}, pkgName, protoType string) map[string]interface{} {
+	switch protoType {
+	case "string":
+		return map[string]interface{}{"type": "string"}
+	case "bytes":
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case "bool":
+		return map[string]interface{}{"type": "boolean"}
+	case "int32", "sint32", "sfixed32":
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case "uint32", "fixed32":
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case "int64", "sint64", "sfixed64":
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case "uint64", "fixed64":
+		return map[string]interface{}{"type": "string", "format": "uint64"}
+	case "double":
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case "float":
+		return map[string]interface{}{"type": "number", "format": "float"}
+	}
+
+	for _, name := range []string{schemaName(pkgName, protoType), protoType} {
+		if _, ok := schemas[name]; ok {
+			return map[string]interface{}{"$ref": schemasRefPrefix + name}
+		}
+	}
+	return map[string]interface{}{"type": "object"}
+}
+
+// combineSpecsV3 combines the OpenAPI v3 specs of the modules in a single spec, the paths are
+// grouped by the tags of the modules. The operation ids are prefixed with the module like in the
+// combined OpenAPI v2 spec, to keep them unique.
+func combineSpecsV3(title string, specs map[string]map[string]interface{}) map[string]interface{} {
+	var ids []string
+	for id := range specs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var (
+		tags    []interface{}
+		paths   = make(map[string]interface{})
+		schemas = make(map[string]interface{})
+	)
+	for _, id := range ids {
+		spec := specs[id]
+		moduleTags, _ := spec["tags"].([]interface{})
+		tags = append(tags, moduleTags...)
+
+		specPaths, _ := spec["paths"].(map[string]interface{})
+		for path, item := range specPaths {
+			methods, _ := item.(map[string]interface{})
+			for _, op := range methods {
+				if op, ok := op.(map[string]interface{}); ok {
+					if opID, ok := op["operationId"].(string); ok {
+						op["operationId"] = id + opID
+					}
+				}
+			}
+			paths[path] = item
+		}
+
+		components, _ := spec["components"].(map[string]interface{})
+		specSchemas, _ := components["schemas"].(map[string]interface{})
+		for name, schema := range specSchemas {
+			schemas[name] = schema
+		}
+	}
+
+	return map[string]interface{}{
+		"openapi":    openAPIV3Version,
+		"info":       map[string]interface{}{"title": title, "version": "version not set"},
+		"tags":       tags,
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": schemas},
+	}
+}
+
+// writeSpec writes the spec to path, in JSON when the extension of the path is .json, in YAML otherwise.
+func writeSpec(path string, spec interface{}) error {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(spec); err != nil {
+		return err
+	}
+
+	data := b.Bytes()
+	if filepath.Ext(path) != ".json" {
+		var err error
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0766); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
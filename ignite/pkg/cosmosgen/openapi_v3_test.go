@@ -0,0 +1,163 @@
+package cosmosgen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertSpecV3(t *testing.T) {
+	specV2 := `{
+  "swagger": "2.0",
+  "info": {"title": "blog", "version": "version not set"},
+  "paths": {
+    "/blog/posts": {
+      "get": {
+        "operationId": "PostAll",
+        "tags": ["blog"],
+        "parameters": [
+          {"name": "tags", "in": "query", "required": false, "type": "array", "items": {"type": "string"}, "collectionFormat": "multi"}
+        ],
+        "responses": {
+          "200": {"description": "A successful response.", "schema": {"$ref": "#/definitions/owner.app.blog.QueryAllPostResponse"}}
+        }
+      },
+      "post": {
+        "operationId": "CreatePost",
+        "parameters": [
+          {"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/owner.app.blog.MsgCreatePost"}}
+        ],
+        "responses": {"default": {"description": "An unexpected error response."}}
+      }
+    }
+  },
+  "definitions": {
+    "owner.app.blog.Post": {"type": "object"},
+    "owner.app.blog.QueryAllPostResponse": {"type": "object", "properties": {"Post": {"type": "array", "items": {"$ref": "#/definitions/owner.app.blog.Post"}}}},
+    "owner.app.blog.MsgCreatePost": {"type": "object", "title": "kept"}
+  }
+}`
+
+	spec, err := convertSpecV3([]byte(specV2), openapiBlogModule())
+	require.NoError(t, err)
+
+	b, err := json.Marshal(spec)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "openapi": "3.0.3",
+  "info": {"title": "owner.app.blog", "version": "version not set"},
+  "tags": [{"name": "owner.app.blog", "description": "Msg defines the messages of the blog."}],
+  "paths": {
+    "/blog/posts": {
+      "get": {
+        "operationId": "PostAll",
+        "tags": ["blog"],
+        "parameters": [
+          {"name": "tags", "in": "query", "required": false, "schema": {"type": "array", "items": {"type": "string"}}}
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/owner.app.blog.QueryAllPostResponse"}}}
+          }
+        }
+      },
+      "post": {
+        "operationId": "CreatePost",
+        "requestBody": {
+          "required": true,
+          "content": {"application/json": {"schema": {"$ref": "#/components/schemas/owner.app.blog.MsgCreatePost"}}}
+        },
+        "responses": {"default": {"description": "An unexpected error response."}}
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "owner.app.blog.Post": {"type": "object"},
+      "owner.app.blog.QueryAllPostResponse": {"type": "object", "properties": {"Post": {"type": "array", "items": {"$ref": "#/components/schemas/owner.app.blog.Post"}}}},
+      "owner.app.blog.MsgCreatePost": {"type": "object", "title": "kept"},
+      "owner.app.blog.MsgCreatePostResponse": {"type": "object", "properties": {"id": {"type": "string", "format": "uint64"}}}
+    }
+  }
+}`, string(b))
+}
+
+func TestMessageSchema(t *testing.T) {
+	m := openapiBlogModule()
+	schemas := map[string]interface{}{"owner.app.blog.Post": map[string]interface{}{"type": "object"}}
+	addMsgSchemas(schemas, m)
+
+	require.Equal(t, map[string]interface{}{
+		"type":        "object",
+		"description": "MsgCreatePost creates a post.",
+		"properties": map[string]interface{}{
+			"creator": map[string]interface{}{"type": "string"},
+			"likes": map[string]interface{}{
+				"type":        "string",
+				"format":      "uint64",
+				"description": "Likes of the post.",
+				"example":     "10",
+			},
+			"tags": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"type": "string"},
+			},
+			"post": map[string]interface{}{"$ref": "#/components/schemas/owner.app.blog.Post"},
+		},
+	}, schemas["owner.app.blog.MsgCreatePost"])
+	require.Contains(t, schemas, "owner.app.blog.MsgCreatePostResponse")
+}
+
+func TestCombineSpecsV3(t *testing.T) {
+	spec := func(tag, path, opID, schema string) map[string]interface{} {
+		return map[string]interface{}{
+			"tags": []interface{}{map[string]interface{}{"name": tag}},
+			"paths": map[string]interface{}{
+				path: map[string]interface{}{
+					"get": map[string]interface{}{"operationId": opID},
+				},
+			},
+			"components": map[string]interface{}{
+				"schemas": map[string]interface{}{schema: map[string]interface{}{"type": "object"}},
+			},
+		}
+	}
+
+	combined := combineSpecsV3("API", map[string]map[string]interface{}{
+		"Mars": spec("mars", "/mars/params", "Params", "mars.Params"),
+		"Blog": spec("blog", "/blog/params", "Params", "blog.Params"),
+	})
+
+	require.Equal(t, "3.0.3", combined["openapi"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"name": "blog"},
+		map[string]interface{}{"name": "mars"},
+	}, combined["tags"])
+	require.Equal(t, map[string]interface{}{
+		"/blog/params": map[string]interface{}{"get": map[string]interface{}{"operationId": "BlogParams"}},
+		"/mars/params": map[string]interface{}{"get": map[string]interface{}{"operationId": "MarsParams"}},
+	}, combined["paths"])
+	require.Len(t, combined["components"].(map[string]interface{})["schemas"], 2)
+}
+
+func TestWriteSpec(t *testing.T) {
+	dir := t.TempDir()
+	spec := map[string]interface{}{"openapi": "3.0.3", "info": map[string]interface{}{"title": "<blog>"}}
+
+	yamlPath := filepath.Join(dir, "modules", "blog.yml")
+	require.NoError(t, writeSpec(yamlPath, spec))
+	content, err := os.ReadFile(yamlPath)
+	require.NoError(t, err)
+	require.Equal(t, "info:\n  title: <blog>\nopenapi: 3.0.3\n", string(content))
+
+	jsonPath := filepath.Join(dir, "blog.json")
+	require.NoError(t, writeSpec(jsonPath, spec))
+	content, err = os.ReadFile(jsonPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"openapi": "3.0.3", "info": {"title": "<blog>"}}`, string(content))
+	require.Contains(t, string(content), "<blog>")
+}
@@ -2,6 +2,7 @@ package cosmosgen
 
 import (
 	"embed"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,27 +20,85 @@ var (
 	templateVuexRoot  = newTemplateWriter("vuex/root")  // vuex store loader.
 	templateVuexStore = newTemplateWriter("vuex/store") // vuex store.
 
+	templateComponentsCommon = newTemplateWriter("components/common") // helpers of the ui components.
+	templateComponentsVue    = newTemplateWriter("components/vue")    // vue ui components.
+	templateComponentsReact  = newTemplateWriter("components/react")  // react ui components.
+
+	templateE2ESuite   = newTemplateWriter("e2e/suite")   // config and fixtures of the e2e tests.
+	templateE2EHarness = newTemplateWriter("e2e/harness") // page mounting the components tested.
+	templateE2ESpec    = newTemplateWriter("e2e/spec")    // e2e test of a type.
+
+	templateGoClient = newTemplateWriter("goclient") // go client.
+
+	templateORM = newTemplateWriter("orm") // data-access layer of the stored types.
+
+	templateAdmin = newTemplateWriter("admin") // dev admin page of the params and the proposals.
+
+	templateReactRoot   = newTemplateWriter("react/root")   // context and index of the react hooks.
+	templateReactModule = newTemplateWriter("react/module") // react hooks of a module.
+
+	templateDocs = newTemplateWriter("docs") // reference docs of the modules.
 )
 
 type templateWriter struct {
 	templateDir string
+
+	// overrideDir is a dir of user-defined templates, they replace the templates of the template dir
+	// with the same name.
+	overrideDir string
 }
 
 // tpl returns a func for template residing at templatePath to initialize a text template
 // with given protoPath.
 func newTemplateWriter(templateDir string) templateWriter {
 	return templateWriter{
-		templateDir,
+		templateDir: templateDir,
 	}
 }
 
+// Override returns a template writer rendering the templates of dir instead of the ones of the
+// template dir with the same name. The other templates of dir, e.g. the ones defining shared
+// blocks, are available to all the templates.
+func (t templateWriter) Override(dir string) templateWriter {
+	t.overrideDir = dir
+	return t
+}
+
 func (t templateWriter) Write(destDir, protoPath string, data interface{}) error {
+	paths, err := t.paths()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		out := filepath.Join(destDir, strings.TrimSuffix(filepath.Base(path), ".tpl"))
+		if err := t.write(paths, path, out, protoPath, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteFile renders the template file of the template dir to the out path.
+// It is used when the name of the generated file depends on the data.
+func (t templateWriter) WriteFile(out, file, protoPath string, data interface{}) error {
+	paths, err := t.paths()
+	if err != nil {
+		return err
+	}
+
+	return t.write(paths, filepath.Join("templates", t.templateDir, file), out, protoPath, data)
+}
+
+// paths returns the paths of the templates inside the template dir.
+func (t templateWriter) paths() ([]string, error) {
 	base := filepath.Join("templates", t.templateDir)
 
 	// find out templates inside the dir.
 	files, err := templates.ReadDir(base)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var paths []string
@@ -47,6 +106,11 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 		paths = append(paths, filepath.Join(base, file.Name()))
 	}
 
+	return paths, nil
+}
+
+// write renders the template residing at path and writes it to out.
+func (t templateWriter) write(paths []string, path, out, protoPath string, data interface{}) error {
 	funcs := template.FuncMap{
 		"camelCase": strcase.ToLowerCamel,
 		"camelCaseSta": func(word string) string {
@@ -54,41 +118,45 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 		},
 		"resolveFile": func(fullPath string) string {
 			rel, _ := filepath.Rel(protoPath, fullPath)
-			rel = strings.TrimSuffix(rel, ".proto")
+			rel = strings.TrimSuffix(filepath.ToSlash(rel), ".proto")
 			return rel
 		},
 		"inc": func(i int) int {
 			return i + 1
 		},
-		"replace": strings.ReplaceAll,
+		"replace":    strings.ReplaceAll,
+		"trimPrefix": strings.TrimPrefix,
+		"markdownCell": func(text string) string {
+			text = strings.ReplaceAll(text, "|", "\\|")
+			return strings.ReplaceAll(strings.TrimSpace(text), "\n", "<br>")
+		},
 	}
 
-	// render and write the template.
-	write := func(path string) error {
-		tpl := template.
-			Must(
-				template.
-					New(filepath.Base(path)).
-					Funcs(funcs).
-					ParseFS(templates, paths...),
-			)
+	tpl := template.
+		Must(
+			template.
+				New(filepath.Base(path)).
+				Funcs(funcs).
+				ParseFS(templates, paths...),
+		)
 
-		out := filepath.Join(destDir, strings.TrimSuffix(filepath.Base(path), ".tpl"))
-
-		f, err := os.OpenFile(out, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0766)
+	if t.overrideDir != "" {
+		overrides, err := filepath.Glob(filepath.Join(t.overrideDir, "*.tpl"))
 		if err != nil {
 			return err
 		}
-		defer f.Close()
-
-		return tpl.Execute(f, data)
+		if len(overrides) > 0 {
+			if tpl, err = tpl.ParseFiles(overrides...); err != nil {
+				return fmt.Errorf("invalid templates in %s: %w", t.overrideDir, err)
+			}
+		}
 	}
 
-	for _, path := range paths {
-		if err := write(path); err != nil {
-			return err
-		}
+	f, err := os.OpenFile(out, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0766)
+	if err != nil {
+		return err
 	}
+	defer f.Close()
 
-	return nil
+	return tpl.Execute(f, data)
 }
@@ -0,0 +1,367 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+
+<template>
+  <div class="admin">
+    <h1>Admin</h1>
+    <p class="warning">This page signs with the accounts imported in it, use it in development only.</p>
+
+    <section>
+      <h2>Account</h2>
+      <select v-model="signer">
+        <option v-for="name in accounts" :key="name" :value="name" v-text="name" />
+      </select>
+      <code v-text="addresses[signer]" />
+      <form class="field" @submit.prevent="importAccount">
+        <input v-model="importName" type="text" placeholder="Name, e.g. alice" />
+        <input v-model="importMnemonic" type="password" placeholder="Mnemonic, kept in the memory of the page" />
+        <button type="submit" :disabled="sending || !importName || !importMnemonic">Import</button>
+      </form>
+    </section>
+
+    <section>
+      <h2>Params</h2>
+      <div v-for="m in modules" :key="m.name" class="module">
+        <h3 v-text="m.name" />
+        <p v-if="params[m.name] && params[m.name].error" class="error" v-text="params[m.name].error" />
+        <table v-else-if="params[m.name]">
+          <tr v-for="(value, field) in params[m.name].value" :key="field">
+            <td v-text="field" />
+            <td><code v-text="JSON.stringify(value)" /></td>
+            <td><button type="button" @click="addChange(m.name, field, value)">Change</button></td>
+          </tr>
+        </table>
+      </div>
+    </section>
+
+    <section>
+      <h2>Parameter change proposal</h2>
+      <form @submit.prevent="submitProposal">
+        <div class="field">
+          <label for="proposal-title">Title</label>
+          <input id="proposal-title" v-model="title" type="text" />
+        </div>
+        <div class="field">
+          <label for="proposal-description">Description</label>
+          <textarea id="proposal-description" v-model="description" />
+        </div>
+        <div v-for="(change, index) in changes" :key="index" class="change">
+          <input v-model="change.subspace" type="text" placeholder="Subspace, e.g. staking" />
+          <input v-model="change.key" type="text" placeholder="Key, e.g. MaxValidators" />
+          <textarea v-model="change.value" placeholder='JSON value, e.g. "100"' />
+          <button type="button" @click="changes.splice(index, 1)">Remove</button>
+        </div>
+        <button type="button" @click="addChange('', '', '')">Add change</button>
+        <div class="field">
+          <label for="proposal-deposit">Deposit</label>
+          <input id="proposal-deposit" v-model="deposit" type="text" placeholder="10000000stake" />
+        </div>
+        <button type="submit" :disabled="sending || changes.length === 0">Submit proposal</button>
+      </form>
+    </section>
+
+    <section>
+      <h2>Proposals</h2>
+      <button type="button" :disabled="sending" @click="loadProposals">Reload</button>
+      <table>
+        <thead>
+          <tr>
+            <th>Id</th>
+            <th>Title</th>
+            <th>Status</th>
+            <th>Yes / No / Abstain / Veto</th>
+            <th>Vote</th>
+          </tr>
+        </thead>
+        <tbody>
+          <tr v-for="proposal in proposals" :key="proposal.id">
+            <td v-text="proposal.id" />
+            <td v-text="proposal.title" />
+            <td v-text="proposal.status" />
+            <td v-text="proposal.tally" />
+            <td v-if="proposal.votable">
+              <button v-for="option in voteOptions" :key="option.name" type="button" :disabled="sending" @click="vote(proposal.id, option.value, [signer])" v-text="option.name" />
+              <button type="button" :disabled="sending" @click="vote(proposal.id, VoteOption.VOTE_OPTION_YES, accounts)">Yes with all accounts</button>
+            </td>
+            <td v-else />
+          </tr>
+        </tbody>
+      </table>
+    </section>
+
+    <p v-if="error" class="error" v-text="error" />
+    <p v-if="message" class="message" v-text="message" />
+  </div>
+</template>
+
+<script lang="ts">
+import { fromHex } from '@cosmjs/encoding'
+import { DirectSecp256k1HdWallet, DirectSecp256k1Wallet, EncodeObject, OfflineDirectSigner } from '@cosmjs/proto-signing'
+import { parseCoins, SigningStargateClient } from '@cosmjs/stargate'
+import { VoteOption } from 'cosmjs-types/cosmos/gov/v1beta1/gov'
+import { ParameterChangeProposal } from 'cosmjs-types/cosmos/params/v1beta1/params'
+import { computed, defineComponent, onMounted, reactive, ref } from 'vue'
+import { useStore } from 'vuex'
+
+import { accounts as devAccounts } from './accounts'
+{{- range .Modules }}
+import { queryClient as {{ .Ident }} } from '{{ .ClientPath }}'{{ end }}
+
+// the modules with params, the params of a module are changed in the subspace named after it.
+const modules = [
+{{- range .Modules }}
+  { name: '{{ .Name }}', queryClient: {{ .Ident }} },
+{{- end }}
+]
+
+const voteOptions = [
+  { name: 'Yes', value: VoteOption.VOTE_OPTION_YES },
+  { name: 'No', value: VoteOption.VOTE_OPTION_NO },
+  { name: 'Abstain', value: VoteOption.VOTE_OPTION_ABSTAIN },
+  { name: 'Veto', value: VoteOption.VOTE_OPTION_NO_WITH_VETO }
+]
+
+const fee = { amount: [], gas: '400000' }
+
+// paramKey returns the key of a param from its JSON field, the keys of the params are usually
+// their fields in Pascal case, e.g. MaxValidators for max_validators.
+const paramKey = (field: string) => field.replace(/(^|_)([a-z0-9])/g, (_, __, c) => c.toUpperCase())
+
+interface Change {
+  subspace: string
+  key: string
+  value: string
+}
+
+export default defineComponent({
+  name: 'Admin',
+
+  setup() {
+    const $s = useStore()
+
+    const apiAddr = computed(() => $s.getters['common/env/apiCosmos'])
+    const rpcAddr = computed(() => $s.getters['common/env/apiTendermint'])
+    const prefix = computed(() => $s.getters['common/env/addrPrefix'])
+
+    // the wallets of the accounts signing the txs, the dev accounts of accounts.ts and the accounts
+    // imported in the page.
+    const wallets: Record<string, OfflineDirectSigner> = {}
+    const accounts = ref<string[]>([])
+    const signer = ref('')
+    const addresses = reactive<Record<string, string>>({})
+    const importName = ref('')
+    const importMnemonic = ref('')
+    const params = reactive<Record<string, { value?: any; error?: string }>>({})
+    const proposals = ref<any[]>([])
+
+    const title = ref('')
+    const description = ref('')
+    const changes = reactive<Change[]>([])
+    const deposit = ref('')
+
+    const error = ref('')
+    const message = ref('')
+    const sending = ref(false)
+
+    const addWallet = async (name: string, w: OfflineDirectSigner) => {
+      const [{ address }] = await w.getAccounts()
+      wallets[name] = w
+      addresses[name] = address
+      if (!accounts.value.includes(name)) {
+        accounts.value.push(name)
+      }
+      if (!signer.value) {
+        signer.value = name
+      }
+    }
+
+    // broadcast signs the messages with the account and broadcasts them.
+    const broadcast = async (name: string, msgs: (address: string) => EncodeObject[]) => {
+      const w = wallets[name]
+      if (!w) {
+        throw new Error(`unknown account ${name}`)
+      }
+      const [{ address }] = await w.getAccounts()
+      const client = await SigningStargateClient.connectWithSigner(rpcAddr.value, w)
+      const result = await client.signAndBroadcast(address, msgs(address), fee)
+      if (result.code) {
+        throw new Error(result.rawLog)
+      }
+      return result
+    }
+
+    const run = async (action: () => Promise<string>) => {
+      error.value = ''
+      message.value = ''
+      sending.value = true
+      try {
+        message.value = await action()
+      } catch (e) {
+        error.value = e.message
+      } finally {
+        sending.value = false
+      }
+    }
+
+    const importAccount = () =>
+      run(async () => {
+        const name = importName.value
+        await addWallet(name, await DirectSecp256k1HdWallet.fromMnemonic(importMnemonic.value.trim(), { prefix: prefix.value }))
+        importName.value = ''
+        importMnemonic.value = ''
+        return `Imported ${name}.`
+      })
+
+    const get = async (path: string) => {
+      const res = await fetch(apiAddr.value + path)
+      if (!res.ok) {
+        throw new Error(`${path}: ${res.status} ${res.statusText}`)
+      }
+      return res.json()
+    }
+
+    const loadParams = async () => {
+      for (const m of modules) {
+        try {
+          const client = await m.queryClient({ addr: apiAddr.value })
+          const { data } = await client.queryParams()
+          params[m.name] = { value: (data as any).params ?? {} }
+        } catch (e) {
+          params[m.name] = { error: e.message }
+        }
+      }
+    }
+
+    const loadProposals = async () => {
+      try {
+        const { proposals: list } = await get('/cosmos/gov/v1beta1/proposals?pagination.reverse=true')
+        proposals.value = await Promise.all(
+          list.map(async (p: any) => {
+            const votable = p.status === 'PROPOSAL_STATUS_VOTING_PERIOD'
+            const tally = votable ? (await get(`/cosmos/gov/v1beta1/proposals/${p.proposal_id}/tally`)).tally : p.final_tally_result
+            return {
+              id: p.proposal_id,
+              title: p.content?.title ?? '',
+              status: p.status.replace('PROPOSAL_STATUS_', ''),
+              tally: [tally.yes, tally.no, tally.abstain, tally.no_with_veto].join(' / '),
+              votable
+            }
+          })
+        )
+      } catch (e) {
+        error.value = e.message
+      }
+    }
+
+    const addChange = (subspace: string, field: string, value: any) => {
+      changes.push({
+        subspace,
+        key: field ? paramKey(field) : '',
+        value: value === '' ? '' : JSON.stringify(value)
+      })
+    }
+
+    const submitProposal = () =>
+      run(async () => {
+        const content = ParameterChangeProposal.fromPartial({
+          title: title.value,
+          description: description.value,
+          changes: changes.map((c) => ({ subspace: c.subspace, key: c.key, value: c.value }))
+        })
+        await broadcast(signer.value, (proposer) => [
+          {
+            typeUrl: '/cosmos.gov.v1beta1.MsgSubmitProposal',
+            value: {
+              content: {
+                typeUrl: '/cosmos.params.v1beta1.ParameterChangeProposal',
+                value: ParameterChangeProposal.encode(content).finish()
+              },
+              initialDeposit: parseCoins(deposit.value),
+              proposer
+            }
+          }
+        ])
+        changes.splice(0, changes.length)
+        await loadProposals()
+        return 'Proposal submitted.'
+      })
+
+    const vote = (proposalId: string, option: VoteOption, names: string[]) =>
+      run(async () => {
+        for (const name of names) {
+          await broadcast(name, (voter) => [
+            {
+              typeUrl: '/cosmos.gov.v1beta1.MsgVote',
+              value: { proposalId, voter, option }
+            }
+          ])
+        }
+        await loadProposals()
+        return `Voted with ${names.join(', ')}.`
+      })
+
+    onMounted(async () => {
+      await $s.dispatch('common/env/init')
+
+      for (const account of devAccounts) {
+        await addWallet(account.name, await DirectSecp256k1Wallet.fromKey(fromHex(account.privKey), prefix.value))
+      }
+
+      // the deposit is the minimum deposit of the proposals by default.
+      try {
+        const { deposit_params } = await get('/cosmos/gov/v1beta1/params/deposit')
+        deposit.value = deposit_params.min_deposit.map((c: any) => c.amount + c.denom).join(',')
+      } catch (e) {
+        error.value = e.message
+      }
+
+      await Promise.all([loadParams(), loadProposals()])
+    })
+
+    return {
+      accounts,
+      modules,
+      voteOptions,
+      VoteOption,
+      signer,
+      addresses,
+      importName,
+      importMnemonic,
+      params,
+      proposals,
+      title,
+      description,
+      changes,
+      deposit,
+      error,
+      message,
+      sending,
+      addChange,
+      importAccount,
+      submitProposal,
+      loadProposals,
+      vote
+    }
+  }
+})
+</script>
+
+<style scoped>
+.admin {
+  max-width: 960px;
+  margin: 0 auto;
+  font-family: sans-serif;
+}
+.warning,
+.error {
+  color: #b00020;
+}
+.field,
+.change {
+  display: flex;
+  gap: 8px;
+  margin: 8px 0;
+}
+td {
+  padding: 4px 8px;
+}
+</style>
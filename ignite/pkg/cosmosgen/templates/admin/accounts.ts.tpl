@@ -0,0 +1,14 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+//
+// The private keys of the dev accounts of the chain written with --dev-keys, they sign the txs of
+// the admin page along with the accounts imported in it. This file is ignored by git, never use
+// these accounts outside of development.
+
+export interface Account {
+  name: string
+  privKey: string
+}
+
+export const accounts: Account[] = [{{ range .Accounts }}
+  { name: '{{ .Name }}', privKey: '{{ .PrivKey }}' },{{ end }}
+]
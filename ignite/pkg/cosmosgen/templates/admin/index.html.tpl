@@ -0,0 +1,12 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8" />
+    <title>Admin</title>
+  </head>
+  <body>
+    <div id="app"></div>
+    <script type="module" src="./main.ts"></script>
+  </body>
+</html>
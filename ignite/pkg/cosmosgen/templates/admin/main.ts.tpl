@@ -0,0 +1,8 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { createApp } from 'vue'
+
+import store from '../src/store'
+import Admin from './Admin.vue'
+
+createApp(Admin).use(store).mount('#app')
@@ -0,0 +1,129 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// FieldKind is the kind of the input of a field.
+// long and ulong are 64-bit integers, they are kept as strings to keep their precision.
+export type FieldKind = 'string' | 'bool' | 'int' | 'uint' | 'long' | 'ulong' | 'number' | 'coin' | 'json'
+
+export interface Field {
+  name: string
+  label: string
+  kind: FieldKind
+  repeated: boolean
+}
+
+export type Input = string | boolean
+
+const intRe = /^-?\d+$/
+const uintRe = /^\d+$/
+const coinRe = /^(\d+)\s*([a-zA-Z][a-zA-Z0-9/:._-]{2,127})$/
+
+// initialInput returns the input of an empty field.
+export function initialInput(field: Field): Input {
+  return field.kind === 'bool' && !field.repeated ? false : ''
+}
+
+// placeholder describes the expected input of the field.
+export function placeholder(field: Field): string {
+  if (field.kind === 'json') {
+    return field.repeated ? 'JSON array' : 'JSON object'
+  }
+  const example = field.kind === 'coin' ? '10token' : field.kind
+  return field.repeated ? example + ', comma separated' : example
+}
+
+function items(field: Field, input: Input): string[] {
+  const text = String(input).trim()
+  if (!field.repeated) {
+    return [text]
+  }
+  return text.split(',').map((item) => item.trim()).filter((item) => item !== '')
+}
+
+function validateItem(kind: FieldKind, item: string): string {
+  switch (kind) {
+    case 'bool':
+      return item === 'true' || item === 'false' ? '' : 'must be true or false'
+    case 'int':
+    case 'long':
+      return intRe.test(item) ? '' : 'must be an integer'
+    case 'uint':
+    case 'ulong':
+      return uintRe.test(item) ? '' : 'must be a positive integer'
+    case 'number':
+      return item !== '' && !isNaN(Number(item)) ? '' : 'must be a number'
+    case 'coin':
+      return coinRe.test(item) ? '' : 'must be an amount followed by a denom, e.g. 10token'
+  }
+  return ''
+}
+
+// validate returns the error of the input of the field, it is empty when the input is valid.
+export function validate(field: Field, input: Input): string {
+  if (field.kind === 'bool' && !field.repeated) {
+    return ''
+  }
+  if (field.kind === 'json') {
+    try {
+      JSON.parse(String(input).trim() || (field.repeated ? '[]' : '{}'))
+      return ''
+    } catch (e) {
+      return 'must be valid JSON'
+    }
+  }
+  for (const item of items(field, input)) {
+    const error = validateItem(field.kind, item)
+    if (error !== '') {
+      return field.label + ' ' + error
+    }
+  }
+  return ''
+}
+
+function parseItem(kind: FieldKind, item: string): any {
+  switch (kind) {
+    case 'bool':
+      return item === 'true'
+    case 'int':
+    case 'uint':
+    case 'number':
+      return Number(item)
+    case 'coin': {
+      const [, amount, denom] = coinRe.exec(item)
+      return { amount, denom }
+    }
+  }
+  return item
+}
+
+// parse converts the valid input of the field to the value of the message field.
+export function parse(field: Field, input: Input): any {
+  if (field.kind === 'bool' && !field.repeated) {
+    return input === true
+  }
+  if (field.kind === 'json') {
+    return JSON.parse(String(input).trim() || (field.repeated ? '[]' : '{}'))
+  }
+  const values = items(field, input).map((item) => parseItem(field.kind, item))
+  return field.repeated ? values : values[0]
+}
+
+function formatItem(kind: FieldKind, value: any): string {
+  switch (kind) {
+    case 'coin':
+      return value.amount + value.denom
+    case 'json':
+      return JSON.stringify(value)
+  }
+  return String(value)
+}
+
+// format formats the value of the field for display.
+export function format(field: Field, value: any): string {
+  if (value === undefined || value === null) {
+    return ''
+  }
+  if (field.repeated) {
+    return (value as any[]).map((item) => formatItem(field.kind, item)).join(', ')
+  }
+  return formatItem(field.kind, value)
+}
@@ -0,0 +1,105 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import React, { FormEvent, useState } from 'react'
+import { OfflineSigner } from '@cosmjs/proto-signing'
+import { txClient } from '{{ .ClientPath }}'
+import { Field, Input, initialInput, parse, placeholder, validate } from './fields'
+
+const fields: Field[] = [{{ range .CreateFields }}
+  { name: '{{ .Name }}', label: '{{ .Label }}', kind: '{{ .Kind }}', repeated: {{ .Repeated }} },{{ end }}
+]
+
+const initialInputs = () => Object.fromEntries(fields.map((field) => [field.name, initialInput(field)]))
+
+export interface {{ .Name }}FormProps {
+  // wallet signs the message.
+  wallet?: OfflineSigner
+  // rpcAddr is the address of the RPC of the node.
+  rpcAddr?: string
+  onCreated?: (result: any) => void
+}
+
+export function {{ .Name }}Form({ wallet, rpcAddr, onCreated }: {{ .Name }}FormProps) {
+  const [inputs, setInputs] = useState<Record<string, Input>>(initialInputs())
+  const [errors, setErrors] = useState<Record<string, string>>({})
+  const [error, setError] = useState('')
+  const [sending, setSending] = useState(false)
+
+  const setInput = (name: string, input: Input) => setInputs({ ...inputs, [name]: input })
+
+  const submit = async (event: FormEvent) => {
+    event.preventDefault()
+    setError('')
+
+    const newErrors: Record<string, string> = {}
+    for (const field of fields) {
+      newErrors[field.name] = validate(field, inputs[field.name])
+    }
+    setErrors(newErrors)
+    if (Object.values(newErrors).some((e) => e !== '')) {
+      return
+    }
+
+    setSending(true)
+    try {
+      const [account] = await wallet.getAccounts()
+      const value: Record<string, any> = { {{ .Signer }}: account.address }
+      for (const field of fields) {
+        value[field.name] = parse(field, inputs[field.name])
+      }
+
+      const client = await txClient(wallet, rpcAddr ? { addr: rpcAddr } : undefined)
+      const result = await client.signAndBroadcast([client.{{ .CreateMethod }}(value as any)])
+      if (result.code) {
+        throw new Error(result.rawLog)
+      }
+      setInputs(initialInputs())
+      onCreated?.(result)
+    } catch (e) {
+      setError(e.message)
+    } finally {
+      setSending(false)
+    }
+  }
+
+  return (
+    <form className="{{ camelCase .Name }}-form" onSubmit={submit}>
+      {fields.map((field) => {
+        const id = '{{ camelCase .Name }}-' + field.name
+        return (
+          <div key={field.name} className="field">
+            <label htmlFor={id}>{field.label}</label>
+            {field.kind === 'bool' && !field.repeated ? (
+              <input
+                id={id}
+                type="checkbox"
+                checked={inputs[field.name] === true}
+                onChange={(e) => setInput(field.name, e.target.checked)}
+              />
+            ) : field.kind === 'json' ? (
+              <textarea
+                id={id}
+                value={String(inputs[field.name])}
+                placeholder={placeholder(field)}
+                onChange={(e) => setInput(field.name, e.target.value)}
+              />
+            ) : (
+              <input
+                id={id}
+                type="text"
+                value={String(inputs[field.name])}
+                placeholder={placeholder(field)}
+                onChange={(e) => setInput(field.name, e.target.value)}
+              />
+            )}
+            {errors[field.name] && <p className="error">{errors[field.name]}</p>}
+          </div>
+        )
+      })}
+      {error && <p className="error">{error}</p>}
+      <button type="submit" disabled={!wallet || sending}>
+        Create {{ .Name }}
+      </button>
+    </form>
+  )
+}
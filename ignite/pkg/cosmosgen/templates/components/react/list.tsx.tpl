@@ -0,0 +1,98 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import React, { useCallback, useEffect, useState } from 'react'
+import { queryClient } from '{{ .ClientPath }}'
+import { Field, format } from './fields'
+
+const fields: Field[] = [{{ range .Fields }}
+  { name: '{{ .Name }}', label: '{{ .Label }}', kind: '{{ .Kind }}', repeated: {{ .Repeated }} },{{ end }}
+]
+
+export interface {{ .Name }}ListProps {
+  // apiAddr is the address of the API of the node.
+  apiAddr?: string
+  pageSize?: number
+  // reloadKey reloads the current page when it changes, e.g. once an item is created.
+  reloadKey?: unknown
+}
+
+export function {{ .Name }}List({ apiAddr, pageSize = 10, reloadKey }: {{ .Name }}ListProps) {
+  const [items, setItems] = useState<any[]>([])
+  const [error, setError] = useState('')
+  const [loading, setLoading] = useState(false)
+
+  // keys of the previous pages and of the current and next pages.
+  const [keys, setKeys] = useState<string[]>([])
+  const [key, setKey] = useState('')
+  const [nextKey, setNextKey] = useState('')
+
+  const load = useCallback(
+    async (pageKey: string) => {
+      setLoading(true)
+      setError('')
+      try {
+        const client = await queryClient(apiAddr ? { addr: apiAddr } : undefined)
+        const { data } = await client.{{ .ListMethod }}({
+          'pagination.limit': String(pageSize),
+          'pagination.key': pageKey
+        })
+        const value: any = data
+        setItems(value.{{ .ListField }} ?? [])
+        setKey(pageKey)
+        setNextKey(value.pagination?.next_key ?? '')
+      } catch (e) {
+        setError(e.message)
+      } finally {
+        setLoading(false)
+      }
+    },
+    [apiAddr, pageSize]
+  )
+
+  useEffect(() => {
+    load(key)
+  }, [load, reloadKey])
+
+  const next = () => {
+    setKeys([...keys, key])
+    load(nextKey)
+  }
+
+  const previous = () => {
+    load(keys[keys.length - 1] ?? '')
+    setKeys(keys.slice(0, -1))
+  }
+
+  return (
+    <div className="{{ camelCase .Name }}-list">
+      <table>
+        <thead>
+          <tr>
+            {fields.map((field) => (
+              <th key={field.name}>{field.label}</th>
+            ))}
+          </tr>
+        </thead>
+        <tbody>
+          {items.map((item, index) => (
+            <tr key={index}>
+              {fields.map((field) => (
+                <td key={field.name}>{format(field, item[field.name])}</td>
+              ))}
+            </tr>
+          ))}
+        </tbody>
+      </table>
+      {error && <p className="error">{error}</p>}
+      <div className="pagination">
+        <button disabled={loading || keys.length === 0} onClick={previous}>
+          Previous
+        </button>
+        <span>Page {keys.length + 1}</span>
+        <button disabled={loading || !nextKey} onClick={next}>
+          Next
+        </button>
+      </div>
+    </div>
+  )
+}
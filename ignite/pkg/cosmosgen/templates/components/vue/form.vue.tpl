@@ -0,0 +1,93 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+
+<template>
+  <form class="{{ camelCase .Name }}-form" @submit.prevent="submit">
+    <div v-for="field in fields" :key="field.name" class="field">
+      <label :for="'{{ camelCase .Name }}-' + field.name" v-text="field.label" />
+      <input
+        v-if="field.kind === 'bool' && !field.repeated"
+        :id="'{{ camelCase .Name }}-' + field.name"
+        v-model="inputs[field.name]"
+        type="checkbox"
+      />
+      <textarea
+        v-else-if="field.kind === 'json'"
+        :id="'{{ camelCase .Name }}-' + field.name"
+        v-model="inputs[field.name]"
+        :placeholder="placeholder(field)"
+      />
+      <input
+        v-else
+        :id="'{{ camelCase .Name }}-' + field.name"
+        v-model="inputs[field.name]"
+        type="text"
+        :placeholder="placeholder(field)"
+      />
+      <p v-if="errors[field.name]" class="error" v-text="errors[field.name]" />
+    </div>
+    <p v-if="error" class="error" v-text="error" />
+    <button type="submit" :disabled="!address || sending">Create {{ .Name }}</button>
+  </form>
+</template>
+
+<script lang="ts">
+import { computed, defineComponent, reactive, ref } from 'vue'
+import { useStore } from 'vuex'
+import { Field, Input, initialInput, parse, placeholder, validate } from './fields'
+
+const fields: Field[] = [{{ range .CreateFields }}
+  { name: '{{ .Name }}', label: '{{ .Label }}', kind: '{{ .Kind }}', repeated: {{ .Repeated }} },{{ end }}
+]
+
+const initialInputs = () => Object.fromEntries(fields.map((field) => [field.name, initialInput(field)]))
+
+export default defineComponent({
+  name: '{{ .Name }}Form',
+
+  emits: ['created'],
+
+  setup(_, { emit }) {
+    const $s = useStore()
+
+    const address = computed(() => $s.getters['common/wallet/address'])
+
+    const inputs = reactive<Record<string, Input>>(initialInputs())
+    const errors = reactive<Record<string, string>>({})
+    const error = ref('')
+    const sending = ref(false)
+
+    const submit = async () => {
+      error.value = ''
+      let valid = true
+      for (const field of fields) {
+        errors[field.name] = validate(field, inputs[field.name])
+        valid = valid && errors[field.name] === ''
+      }
+      if (!valid) {
+        return
+      }
+
+      const value: Record<string, any> = { {{ .Signer }}: address.value }
+      for (const field of fields) {
+        value[field.name] = parse(field, inputs[field.name])
+      }
+
+      sending.value = true
+      try {
+        const result = await $s.dispatch('{{ .Namespace }}/send{{ .CreateMsg }}', { value, fee: [], memo: '' })
+        if (result.code) {
+          throw new Error(result.rawLog)
+        }
+        Object.assign(inputs, initialInputs())
+        emit('created', result)
+      } catch (e) {
+        error.value = e.message
+      } finally {
+        sending.value = false
+      }
+    }
+
+    return { fields, placeholder, address, inputs, errors, error, sending, submit }
+  }
+})
+</script>
@@ -0,0 +1,92 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+
+<template>
+  <div class="{{ camelCase .Name }}-list">
+    <table>
+      <thead>
+        <tr>
+          <th v-for="field in fields" :key="field.name" v-text="field.label" />
+        </tr>
+      </thead>
+      <tbody>
+        <tr v-for="(item, index) in items" :key="index">
+          <td v-for="field in fields" :key="field.name" v-text="format(field, item[field.name])" />
+        </tr>
+      </tbody>
+    </table>
+    <p v-if="error" class="error" v-text="error" />
+    <div class="pagination">
+      <button :disabled="loading || keys.length === 0" @click="previous">Previous</button>
+      <span v-text="'Page ' + (keys.length + 1)" />
+      <button :disabled="loading || !nextKey" @click="next">Next</button>
+    </div>
+  </div>
+</template>
+
+<script lang="ts">
+import { defineComponent, onMounted, ref } from 'vue'
+import { useStore } from 'vuex'
+import { Field, format } from './fields'
+
+const fields: Field[] = [{{ range .Fields }}
+  { name: '{{ .Name }}', label: '{{ .Label }}', kind: '{{ .Kind }}', repeated: {{ .Repeated }} },{{ end }}
+]
+
+export default defineComponent({
+  name: '{{ .Name }}List',
+
+  props: {
+    pageSize: {
+      type: Number,
+      default: 10
+    }
+  },
+
+  setup(props) {
+    const $s = useStore()
+
+    const items = ref<any[]>([])
+    const error = ref('')
+    const loading = ref(false)
+
+    // keys of the previous pages and of the current and next pages.
+    const keys = ref<string[]>([])
+    const key = ref('')
+    const nextKey = ref('')
+
+    const load = async (pageKey: string) => {
+      loading.value = true
+      error.value = ''
+      try {
+        const value = await $s.dispatch('{{ .Namespace }}/{{ .ListQuery }}', {
+          params: {},
+          query: { 'pagination.limit': String(props.pageSize), 'pagination.key': pageKey }
+        })
+        items.value = value.{{ .ListField }} ?? []
+        key.value = pageKey
+        nextKey.value = value.pagination?.next_key ?? ''
+      } catch (e) {
+        error.value = e.message
+      } finally {
+        loading.value = false
+      }
+    }
+
+    const next = async () => {
+      keys.value.push(key.value)
+      await load(nextKey.value)
+    }
+
+    const previous = async () => {
+      await load(keys.value.pop() ?? '')
+    }
+
+    // reload reloads the current page, e.g. once an item is created.
+    const reload = () => load(key.value)
+
+    onMounted(() => load(''))
+
+    return { fields, format, items, error, loading, keys, nextKey, next, previous, reload }
+  }
+})
+</script>
@@ -0,0 +1,52 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+
+<template>
+  <div>
+    <p data-testid="address" v-text="address" />
+    <component :is="current.form" v-if="current && address" @created="reload" />
+    <component :is="current.list" v-if="current" ref="list" :page-size="100" />
+    <p v-if="!current" v-text="'Unknown component ' + name" />
+  </div>
+</template>
+
+<script lang="ts">
+import { DirectSecp256k1HdWallet } from '@cosmjs/proto-signing'
+import { computed, defineComponent, onMounted, ref } from 'vue'
+import { useStore } from 'vuex'
+{{ range .Specs }}
+import {{ .Ident }}Form from '{{ .ComponentPath }}/{{ .Name }}Form.vue'
+import {{ .Ident }}List from '{{ .ComponentPath }}/{{ .Name }}List.vue'{{ end }}
+
+const components = {
+{{- range .Specs }}
+  '{{ .Key }}': { form: {{ .Ident }}Form, list: {{ .Ident }}List },
+{{- end }}
+}
+
+export default defineComponent({
+  name: 'Harness',
+
+  setup() {
+    const $s = useStore()
+
+    const name = new URLSearchParams(window.location.search).get('component') ?? ''
+    const current = components[name]
+
+    const address = computed(() => $s.getters['common/wallet/address'])
+    const list = ref()
+
+    const reload = () => list.value?.reload()
+
+    // the wallet is created for each test and funded by the test with the faucet.
+    onMounted(async () => {
+      await $s.dispatch('common/env/init')
+      const wallet = await DirectSecp256k1HdWallet.generate(24, {
+        prefix: $s.getters['common/env/addrPrefix']
+      })
+      await $s.dispatch('common/wallet/connectWithKeplr', wallet)
+    })
+
+    return { name, current, address, list, reload }
+  }
+})
+</script>
@@ -0,0 +1,8 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { createApp } from 'vue'
+
+import store from '../../src/store'
+import Harness from './Harness.vue'
+
+createApp(Harness).use(store).mount('#app')
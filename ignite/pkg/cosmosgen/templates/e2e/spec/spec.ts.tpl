@@ -0,0 +1,20 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { expect, test } from '@playwright/test'
+
+import { openHarness } from './fixtures'
+
+test('creates a {{ .Name }} and lists it', async ({ page }) => {
+  await openHarness(page, '{{ .Key }}')
+
+  const form = page.locator('.{{ camelCase .Name }}-form')
+  const rows = page.locator('.{{ camelCase .Name }}-list tbody tr')
+  await page.waitForLoadState('networkidle')
+  {{ if .Unique }}const unique = 'e2e-' + Date.now(){{ else }}const count = await rows.count(){{ end }}
+{{ range .Inputs }}
+  {{ if .Check }}await form.locator('#{{ .ID }}').check(){{ else }}await form.locator('#{{ .ID }}').fill({{ .Value }}){{ end }}{{ end }}
+  await form.locator('button[type=submit]').click()
+
+  {{ if .Unique }}await expect(rows.filter({ hasText: unique })).toHaveCount(1, { timeout: 30_000 }){{ else }}await expect(rows).toHaveCount(count + 1, { timeout: 30_000 }){{ end }}
+  await expect(form.locator('.error')).toHaveCount(0)
+})
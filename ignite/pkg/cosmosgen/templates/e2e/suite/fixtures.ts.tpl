@@ -0,0 +1,19 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { expect, Page } from '@playwright/test'
+
+export const faucetURL = '{{ .FaucetURL }}'
+
+// openHarness opens the page of the components of a type, e.g. owner.app.blog/Post, and funds
+// the wallet created by the page with the faucet.
+export async function openHarness(page: Page, component: string) {
+  await page.goto('/e2e/harness/index.html?component=' + encodeURIComponent(component))
+
+  const address = page.locator('[data-testid=address]')
+  await expect(address).not.toBeEmpty({ timeout: 30_000 })
+
+  const res = await page.request.post(faucetURL, {
+    data: { address: await address.textContent(), coins: [] }
+  })
+  expect(res.ok(), await res.text()).toBeTruthy()
+}
@@ -0,0 +1,32 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { PlaywrightTestConfig } from '@playwright/test'
+
+const config: PlaywrightTestConfig = {
+  testDir: '.',
+  timeout: 120_000,
+  // the tests share the wallet funds of the faucet and the blocks of the chain.
+  workers: 1,
+  use: {
+    baseURL: 'http://localhost:{{ .FrontendPort }}'
+  },
+  webServer: [
+    {
+      // the chain is reset to list the items created by the tests only.
+      command: 'ignite chain serve --reset-once',
+      cwd: '{{ .AppPath }}',
+      url: '{{ .FaucetURL }}/info',
+      timeout: 600_000,
+      reuseExistingServer: !process.env.CI
+    },
+    {
+      command: 'npm run dev -- --port {{ .FrontendPort }} --strictPort',
+      cwd: '..',
+      port: {{ .FrontendPort }},
+      timeout: 120_000,
+      reuseExistingServer: !process.env.CI
+    }
+  ]
+}
+
+export default config
@@ -0,0 +1,46 @@
+{{ .Header }}
+
+// Package {{ .Package }} is the Go client of the chain, it queries the modules of the chain and
+// broadcasts their messages with cosmosclient.
+package {{ .Package }}
+
+import (
+	"context"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"{{ range .Modules }}{{ if .Msgs }}
+	{{ .TypesAlias }} "{{ .TypesImport }}"{{ end }}{{ end }}
+)
+
+// Client is the client of the chain, it embeds cosmosclient.Client.
+type Client struct {
+	cosmosclient.Client
+	{{ range .Modules }}
+	// {{ .Name }} is the client of the {{ .Name }} module.
+	{{ .Name }} {{ .Name }}Client
+	{{ end }}
+}
+
+// New creates a new client of the chain, the messages of the modules of the chain are registered
+// in the codec of the client.
+func New(ctx context.Context, options ...cosmosclient.Option) (Client, error) {
+	options = append([]cosmosclient.Option{
+		cosmosclient.WithRegisterInterfaces({{ range .Modules }}{{ if .Msgs }}
+			{{ .TypesAlias }}.RegisterInterfaces,{{ end }}{{ end }}
+		),
+	}, options...)
+
+	c, err := cosmosclient.New(ctx, options...)
+	if err != nil {
+		return Client{}, err
+	}
+	return NewFromClient(c), nil
+}
+
+// NewFromClient creates a new client of the chain from a cosmosclient.Client, the messages of the
+// modules of the chain must be registered with cosmosclient.WithRegisterInterfaces.
+func NewFromClient(c cosmosclient.Client) Client {
+	return Client{
+		Client: c,{{ range .Modules }}
+		{{ .Name }}: new{{ .Name }}Client(c),{{ end }}
+	}
+}
@@ -0,0 +1,53 @@
+{{ .Header }}
+
+package {{ .Package }}
+
+import (
+	{{ if .Queries }}"context"
+
+	{{ end }}{{ if .Msgs }}sdk "github.com/cosmos/cosmos-sdk/types"
+	{{ end }}"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	{{ .TypesAlias }} "{{ .TypesImport }}"
+)
+
+// {{ .Name }}Client is the client of the {{ .Name }} module.
+type {{ .Name }}Client struct {
+	client cosmosclient.Client{{ if .Queries }}
+	query  {{ .TypesAlias }}.QueryClient{{ end }}
+}
+
+func new{{ .Name }}Client(c cosmosclient.Client) {{ .Name }}Client {
+	return {{ .Name }}Client{
+		client: c,{{ if .Queries }}
+		query:  {{ .TypesAlias }}.NewQueryClient(c.QueryConn()),{{ end }}
+	}
+}
+{{ $alias := .TypesAlias }}{{ $name := .Name }}
+{{ range .Queries }}
+// Query{{ .Name }} sends the {{ .Name }} query of the {{ $name }} module.
+func (c {{ $name }}Client) Query{{ .Name }}(ctx context.Context, req *{{ $alias }}.{{ .Request }}) (*{{ $alias }}.{{ .Response }}, error) {
+	return c.query.{{ .Name }}(ctx, req)
+}
+{{ end }}
+{{ range .Msgs }}
+// {{ .Type }} returns the {{ .Type }} message of the {{ $name }} module{{ if .Signer }} signed by the account{{ end }}.
+func (c {{ $name }}Client) {{ .Type }}(accountName string, msg {{ $alias }}.{{ .Type }}) (*{{ $alias }}.{{ .Type }}, error) {
+	{{- if .Signer }}
+	account, err := c.client.Account(accountName)
+	if err != nil {
+		return nil, err
+	}
+	msg.{{ .Signer }} = account.Address(c.client.AddressPrefix())
+	{{- end }}
+	return &msg, nil
+}
+
+// {{ .Name }} broadcasts the {{ .Type }} message of the {{ $name }} module signed by the account.
+func (c {{ $name }}Client) {{ .Name }}(accountName string, msg {{ $alias }}.{{ .Type }}, options ...cosmosclient.BroadcastOption) (cosmosclient.Response, error) {
+	m, err := c.{{ .Type }}(accountName, msg)
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+	return c.client.BroadcastTxWithOptions(accountName, []sdk.Msg{m}, options...)
+}
+{{ end }}
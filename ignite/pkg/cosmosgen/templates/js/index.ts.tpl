@@ -2,19 +2,33 @@
 
 import { StdFee } from "@cosmjs/launchpad";
 import { SigningStargateClient } from "@cosmjs/stargate";
-import { Registry, OfflineSigner, EncodeObject, DirectSecp256k1HdWallet } from "@cosmjs/proto-signing";
-import { Api } from "./rest";
-{{ range .Module.Msgs }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
-{{ end }}
-
-const types = [
-  {{ range .Module.Msgs }}["/{{ .URI }}", {{ .Name }}],
-  {{ end }}
-];
+import { OfflineSigner, EncodeObject, DirectSecp256k1HdWallet } from "@cosmjs/proto-signing";
+{{ if eq .AddressCodec "hex" }}import { fromBech32, fromHex, toBech32, toHex } from "@cosmjs/encoding";
+{{ end }}import { Api } from "./rest";
+import { aminoTypes, makeAminoSignDoc, makeDirectSignDoc, msgs, registry, signOffline } from "./signing";
+{{ range .Module.Pkg.Services }}{{ if eq .Name "Query" }}import { QueryClientImpl } from "./types/{{ resolveFile .Path }}";
+{{ end }}{{ end }}
 export const MissingWalletError = new Error("wallet is required");
 
-export const registry = new Registry(<any>types);
+export { registry };
+
+// addressCodec is the codec of the account addresses of the chain, the addresses of the wallets are
+// bech32 encoded and must be encoded with it in the messages.
+export const addressCodec = "{{ .AddressCodec }}";
+{{ if eq .AddressCodec "hex" }}
+// encodeAddress encodes the bech32 address of a wallet with the codec of the chain.
+export const encodeAddress = (address: string): string => "0x" + toHex(fromBech32(address).data);
+
+// decodeAddress decodes an address encoded with the codec of the chain to a bech32 address with prefix.
+export const decodeAddress = (address: string, prefix: string): string =>
+  toBech32(prefix, fromHex(address.replace(/^0x/i, "")));
+{{ else }}
+// encodeAddress encodes the bech32 address of a wallet with the codec of the chain.
+export const encodeAddress = (address: string): string => address;
 
+// decodeAddress decodes an address encoded with the codec of the chain to a bech32 address with prefix.
+export const decodeAddress = (address: string, prefix: string): string => address;
+{{ end }}
 const defaultFee = {
   amount: [],
   gas: "200000",
@@ -33,16 +47,17 @@ const txClient = async (wallet: OfflineSigner, { addr: addr }: TxClientOptions =
   if (!wallet) throw MissingWalletError;
   let client;
   if (addr) {
-    client = await SigningStargateClient.connectWithSigner(addr, wallet, { registry });
+    client = await SigningStargateClient.connectWithSigner(addr, wallet, { registry, aminoTypes });
   }else{
-    client = await SigningStargateClient.offline( wallet, { registry });
+    client = await SigningStargateClient.offline( wallet, { registry, aminoTypes });
   }
   const { address } = (await wallet.getAccounts())[0];
 
   return {
+    // address is the address of the wallet encoded with the codec of the chain, e.g. as the creator of the messages.
+    address: encodeAddress(address),
     signAndBroadcast: (msgs: EncodeObject[], { fee, memo }: SignAndBroadcastOptions = {fee: defaultFee, memo: ""}) => client.signAndBroadcast(address, msgs, fee,memo),
-    {{ range .Module.Msgs }}{{ camelCase .Name }}: (data: {{ .Name }}): EncodeObject => ({ typeUrl: "/{{ .URI }}", value: {{ .Name }}.fromPartial( data ) }),
-    {{ end }}
+    ...msgs,
   };
 };
 
@@ -53,8 +68,71 @@ interface QueryClientOptions {
 const queryClient = async ({ addr: addr }: QueryClientOptions = { addr: "http://localhost:1317" }) => {
   return new Api({ baseUrl: addr });
 };
+{{ range .Module.HTTPQueries }}{{ if eq .FullName "QueryBaseFee" }}
+// estimateFee returns the fee paying the gas at the base fee of the fee market of the chain. The base
+// fee is multiplied by multiplier to keep paying it when it increases in the next blocks.
+const estimateFee = async (gas: number, multiplier: number = 1.2, { addr: addr }: QueryClientOptions = { addr: "http://localhost:1317" }): Promise<StdFee> => {
+  const { data } = await (await queryClient({ addr })).queryBaseFee();
+  const { denom, amount } = data.base_fee;
+  const fee = Math.ceil(parseFloat(amount) * multiplier * gas);
+  return { amount: [{ denom, amount: fee.toString() }], gas: gas.toString() };
+};
+{{ end }}{{ end }}{{ range .Module.Pkg.Services }}{{ if eq .Name "Query" }}
+interface GrpcWebClientOptions {
+  addr: string
+}
+
+// grpcWebRpc sends the queries to the gRPC-web endpoint of the node, so browsers can query the
+// gRPC services of the chain without a proxy.
+const grpcWebRpc = (addr: string) => ({
+  request: async (service: string, method: string, data: Uint8Array): Promise<Uint8Array> => {
+    // a gRPC-web message is prefixed by a flag byte and its length.
+    const body = new Uint8Array(5 + data.length);
+    new DataView(body.buffer).setUint32(1, data.length);
+    body.set(data, 5);
+
+    const res = await fetch(`${addr}/${service}/${method}`, {
+      method: "POST",
+      headers: { "content-type": "application/grpc-web+proto", "x-grpc-web": "1" },
+      body,
+    });
+    if (!res.ok) throw new Error(`gRPC-web request failed: ${res.status} ${res.statusText}`);
 
+    // the response holds the message frame followed by the trailers frame.
+    const frames = new Uint8Array(await res.arrayBuffer());
+    let message = new Uint8Array();
+    let trailers = "";
+    for (let i = 0; i + 5 <= frames.length; ) {
+      const length = new DataView(frames.buffer, frames.byteOffset + i + 1, 4).getUint32(0);
+      const frame = frames.subarray(i + 5, i + 5 + length);
+      if (frames[i] & 0x80) {
+        trailers = new TextDecoder().decode(frame);
+      } else {
+        message = frame;
+      }
+      i += 5 + length;
+    }
+
+    const trailer = (name: string) => res.headers.get(name) || (trailers.match(new RegExp(`${name}:\\s*(.*)`, "i")) || [])[1] || "";
+    const status = trailer("grpc-status").trim();
+    if (status && status !== "0") {
+      throw new Error(`gRPC-web error ${status}: ${decodeURIComponent(trailer("grpc-message").trim())}`);
+    }
+    return message;
+  },
+});
+
+const grpcWebClient = async ({ addr: addr }: GrpcWebClientOptions = { addr: "http://localhost:9091" }) => {
+  return new QueryClientImpl(grpcWebRpc(addr));
+};
+{{ end }}{{ end }}
 export {
   txClient,
-  queryClient,
+  msgs,
+  signOffline,
+  makeDirectSignDoc,
+  makeAminoSignDoc,
+  queryClient,{{ range .Module.HTTPQueries }}{{ if eq .FullName "QueryBaseFee" }}
+  estimateFee,{{ end }}{{ end }}{{ range .Module.Pkg.Services }}{{ if eq .Name "Query" }}
+  grpcWebClient,{{ end }}{{ end }}
 };
@@ -0,0 +1,52 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// Regression tests of the proto3 JSON encoding of the module messages.
+// Run them with `npx ts-node json.spec.ts`.
+import { fromProto3JSON, jsonTypes, toProto3JSON } from "./json";
+
+// 2^53 + 1 can't be represented by a JS number, it is corrupted if decoded as one.
+const int64Sample = "9007199254740993";
+
+const tests: [string, string[]][] = [
+  {{ range .Module.Msgs }}["/{{ .URI }}", [{{ range $i, $field := .Int64Fields }}{{ if $i }}, {{ end }}"{{ $field }}"{{ end }}]],
+  {{ end }}{{ range .Module.Types }}["/{{ $.Module.Pkg.Name }}.{{ .Name }}", [{{ range $i, $field := .Int64Fields }}{{ if $i }}, {{ end }}"{{ $field }}"{{ end }}]],
+  {{ end }}
+];
+
+function check(typeUrl: string, int64Fields: string[]) {
+  const sample: Record<string, string> = {};
+  for (const field of int64Fields) {
+    sample[field] = int64Sample;
+  }
+
+  // 64-bit integers keep their precision and are encoded as strings.
+  const message = fromProto3JSON(typeUrl, sample);
+  const json = toProto3JSON(typeUrl, message);
+  for (const field of int64Fields) {
+    if (json[field] !== int64Sample) {
+      throw new Error(`${field}: expected "${int64Sample}", got ${JSON.stringify(json[field])}`);
+    }
+  }
+
+  // the binary encoding is unchanged by a JSON round trip.
+  const type = jsonTypes[typeUrl];
+  const encoded = type.encode(message).finish();
+  const reencoded = type.encode(fromProto3JSON(typeUrl, json)).finish();
+  if (encoded.length !== reencoded.length || encoded.some((byte, i) => byte !== reencoded[i])) {
+    throw new Error("binary encoding changed after a JSON round trip");
+  }
+}
+
+let failed = 0;
+for (const [typeUrl, int64Fields] of tests) {
+  try {
+    check(typeUrl, int64Fields);
+    console.log(`ok   ${typeUrl}`);
+  } catch (e) {
+    failed++;
+    console.error(`FAIL ${typeUrl}: ${(e as Error).message}`);
+  }
+}
+if (failed > 0) {
+  process.exitCode = 1;
+}
@@ -0,0 +1,104 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// Proto3 JSON encoding of the module messages, the encoding used by the gRPC gateway of the chain:
+// 64-bit integers are strings, enums are names and Any messages embed the fields of their message next to "@type".
+{{ range .Module.Msgs }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}{{ range .Module.Types }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}
+export interface JSONType {
+  encode(message: any): { finish(): Uint8Array };
+  decode(input: Uint8Array): any;
+  fromJSON(object: any): any;
+  toJSON(message: any): unknown;
+}
+
+export const jsonTypes: Record<string, JSONType> = {
+  {{ range .Module.Msgs }}"/{{ .URI }}": {{ .Name }},
+  {{ end }}{{ range .Module.Types }}"/{{ $.Module.Pkg.Name }}.{{ .Name }}": {{ .Name }},
+  {{ end }}
+};
+
+// registerJSONType registers a message of another module that can be packed in an Any.
+export function registerJSONType(typeUrl: string, type: JSONType) {
+  jsonTypes[typeUrl] = type;
+}
+
+// toProto3JSON encodes the message of the type to proto3 JSON.
+export function toProto3JSON(typeUrl: string, message: any): any {
+  return expandAny(getJSONType(typeUrl).toJSON(message));
+}
+
+// fromProto3JSON decodes the message of the type from proto3 JSON.
+export function fromProto3JSON(typeUrl: string, object: any): any {
+  return getJSONType(typeUrl).fromJSON(packAny(object));
+}
+
+function getJSONType(typeUrl: string): JSONType {
+  const type = jsonTypes[typeUrl];
+  if (!type) {
+    throw new Error(`unknown type ${typeUrl}, register it with registerJSONType`);
+  }
+  return type;
+}
+
+// expandAny replaces the Any messages encoded by ts-proto as { type_url, value } with their proto3 JSON.
+function expandAny(json: any): any {
+  if (Array.isArray(json)) {
+    return json.map(expandAny);
+  }
+  if (json === null || typeof json !== "object") {
+    return json;
+  }
+  if (isAny(json) && jsonTypes[json.type_url]) {
+    const type = jsonTypes[json.type_url];
+    const message = type.decode(bytesFromBase64(json.value ?? ""));
+    return { "@type": json.type_url, ...expandAny(type.toJSON(message)) };
+  }
+  const expanded: Record<string, any> = {};
+  for (const key of Object.keys(json)) {
+    expanded[key] = expandAny(json[key]);
+  }
+  return expanded;
+}
+
+// packAny replaces the proto3 JSON Any messages with their ts-proto encoding { type_url, value }.
+function packAny(object: any): any {
+  if (Array.isArray(object)) {
+    return object.map(packAny);
+  }
+  if (object === null || typeof object !== "object") {
+    return object;
+  }
+  if ("@type" in object) {
+    const { "@type": typeUrl, ...fields } = object;
+    const type = getJSONType(typeUrl);
+    const value = type.encode(type.fromJSON(packAny(fields))).finish();
+    return { type_url: typeUrl, value: base64FromBytes(value) };
+  }
+  const packed: Record<string, any> = {};
+  for (const key of Object.keys(object)) {
+    packed[key] = packAny(object[key]);
+  }
+  return packed;
+}
+
+function isAny(json: Record<string, any>): boolean {
+  return "type_url" in json && Object.keys(json).every((key) => key === "type_url" || key === "value");
+}
+
+function bytesFromBase64(b64: string): Uint8Array {
+  const bin = globalThis.atob(b64);
+  const bytes = new Uint8Array(bin.length);
+  for (let i = 0; i < bin.length; ++i) {
+    bytes[i] = bin.charCodeAt(i);
+  }
+  return bytes;
+}
+
+function base64FromBytes(bytes: Uint8Array): string {
+  let bin = "";
+  for (const byte of bytes) {
+    bin += String.fromCharCode(byte);
+  }
+  return globalThis.btoa(bin);
+}
@@ -0,0 +1,110 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// Composition and offline signing of the txs of the module messages: the txs are built and signed
+// without a connection to the node, e.g. to sign them with a Ledger or on an air-gapped machine and
+// broadcast them later with StargateClient.broadcastTx.
+import { StdFee } from "@cosmjs/launchpad";
+import { encodeSecp256k1Pubkey, makeSignDoc as makeStdSignDoc, OfflineAminoSigner, StdSignDoc } from "@cosmjs/amino";
+import { encodePubkey, EncodeObject, isOfflineDirectSigner, makeAuthInfoBytes, makeSignDoc, OfflineSigner, Registry } from "@cosmjs/proto-signing";
+import { AminoTypes, SignerData, SigningStargateClient } from "@cosmjs/stargate";
+import { SignDoc, TxRaw } from "cosmjs-types/cosmos/tx/v1beta1/tx";
+{{ range .Module.Msgs }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}
+const types = [
+  {{ range .Module.Msgs }}["/{{ .URI }}", {{ .Name }}],
+  {{ end }}
+];
+
+export const registry = new Registry(<any>types);
+
+// msgs composes the messages of the module, without a wallet or a connection to the node.
+export const msgs = {
+  {{ range .Module.Msgs }}{{ camelCase .Name }}: (data: {{ .Name }}): EncodeObject => ({ typeUrl: "/{{ .URI }}", value: {{ .Name }}.fromPartial( data ) }),
+  {{ end }}
+};
+
+// omitEmpty removes the empty values of the amino JSON of a message, like the legacy amino encoding
+// of the chain does. The 64-bit integers of int64Fields are strings, they're empty when zero.
+const omitEmpty = (value: any, int64Fields: string[] = []): any => {
+  if (Array.isArray(value)) return value.map((v) => omitEmpty(v));
+  if (value === null || typeof value !== "object") return value;
+  const json: any = {};
+  for (const [key, v] of Object.entries(value)) {
+    if (v === undefined || v === null || v === "" || v === false || v === 0) continue;
+    if (Array.isArray(v) && v.length === 0) continue;
+    if (v === "0" && int64Fields.includes(key)) continue;
+    json[key] = omitEmpty(v);
+  }
+  return json;
+};
+
+// aminoConverters convert the module messages to their legacy amino JSON, signed by the wallets
+// signing with amino only, like Ledger.
+export const aminoConverters = {
+  {{ if .AppModule }}{{ range .Module.Msgs }}"/{{ .URI }}": {
+    aminoType: "{{ $.Module.Name }}/{{ trimPrefix .Name "Msg" }}",
+    toAmino: (value: {{ .Name }}) => omitEmpty({{ .Name }}.toJSON({{ .Name }}.fromPartial(value)), [{{ range $i, $field := .Int64Fields }}{{ if $i }}, {{ end }}"{{ $field }}"{{ end }}]),
+    fromAmino: (value: any): {{ .Name }} => {{ .Name }}.fromJSON(value),
+  },
+  {{ end }}{{ end }}
+};
+
+export const aminoTypes = new AminoTypes({ prefix: "cosmos", additions: aminoConverters });
+
+// SignMode is the mode signing the txs: direct signs their proto encoding and amino their legacy
+// amino JSON.
+export type SignMode = "direct" | "amino";
+
+export interface SignOfflineOptions {
+  fee: StdFee,
+  memo?: string,
+  // signerData are the account number and the sequence of the signer and the ID of the chain, they're
+  // queried from the chain beforehand.
+  signerData: SignerData,
+  // mode is the sign mode, the mode of the wallet by default: direct, or amino for the wallets signing
+  // with amino only.
+  mode?: SignMode,
+}
+
+// aminoSigner returns the wallet signing with amino only.
+const aminoSigner = (wallet: OfflineSigner): OfflineAminoSigner => {
+  const signer = wallet as OfflineAminoSigner;
+  if (!signer.signAmino) throw new Error("the wallet can't sign in amino mode");
+  return { getAccounts: () => signer.getAccounts(), signAmino: (address, doc) => signer.signAmino(address, doc) };
+};
+
+// signOffline signs a tx of the messages with the first account of the wallet and returns the bytes of
+// the signed tx, ready to be broadcasted.
+export const signOffline = async (wallet: OfflineSigner, messages: EncodeObject[], { fee, memo = "", signerData, mode }: SignOfflineOptions): Promise<Uint8Array> => {
+  if (mode === "direct" && !isOfflineDirectSigner(wallet)) throw new Error("the wallet can't sign in direct mode");
+  const signer = mode === "amino" ? aminoSigner(wallet) : wallet;
+  const client = await SigningStargateClient.offline(signer, { registry, aminoTypes });
+  const { address } = (await wallet.getAccounts())[0];
+  const txRaw = await client.sign(address, messages, fee, memo, signerData);
+  return TxRaw.encode(txRaw).finish();
+};
+
+// makeDirectSignDoc returns the doc of a tx of the messages signed in direct mode by the account of
+// the secp256k1 public key, e.g. to sign it with another signer.
+export const makeDirectSignDoc = (pubkey: Uint8Array, messages: EncodeObject[], { fee, memo = "", signerData }: SignOfflineOptions): SignDoc => {
+  const bodyBytes = registry.encode({ typeUrl: "/cosmos.tx.v1beta1.TxBody", value: { messages, memo } });
+  const authInfoBytes = makeAuthInfoBytes(
+    [{ pubkey: encodePubkey(encodeSecp256k1Pubkey(pubkey)), sequence: signerData.sequence }],
+    fee.amount,
+    parseInt(fee.gas, 10),
+  );
+  return makeSignDoc(bodyBytes, authInfoBytes, signerData.chainId, signerData.accountNumber);
+};
+
+// makeAminoSignDoc returns the doc of a tx of the messages signed in amino mode, e.g. to sign it with
+// a Ledger.
+export const makeAminoSignDoc = (messages: EncodeObject[], { fee, memo = "", signerData }: SignOfflineOptions): StdSignDoc => {
+  return makeStdSignDoc(
+    messages.map((msg) => aminoTypes.toAmino(msg)),
+    fee,
+    signerData.chainId,
+    memo,
+    signerData.accountNumber,
+    signerData.sequence,
+  );
+};
@@ -0,0 +1,185 @@
+{{ .Header }}
+
+// Package {{ .Package }} is the data-access layer of the types stored by the {{ .Module }} module.
+// The business logic is written against the repositories of the types, they're backed by the
+// store of the module in the keeper and by memory in the unit tests.
+package {{ .Package }}
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Repository stores the values of type T by their key of type K.
+type Repository[K comparable, T any] interface {
+	// Get returns the value of the key, it's false when the value isn't found.
+	Get(ctx sdk.Context, key K) (T, bool)
+
+	// Set stores the value by its key.
+	Set(ctx sdk.Context, value T)
+
+	// Remove removes the value of the key.
+	Remove(ctx sdk.Context, key K)
+
+	// Iterate calls fn with the values in the order of their keys until it returns true.
+	Iterate(ctx sdk.Context, fn func(value T) (stop bool))
+
+	// All returns the values in the order of their keys.
+	All(ctx sdk.Context) []T
+}
+
+// ByIndex returns the values of the repository matching the index, e.g. the values with a given
+// index field, in the order of their keys.
+func ByIndex[K comparable, T any](ctx sdk.Context, r Repository[K, T], match func(value T) bool) []T {
+	var values []T
+	r.Iterate(ctx, func(value T) bool {
+		if match(value) {
+			values = append(values, value)
+		}
+		return false
+	})
+	return values
+}
+
+// protoMessage is a pointer to a proto message of type T.
+type protoMessage[T any] interface {
+	*T
+	codec.ProtoMarshaler
+}
+
+// keyCodec encodes the keys of the values of type T.
+type keyCodec[K comparable, T any] struct {
+	// keyOf returns the key of the value.
+	keyOf func(value T) K
+
+	// encode returns the bytes of the key in the store.
+	encode func(key K) []byte
+}
+
+// Store is the repository of the values of type T in the store of the module.
+type Store[K comparable, T any, PT protoMessage[T]] struct {
+	keyCodec[K, T]
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+	prefix   []byte
+}
+
+func newStore[K comparable, T any, PT protoMessage[T]](
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	prefix []byte,
+	keys keyCodec[K, T],
+) Store[K, T, PT] {
+	return Store[K, T, PT]{
+		keyCodec: keys,
+		storeKey: storeKey,
+		cdc:      cdc,
+		prefix:   prefix,
+	}
+}
+
+func (s Store[K, T, PT]) store(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(s.storeKey), s.prefix)
+}
+
+// Get returns the value of the key, it's false when the value isn't found.
+func (s Store[K, T, PT]) Get(ctx sdk.Context, key K) (value T, found bool) {
+	b := s.store(ctx).Get(s.encode(key))
+	if b == nil {
+		return value, false
+	}
+	s.cdc.MustUnmarshal(b, PT(&value))
+	return value, true
+}
+
+// Set stores the value by its key.
+func (s Store[K, T, PT]) Set(ctx sdk.Context, value T) {
+	s.store(ctx).Set(s.encode(s.keyOf(value)), s.cdc.MustMarshal(PT(&value)))
+}
+
+// Remove removes the value of the key.
+func (s Store[K, T, PT]) Remove(ctx sdk.Context, key K) {
+	s.store(ctx).Delete(s.encode(key))
+}
+
+// Iterate calls fn with the values in the order of their keys until it returns true.
+func (s Store[K, T, PT]) Iterate(ctx sdk.Context, fn func(value T) (stop bool)) {
+	iterator := s.store(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var value T
+		s.cdc.MustUnmarshal(iterator.Value(), PT(&value))
+		if fn(value) {
+			return
+		}
+	}
+}
+
+// All returns the values in the order of their keys.
+func (s Store[K, T, PT]) All(ctx sdk.Context) []T {
+	return all[K, T](ctx, s)
+}
+
+// Memory is the repository of the values of type T in memory, the context is ignored.
+// It's meant to unit test the business logic without setting up the keeper.
+type Memory[K comparable, T any] struct {
+	keyCodec[K, T]
+	values map[string]T
+}
+
+func newMemory[K comparable, T any](keys keyCodec[K, T]) *Memory[K, T] {
+	return &Memory[K, T]{
+		keyCodec: keys,
+		values:   make(map[string]T),
+	}
+}
+
+// Get returns the value of the key, it's false when the value isn't found.
+func (m *Memory[K, T]) Get(_ sdk.Context, key K) (T, bool) {
+	value, found := m.values[string(m.encode(key))]
+	return value, found
+}
+
+// Set stores the value by its key.
+func (m *Memory[K, T]) Set(_ sdk.Context, value T) {
+	m.values[string(m.encode(m.keyOf(value)))] = value
+}
+
+// Remove removes the value of the key.
+func (m *Memory[K, T]) Remove(_ sdk.Context, key K) {
+	delete(m.values, string(m.encode(key)))
+}
+
+// Iterate calls fn with the values in the order of their keys until it returns true, the keys are
+// ordered by their bytes such as in the store.
+func (m *Memory[K, T]) Iterate(_ sdk.Context, fn func(value T) (stop bool)) {
+	keys := make([]string, 0, len(m.values))
+	for key := range m.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if fn(m.values[key]) {
+			return
+		}
+	}
+}
+
+// All returns the values in the order of their keys.
+func (m *Memory[K, T]) All(ctx sdk.Context) []T {
+	return all[K, T](ctx, m)
+}
+
+func all[K comparable, T any](ctx sdk.Context, r Repository[K, T]) []T {
+	var values []T
+	r.Iterate(ctx, func(value T) bool {
+		values = append(values, value)
+		return false
+	})
+	return values
+}
@@ -0,0 +1,74 @@
+{{ .Header }}
+
+package {{ .Package }}
+
+import (
+	{{ if not .Indexes }}"encoding/binary"
+
+	{{ end }}"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"{{ .TypesImport }}"
+)
+{{ $name := .Name }}
+{{- if .Indexes }}
+// {{ .Name }}Key is the key of a {{ .Name }}, made of its index fields.
+type {{ .Name }}Key struct {
+	{{- range .Indexes }}
+	{{ .Name }} {{ .Type }}
+	{{- end }}
+}
+
+// {{ .Name }}Repository stores the {{ .Name }} values by their index fields.
+type {{ .Name }}Repository = Repository[{{ .Name }}Key, types.{{ .Name }}]
+
+var {{ .Var }}Keys = keyCodec[{{ .Name }}Key, types.{{ .Name }}]{
+	keyOf: func(value types.{{ .Name }}) {{ .Name }}Key {
+		return {{ .Name }}Key{
+			{{- range .Indexes }}
+			{{ .Name }}: value.{{ .Name }},
+			{{- end }}
+		}
+	},
+	encode: func(key {{ .Name }}Key) []byte {
+		return types.{{ .Name }}Key(
+			{{- range .Indexes }}
+			key.{{ .Name }},
+			{{- end }}
+		)
+	},
+}
+{{- else }}
+// {{ .Name }}Repository stores the {{ .Name }} values by their id.
+type {{ .Name }}Repository = Repository[uint64, types.{{ .Name }}]
+
+var {{ .Var }}Keys = keyCodec[uint64, types.{{ .Name }}]{
+	keyOf: func(value types.{{ .Name }}) uint64 {
+		return value.Id
+	},
+	encode: func(id uint64) []byte {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, id)
+		return bz
+	},
+}
+{{- end }}
+
+// New{{ .Name }}Store returns the repository of the {{ .Name }} values in the store of the module,
+// the values are shared with the keeper.
+func New{{ .Name }}Store(storeKey sdk.StoreKey, cdc codec.BinaryCodec) {{ .Name }}Repository {
+	return newStore[{{ if .Indexes }}{{ .Name }}Key{{ else }}uint64{{ end }}, types.{{ .Name }}](storeKey, cdc, types.KeyPrefix(types.{{ .Prefix }}), {{ .Var }}Keys)
+}
+
+// NewMemory{{ .Name }}Repository returns an empty repository of the {{ .Name }} values in memory.
+func NewMemory{{ .Name }}Repository() {{ .Name }}Repository {
+	return newMemory({{ .Var }}Keys)
+}
+{{ range .Indexes }}
+// {{ $name }}By{{ .Name }} returns the {{ $name }} values of the repository with the {{ .Name }} index.
+func {{ $name }}By{{ .Name }}(ctx sdk.Context, r {{ $name }}Repository, {{ .Arg }} {{ .Type }}) []types.{{ $name }} {
+	return ByIndex(ctx, r, func(value types.{{ $name }}) bool {
+		return value.{{ .Name }} == {{ .Arg }}
+	})
+}
+{{ end }}
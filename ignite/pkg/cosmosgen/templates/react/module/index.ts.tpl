@@ -0,0 +1,57 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { useMutation, useQuery, useQueryClient, UseQueryOptions } from '@tanstack/react-query'
+import { StdFee } from '@cosmjs/launchpad'
+import { queryClient, txClient } from '{{ .ClientPath }}'
+{{ range .Msgs }}import { {{ .Type }} } from '{{ $.ClientPath }}/types/{{ resolveFile .FilePath }}'
+{{ end }}import { useChain } from '{{ .RootPath }}/context'
+
+// QueryOptions are the options of the hooks of the queries, e.g. enabled or refetchInterval.
+export type QueryOptions<T = any> = Omit<UseQueryOptions<T>, 'queryKey' | 'queryFn'>
+
+// TxVariables are the variables of the hooks of the messages, the fee and the memo are optional.
+export interface TxVariables<T> {
+  value: T
+  fee?: StdFee
+  memo?: string
+}
+
+// namespace prefixes the keys of the queries of the module, the queries are refetched once a
+// message of the module is broadcast.
+const namespace = '{{ .Namespace }}'
+{{ range .Queries }}
+export function use{{ .Name }}({{ range .Params }}{{ . }}: string, {{ end }}{{ if .HasQuery }}query?: Record<string, any>, {{ end }}options?: QueryOptions) {
+  const { apiAddr } = useChain()
+  return useQuery({
+    queryKey: [namespace, '{{ .Name }}', {{ range .Params }}{{ . }}, {{ end }}{{ if .HasQuery }}query, {{ end }}apiAddr],
+    queryFn: async () => {
+      const client = await queryClient(apiAddr ? { addr: apiAddr } : undefined)
+      const { data } = await client.{{ .Method }}({{ range $i, $p := .Params }}{{ if $i }}, {{ end }}{{ $p }}{{ end }}{{ if .HasQuery }}{{ if .Params }}, {{ end }}query as any{{ end }})
+      return data
+    },
+    ...options
+  })
+}
+{{ end }}{{ range .Msgs }}
+export function useTx{{ .Name }}() {
+  const { wallet, rpcAddr } = useChain()
+  const cache = useQueryClient()
+  return useMutation({
+    mutationFn: async ({ value, fee, memo }: TxVariables<{{ if .Signer }}Omit<{{ .Type }}, '{{ .Signer }}'>{{ else }}{{ .Type }}{{ end }}>) => {
+      const client = await txClient(wallet, rpcAddr ? { addr: rpcAddr } : undefined)
+{{- if .Signer }}
+      const [account] = await wallet.getAccounts()
+      const msg = client.{{ .Method }}({ ...value, {{ .Signer }}: account.address } as {{ .Type }})
+{{- else }}
+      const msg = client.{{ .Method }}(value)
+{{- end }}
+      const result = await client.signAndBroadcast([msg], fee ? { fee, memo } : undefined)
+      if (result.code) {
+        throw new Error(result.rawLog)
+      }
+      return result
+    },
+    onSuccess: () => cache.invalidateQueries({ queryKey: [namespace] })
+  })
+}
+{{ end }}
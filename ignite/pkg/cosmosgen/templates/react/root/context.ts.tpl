@@ -0,0 +1,27 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { createContext, useContext } from 'react'
+import { OfflineSigner } from '@cosmjs/proto-signing'
+
+export interface Chain {
+  // apiAddr is the address of the API of the node.
+  apiAddr?: string
+  // rpcAddr is the address of the RPC of the node.
+  rpcAddr?: string
+  // wallet signs the messages.
+  wallet?: OfflineSigner
+}
+
+export const ChainContext = createContext<Chain>({})
+
+// ChainProvider provides the addresses of the node and the wallet to the hooks, they are used
+// inside the QueryClientProvider of React Query:
+//
+//   <QueryClientProvider client={queryClient}>
+//     <ChainProvider value={{"{{"}} apiAddr, rpcAddr, wallet {{"}}"}}>
+//       <App />
+//     </ChainProvider>
+//   </QueryClientProvider>
+export const ChainProvider = ChainContext.Provider
+
+export const useChain = () => useContext(ChainContext)
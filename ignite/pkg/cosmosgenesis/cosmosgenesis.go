@@ -0,0 +1,240 @@
+// Package cosmosgenesis checks a genesis file against deterministic rules going beyond the
+// validation of the modules, such as the consistency of the supply with the balances, the pools
+// of the module accounts, the signatures of the gentxs and the sanity of the params.
+//
+// The rules only depend on the genesis file, they detect the genesis files that pass the
+// validate-genesis command of the chain but fail, or misbehave, when the chain starts.
+package cosmosgenesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+)
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityError is the severity of the findings preventing the chain from starting, or breaking
+	// an invariant of the chain.
+	SeverityError Severity = "error"
+
+	// SeverityWarning is the severity of the findings that are likely mistakes.
+	SeverityWarning Severity = "warning"
+)
+
+// The rules checked in the genesis.
+const (
+	RuleSupply        = "supply"
+	RuleModuleAccount = "module-account"
+	RuleGentx         = "gentx"
+	RuleDenomMetadata = "denom-metadata"
+	RuleParams        = "params"
+)
+
+// Finding is a rule broken by the genesis.
+type Finding struct {
+	// Rule is the broken rule, e.g. supply.
+	Rule string `json:"rule"`
+
+	// Severity is the severity of the finding.
+	Severity Severity `json:"severity"`
+
+	// Path is the path of the field of the genesis breaking the rule, e.g. app_state.bank.supply.
+	Path string `json:"path"`
+
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+// Findings are the findings of the checks of a genesis.
+type Findings []Finding
+
+// HasErrors returns true when a finding is an error.
+func (f Findings) HasErrors() bool {
+	for _, finding := range f {
+		if finding.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	// genesis holds the fields of the genesis file checked by the rules.
+	genesis struct {
+		ChainID  string `json:"chain_id"`
+		AppState struct {
+			Auth struct {
+				Accounts []account `json:"accounts"`
+			} `json:"auth"`
+			Bank    json.RawMessage `json:"bank"`
+			Staking *stakingGenesis `json:"staking"`
+			Mint    *mintGenesis    `json:"mint"`
+			Gov     *govGenesis     `json:"gov"`
+			Crisis  *crisisGenesis  `json:"crisis"`
+			Genutil struct {
+				GenTxs []json.RawMessage `json:"gen_txs"`
+			} `json:"genutil"`
+			IBC *ibcGenesis `json:"ibc"`
+		} `json:"app_state"`
+
+		// bank is the decoded genesis of the bank module.
+		bank *banktypes.GenesisState
+	}
+
+	account struct {
+		Type        string `json:"@type"`
+		Address     string `json:"address"`
+		Sequence    string `json:"sequence"`
+		BaseAccount *struct {
+			Address  string `json:"address"`
+			Sequence string `json:"sequence"`
+		} `json:"base_account"`
+		Name string `json:"name"`
+	}
+
+	coin struct {
+		Denom  string `json:"denom"`
+		Amount string `json:"amount"`
+	}
+
+	stakingGenesis struct {
+		Params struct {
+			UnbondingTime string `json:"unbonding_time"`
+			MaxValidators uint32 `json:"max_validators"`
+			BondDenom     string `json:"bond_denom"`
+		} `json:"params"`
+		Validators []struct {
+			OperatorAddress string `json:"operator_address"`
+			Status          string `json:"status"`
+			Tokens          string `json:"tokens"`
+		} `json:"validators"`
+		UnbondingDelegations []struct {
+			Entries []struct {
+				Balance string `json:"balance"`
+			} `json:"entries"`
+		} `json:"unbonding_delegations"`
+	}
+
+	mintGenesis struct {
+		Params struct {
+			MintDenom    string `json:"mint_denom"`
+			InflationMax string `json:"inflation_max"`
+			InflationMin string `json:"inflation_min"`
+		} `json:"params"`
+	}
+
+	govGenesis struct {
+		DepositParams struct {
+			MinDeposit []coin `json:"min_deposit"`
+		} `json:"deposit_params"`
+		VotingParams struct {
+			VotingPeriod string `json:"voting_period"`
+		} `json:"voting_params"`
+	}
+
+	crisisGenesis struct {
+		ConstantFee coin `json:"constant_fee"`
+	}
+
+	ibcGenesis struct {
+		ClientGenesis struct {
+			Clients []struct {
+				ClientID    string `json:"client_id"`
+				ClientState struct {
+					Type            string `json:"@type"`
+					TrustingPeriod  string `json:"trusting_period"`
+					UnbondingPeriod string `json:"unbonding_period"`
+				} `json:"client_state"`
+			} `json:"clients"`
+		} `json:"client_genesis"`
+	}
+)
+
+// address returns the address of the account, base accounts hold it at the top level while
+// module and vesting accounts hold it in their base account.
+func (a account) address() string {
+	if a.BaseAccount != nil {
+		return a.BaseAccount.Address
+	}
+	return a.Address
+}
+
+// sequence returns the sequence of the account.
+func (a account) sequence() string {
+	if a.BaseAccount != nil {
+		return a.BaseAccount.Sequence
+	}
+	return a.Sequence
+}
+
+// Check checks the genesis file against the rules and returns its findings, ordered by rule. An error is returned when the genesis can't be parsed.
+func Check(genesisFile []byte) (Findings, error) {
+	var g genesis
+	if err := json.Unmarshal(genesisFile, &g); err != nil {
+		return nil, errors.Wrap(err, "cannot parse the genesis file")
+	}
+
+	if len(g.AppState.Bank) > 0 {
+		g.bank = &banktypes.GenesisState{}
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		if err := cdc.UnmarshalJSON(g.AppState.Bank, g.bank); err != nil {
+			return nil, errors.Wrap(err, "cannot parse the genesis of the bank module")
+		}
+	}
+
+	var findings Findings
+	for _, check := range []func(genesis) Findings{
+		checkSupply,
+		checkModuleAccounts,
+		checkGentxs,
+		checkDenomMetadata,
+		checkParams,
+	} {
+		findings = append(findings, check(g)...)
+	}
+
+	// the findings of a rule are ordered as their fields in the genesis
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Rule < findings[j].Rule
+	})
+
+	return findings, nil
+}
+
+// addressKey returns the bytes of a bech32 address as a map key, the addresses are compared by
+// bytes since the prefixes of the accounts and the validators are different.
+func addressKey(address string) (string, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// parseDuration parses a protobuf JSON duration, e.g. 1814400s.
+func parseDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
+
+func newFinding(rule string, severity Severity, path, format string, args ...interface{}) Finding {
+	return Finding{
+		Rule:     rule,
+		Severity: severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
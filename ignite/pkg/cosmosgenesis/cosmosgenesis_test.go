@@ -0,0 +1,273 @@
+package cosmosgenesis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosgenesis"
+)
+
+const chainID = "mars-1"
+
+// newGentx returns a gentx of a validator staking 100stake, signed for the chain mars-1.
+func newGentx(t *testing.T) (delegator sdk.AccAddress, gentx json.RawMessage) {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	priv := secp256k1.GenPrivKey()
+	delegator = sdk.AccAddress(priv.PubKey().Address())
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(delegator),
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewInt64Coin("stake", 100),
+		stakingtypes.NewDescription("mars", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: priv.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+	}))
+	sig, err := clienttx.SignWithPrivKey(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{ChainID: chainID},
+		builder,
+		priv,
+		txConfig,
+		0,
+	)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	gentx, err = txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return delegator, gentx
+}
+
+// newGenesis returns a valid genesis of a chain with a validator created by a gentx.
+func newGenesis(t *testing.T) map[string]interface{} {
+	delegator, gentx := newGentx(t)
+
+	var genesis map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"chain_id": "`+chainID+`",
+		"app_state": {
+			"auth": {"accounts": [
+				{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "`+delegator.String()+`", "sequence": "0"},
+				{
+					"@type": "/cosmos.auth.v1beta1.ModuleAccount",
+					"base_account": {"address": "`+authtypes.NewModuleAddress("distribution").String()+`"},
+					"name": "distribution"
+				}
+			]},
+			"bank": {
+				"balances": [{"address": "`+delegator.String()+`", "coins": [{"denom": "stake", "amount": "1000"}]}],
+				"supply": [{"denom": "stake", "amount": "1000"}],
+				"denom_metadata": [{
+					"name": "Stake",
+					"symbol": "STAKE",
+					"base": "stake",
+					"display": "kstake",
+					"denom_units": [{"denom": "stake", "exponent": 0}, {"denom": "kstake", "exponent": 3}]
+				}]
+			},
+			"staking": {"params": {"unbonding_time": "1814400s", "max_validators": 100, "bond_denom": "stake"}},
+			"gov": {
+				"deposit_params": {"min_deposit": [{"denom": "stake", "amount": "10"}]},
+				"voting_params": {"voting_period": "172800s"}
+			},
+			"mint": {"params": {"mint_denom": "stake", "inflation_max": "0.20", "inflation_min": "0.07"}},
+			"genutil": {"gen_txs": [`+string(gentx)+`]},
+			"ibc": {"client_genesis": {"clients": [{
+				"client_id": "07-tendermint-0",
+				"client_state": {
+					"@type": "/ibc.lightclients.tendermint.v1.ClientState",
+					"trusting_period": "1209600s",
+					"unbonding_period": "1814400s"
+				}
+			}]}}
+		}
+	}`), &genesis))
+	return genesis
+}
+
+// set sets the value of the field at path in the genesis.
+func set(genesis map[string]interface{}, value interface{}, path ...interface{}) {
+	var node interface{} = genesis
+	for i, key := range path {
+		last := i == len(path)-1
+		switch k := key.(type) {
+		case string:
+			if last {
+				node.(map[string]interface{})[k] = value
+			} else {
+				node = node.(map[string]interface{})[k]
+			}
+		case int:
+			if last {
+				node.([]interface{})[k] = value
+			} else {
+				node = node.([]interface{})[k]
+			}
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(t *testing.T, genesis map[string]interface{})
+		findings cosmosgenesis.Findings
+
+		// gentxError is true when the genesis has a gentx finding, its message holds the random
+		// address of the delegator
+		gentxError bool
+	}{
+		{
+			name: "valid genesis",
+		},
+		{
+			name: "supply not matching the balances",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				set(genesis, "900", "app_state", "bank", "supply", 0, "amount")
+			},
+			findings: cosmosgenesis.Findings{{
+				Rule:     cosmosgenesis.RuleSupply,
+				Severity: cosmosgenesis.SeverityError,
+				Path:     "app_state.bank.supply",
+				Message:  "the supply of stake is 900 but the balances hold 1000",
+			}},
+		},
+		{
+			name: "module account not derived from its name",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				set(genesis, "gov", "app_state", "auth", "accounts", 1, "name")
+			},
+			findings: cosmosgenesis.Findings{{
+				Rule:     cosmosgenesis.RuleModuleAccount,
+				Severity: cosmosgenesis.SeverityError,
+				Path:     "app_state.auth.accounts[1]",
+				Message: "the address of the module account gov is " +
+					authtypes.NewModuleAddress("distribution").String() +
+					" instead of " + authtypes.NewModuleAddress("gov").String(),
+			}},
+		},
+		{
+			name: "gentx signed for another chain",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				set(genesis, "venus-1", "chain_id")
+			},
+			gentxError: true,
+		},
+		{
+			name: "self-delegation over the balance",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				set(genesis, "50", "app_state", "bank", "balances", 0, "coins", 0, "amount")
+				set(genesis, "50", "app_state", "bank", "supply", 0, "amount")
+			},
+			gentxError: true,
+		},
+		{
+			name: "unit defined by two metadata",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				metadata := genesis["app_state"].(map[string]interface{})["bank"].(map[string]interface{})["denom_metadata"].([]interface{})
+				var token map[string]interface{}
+				require.NoError(t, json.Unmarshal([]byte(`{
+					"name": "Token",
+					"symbol": "TOKEN",
+					"base": "token",
+					"display": "kstake",
+					"denom_units": [{"denom": "token", "exponent": 0}, {"denom": "kstake", "exponent": 3}]
+				}`), &token))
+				set(genesis, append(metadata, token), "app_state", "bank", "denom_metadata")
+			},
+			findings: cosmosgenesis.Findings{
+				{
+					Rule:     cosmosgenesis.RuleDenomMetadata,
+					Severity: cosmosgenesis.SeverityError,
+					Path:     "app_state.bank.denom_metadata[1]",
+					Message:  "the unit kstake is defined by the metadata of stake and token",
+				},
+				{
+					Rule:     cosmosgenesis.RuleDenomMetadata,
+					Severity: cosmosgenesis.SeverityWarning,
+					Path:     "app_state.bank.denom_metadata[1]",
+					Message:  "no account holds the base denom token of the metadata",
+				},
+			},
+		},
+		{
+			name: "trusting period over the unbonding period",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				set(genesis, "1814400s", "app_state", "ibc", "client_genesis", "clients", 0, "client_state", "trusting_period")
+			},
+			findings: cosmosgenesis.Findings{{
+				Rule:     cosmosgenesis.RuleParams,
+				Severity: cosmosgenesis.SeverityError,
+				Path:     "app_state.ibc.client_genesis.clients[0].client_state.trusting_period",
+				Message:  "the trusting period 504h0m0s of the client 07-tendermint-0 must be shorter than its unbonding period 504h0m0s",
+			}},
+		},
+		{
+			name: "voting period over the unbonding time",
+			update: func(t *testing.T, genesis map[string]interface{}) {
+				set(genesis, "3628800s", "app_state", "gov", "voting_params", "voting_period")
+			},
+			findings: cosmosgenesis.Findings{{
+				Rule:     cosmosgenesis.RuleParams,
+				Severity: cosmosgenesis.SeverityWarning,
+				Path:     "app_state.gov.voting_params.voting_period",
+				Message:  "the voting period 1008h0m0s is longer than the unbonding time 504h0m0s, the validators can unbond their stake before the end of the votes",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := newGenesis(t)
+			if tt.update != nil {
+				tt.update(t, genesis)
+			}
+			data, err := json.Marshal(genesis)
+			require.NoError(t, err)
+
+			findings, err := cosmosgenesis.Check(data)
+			require.NoError(t, err)
+
+			if tt.gentxError {
+				require.Len(t, findings, 1)
+				require.Equal(t, cosmosgenesis.RuleGentx, findings[0].Rule)
+				require.Equal(t, "app_state.genutil.gen_txs[0]", findings[0].Path)
+				require.True(t, findings.HasErrors())
+				return
+			}
+			require.Equal(t, tt.findings, findings)
+			require.Equal(t, tt.findings.HasErrors(), findings.HasErrors())
+		})
+	}
+}
+
+func TestCheckInvalidGenesis(t *testing.T) {
+	_, err := cosmosgenesis.Check([]byte("{"))
+	require.Error(t, err)
+}
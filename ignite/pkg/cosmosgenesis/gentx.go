@@ -0,0 +1,149 @@
+package cosmosgenesis
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// newTxConfig returns the config decoding the gentxs and verifying their signatures.
+func newTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+// checkGentxs checks that each gentx creates a validator with a self-delegation held by its
+// delegator, and that it's signed by its delegator for the chain. The gentxs are signed with the
+// account number zero at genesis.
+func checkGentxs(g genesis) (findings Findings) {
+	if len(g.AppState.Genutil.GenTxs) == 0 {
+		return nil
+	}
+
+	accounts := make(map[string]account)
+	for _, acc := range g.AppState.Auth.Accounts {
+		if key, err := addressKey(acc.address()); err == nil {
+			accounts[key] = acc
+		}
+	}
+
+	var (
+		txConfig   = newTxConfig()
+		bondDenom  string
+		delegators = make(map[string]bool)
+	)
+	if g.AppState.Staking != nil {
+		bondDenom = g.AppState.Staking.Params.BondDenom
+	}
+
+	for i, raw := range g.AppState.Genutil.GenTxs {
+		path := fmt.Sprintf("app_state.genutil.gen_txs[%d]", i)
+		gentxError := func(format string, args ...interface{}) {
+			findings = append(findings, newFinding(RuleGentx, SeverityError, path, format, args...))
+		}
+
+		tx, err := txConfig.TxJSONDecoder()(raw)
+		if err != nil {
+			gentxError("cannot decode the gentx: %s", err)
+			continue
+		}
+		msgs := tx.GetMsgs()
+		if len(msgs) != 1 {
+			gentxError("the gentx must have one message, it has %d", len(msgs))
+			continue
+		}
+		msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+		if !ok {
+			gentxError("the message of the gentx must create a validator")
+			continue
+		}
+
+		delegator, err := addressKey(msg.DelegatorAddress)
+		if err != nil {
+			gentxError("invalid delegator address %q", msg.DelegatorAddress)
+			continue
+		}
+		if delegators[delegator] {
+			gentxError("the delegator %s has several gentxs", msg.DelegatorAddress)
+		}
+		delegators[delegator] = true
+
+		acc, ok := accounts[delegator]
+		if !ok {
+			gentxError("the delegator %s isn't a genesis account", msg.DelegatorAddress)
+			continue
+		}
+
+		if err := verifyGentxSignature(txConfig, tx, g.ChainID, acc); err != nil {
+			gentxError("invalid signature of the gentx of %s: %s", msg.DelegatorAddress, err)
+		}
+
+		if bondDenom != "" && msg.Value.Denom != bondDenom {
+			gentxError("the self-delegation of %s is in %s instead of the bond denom %s", msg.DelegatorAddress, msg.Value.Denom, bondDenom)
+			continue
+		}
+		if balance := balanceOf(g, delegator, msg.Value.Denom); balance.LT(msg.Value.Amount) {
+			gentxError(
+				"the self-delegation of %s is %s but its balance is %s%s",
+				msg.DelegatorAddress,
+				msg.Value,
+				balance,
+				msg.Value.Denom,
+			)
+		}
+	}
+	return findings
+}
+
+// verifyGentxSignature verifies that the gentx is signed by its delegator for the chain.
+func verifyGentxSignature(txConfig client.TxConfig, tx interface{}, chainID string, acc account) error {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return fmt.Errorf("unsupported transaction type %T", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) != len(signers) || len(sigs) == 0 {
+		return fmt.Errorf("the gentx has %d signatures for %d signers", len(sigs), len(signers))
+	}
+
+	var sequence uint64
+	if s := acc.sequence(); s != "" {
+		if sequence, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return fmt.Errorf("invalid sequence %q of the delegator", s)
+		}
+	}
+
+	for i, sig := range sigs {
+		if sig.PubKey == nil {
+			return fmt.Errorf("the signature %d has no public key", i)
+		}
+		if !bytes.Equal(sig.PubKey.Address(), signers[i]) {
+			return fmt.Errorf("the public key of the signature %d isn't the one of the signer %s", i, signers[i])
+		}
+
+		signerData := authsigning.SignerData{
+			ChainID:       chainID,
+			AccountNumber: 0,
+			Sequence:      sequence,
+		}
+		if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), sigTx); err != nil {
+			return fmt.Errorf("the signature doesn't match the chain %s: %s", chainID, err)
+		}
+	}
+	return nil
+}
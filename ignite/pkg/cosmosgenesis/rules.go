@@ -0,0 +1,409 @@
+package cosmosgenesis
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	moduleAccountType = "/cosmos.auth.v1beta1.ModuleAccount"
+	tendermintClient  = "/ibc.lightclients.tendermint.v1.ClientState"
+	bondedStatus      = "BOND_STATUS_BONDED"
+)
+
+// checkSupply checks that the supply of each denom is the sum of its balances, the chain doesn't
+// start otherwise. An empty supply is computed from the balances when the chain starts.
+func checkSupply(g genesis) (findings Findings) {
+	if g.bank == nil || g.bank.Supply.Empty() {
+		return nil
+	}
+
+	balances := make(map[string]sdk.Int)
+	for _, b := range g.bank.Balances {
+		for _, c := range b.Coins {
+			balances[c.Denom] = addInt(balances[c.Denom], c.Amount)
+		}
+	}
+	supply := make(map[string]sdk.Int)
+	for _, c := range g.bank.Supply {
+		supply[c.Denom] = c.Amount
+	}
+
+	for _, denom := range sortedDenoms(balances, supply) {
+		held, total := orZero(balances[denom]), orZero(supply[denom])
+		if !held.Equal(total) {
+			findings = append(findings, newFinding(
+				RuleSupply,
+				SeverityError,
+				"app_state.bank.supply",
+				"the supply of %s is %s but the balances hold %s",
+				denom,
+				total,
+				held,
+			))
+		}
+	}
+	return findings
+}
+
+// checkModuleAccounts checks that the module accounts have the address derived from their name,
+// and that the balances of the staking pools match the tokens of the validators.
+func checkModuleAccounts(g genesis) (findings Findings) {
+	names := make(map[string]bool)
+	for i, acc := range g.AppState.Auth.Accounts {
+		if acc.Type != moduleAccountType {
+			continue
+		}
+		path := fmt.Sprintf("app_state.auth.accounts[%d]", i)
+
+		if acc.Name == "" {
+			findings = append(findings, newFinding(RuleModuleAccount, SeverityError, path, "the module account has no name"))
+			continue
+		}
+		if names[acc.Name] {
+			findings = append(findings, newFinding(
+				RuleModuleAccount,
+				SeverityError,
+				path,
+				"the module account %s is defined twice",
+				acc.Name,
+			))
+		}
+		names[acc.Name] = true
+
+		hrp, bz, err := bech32.DecodeAndConvert(acc.address())
+		if err != nil {
+			findings = append(findings, newFinding(
+				RuleModuleAccount,
+				SeverityError,
+				path,
+				"invalid address %q of the module account %s",
+				acc.address(),
+				acc.Name,
+			))
+			continue
+		}
+		expected := authtypes.NewModuleAddress(acc.Name)
+		if !expected.Equals(sdk.AccAddress(bz)) {
+			expectedAddress, _ := bech32.ConvertAndEncode(hrp, expected)
+			findings = append(findings, newFinding(
+				RuleModuleAccount,
+				SeverityError,
+				path,
+				"the address of the module account %s is %s instead of %s",
+				acc.Name,
+				acc.address(),
+				expectedAddress,
+			))
+		}
+	}
+
+	return append(findings, checkStakingPools(g)...)
+}
+
+// checkStakingPools checks that the bonded pool holds the tokens of the bonded validators and
+// the not bonded pool holds the tokens of the other validators and of the unbonding delegations,
+// the chain doesn't start otherwise.
+func checkStakingPools(g genesis) (findings Findings) {
+	staking := g.AppState.Staking
+	if staking == nil || len(staking.Validators) == 0 || g.bank == nil {
+		return nil
+	}
+
+	bonded, notBonded := sdk.ZeroInt(), sdk.ZeroInt()
+	for i, v := range staking.Validators {
+		tokens, ok := sdk.NewIntFromString(v.Tokens)
+		if !ok {
+			findings = append(findings, newFinding(
+				RuleModuleAccount,
+				SeverityError,
+				fmt.Sprintf("app_state.staking.validators[%d].tokens", i),
+				"invalid tokens %q of the validator %s",
+				v.Tokens,
+				v.OperatorAddress,
+			))
+			continue
+		}
+		if v.Status == bondedStatus {
+			bonded = bonded.Add(tokens)
+		} else {
+			notBonded = notBonded.Add(tokens)
+		}
+	}
+	for _, ubd := range staking.UnbondingDelegations {
+		for _, e := range ubd.Entries {
+			if balance, ok := sdk.NewIntFromString(e.Balance); ok {
+				notBonded = notBonded.Add(balance)
+			}
+		}
+	}
+
+	for _, pool := range []struct {
+		name   string
+		tokens sdk.Int
+	}{
+		{stakingtypes.BondedPoolName, bonded},
+		{stakingtypes.NotBondedPoolName, notBonded},
+	} {
+		held := balanceOf(g, string(authtypes.NewModuleAddress(pool.name)), staking.Params.BondDenom)
+		if !held.Equal(pool.tokens) {
+			findings = append(findings, newFinding(
+				RuleModuleAccount,
+				SeverityError,
+				"app_state.bank.balances",
+				"the %s module account holds %s%s but the staking module expects %s%s",
+				pool.name,
+				held,
+				staking.Params.BondDenom,
+				pool.tokens,
+				staking.Params.BondDenom,
+			))
+		}
+	}
+	return findings
+}
+
+// checkDenomMetadata checks that the denom units aren't defined by several metadata, and that
+// the base denoms of the metadata are held by an account.
+func checkDenomMetadata(g genesis) (findings Findings) {
+	if g.bank == nil {
+		return nil
+	}
+
+	held := heldDenoms(g)
+	units := make(map[string]string)
+	for i, m := range g.bank.DenomMetadata {
+		path := fmt.Sprintf("app_state.bank.denom_metadata[%d]", i)
+
+		if err := m.Validate(); err != nil {
+			findings = append(findings, newFinding(RuleDenomMetadata, SeverityError, path, "%s", err))
+		}
+
+		for _, u := range m.DenomUnits {
+			for _, denom := range append([]string{u.Denom}, u.Aliases...) {
+				if base, ok := units[denom]; ok && base != m.Base {
+					findings = append(findings, newFinding(
+						RuleDenomMetadata,
+						SeverityError,
+						path,
+						"the unit %s is defined by the metadata of %s and %s",
+						denom,
+						base,
+						m.Base,
+					))
+					continue
+				}
+				units[denom] = m.Base
+			}
+		}
+
+		if !held[m.Base] {
+			findings = append(findings, newFinding(
+				RuleDenomMetadata,
+				SeverityWarning,
+				path,
+				"no account holds the base denom %s of the metadata",
+				m.Base,
+			))
+		}
+	}
+	return findings
+}
+
+// checkParams checks the sanity of the params of the standard modules.
+func checkParams(g genesis) (findings Findings) {
+	invalid := func(path string, err error) {
+		findings = append(findings, newFinding(RuleParams, SeverityError, path, "%s", err))
+	}
+
+	held := heldDenoms(g)
+	checkDenom := func(path, denom string, severity Severity, usage string) {
+		if g.bank != nil && denom != "" && !held[denom] {
+			findings = append(findings, newFinding(
+				RuleParams,
+				severity,
+				path,
+				"no account holds the denom %s %s",
+				denom,
+				usage,
+			))
+		}
+	}
+
+	// the unbonding time is zero when it's not set or invalid
+	var unbondingTime time.Duration
+	if staking := g.AppState.Staking; staking != nil {
+		const path = "app_state.staking.params"
+
+		d, err := parseDuration(staking.Params.UnbondingTime)
+		switch {
+		case err != nil:
+			invalid(path+".unbonding_time", err)
+		case d <= 0:
+			findings = append(findings, newFinding(RuleParams, SeverityError, path+".unbonding_time", "the unbonding time must be positive"))
+		default:
+			unbondingTime = d
+		}
+
+		if staking.Params.MaxValidators == 0 {
+			findings = append(findings, newFinding(RuleParams, SeverityError, path+".max_validators", "the chain must have one validator at least"))
+		}
+		checkDenom(path+".bond_denom", staking.Params.BondDenom, SeverityError, "staked by the validators")
+	}
+
+	if gov := g.AppState.Gov; gov != nil {
+		const path = "app_state.gov"
+
+		d, err := parseDuration(gov.VotingParams.VotingPeriod)
+		switch {
+		case err != nil:
+			invalid(path+".voting_params.voting_period", err)
+		case unbondingTime > 0 && d > unbondingTime:
+			findings = append(findings, newFinding(
+				RuleParams,
+				SeverityWarning,
+				path+".voting_params.voting_period",
+				"the voting period %s is longer than the unbonding time %s, the validators can unbond their stake before the end of the votes",
+				d,
+				unbondingTime,
+			))
+		}
+
+		for _, c := range gov.DepositParams.MinDeposit {
+			checkDenom(path+".deposit_params.min_deposit", c.Denom, SeverityWarning, "of the deposits of the proposals")
+		}
+	}
+
+	if mint := g.AppState.Mint; mint != nil {
+		const path = "app_state.mint.params"
+
+		min, errMin := sdk.NewDecFromStr(mint.Params.InflationMin)
+		max, errMax := sdk.NewDecFromStr(mint.Params.InflationMax)
+		switch {
+		case errMin != nil || errMax != nil:
+			findings = append(findings, newFinding(RuleParams, SeverityError, path, "invalid inflation rates"))
+		case min.IsNegative():
+			findings = append(findings, newFinding(RuleParams, SeverityError, path+".inflation_min", "the minimum inflation rate %s is negative", min))
+		case min.GT(max):
+			findings = append(findings, newFinding(
+				RuleParams,
+				SeverityError,
+				path+".inflation_min",
+				"the minimum inflation rate %s is greater than the maximum inflation rate %s",
+				min,
+				max,
+			))
+		case max.GT(sdk.OneDec()):
+			findings = append(findings, newFinding(RuleParams, SeverityWarning, path+".inflation_max", "the maximum inflation rate %s is over 100%%", max))
+		}
+
+		checkDenom(path+".mint_denom", mint.Params.MintDenom, SeverityWarning, "minted as inflation")
+	}
+
+	if crisis := g.AppState.Crisis; crisis != nil {
+		checkDenom("app_state.crisis.constant_fee", crisis.ConstantFee.Denom, SeverityWarning, "of the fee checking the invariants")
+	}
+
+	// the trusting period of a light client must be shorter than the unbonding period of its
+	// chain, the validators of the chain can't be slashed for a misbehavior once unbonded
+	if ibc := g.AppState.IBC; ibc != nil {
+		for i, client := range ibc.ClientGenesis.Clients {
+			cs := client.ClientState
+			if cs.Type != tendermintClient {
+				continue
+			}
+			path := fmt.Sprintf("app_state.ibc.client_genesis.clients[%d].client_state", i)
+
+			trusting, err := parseDuration(cs.TrustingPeriod)
+			if err != nil {
+				invalid(path+".trusting_period", err)
+				continue
+			}
+			unbonding, err := parseDuration(cs.UnbondingPeriod)
+			if err != nil {
+				invalid(path+".unbonding_period", err)
+				continue
+			}
+			switch {
+			case trusting <= 0:
+				findings = append(findings, newFinding(RuleParams, SeverityError, path+".trusting_period", "the trusting period of the client %s must be positive", client.ClientID))
+			case trusting >= unbonding:
+				findings = append(findings, newFinding(
+					RuleParams,
+					SeverityError,
+					path+".trusting_period",
+					"the trusting period %s of the client %s must be shorter than its unbonding period %s",
+					trusting,
+					client.ClientID,
+					unbonding,
+				))
+			}
+		}
+	}
+
+	return findings
+}
+
+// heldDenoms returns the denoms held by the accounts or in the supply.
+func heldDenoms(g genesis) map[string]bool {
+	held := make(map[string]bool)
+	if g.bank == nil {
+		return held
+	}
+	for _, b := range g.bank.Balances {
+		for _, c := range b.Coins {
+			held[c.Denom] = true
+		}
+	}
+	for _, c := range g.bank.Supply {
+		held[c.Denom] = true
+	}
+	return held
+}
+
+// balanceOf returns the balance of the denom of the address, given as an address key.
+func balanceOf(g genesis, key, denom string) sdk.Int {
+	balance := sdk.ZeroInt()
+	if g.bank == nil {
+		return balance
+	}
+	for _, b := range g.bank.Balances {
+		if k, err := addressKey(b.Address); err != nil || k != key {
+			continue
+		}
+		balance = addInt(balance, b.Coins.AmountOf(denom))
+	}
+	return balance
+}
+
+func addInt(a, b sdk.Int) sdk.Int {
+	return orZero(a).Add(orZero(b))
+}
+
+func orZero(i sdk.Int) sdk.Int {
+	if i.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return i
+}
+
+func sortedDenoms(amounts ...map[string]sdk.Int) []string {
+	seen := make(map[string]bool)
+	var denoms []string
+	for _, m := range amounts {
+		for denom := range m {
+			if !seen[denom] {
+				seen[denom] = true
+				denoms = append(denoms, denom)
+			}
+		}
+	}
+	sort.Strings(denoms)
+	return denoms
+}
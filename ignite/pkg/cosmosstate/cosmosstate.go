@@ -0,0 +1,348 @@
+// Package cosmosstate loads the application states exported from a chain, like the exported genesis
+// of the export command of the chain binary, and compares them module by module.
+//
+// The entries of the lists are matched by their identifier when they have one, e.g. the address of
+// the balances of the bank module or the denom of their coins, so an entry inserted in a list only
+// shows as added instead of changing all the entries after it.
+package cosmosstate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// identityKeys are the fields identifying the entries of a list, by order of preference.
+var identityKeys = []string{"address", "denom", "id", "index", "name", "key"}
+
+// State is the application state of a chain exported at a height.
+type State struct {
+	// Height is the height of the exported state.
+	Height int64 `json:"height"`
+
+	// Modules are the states of the modules by name, e.g. bank.
+	Modules map[string]json.RawMessage `json:"app_state"`
+}
+
+// Parse parses an exported state, either a state written by State or a genesis exported by the
+// chain binary, the height of the genesis is the one before its initial height.
+func Parse(data []byte) (State, error) {
+	var doc struct {
+		Height        *int64                     `json:"height"`
+		InitialHeight json.RawMessage            `json:"initial_height"`
+		AppState      map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return State{}, errors.Wrap(err, "invalid state")
+	}
+	if doc.AppState == nil {
+		return State{}, errors.New("invalid state: app_state is missing")
+	}
+
+	state := State{Modules: doc.AppState}
+	switch {
+	case doc.Height != nil:
+		state.Height = *doc.Height
+	case len(doc.InitialHeight) > 0:
+		// the initial height is encoded as a string, or as a number by older versions.
+		initialHeight := strings.Trim(string(doc.InitialHeight), `"`)
+		height, err := strconv.ParseInt(initialHeight, 10, 64)
+		if err != nil {
+			return State{}, fmt.Errorf("invalid initial height of the state %q", initialHeight)
+		}
+		state.Height = height - 1
+	}
+	return state, nil
+}
+
+// ModuleNames returns the names of the modules of the state, sorted.
+func (s State) ModuleNames() []string {
+	names := make([]string, 0, len(s.Modules))
+	for name := range s.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Filter returns the state with the modules only, the state is returned as is without modules.
+func (s State) Filter(modules ...string) (State, error) {
+	if len(modules) == 0 {
+		return s, nil
+	}
+	filtered := State{
+		Height:  s.Height,
+		Modules: make(map[string]json.RawMessage, len(modules)),
+	}
+	for _, name := range modules {
+		module, ok := s.Modules[name]
+		if !ok {
+			return State{}, fmt.Errorf("module %q isn't in the state, its modules are %s", name, strings.Join(s.ModuleNames(), ", "))
+		}
+		filtered.Modules[name] = module
+	}
+	return filtered, nil
+}
+
+// ChangeKind is the kind of change of an entry of the state.
+type ChangeKind string
+
+// The kinds of change.
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Changed ChangeKind = "changed"
+)
+
+// Change is an entry of a module added, removed or changed between two states.
+type Change struct {
+	// Module is the name of the module of the entry.
+	Module string `json:"module"`
+
+	// Path is the path of the entry in the state of the module, e.g. balances[address=cosmos1...].coins,
+	// it's empty when the whole module is added or removed.
+	Path string `json:"path"`
+
+	// Kind is the kind of change.
+	Kind ChangeKind `json:"kind"`
+
+	// Old is the value of the entry in the first state, it's empty when the entry is added.
+	Old json.RawMessage `json:"old,omitempty"`
+
+	// New is the value of the entry in the second state, it's empty when the entry is removed.
+	New json.RawMessage `json:"new,omitempty"`
+}
+
+// String returns the change on a line, e.g. `~ params.max_validators: 100 -> 120`.
+func (c Change) String() string {
+	path := c.Path
+	if path == "" {
+		path = c.Module
+	}
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", path, c.New)
+	case Removed:
+		return fmt.Sprintf("- %s: %s", path, c.Old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", path, c.Old, c.New)
+	}
+}
+
+// Diff returns the entries added, removed and changed from the state a to the state b, sorted by
+// module.
+func Diff(a, b State) ([]Change, error) {
+	modules := make(map[string]bool)
+	for name := range a.Modules {
+		modules[name] = true
+	}
+	for name := range b.Modules {
+		modules[name] = true
+	}
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []Change
+	for _, name := range names {
+		oldModule, inA := a.Modules[name]
+		newModule, inB := b.Modules[name]
+		oldValue, err := decode(oldModule, inA)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid state of the module %s", name)
+		}
+		newValue, err := decode(newModule, inB)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid state of the module %s", name)
+		}
+
+		d := differ{module: name}
+		switch {
+		case !inA:
+			d.add(Added, "", nil, newValue)
+		case !inB:
+			d.add(Removed, "", oldValue, nil)
+		default:
+			d.diff("", oldValue, newValue)
+		}
+		changes = append(changes, d.changes...)
+	}
+	return changes, nil
+}
+
+// decode decodes the state of a module, the numbers are kept as is.
+func decode(data json.RawMessage, ok bool) (interface{}, error) {
+	if !ok {
+		return nil, nil
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// differ collects the changes of a module.
+type differ struct {
+	module  string
+	changes []Change
+}
+
+func (d *differ) add(kind ChangeKind, path string, oldValue, newValue interface{}) {
+	change := Change{Module: d.module, Path: path, Kind: kind}
+	if kind != Added {
+		change.Old = encode(oldValue)
+	}
+	if kind != Removed {
+		change.New = encode(newValue)
+	}
+	d.changes = append(d.changes, change)
+}
+
+func (d *differ) diff(path string, a, b interface{}) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			d.diffObjects(path, a, b)
+			return
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok {
+			d.diffLists(path, a, b)
+			return
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		d.add(Changed, path, a, b)
+	}
+}
+
+func (d *differ) diffObjects(path string, a, b map[string]interface{}) {
+	keys := make(map[string]bool)
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		oldValue, inA := a[key]
+		newValue, inB := b[key]
+		switch {
+		case !inA:
+			d.add(Added, keyPath, nil, newValue)
+		case !inB:
+			d.add(Removed, keyPath, oldValue, nil)
+		default:
+			d.diff(keyPath, oldValue, newValue)
+		}
+	}
+}
+
+func (d *differ) diffLists(path string, a, b []interface{}) {
+	key, ok := identityKey(a, b)
+	if !ok {
+		// the entries without identifier are compared by position.
+		for i := 0; i < len(a) || i < len(b); i++ {
+			entryPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(a):
+				d.add(Added, entryPath, nil, b[i])
+			case i >= len(b):
+				d.add(Removed, entryPath, a[i], nil)
+			default:
+				d.diff(entryPath, a[i], b[i])
+			}
+		}
+		return
+	}
+
+	newEntries := make(map[string]interface{}, len(b))
+	for _, entry := range b {
+		newEntries[identity(entry, key)] = entry
+	}
+	oldEntries := make(map[string]bool, len(a))
+	for _, entry := range a {
+		id := identity(entry, key)
+		oldEntries[id] = true
+		entryPath := fmt.Sprintf("%s[%s=%s]", path, key, id)
+		if newEntry, ok := newEntries[id]; ok {
+			d.diff(entryPath, entry, newEntry)
+		} else {
+			d.add(Removed, entryPath, entry, nil)
+		}
+	}
+	for _, entry := range b {
+		if id := identity(entry, key); !oldEntries[id] {
+			d.add(Added, fmt.Sprintf("%s[%s=%s]", path, key, id), nil, entry)
+		}
+	}
+}
+
+// identityKey returns the field identifying the entries of the lists, it's set and unique in the
+// entries of both lists.
+func identityKey(a, b []interface{}) (string, bool) {
+	if len(a) == 0 && len(b) == 0 {
+		return "", false
+	}
+	for _, key := range identityKeys {
+		if isIdentity(a, key) && isIdentity(b, key) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func isIdentity(entries []interface{}, key string) bool {
+	ids := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		object, ok := entry.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		switch object[key].(type) {
+		case string, json.Number:
+		default:
+			return false
+		}
+		id := identity(entry, key)
+		if ids[id] {
+			return false
+		}
+		ids[id] = true
+	}
+	return true
+}
+
+func identity(entry interface{}, key string) string {
+	return fmt.Sprint(entry.(map[string]interface{})[key])
+}
+
+// encode encodes a decoded value, the keys of the objects are sorted.
+func encode(value interface{}) json.RawMessage {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return json.RawMessage(strconv.Quote(fmt.Sprint(value)))
+	}
+	return data
+}
@@ -0,0 +1,101 @@
+package cosmosstate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosstate"
+)
+
+const stateA = `{
+  "height": 10,
+  "app_state": {
+    "bank": {
+      "balances": [
+        {"address": "cosmos1alice", "coins": [{"denom": "stake", "amount": "100"}, {"denom": "token", "amount": "5"}]},
+        {"address": "cosmos1bob", "coins": [{"denom": "token", "amount": "10"}]}
+      ],
+      "params": {"default_send_enabled": true}
+    },
+    "mars": {"posts": ["hello"], "count": 1},
+    "venus": {}
+  }
+}`
+
+const stateB = `{
+  "height": 20,
+  "app_state": {
+    "bank": {
+      "balances": [
+        {"address": "cosmos1carol", "coins": [{"denom": "token", "amount": "1"}]},
+        {"address": "cosmos1alice", "coins": [{"denom": "stake", "amount": "120"}, {"denom": "token", "amount": "5"}]}
+      ],
+      "params": {"default_send_enabled": true, "send_enabled": []}
+    },
+    "mars": {"posts": ["hello", "world"], "count": 2},
+    "jupiter": {"moons": 79}
+  }
+}`
+
+func parse(t *testing.T, data string) cosmosstate.State {
+	state, err := cosmosstate.Parse([]byte(data))
+	require.NoError(t, err)
+	return state
+}
+
+func TestParse(t *testing.T) {
+	state := parse(t, stateA)
+	require.EqualValues(t, 10, state.Height)
+	require.Equal(t, []string{"bank", "mars", "venus"}, state.ModuleNames())
+
+	// the height of an exported genesis is the one before its initial height.
+	state = parse(t, `{"chain_id": "mars", "initial_height": "42", "app_state": {"bank": {}}}`)
+	require.EqualValues(t, 41, state.Height)
+	state = parse(t, `{"initial_height": 7, "app_state": {}}`)
+	require.EqualValues(t, 6, state.Height)
+
+	_, err := cosmosstate.Parse([]byte(`{"chain_id": "mars"}`))
+	require.EqualError(t, err, "invalid state: app_state is missing")
+	_, err = cosmosstate.Parse([]byte(`{"initial_height": "next", "app_state": {}}`))
+	require.Error(t, err)
+}
+
+func TestFilter(t *testing.T) {
+	state, err := parse(t, stateA).Filter("mars")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, state.Height)
+	require.Equal(t, []string{"mars"}, state.ModuleNames())
+
+	state, err = parse(t, stateA).Filter()
+	require.NoError(t, err)
+	require.Len(t, state.Modules, 3)
+
+	_, err = parse(t, stateA).Filter("jupiter")
+	require.EqualError(t, err, `module "jupiter" isn't in the state, its modules are bank, mars, venus`)
+}
+
+func TestDiff(t *testing.T) {
+	changes, err := cosmosstate.Diff(parse(t, stateA), parse(t, stateB))
+	require.NoError(t, err)
+
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, change.Module+" "+change.String())
+	}
+	require.Equal(t, []string{
+		`bank ~ balances[address=cosmos1alice].coins[denom=stake].amount: "100" -> "120"`,
+		`bank - balances[address=cosmos1bob]: {"address":"cosmos1bob","coins":[{"amount":"10","denom":"token"}]}`,
+		`bank + balances[address=cosmos1carol]: {"address":"cosmos1carol","coins":[{"amount":"1","denom":"token"}]}`,
+		`bank + params.send_enabled: []`,
+		`jupiter + jupiter: {"moons":79}`,
+		`mars ~ count: 1 -> 2`,
+		`mars + posts[1]: "world"`,
+		`venus - venus: {}`,
+	}, lines)
+
+	// the same states have no changes.
+	changes, err = cosmosstate.Diff(parse(t, stateA), parse(t, stateA))
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
@@ -0,0 +1,223 @@
+// Package cosmosstore prunes and compacts the databases of the data dir of a Cosmos SDK node.
+// The node must not be running since it locks its databases.
+package cosmosstore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/iavl"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// ApplicationDB is the database of the state of the app.
+	ApplicationDB = "application"
+
+	// BlockstoreDB is the database of the blocks.
+	BlockstoreDB = "blockstore"
+
+	// StateDB is the database of the Tendermint states of the blocks.
+	StateDB = "state"
+
+	dbExt = ".db"
+
+	// keys of the multistore of the app.
+	latestVersionKey  = "s/latest"
+	commitInfoKeyFmt  = "s/%d"
+	storeKeyPrefixFmt = "s/k:%s/"
+)
+
+// ErrDBNotFound is returned when a database doesn't exist in the data dir.
+var ErrDBNotFound = errors.New("database not found")
+
+// PruneStates deletes the states of the app at the heights not kept by the pruning options, the
+// KeepRecent latest states and the states at every KeepEvery heights are kept. It returns the
+// number of heights pruned, nothing is pruned when KeepRecent is 0.
+func PruneStates(dataDir string, options storetypes.PruningOptions) (pruned int, err error) {
+	if options.KeepRecent == 0 {
+		return 0, nil
+	}
+
+	db, err := openDB(dataDir, ApplicationDB)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	latest, err := latestVersion(db)
+	if err != nil || latest == 0 {
+		return 0, err
+	}
+
+	commitInfo, err := commitInfo(db, latest)
+	if err != nil {
+		return 0, err
+	}
+
+	prunedHeights := make(map[int64]bool)
+	for _, info := range commitInfo.StoreInfos {
+		prefix := fmt.Sprintf(storeKeyPrefixFmt, info.Name)
+		tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte(prefix)), 0)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tree.LoadVersion(0); err != nil {
+			return 0, fmt.Errorf("cannot load the %s store: %w", info.Name, err)
+		}
+
+		var versions []int64
+		for _, v := range tree.AvailableVersions() {
+			version := int64(v)
+			if !isPruned(version, latest, options) {
+				continue
+			}
+			versions = append(versions, version)
+			prunedHeights[version] = true
+		}
+		if err := tree.DeleteVersions(versions...); err != nil {
+			return 0, fmt.Errorf("cannot prune the %s store: %w", info.Name, err)
+		}
+	}
+
+	return len(prunedHeights), nil
+}
+
+// isPruned returns true when the state at version isn't kept by the pruning options.
+func isPruned(version, latest int64, options storetypes.PruningOptions) bool {
+	if version > latest-int64(options.KeepRecent) {
+		return false
+	}
+	return options.KeepEvery == 0 || version%int64(options.KeepEvery) != 0
+}
+
+// PruneBlocks deletes the blocks and their Tendermint states older than the retain latest blocks.
+// It returns the number of blocks pruned, nothing is pruned when retain is 0.
+func PruneBlocks(dataDir string, retain int64) (pruned uint64, err error) {
+	if retain <= 0 {
+		return 0, nil
+	}
+
+	blockDB, err := openDB(dataDir, BlockstoreDB)
+	if err != nil {
+		return 0, err
+	}
+	defer blockDB.Close()
+
+	stateDB, err := openDB(dataDir, StateDB)
+	if err != nil {
+		return 0, err
+	}
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockDB)
+	base := blockStore.Base()
+	retainHeight := blockStore.Height() - retain + 1
+	if retainHeight <= base {
+		return 0, nil
+	}
+
+	// the blocks and the states are pruned up to the retain height like the node does.
+	if pruned, err = blockStore.PruneBlocks(retainHeight); err != nil {
+		return 0, err
+	}
+	if err := sm.NewStore(stateDB).PruneStates(base, retainHeight); err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+// Compact compacts the databases of the data dir, it reclaims the space of the deleted data.
+func Compact(dataDir string) error {
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasSuffix(entry.Name(), dbExt) {
+			continue
+		}
+		if err := compactDB(dataDir, strings.TrimSuffix(entry.Name(), dbExt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compactDB(dataDir, name string) error {
+	db, err := openDB(dataDir, name)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := db.DB().CompactRange(util.Range{}); err != nil {
+		return fmt.Errorf("cannot compact the %s database: %w", name, err)
+	}
+	return nil
+}
+
+// Size returns the size in bytes of the files of the data dir.
+func Size(dataDir string) (size int64, err error) {
+	err = filepath.WalkDir(dataDir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+// openDB opens the goleveldb database with name in the data dir, it must exist.
+func openDB(dataDir, name string) (*dbm.GoLevelDB, error) {
+	if _, err := os.Stat(filepath.Join(dataDir, name+dbExt)); os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrDBNotFound, name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	db, err := dbm.NewGoLevelDB(name, dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the %s database, make sure the node is not running: %w", name, err)
+	}
+	return db, nil
+}
+
+func latestVersion(db dbm.DB) (int64, error) {
+	bz, err := db.Get([]byte(latestVersionKey))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	var version int64
+	if err := gogotypes.StdInt64Unmarshal(&version, bz); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func commitInfo(db dbm.DB, version int64) (storetypes.CommitInfo, error) {
+	var info storetypes.CommitInfo
+	bz, err := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if err != nil {
+		return info, err
+	}
+	if bz == nil {
+		return info, fmt.Errorf("no commit info found at height %d", version)
+	}
+	return info, info.Unmarshal(bz)
+}
@@ -0,0 +1,111 @@
+package cosmosstore
+
+import (
+	"fmt"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/iavl"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// newApplicationDB creates the application database of a node with a store committed at heights.
+func newApplicationDB(t *testing.T, dataDir string, heights int64) {
+	db, err := dbm.NewGoLevelDB(ApplicationDB, dataDir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte(fmt.Sprintf(storeKeyPrefixFmt, "bank"))), 0)
+	require.NoError(t, err)
+	for h := int64(1); h <= heights; h++ {
+		tree.Set([]byte("height"), []byte(fmt.Sprint(h)))
+		_, _, err := tree.SaveVersion()
+		require.NoError(t, err)
+	}
+
+	info := storetypes.CommitInfo{
+		Version:    heights,
+		StoreInfos: []storetypes.StoreInfo{{Name: "bank"}},
+	}
+	bz, err := info.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte(fmt.Sprintf(commitInfoKeyFmt, heights)), bz))
+	bz, err = gogotypes.StdInt64Marshal(heights)
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte(latestVersionKey), bz))
+}
+
+// storeVersions returns the heights of the states kept by the store of the application database.
+func storeVersions(t *testing.T, dataDir string) []int {
+	db, err := dbm.NewGoLevelDB(ApplicationDB, dataDir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte(fmt.Sprintf(storeKeyPrefixFmt, "bank"))), 0)
+	require.NoError(t, err)
+	_, err = tree.LoadVersion(0)
+	require.NoError(t, err)
+	return tree.AvailableVersions()
+}
+
+func TestPruneStates(t *testing.T) {
+	tests := []struct {
+		name    string
+		options storetypes.PruningOptions
+		pruned  int
+		want    []int
+	}{
+		{
+			name:    "keep recent",
+			options: storetypes.NewPruningOptions(3, 0, 10),
+			pruned:  7,
+			want:    []int{8, 9, 10},
+		},
+		{
+			name:    "keep every",
+			options: storetypes.NewPruningOptions(2, 4, 10),
+			pruned:  6,
+			want:    []int{4, 8, 9, 10},
+		},
+		{
+			name:    "nothing",
+			options: storetypes.PruneNothing,
+			want:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataDir := t.TempDir()
+			newApplicationDB(t, dataDir, 10)
+
+			pruned, err := PruneStates(dataDir, tt.options)
+			require.NoError(t, err)
+			require.Equal(t, tt.pruned, pruned)
+			require.Equal(t, tt.want, storeVersions(t, dataDir))
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	dataDir := t.TempDir()
+	newApplicationDB(t, dataDir, 10)
+
+	_, err := PruneStates(dataDir, storetypes.NewPruningOptions(1, 0, 10))
+	require.NoError(t, err)
+	require.NoError(t, Compact(dataDir))
+	require.Equal(t, []int{10}, storeVersions(t, dataDir))
+
+	size, err := Size(dataDir)
+	require.NoError(t, err)
+	require.Greater(t, size, int64(0))
+}
+
+func TestMissingDB(t *testing.T) {
+	_, err := PruneStates(t.TempDir(), storetypes.PruneEverything)
+	require.ErrorIs(t, err, ErrDBNotFound)
+
+	_, err = PruneBlocks(t.TempDir(), 100)
+	require.ErrorIs(t, err, ErrDBNotFound)
+}
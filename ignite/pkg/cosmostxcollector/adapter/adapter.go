@@ -0,0 +1,97 @@
+// Package adapter defines the output sinks of the transactions collected by cosmostxcollector
+// and the normalized payloads they receive.
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+// Sink receives the transactions collected from a chain, the transactions of a block are sent together.
+type Sink interface {
+	Send(ctx context.Context, txs []TX) error
+}
+
+// SinkFunc is a function implementing Sink.
+type SinkFunc func(ctx context.Context, txs []TX) error
+
+// Send calls f(ctx, txs).
+func (f SinkFunc) Send(ctx context.Context, txs []TX) error {
+	return f(ctx, txs)
+}
+
+// TX is the normalized payload of a transaction with its result.
+type TX struct {
+	Hash      string    `json:"hash"`
+	Height    int64     `json:"height"`
+	Index     uint32    `json:"index"`
+	BlockTime time.Time `json:"block_time"`
+
+	// Code is the result code of the transaction, it succeeded when the code is zero.
+	Code      uint32  `json:"code"`
+	Codespace string  `json:"codespace,omitempty"`
+	Log       string  `json:"log"`
+	GasWanted int64   `json:"gas_wanted"`
+	GasUsed   int64   `json:"gas_used"`
+	Events    []Event `json:"events"`
+
+	// Raw is the encoded transaction, it's decoded with the codec of the chain.
+	Raw []byte `json:"raw"`
+}
+
+// Event is an event emitted by a transaction.
+type Event struct {
+	Type       string      `json:"type"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute is an attribute of an event.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewTX returns the normalized payload of a collected transaction.
+func NewTX(tx cosmosclient.TX) TX {
+	result := tx.Raw.TxResult
+
+	events := make([]Event, len(result.Events))
+	for i, e := range result.Events {
+		attrs := make([]Attribute, len(e.Attributes))
+		for j, a := range e.Attributes {
+			attrs[j] = Attribute{
+				Key:   string(a.Key),
+				Value: string(a.Value),
+			}
+		}
+		events[i] = Event{
+			Type:       e.Type,
+			Attributes: attrs,
+		}
+	}
+
+	return TX{
+		Hash:      tx.Raw.Hash.String(),
+		Height:    tx.Raw.Height,
+		Index:     tx.Raw.Index,
+		BlockTime: tx.BlockTime,
+		Code:      result.Code,
+		Codespace: result.Codespace,
+		Log:       result.Log,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Events:    events,
+		Raw:       tx.Raw.Tx,
+	}
+}
+
+// NewTXs returns the normalized payloads of the collected transactions.
+func NewTXs(txs []cosmosclient.TX) []TX {
+	payloads := make([]TX, len(txs))
+	for i, tx := range txs {
+		payloads[i] = NewTX(tx)
+	}
+	return payloads
+}
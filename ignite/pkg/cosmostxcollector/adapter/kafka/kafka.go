@@ -0,0 +1,53 @@
+// Package kafka implements a sink producing the collected transactions to a Kafka topic.
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+)
+
+// Sink produces each transaction as a JSON message to a Kafka topic, the messages are keyed by
+// the hash of their transaction.
+type Sink struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// New creates a new sink producing to topic with producer.
+// The producer is owned by the caller, it's not closed by the sink.
+func New(producer sarama.SyncProducer, topic string) Sink {
+	return Sink{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+// Send produces the transactions and waits for the brokers to acknowledge them.
+func (s Sink) Send(ctx context.Context, txs []adapter.TX) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msgs := make([]*sarama.ProducerMessage, len(txs))
+	for i, tx := range txs {
+		data, err := json.Marshal(tx)
+		if err != nil {
+			return err
+		}
+		msgs[i] = &sarama.ProducerMessage{
+			Topic: s.topic,
+			Key:   sarama.StringEncoder(tx.Hash),
+			Value: sarama.ByteEncoder(data),
+		}
+	}
+
+	if err := s.producer.SendMessages(msgs); err != nil {
+		return errors.Wrap(err, "cannot produce the transactions")
+	}
+	return nil
+}
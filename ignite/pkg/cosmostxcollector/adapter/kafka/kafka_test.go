@@ -0,0 +1,34 @@
+package kafka_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/kafka"
+)
+
+func TestSend(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close()
+
+	var hashes []string
+	checkTX := func(value []byte) error {
+		var tx adapter.TX
+		err := json.Unmarshal(value, &tx)
+		hashes = append(hashes, tx.Hash)
+		return err
+	}
+	producer.ExpectSendMessageWithCheckerFunctionAndSucceed(checkTX)
+	producer.ExpectSendMessageWithCheckerFunctionAndSucceed(checkTX)
+
+	sink := kafka.New(producer, "txs")
+	err := sink.Send(context.Background(), []adapter.TX{{Hash: "AB"}, {Hash: "CD"}})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"AB", "CD"}, hashes)
+}
@@ -0,0 +1,47 @@
+// Package nats implements a sink publishing the collected transactions to a NATS subject.
+package nats
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+)
+
+// Sink publishes each transaction as a JSON message to a NATS subject.
+type Sink struct {
+	conn    *nats.Conn
+	subject string
+}
+
+// New creates a new sink publishing to subject with the NATS connection conn.
+// The connection is owned by the caller, it's not closed by the sink.
+func New(conn *nats.Conn, subject string) Sink {
+	return Sink{
+		conn:    conn,
+		subject: subject,
+	}
+}
+
+// Send publishes the transactions in their order and waits for the server to receive them.
+func (s Sink) Send(ctx context.Context, txs []adapter.TX) error {
+	for _, tx := range txs {
+		data, err := json.Marshal(tx)
+		if err != nil {
+			return err
+		}
+		if err := s.conn.Publish(s.subject, data); err != nil {
+			return errors.Wrapf(err, "cannot publish the transaction %s", tx.Hash)
+		}
+	}
+
+	// the flush can only be bounded by a context with a deadline, the default timeout
+	// of the connection is used otherwise.
+	if _, ok := ctx.Deadline(); ok {
+		return s.conn.FlushWithContext(ctx)
+	}
+	return s.conn.Flush()
+}
@@ -0,0 +1,42 @@
+// Package sqlite implements a storage saving the collected transactions in a SQLite database.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	// register the SQLite driver
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/sqlstorage"
+)
+
+// Dialect is the SQL dialect of SQLite.
+var Dialect = sqlstorage.Dialect{
+	BytesType: "BLOB",
+	NoLimit:   "-1",
+}
+
+// New creates a new storage saving the transactions in the SQLite database db.
+func New(db *sql.DB) sqlstorage.Storage {
+	return sqlstorage.New(db, Dialect)
+}
+
+// Open opens the SQLite database file at path, it's created when it doesn't exist, and returns a
+// storage with its tables created.
+func Open(ctx context.Context, path string) (sqlstorage.Storage, error) {
+	db, err := sql.Open("sqlite3", path+"?_foreign_keys=on")
+	if err != nil {
+		return sqlstorage.Storage{}, err
+	}
+
+	// SQLite supports a single writer, a single connection avoids the database is locked errors
+	db.SetMaxOpenConns(1)
+
+	s := New(db)
+	if err := s.Init(ctx); err != nil {
+		db.Close()
+		return sqlstorage.Storage{}, err
+	}
+	return s, nil
+}
@@ -0,0 +1,94 @@
+package sqlite_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/sqlite"
+)
+
+func newTX(height int64, index uint32, hash, sender string) adapter.TX {
+	return adapter.TX{
+		Hash:      hash,
+		Height:    height,
+		Index:     index,
+		BlockTime: time.Unix(height, 0).UTC(),
+		Events: []adapter.Event{
+			{
+				Type:       "message",
+				Attributes: []adapter.Attribute{{Key: "sender", Value: sender}},
+			},
+			{
+				Type: "transfer",
+				Attributes: []adapter.Attribute{
+					{Key: "sender", Value: sender},
+					{Key: "amount", Value: "10token"},
+				},
+			},
+		},
+		Raw: []byte(hash),
+	}
+}
+
+func TestStorage(t *testing.T) {
+	ctx := context.Background()
+	s, err := sqlite.Open(ctx, filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+	defer s.Close()
+
+	height, err := s.LatestHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, height)
+
+	require.NoError(t, s.Send(ctx, []adapter.TX{newTX(1, 0, "A", "alice"), newTX(1, 1, "B", "bob")}))
+	require.NoError(t, s.Send(ctx, []adapter.TX{newTX(3, 0, "C", "alice")}))
+
+	// a block saved again is ignored
+	require.NoError(t, s.Send(ctx, []adapter.TX{newTX(1, 0, "A", "alice")}))
+
+	height, err = s.LatestHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, height)
+
+	events, err := s.QueryEvents(ctx, adapter.EventQuery{
+		Type:       "transfer",
+		Attributes: []adapter.Attribute{{Key: "sender", Value: "alice"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []adapter.SavedEvent{
+		{
+			Event:     newTX(1, 0, "A", "alice").Events[1],
+			TXHash:    "A",
+			Height:    1,
+			BlockTime: time.Unix(1, 0).UTC(),
+			Index:     1,
+		},
+		{
+			Event:     newTX(3, 0, "C", "alice").Events[1],
+			TXHash:    "C",
+			Height:    3,
+			BlockTime: time.Unix(3, 0).UTC(),
+			Index:     1,
+		},
+	}, events)
+
+	events, err = s.QueryEvents(ctx, adapter.EventQuery{Type: "message", FromHeight: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "C", events[0].TXHash)
+
+	events, err = s.QueryEvents(ctx, adapter.EventQuery{Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "transfer", events[0].Type)
+	require.Equal(t, "B", events[1].TXHash)
+
+	events, err = s.QueryEvents(ctx, adapter.EventQuery{Offset: 5})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
@@ -0,0 +1,322 @@
+// Package sqlstorage implements a storage saving the collected transactions in a SQL database,
+// the differences between the databases are defined by a dialect, see the postgres and sqlite adapters.
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+)
+
+// Dialect defines the SQL differences between the databases.
+type Dialect struct {
+	// BytesType is the column type of binary data.
+	BytesType string
+
+	// NumberedPlaceholders is true when the arguments of the queries are numbered, e.g. $1,
+	// instead of being question marks.
+	NumberedPlaceholders bool
+
+	// NoLimit is the limit of a query returning all the rows, used when only an offset is set.
+	NoLimit string
+}
+
+// schema creates the tables of the storage, %[1]s is the column type of binary data.
+const schema = `
+CREATE TABLE IF NOT EXISTS tx (
+	hash       TEXT PRIMARY KEY,
+	height     BIGINT NOT NULL,
+	idx        BIGINT NOT NULL,
+	block_time TIMESTAMP NOT NULL,
+	code       BIGINT NOT NULL,
+	codespace  TEXT NOT NULL,
+	log        TEXT NOT NULL,
+	gas_wanted BIGINT NOT NULL,
+	gas_used   BIGINT NOT NULL,
+	raw        %[1]s
+);
+
+CREATE INDEX IF NOT EXISTS tx_height ON tx (height, idx);
+
+CREATE TABLE IF NOT EXISTS event (
+	tx_hash TEXT NOT NULL REFERENCES tx (hash),
+	idx     BIGINT NOT NULL,
+	type    TEXT NOT NULL,
+	PRIMARY KEY (tx_hash, idx)
+);
+
+CREATE INDEX IF NOT EXISTS event_type ON event (type);
+
+CREATE TABLE IF NOT EXISTS attribute (
+	tx_hash     TEXT NOT NULL,
+	event_idx   BIGINT NOT NULL,
+	idx         BIGINT NOT NULL,
+	key         TEXT NOT NULL,
+	value       TEXT NOT NULL,
+	PRIMARY KEY (tx_hash, event_idx, idx),
+	FOREIGN KEY (tx_hash, event_idx) REFERENCES event (tx_hash, idx)
+);
+
+CREATE INDEX IF NOT EXISTS attribute_key_value ON attribute (key, value);
+
+CREATE TABLE IF NOT EXISTS latest_height (
+	id     BIGINT PRIMARY KEY,
+	height BIGINT NOT NULL
+);
+`
+
+// Storage saves the collected transactions and their events in a SQL database.
+type Storage struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// New creates a new storage saving the transactions in db, call Init to create its tables.
+func New(db *sql.DB, dialect Dialect) Storage {
+	return Storage{
+		db:      db,
+		dialect: dialect,
+	}
+}
+
+// DB returns the database of the storage, e.g. to query the saved transactions with SQL.
+func (s Storage) DB() *sql.DB {
+	return s.db
+}
+
+// Init creates the tables of the storage when they don't exist.
+func (s Storage) Init(ctx context.Context) error {
+	for _, stmt := range strings.Split(fmt.Sprintf(schema, s.dialect.BytesType), ";") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return errors.Wrap(err, "cannot create the tables of the storage")
+		}
+	}
+	return nil
+}
+
+// Close closes the database of the storage.
+func (s Storage) Close() error {
+	return s.db.Close()
+}
+
+// Send saves the transactions of a block with their events, and the height of the block as the
+// latest height. The block is saved atomically, a block saved again is ignored.
+func (s Storage) Send(ctx context.Context, txs []adapter.TX) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	dbTx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback() // nolint:errcheck
+
+	for _, tx := range txs {
+		if err := s.saveTX(ctx, dbTx, tx); err != nil {
+			return errors.Wrapf(err, "cannot save the transaction %s", tx.Hash)
+		}
+	}
+
+	if _, err := dbTx.ExecContext(
+		ctx,
+		s.rebind(`INSERT INTO latest_height (id, height) VALUES (1, ?)
+			ON CONFLICT (id) DO UPDATE SET height = excluded.height WHERE latest_height.height < excluded.height`),
+		txs[0].Height,
+	); err != nil {
+		return errors.Wrap(err, "cannot save the latest height")
+	}
+
+	return dbTx.Commit()
+}
+
+func (s Storage) saveTX(ctx context.Context, dbTx *sql.Tx, tx adapter.TX) error {
+	res, err := dbTx.ExecContext(
+		ctx,
+		s.rebind(`INSERT INTO tx (hash, height, idx, block_time, code, codespace, log, gas_wanted, gas_used, raw)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (hash) DO NOTHING`),
+		tx.Hash,
+		tx.Height,
+		tx.Index,
+		tx.BlockTime.UTC(),
+		tx.Code,
+		tx.Codespace,
+		tx.Log,
+		tx.GasWanted,
+		tx.GasUsed,
+		tx.Raw,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the transaction and its events are already saved
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return err
+	}
+
+	for i, e := range tx.Events {
+		if _, err := dbTx.ExecContext(
+			ctx,
+			s.rebind("INSERT INTO event (tx_hash, idx, type) VALUES (?, ?, ?)"),
+			tx.Hash,
+			i,
+			e.Type,
+		); err != nil {
+			return err
+		}
+
+		for j, a := range e.Attributes {
+			if _, err := dbTx.ExecContext(
+				ctx,
+				s.rebind("INSERT INTO attribute (tx_hash, event_idx, idx, key, value) VALUES (?, ?, ?, ?, ?)"),
+				tx.Hash,
+				i,
+				j,
+				a.Key,
+				a.Value,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// LatestHeight returns the height of the last saved block, it's zero when no block is saved.
+func (s Storage) LatestHeight(ctx context.Context) (int64, error) {
+	var height int64
+	err := s.db.QueryRowContext(ctx, "SELECT height FROM latest_height WHERE id = 1").Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// QueryEvents returns the saved events matching the query, ordered by block, transaction and event.
+func (s Storage) QueryEvents(ctx context.Context, q adapter.EventQuery) ([]adapter.SavedEvent, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+
+	if q.Type != "" {
+		where = append(where, "e.type = ?")
+		args = append(args, q.Type)
+	}
+	if q.TXHash != "" {
+		where = append(where, "e.tx_hash = ?")
+		args = append(args, q.TXHash)
+	}
+	if q.FromHeight > 0 {
+		where = append(where, "t.height >= ?")
+		args = append(args, q.FromHeight)
+	}
+	if q.ToHeight > 0 {
+		where = append(where, "t.height <= ?")
+		args = append(args, q.ToHeight)
+	}
+	for _, a := range q.Attributes {
+		where = append(where, `EXISTS (SELECT 1 FROM attribute a
+			WHERE a.tx_hash = e.tx_hash AND a.event_idx = e.idx AND a.key = ? AND a.value = ?)`)
+		args = append(args, a.Key, a.Value)
+	}
+
+	query := `SELECT e.tx_hash, e.idx, e.type, t.height, t.block_time
+		FROM event e JOIN tx t ON t.hash = e.tx_hash`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY t.height, t.idx, e.idx"
+
+	switch {
+	case q.Limit > 0:
+		query += fmt.Sprintf(" LIMIT %d", q.Limit)
+	case q.Offset > 0:
+		query += " LIMIT " + s.dialect.NoLimit
+	}
+	if q.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", q.Offset)
+	}
+
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot query the events")
+	}
+	defer rows.Close()
+
+	var events []adapter.SavedEvent
+	for rows.Next() {
+		var e adapter.SavedEvent
+		if err := rows.Scan(&e.TXHash, &e.Index, &e.Type, &e.Height, &e.BlockTime); err != nil {
+			return nil, err
+		}
+		e.BlockTime = e.BlockTime.UTC()
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, e := range events {
+		if events[i].Attributes, err = s.queryAttributes(ctx, e.TXHash, e.Index); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (s Storage) queryAttributes(ctx context.Context, txHash string, eventIndex int) ([]adapter.Attribute, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		s.rebind("SELECT key, value FROM attribute WHERE tx_hash = ? AND event_idx = ? ORDER BY idx"),
+		txHash,
+		eventIndex,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot query the attributes of the events")
+	}
+	defer rows.Close()
+
+	attrs := []adapter.Attribute{}
+	for rows.Next() {
+		var a adapter.Attribute
+		if err := rows.Scan(&a.Key, &a.Value); err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, a)
+	}
+
+	return attrs, rows.Err()
+}
+
+// rebind replaces the question marks of the query by the placeholders of the dialect.
+func (s Storage) rebind(query string) string {
+	if !s.dialect.NumberedPlaceholders {
+		return query
+	}
+
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+		n++
+		fmt.Fprintf(&b, "$%d", n)
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"context"
+	"time"
+)
+
+// Storage is a sink saving the transactions and their events in a data backend. It tracks the height
+// of the last saved block so the collection can be resumed, and queries the saved events.
+type Storage interface {
+	Sink
+
+	// LatestHeight returns the height of the last saved block, it's zero when no block is saved.
+	LatestHeight(ctx context.Context) (int64, error)
+
+	// QueryEvents returns the saved events matching the query, ordered by block, transaction and event.
+	QueryEvents(ctx context.Context, q EventQuery) ([]SavedEvent, error)
+}
+
+// EventQuery filters the saved events, the zero value matches all the events.
+type EventQuery struct {
+	// Type only matches the events of the type.
+	Type string
+
+	// Attributes only matches the events having all the attributes.
+	Attributes []Attribute
+
+	// TXHash only matches the events of the transaction.
+	TXHash string
+
+	// FromHeight and ToHeight only match the events of the blocks in the range, a zero height leaves
+	// the range open.
+	FromHeight int64
+	ToHeight   int64
+
+	// Offset skips the first matching events.
+	Offset int
+
+	// Limit is the maximum number of events returned, all the events are returned when it's zero.
+	Limit int
+}
+
+// SavedEvent is a saved event with the transaction that emitted it.
+type SavedEvent struct {
+	Event
+
+	// TXHash is the hash of the transaction.
+	TXHash string `json:"tx_hash"`
+
+	// Height is the height of the block including the transaction.
+	Height int64 `json:"height"`
+
+	// BlockTime is the time of the block including the transaction.
+	BlockTime time.Time `json:"block_time"`
+
+	// Index is the position of the event in the events of the transaction.
+	Index int `json:"index"`
+}
@@ -0,0 +1,87 @@
+// Package webhook implements a sink posting the collected transactions to an HTTP webhook.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+)
+
+// Payload is the JSON body posted to the webhook, it holds the transactions of a block.
+type Payload struct {
+	TXs []adapter.TX `json:"txs"`
+}
+
+// Option configures the webhook sink.
+type Option func(*Sink)
+
+// WithHTTPClient sets the HTTP client posting to the webhook, http.DefaultClient is used by default.
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Sink) {
+		s.client = client
+	}
+}
+
+// WithHeader sets a header of the requests, e.g. to authenticate with the webhook.
+func WithHeader(key, value string) Option {
+	return func(s *Sink) {
+		s.header.Set(key, value)
+	}
+}
+
+// Sink posts the transactions of each block to a webhook.
+type Sink struct {
+	url    string
+	client *http.Client
+	header http.Header
+}
+
+// New creates a new sink posting to the webhook at url.
+func New(url string, options ...Option) Sink {
+	s := Sink{
+		url:    url,
+		client: http.DefaultClient,
+		header: make(http.Header),
+	}
+
+	for _, apply := range options {
+		apply(&s)
+	}
+
+	return s
+}
+
+// Send posts the transactions to the webhook, the webhook must respond with a 2xx status code.
+func (s Sink) Send(ctx context.Context, txs []adapter.TX) error {
+	body, err := json.Marshal(Payload{TXs: txs})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = s.header.Clone()
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "cannot post to the webhook")
+	}
+	defer res.Body.Close()
+
+	// drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with %s", res.Status)
+	}
+	return nil
+}
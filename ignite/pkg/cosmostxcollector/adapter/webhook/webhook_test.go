@@ -0,0 +1,44 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/webhook"
+)
+
+func TestSend(t *testing.T) {
+	var (
+		payload webhook.Payload
+		token   string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer srv.Close()
+
+	txs := []adapter.TX{{Hash: "AB", Height: 2}}
+	sink := webhook.New(srv.URL, webhook.WithHeader("Authorization", "Bearer secret"))
+
+	require.NoError(t, sink.Send(context.Background(), txs))
+	require.Equal(t, "Bearer secret", token)
+	require.Equal(t, txs[0].Hash, payload.TXs[0].Hash)
+	require.Equal(t, txs[0].Height, payload.TXs[0].Height)
+}
+
+func TestSendStatusError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	err := webhook.New(srv.URL).Send(context.Background(), []adapter.TX{{Hash: "AB"}})
+	require.EqualError(t, err, "webhook responded with 503 Service Unavailable")
+}
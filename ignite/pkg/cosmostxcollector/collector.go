@@ -0,0 +1,123 @@
+// Package cosmostxcollector collects the transactions of a chain and sends them to output sinks,
+// e.g. webhooks or message queues, so other systems can react to the activity of the chain, or
+// storages saving them in a database to index the chain.
+package cosmostxcollector
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+)
+
+// TXsCollector defines the interface for Cosmos clients that support collection of transactions.
+type TXsCollector interface {
+	CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX, options ...cosmosclient.TXsOption) error
+	StreamTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX, options ...cosmosclient.TXsOption) error
+}
+
+// Collector collects the transactions of a chain and sends them to its sinks.
+type Collector struct {
+	client TXsCollector
+	sinks  []adapter.Sink
+	logger log.Logger
+
+	// queueOptions configure the queue of the blocks when the blocks are sent through a queue.
+	queueOptions []jobqueue.Option
+	useQueue     bool
+
+	// queue is the queue of the running collection.
+	queue *queueRef
+}
+
+// New creates a new collector sending the transactions collected with client to the sinks.
+func New(client TXsCollector, sinks ...adapter.Sink) Collector {
+	return Collector{
+		client: client,
+		sinks:  sinks,
+		logger: log.NewNopLogger(),
+		queue:  &queueRef{},
+	}
+}
+
+// WithLogger returns a copy of the collector logging its progress: the collected blocks are logged
+// at the debug level and the failures of the sinks at the error level.
+func (c Collector) WithLogger(logger log.Logger) Collector {
+	c.logger = logger
+	return c
+}
+
+// Collect sends the transactions of the blocks from fromHeight to the latest block to the sinks.
+func (c Collector) Collect(ctx context.Context, fromHeight int64, options ...cosmosclient.TXsOption) error {
+	return c.run(ctx, func(ctx context.Context, tc chan<- []cosmosclient.TX) error {
+		return c.client.CollectTXs(ctx, fromHeight, tc, options...)
+	})
+}
+
+// Stream sends the transactions of the blocks from fromHeight to the sinks like Collect, then it
+// keeps sending the transactions of the new blocks until ctx is canceled.
+func (c Collector) Stream(ctx context.Context, fromHeight int64, options ...cosmosclient.TXsOption) error {
+	return c.run(ctx, func(ctx context.Context, tc chan<- []cosmosclient.TX) error {
+		return c.client.StreamTXs(ctx, fromHeight, tc, options...)
+	})
+}
+
+// ResumeHeight returns the height to resume the collection from with a storage, the height of the
+// block after the last one saved in the storage, or fromHeight when no block is saved.
+func ResumeHeight(ctx context.Context, s adapter.Storage, fromHeight int64) (int64, error) {
+	latest, err := s.LatestHeight(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot get the latest saved height")
+	}
+	if latest == 0 {
+		return fromHeight, nil
+	}
+	return latest + 1, nil
+}
+
+// run sends the transactions collected by collect to the sinks, the transactions of a block are
+// sent to all the sinks before the next block, the collection stops on the first sink error
+// unless the blocks are sent through a queue.
+func (c Collector) run(ctx context.Context, collect func(context.Context, chan<- []cosmosclient.TX) error) error {
+	if c.useQueue {
+		return c.runQueue(ctx, collect)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	tc := make(chan []cosmosclient.TX)
+
+	g.Go(func() error {
+		defer close(tc)
+		return collect(ctx, tc)
+	})
+
+	g.Go(func() error {
+		for txs := range tc {
+			if err := c.send(ctx, adapter.NewTXs(txs)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return g.Wait()
+}
+
+// send sends the transactions of a block to all the sinks.
+func (c Collector) send(ctx context.Context, payloads []adapter.TX) error {
+	for _, s := range c.sinks {
+		if err := s.Send(ctx, payloads); err != nil {
+			c.logger.Error("cannot send the transactions", "height", payloads[0].Height, "err", err)
+			return errors.Wrapf(err, "cannot send the transactions of the block %d", payloads[0].Height)
+		}
+	}
+	if len(payloads) > 0 {
+		c.logger.Debug("block collected", "height", payloads[0].Height, "txs", len(payloads))
+	}
+	return nil
+}
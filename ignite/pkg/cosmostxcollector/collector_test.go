@@ -0,0 +1,188 @@
+package cosmostxcollector_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/sqlite"
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+)
+
+var _ cosmostxcollector.TXsCollector = cosmosclient.Client{}
+
+type blocksClient [][]cosmosclient.TX
+
+func (b blocksClient) CollectTXs(ctx context.Context, _ int64, tc chan<- []cosmosclient.TX, _ ...cosmosclient.TXsOption) error {
+	for _, txs := range b {
+		select {
+		case tc <- txs:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (b blocksClient) StreamTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX, options ...cosmosclient.TXsOption) error {
+	return b.CollectTXs(ctx, fromHeight, tc, options...)
+}
+
+func newTX(height int64, tx string) cosmosclient.TX {
+	return cosmosclient.TX{
+		BlockTime: time.Unix(height, 0).UTC(),
+		Raw: ctypes.ResultTx{
+			Hash:   tmtypes.Tx(tx).Hash(),
+			Height: height,
+			Tx:     tmtypes.Tx(tx),
+			TxResult: abci.ResponseDeliverTx{
+				GasUsed: 10,
+				Events: []abci.Event{
+					{
+						Type: "transfer",
+						Attributes: []abci.EventAttribute{
+							{Key: []byte("amount"), Value: []byte("10token")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	client := blocksClient{
+		{newTX(1, "a"), newTX(1, "b")},
+		{newTX(3, "c")},
+	}
+
+	var heights [][]int64
+	sink := adapter.SinkFunc(func(_ context.Context, txs []adapter.TX) error {
+		var block []int64
+		for _, tx := range txs {
+			block = append(block, tx.Height)
+		}
+		heights = append(heights, block)
+		return nil
+	})
+
+	err := cosmostxcollector.New(client, sink).Collect(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]int64{{1, 1}, {3}}, heights)
+}
+
+func TestCollectSinkError(t *testing.T) {
+	client := blocksClient{
+		{newTX(1, "a")},
+		{newTX(2, "b")},
+	}
+	sinkErr := errors.New("sink unavailable")
+	sink := adapter.SinkFunc(func(context.Context, []adapter.TX) error {
+		return sinkErr
+	})
+
+	err := cosmostxcollector.New(client, sink).Collect(context.Background(), 1)
+	require.ErrorIs(t, err, sinkErr)
+	require.EqualError(t, err, "cannot send the transactions of the block 1: sink unavailable")
+}
+
+func TestResumeHeight(t *testing.T) {
+	ctx := context.Background()
+	storage, err := sqlite.Open(ctx, filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+	defer storage.Close()
+
+	height, err := cosmostxcollector.ResumeHeight(ctx, storage, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, height)
+
+	client := blocksClient{
+		{newTX(1, "a")},
+		{newTX(3, "b")},
+	}
+	require.NoError(t, cosmostxcollector.New(client, storage).Collect(ctx, height))
+
+	height, err = cosmostxcollector.ResumeHeight(ctx, storage, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, height)
+}
+
+func TestNewTX(t *testing.T) {
+	tx := newTX(5, "a")
+
+	payload := adapter.NewTX(tx)
+
+	require.Equal(t, adapter.TX{
+		Hash:      tx.Raw.Hash.String(),
+		Height:    5,
+		BlockTime: time.Unix(5, 0).UTC(),
+		GasUsed:   10,
+		Events: []adapter.Event{
+			{
+				Type:       "transfer",
+				Attributes: []adapter.Attribute{{Key: "amount", Value: "10token"}},
+			},
+		},
+		Raw: []byte("a"),
+	}, payload)
+}
+
+func TestCollectQueue(t *testing.T) {
+	client := blocksClient{
+		{newTX(1, "a")},
+		{newTX(2, "b")},
+		{newTX(3, "c")},
+	}
+
+	var (
+		attempts = make(map[int64]int)
+		sent     []int64
+	)
+	sink := adapter.SinkFunc(func(_ context.Context, txs []adapter.TX) error {
+		height := txs[0].Height
+		attempts[height]++
+		switch {
+		case height == 1 && attempts[height] < 3:
+			return errors.New("node unavailable")
+		case height == 2:
+			return errors.New("invalid block")
+		}
+		sent = append(sent, height)
+		return nil
+	})
+
+	c := cosmostxcollector.New(client, sink).WithQueue(jobqueue.WithRetry(3, time.Millisecond))
+
+	// the failing blocks don't stop the collection.
+	require.NoError(t, c.Collect(context.Background(), 1))
+	require.Equal(t, []int64{1, 3}, sent)
+	require.Equal(t, 3, attempts[2])
+
+	r := httptest.NewRequest(http.MethodGet, "/status", nil)
+	w := httptest.NewRecorder()
+	c.StatusHandler().ServeHTTP(w, r)
+
+	var status cosmostxcollector.QueueStatus
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+	require.Equal(t, jobqueue.Status{Dead: 1, Done: 2, Retries: 4}, status.Status)
+	require.Len(t, status.DeadLetters, 1)
+	require.Equal(t, "2", status.DeadLetters[0].ID)
+	require.Equal(t, "cannot send the transactions of the block 2: invalid block", status.DeadLetters[0].LastError)
+
+	var txs []adapter.TX
+	require.NoError(t, status.DeadLetters[0].Decode(&txs))
+	require.Equal(t, []byte("b"), txs[0].Raw)
+}
@@ -0,0 +1,62 @@
+package dispatcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Checkpoint saves the height of the last handled block.
+type Checkpoint interface {
+	// LatestHeight returns the height of the last handled block, it's zero when no block is handled.
+	LatestHeight(ctx context.Context) (int64, error)
+
+	// SaveHeight saves the height of the last handled block.
+	SaveHeight(ctx context.Context, height int64) error
+}
+
+// FileCheckpoint is a checkpoint saving the height in a file.
+type FileCheckpoint struct {
+	path string
+}
+
+// NewFileCheckpoint creates a new checkpoint saving the height in the file at path, the file and
+// its directory are created on the first save.
+func NewFileCheckpoint(path string) FileCheckpoint {
+	return FileCheckpoint{path: path}
+}
+
+// LatestHeight returns the height saved in the file, it's zero when the file doesn't exist.
+func (c FileCheckpoint) LatestHeight(context.Context) (int64, error) {
+	b, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid checkpoint %s", c.path)
+	}
+	return height, nil
+}
+
+// SaveHeight saves the height in the file, the file is replaced atomically so an interrupted save
+// keeps the previous height.
+func (c FileCheckpoint) SaveHeight(_ context.Context, height int64) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
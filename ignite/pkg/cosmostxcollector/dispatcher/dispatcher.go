@@ -0,0 +1,207 @@
+// Package dispatcher dispatches the events of the collected transactions to the handlers registered
+// for their event type or message type.
+//
+// The events are delivered at least once: a failed handler is retried, and the height of a block
+// is checkpointed once all its events are handled, so the blocks that aren't fully handled are
+// dispatched again when the dispatcher is restarted. Handlers must be idempotent.
+package dispatcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+)
+
+const (
+	// defaultMaxAttempts is the default number of attempts of a handler.
+	defaultMaxAttempts = 5
+
+	// defaultBackoff is the default wait before the second attempt of a handler.
+	defaultBackoff = time.Second
+
+	// maxBackoff is the maximum wait between two attempts of a handler.
+	maxBackoff = time.Minute
+
+	// msgEventType is the type of the event emitted for each message of a transaction.
+	msgEventType = "message"
+
+	// msgActionKey is the attribute of the message events holding the type of the message.
+	msgActionKey = "action"
+)
+
+// Handler handles an event of a collected transaction, it's retried when it returns an error.
+type Handler func(ctx context.Context, tx adapter.TX, event adapter.Event) error
+
+// Option configures the dispatcher.
+type Option func(*Dispatcher)
+
+// WithCheckpoint saves the height of the handled blocks to checkpoint, Run resumes from the block
+// after the last saved one. By default, the heights aren't saved.
+func WithCheckpoint(checkpoint Checkpoint) Option {
+	return func(d *Dispatcher) {
+		d.checkpoint = checkpoint
+	}
+}
+
+// WithRetry calls a failing handler up to maxAttempts times in total, the wait between two attempts
+// starts at initialBackoff and doubles after each attempt. By default, a handler is called up to 5
+// times starting with a 1s wait.
+func WithRetry(maxAttempts int, initialBackoff time.Duration) Option {
+	return func(d *Dispatcher) {
+		d.maxAttempts = maxAttempts
+		d.backoff = initialBackoff
+	}
+}
+
+// WithLogger logs the progress of the dispatcher: the collected and checkpointed blocks are logged
+// at the debug level, the retries of the handlers at the info level. By default, it doesn't log.
+func WithLogger(logger log.Logger) Option {
+	return func(d *Dispatcher) {
+		d.logger = logger
+	}
+}
+
+// route delivers the events matched to a handler.
+type route struct {
+	match   func(adapter.Event) bool
+	handler Handler
+}
+
+// Dispatcher is a sink dispatching the events of the transactions to their handlers.
+type Dispatcher struct {
+	routes      []route
+	checkpoint  Checkpoint
+	maxAttempts int
+	backoff     time.Duration
+	logger      log.Logger
+}
+
+// New creates a new dispatcher, register the handlers before sending transactions to it.
+func New(options ...Option) *Dispatcher {
+	d := &Dispatcher{
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+		logger:      log.NewNopLogger(),
+	}
+
+	for _, apply := range options {
+		apply(d)
+	}
+
+	return d
+}
+
+// Handle registers h to handle the events of eventType, e.g. transfer.
+func (d *Dispatcher) Handle(eventType string, h Handler) {
+	d.routes = append(d.routes, route{
+		match: func(e adapter.Event) bool {
+			return e.Type == eventType
+		},
+		handler: h,
+	})
+}
+
+// HandleMsg registers h to handle the messages of typeURL, e.g. /cosmos.bank.v1beta1.MsgSend.
+// The handler receives the message event of each message.
+func (d *Dispatcher) HandleMsg(typeURL string, h Handler) {
+	d.routes = append(d.routes, route{
+		match: func(e adapter.Event) bool {
+			if e.Type != msgEventType {
+				return false
+			}
+			for _, a := range e.Attributes {
+				if a.Key == msgActionKey && a.Value == typeURL {
+					return true
+				}
+			}
+			return false
+		},
+		handler: h,
+	})
+}
+
+// Run dispatches the events of the blocks collected by client from the block after the checkpoint,
+// or from fromHeight when there is no checkpoint, then it keeps dispatching the events of the new
+// blocks until ctx is canceled.
+func (d *Dispatcher) Run(ctx context.Context, client cosmostxcollector.TXsCollector, fromHeight int64, options ...cosmosclient.TXsOption) error {
+	if d.checkpoint != nil {
+		latest, err := d.checkpoint.LatestHeight(ctx)
+		if err != nil {
+			return errors.Wrap(err, "cannot load the checkpoint")
+		}
+		if latest > 0 {
+			fromHeight = latest + 1
+		}
+	}
+
+	d.logger.Info("dispatching the events", "from_height", fromHeight)
+
+	return cosmostxcollector.New(client, d).WithLogger(d.logger).Stream(ctx, fromHeight, options...)
+}
+
+// Send dispatches the events of the transactions of a block. The handlers are called concurrently,
+// each handler receives its events in the order of the block. The block is checkpointed once all
+// the events are handled.
+func (d *Dispatcher) Send(ctx context.Context, txs []adapter.TX) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, r := range d.routes {
+		r := r
+		g.Go(func() error {
+			for _, tx := range txs {
+				for _, e := range tx.Events {
+					if !r.match(e) {
+						continue
+					}
+					if err := d.handle(gctx, r.handler, tx, e); err != nil {
+						return errors.Wrapf(err, "cannot handle the %s event of the transaction %s", e.Type, tx.Hash)
+					}
+				}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if d.checkpoint == nil {
+		return nil
+	}
+	if err := d.checkpoint.SaveHeight(ctx, txs[0].Height); err != nil {
+		return errors.Wrap(err, "cannot save the checkpoint")
+	}
+	d.logger.Debug("block checkpointed", "height", txs[0].Height)
+	return nil
+}
+
+// handle calls the handler with the event until it succeeds or the attempts are exhausted.
+func (d *Dispatcher) handle(ctx context.Context, h Handler, tx adapter.TX, e adapter.Event) error {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = d.backoff
+	b.MaxInterval = maxBackoff
+	b.MaxElapsedTime = 0
+
+	var retries uint64
+	if d.maxAttempts > 1 {
+		retries = uint64(d.maxAttempts - 1)
+	}
+
+	notify := func(err error, wait time.Duration) {
+		d.logger.Info("retrying the handler", "event", e.Type, "tx", tx.Hash, "wait", wait, "err", err)
+	}
+	return backoff.RetryNotify(func() error {
+		return h(ctx, tx, e)
+	}, backoff.WithContext(backoff.WithMaxRetries(b, retries), ctx), notify)
+}
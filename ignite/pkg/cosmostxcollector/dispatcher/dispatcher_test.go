@@ -0,0 +1,108 @@
+package dispatcher_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/dispatcher"
+)
+
+func newTX(height int64, hash, msgType string) adapter.TX {
+	return adapter.TX{
+		Hash:   hash,
+		Height: height,
+		Events: []adapter.Event{
+			{
+				Type:       "message",
+				Attributes: []adapter.Attribute{{Key: "action", Value: msgType}},
+			},
+			{
+				Type:       "transfer",
+				Attributes: []adapter.Attribute{{Key: "amount", Value: "10token"}},
+			},
+		},
+	}
+}
+
+// recorder records the hashes of the transactions of the handled events.
+type recorder struct {
+	mu     sync.Mutex
+	hashes []string
+}
+
+func (r *recorder) handle(_ context.Context, tx adapter.TX, _ adapter.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hashes = append(r.hashes, tx.Hash)
+	return nil
+}
+
+func TestSend(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		checkpoint = dispatcher.NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint"))
+		d          = dispatcher.New(dispatcher.WithCheckpoint(checkpoint))
+		transfers  recorder
+		sends      recorder
+	)
+	d.Handle("transfer", transfers.handle)
+	d.HandleMsg("/cosmos.bank.v1beta1.MsgSend", sends.handle)
+
+	err := d.Send(ctx, []adapter.TX{
+		newTX(2, "A", "/cosmos.bank.v1beta1.MsgSend"),
+		newTX(2, "B", "/cosmos.staking.v1beta1.MsgDelegate"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A", "B"}, transfers.hashes)
+	require.Equal(t, []string{"A"}, sends.hashes)
+
+	height, err := checkpoint.LatestHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, height)
+}
+
+func TestSendRetry(t *testing.T) {
+	ctx := context.Background()
+	checkpoint := dispatcher.NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint"))
+
+	t.Run("handler succeeds", func(t *testing.T) {
+		d := dispatcher.New(dispatcher.WithCheckpoint(checkpoint), dispatcher.WithRetry(3, 0))
+
+		attempts := 0
+		d.Handle("transfer", func(context.Context, adapter.TX, adapter.Event) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("unavailable")
+			}
+			return nil
+		})
+
+		require.NoError(t, d.Send(ctx, []adapter.TX{newTX(1, "A", "")}))
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("handler fails", func(t *testing.T) {
+		d := dispatcher.New(dispatcher.WithCheckpoint(checkpoint), dispatcher.WithRetry(2, 0))
+
+		attempts := 0
+		d.Handle("transfer", func(context.Context, adapter.TX, adapter.Event) error {
+			attempts++
+			return errors.New("unavailable")
+		})
+
+		err := d.Send(ctx, []adapter.TX{newTX(2, "B", "")})
+		require.EqualError(t, err, "cannot handle the transfer event of the transaction B: unavailable")
+		require.Equal(t, 2, attempts)
+
+		// the block isn't checkpointed to be dispatched again
+		height, err := checkpoint.LatestHeight(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, height)
+	})
+}
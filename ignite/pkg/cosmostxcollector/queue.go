@@ -0,0 +1,142 @@
+package cosmostxcollector
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+// defaultQueueCapacity is the default number of blocks waiting to be sent, the collection waits
+// when the queue is full.
+const defaultQueueCapacity = 100
+
+// QueueStatus is the state of the queue of the blocks of a collector.
+type QueueStatus struct {
+	// Status is the state of the queue, zero when the collector doesn't run.
+	Status jobqueue.Status `json:"status"`
+
+	// DeadLetters are the blocks that failed all their attempts, their payload is the list of the
+	// transactions of the block.
+	DeadLetters []jobqueue.Job `json:"dead_letters"`
+}
+
+// queueRef references the queue of the running collection, it's shared by the copies of a collector.
+type queueRef struct {
+	mu    sync.Mutex
+	queue *jobqueue.Queue
+}
+
+func (r *queueRef) get() *jobqueue.Queue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.queue
+}
+
+func (r *queueRef) set(q *jobqueue.Queue) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.queue = q
+}
+
+// WithQueue returns a copy of the collector sending the blocks to the sinks through a job queue:
+// a block failing to be sent is retried, and moved to the dead letters when all its attempts fail,
+// the collection goes on with the next blocks. Up to 100 blocks wait in the queue by default, use
+// jobqueue.WithCapacity to change it.
+//
+// Persist the queue with jobqueue.WithStore to send the blocks still in the queue after a restart.
+// The blocks are sent at least once and in order, unless jobqueue.WithWorkers sends them concurrently.
+func (c Collector) WithQueue(options ...jobqueue.Option) Collector {
+	c.useQueue = true
+	c.queueOptions = options
+	return c
+}
+
+// QueueStatus returns the state of the queue of the blocks of the running collection.
+func (c Collector) QueueStatus() QueueStatus {
+	q := c.queue.get()
+	if q == nil {
+		return QueueStatus{}
+	}
+	return QueueStatus{
+		Status:      q.Status(),
+		DeadLetters: q.DeadLetters(),
+	}
+}
+
+// Requeue moves the block of the dead letters with id back to the queue of the running collection.
+func (c Collector) Requeue(id string) error {
+	q := c.queue.get()
+	if q == nil {
+		return jobqueue.ErrNotFound
+	}
+	return q.Requeue(id)
+}
+
+// StatusHandler returns the handler of the status endpoint of the collector, it responds with the
+// state of the queue of the blocks.
+func (c Collector) StatusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		xhttp.ResponseJSON(w, http.StatusOK, c.QueueStatus())
+	})
+}
+
+// runQueue enqueues the blocks collected by collect and sends them to the sinks from the queue,
+// it returns once the collected blocks are sent or moved to the dead letters.
+func (c Collector) runQueue(ctx context.Context, collect func(context.Context, chan<- []cosmosclient.TX) error) error {
+	options := append([]jobqueue.Option{
+		jobqueue.WithCapacity(defaultQueueCapacity),
+		jobqueue.WithLogger(c.logger),
+	}, c.queueOptions...)
+	q, err := jobqueue.New(c.sendJob, options...)
+	if err != nil {
+		return err
+	}
+	c.queue.set(q)
+
+	g, ctx := errgroup.WithContext(ctx)
+	tc := make(chan []cosmosclient.TX)
+
+	g.Go(func() error {
+		defer close(tc)
+		return collect(ctx, tc)
+	})
+
+	g.Go(func() error {
+		defer q.Close()
+		for txs := range tc {
+			var id string
+			if len(txs) > 0 {
+				id = strconv.FormatInt(txs[0].Raw.Height, 10)
+			}
+			if _, err := q.Enqueue(ctx, id, adapter.NewTXs(txs)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		return q.Run(ctx)
+	})
+
+	return g.Wait()
+}
+
+// sendJob sends the transactions of the block of a job to all the sinks.
+func (c Collector) sendJob(ctx context.Context, job *jobqueue.Job) error {
+	var payloads []adapter.TX
+	if err := job.Decode(&payloads); err != nil {
+		return jobqueue.Permanent(err)
+	}
+	return c.send(ctx, payloads)
+}
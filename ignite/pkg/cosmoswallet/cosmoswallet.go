@@ -0,0 +1,142 @@
+// Package cosmoswallet generates the chain info suggested to the browser wallets, such as Keplr
+// and Leap, with their experimentalSuggestChain method, and the frontend helper adding the chain
+// to the wallets.
+package cosmoswallet
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// CoinTypeCosmos is the BIP44 coin type of the Cosmos SDK chains.
+	CoinTypeCosmos = 118
+
+	// ChainInfoFile is the file of the chain info in the wallet dir of the frontend.
+	ChainInfoFile = "chainInfo.json"
+)
+
+// DefaultGasPriceStep is the gas price step of the wallets by default.
+var DefaultGasPriceStep = GasPriceStep{Low: 0.01, Average: 0.025, High: 0.04}
+
+//go:embed templates/*
+var templates embed.FS
+
+type (
+	// ChainInfo is the chain info suggested to the wallets.
+	ChainInfo struct {
+		ChainID       string        `json:"chainId"`
+		ChainName     string        `json:"chainName"`
+		RPC           string        `json:"rpc"`
+		REST          string        `json:"rest"`
+		BIP44         BIP44         `json:"bip44"`
+		Bech32Config  Bech32Config  `json:"bech32Config"`
+		Currencies    []Currency    `json:"currencies"`
+		FeeCurrencies []FeeCurrency `json:"feeCurrencies"`
+		StakeCurrency Currency      `json:"stakeCurrency"`
+	}
+
+	// BIP44 is the derivation path of the keys of the accounts.
+	BIP44 struct {
+		CoinType uint32 `json:"coinType"`
+	}
+
+	// Bech32Config holds the prefixes of the addresses and the public keys.
+	Bech32Config struct {
+		Bech32PrefixAccAddr  string `json:"bech32PrefixAccAddr"`
+		Bech32PrefixAccPub   string `json:"bech32PrefixAccPub"`
+		Bech32PrefixValAddr  string `json:"bech32PrefixValAddr"`
+		Bech32PrefixValPub   string `json:"bech32PrefixValPub"`
+		Bech32PrefixConsAddr string `json:"bech32PrefixConsAddr"`
+		Bech32PrefixConsPub  string `json:"bech32PrefixConsPub"`
+	}
+
+	// Currency is a coin of the chain, it's displayed in its display unit by the wallets.
+	Currency struct {
+		CoinDenom        string `json:"coinDenom"`
+		CoinMinimalDenom string `json:"coinMinimalDenom"`
+		CoinDecimals     uint32 `json:"coinDecimals"`
+	}
+
+	// FeeCurrency is a coin paying the fees of the txs.
+	FeeCurrency struct {
+		Currency
+		GasPriceStep GasPriceStep `json:"gasPriceStep"`
+	}
+
+	// GasPriceStep are the gas prices offered by the wallets to pay the fees.
+	GasPriceStep struct {
+		Low     float64 `json:"low"`
+		Average float64 `json:"average"`
+		High    float64 `json:"high"`
+	}
+)
+
+// NewBech32Config returns the prefixes of the addresses and the public keys of the chain from its
+// account address prefix, e.g. cosmos.
+func NewBech32Config(prefix string) Bech32Config {
+	return Bech32Config{
+		Bech32PrefixAccAddr:  prefix,
+		Bech32PrefixAccPub:   prefix + "pub",
+		Bech32PrefixValAddr:  prefix + "valoper",
+		Bech32PrefixValPub:   prefix + "valoperpub",
+		Bech32PrefixConsAddr: prefix + "valcons",
+		Bech32PrefixConsPub:  prefix + "valconspub",
+	}
+}
+
+// NewCurrency returns the currency of the base denom. The display unit of the denom and its
+// decimals are read from the metadata of the denom when they are registered in the bank module,
+// otherwise the denom is displayed in upper case without decimals.
+func NewCurrency(denom string, metadata []banktypes.Metadata) Currency {
+	for _, m := range metadata {
+		if m.Base != denom {
+			continue
+		}
+		for _, unit := range m.DenomUnits {
+			if unit.Denom == m.Display {
+				return Currency{
+					CoinDenom:        strings.ToUpper(m.Display),
+					CoinMinimalDenom: denom,
+					CoinDecimals:     unit.Exponent,
+				}
+			}
+		}
+	}
+
+	return Currency{
+		CoinDenom:        strings.ToUpper(denom),
+		CoinMinimalDenom: denom,
+	}
+}
+
+// Files returns the files of the wallet dir of the frontend by name, the chain info and the helper
+// adding the chain to the wallets.
+func Files(info ChainInfo) (map[string][]byte, error) {
+	chainInfo, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{
+		ChainInfoFile: append(chainInfo, '\n'),
+	}
+
+	tpl, err := template.ParseFS(templates, "templates/*")
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tpl.Templates() {
+		var b bytes.Buffer
+		if err := t.Execute(&b, info); err != nil {
+			return nil, err
+		}
+		files[strings.TrimSuffix(t.Name(), ".tpl")] = b.Bytes()
+	}
+
+	return files, nil
+}
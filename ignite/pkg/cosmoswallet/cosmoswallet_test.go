@@ -0,0 +1,42 @@
+package cosmoswallet
+
+import (
+	"encoding/json"
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCurrency(t *testing.T) {
+	metadata := []banktypes.Metadata{{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	}}
+
+	require.Equal(t, Currency{"ATOM", "uatom", 6}, NewCurrency("uatom", metadata))
+	require.Equal(t, Currency{"STAKE", "stake", 0}, NewCurrency("stake", metadata))
+}
+
+func TestFiles(t *testing.T) {
+	info := ChainInfo{
+		ChainID:       "mars-1",
+		Bech32Config:  NewBech32Config("mars"),
+		StakeCurrency: Currency{"STAKE", "stake", 0},
+	}
+
+	files, err := Files(info)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Contains(t, files, "addToWallet.ts")
+	require.Contains(t, files, "AddToWallet.vue")
+
+	var decoded ChainInfo
+	require.NoError(t, json.Unmarshal(files[ChainInfoFile], &decoded))
+	require.Equal(t, info, decoded)
+	require.Equal(t, "marsvaloper", decoded.Bech32Config.Bech32PrefixValAddr)
+}
@@ -0,0 +1,38 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+
+<template>
+  <div class="add-to-wallet">
+    <button type="button" :disabled="adding" @click="add('keplr')">Add to Keplr</button>
+    <button type="button" :disabled="adding" @click="add('leap')">Add to Leap</button>
+    <p v-if="error" class="error" v-text="error" />
+  </div>
+</template>
+
+<script lang="ts">
+import { defineComponent, ref } from 'vue'
+
+import { addToWallet, Wallet } from './addToWallet'
+
+export default defineComponent({
+  name: 'AddToWallet',
+
+  setup() {
+    const adding = ref(false)
+    const error = ref('')
+
+    async function add(wallet: Wallet) {
+      adding.value = true
+      error.value = ''
+      try {
+        await addToWallet(wallet)
+      } catch (e) {
+        error.value = (e as Error).message
+      } finally {
+        adding.value = false
+      }
+    }
+
+    return { adding, error, add }
+  }
+})
+</script>
@@ -0,0 +1,20 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import chainInfo from './chainInfo.json'
+
+// Wallet is a browser wallet suggested the chain, its extension is injected in the window.
+export type Wallet = 'keplr' | 'leap'
+
+export { chainInfo }
+
+// addToWallet suggests the chain {{ .ChainID }} to the wallet and asks the user to connect to it,
+// the wallet asks the user to approve the chain the first time only.
+export async function addToWallet(wallet: Wallet = 'keplr'): Promise<void> {
+  const extension = (window as any)[wallet]
+  if (!extension) {
+    throw new Error(`${wallet} extension is not installed`)
+  }
+
+  await extension.experimentalSuggestChain(chainInfo)
+  await extension.enable(chainInfo.chainId)
+}
@@ -0,0 +1,337 @@
+// Package docserver serves sets of markdown docs as a local website with a full-text search.
+package docserver
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+)
+
+const (
+	mdExt            = ".md"
+	frontMatterDelim = "---"
+	snippetLength    = 160
+	titleWeight      = 10
+)
+
+//go:embed index.tpl
+var index embed.FS
+
+// Source is a set of markdown docs, its pages are served under its prefix.
+type Source struct {
+	// Name of the docs, e.g. Ignite CLI.
+	Name string
+
+	// Prefix is the path of the docs on the server, e.g. ignite.
+	Prefix string
+
+	// FS contains the markdown files of the docs, the other files are served as they are. The hidden
+	// directories and the node_modules and vendor directories are skipped.
+	FS fs.FS
+}
+
+// Page is a markdown page of the docs.
+type Page struct {
+	// Path of the page on the server, e.g. ignite/kb/03-config.
+	Path string
+
+	// Title of the page, from its front matter or its first heading.
+	Title string
+
+	// Source is the name of the docs of the page.
+	Source string
+
+	// Markdown is the content of the page without its front matter.
+	Markdown []byte
+
+	html  template.HTML
+	text  string
+	lower string
+}
+
+// Result is a page matching a search.
+type Result struct {
+	Page    Page
+	Snippet string
+	Score   int
+}
+
+// Server serves the pages of the docs.
+type Server struct {
+	sources []Source
+	pages   []Page
+	paths   map[string]int
+	tpl     *template.Template
+}
+
+// New creates a server for the docs of the sources.
+func New(sources ...Source) (*Server, error) {
+	tpl, err := template.ParseFS(index, "index.tpl")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		sources: sources,
+		paths:   make(map[string]int),
+		tpl:     tpl,
+	}
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+
+	for _, src := range sources {
+		err := fs.WalkDir(src.FS, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if name != "." && isSkippedDir(d.Name()) {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if path.Ext(name) != mdExt {
+				return nil
+			}
+
+			content, err := fs.ReadFile(src.FS, name)
+			if err != nil {
+				return err
+			}
+			page := newPage(src, name, content)
+
+			var html bytes.Buffer
+			if err := md.Convert(page.Markdown, &html); err != nil {
+				return fmt.Errorf("cannot render %s: %w", name, err)
+			}
+			page.html = template.HTML(html.String())
+
+			s.paths[page.Path] = len(s.pages)
+			s.pages = append(s.pages, page)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Pages returns the pages of the docs.
+func (s *Server) Pages() []Page {
+	return s.pages
+}
+
+// Page returns the page at path, the .md extension of the path is optional.
+func (s *Server) Page(pagePath string) (Page, bool) {
+	i, ok := s.paths[strings.TrimSuffix(strings.Trim(pagePath, "/"), mdExt)]
+	if !ok {
+		return Page{}, false
+	}
+	return s.pages[i], true
+}
+
+// Search returns the pages containing all the words of the query, the most relevant first.
+func (s *Server) Search(query string) []Result {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var results []Result
+	for _, page := range s.pages {
+		var (
+			score int
+			title = strings.ToLower(page.Title)
+		)
+		for _, term := range terms {
+			n := strings.Count(page.lower, term)
+			if n == 0 {
+				score = 0
+				break
+			}
+			score += n + strings.Count(title, term)*titleWeight
+		}
+		if score == 0 {
+			continue
+		}
+
+		results = append(results, Result{
+			Page:    page,
+			Snippet: snippet(page.text, page.lower, terms[0]),
+			Score:   score,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
+
+// ServeHTTP serves the index of the docs at /, the results of a search at /search?q=query and the
+// pages and the files of the docs at their path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/":
+		s.render(w, http.StatusOK, view{Sources: s.index()})
+		return
+	case "/search":
+		query := r.URL.Query().Get("q")
+		s.render(w, http.StatusOK, view{Query: query, Results: s.Search(query), Searched: true})
+		return
+	}
+
+	if page, ok := s.Page(r.URL.Path); ok {
+		s.render(w, http.StatusOK, view{Page: &page, Content: page.html})
+		return
+	}
+
+	// serve the other files of the docs, e.g. the images of the pages.
+	for _, src := range s.sources {
+		prefix := "/" + src.Prefix + "/"
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(r.URL.Path, prefix)
+		if _, err := fs.Stat(src.FS, name); err == nil {
+			http.StripPrefix(prefix, http.FileServer(http.FS(src.FS))).ServeHTTP(w, r)
+			return
+		}
+	}
+
+	s.render(w, http.StatusNotFound, view{NotFound: true})
+}
+
+// view is the data of the template of the pages.
+type view struct {
+	Sources  []sourcePages
+	Page     *Page
+	Content  template.HTML
+	Query    string
+	Results  []Result
+	Searched bool
+	NotFound bool
+}
+
+type sourcePages struct {
+	Name  string
+	Pages []Page
+}
+
+func (s *Server) index() []sourcePages {
+	var index []sourcePages
+	for _, src := range s.sources {
+		sp := sourcePages{Name: src.Name}
+		for _, page := range s.pages {
+			if page.Source == src.Name {
+				sp.Pages = append(sp.Pages, page)
+			}
+		}
+		index = append(index, sp)
+	}
+	return index
+}
+
+func (s *Server) render(w http.ResponseWriter, status int, v view) {
+	var buf bytes.Buffer
+	if err := s.tpl.Execute(&buf, v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+func newPage(src Source, name string, content []byte) Page {
+	page := Page{
+		Path:     path.Join(src.Prefix, strings.TrimSuffix(name, mdExt)),
+		Source:   src.Name,
+		Markdown: content,
+	}
+
+	// the front matter of the page is removed, its title is the title of the page.
+	if rest, ok := cutFrontMatter(content); ok {
+		for _, line := range strings.Split(string(content[:len(content)-len(rest)]), "\n") {
+			if title := strings.TrimPrefix(line, "title:"); title != line {
+				page.Title = strings.Trim(strings.TrimSpace(title), `"'`)
+			}
+		}
+		page.Markdown = rest
+	}
+
+	if page.Title == "" {
+		for _, line := range strings.Split(string(page.Markdown), "\n") {
+			if strings.HasPrefix(line, "# ") {
+				page.Title = strings.TrimSpace(strings.TrimPrefix(line, "# "))
+				break
+			}
+		}
+	}
+	if page.Title == "" {
+		page.Title = path.Base(page.Path)
+	}
+
+	page.text = strings.Join(strings.Fields(string(page.Markdown)), " ")
+	page.lower = strings.ToLower(page.text)
+	return page
+}
+
+// cutFrontMatter returns the content after the front matter, ok is false when there is no front
+// matter.
+func cutFrontMatter(content []byte) (rest []byte, ok bool) {
+	delim := []byte(frontMatterDelim + "\n")
+	if !bytes.HasPrefix(content, delim) {
+		return content, false
+	}
+	end := bytes.Index(content[len(delim):], []byte("\n"+frontMatterDelim))
+	if end == -1 {
+		return content, false
+	}
+	rest = content[len(delim)+end+len(frontMatterDelim)+1:]
+	return bytes.TrimLeft(rest, "\n"), true
+}
+
+// snippet returns an extract of the text around the first occurrence of the term in its lowercase
+// version.
+func snippet(text, lower, term string) string {
+	i := strings.Index(lower, term)
+	if len(lower) != len(text) {
+		i = 0
+	}
+	start := i - snippetLength/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetLength
+	if end > len(text) {
+		end = len(text)
+	}
+
+	s := strings.ToValidUTF8(text[start:end], "")
+	if start > 0 {
+		s = "…" + s
+	}
+	if end < len(text) {
+		s += "…"
+	}
+	return s
+}
+
+func isSkippedDir(name string) bool {
+	return strings.HasPrefix(name, ".") || name == "node_modules" || name == "vendor"
+}
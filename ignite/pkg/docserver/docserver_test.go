@@ -0,0 +1,131 @@
+package docserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/docserver"
+)
+
+func newServer(t *testing.T) *docserver.Server {
+	s, err := docserver.New(
+		docserver.Source{
+			Name:   "Ignite CLI",
+			Prefix: "ignite",
+			FS: fstest.MapFS{
+				"kb/01-config.md": {Data: []byte(`---
+title: config.yml reference
+---
+
+# Config
+
+## faucet
+
+The faucet sends tokens to the accounts, see [serve](02-serve.md).
+`)},
+				"kb/02-serve.md": {Data: []byte("# Serve\n\nServe the chain with the faucet.\n")},
+				"kb/image.png":   {Data: []byte("png")},
+			},
+		},
+		docserver.Source{
+			Name:   "mars",
+			Prefix: "app",
+			FS: fstest.MapFS{
+				"readme.md":                      {Data: []byte("# mars\n\nA chain.\n")},
+				"x/mars/README.md":               {Data: []byte("The mars module.\n")},
+				"vue/node_modules/pkg/readme.md": {Data: []byte("# dependency\n")},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func TestPages(t *testing.T) {
+	s := newServer(t)
+
+	var paths, titles []string
+	for _, page := range s.Pages() {
+		paths = append(paths, page.Path)
+		titles = append(titles, page.Title)
+	}
+	require.Equal(t, []string{"ignite/kb/01-config", "ignite/kb/02-serve", "app/readme", "app/x/mars/README"}, paths)
+	require.Equal(t, []string{"config.yml reference", "Serve", "mars", "README"}, titles)
+
+	page, ok := s.Page("/ignite/kb/01-config.md")
+	require.True(t, ok)
+	require.NotContains(t, string(page.Markdown), "title:")
+
+	_, ok = s.Page("ignite/kb/03-missing")
+	require.False(t, ok)
+}
+
+func TestSearch(t *testing.T) {
+	s := newServer(t)
+
+	results := s.Search("Faucet")
+	require.Len(t, results, 2)
+	require.Equal(t, "ignite/kb/01-config", results[0].Page.Path)
+	require.Contains(t, results[0].Snippet, "faucet")
+
+	results = s.Search("serve chain")
+	require.Len(t, results, 1)
+	require.Equal(t, "ignite/kb/02-serve", results[0].Page.Path)
+
+	require.Empty(t, s.Search("validator"))
+	require.Empty(t, s.Search(" "))
+}
+
+func TestServeHTTP(t *testing.T) {
+	s := newServer(t)
+
+	tests := []struct {
+		name     string
+		url      string
+		status   int
+		contains string
+	}{
+		{
+			name:     "index",
+			url:      "/",
+			status:   http.StatusOK,
+			contains: `<a href="/app/x/mars/README">README</a>`,
+		},
+		{
+			name:     "page",
+			url:      "/ignite/kb/01-config",
+			status:   http.StatusOK,
+			contains: `<h2 id="faucet">faucet</h2>`,
+		},
+		{
+			name:     "search",
+			url:      "/search?q=module",
+			status:   http.StatusOK,
+			contains: `<a href="/app/x/mars/README">README</a>`,
+		},
+		{
+			name:     "file",
+			url:      "/ignite/kb/image.png",
+			status:   http.StatusOK,
+			contains: "png",
+		},
+		{
+			name:     "not found",
+			url:      "/ignite/kb/03-missing",
+			status:   http.StatusNotFound,
+			contains: "Page not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			require.Equal(t, tt.status, rec.Code)
+			require.Contains(t, rec.Body.String(), tt.contains)
+		})
+	}
+}
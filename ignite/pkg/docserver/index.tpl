@@ -0,0 +1,57 @@
+<!doctype html>
+<html lang="en">
+  <head>
+    <meta charset="utf-8">
+    <title>{{if .Page}}{{.Page.Title}} - {{end}}Docs</title>
+    <style>
+      body { margin: 0; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2328; line-height: 1.6; }
+      header { display: flex; align-items: center; gap: 1em; padding: 0.8em 2em; border-bottom: 1px solid #d0d7de; }
+      header a { font-weight: bold; color: inherit; text-decoration: none; }
+      header input { flex: 1; max-width: 30em; padding: 0.4em 0.6em; font-size: 1em; }
+      main { max-width: 56em; margin: 0 auto; padding: 1em 2em 4em; }
+      pre { padding: 1em; overflow: auto; background: #f6f8fa; border-radius: 6px; }
+      code { font-size: 0.9em; background: #f6f8fa; }
+      table { border-collapse: collapse; }
+      th, td { padding: 0.3em 0.8em; border: 1px solid #d0d7de; }
+      .result { margin-bottom: 1.5em; }
+      .result p { margin: 0.2em 0; color: #57606a; }
+      .source { color: #57606a; font-size: 0.9em; }
+    </style>
+  </head>
+  <body>
+    <header>
+      <a href="/">Docs</a>
+      <form action="/search">
+        <input type="search" name="q" value="{{.Query}}" placeholder="Search the docs">
+      </form>
+    </header>
+    <main>
+      {{- if .Page}}
+      <p class="source">{{.Page.Source}}</p>
+      {{.Content}}
+      {{- else if .Searched}}
+      <h1>Results for "{{.Query}}"</h1>
+      {{- range .Results}}
+      <div class="result">
+        <a href="/{{.Page.Path}}">{{.Page.Title}}</a> <span class="source">{{.Page.Source}}</span>
+        <p>{{.Snippet}}</p>
+      </div>
+      {{- else}}
+      <p>No page matches the search.</p>
+      {{- end}}
+      {{- else if .NotFound}}
+      <h1>Page not found</h1>
+      <p>Go back to the <a href="/">index</a> or search the docs.</p>
+      {{- else}}
+      {{- range .Sources}}
+      <h1>{{.Name}}</h1>
+      <ul>
+        {{- range .Pages}}
+        <li><a href="/{{.Path}}">{{.Title}}</a></li>
+        {{- end}}
+      </ul>
+      {{- end}}
+      {{- end}}
+    </main>
+  </body>
+</html>
@@ -0,0 +1,317 @@
+// Package envtest boots a single-node chain of a scaffolded app for the end-to-end tests of
+// the app, e.g.:
+//
+//	func TestTransfer(t *testing.T) {
+//		chain := envtest.Start(t, "../..", envtest.WithAccount("carol", "1000token"))
+//		res := chain.Fund(t, "carol", chain.Address(t, "dave"), "10token")
+//		...
+//	}
+//
+// Each chain has its own home, config and ports so the tests can run in parallel, the chain is
+// stopped and its home removed at the end of the test.
+package envtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/availableport"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	// DefaultBlockTime is the time between two blocks of the chains of the tests.
+	DefaultBlockTime = 500 * time.Millisecond
+
+	// DefaultStartTimeout is the time to build and start a chain.
+	DefaultStartTimeout = 10 * time.Minute
+)
+
+// Account is a test account funded in the genesis of the chain.
+type Account struct {
+	Name  string
+	Coins []string
+}
+
+type options struct {
+	configFile    string
+	blockTime     time.Duration
+	startTimeout  time.Duration
+	accounts      []Account
+	addressPrefix string
+	verbose       bool
+}
+
+// Option configures the chain of a test.
+type Option func(*options)
+
+// WithConfigFile sets the config of the chain, the config.yml of the app by default.
+func WithConfigFile(path string) Option {
+	return func(o *options) {
+		o.configFile = path
+	}
+}
+
+// WithBlockTime sets the time between two blocks of the chain, DefaultBlockTime by default.
+func WithBlockTime(blockTime time.Duration) Option {
+	return func(o *options) {
+		o.blockTime = blockTime
+	}
+}
+
+// WithStartTimeout sets the time to build and start the chain, DefaultStartTimeout by default.
+func WithStartTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.startTimeout = timeout
+	}
+}
+
+// WithAccount adds a test account funded with coins in the genesis of the chain, its keys are
+// created in the keyring of the chain.
+func WithAccount(name string, coins ...string) Option {
+	return func(o *options) {
+		o.accounts = append(o.accounts, Account{Name: name, Coins: coins})
+	}
+}
+
+// WithAddressPrefix sets the address prefix of the accounts of the app, cosmos by default.
+func WithAddressPrefix(prefix string) Option {
+	return func(o *options) {
+		o.addressPrefix = prefix
+	}
+}
+
+// WithVerbose prints the logs of the build and of the node of the chain.
+func WithVerbose() Option {
+	return func(o *options) {
+		o.verbose = true
+	}
+}
+
+// Chain is a single-node chain of an app started for a test.
+type Chain struct {
+	conf   chainconfig.Config
+	client cosmosclient.Client
+}
+
+// Start builds the app at appPath and starts a single-node chain of the app, it returns once the
+// node produces blocks. The test fails when the chain can't be started before the start timeout.
+func Start(t *testing.T, appPath string, opts ...Option) *Chain {
+	t.Helper()
+
+	o := options{
+		blockTime:     DefaultBlockTime,
+		startTimeout:  DefaultStartTimeout,
+		addressPrefix: "cosmos",
+	}
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	configFile := o.configFile
+	if configFile == "" {
+		path, err := chainconfig.LocateDefault(appPath)
+		require.NoError(t, err, "cannot find the config of the app")
+		configFile = path
+	}
+	conf, err := chainconfig.ParseFile(configFile)
+	require.NoError(t, err)
+
+	ports, err := availableport.Find(6)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	conf = testConfig(conf, o, ports, filepath.Join(dir, "home"))
+
+	testConfigFile := filepath.Join(dir, chainconfig.ConfigFileNames[0])
+	data, err := yaml.Marshal(conf)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(testConfigFile, data, 0644))
+
+	cacheStorage, err := cache.NewStorage(filepath.Join(dir, "cache.db"))
+	require.NoError(t, err)
+
+	logLevel := chain.LogSilent
+	if o.verbose {
+		logLevel = chain.LogVerbose
+	}
+	c, err := chain.New(appPath, chain.ConfigFile(testConfigFile), chain.LogLevel(logLevel))
+	require.NoError(t, err)
+
+	// the chain is served until the end of the test.
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- c.Serve(ctx, cacheStorage, chain.ServeForceReset(), chain.ServeSkipStateSave())
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-served
+	})
+
+	client, err := waitForChain(ctx, conf, o, served)
+	require.NoError(t, err, "cannot start the chain")
+
+	return &Chain{conf: conf, client: client}
+}
+
+// waitForChain waits for the node of the chain to produce blocks and returns a client of the node.
+func waitForChain(ctx context.Context, conf chainconfig.Config, o options, served <-chan error) (cosmosclient.Client, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.startTimeout)
+	defer cancel()
+
+	rpcAddr, err := xurl.HTTP(conf.Host.RPC)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	// the node is unreachable until the app is built and initialized.
+	for {
+		client, err := cosmosclient.New(
+			ctx,
+			cosmosclient.WithNodeAddress(rpcAddr),
+			cosmosclient.WithHome(conf.Init.Home),
+			cosmosclient.WithAddressPrefix(o.addressPrefix),
+		)
+		if err == nil {
+			if _, err := client.WaitUntilSynced(ctx); err != nil {
+				return cosmosclient.Client{}, err
+			}
+			return client, nil
+		}
+
+		select {
+		case err := <-served:
+			if err == nil {
+				err = errors.New("the chain stopped")
+			}
+			return cosmosclient.Client{}, err
+		case <-ctx.Done():
+			return cosmosclient.Client{}, fmt.Errorf("the node isn't reachable after %s: %w", o.startTimeout, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// testConfig returns the config of the chain of a test: the servers listen on ports, the home is
+// home, the blocks are produced every block time and the test accounts are funded. The faucet and
+// the client generation are disabled, the tests don't share ports or write in the app.
+func testConfig(conf chainconfig.Config, o options, ports []int, home string) chainconfig.Config {
+	addr := func(port int) string {
+		return fmt.Sprintf("127.0.0.1:%d", port)
+	}
+	conf.Host = chainconfig.Host{
+		RPC:     addr(ports[0]),
+		P2P:     addr(ports[1]),
+		Prof:    addr(ports[2]),
+		GRPC:    addr(ports[3]),
+		GRPCWeb: addr(ports[4]),
+		API:     addr(ports[5]),
+	}
+	conf.Init.Home = home
+	conf.Validator.BlockTime = o.blockTime.String()
+	conf.Validator.Consensus.TimeoutCommit = ""
+	conf.Faucet = chainconfig.Faucet{}
+	conf.Client = chainconfig.Client{}
+	conf.Profiles = nil
+
+	accounts := make([]chainconfig.Account, len(conf.Accounts), len(conf.Accounts)+len(o.accounts))
+	copy(accounts, conf.Accounts)
+	for _, account := range o.accounts {
+		accounts = append(accounts, chainconfig.Account{Name: account.Name, Coins: account.Coins})
+	}
+	conf.Accounts = accounts
+
+	return conf
+}
+
+// Client returns a client of the node of the chain, it signs with the accounts of the config.
+func (c *Chain) Client() cosmosclient.Client {
+	return c.client
+}
+
+// Config returns the config of the chain.
+func (c *Chain) Config() chainconfig.Config {
+	return c.conf
+}
+
+// Home returns the home of the node of the chain.
+func (c *Chain) Home() string {
+	return c.conf.Init.Home
+}
+
+// Host returns the addresses of the servers of the node.
+func (c *Chain) Host() chainconfig.Host {
+	return c.conf.Host
+}
+
+// Address returns the address of the account.
+func (c *Chain) Address(t *testing.T, accountName string) string {
+	t.Helper()
+
+	account, err := c.client.Account(accountName)
+	require.NoError(t, err)
+	return account.Address(c.client.AddressPrefix())
+}
+
+// Fund sends coins from the account to the address and waits for the tx to be committed,
+// e.g. to fund an account created by the test.
+func (c *Chain) Fund(t *testing.T, fromAccountName, toAddress string, coins ...string) cosmosclient.Response {
+	t.Helper()
+
+	from, err := c.client.Address(fromAccountName)
+	require.NoError(t, err)
+	to, err := sdktypes.AccAddressFromBech32(toAddress)
+	require.NoError(t, err)
+
+	var amount sdktypes.Coins
+	for _, coin := range coins {
+		parsed, err := sdktypes.ParseCoinsNormalized(coin)
+		require.NoError(t, err)
+		amount = amount.Add(parsed...)
+	}
+
+	res, err := c.client.BroadcastTx(fromAccountName, banktypes.NewMsgSend(from, to, amount))
+	require.NoError(t, err)
+	require.Zero(t, res.Code, res.RawLog)
+	return res
+}
+
+// WaitForBlocks waits for the node to produce n blocks.
+func (c *Chain) WaitForBlocks(t *testing.T, n int64) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(n+1)*c.blockTimeout())
+	defer cancel()
+
+	health, err := c.client.Health(ctx)
+	require.NoError(t, err)
+	_, err = c.client.WaitForBlockHeight(ctx, health.Height+n)
+	require.NoError(t, err)
+}
+
+// blockTimeout is the max time to wait for a block of the chain.
+func (c *Chain) blockTimeout() time.Duration {
+	blockTime, err := time.ParseDuration(c.conf.Validator.BlockTime)
+	if err != nil {
+		blockTime = DefaultBlockTime
+	}
+	return 10*blockTime + 10*time.Second
+}
@@ -0,0 +1,66 @@
+package envtest
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestTestConfig(t *testing.T) {
+	faucet := "bob"
+	conf := chainconfig.Config{
+		Accounts: []chainconfig.Account{
+			{Name: "alice", Coins: []string{"20000token", "200000000stake"}},
+			{Name: "bob", Coins: []string{"10000token"}},
+		},
+		Validator: chainconfig.Validator{
+			Name:      "alice",
+			Staked:    "100000000stake",
+			Consensus: chainconfig.Consensus{TimeoutCommit: "5s"},
+		},
+		Faucet: chainconfig.Faucet{Name: &faucet, Coins: []string{"5token"}},
+		Client: chainconfig.Client{Vuex: chainconfig.Vuex{Path: "vue/src/store"}},
+		Host:   chainconfig.Host{RPC: "0.0.0.0:26657"},
+	}
+
+	var o options
+	WithBlockTime(200 * time.Millisecond)(&o)
+	WithAccount("carol", "1000token", "10stake")(&o)
+
+	got := testConfig(conf, o, []int{1, 2, 3, 4, 5, 6}, "/tmp/home")
+	require.Equal(t, chainconfig.Host{
+		RPC:     "127.0.0.1:1",
+		P2P:     "127.0.0.1:2",
+		Prof:    "127.0.0.1:3",
+		GRPC:    "127.0.0.1:4",
+		GRPCWeb: "127.0.0.1:5",
+		API:     "127.0.0.1:6",
+	}, got.Host)
+	require.Equal(t, "/tmp/home", got.Init.Home)
+	require.Equal(t, "200ms", got.Validator.BlockTime)
+	require.Empty(t, got.Validator.Consensus.TimeoutCommit)
+	require.Nil(t, got.Faucet.Name)
+	require.Empty(t, got.Client.Vuex.Path)
+	require.Equal(t, []chainconfig.Account{
+		{Name: "alice", Coins: []string{"20000token", "200000000stake"}},
+		{Name: "bob", Coins: []string{"10000token"}},
+		{Name: "carol", Coins: []string{"1000token", "10stake"}},
+	}, got.Accounts)
+
+	// the config is valid once written.
+	data, err := yaml.Marshal(got)
+	require.NoError(t, err)
+	parsed, err := chainconfig.Parse(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, got.Host.RPC, parsed.Host.RPC)
+	require.Equal(t, got.Accounts, parsed.Accounts)
+
+	// the config of the app is untouched.
+	require.Len(t, conf.Accounts, 2)
+	require.Equal(t, "0.0.0.0:26657", conf.Host.RPC)
+}
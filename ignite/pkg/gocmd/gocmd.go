@@ -1,6 +1,7 @@
 package gocmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,6 +29,27 @@ const (
 
 	// CommandModVerify represents go mod "verify" command.
 	CommandModVerify = "verify"
+
+	// CommandWork represents go "work" command.
+	CommandWork = "work"
+
+	// CommandWorkInit represents go work "init" command.
+	CommandWorkInit = "init"
+
+	// CommandWorkUse represents go work "use" command.
+	CommandWorkUse = "use"
+
+	// CommandTool represents go "tool" command.
+	CommandTool = "tool"
+
+	// CommandToolPprof represents go tool "pprof" command.
+	CommandToolPprof = "pprof"
+
+	// CommandTest represents go "test" command.
+	CommandTest = "test"
+
+	// CommandList represents go "list" command.
+	CommandList = "list"
 )
 
 const (
@@ -35,11 +57,18 @@ const (
 	FlagModValueReadOnly = "readonly"
 	FlagLdflags          = "-ldflags"
 	FlagOut              = "-o"
+	FlagTags             = "-tags"
+	FlagJSON             = "-json"
+	FlagRace             = "-race"
+	FlagCoverProfile     = "-coverprofile"
+	FlagTimeout          = "-timeout"
 )
 
 const (
-	EnvGOOS   = "GOOS"
-	EnvGOARCH = "GOARCH"
+	EnvGOOS       = "GOOS"
+	EnvGOARCH     = "GOARCH"
+	EnvCGOEnabled = "CGO_ENABLED"
+	EnvCC         = "CC"
 )
 
 // Name returns the name of Go binary to use.
@@ -61,6 +90,45 @@ func ModVerify(ctx context.Context, path string, options ...exec.Option) error {
 	return exec.Exec(ctx, []string{Name(), CommandMod, CommandModVerify}, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// WorkInit runs go work init on path to create a workspace with the modules.
+func WorkInit(ctx context.Context, path string, modules []string, options ...exec.Option) error {
+	command := append([]string{Name(), CommandWork, CommandWorkInit}, modules...)
+	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
+}
+
+// WorkUse runs go work use on path to add the modules to the workspace.
+func WorkUse(ctx context.Context, path string, modules []string, options ...exec.Option) error {
+	command := append([]string{Name(), CommandWork, CommandWorkUse}, modules...)
+	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
+}
+
+// PprofTop runs go tool pprof -top on the profile with flags, e.g. -nodecount=20, and options.
+func PprofTop(ctx context.Context, profile string, flags []string, options ...exec.Option) error {
+	command := []string{Name(), CommandTool, CommandToolPprof, "-top"}
+	command = append(command, flags...)
+	command = append(command, profile)
+	return exec.Exec(ctx, command, options...)
+}
+
+// List returns the import paths of the packages of path matching the patterns.
+func List(ctx context.Context, path string, patterns []string, options ...exec.Option) ([]string, error) {
+	var b bytes.Buffer
+	command := append([]string{Name(), CommandList}, patterns...)
+	options = append(options, exec.StepOption(step.Workdir(path)), exec.StepOption(step.Stdout(&b)))
+	if err := exec.Exec(ctx, command, options...); err != nil {
+		return nil, err
+	}
+	return strings.Fields(b.String()), nil
+}
+
+// Test runs go test on the packages of path with flags and options.
+func Test(ctx context.Context, path string, flags, packages []string, options ...exec.Option) error {
+	command := []string{Name(), CommandTest}
+	command = append(command, flags...)
+	command = append(command, packages...)
+	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
+}
+
 // BuildPath runs go install on cmd folder with options.
 func BuildPath(ctx context.Context, output, binary, path string, flags []string, options ...exec.Option) error {
 	binaryOutput, err := binaryPath(output, binary)
@@ -105,6 +173,11 @@ func Ldflags(flags ...string) string {
 	return strings.Join(flags, " ")
 }
 
+// Tags returns a combined build tags string with given tags.
+func Tags(tags ...string) string {
+	return strings.Join(tags, ",")
+}
+
 // BuildTarget builds a GOOS:GOARCH pair.
 func BuildTarget(goos, goarch string) string {
 	return fmt.Sprintf("%s:%s", goos, goarch)
@@ -120,6 +193,15 @@ func ParseTarget(t string) (goos, goarch string, err error) {
 	return parsed[0], parsed[1], nil
 }
 
+// ExecutableName returns the file name of the binary built for goos, the binaries of Windows have
+// the .exe extension.
+func ExecutableName(binary, goos string) string {
+	if goos == "windows" {
+		return binary + ".exe"
+	}
+	return binary
+}
+
 // PackageLiteral returns the string representation of package part of go get [package].
 func PackageLiteral(path, version string) string {
 	return fmt.Sprintf("%s@%s", path, version)
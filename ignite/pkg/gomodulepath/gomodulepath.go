@@ -67,11 +67,15 @@ func ParseAt(path string) (Path, error) {
 
 // Find search the Go module in the current and parent paths until finding it.
 func Find(path string) (parsed Path, appPath string, err error) {
-	for len(path) != 0 && path != "." && path != "/" {
+	for len(path) != 0 && path != "." {
 		parsed, err = ParseAt(path)
 		if errors.Is(err, gomodule.ErrGoModNotFound) {
-			path = filepath.Dir(path)
-			continue
+			// the root is reached, e.g. / or C:\.
+			if parent := filepath.Dir(path); parent != path {
+				path = parent
+				continue
+			}
+			break
 		}
 		return parsed, path, err
 	}
@@ -3,10 +3,14 @@ package gomodulepath
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/mod/module"
+
+	"github.com/ignite/cli/ignite/pkg/gomodule"
 )
 
 func TestParse(t *testing.T) {
@@ -185,3 +189,19 @@ func TestValidateNamePath(t *testing.T) {
 func TestValidateNamePathWithInvalidPath(t *testing.T) {
 	require.Error(t, validateNamePath("cli@"))
 }
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module github.com/foo/bar\n"), 0644))
+	sub := filepath.Join(root, "x", "bar")
+	require.NoError(t, os.MkdirAll(sub, 0755))
+
+	parsed, appPath, err := Find(sub)
+	require.NoError(t, err)
+	require.Equal(t, "github.com/foo/bar", parsed.RawPath)
+	require.Equal(t, root, appPath)
+
+	// the search ends at the root of the file system.
+	_, _, err = Find(t.TempDir())
+	require.ErrorIs(t, err, gomodule.ErrGoModNotFound)
+}
@@ -0,0 +1,110 @@
+// Package gopanic parses the panics printed by Go programs.
+package gopanic
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// panicRe matches the first line of a panic or of a fatal runtime error, the panic
+	// repanicked while recovering a panic is indented in the following line.
+	panicRe = regexp.MustCompile(`(?m)^(panic|fatal error): `)
+
+	// goroutineRe matches the header of the stack trace of a goroutine.
+	goroutineRe = regexp.MustCompile(`(?m)^goroutine \d+ \[.*\]:$`)
+
+	// locationRe matches the location of a stack frame, e.g. `	/app/x/foo/keeper.go:42 +0x1d`.
+	locationRe = regexp.MustCompile(`^\t(.+):(\d+)(?: \+0x[0-9a-f]+)?$`)
+
+	// createdInRe matches the goroutine creating a goroutine, e.g. ` in goroutine 1`.
+	createdInRe = regexp.MustCompile(` in goroutine \d+$`)
+)
+
+// Panic is a panic of a Go program.
+type Panic struct {
+	// Message is the value of the panic, including the ones of the recovered and repanicked panics.
+	Message string
+
+	// Frames are the frames of the stack trace of the panicking goroutine, the innermost first.
+	Frames []Frame
+}
+
+// Frame is a frame of a stack trace.
+type Frame struct {
+	// Function is the fully qualified name of the function, e.g. `github.com/foo/bar/x/foo.Bar`.
+	Function string
+
+	// File is the path of the source file of the function, as recorded in the binary.
+	File string
+
+	// Line is the line number in File.
+	Line int
+}
+
+// Parse parses the last panic printed in the output of a program.
+// It returns false when output doesn't contain a panic.
+func Parse(output string) (Panic, bool) {
+	indexes := panicRe.FindAllStringIndex(output, -1)
+	if len(indexes) == 0 {
+		return Panic{}, false
+	}
+
+	output = output[indexes[len(indexes)-1][0]:]
+
+	var p Panic
+
+	trace := goroutineRe.FindStringIndex(output)
+	if trace == nil {
+		p.Message = message(output)
+		return p, true
+	}
+
+	p.Message = message(output[:trace[0]])
+	p.Frames = parseFrames(output[trace[1]:])
+
+	return p, true
+}
+
+// message returns the message of the panic without the panic prefixes.
+func message(text string) string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(line, "panic: ")
+		line = strings.TrimPrefix(line, "fatal error: ")
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseFrames parses the frames of a stack trace, it stops at the end of the trace.
+func parseFrames(trace string) (frames []Frame) {
+	lines := strings.Split(strings.TrimLeft(trace, "\n"), "\n")
+
+	for i := 0; i+1 < len(lines); i += 2 {
+		function, location := lines[i], lines[i+1]
+
+		match := locationRe.FindStringSubmatch(location)
+		if match == nil || function == "" {
+			break
+		}
+
+		// remove the arguments of the call, e.g. `foo.Bar(0xc0001, 0x2)`.
+		if i := strings.LastIndex(function, "("); i > 0 && !strings.HasPrefix(function, "created by ") {
+			function = function[:i]
+		}
+		function = strings.TrimPrefix(function, "created by ")
+		function = createdInRe.ReplaceAllString(function, "")
+
+		line, _ := strconv.Atoi(match[2])
+		frames = append(frames, Frame{
+			Function: function,
+			File:     match[1],
+			Line:     line,
+		})
+	}
+
+	return frames
+}
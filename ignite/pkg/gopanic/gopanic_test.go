@@ -0,0 +1,72 @@
+package gopanic_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/gopanic"
+)
+
+const output = `3:04PM INF committed state app_hash=E3B0C4 height=4 module=state
+3:04PM INF indexed block height=4 module=txindex
+panic: invalid post id [recovered]
+	panic: invalid post id
+
+goroutine 112 [running]:
+github.com/cosmos/cosmos-sdk/baseapp.(*BaseApp).BeginBlock.func1()
+	/go/pkg/mod/github.com/cosmos/cosmos-sdk@v0.45.4/baseapp/abci.go:155 +0x8a
+panic({0x2a0b7a0, 0xc001c2a7f0})
+	/usr/local/go/src/runtime/panic.go:838 +0x207
+github.com/foo/mars/x/mars/keeper.Keeper.GetPost({{0x3b3e4c8, 0xc00011d2b0}, {0x3b7c6e0, 0xc000f1c7e0}}, 0x4)
+	/home/foo/mars/x/mars/keeper/post.go:42 +0x1a5
+github.com/foo/mars/x/mars.BeginBlocker(...)
+	/home/foo/mars/x/mars/abci.go:12
+created by github.com/tendermint/tendermint/consensus.(*State).OnStart in goroutine 1
+	/go/pkg/mod/github.com/tendermint/tendermint@v0.34.19/consensus/state.go:393 +0x1e5
+`
+
+func TestParse(t *testing.T) {
+	p, ok := gopanic.Parse(output)
+	require.True(t, ok)
+	require.Equal(t, "invalid post id [recovered]\ninvalid post id", p.Message)
+	require.Equal(t, []gopanic.Frame{
+		{
+			Function: "github.com/cosmos/cosmos-sdk/baseapp.(*BaseApp).BeginBlock.func1",
+			File:     "/go/pkg/mod/github.com/cosmos/cosmos-sdk@v0.45.4/baseapp/abci.go",
+			Line:     155,
+		},
+		{
+			Function: "panic",
+			File:     "/usr/local/go/src/runtime/panic.go",
+			Line:     838,
+		},
+		{
+			Function: "github.com/foo/mars/x/mars/keeper.Keeper.GetPost",
+			File:     "/home/foo/mars/x/mars/keeper/post.go",
+			Line:     42,
+		},
+		{
+			Function: "github.com/foo/mars/x/mars.BeginBlocker",
+			File:     "/home/foo/mars/x/mars/abci.go",
+			Line:     12,
+		},
+		{
+			Function: "github.com/tendermint/tendermint/consensus.(*State).OnStart",
+			File:     "/go/pkg/mod/github.com/tendermint/tendermint@v0.34.19/consensus/state.go",
+			Line:     393,
+		},
+	}, p.Frames)
+}
+
+func TestParseFatalError(t *testing.T) {
+	p, ok := gopanic.Parse("fatal error: concurrent map writes\n")
+	require.True(t, ok)
+	require.Equal(t, "concurrent map writes", p.Message)
+	require.Empty(t, p.Frames)
+}
+
+func TestParseNoPanic(t *testing.T) {
+	_, ok := gopanic.Parse("Error: listen tcp 127.0.0.1:26657: bind: address already in use\n")
+	require.False(t, ok)
+}
@@ -0,0 +1,509 @@
+// Package jobqueue is a queue of jobs processed by workers in the order they are enqueued.
+//
+// A failed job is retried by its worker with an exponential backoff, a job failing all its
+// attempts is moved to the dead letters where it waits to be requeued. The pending jobs and the
+// dead letters are saved in a store, so the jobs survive the restarts: the jobs are processed at
+// least once, their handlers must be idempotent.
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// DefaultMaxAttempts is the default number of attempts of a job.
+	DefaultMaxAttempts = 5
+
+	// DefaultBackoff is the default wait before the second attempt of a job.
+	DefaultBackoff = time.Second
+
+	// maxBackoff is the maximum wait between two attempts of a job.
+	maxBackoff = time.Minute
+)
+
+var (
+	// ErrClosed is returned when a job is enqueued to a closed queue.
+	ErrClosed = errors.New("the queue is closed")
+
+	// ErrNotFound is returned when a dead letter doesn't exist.
+	ErrNotFound = errors.New("job not found")
+)
+
+// Job is a job of the queue.
+type Job struct {
+	// ID identifies the job in the queue.
+	ID string `json:"id"`
+
+	// Payload is the JSON encoded payload of the job.
+	Payload json.RawMessage `json:"payload"`
+
+	// Attempts is the number of times the job was handled.
+	Attempts int `json:"attempts"`
+
+	// LastError is the error of the last failed attempt.
+	LastError string `json:"last_error,omitempty"`
+
+	// EnqueuedAt is the time the job was enqueued.
+	EnqueuedAt time.Time `json:"enqueued_at"`
+
+	// Result is set by the handler of the job when it succeeds, e.g. the hash of a tx.
+	Result string `json:"result,omitempty"`
+}
+
+// Decode decodes the payload of the job into v.
+func (j Job) Decode(v interface{}) error {
+	return json.Unmarshal(j.Payload, v)
+}
+
+// Handler handles a job, the job is retried when the handler returns an error unless the error is
+// permanent. The handler can set the result of the job.
+type Handler func(ctx context.Context, job *Job) error
+
+// permanentError is an error that isn't fixed by retrying the job.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps the error of a handler to fail the job without retrying it, e.g. an invalid
+// payload. The job is dropped instead of being moved to the dead letters.
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// Outcome is the outcome of a job, Err is the error of its last attempt when it failed.
+type Outcome struct {
+	Job Job
+	Err error
+}
+
+// Status is the state of the queue.
+type Status struct {
+	// Pending is the number of jobs waiting for a worker.
+	Pending int `json:"pending"`
+
+	// Running is the number of jobs handled by the workers, including their retries.
+	Running int `json:"running"`
+
+	// Dead is the number of jobs that failed all their attempts.
+	Dead int `json:"dead"`
+
+	// Done is the number of jobs that succeeded since the queue was created.
+	Done uint64 `json:"done"`
+
+	// Failed is the number of jobs dropped with a permanent error since the queue was created.
+	Failed uint64 `json:"failed"`
+
+	// Retries is the number of retried attempts since the queue was created.
+	Retries uint64 `json:"retries"`
+}
+
+// Option configures the queue.
+type Option func(*Queue)
+
+// WithWorkers processes n jobs concurrently, the jobs are processed one by one by default.
+// The jobs are started in the order they are enqueued.
+func WithWorkers(n int) Option {
+	return func(q *Queue) {
+		q.workers = n
+	}
+}
+
+// WithRetry handles a failing job up to maxAttempts times in total, the wait between two attempts
+// starts at initialBackoff and doubles after each attempt. By default, a job is handled up to 5
+// times starting with a 1s wait.
+func WithRetry(maxAttempts int, initialBackoff time.Duration) Option {
+	return func(q *Queue) {
+		q.maxAttempts = maxAttempts
+		q.backoff = initialBackoff
+	}
+}
+
+// WithStore saves the pending jobs and the dead letters in store, they are loaded when the queue
+// is created. By default, the jobs are kept in memory.
+func WithStore(store Store) Option {
+	return func(q *Queue) {
+		q.store = store
+	}
+}
+
+// WithCapacity blocks the enqueues while n jobs are pending or running, the number of jobs isn't
+// limited by default.
+func WithCapacity(n int) Option {
+	return func(q *Queue) {
+		q.capacity = n
+	}
+}
+
+// WithLogger logs the retries and the dead letters at the info level. By default, it doesn't log.
+func WithLogger(logger log.Logger) Option {
+	return func(q *Queue) {
+		q.logger = logger
+	}
+}
+
+// Queue is a queue of jobs.
+type Queue struct {
+	handler     Handler
+	workers     int
+	maxAttempts int
+	backoff     time.Duration
+	capacity    int
+	store       Store
+	logger      log.Logger
+
+	mu      sync.Mutex
+	pending []Job
+	running []Job
+	dead    []Job
+	waiters map[string]chan Outcome
+	closed  bool
+	seq     uint64
+	status  Status
+
+	// changed is closed and replaced when the jobs change, to wake up the workers and the enqueues.
+	changed chan struct{}
+}
+
+// New creates a new queue handling the jobs with handler, the jobs saved in the store of the
+// queue are loaded. Run the queue to process the jobs.
+func New(handler Handler, options ...Option) (*Queue, error) {
+	q := &Queue{
+		handler:     handler,
+		workers:     1,
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+		logger:      log.NewNopLogger(),
+		waiters:     make(map[string]chan Outcome),
+		changed:     make(chan struct{}),
+	}
+
+	for _, apply := range options {
+		apply(q)
+	}
+	if q.workers < 1 {
+		q.workers = 1
+	}
+	if q.maxAttempts < 1 {
+		q.maxAttempts = 1
+	}
+
+	if q.store != nil {
+		s, err := q.store.Load()
+		if err != nil {
+			return nil, fmt.Errorf("cannot load the jobs: %w", err)
+		}
+		q.pending = s.Pending
+		q.dead = s.Dead
+	}
+
+	return q, nil
+}
+
+// Enqueue adds a job with the JSON encoded payload to the queue and returns the channel receiving
+// its outcome. A unique id is generated when id is empty. Enqueue blocks while the queue is full.
+func (q *Queue) Enqueue(ctx context.Context, id string, payload interface{}) (<-chan Outcome, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.capacity > 0 && len(q.pending)+len(q.running) >= q.capacity && !q.closed {
+		changed := q.changed
+		q.mu.Unlock()
+		select {
+		case <-changed:
+			q.mu.Lock()
+		case <-ctx.Done():
+			q.mu.Lock()
+			return nil, ctx.Err()
+		}
+	}
+	if q.closed {
+		return nil, ErrClosed
+	}
+
+	if id == "" {
+		q.seq++
+		id = fmt.Sprintf("%x-%d", time.Now().UnixNano(), q.seq)
+	}
+
+	q.pending = append(q.pending, Job{
+		ID:         id,
+		Payload:    data,
+		EnqueuedAt: time.Now().UTC(),
+	})
+	if err := q.changeLocked(); err != nil {
+		// the job isn't enqueued when it can't be saved.
+		q.pending = q.pending[:len(q.pending)-1]
+		return nil, fmt.Errorf("cannot save the job: %w", err)
+	}
+
+	outcome := make(chan Outcome, 1)
+	q.waiters[id] = outcome
+	return outcome, nil
+}
+
+// Run processes the jobs until ctx is canceled or the queue is closed and all its jobs are processed.
+// The jobs interrupted by the cancellation are kept pending.
+func (q *Queue) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < q.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				job, ok := q.next(ctx)
+				if !ok {
+					return
+				}
+				q.process(ctx, job)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// Close stops the enqueues, Run returns once the jobs already enqueued are processed.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.signalLocked()
+}
+
+// Status returns the state of the queue.
+func (q *Queue) Status() Status {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	s := q.status
+	s.Pending = len(q.pending)
+	s.Running = len(q.running)
+	s.Dead = len(q.dead)
+	return s
+}
+
+// DeadLetters returns the jobs that failed all their attempts, from the oldest.
+func (q *Queue) DeadLetters() []Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return append([]Job(nil), q.dead...)
+}
+
+// Requeue moves the dead letter with id back to the queue, its attempts are reset.
+func (q *Queue) Requeue(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, job := range q.dead {
+		if job.ID != id {
+			continue
+		}
+		q.dead = append(q.dead[:i], q.dead[i+1:]...)
+		job.Attempts = 0
+		job.LastError = ""
+		q.pending = append(q.pending, job)
+		return q.changeLocked()
+	}
+	return fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+// next waits for the next pending job and marks it as running, it returns false when ctx is
+// canceled or the queue is closed and empty.
+func (q *Queue) next(ctx context.Context) (Job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if ctx.Err() != nil {
+			return Job{}, false
+		}
+		if len(q.pending) > 0 {
+			job := q.pending[0]
+			q.pending = q.pending[1:]
+			q.running = append(q.running, job)
+			return job, true
+		}
+		if q.closed && len(q.running) == 0 {
+			return Job{}, false
+		}
+
+		changed := q.changed
+		q.mu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+		}
+		q.mu.Lock()
+	}
+}
+
+// process handles the job until it succeeds, fails with a permanent error or exhausts its attempts.
+func (q *Queue) process(ctx context.Context, job Job) {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = q.backoff
+	b.MaxInterval = maxBackoff
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	for {
+		job.Attempts++
+		err := q.handler(ctx, &job)
+
+		var permanent permanentError
+		switch {
+		case err == nil:
+			q.finish(job, nil)
+			return
+		case ctx.Err() != nil:
+			// the job is interrupted, it's kept pending in the store.
+			job.Attempts--
+			q.interrupt(job, ctx.Err())
+			return
+		case errors.As(err, &permanent):
+			job.LastError = err.Error()
+			q.finish(job, err)
+			return
+		}
+
+		job.LastError = err.Error()
+		if job.Attempts >= q.maxAttempts {
+			q.logger.Info("job moved to the dead letters", "id", job.ID, "attempts", job.Attempts, "err", err)
+			q.bury(job, err)
+			return
+		}
+
+		wait := b.NextBackOff()
+		q.logger.Info("retrying the job", "id", job.ID, "attempt", job.Attempts, "wait", wait, "err", err)
+		q.retry(job)
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			q.interrupt(job, ctx.Err())
+			return
+		}
+	}
+}
+
+// finish removes the succeeded or permanently failed job from the queue.
+func (q *Queue) finish(job Job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.removeRunningLocked(job.ID)
+	if err == nil {
+		q.status.Done++
+	} else {
+		q.status.Failed++
+	}
+	q.notifyLocked(job, err)
+	q.saveLocked()
+}
+
+// bury moves the job that failed all its attempts to the dead letters.
+func (q *Queue) bury(job Job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.removeRunningLocked(job.ID)
+	q.dead = append(q.dead, job)
+	q.notifyLocked(job, err)
+	q.saveLocked()
+}
+
+// retry saves the attempts of the job before its next attempt.
+func (q *Queue) retry(job Job) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i := range q.running {
+		if q.running[i].ID == job.ID {
+			q.running[i] = job
+		}
+	}
+	q.status.Retries++
+	q.saveLocked()
+}
+
+// interrupt puts the job interrupted by the cancellation back at the front of the queue.
+func (q *Queue) interrupt(job Job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.removeRunningLocked(job.ID)
+	q.pending = append([]Job{job}, q.pending...)
+	q.notifyLocked(job, err)
+	q.saveLocked()
+}
+
+func (q *Queue) removeRunningLocked(id string) {
+	for i, job := range q.running {
+		if job.ID == id {
+			q.running = append(q.running[:i], q.running[i+1:]...)
+			break
+		}
+	}
+	q.signalLocked()
+}
+
+// notifyLocked sends the outcome of the job to the caller that enqueued it.
+func (q *Queue) notifyLocked(job Job, err error) {
+	if outcome, ok := q.waiters[job.ID]; ok {
+		outcome <- Outcome{Job: job, Err: err}
+		delete(q.waiters, job.ID)
+	}
+}
+
+// changeLocked wakes up the workers and saves the jobs.
+func (q *Queue) changeLocked() error {
+	q.signalLocked()
+	if q.store == nil {
+		return nil
+	}
+	return q.store.Save(q.snapshotLocked())
+}
+
+// saveLocked saves the jobs, the failures are logged since the jobs are still processed.
+func (q *Queue) saveLocked() {
+	if q.store == nil {
+		return
+	}
+	if err := q.store.Save(q.snapshotLocked()); err != nil {
+		q.logger.Error("cannot save the jobs", "err", err)
+	}
+}
+
+// snapshotLocked returns the jobs to save, the running jobs are pending again after a restart.
+func (q *Queue) snapshotLocked() Snapshot {
+	pending := make([]Job, 0, len(q.running)+len(q.pending))
+	pending = append(pending, q.running...)
+	pending = append(pending, q.pending...)
+	return Snapshot{
+		Pending: pending,
+		Dead:    append([]Job(nil), q.dead...),
+	}
+}
+
+func (q *Queue) signalLocked() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
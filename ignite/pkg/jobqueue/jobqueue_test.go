@@ -0,0 +1,174 @@
+package jobqueue_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/jobqueue"
+)
+
+func TestQueue(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts = make(map[string]int)
+	)
+	q, err := jobqueue.New(func(ctx context.Context, job *jobqueue.Job) error {
+		var payload string
+		if err := job.Decode(&payload); err != nil {
+			return jobqueue.Permanent(err)
+		}
+
+		mu.Lock()
+		attempts[payload]++
+		n := attempts[payload]
+		mu.Unlock()
+
+		switch payload {
+		case "flaky":
+			if n < 3 {
+				return errors.New("node unavailable")
+			}
+		case "invalid":
+			return jobqueue.Permanent(errors.New("invalid address"))
+		case "down":
+			return errors.New("node down")
+		}
+		job.Result = "done " + payload
+		return nil
+	}, jobqueue.WithRetry(3, time.Millisecond), jobqueue.WithWorkers(2))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	outcomes := make(map[string]<-chan jobqueue.Outcome)
+	for _, payload := range []string{"ok", "flaky", "invalid", "down"} {
+		outcome, err := q.Enqueue(ctx, payload, payload)
+		require.NoError(t, err)
+		outcomes[payload] = outcome
+	}
+
+	done := make(chan error)
+	go func() { done <- q.Run(ctx) }()
+
+	ok := <-outcomes["ok"]
+	require.NoError(t, ok.Err)
+	require.Equal(t, "done ok", ok.Job.Result)
+	require.Equal(t, 1, ok.Job.Attempts)
+
+	flaky := <-outcomes["flaky"]
+	require.NoError(t, flaky.Err)
+	require.Equal(t, 3, flaky.Job.Attempts)
+
+	invalid := <-outcomes["invalid"]
+	require.EqualError(t, invalid.Err, "invalid address")
+	require.Equal(t, 1, invalid.Job.Attempts)
+
+	down := <-outcomes["down"]
+	require.EqualError(t, down.Err, "node down")
+	require.Equal(t, 3, down.Job.Attempts)
+
+	require.Equal(t, jobqueue.Status{Dead: 1, Done: 2, Failed: 1, Retries: 4}, q.Status())
+	dead := q.DeadLetters()
+	require.Len(t, dead, 1)
+	require.Equal(t, "down", dead[0].ID)
+	require.Equal(t, "node down", dead[0].LastError)
+
+	// a requeued dead letter is attempted again.
+	require.NoError(t, q.Requeue("down"))
+	require.ErrorIs(t, q.Requeue("down"), jobqueue.ErrNotFound)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return attempts["down"] == 6
+	}, time.Second, time.Millisecond)
+
+	q.Close()
+	require.NoError(t, <-done)
+
+	_, err = q.Enqueue(ctx, "", "late")
+	require.ErrorIs(t, err, jobqueue.ErrClosed)
+}
+
+func TestQueueStore(t *testing.T) {
+	store := jobqueue.NewFileStore(filepath.Join(t.TempDir(), "queue", "jobs.json"))
+
+	// the jobs interrupted by the shutdown are kept pending.
+	started := make(chan struct{})
+	q, err := jobqueue.New(func(ctx context.Context, job *jobqueue.Job) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}, jobqueue.WithStore(store))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	outcome, err := q.Enqueue(ctx, "1", map[string]string{"address": "cosmos1"})
+	require.NoError(t, err)
+	_, err = q.Enqueue(ctx, "2", map[string]string{"address": "cosmos2"})
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() { done <- q.Run(ctx) }()
+	<-started
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.ErrorIs(t, (<-outcome).Err, context.Canceled)
+
+	snapshot, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, snapshot.Pending, 2)
+	require.Equal(t, "1", snapshot.Pending[0].ID)
+	require.Equal(t, 0, snapshot.Pending[0].Attempts)
+
+	// the next run processes the saved jobs in order.
+	var handled []string
+	q, err = jobqueue.New(func(ctx context.Context, job *jobqueue.Job) error {
+		var payload map[string]string
+		if err := job.Decode(&payload); err != nil {
+			return err
+		}
+		handled = append(handled, payload["address"])
+		return nil
+	}, jobqueue.WithStore(store))
+	require.NoError(t, err)
+	require.Equal(t, 2, q.Status().Pending)
+
+	q.Close()
+	require.NoError(t, q.Run(context.Background()))
+	require.Equal(t, []string{"cosmos1", "cosmos2"}, handled)
+
+	snapshot, err = store.Load()
+	require.NoError(t, err)
+	require.Empty(t, snapshot.Pending)
+}
+
+func TestQueueCapacity(t *testing.T) {
+	release := make(chan struct{})
+	q, err := jobqueue.New(func(ctx context.Context, job *jobqueue.Job) error {
+		<-release
+		return nil
+	}, jobqueue.WithCapacity(1))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = q.Enqueue(ctx, "", 1)
+	require.NoError(t, err)
+
+	// the queue is full until the first job is processed.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Enqueue(timeoutCtx, "", 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go q.Run(ctx)
+	close(release)
+	_, err = q.Enqueue(ctx, "", 2)
+	require.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+package jobqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Snapshot is the state of the queue saved in a store.
+type Snapshot struct {
+	// Pending are the jobs to process, from the oldest.
+	Pending []Job `json:"pending"`
+
+	// Dead are the jobs that failed all their attempts, from the oldest.
+	Dead []Job `json:"dead"`
+}
+
+// Store persists the jobs of a queue, so they survive restarts.
+type Store interface {
+	// Load returns the saved jobs.
+	Load() (Snapshot, error)
+
+	// Save replaces the saved jobs.
+	Save(Snapshot) error
+}
+
+// FileStore is a store saving the jobs in a JSON file.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore creates a new store saving the jobs in the file at path, the file and its
+// directory are created on the first save.
+func NewFileStore(path string) FileStore {
+	return FileStore{path: path}
+}
+
+// Load returns the jobs saved in the file, none when the file doesn't exist.
+func (s FileStore) Load() (Snapshot, error) {
+	b, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return Snapshot{}, nil
+	}
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("invalid jobs %s: %w", s.path, err)
+	}
+	return snapshot, nil
+}
+
+// Save saves the jobs in the file, the file is replaced atomically so an interrupted save keeps
+// the previous jobs.
+func (s FileStore) Save(snapshot Snapshot) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
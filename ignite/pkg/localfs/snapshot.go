@@ -0,0 +1,134 @@
+package localfs
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// snapshotFile is the state of a file at the time of a snapshot.
+type snapshotFile struct {
+	content []byte
+	mode    fs.FileMode
+}
+
+// Snapshot is the state of the files of a dir at a point in time.
+type Snapshot struct {
+	path  string
+	skip  []string
+	files map[string]snapshotFile
+}
+
+// TakeSnapshot saves in memory the content of the files inside path, the hidden dirs such as
+// .git and the dirs named as one of skipDirs, e.g. node_modules, are skipped.
+func TakeSnapshot(path string, skipDirs ...string) (Snapshot, error) {
+	s := Snapshot{
+		path:  path,
+		skip:  skipDirs,
+		files: make(map[string]snapshotFile),
+	}
+
+	err := filepath.WalkDir(path, func(wpath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if wpath != path && s.isSkipped(d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(wpath)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(path, wpath)
+		if err != nil {
+			return err
+		}
+		s.files[rel] = snapshotFile{content, info.Mode().Perm()}
+
+		return nil
+	})
+
+	return s, err
+}
+
+func (s Snapshot) isSkipped(dir string) bool {
+	if strings.HasPrefix(dir, ".") {
+		return true
+	}
+	for _, skip := range s.skip {
+		if dir == skip {
+			return true
+		}
+	}
+	return false
+}
+
+// Changes returns the sorted paths, relative to the dir of the snapshot, of the files created,
+// modified or deleted since the snapshot.
+func (s Snapshot) Changes() ([]string, error) {
+	current, err := TakeSnapshot(s.path, s.skip...)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	for path, f := range s.files {
+		cf, ok := current.files[path]
+		if !ok || !bytes.Equal(f.content, cf.content) {
+			changes = append(changes, path)
+		}
+	}
+	for path := range current.files {
+		if _, ok := s.files[path]; !ok {
+			changes = append(changes, path)
+		}
+	}
+	sort.Strings(changes)
+
+	return changes, nil
+}
+
+// Restore reverts the files changed since the snapshot to their state at the time of the snapshot,
+// the files created since the snapshot are removed.
+func (s Snapshot) Restore() error {
+	changes, err := s.Changes()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range changes {
+		out := filepath.Join(s.path, path)
+
+		f, ok := s.files[path]
+		if !ok {
+			if err := os.Remove(out); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(out, f.content, f.mode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
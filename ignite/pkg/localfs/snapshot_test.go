@@ -0,0 +1,48 @@
+package localfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	tmpdir := setupGlobTests(t, []string{
+		"foo/modified.ts",
+		"foo/deleted.ts",
+		"foo/unchanged.ts",
+		".git/index",
+		"vue/node_modules/dep/index.js",
+	})
+	write := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tmpdir, path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpdir, path), []byte(content), 0644))
+	}
+
+	s, err := TakeSnapshot(tmpdir, "node_modules")
+	require.NoError(t, err)
+
+	write("foo/modified.ts", "modified")
+	write("foo/bar/created.ts", "created")
+	write(".git/index", "modified")
+	write("vue/node_modules/dep/index.js", "modified")
+	require.NoError(t, os.Remove(filepath.Join(tmpdir, "foo/deleted.ts")))
+
+	changes, err := s.Changes()
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join("foo", "bar", "created.ts"),
+		filepath.Join("foo", "deleted.ts"),
+		filepath.Join("foo", "modified.ts"),
+	}, changes)
+
+	require.NoError(t, s.Restore())
+
+	changes, err = s.Changes()
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.NoFileExists(t, filepath.Join(tmpdir, "foo/bar/created.ts"))
+	require.FileExists(t, filepath.Join(tmpdir, "foo/deleted.ts"))
+}
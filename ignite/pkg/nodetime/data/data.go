@@ -1,6 +1,13 @@
 package data
 
-// Binary returns the compressed platform specific nodetime binary.
+import "github.com/ignite/cli/ignite/pkg/xos"
+
+// Binary returns the compressed platform specific nodetime binary, it's empty when nodetime isn't
+// embedded for the platform. The embedded binaries are linked with the glibc, none is returned on
+// musl systems.
 func Binary() []byte {
+	if xos.IsMusl() {
+		return nil
+	}
 	return binaryCompressed
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/nodetime/data"
+	"github.com/ignite/cli/ignite/pkg/xexec"
 )
 
 // the list of CLIs included.
@@ -67,9 +68,17 @@ func Binary() []byte {
 	return binary
 }
 
+// installCommand installs nodetime when it isn't embedded for the platform.
+const installCommand = "run npm ci && npx tsc && npm install -g . in the scripts/data/gen-nodetime dir of Ignite CLI"
+
 // Command setups the nodetime binary and returns the command needed to execute c.
+// The nodetime installed in PATH is used when it isn't embedded for the platform.
 func Command(c CommandName) (command []string, cleanup func(), err error) {
 	cs := string(c)
+	if len(data.Binary()) == 0 {
+		path, err := xexec.LookInstalled("nodetime", installCommand)
+		return []string{path, cs}, func() {}, err
+	}
 	path, cleanup, err := localfs.SaveBytesTemp(Binary(), cs, 0755)
 	if err != nil {
 		return nil, nil, err
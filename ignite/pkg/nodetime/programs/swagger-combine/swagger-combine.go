@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"sort"
 
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
 	"github.com/ignite/cli/ignite/pkg/nodetime"
@@ -15,6 +16,7 @@ import (
 type Config struct {
 	Swagger string `json:"swagger"`
 	Info    Info   `json:"info"`
+	Tags    []Tag  `json:"tags,omitempty"`
 	APIs    []API  `json:"apis"`
 }
 
@@ -24,6 +26,12 @@ type Info struct {
 	Description string `json:"description"`
 }
 
+// Tag groups the operations of the combined spec.
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
 type API struct {
 	ID           string       `json:"-"`
 	URL          string       `json:"url"`
@@ -75,6 +83,19 @@ func (c *Config) AddSpec(id, path string) error {
 	return nil
 }
 
+// AddTag adds a tag with its description to the combined spec, the tags are sorted by name
+// to not depend on the order in which they are added.
+func (c *Config) AddTag(name, description string) {
+	for _, t := range c.Tags {
+		if t.Name == name {
+			return
+		}
+	}
+
+	c.Tags = append(c.Tags, Tag{Name: name, Description: description})
+	sort.Slice(c.Tags, func(i, j int) bool { return c.Tags[i].Name < c.Tags[j].Name })
+}
+
 // Combine combines openapi specs into one and saves to out path.
 // specs is a spec id-fs path pair.
 func Combine(ctx context.Context, c Config, out string) error {
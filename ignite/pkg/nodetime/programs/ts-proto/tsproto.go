@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/ignite/cli/ignite/pkg/nodetime"
@@ -34,6 +35,12 @@ func BinaryPath() (path string, cleanup func(), err error) {
 %s "$@"
 `, strings.Join(command, " "))
 
+	// protoc runs batch files as plugins on Windows.
+	if runtime.GOOS == "windows" {
+		path += ".cmd"
+		script = fmt.Sprintf("@echo off\r\n\"%s\" %s %%*\r\n", command[0], strings.Join(command[1:], " "))
+	}
+
 	err = os.WriteFile(path, []byte(script), 0755)
 
 	return
@@ -1,6 +1,7 @@
 package protoanalysis
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -21,7 +22,7 @@ func build(p pkg) Package {
 		Path:     p.dir,
 		Files:    br.buildFiles(),
 		Messages: br.buildMessages(),
-		Services: br.toServices(p.services()),
+		Services: br.buildServices(),
 	}
 
 	for _, option := range p.options() {
@@ -46,14 +47,34 @@ func (b builder) buildMessages() (messages []Message) {
 	for _, f := range b.p.files {
 		for _, message := range f.messages {
 
-			// Find the highest field number
-			var highestFieldNumber int
+			// Find the fields, the highest field number and the 64-bit integer fields
+			var (
+				highestFieldNumber int
+				int64Fields        []string
+				fields             []Field
+				options            []Option
+			)
 			for _, elem := range message.Elements {
+				if option, ok := elem.(*proto.Option); ok {
+					options = append(options, buildOption(option))
+				}
 				field, ok := elem.(*proto.NormalField)
 				if ok {
 					if field.Sequence > highestFieldNumber {
 						highestFieldNumber = field.Sequence
 					}
+					if !field.Repeated && isInt64Type(field.Type) {
+						int64Fields = append(int64Fields, field.Name)
+					}
+					fields = append(fields, Field{
+						Name:        field.Name,
+						Number:      field.Sequence,
+						Type:        field.Type,
+						Repeated:    field.Repeated,
+						Description: fieldDescription(field),
+						Example:     fieldExample(field),
+						Options:     buildOptions(field.Options),
+					})
 				}
 			}
 
@@ -81,7 +102,11 @@ func (b builder) buildMessages() (messages []Message) {
 			messages = append(messages, Message{
 				Name:               name,
 				Path:               f.path,
+				Description:        commentText(message.Comment),
 				HighestFieldNumber: highestFieldNumber,
+				Int64Fields:        int64Fields,
+				Fields:             fields,
+				Options:            options,
 			})
 		}
 	}
@@ -89,14 +114,127 @@ func (b builder) buildMessages() (messages []Message) {
 	return messages
 }
 
-func (b builder) toServices(ps []*proto.Service) (services []Service) {
-	for _, service := range ps {
-		s := Service{
-			Name:     service.Name,
-			RPCFuncs: b.elementsToRPCFunc(service.Elements),
+// isInt64Type checks if the proto scalar type is a 64-bit integer.
+func isInt64Type(protoType string) bool {
+	switch protoType {
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64":
+		return true
+	}
+	return false
+}
+
+// buildOption turns a proto option into an Option.
+func buildOption(option *proto.Option) Option {
+	value := option.Constant.Source
+	if option.Constant.OrderedMap != nil || option.Constant.Array != nil {
+		value = literalText(option.Constant)
+	}
+	return Option{Name: option.Name, Value: value}
+}
+
+func buildOptions(options []*proto.Option) []Option {
+	var built []Option
+	for _, option := range options {
+		built = append(built, buildOption(option))
+	}
+	return built
+}
+
+// literalText returns the proto text representation of an aggregate or array literal.
+func literalText(l proto.Literal) string {
+	switch {
+	case l.OrderedMap != nil:
+		var entries []string
+		for _, entry := range l.OrderedMap {
+			entries = append(entries, fmt.Sprintf("%s: %s", entry.Name, literalText(*entry.Literal)))
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	case l.Array != nil:
+		var elems []string
+		for _, elem := range l.Array {
+			elems = append(elems, literalText(*elem))
 		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	default:
+		return l.SourceRepresentation()
+	}
+}
+
+const optionOpenAPIField = "(grpc.gateway.protoc_gen_openapiv2.options.openapiv2_field)"
+
+var exampleLineRe = regexp.MustCompile("^\\s*Example:\\s*`?(.+?)`?\\s*$")
+
+// fieldExample returns the example of the field set by its openapiv2_field option, or by
+// the `Example:` line of its leading comment which is JSON encoded when it isn't valid JSON.
+func fieldExample(field *proto.NormalField) string {
+	for _, option := range field.Options {
+		if option.Name != optionOpenAPIField {
+			continue
+		}
+		if example, ok := option.Constant.Map["example"]; ok && example.Source != "" {
+			return strings.ReplaceAll(example.Source, `\"`, `"`)
+		}
+	}
 
-		services = append(services, s)
+	if field.Comment == nil {
+		return ""
+	}
+	for _, line := range field.Comment.Lines {
+		match := exampleLineRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if json.Valid([]byte(match[1])) {
+			return match[1]
+		}
+		example, _ := json.Marshal(match[1])
+		return string(example)
+	}
+
+	return ""
+}
+
+// fieldDescription returns the text of the leading comment of the field without its `Example:` line.
+func fieldDescription(field *proto.NormalField) string {
+	if field.Comment == nil {
+		return ""
+	}
+	c := *field.Comment
+	c.Lines = nil
+	for _, line := range field.Comment.Lines {
+		if !exampleLineRe.MatchString(line) {
+			c.Lines = append(c.Lines, line)
+		}
+	}
+	return commentText(&c)
+}
+
+// commentText returns the text of the comment, its lines are trimmed and joined by spaces.
+func commentText(c *proto.Comment) string {
+	if c == nil {
+		return ""
+	}
+	var lines []string
+	for _, line := range c.Lines {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, " ")
+}
+
+func (b builder) buildServices() (services []Service) {
+	for _, f := range b.p.files {
+		for _, service := range f.services {
+			s := Service{
+				Name:        service.Name,
+				Path:        f.path,
+				Description: commentText(service.Comment),
+				RPCFuncs:    b.elementsToRPCFunc(service.Elements),
+			}
+
+			services = append(services, s)
+		}
 	}
 
 	return
@@ -124,6 +262,7 @@ func (b builder) elementsToRPCFunc(elems []proto.Visitee) (rpcFuncs []RPCFunc) {
 
 		rf := RPCFunc{
 			Name:        rpc.Name,
+			Description: commentText(rpc.Comment),
 			RequestType: rpc.RequestType,
 			ReturnsType: rpc.ReturnsType,
 			HTTPRules:   b.elementsToHTTPRules(requestMessage, rpc.Elements),
@@ -145,7 +284,10 @@ func (b builder) elementsToHTTPRules(requestMessage *proto.Message, elems []prot
 			continue
 		}
 
-		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, option.Constant)...)
+		// the method of a rule is in the option name when its value is the path template,
+		// e.g. (google.api.http).get = "/blog/posts".
+		method := strings.ToUpper(strings.TrimPrefix(option.Name[strings.LastIndex(option.Name, ")")+1:], "."))
+		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, method, option.Constant)...)
 	}
 
 	return
@@ -153,7 +295,7 @@ func (b builder) elementsToHTTPRules(requestMessage *proto.Message, elems []prot
 
 var urlParamRe = regexp.MustCompile(`(?m){(.+?)}`)
 
-func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant proto.Literal) (httpRules []HTTPRule) {
+func (b builder) constantToHTTPRules(requestMessage *proto.Message, method string, constant proto.Literal) (httpRules []HTTPRule) {
 	// find out the endpoint template.
 	endpoint := constant.Source
 
@@ -167,6 +309,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 				"patch",
 				"delete":
 				endpoint = val.Source
+				method = strings.ToUpper(key)
 			}
 			if endpoint != "" {
 				break
@@ -201,6 +344,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 
 	// create and add the HTTP rule to the list.
 	httpRule := HTTPRule{
+		Endpoint: strings.TrimSpace(method + " " + endpoint),
 		Params:   params,
 		HasQuery: queryParamsCount > 0,
 		HasBody:  bodyFieldsCount > 0,
@@ -210,7 +354,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 
 	// search for nested HTTP rules.
 	if constant, ok := constant.Map["additional_bindings"]; ok {
-		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, *constant)...)
+		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, "", *constant)...)
 	}
 
 	return httpRules
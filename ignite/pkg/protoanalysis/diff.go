@@ -0,0 +1,198 @@
+package protoanalysis
+
+import (
+	"fmt"
+	"sort"
+)
+
+// msgServiceName is the name of the services of the Msgs of the Cosmos SDK modules.
+const msgServiceName = "Msg"
+
+// Diff lists the changes of the API of proto packages between two versions.
+type Diff struct {
+	// NewMsgs are the RPC funcs added to the Msg services, e.g. blog.Msg/CreatePost.
+	NewMsgs []string
+
+	// NewEndpoints are the HTTP endpoints and the gRPC methods added to the other services,
+	// e.g. GET /blog/posts/{id} (blog.Query/Post).
+	NewEndpoints []string
+
+	// Breaking are the changes breaking the clients of the API, e.g. a removed RPC func or a
+	// removed field of a request.
+	Breaking []string
+
+	// StateBreaking are the changes of the encoding of the messages that aren't requests nor
+	// responses of RPC funcs, the chain stores them in its state.
+	StateBreaking []string
+}
+
+// Empty returns true when there isn't any change.
+func (d Diff) Empty() bool {
+	return len(d.NewMsgs) == 0 && len(d.NewEndpoints) == 0 && len(d.Breaking) == 0 && len(d.StateBreaking) == 0
+}
+
+// Compare returns the changes of the API from the old packages to the new ones.
+func Compare(old, new Packages) Diff {
+	var (
+		d       Diff
+		oldRPCs = rpcFuncsByName(old)
+		newRPCs = rpcFuncsByName(new)
+	)
+
+	for name, rpc := range newRPCs {
+		oldRPC, ok := oldRPCs[name]
+		if !ok {
+			if rpc.service == msgServiceName {
+				d.NewMsgs = append(d.NewMsgs, name)
+				continue
+			}
+			if len(rpc.HTTPRules) == 0 {
+				d.NewEndpoints = append(d.NewEndpoints, fmt.Sprintf("gRPC %s", name))
+			}
+			for _, endpoint := range rpc.endpoints() {
+				d.NewEndpoints = append(d.NewEndpoints, fmt.Sprintf("%s (%s)", endpoint, name))
+			}
+			continue
+		}
+
+		if oldRPC.RequestType != rpc.RequestType {
+			d.Breaking = append(d.Breaking, fmt.Sprintf("%s: request type changed from %s to %s", name, oldRPC.RequestType, rpc.RequestType))
+		}
+		if oldRPC.ReturnsType != rpc.ReturnsType {
+			d.Breaking = append(d.Breaking, fmt.Sprintf("%s: response type changed from %s to %s", name, oldRPC.ReturnsType, rpc.ReturnsType))
+		}
+
+		oldEndpoints := make(map[string]bool)
+		for _, endpoint := range oldRPC.endpoints() {
+			oldEndpoints[endpoint] = true
+		}
+		for _, endpoint := range rpc.endpoints() {
+			if !oldEndpoints[endpoint] {
+				d.NewEndpoints = append(d.NewEndpoints, fmt.Sprintf("%s (%s)", endpoint, name))
+			}
+			delete(oldEndpoints, endpoint)
+		}
+		for endpoint := range oldEndpoints {
+			d.Breaking = append(d.Breaking, fmt.Sprintf("%s: endpoint %s removed", name, endpoint))
+		}
+	}
+	for name := range oldRPCs {
+		if _, ok := newRPCs[name]; !ok {
+			d.Breaking = append(d.Breaking, fmt.Sprintf("%s removed", name))
+		}
+	}
+
+	// the changes of the requests and the responses break the clients,
+	// the changes of the other messages break the state.
+	rpcTypes := make(map[string]bool)
+	for _, rpcs := range []map[string]rpcFunc{oldRPCs, newRPCs} {
+		for _, rpc := range rpcs {
+			rpcTypes[rpc.pkg+"."+rpc.RequestType] = true
+			rpcTypes[rpc.pkg+"."+rpc.ReturnsType] = true
+		}
+	}
+
+	newMessages := messagesByName(new)
+	for name, oldMessage := range messagesByName(old) {
+		newMessage, ok := newMessages[name]
+		if !ok && rpcTypes[name] {
+			// the changes of the RPC funcs are already listed.
+			continue
+		}
+		changes := []string{"removed"}
+		if ok {
+			changes = compareFields(oldMessage, newMessage)
+		}
+		for _, change := range changes {
+			change = fmt.Sprintf("%s %s", name, change)
+			if rpcTypes[name] {
+				d.Breaking = append(d.Breaking, change)
+			} else {
+				d.StateBreaking = append(d.StateBreaking, change)
+			}
+		}
+	}
+
+	sort.Strings(d.NewMsgs)
+	sort.Strings(d.NewEndpoints)
+	sort.Strings(d.Breaking)
+	sort.Strings(d.StateBreaking)
+
+	return d
+}
+
+// rpcFunc is an RPC func with its package and service.
+type rpcFunc struct {
+	RPCFunc
+	pkg     string
+	service string
+}
+
+// endpoints returns the HTTP endpoints of the RPC func.
+func (f rpcFunc) endpoints() []string {
+	var endpoints []string
+	for _, rule := range f.HTTPRules {
+		if rule.Endpoint != "" {
+			endpoints = append(endpoints, rule.Endpoint)
+		}
+	}
+	return endpoints
+}
+
+// rpcFuncsByName indexes the RPC funcs of the packages by their full name, e.g. blog.Msg/CreatePost.
+func rpcFuncsByName(pkgs Packages) map[string]rpcFunc {
+	rpcs := make(map[string]rpcFunc)
+	for _, pkg := range pkgs {
+		for _, service := range pkg.Services {
+			for _, rpc := range service.RPCFuncs {
+				name := fmt.Sprintf("%s.%s/%s", pkg.Name, service.Name, rpc.Name)
+				rpcs[name] = rpcFunc{RPCFunc: rpc, pkg: pkg.Name, service: service.Name}
+			}
+		}
+	}
+	return rpcs
+}
+
+// messagesByName indexes the messages of the packages by their full name, e.g. blog.Post.
+func messagesByName(pkgs Packages) map[string]Message {
+	messages := make(map[string]Message)
+	for _, pkg := range pkgs {
+		for _, message := range pkg.Messages {
+			messages[pkg.Name+"."+message.Name] = message
+		}
+	}
+	return messages
+}
+
+// compareFields returns the changes of the encoding of the fields of the message,
+// the fields are identified by their numbers. The new fields don't change the encoding
+// of the existing messages.
+func compareFields(old, new Message) (changes []string) {
+	newFields := make(map[int]Field)
+	for _, field := range new.Fields {
+		newFields[field.Number] = field
+	}
+
+	for _, oldField := range old.Fields {
+		field, ok := newFields[oldField.Number]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("field %s (%d) removed", oldField.Name, oldField.Number))
+		case field.Type != oldField.Type || field.Repeated != oldField.Repeated:
+			changes = append(changes, fmt.Sprintf("field %s (%d) type changed from %s to %s",
+				oldField.Name, oldField.Number, fieldType(oldField), fieldType(field)))
+		case field.Name != oldField.Name:
+			// the JSON and amino encodings use the names of the fields.
+			changes = append(changes, fmt.Sprintf("field %s (%d) renamed to %s", oldField.Name, oldField.Number, field.Name))
+		}
+	}
+	return changes
+}
+
+// fieldType returns the type of the field as declared in the proto file.
+func fieldType(f Field) string {
+	if f.Repeated {
+		return "repeated " + f.Type
+	}
+	return f.Type
+}
@@ -0,0 +1,98 @@
+package protoanalysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	old := Packages{
+		{
+			Name: "blog",
+			Messages: []Message{
+				{Name: "Post", Fields: []Field{
+					{Name: "id", Number: 1, Type: "uint64"},
+					{Name: "title", Number: 2, Type: "string"},
+					{Name: "tags", Number: 3, Type: "string", Repeated: true},
+					{Name: "body", Number: 4, Type: "string"},
+				}},
+				{Name: "Comment", Fields: []Field{{Name: "body", Number: 1, Type: "string"}}},
+				{Name: "QueryPostRequest", Fields: []Field{{Name: "id", Number: 1, Type: "uint64"}}},
+				{Name: "QueryPostResponse"},
+				{Name: "QueryCommentsRequest"},
+				{Name: "QueryCommentsResponse"},
+				{Name: "MsgCreatePost", Fields: []Field{{Name: "creator", Number: 1, Type: "string"}}},
+				{Name: "MsgCreatePostResponse"},
+			},
+			Services: []Service{
+				{Name: "Query", RPCFuncs: []RPCFunc{
+					{Name: "Post", RequestType: "QueryPostRequest", ReturnsType: "QueryPostResponse", HTTPRules: []HTTPRule{
+						{Endpoint: "GET /blog/posts/{id}"},
+					}},
+					{Name: "Comments", RequestType: "QueryCommentsRequest", ReturnsType: "QueryCommentsResponse"},
+				}},
+				{Name: "Msg", RPCFuncs: []RPCFunc{
+					{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+				}},
+			},
+		},
+	}
+
+	require.True(t, Compare(old, old).Empty())
+
+	new := Packages{
+		{
+			Name: "blog",
+			Messages: []Message{
+				{Name: "Post", Fields: []Field{
+					{Name: "id", Number: 1, Type: "uint64"},
+					{Name: "name", Number: 2, Type: "string"},
+					{Name: "tags", Number: 3, Type: "string"},
+					{Name: "author", Number: 5, Type: "string"},
+				}},
+				{Name: "QueryPostRequest", Fields: []Field{{Name: "id", Number: 1, Type: "string"}}},
+				{Name: "QueryPostResponse"},
+				{Name: "QueryPostsRequest"},
+				{Name: "QueryPostsResponse"},
+				{Name: "MsgCreatePost", Fields: []Field{{Name: "creator", Number: 1, Type: "string"}}},
+				{Name: "MsgCreatePostResponse"},
+				{Name: "MsgDeletePost"},
+				{Name: "MsgDeletePostResponse"},
+			},
+			Services: []Service{
+				{Name: "Query", RPCFuncs: []RPCFunc{
+					{Name: "Post", RequestType: "QueryPostRequest", ReturnsType: "QueryPostResponse", HTTPRules: []HTTPRule{
+						{Endpoint: "GET /blog/post/{id}"},
+					}},
+					{Name: "Posts", RequestType: "QueryPostsRequest", ReturnsType: "QueryPostsResponse", HTTPRules: []HTTPRule{
+						{Endpoint: "GET /blog/posts"},
+					}},
+				}},
+				{Name: "Msg", RPCFuncs: []RPCFunc{
+					{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+					{Name: "DeletePost", RequestType: "MsgDeletePost", ReturnsType: "MsgDeletePostResponse"},
+				}},
+			},
+		},
+	}
+
+	require.Equal(t, Diff{
+		NewMsgs: []string{"blog.Msg/DeletePost"},
+		NewEndpoints: []string{
+			"GET /blog/post/{id} (blog.Query/Post)",
+			"GET /blog/posts (blog.Query/Posts)",
+		},
+		Breaking: []string{
+			"blog.Query/Comments removed",
+			"blog.Query/Post: endpoint GET /blog/posts/{id} removed",
+			"blog.QueryPostRequest field id (1) type changed from uint64 to string",
+		},
+		StateBreaking: []string{
+			"blog.Comment removed",
+			"blog.Post field body (4) removed",
+			"blog.Post field tags (3) type changed from repeated string to string",
+			"blog.Post field title (2) renamed to name",
+		},
+	}, Compare(old, new))
+}
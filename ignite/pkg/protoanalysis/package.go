@@ -78,9 +78,70 @@ type Message struct {
 	// Path of the file where message is defined at.
 	Path string
 
+	// Description of the message from its leading comment.
+	Description string
+
 	// HighestFieldNumber is the highest field number among fields of the message
 	// This allows to determine new field number when writing to proto message
 	HighestFieldNumber int
+
+	// Int64Fields is the list of the singular 64-bit integer fields of the message.
+	// proto3 JSON encodes them as strings to keep their precision.
+	Int64Fields []string
+
+	// Fields is the list of the fields of the message, map and oneof fields excluded.
+	Fields []Field
+
+	// Options is the list of the options of the message, e.g. its cosmos.msg.v1.signer options.
+	Options []Option
+}
+
+// Signers returns the names of the signer fields of the message set by its cosmos.msg.v1.signer options.
+func (m Message) Signers() []string {
+	var signers []string
+	for _, option := range m.Options {
+		if option.Name == OptionMsgSigner {
+			signers = append(signers, option.Value)
+		}
+	}
+	return signers
+}
+
+// Field represents a field of a proto message.
+type Field struct {
+	// Name of the field.
+	Name string
+
+	// Number of the field in the encoding of the message.
+	Number int
+
+	// Type of the field, it is either a scalar type or the name of a message.
+	Type string
+
+	// Repeated indicates if the field is a list.
+	Repeated bool
+
+	// Description of the field from its leading comment, the `Example:` line excluded.
+	Description string
+
+	// Example is the JSON encoded example value of the field, empty when it is not documented.
+	// It is set by the example of the openapiv2_field option or by an `Example:` line of the
+	// leading comment of the field.
+	Example string
+
+	// Options is the list of the options of the field, e.g. its gogoproto or amino options.
+	Options []Option
+}
+
+// Option represents a proto option of a message or a field, custom options included.
+type Option struct {
+	// Name of the option, custom option names are in parentheses, e.g. (cosmos.msg.v1.signer).
+	// The name of the field of an aggregate custom option follows them, e.g. (validate.rules).string.
+	Name string
+
+	// Value of the option, the source of its constant without its quotes.
+	// The value of an aggregate option is its proto text representation.
+	Value string
 }
 
 // Service is an RPC service.
@@ -88,6 +149,12 @@ type Service struct {
 	// Name of the services.
 	Name string
 
+	// Path of the file where the service is defined.
+	Path string
+
+	// Description of the service from its leading comment.
+	Description string
+
 	// RPC is a list of RPC funcs of the service.
 	RPCFuncs []RPCFunc
 }
@@ -97,6 +164,9 @@ type RPCFunc struct {
 	// Name of the RPC func.
 	Name string
 
+	// Description of the RPC func from its leading comment.
+	Description string
+
 	// RequestType is the request type of RPC func.
 	RequestType string
 
@@ -111,6 +181,9 @@ type RPCFunc struct {
 
 // HTTPRule keeps info about a configured http rule of an RPC func.
 type HTTPRule struct {
+	// Endpoint is the HTTP method and the path template of the rule, e.g. GET /blog/posts/{id}.
+	Endpoint string
+
 	// Params is a list of parameters defined in the http endpoint itself.
 	Params []string
 
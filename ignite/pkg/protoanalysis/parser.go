@@ -81,14 +81,6 @@ func (p *pkg) messages() (m []*proto.Message) {
 	return
 }
 
-func (p *pkg) services() (s []*proto.Service) {
-	for _, f := range p.files {
-		s = append(s, f.services...)
-	}
-
-	return
-}
-
 func (p *parser) parseFile(path string) error {
 	f, err := os.Open(path)
 	if err != nil {
@@ -13,6 +13,10 @@ var ErrImportNotFound = errors.New("proto import not found")
 
 const protoFilePattern = "*.proto"
 
+// OptionMsgSigner is the option of a Msg annotating its signer fields,
+// the Msg services are routed with it since Cosmos SDK v0.46.
+const OptionMsgSigner = "(cosmos.msg.v1.signer)"
+
 type Cache map[string]Packages // proto dir path-proto packages pair.
 
 func NewCache() Cache {
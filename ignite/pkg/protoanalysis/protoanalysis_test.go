@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/emicklei/proto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -49,99 +50,613 @@ func TestLiquidity(t *testing.T) {
 			},
 			GoImportName: "github.com/tendermint/liquidity/x/liquidity/types",
 			Messages: []Message{
-				{Name: "PoolRecord", Path: "testdata/liquidity/genesis.proto", HighestFieldNumber: 6},
-				{Name: "GenesisState", Path: "testdata/liquidity/genesis.proto", HighestFieldNumber: 2},
-				{Name: "PoolType", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 5},
-				{Name: "Params", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 9},
-				{Name: "Pool", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 5},
-				{Name: "PoolMetadata", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 3},
-				{Name: "PoolMetadataResponse", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 2},
-				{Name: "PoolBatch", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 7},
-				{Name: "PoolBatchResponse", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 6},
-				{Name: "DepositMsgState", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 6},
-				{Name: "WithdrawMsgState", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 6},
-				{Name: "SwapMsgState", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 10},
-				{Name: "QueryLiquidityPoolRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolBatchRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolBatchResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryParamsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 0},
-				{Name: "QueryParamsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryPoolBatchSwapMsgsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchSwapMsgRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchSwapMsgsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchSwapMsgResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryPoolBatchDepositMsgsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchDepositMsgRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchDepositMsgsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchDepositMsgResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryPoolBatchWithdrawMsgsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchWithdrawMsgRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchWithdrawMsgsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchWithdrawMsgResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "MsgCreatePool", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 4},
-				{Name: "MsgCreatePoolRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 2},
-				{Name: "MsgCreatePoolResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "MsgDepositWithinBatch", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgDepositWithinBatchRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgDepositWithinBatchResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "MsgWithdrawWithinBatch", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgWithdrawWithinBatchRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgWithdrawWithinBatchResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "MsgSwapWithinBatch", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 7},
-				{Name: "MsgSwapWithinBatchRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgSwapWithinBatchResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "BaseReq", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 11},
-				{Name: "Fee", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 2},
-				{Name: "PubKey", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 2},
-				{Name: "Signature", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 4},
-				{Name: "StdTx", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 4},
+				{
+					Name:               "PoolRecord",
+					Path:               "testdata/liquidity/genesis.proto",
+					HighestFieldNumber: 6,
+					Fields: []Field{
+						{Name: "pool", Number: 1, Type: "Pool"},
+						{Name: "pool_metadata", Number: 2, Type: "PoolMetadata"},
+						{Name: "pool_batch", Number: 3, Type: "PoolBatch"},
+						{Name: "deposit_msg_states", Number: 4, Type: "DepositMsgState", Repeated: true},
+						{Name: "withdraw_msg_states", Number: 5, Type: "WithdrawMsgState", Repeated: true},
+						{Name: "swap_msg_states", Number: 6, Type: "SwapMsgState", Repeated: true},
+					},
+				},
+				{
+					Name:               "GenesisState",
+					Path:               "testdata/liquidity/genesis.proto",
+					Description:        "GenesisState defines the liquidity module's genesis state.",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "params", Number: 1, Type: "Params", Description: "params defines all the parameters of related to liquidity."},
+						{Name: "pool_records", Number: 2, Type: "PoolRecord", Repeated: true},
+					},
+				},
+				{
+					Name:               "PoolType",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 5,
+					Fields: []Field{
+						{Name: "id", Number: 1, Type: "uint32", Description: "id of target pool type, only 1 is allowed on this version.", Example: `"1"`},
+						{Name: "name", Number: 2, Type: "string", Description: "name of the pool type", Example: `"ConstantProductLiquidityPool"`},
+						{Name: "min_reserve_coin_num", Number: 3, Type: "uint32", Description: "min number of reserveCoins for LiquidityPoolType only 2 is allowed on this spec", Example: `"2"`},
+						{Name: "max_reserve_coin_num", Number: 4, Type: "uint32", Description: "max number of reserveCoins for LiquidityPoolType only 2 is allowed on this spec", Example: `"2"`},
+						{Name: "description", Number: 5, Type: "string", Description: "description of the pool type"},
+					},
+				},
+				{
+					Name:               "Params",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 9,
+					Fields: []Field{
+						{Name: "pool_types", Number: 1, Type: "PoolType", Repeated: true, Description: "list of available pool types"},
+						{Name: "min_init_deposit_amount", Number: 2, Type: "string", Description: "Minimum number of coins to be deposited to the liquidity pool upon pool creation", Example: `"1000000"`},
+						{Name: "init_pool_coin_mint_amount", Number: 3, Type: "string", Description: "Initial mint amount of pool coin upon pool creation", Example: `"1000000"`},
+						{Name: "max_reserve_coin_amount", Number: 4, Type: "string", Description: "Limit the size of each liquidity pool in the beginning phase of Liquidity Module adoption to minimize risk, 0 means no limit", Example: `"1000000000000"`},
+						{Name: "pool_creation_fee", Number: 5, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "Fee paid for new Liquidity Pool creation to prevent spamming", Example: `[{"denom": "uatom", "amount": "100000000"}]`},
+						{Name: "swap_fee_rate", Number: 6, Type: "bytes", Description: "Swap fee rate for every executed swap", Example: `"0.003"`},
+						{Name: "withdraw_fee_rate", Number: 7, Type: "bytes", Description: "Reserve coin withdrawal with less proportion by withdrawFeeRate", Example: `"0.003"`},
+						{Name: "max_order_amount_ratio", Number: 8, Type: "bytes", Description: "Maximum ratio of reserve coins that can be ordered at a swap order", Example: `"0.003"`},
+						{Name: "unit_batch_height", Number: 9, Type: "uint32", Description: "The smallest unit batch height for every liquidity pool", Example: `"1"`},
+					},
+				},
+				{
+					Name:               "Pool",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 5,
+					Int64Fields:        []string{"id"},
+					Fields: []Field{
+						{Name: "id", Number: 1, Type: "uint64", Description: "id of the pool", Example: `"1"`},
+						{Name: "type_id", Number: 2, Type: "uint32", Description: "id of the pool type", Example: `"1"`},
+						{Name: "reserve_coin_denoms", Number: 3, Type: "string", Repeated: true, Description: "denoms of reserve coin pair of the pool", Example: `["denomX","denomY"]`},
+						{Name: "reserve_account_address", Number: 4, Type: "string", Description: "reserve account address of the pool", Example: `"cosmos16ddqestwukv0jzcyfn3fdfq9h2wrs83cr4rfm3"`},
+						{Name: "pool_coin_denom", Number: 5, Type: "string", Description: "denom of pool coin of the pool", Example: `"poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4"`},
+					},
+				},
+				{
+					Name:               "PoolMetadata",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 3,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the pool", Example: `"1"`},
+						{Name: "pool_coin_total_supply", Number: 2, Type: "cosmos.base.v1beta1.Coin", Description: "pool coin issued at the pool", Example: `{"denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4", "amount": "1000000"}`},
+						{Name: "reserve_coins", Number: 3, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "reserve coins deposited in the pool", Example: `[{"denom": "denomX", "amount": "1000000"}, {"denom": "denomY", "amount": "2000000"}]`},
+					},
+				},
+				{
+					Name:               "PoolMetadataResponse",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_coin_total_supply", Number: 1, Type: "cosmos.base.v1beta1.Coin", Description: "pool coin issued at the pool", Example: `{"denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4", "amount": "1000000"}`},
+						{Name: "reserve_coins", Number: 2, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "reserve coins deposited in the pool", Example: `[{"denom": "denomX", "amount": "1000000"}, {"denom": "denomY", "amount": "2000000"}]`},
+					},
+				},
+				{
+					Name:               "PoolBatch",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 7,
+					Int64Fields:        []string{"pool_id", "index", "begin_height", "deposit_msg_index", "withdraw_msg_index", "swap_msg_index"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the pool", Example: `"1"`},
+						{Name: "index", Number: 2, Type: "uint64", Description: "index of this batch", Example: `"1"`},
+						{Name: "begin_height", Number: 3, Type: "int64", Description: "height where this batch is begun", Example: `"1000"`},
+						{Name: "deposit_msg_index", Number: 4, Type: "uint64", Description: "last index of DepositMsgStates", Example: `"1"`},
+						{Name: "withdraw_msg_index", Number: 5, Type: "uint64", Description: "last index of WithdrawMsgStates", Example: `"1"`},
+						{Name: "swap_msg_index", Number: 6, Type: "uint64", Description: "last index of SwapMsgStates", Example: `"1"`},
+						{Name: "executed", Number: 7, Type: "bool", Description: "true if executed, false if not executed yet", Example: "true"},
+					},
+				},
+				{
+					Name:               "PoolBatchResponse",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 6,
+					Int64Fields:        []string{"index", "begin_height", "deposit_msg_index", "withdraw_msg_index", "swap_msg_index"},
+					Fields: []Field{
+						{Name: "index", Number: 1, Type: "uint64", Description: "index of this batch", Example: `"1"`},
+						{Name: "begin_height", Number: 2, Type: "int64", Description: "height where this batch is begun", Example: `"1000"`},
+						{Name: "deposit_msg_index", Number: 3, Type: "uint64", Description: "last index of DepositMsgStates", Example: `"1"`},
+						{Name: "withdraw_msg_index", Number: 4, Type: "uint64", Description: "last index of WithdrawMsgStates", Example: `"1"`},
+						{Name: "swap_msg_index", Number: 5, Type: "uint64", Description: "last index of SwapMsgStates", Example: `"1"`},
+						{Name: "executed", Number: 6, Type: "bool", Description: "true if executed, false if not executed yet", Example: "true"},
+					},
+				},
+				{
+					Name:               "DepositMsgState",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 6,
+					Int64Fields:        []string{"msg_height", "msg_index"},
+					Fields: []Field{
+						{Name: "msg_height", Number: 1, Type: "int64", Description: "height where this message is appended to the batch", Example: `"1000"`},
+						{Name: "msg_index", Number: 2, Type: "uint64", Description: "index of this deposit message in this liquidity pool", Example: `"1"`},
+						{Name: "executed", Number: 3, Type: "bool", Description: "true if executed on this batch, false if not executed yet", Example: "true"},
+						{Name: "succeeded", Number: 4, Type: "bool", Description: "true if executed successfully on this batch, false if failed", Example: "true"},
+						{Name: "to_be_deleted", Number: 5, Type: "bool", Description: "true if ready to be deleted on kvstore, false if not ready to be deleted", Example: "true"},
+						{Name: "msg", Number: 6, Type: "MsgDepositWithinBatch", Description: "MsgDepositWithinBatch"},
+					},
+				},
+				{
+					Name:               "WithdrawMsgState",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 6,
+					Int64Fields:        []string{"msg_height", "msg_index"},
+					Fields: []Field{
+						{Name: "msg_height", Number: 1, Type: "int64", Description: "height where this message is appended to the batch", Example: `"1000"`},
+						{Name: "msg_index", Number: 2, Type: "uint64", Description: "index of this withdraw message in this liquidity pool", Example: `"1"`},
+						{Name: "executed", Number: 3, Type: "bool", Description: "true if executed on this batch, false if not executed yet", Example: "true"},
+						{Name: "succeeded", Number: 4, Type: "bool", Description: "true if executed successfully on this batch, false if failed", Example: "true"},
+						{Name: "to_be_deleted", Number: 5, Type: "bool", Description: "true if ready to be deleted on kvstore, false if not ready to be deleted", Example: "true"},
+						{Name: "msg", Number: 6, Type: "MsgWithdrawWithinBatch", Description: "MsgWithdrawWithinBatch"},
+					},
+				},
+				{
+					Name:               "SwapMsgState",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 10,
+					Int64Fields:        []string{"msg_height", "msg_index", "order_expiry_height"},
+					Fields: []Field{
+						{Name: "msg_height", Number: 1, Type: "int64", Description: "height where this message is appended to the batch", Example: `"1000"`},
+						{Name: "msg_index", Number: 2, Type: "uint64", Description: "index of this swap message in this liquidity pool", Example: `"1"`},
+						{Name: "executed", Number: 3, Type: "bool", Description: "true if executed on this batch, false if not executed yet", Example: "true"},
+						{Name: "succeeded", Number: 4, Type: "bool", Description: "true if executed successfully on this batch, false if failed", Example: "true"},
+						{Name: "to_be_deleted", Number: 5, Type: "bool", Description: "true if ready to be deleted on kvstore, false if not ready to be deleted", Example: "true"},
+						{Name: "order_expiry_height", Number: 6, Type: "int64", Description: "swap orders are cancelled when current height is equal or higher than ExpiryHeight", Example: `"1000"`},
+						{Name: "exchanged_offer_coin", Number: 7, Type: "cosmos.base.v1beta1.Coin", Description: "offer coin exchanged until now", Example: `{"denom": "denomX", "amount": "600000"}`},
+						{Name: "remaining_offer_coin", Number: 8, Type: "cosmos.base.v1beta1.Coin", Description: "offer coin currently remaining to be exchanged", Example: `{"denom": "denomX", "amount": "400000"}`},
+						{Name: "reserved_offer_coin_fee", Number: 9, Type: "cosmos.base.v1beta1.Coin", Description: "reserve fee for pays fee in half offer coin", Example: `{"denom": "denomX", "amount": "5000"}`},
+						{Name: "msg", Number: 10, Type: "MsgSwapWithinBatch", Description: "MsgSwapWithinBatch"},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryLiquidityPool RPC method. requestable specified pool_id.",
+					HighestFieldNumber: 1,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64"},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryLiquidityPoolResponse RPC method. It returns the liquidity pool corresponding to the requested pool_id.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "pool", Number: 1, Type: "Pool"},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolBatchRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryLiquidityPoolBatch RPC method. requestable including specified pool_id.",
+					HighestFieldNumber: 1,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolBatchResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryLiquidityPoolBatchResponse RPC method. It returns the liquidity pool batch corresponding to the requested pool_id.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "batch", Number: 1, Type: "PoolBatch"},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryLiquidityPools RPC method. requestable including pagination offset, limit, key.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "pagination", Number: 1, Type: "cosmos.base.query.v1beta1.PageRequest", Description: "pagination defines an optional pagination for the request."},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryLiquidityPoolsResponse RPC method. This includes list of all liquidity pools currently existed and paging results containing next_key and total count.",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pools", Number: 1, Type: "Pool", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Description: "pagination defines the pagination in the response. not working on this version."},
+					},
+				},
+				{
+					Name:               "QueryParamsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "QueryParamsRequest is request type for the QueryParams RPC method.",
+					HighestFieldNumber: 0,
+				},
+				{
+					Name:               "QueryParamsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryParamsResponse RPC method. This includes current parameter of the liquidity module.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "params", Number: 1, Type: "Params", Description: "params holds all the parameters of this module."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryPoolBatchSwapMsgs RPC method. requestable including specified pool_id and pagination offset, limit, key.",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageRequest", Description: "pagination defines an optional pagination for the request."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryPoolBatchSwap RPC method. requestable including specified pool_id and msg_index",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"pool_id", "msg_index"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Description: "target msg_index of the pool"},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryPoolBatchSwapMsgs RPC method. This includes list of all currently existing swap messages of the batch and paging results containing next_key and total count.",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "swaps", Number: 1, Type: "SwapMsgState", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Description: "pagination defines the pagination in the response. not working on this version."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryPoolBatchSwapMsg RPC method. This includes a batch swap message of the batch",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "swap", Number: 1, Type: "SwapMsgState"},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryPoolBatchDeposit RPC method. requestable including specified pool_id and pagination offset, limit, key.",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageRequest", Description: "pagination defines an optional pagination for the request."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryPoolBatchDeposit RPC method. requestable including specified pool_id and msg_index",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"pool_id", "msg_index"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Description: "target msg_index of the pool"},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryPoolBatchDeposit RPC method. This includes a list of all currently existing deposit messages of the batch and paging results containing next_key and total count.",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "deposits", Number: 1, Type: "DepositMsgState", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Description: "pagination defines the pagination in the response. not working on this version."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryPoolBatchDepositMsg RPC method. This includes a batch swap message of the batch",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "deposit", Number: 1, Type: "DepositMsgState"},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryPoolBatchWithdraw RPC method. requestable including specified pool_id and pagination offset, limit, key.",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageRequest", Description: "pagination defines an optional pagination for the request."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgRequest",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the request type for the QueryPoolBatchWithdraw RPC method. requestable including specified pool_id and msg_index",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"pool_id", "msg_index"},
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Description: "id of the target pool for query"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Description: "target msg_index of the pool"},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryPoolBatchWithdraw RPC method. This includes a list of all currently existing withdraw messages of the batch and paging results containing next_key and total count.",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "withdraws", Number: 1, Type: "WithdrawMsgState", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Description: "pagination defines the pagination in the response. not working on this version."},
+					},
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgResponse",
+					Path:               "testdata/liquidity/query.proto",
+					Description:        "the response type for the QueryPoolBatchWithdrawMsg RPC method. This includes a batch swap message of the batch",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "withdraw", Number: 1, Type: "WithdrawMsgState"},
+					},
+				},
+				{
+					Name:               "MsgCreatePool",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgCreatePool defines an sdk.Msg type that supports submitting create liquidity pool",
+					HighestFieldNumber: 4,
+					Fields: []Field{
+						{Name: "pool_creator_address", Number: 1, Type: "string", Example: `"cosmos1e35y69rhrt7y4yce5l5u73sjnxu0l33wvznyun"`},
+						{Name: "pool_type_id", Number: 2, Type: "uint32", Description: "id of target pool type, only 1 is allowed on this version, Must match the value in the pool.", Example: `"1"`},
+						{Name: "deposit_coins", Number: 4, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "reserve coin pair of the pool to deposit", Example: `[{"denom": "denomX", "amount": "1000000"}, {"denom": "denomY", "amount": "2000000"}]`},
+					},
+				},
+				{
+					Name:               "MsgCreatePoolRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgCreatePoolRequest is the request type for the Msg/MsgCreatePoolRequest RPC method.",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "msg", Number: 2, Type: "MsgCreatePool", Description: "MsgCreatePool"},
+					},
+				},
+				{
+					Name:               "MsgCreatePoolResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgCreatePoolResponse defines the Msg/CreatePool response type.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+				},
+				{
+					Name:               "MsgDepositWithinBatch",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "`MsgDepositWithinBatch defines` an `sdk.Msg` type that supports submitting deposit request to the batch of the liquidity pool Deposit submit to the batch of the Liquidity pool with the specified `pool_id`, deposit_coins for reserve this requests are stacked in the batch of the liquidity pool, not immediately processed and processed in the `endblock` at once with other requests. See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md",
+					HighestFieldNumber: 3,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "depositor_address", Number: 1, Type: "string", Description: "The publisher in which to create the book. Format: `publishers/{publisher}`", Example: `"cosmos1e35y69rhrt7y4yce5l5u73sjnxu0l33wvznyun"`},
+						{Name: "pool_id", Number: 2, Type: "uint64", Description: "id of the target pool", Example: `"1"`},
+						{Name: "deposit_coins", Number: 3, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "reserve coin pair of the pool to deposit", Example: `[{"denom": "denomX", "amount": "1000000"}, {"denom": "denomY", "amount": "2000000"}]`},
+					},
+				},
+				{
+					Name:               "MsgDepositWithinBatchRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgDepositWithinBatchRequest is the request type for the Msg/DepositWithinBatch RPC method.",
+					HighestFieldNumber: 3,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Description: "id of the target pool", Example: `"1"`},
+						{Name: "msg", Number: 3, Type: "MsgDepositWithinBatch", Description: "MsgDepositWithinBatch"},
+					},
+				},
+				{
+					Name:               "MsgDepositWithinBatchResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgDepositWithinBatchResponse defines the Msg/DepositWithinBatch response type.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+				},
+				{
+					Name:               "MsgWithdrawWithinBatch",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "`MsgWithdrawWithinBatch` defines an `sdk.Msg` type that supports submitting withdraw request to the batch of the liquidity pool Withdraw submit to the batch from the Liquidity pool with the specified `pool_id`, `pool_coin` of the pool this requests are stacked in the batch of the liquidity pool, not immediately processed and processed in the `endblock` at once with other requests. See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md",
+					HighestFieldNumber: 3,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "withdrawer_address", Number: 1, Type: "string", Example: `"cosmos1e35y69rhrt7y4yce5l5u73sjnxu0l33wvznyun"`},
+						{Name: "pool_id", Number: 2, Type: "uint64", Description: "id of the target pool", Example: `"1"`},
+						{Name: "pool_coin", Number: 3, Type: "cosmos.base.v1beta1.Coin", Example: `{"denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4", "amount": "1000"}`},
+					},
+				},
+				{
+					Name:               "MsgWithdrawWithinBatchRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgWithdrawWithinBatchRequest is the request type for the Query/WithdrawWithinBatch RPC method.",
+					HighestFieldNumber: 3,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Description: "id of the target pool", Example: `"1"`},
+						{Name: "msg", Number: 3, Type: "MsgWithdrawWithinBatch", Description: "MsgWithdrawWithinBatch"},
+					},
+				},
+				{
+					Name:               "MsgWithdrawWithinBatchResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgWithdrawWithinBatchResponse defines the Msg/WithdrawWithinBatch response type.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+				},
+				{
+					Name:               "MsgSwapWithinBatch",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "`MsgSwapWithinBatch` defines an sdk.Msg type that supports submitting swap offer request to the batch of the liquidity pool Swap offer submit to the batch to the Liquidity pool with the specified the `pool_id`, `swap_type_id`, `demand_coin_denom` with the coin and the price you're offering and current `params.swap_fee_rate` this requests are stacked in the batch of the liquidity pool, not immediately processed and processed in the `endblock` at once with other requests You should request the same each field as the pool Currently, only the default `swap_type_id`1 is available on this version The detailed swap algorithm can be found here. See: https://github.com/tendermint/liquidity/tree/develop/doc https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md",
+					HighestFieldNumber: 7,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "swap_requester_address", Number: 1, Type: "string", Description: "address of swap requester", Example: `"cosmos1e35y69rhrt7y4yce5l5u73sjnxu0l33wvznyun"`},
+						{Name: "pool_id", Number: 2, Type: "uint64", Description: "id of the target pool", Example: `"1"`},
+						{Name: "swap_type_id", Number: 3, Type: "uint32", Description: "id of swap type, only 1 is allowed on this version, Must match the value in the pool.", Example: `"1"`},
+						{Name: "offer_coin", Number: 4, Type: "cosmos.base.v1beta1.Coin", Description: "offer sdk.coin for the swap request, Must match the denom in the pool.", Example: `{"denom": "denomX", "amount": "1000000"}`},
+						{Name: "demand_coin_denom", Number: 5, Type: "string", Description: "denom of demand coin to be exchanged on the swap request, Must match the denom in the pool.", Example: `"denomB"`},
+						{Name: "offer_coin_fee", Number: 6, Type: "cosmos.base.v1beta1.Coin", Description: "offer coin fee for pay fees in half offer coin", Example: `{"denom": "denomX", "amount": "5000"}`},
+						{Name: "order_price", Number: 7, Type: "bytes", Description: "limit order price for this offer", Example: `"1.1"`},
+					},
+				},
+				{
+					Name:               "MsgSwapWithinBatchRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgSwapWithinBatchRequest is the request type for the Query/Swap RPC method.",
+					HighestFieldNumber: 3,
+					Int64Fields:        []string{"pool_id"},
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Description: "id of the target pool", Example: `"1"`},
+						{Name: "msg", Number: 3, Type: "MsgSwapWithinBatch", Description: "MsgSwapWithinBatch"},
+					},
+				},
+				{
+					Name:               "MsgSwapWithinBatchResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "MsgSwapWithinBatchResponse defines the Msg/Swap response type.",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+				},
+				{
+					Name:               "BaseReq",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "Base Request struct for Post Tx, standard of tendermint/cosmos-sdk",
+					HighestFieldNumber: 11,
+					Int64Fields:        []string{"account_number", "sequence", "timeout_height", "gas"},
+					Fields: []Field{
+						{Name: "from", Number: 1, Type: "string", Description: "Sender address or Keybase name to generate a transaction", Example: `"cosmos1qz38nymksetqd2d4qesrxpffzywuel82a4l0vs"`},
+						{Name: "memo", Number: 2, Type: "string", Description: "Memo to send along with transaction", Example: `"Sent via Cosmos Voyager"`},
+						{Name: "chain_id", Number: 3, Type: "string", Description: "Name or address of private key with which to sign", Example: `"Cosmos-Hub"`},
+						{Name: "account_number", Number: 4, Type: "uint64", Description: "The account number of the signing account (offline mode only)", Example: `"1421"`},
+						{Name: "sequence", Number: 5, Type: "uint64", Description: "The sequence number of the signing account (offline mode only)", Example: `"13"`},
+						{Name: "timeout_height", Number: 6, Type: "uint64", Description: "Set a block timeout height to prevent the tx from being committed past a certain height", Example: `"200"`},
+						{Name: "fees", Number: 7, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "Fees to pay along with transaction", Example: `[{"denom": "uatom", "amount": "10"}]`},
+						{Name: "gas_prices", Number: 8, Type: "cosmos.base.v1beta1.DecCoin", Repeated: true, Description: "Gas prices in decimal format to determine the transaction fee", Example: `[{"denom": "uatom", "amount": "0.1"}]`},
+						{Name: "gas", Number: 9, Type: "uint64", Description: "Gas amount to determine the transaction fee", Example: `"200000"`},
+						{Name: "gas_adjustment", Number: 10, Type: "string", Description: "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored", Example: `"1.2"`},
+						{Name: "simulate", Number: 11, Type: "bool", Description: "Estimate gas for a transaction (cannot be used in conjunction with generate_only)", Example: "false"},
+					},
+				},
+				{
+					Name:               "Fee",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "Fee struct of cosmos-sdk",
+					HighestFieldNumber: 2,
+					Int64Fields:        []string{"gas"},
+					Fields: []Field{
+						{Name: "gas", Number: 1, Type: "uint64", Example: `"200000"`},
+						{Name: "amount", Number: 2, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Description: "amount is the amount of coins to be paid as a fee", Example: `[{"denom": "uatom", "amount": "10"}]`},
+					},
+				},
+				{
+					Name:               "PubKey",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "PubKey struct of tendermint/cosmos-sdk",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "type", Number: 1, Type: "string", Description: "type of pubkey algorithm", Example: `"tendermint/PubKeySecp256k1"`},
+						{Name: "value", Number: 2, Type: "string", Description: "value of pubkey", Example: `"Avz04VhtKJh8ACCVzlI8aTosGy0ikFXKIVHQ3jKMrosH"`},
+					},
+				},
+				{
+					Name:               "Signature",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "signature struct of tendermint/cosmos-sdk",
+					HighestFieldNumber: 4,
+					Int64Fields:        []string{"account_number", "sequence"},
+					Fields: []Field{
+						{Name: "signature", Number: 1, Type: "string", Description: "signature base64", Example: `"MEUCIQD02fsDPra8MtbRsyB1w7bqTM55Wu138zQbFcWx4+CFyAIge5WNPfKIuvzBZ69MyqHsqD8S1IwiEp+iUb6VSdtlpgY="`},
+						{Name: "pub_key", Number: 2, Type: "PubKey", Description: "PubKey"},
+						{Name: "account_number", Number: 3, Type: "uint64", Description: "The account number of the signing account (offline mode only)", Example: `"1421"`},
+						{Name: "sequence", Number: 4, Type: "uint64", Description: "The sequence number of the signing account (offline mode only)", Example: `"13"`},
+					},
+				},
+				{
+					Name:               "StdTx",
+					Path:               "testdata/liquidity/tx.proto",
+					Description:        "Base response struct of result of the requested Tx, standard of tendermint/cosmos-sdk",
+					HighestFieldNumber: 4,
+					Fields: []Field{
+						{Name: "msg", Number: 1, Type: "string", Repeated: true, Description: "Msgs"},
+						{Name: "fee", Number: 2, Type: "Fee", Description: "Fee"},
+						{Name: "memo", Number: 3, Type: "string", Description: "Memo of the transaction"},
+						{Name: "signature", Number: 4, Type: "Signature", Description: "Signature"},
+					},
+				},
 			},
 			Services: []Service{
 				{
-					Name: "MsgApi",
+					Name:        "MsgApi",
+					Path:        "testdata/liquidity/msg.proto",
+					Description: "Msg defines the staking Msg service.",
 					RPCFuncs: []RPCFunc{
 						{
 							Name:        "CreatePoolApi",
+							Description: "Submit create liquidity pool message.",
 							RequestType: "MsgCreatePoolRequest",
 							ReturnsType: "MsgCreatePoolResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"test"},
-									HasBody: true,
+									Endpoint: "POST /liquidity/pools/{test}",
+									Params:   []string{"test"},
+									HasBody:  true,
 								},
 							},
 						},
 						{
 							Name:        "DepositWithinBatchApi",
+							Description: "Submit deposit to the liquidity pool batch",
 							RequestType: "MsgDepositWithinBatchRequest",
 							ReturnsType: "MsgDepositWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"pool_id"},
-									HasBody: true,
+									Endpoint: "POST /liquidity/pools/{pool_id}/batch/deposits",
+									Params:   []string{"pool_id"},
+									HasBody:  true,
 								},
 							},
 						},
 						{
 							Name:        "WithdrawWithinBatchApi",
+							Description: "Submit withdraw from to the liquidity pool batch",
 							RequestType: "MsgWithdrawWithinBatchRequest",
 							ReturnsType: "MsgWithdrawWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"pool_id"},
-									HasBody: true,
+									Endpoint: "POST /liquidity/pools/{pool_id}/batch/withdraws",
+									Params:   []string{"pool_id"},
+									HasBody:  true,
 								},
 							},
 						},
 						{
 							Name:        "SwapApi",
+							Description: "Submit swap to the liquidity pool batch",
 							RequestType: "MsgSwapWithinBatchRequest",
 							ReturnsType: "MsgSwapWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Endpoint: "POST /liquidity/pools/{pool_id}/batch/swaps",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 									HasBody:  true,
@@ -151,44 +666,54 @@ func TestLiquidity(t *testing.T) {
 					},
 				},
 				{
-					Name: "Query",
+					Name:        "Query",
+					Path:        "testdata/liquidity/query.proto",
+					Description: "Query defines the gRPC querier service for liquidity module.",
 					RPCFuncs: []RPCFunc{
 						{
 							Name:        "LiquidityPools",
+							Description: "Get existing liquidity pools.",
 							RequestType: "QueryLiquidityPoolsRequest",
 							ReturnsType: "QueryLiquidityPoolsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Endpoint: "GET /liquidity/pools",
 									HasQuery: true,
 								},
 							},
 						},
 						{
 							Name:        "LiquidityPool",
+							Description: "Get specific liquidity pool.",
 							RequestType: "QueryLiquidityPoolRequest",
 							ReturnsType: "QueryLiquidityPoolResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id"},
+									Endpoint: "GET /liquidity/pools/{pool_id}",
+									Params:   []string{"pool_id"},
 								},
 							},
 						},
 						{
 							Name:        "LiquidityPoolBatch",
+							Description: "Get the pool's current batch.",
 							RequestType: "QueryLiquidityPoolBatchRequest",
 							ReturnsType: "QueryLiquidityPoolBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id"},
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch",
+									Params:   []string{"pool_id"},
 								},
 							},
 						},
 						{
 							Name:        "PoolBatchSwapMsgs",
+							Description: "Get all swap messages in the pool's current batch.",
 							RequestType: "QueryPoolBatchSwapMsgsRequest",
 							ReturnsType: "QueryPoolBatchSwapMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch/swaps",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
@@ -196,20 +721,24 @@ func TestLiquidity(t *testing.T) {
 						},
 						{
 							Name:        "PoolBatchSwapMsg",
+							Description: "Get specific swap message in the pool's current batch.",
 							RequestType: "QueryPoolBatchSwapMsgRequest",
 							ReturnsType: "QueryPoolBatchSwapMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch/swaps/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
 						},
 						{
 							Name:        "PoolBatchDepositMsgs",
+							Description: "Get all deposit messages in the pool's current batch.",
 							RequestType: "QueryPoolBatchDepositMsgsRequest",
 							ReturnsType: "QueryPoolBatchDepositMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch/deposits",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
@@ -217,20 +746,24 @@ func TestLiquidity(t *testing.T) {
 						},
 						{
 							Name:        "PoolBatchDepositMsg",
+							Description: "Get specific deposit message in the pool's current batch.",
 							RequestType: "QueryPoolBatchDepositMsgRequest",
 							ReturnsType: "QueryPoolBatchDepositMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch/deposits/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
 						},
 						{
 							Name:        "PoolBatchWithdrawMsgs",
+							Description: "Get all withdraw messages in the pool's current batch.",
 							RequestType: "QueryPoolBatchWithdrawMsgsRequest",
 							ReturnsType: "QueryPoolBatchWithdrawMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch/withdraws",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
@@ -238,44 +771,55 @@ func TestLiquidity(t *testing.T) {
 						},
 						{
 							Name:        "PoolBatchWithdrawMsg",
+							Description: "Get specific withdraw message in the pool's current batch.",
 							RequestType: "QueryPoolBatchWithdrawMsgRequest",
 							ReturnsType: "QueryPoolBatchWithdrawMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Endpoint: "GET /liquidity/pools/{pool_id}/batch/withdraws/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
 						},
 						{
 							Name:        "Params",
+							Description: "Get all parameters of the liquidity module.",
 							RequestType: "QueryParamsRequest",
 							ReturnsType: "QueryParamsResponse",
 							HTTPRules: []HTTPRule{
-								{},
+								{
+									Endpoint: "GET /liquidity/params",
+								},
 							},
 						},
 					},
 				},
 				{
-					Name: "Msg",
+					Name:        "Msg",
+					Path:        "testdata/liquidity/tx.proto",
+					Description: "Msg defines the liquidity Msg service.",
 					RPCFuncs: []RPCFunc{
 						{
 							Name:        "CreatePool",
+							Description: "Submit create liquidity pool message.",
 							RequestType: "MsgCreatePool",
 							ReturnsType: "MsgCreatePoolResponse",
 						},
 						{
 							Name:        "DepositWithinBatch",
+							Description: "Submit deposit to the liquidity pool batch.",
 							RequestType: "MsgDepositWithinBatch",
 							ReturnsType: "MsgDepositWithinBatchResponse",
 						},
 						{
 							Name:        "WithdrawWithinBatch",
+							Description: "Submit withdraw from to the liquidity pool batch.",
 							RequestType: "MsgWithdrawWithinBatch",
 							ReturnsType: "MsgWithdrawWithinBatchResponse",
 						},
 						{
 							Name:        "Swap",
+							Description: "Submit swap to the liquidity pool batch.",
 							RequestType: "MsgSwapWithinBatch",
 							ReturnsType: "MsgSwapWithinBatchResponse",
 						},
@@ -285,5 +829,93 @@ func TestLiquidity(t *testing.T) {
 		},
 	}
 
+	// the options are covered by TestOptions
+	for _, pkg := range packages {
+		for i, msg := range pkg.Messages {
+			pkg.Messages[i].Options = nil
+			for j := range msg.Fields {
+				msg.Fields[j].Options = nil
+			}
+		}
+	}
+
 	require.Equal(t, expected, packages)
 }
+
+func TestFieldExample(t *testing.T) {
+	cases := []struct {
+		name     string
+		field    *proto.NormalField
+		expected string
+	}{
+		{
+			name:     "option",
+			field:    newField(nil, `"cosmos1e35y69rhrt7y4yce5l5u73sjnxu0l33wvznyun"`),
+			expected: `"cosmos1e35y69rhrt7y4yce5l5u73sjnxu0l33wvznyun"`,
+		},
+		{
+			name:     "comment",
+			field:    newField([]string{" The id of the post.", "", " Example: 42"}, ""),
+			expected: "42",
+		},
+		{
+			name:     "comment text",
+			field:    newField([]string{" Example: `publishers/1257894000000000000`"}, ""),
+			expected: `"publishers/1257894000000000000"`,
+		},
+		{
+			name:  "none",
+			field: newField([]string{" The id of the post."}, ""),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, fieldExample(tt.field))
+		})
+	}
+}
+
+func newField(comment []string, example string) *proto.NormalField {
+	field := &proto.NormalField{Field: &proto.Field{}}
+	if comment != nil {
+		field.Comment = &proto.Comment{Lines: comment}
+	}
+	if example != "" {
+		field.Options = []*proto.Option{{
+			Name: optionOpenAPIField,
+			Constant: proto.Literal{
+				Map: map[string]*proto.Literal{"example": {Source: example}},
+			},
+		}}
+	}
+	return field
+}
+
+func TestOptions(t *testing.T) {
+	packages, err := Parse(context.Background(), nil, "testdata/options")
+	require.NoError(t, err)
+
+	msg, err := packages[0].MessageByName("MsgCreatePost")
+	require.NoError(t, err)
+	require.Equal(t, []Option{
+		{Name: OptionMsgSigner, Value: "creator"},
+		{Name: "(amino.name)", Value: "blog/CreatePost"},
+	}, msg.Options)
+	require.Equal(t, []string{"creator"}, msg.Signers())
+	require.Equal(t, []Field{
+		{Name: "creator", Number: 1, Type: "string"},
+		{Name: "title", Number: 2, Type: "string", Options: []Option{
+			{Name: "(validate.rules).string", Value: "{min_len: 1, max_len: 64}"},
+		}},
+		{Name: "tags", Number: 3, Type: "string", Repeated: true, Options: []Option{
+			{Name: "(gogoproto.nullable)", Value: "false"},
+			{Name: "(validate.rules).repeated.items.string.in", Value: `["news", "tech"]`},
+		}},
+	}, msg.Fields)
+
+	msg, err = packages[0].MessageByName("MsgCreatePostResponse")
+	require.NoError(t, err)
+	require.Empty(t, msg.Options)
+	require.Empty(t, msg.Signers())
+}
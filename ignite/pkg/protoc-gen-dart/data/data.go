@@ -1,6 +1,12 @@
 package data
 
-// Binary returns the platform spesific plugin binary.
+import "github.com/ignite/cli/ignite/pkg/xos"
+
+// Binary returns the platform spesific plugin binary, it's empty when the plugin isn't embedded
+// for the platform. The embedded binaries are linked with the glibc, none is returned on musl systems.
 func Binary() []byte {
+	if xos.IsMusl() {
+		return nil
+	}
 	return binary
 }
@@ -5,13 +5,22 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/protoc-gen-dart/data"
+	"github.com/ignite/cli/ignite/pkg/xexec"
 )
 
 // Name of the plugin.
 const Name = "protoc-gen-dart"
 
-// BinaryPath returns the binary path for the plugin.
+// installCommand installs the plugin when it isn't embedded for the platform.
+const installCommand = "dart pub global activate protoc_plugin"
+
+// BinaryPath returns the binary path for the plugin, the plugin installed in PATH is used when it
+// isn't embedded for the platform.
 func BinaryPath() (path string, cleanup func(), err error) {
+	if len(data.Binary()) == 0 {
+		path, err := xexec.LookInstalled(Name, installCommand)
+		return path, func() {}, err
+	}
 	return localfs.SaveBytesTemp(data.Binary(), Name, 0755)
 }
 
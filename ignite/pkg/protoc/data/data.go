@@ -3,6 +3,8 @@ package data
 import (
 	"embed"
 	"io/fs"
+
+	"github.com/ignite/cli/ignite/pkg/xos"
 )
 
 //go:embed include/* include/**/*
@@ -14,7 +16,11 @@ func Include() fs.FS {
 	return f
 }
 
-// Binary returns the platform spesific protoc binary.
+// Binary returns the platform spesific protoc binary, it's empty when protoc isn't embedded for
+// the platform. The embedded binaries are linked with the glibc, none is returned on musl systems.
 func Binary() []byte {
+	if xos.IsMusl() {
+		return nil
+	}
 	return binary
 }
@@ -0,0 +1,9 @@
+//go:build !(linux && (amd64 || arm64)) && !(darwin && (amd64 || arm64))
+// +build !linux !amd64,!arm64
+// +build !darwin !amd64,!arm64
+
+package data
+
+// protoc is embedded for linux and darwin on amd64 and arm64 only, the protoc installed in PATH is
+// used on the other platforms, e.g. Windows.
+var binary []byte
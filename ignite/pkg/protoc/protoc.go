@@ -13,8 +13,12 @@ import (
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/protoanalysis"
 	"github.com/ignite/cli/ignite/pkg/protoc/data"
+	"github.com/ignite/cli/ignite/pkg/xexec"
 )
 
+// protocInstallURL documents the installation of protoc.
+const protocInstallURL = "https://grpc.io/docs/protoc-installation"
+
 // Option configures Generate configs.
 type Option func(*configs)
 
@@ -56,7 +60,7 @@ type Cmd struct {
 
 // Command sets the protoc binary up and returns the command needed to execute c.
 func Command() (command Cmd, cleanup func(), err error) {
-	path, cleanupProto, err := localfs.SaveBytesTemp(data.Binary(), "protoc", 0755)
+	path, cleanupProto, err := binaryPath()
 	if err != nil {
 		return Cmd{}, nil, err
 	}
@@ -80,6 +84,16 @@ func Command() (command Cmd, cleanup func(), err error) {
 	return command, cleanup, nil
 }
 
+// binaryPath saves the embedded protoc binary and returns its path, the protoc installed in PATH is
+// used when protoc isn't embedded for the platform.
+func binaryPath() (path string, cleanup func(), err error) {
+	if len(data.Binary()) == 0 {
+		path, err := xexec.LookInstalled("protoc", protocInstallURL)
+		return path, func() {}, err
+	}
+	return localfs.SaveBytesTemp(data.Binary(), "protoc", 0755)
+}
+
 // Generate generates code into outDir from protoPath and its includePaths by using plugins provided with protocOuts.
 func Generate(ctx context.Context, outDir, protoPath string, includePaths, protocOuts []string, options ...Option) error {
 	c := configs{}
@@ -0,0 +1,312 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// ErrClientNotActive is returned when the packets of a path can't be relayed because the light client
+// of an end of the path is expired or frozen.
+var ErrClientNotActive = errors.New("the client isn't active")
+
+// PathStatus is the state of the packets and the clients of a path.
+type PathStatus struct {
+	// PathID is the id of the path.
+	PathID string
+
+	// Src and Dst are the states of the ends of the path.
+	Src, Dst PathEndStatus
+}
+
+// PathEndStatus is the state of an end of a path.
+type PathEndStatus struct {
+	// ChainID is the id of the chain of the end.
+	ChainID string
+
+	// ClientID is the id of the light client of the counterparty chain on the chain.
+	ClientID string
+
+	// ClientStatus is the status of the client, e.g. Active or Expired.
+	ClientStatus exported.Status
+
+	// UnrelayedPackets are the sequences of the packets sent by the chain and not received by the
+	// counterparty chain yet.
+	UnrelayedPackets []uint64
+
+	// UnrelayedAcks are the sequences of the packets acknowledged by the chain whose acknowledgements
+	// aren't relayed to the counterparty chain yet.
+	UnrelayedAcks []uint64
+}
+
+// IsClear checks if the packets and the acknowledgements of the path are relayed.
+func (s PathStatus) IsClear() bool {
+	return s.Src.isClear() && s.Dst.isClear()
+}
+
+func (s PathEndStatus) isClear() bool {
+	return len(s.UnrelayedPackets) == 0 && len(s.UnrelayedAcks) == 0
+}
+
+// CheckClients returns ErrClientNotActive when the client of an end of the path isn't active.
+func (s PathStatus) CheckClients() error {
+	for _, end := range []PathEndStatus{s.Src, s.Dst} {
+		if end.ClientStatus != exported.Active {
+			return fmt.Errorf(
+				"%w: the client %s on %q is %s, recover it with a substitute client",
+				ErrClientNotActive,
+				end.ClientID,
+				end.ChainID,
+				end.ClientStatus,
+			)
+		}
+	}
+	return nil
+}
+
+// PathStatus returns the state of the packets and the clients of the linked path.
+func (r Relayer) PathStatus(ctx context.Context, pathID string) (PathStatus, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return PathStatus{}, err
+	}
+
+	path, err := conf.PathByID(pathID)
+	if err != nil {
+		return PathStatus{}, err
+	}
+	return r.pathStatus(ctx, conf, path)
+}
+
+func (r Relayer) pathStatus(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (PathStatus, error) {
+	if path.Src.ChannelID == "" || path.Dst.ChannelID == "" {
+		return PathStatus{}, fmt.Errorf("the path %s isn't linked, connect it first", path.ID)
+	}
+
+	src, err := r.chainClient(ctx, conf, path.Src.ChainID)
+	if err != nil {
+		return PathStatus{}, err
+	}
+	dst, err := r.chainClient(ctx, conf, path.Dst.ChainID)
+	if err != nil {
+		return PathStatus{}, err
+	}
+
+	s := PathStatus{PathID: path.ID}
+	if s.Src, err = pathEndStatus(ctx, src, dst, path.Src, path.Dst); err != nil {
+		return PathStatus{}, err
+	}
+	if s.Dst, err = pathEndStatus(ctx, dst, src, path.Dst, path.Src); err != nil {
+		return PathStatus{}, err
+	}
+	return s, nil
+}
+
+// pathEndStatus returns the state of the end of a path on the chain of client, counterparty is the
+// client of the chain at the other end of the path.
+func pathEndStatus(ctx context.Context, client, counterparty cosmosclient.Client, end, counterpartyEnd relayerconf.PathEnd) (PathEndStatus, error) {
+	var (
+		channels             = channeltypes.NewQueryClient(client.Context())
+		counterpartyChannels = channeltypes.NewQueryClient(counterparty.Context())
+		s                    = PathEndStatus{ChainID: end.ChainID}
+	)
+
+	clientState, err := channels.ChannelClientState(ctx, &channeltypes.QueryChannelClientStateRequest{
+		PortId:    end.PortID,
+		ChannelId: end.ChannelID,
+	})
+	if err != nil {
+		return PathEndStatus{}, fmt.Errorf("cannot query the client of %q: %w", end.ChainID, err)
+	}
+	s.ClientID = clientState.IdentifiedClientState.ClientId
+
+	status, err := clienttypes.NewQueryClient(client.Context()).ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{
+		ClientId: s.ClientID,
+	})
+	if err != nil {
+		return PathEndStatus{}, fmt.Errorf("cannot query the status of the client %s on %q: %w", s.ClientID, end.ChainID, err)
+	}
+	s.ClientStatus = exported.Status(status.Status)
+
+	// the packets sent by the chain are committed until they're acknowledged or timed out.
+	commitments, err := cosmosclient.Paginate(func(ctx context.Context, page *query.PageRequest) ([]*channeltypes.PacketState, *query.PageResponse, error) {
+		res, err := channels.PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:     end.PortID,
+			ChannelId:  end.ChannelID,
+			Pagination: page,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Commitments, res.Pagination, nil
+	}).All(ctx)
+	if err != nil {
+		return PathEndStatus{}, fmt.Errorf("cannot query the packets sent by %q: %w", end.ChainID, err)
+	}
+
+	if len(commitments) > 0 {
+		unreceived, err := counterpartyChannels.UnreceivedPackets(ctx, &channeltypes.QueryUnreceivedPacketsRequest{
+			PortId:                    counterpartyEnd.PortID,
+			ChannelId:                 counterpartyEnd.ChannelID,
+			PacketCommitmentSequences: packetSequences(commitments),
+		})
+		if err != nil {
+			return PathEndStatus{}, fmt.Errorf("cannot query the packets received by %q: %w", counterpartyEnd.ChainID, err)
+		}
+		s.UnrelayedPackets = unreceived.Sequences
+	}
+
+	// the acknowledgements written by the chain are kept, the ones of the packets still committed
+	// by the counterparty chain aren't relayed.
+	acks, err := cosmosclient.Paginate(func(ctx context.Context, page *query.PageRequest) ([]*channeltypes.PacketState, *query.PageResponse, error) {
+		res, err := channels.PacketAcknowledgements(ctx, &channeltypes.QueryPacketAcknowledgementsRequest{
+			PortId:     end.PortID,
+			ChannelId:  end.ChannelID,
+			Pagination: page,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Acknowledgements, res.Pagination, nil
+	}).All(ctx)
+	if err != nil {
+		return PathEndStatus{}, fmt.Errorf("cannot query the acknowledgements of %q: %w", end.ChainID, err)
+	}
+
+	if len(acks) > 0 {
+		unreceived, err := counterpartyChannels.UnreceivedAcks(ctx, &channeltypes.QueryUnreceivedAcksRequest{
+			PortId:             counterpartyEnd.PortID,
+			ChannelId:          counterpartyEnd.ChannelID,
+			PacketAckSequences: packetSequences(acks),
+		})
+		if err != nil {
+			return PathEndStatus{}, fmt.Errorf("cannot query the acknowledgements received by %q: %w", counterpartyEnd.ChainID, err)
+		}
+		s.UnrelayedAcks = unreceived.Sequences
+	}
+
+	return s, nil
+}
+
+func packetSequences(states []*channeltypes.PacketState) []uint64 {
+	sequences := make([]uint64, len(states))
+	for i, state := range states {
+		sequences[i] = state.Sequence
+	}
+	return sequences
+}
+
+// ClearPackets relays the packets and the acknowledgements of the linked paths stuck on their
+// chains, the packets whose timeout is reached are timed out on their source chain instead. The
+// paths are relayed once from the first block of their channels, the stuck packets are skipped
+// by Start when they're sent before the heights it relays from.
+//
+// The packets can't be relayed when the client of an end of a path is expired or frozen, the
+// path isn't relayed and ErrClientNotActive is returned, see RecoverClient.
+// The states of the paths once cleared are returned.
+func (r Relayer) ClearPackets(ctx context.Context, pathIDs ...string) ([]PathStatus, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []PathStatus
+	for _, id := range pathIDs {
+		path, err := conf.PathByID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := r.pathStatus(ctx, conf, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.CheckClients(); err != nil {
+			return nil, err
+		}
+		if s.IsClear() {
+			statuses = append(statuses, s)
+			continue
+		}
+
+		// the relayer searches the packets and the acknowledgements to relay from these heights.
+		path.Src.PacketHeight, path.Src.AckHeight = 0, 0
+		path.Dst.PacketHeight, path.Dst.AckHeight = 0, 0
+
+		if path, err = r.call(ctx, conf, path, "start"); err != nil {
+			return nil, err
+		}
+
+		if err := conf.UpdatePath(path); err != nil {
+			return nil, err
+		}
+		if err := relayerconf.Save(conf); err != nil {
+			return nil, err
+		}
+
+		if s, err = r.pathStatus(ctx, conf, path); err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, s)
+	}
+
+	return statuses, nil
+}
+
+// RecoverClient submits a client update proposal on the chain from the relayer key of the chain,
+// with the initial deposit, to replace the state of the expired or frozen client subjectClientID
+// by the one of the active client substituteClientID. Both clients must track the same chain,
+// e.g. the substitute client is created by connecting a new path between the chains.
+//
+// The client is recovered once the proposal passes, the paths using it are relayed again.
+func (r Relayer) RecoverClient(ctx context.Context, chainID, subjectClientID, substituteClientID string, deposit sdk.Coins) (cosmosclient.Response, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+
+	chain, err := conf.ChainByID(chainID)
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+
+	client, err := r.chainClient(ctx, conf, chainID)
+	if err != nil {
+		return cosmosclient.Response{}, err
+	}
+
+	content := clienttypes.NewClientUpdateProposal(
+		fmt.Sprintf("Recover the client %s", subjectClientID),
+		fmt.Sprintf("Replace the state of the client %s, expired or frozen, by the one of the client %s.", subjectClientID, substituteClientID),
+		subjectClientID,
+		substituteClientID,
+	)
+	return client.SubmitProposalTx(chain.Account, content, deposit)
+}
+
+// chainClient returns the client of the chain of the relayer config, it signs with the relayer keys.
+func (r Relayer) chainClient(ctx context.Context, conf relayerconf.Config, chainID string) (cosmosclient.Client, error) {
+	chain, err := conf.ChainByID(chainID)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	var options []cosmosclient.Option
+	if chain.GasPrice != "" {
+		gasPrices, err := sdk.ParseDecCoins(chain.GasPrice)
+		if err != nil {
+			return cosmosclient.Client{}, err
+		}
+		options = append(options, cosmosclient.WithGasPrices(gasPrices))
+	}
+	return r.client(ctx, chain.RPCAddress, chain.AddressPrefix, options...)
+}
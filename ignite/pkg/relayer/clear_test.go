@@ -0,0 +1,28 @@
+package relayer_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+func TestPathStatus(t *testing.T) {
+	s := relayer.PathStatus{
+		PathID: "earth-mars",
+		Src:    relayer.PathEndStatus{ChainID: "earth", ClientID: "07-tendermint-0", ClientStatus: exported.Active},
+		Dst:    relayer.PathEndStatus{ChainID: "mars", ClientID: "07-tendermint-1", ClientStatus: exported.Active},
+	}
+	require.True(t, s.IsClear())
+	require.NoError(t, s.CheckClients())
+
+	s.Dst.UnrelayedAcks = []uint64{3}
+	require.False(t, s.IsClear())
+
+	s.Src.ClientStatus = exported.Expired
+	err := s.CheckClients()
+	require.ErrorIs(t, err, relayer.ErrClientNotActive)
+	require.ErrorContains(t, err, `the client 07-tendermint-0 on "earth" is Expired`)
+}
@@ -0,0 +1,134 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// KeyringServiceName is the name of the OS keyring service of the relayer keys.
+	KeyringServiceName = "ignite-relayer"
+
+	// lowBalanceSetups is the number of IBC setups the balance of a relayer key must pay for,
+	// a lower balance is reported as low.
+	lowBalanceSetups = 10
+)
+
+// KeyringHome is the home of the keyring of the relayer keys. The relayer keys are isolated from
+// the accounts of the other commands, so the relayer never spends the tokens of a coordinator or
+// faucet account.
+var KeyringHome = os.ExpandEnv("$HOME/.ignite/relayer/keys")
+
+// NewKeyring returns the registry of the relayer keys.
+func NewKeyring(options ...cosmosaccount.Option) (cosmosaccount.Registry, error) {
+	return cosmosaccount.New(append([]cosmosaccount.Option{
+		cosmosaccount.WithHome(KeyringHome),
+		cosmosaccount.WithKeyringServiceName(KeyringServiceName),
+	}, options...)...)
+}
+
+// RotateKey replaces the relayer key with name by a new key and returns the new key with its
+// mnemonic. The previous key is kept with the returned name so its tokens can be withdrawn.
+func RotateKey(ca cosmosaccount.Registry, name string) (key cosmosaccount.Account, mnemonic, previous string, err error) {
+	if _, err := ca.GetByName(name); err != nil {
+		return cosmosaccount.Account{}, "", "", err
+	}
+
+	previous = fmt.Sprintf("%s-rotated-%d", name, time.Now().Unix())
+	if _, err := ca.Rename(name, previous); err != nil {
+		return cosmosaccount.Account{}, "", "", err
+	}
+
+	key, mnemonic, err = ca.Create(name)
+	if err != nil {
+		// restore the previous key, the relayer config still uses it
+		if _, errRestore := ca.Rename(previous, name); errRestore != nil {
+			return cosmosaccount.Account{}, "", "", fmt.Errorf("%w, the previous key is kept as %s", err, previous)
+		}
+		return cosmosaccount.Account{}, "", "", err
+	}
+
+	return key, mnemonic, previous, nil
+}
+
+// KeyBalance is the balance of a relayer key on a chain of the relayer.
+type KeyBalance struct {
+	// ChainID is the id of the chain.
+	ChainID string
+
+	// Key is the name of the relayer key.
+	Key string
+
+	// Address is the address of the key on the chain.
+	Address string
+
+	// Balances are the balances of the key on the chain.
+	Balances sdk.Coins
+
+	// GasBalance is the balance of the key in the denom of the gas price of the chain.
+	GasBalance sdk.Coin
+
+	// Threshold is the balance under which the balance is low.
+	Threshold sdk.Coin
+}
+
+// IsLow returns true when the balance can't pay the fees of the relayer for long.
+func (b KeyBalance) IsLow() bool {
+	return b.GasBalance.IsLT(b.Threshold)
+}
+
+// KeyBalances returns the balances of the relayer keys on the chains of the relayer config, only
+// the balances of the key with name are returned when name is set.
+func (r Relayer) KeyBalances(ctx context.Context, name string) ([]KeyBalance, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	var balances []KeyBalance
+	for _, chain := range conf.Chains {
+		if name != "" && chain.Account != name {
+			continue
+		}
+
+		b, err := r.keyBalance(ctx, chain)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, b)
+	}
+	return balances, nil
+}
+
+func (r Relayer) keyBalance(ctx context.Context, chain relayerconf.Chain) (KeyBalance, error) {
+	account, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return KeyBalance{}, err
+	}
+
+	gasPrice, err := sdk.ParseDecCoin(chain.GasPrice)
+	if err != nil {
+		return KeyBalance{}, err
+	}
+
+	coins, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
+	if err != nil {
+		return KeyBalance{}, err
+	}
+
+	return KeyBalance{
+		ChainID:    chain.ID,
+		Key:        chain.Account,
+		Address:    account.Address(chain.AddressPrefix),
+		Balances:   coins,
+		GasBalance: sdk.NewCoin(gasPrice.Denom, coins.AmountOf(gasPrice.Denom)),
+		Threshold:  sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(ibcSetupGas*lowBalanceSetups).Ceil().TruncateInt()),
+	}, nil
+}
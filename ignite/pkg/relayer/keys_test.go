@@ -0,0 +1,40 @@
+package relayer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+)
+
+func TestRotateKey(t *testing.T) {
+	ca, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	old, _, err := ca.Create("relayer")
+	require.NoError(t, err)
+
+	key, mnemonic, previous, err := relayer.RotateKey(ca, "relayer")
+	require.NoError(t, err)
+	require.NotEmpty(t, mnemonic)
+	require.NotEqual(t, old.Address("cosmos"), key.Address("cosmos"))
+
+	current, err := ca.GetByName("relayer")
+	require.NoError(t, err)
+	require.Equal(t, key.Address("cosmos"), current.Address("cosmos"))
+
+	kept, err := ca.GetByName(previous)
+	require.NoError(t, err)
+	require.Equal(t, old.Address("cosmos"), kept.Address("cosmos"))
+}
+
+func TestRotateKeyNotFound(t *testing.T) {
+	ca, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	_, _, _, err = relayer.RotateKey(ca, "relayer")
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	require.ErrorAs(t, err, &accErr)
+}
@@ -2,6 +2,7 @@ package relayer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,8 +10,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/libs/log"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ignite/cli/ignite/pkg/correlation"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/pkg/ctxticker"
@@ -27,15 +30,52 @@ const (
 // Relayer is an IBC relayer.
 type Relayer struct {
 	ca cosmosaccount.Registry
+
+	// onLowBalance is called when the balance of a relayer key becomes low on a chain.
+	onLowBalance func(KeyBalance)
+
+	// lowBalances holds the ids of the chains where the balance of the relayer key is low, the
+	// low balances are reported once until the keys are funded.
+	lowBalances *sync.Map
+
+	// logger logs the links and the relays of the paths.
+	logger log.Logger
+}
+
+// RelayOption configures the relayer.
+type RelayOption func(*Relayer)
+
+// WithLowBalanceWarning calls warn when the balance of a relayer key becomes low on a chain while
+// linking or relaying, the key must be funded before it runs out of tokens to pay the fees.
+func WithLowBalanceWarning(warn func(KeyBalance)) RelayOption {
+	return func(r *Relayer) {
+		r.onLowBalance = warn
+	}
 }
 
-// New creates a new IBC relayer and uses ca to access accounts.
-func New(ca cosmosaccount.Registry) Relayer {
-	return Relayer{
-		ca: ca,
+// WithLogger logs the links and the relays of the paths with levels and fields, the log lines hold
+// the correlation ID of the context of the calls. By default, the relayer doesn't log.
+func WithLogger(logger log.Logger) RelayOption {
+	return func(r *Relayer) {
+		r.logger = logger
 	}
 }
 
+// New creates a new IBC relayer and uses ca to access the relayer keys, see NewKeyring.
+func New(ca cosmosaccount.Registry, options ...RelayOption) Relayer {
+	r := Relayer{
+		ca:          ca,
+		lowBalances: &sync.Map{},
+		logger:      log.NewNopLogger(),
+	}
+
+	for _, apply := range options {
+		apply(&r)
+	}
+
+	return r
+}
+
 // Link links all chains that has a path to each other.
 // paths are optional and acts as a filter to only link some chains.
 // calling Link multiple times for the same paths does not have any side effects.
@@ -135,7 +175,14 @@ func (r Relayer) call(ctx context.Context, conf relayerconf.Config, path relayer
 		srcKey,
 		dstKey,
 	}
-	return reply, tsrelayer.Call(ctx, action, args, &reply)
+	logger := correlation.Logger(ctx, r.logger).With("action", action, "path", path.ID)
+	if err := tsrelayer.Call(ctx, action, args, &reply); err != nil {
+		logger.Error("relayer call failed", "err", err)
+		return relayerconf.Path{}, err
+	}
+
+	logger.Debug("relayer call", "src_channel", reply.Src.ChannelID, "dst_channel", reply.Dst.ChannelID)
+	return reply, nil
 }
 
 func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (
@@ -145,10 +192,12 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 		return relayerconf.Chain{}, "", err
 	}
 
-	coins, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
+	b, err := r.keyBalance(ctx, chain)
 	if err != nil {
 		return relayerconf.Chain{}, "", err
 	}
+	coins := b.Balances
+	r.checkLowBalance(b)
 
 	gasPrice, err := sdk.ParseCoinNormalized(chain.GasPrice)
 	if err != nil {
@@ -181,6 +230,9 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 	}
 
 	key, err := r.ca.ExportHex(chain.Account, "")
+	if errors.Is(err, cosmosaccount.ErrLedgerExport) {
+		return relayerconf.Chain{}, "", fmt.Errorf("the relayer signs with the private key of its accounts, %q is a Ledger account: %w", chain.Account, err)
+	}
 	if err != nil {
 		return relayerconf.Chain{}, "", err
 	}
@@ -188,19 +240,40 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 	return chain, key, nil
 }
 
+// checkLowBalance reports the balance of the relayer key when it becomes low on the chain.
+func (r Relayer) checkLowBalance(b KeyBalance) {
+	if r.onLowBalance == nil {
+		return
+	}
+	if !b.IsLow() {
+		r.lowBalances.Delete(b.ChainID)
+		return
+	}
+	if _, reported := r.lowBalances.LoadOrStore(b.ChainID, true); !reported {
+		r.onLowBalance(b)
+	}
+}
+
+// client returns a client of the chain at rpcAddress signing with the relayer keys.
+func (r Relayer) client(ctx context.Context, rpcAddress, addressPrefix string, options ...cosmosclient.Option) (cosmosclient.Client, error) {
+	return cosmosclient.New(ctx, append([]cosmosclient.Option{
+		cosmosclient.WithNodeAddress(rpcAddress),
+		cosmosclient.WithAddressPrefix(addressPrefix),
+		cosmosclient.WithAccountRegistry(r.ca),
+	}, options...)...)
+}
+
 func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix string) (sdk.Coins, error) {
-	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(rpcAddress))
+	client, err := r.client(ctx, rpcAddress, addressPrefix)
 	if err != nil {
 		return nil, err
 	}
 
-	acc, err := r.ca.GetByName(account)
+	addr, err := client.AccountAddress(account)
 	if err != nil {
 		return nil, err
 	}
 
-	addr := acc.Address(addressPrefix)
-
 	queryClient := banktypes.NewQueryClient(client.Context())
 	res, err := queryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
 	if err != nil {
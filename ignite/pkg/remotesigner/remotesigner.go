@@ -0,0 +1,200 @@
+// Package remotesigner provides a mock remote signer of a validator: like tmkms, it dials the
+// priv_validator_laddr of the node and signs the blocks, with the key of the node, to rehearse
+// the setup of a remote signer locally.
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/protoio"
+	"github.com/tendermint/tendermint/privval"
+	privvalproto "github.com/tendermint/tendermint/proto/tendermint/privval"
+)
+
+const (
+	// maxMsgSize is the maximum size of the messages of the node, the one of tendermint.
+	maxMsgSize = 1024 * 10
+
+	// defaultRetryInterval is the wait between two dials of the node.
+	defaultRetryInterval = 500 * time.Millisecond
+
+	// defaultTimeoutReadWrite is the maximum wait for a message of the node, the node pings the
+	// signer every few seconds so a silent connection is dialed again.
+	defaultTimeoutReadWrite = 10 * time.Second
+)
+
+// Signer is a mock remote signer of a validator.
+type Signer struct {
+	address string
+	chainID string
+	pv      *privval.FilePV
+
+	retryInterval    time.Duration
+	timeoutReadWrite time.Duration
+	logger           log.Logger
+}
+
+// Option configures the signer.
+type Option func(*Signer)
+
+// WithRetryInterval sets the wait between two dials of the node, 500ms by default.
+func WithRetryInterval(interval time.Duration) Option {
+	return func(s *Signer) {
+		s.retryInterval = interval
+	}
+}
+
+// WithLogger logs the connections of the signer to the node.
+func WithLogger(logger log.Logger) Option {
+	return func(s *Signer) {
+		s.logger = logger
+	}
+}
+
+// New returns a signer dialing the node listening on address, e.g. tcp://127.0.0.1:26659, to sign
+// the blocks of chainID with the key of keyPath, the priv_validator_key.json of the node.
+// The last signed height is kept in statePath like the node does, so the signer refuses to
+// double sign.
+func New(address, chainID, keyPath, statePath string, options ...Option) (*Signer, error) {
+	if !strings.HasPrefix(address, "tcp://") && !strings.HasPrefix(address, "unix://") {
+		return nil, fmt.Errorf("invalid address %q of the node, use a tcp:// or a unix:// address", address)
+	}
+
+	pv, err := loadFilePV(keyPath, statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Signer{
+		address:          address,
+		chainID:          chainID,
+		pv:               pv,
+		retryInterval:    defaultRetryInterval,
+		timeoutReadWrite: defaultTimeoutReadWrite,
+		logger:           log.NewNopLogger(),
+	}
+	for _, apply := range options {
+		apply(s)
+	}
+	return s, nil
+}
+
+// loadFilePV loads the key of the validator and its sign state, a missing state starts empty.
+// The files are checked first because the loading of tendermint exits the process on errors.
+func loadFilePV(keyPath, statePath string) (*privval.FilePV, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	var key privval.FilePVKey
+	if err := tmjson.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("invalid validator key %s: %w", keyPath, err)
+	}
+	if key.PrivKey == nil {
+		return nil, fmt.Errorf("the validator key %s has no private key", keyPath)
+	}
+
+	data, err = os.ReadFile(statePath)
+	if os.IsNotExist(err) {
+		return privval.LoadFilePVEmptyState(keyPath, statePath), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state privval.FilePVLastSignState
+	if err := tmjson.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid validator state %s: %w", statePath, err)
+	}
+	return privval.LoadFilePV(keyPath, statePath), nil
+}
+
+// Run signs the requests of the node until ctx is canceled, the node is dialed again when the
+// connection is lost, e.g. when the node restarts.
+func (s *Signer) Run(ctx context.Context) error {
+	for {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+		s.logger.Info("remote signer connected", "address", s.address)
+
+		err = s.serve(ctx, conn)
+		conn.Close()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.logger.Info("remote signer disconnected", "address", s.address, "err", err)
+	}
+}
+
+// dial connects to the node, it retries until the node listens or ctx is canceled.
+func (s *Signer) dial(ctx context.Context) (net.Conn, error) {
+	var dialer privval.SocketDialer
+	if strings.HasPrefix(s.address, "unix://") {
+		dialer = privval.DialUnixFn(strings.TrimPrefix(s.address, "unix://"))
+	} else {
+		// the key of the connection is only used to encrypt it, the node doesn't authenticate it.
+		dialer = privval.DialTCPFn(s.address, s.timeoutReadWrite, ed25519.GenPrivKey())
+	}
+
+	for {
+		conn, err := dialer()
+		if err == nil {
+			return conn, nil
+		}
+		s.logger.Debug("cannot dial the node", "address", s.address, "err", err)
+
+		select {
+		case <-time.After(s.retryInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// serve responds to the requests of the node until the connection is closed.
+func (s *Signer) serve(ctx context.Context, conn net.Conn) error {
+	// unblock the reads when ctx is canceled.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	var (
+		reader = protoio.NewDelimitedReader(conn, maxMsgSize)
+		writer = protoio.NewDelimitedWriter(conn)
+	)
+	for {
+		if err := conn.SetDeadline(time.Now().Add(s.timeoutReadWrite)); err != nil {
+			return err
+		}
+
+		var req privvalproto.Message
+		if _, err := reader.ReadMsg(&req); err != nil {
+			return err
+		}
+
+		res, err := privval.DefaultValidationRequestHandler(s.pv, req, s.chainID)
+		if err != nil {
+			// the error is sent to the node within the response.
+			s.logger.Error("cannot sign the request of the node", "err", err)
+		}
+
+		if _, err := writer.WriteMsg(&res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package remotesigner_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+)
+
+func TestSigner(t *testing.T) {
+	const chainID = "mars"
+
+	// the path of a unix socket is limited to about a hundred characters.
+	dir, err := os.MkdirTemp("", "signer")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	var (
+		keyPath   = filepath.Join(dir, "priv_validator_key.json")
+		statePath = filepath.Join(dir, "priv_validator_state.json")
+		address   = "unix://" + filepath.Join(dir, "signer.sock")
+	)
+	pv := privval.GenFilePV(keyPath, statePath)
+	pv.Key.Save()
+
+	// the node listens for the signer.
+	endpoint, err := privval.NewSignerListener(address, log.NewNopLogger())
+	require.NoError(t, err)
+	client, err := privval.NewSignerClient(endpoint, chainID)
+	require.NoError(t, err)
+	defer client.Close()
+
+	signer, err := remotesigner.New(address, chainID, keyPath, statePath, remotesigner.WithRetryInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- signer.Run(ctx) }()
+
+	pubKey, err := client.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey, pubKey)
+
+	vote := &tmproto.Vote{Type: tmproto.PrevoteType, Height: 1, Timestamp: time.Now()}
+	require.NoError(t, client.SignVote(chainID, vote))
+	require.True(t, pubKey.VerifySignature(tmtypes.VoteSignBytes(chainID, vote), vote.Signature))
+
+	// the signed height is saved like the node does.
+	require.FileExists(t, statePath)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestSignerInvalid(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "priv_validator_key.json")
+	statePath := filepath.Join(dir, "priv_validator_state.json")
+
+	_, err := remotesigner.New("127.0.0.1:26659", "mars", keyPath, statePath)
+	require.EqualError(t, err, `invalid address "127.0.0.1:26659" of the node, use a tcp:// or a unix:// address`)
+
+	_, err = remotesigner.New("tcp://127.0.0.1:26659", "mars", keyPath, statePath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, os.WriteFile(keyPath, []byte("{}"), 0600))
+	_, err = remotesigner.New("tcp://127.0.0.1:26659", "mars", keyPath, statePath)
+	require.EqualError(t, err, "the validator key "+keyPath+" has no private key")
+}
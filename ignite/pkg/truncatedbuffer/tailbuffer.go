@@ -0,0 +1,55 @@
+package truncatedbuffer
+
+import (
+	"bytes"
+)
+
+// TailBuffer contains a bytes buffer that has a limited capacity
+// Unlike TruncatedBuffer, only the last bytes are preserved on Write
+type TailBuffer struct {
+	buf     *bytes.Buffer
+	cap     int
+	written int
+}
+
+// NewTailBuffer returns a new TailBuffer
+// If the provided cap is 0, the tail buffer has no limit for truncating
+func NewTailBuffer(cap int) *TailBuffer {
+	return &TailBuffer{
+		buf: &bytes.Buffer{},
+		cap: cap,
+	}
+}
+
+// GetBuffer returns the buffer
+func (b TailBuffer) GetBuffer() *bytes.Buffer {
+	return b.buf
+}
+
+// GetCap returns the maximum capacity of the buffer
+func (b TailBuffer) GetCap() int {
+	return b.cap
+}
+
+// Written returns the total number of bytes written to the buffer, including the discarded ones
+func (b TailBuffer) Written() int {
+	return b.written
+}
+
+// Write implements io.Writer
+func (b *TailBuffer) Write(p []byte) (n int, err error) {
+	n, err = b.buf.Write(p)
+	b.written += n
+	if err != nil {
+		return n, err
+	}
+
+	// Discard the first surplus bytes
+	surplus := b.buf.Len() - b.cap
+
+	if b.cap > 0 && surplus > 0 {
+		b.buf.Next(surplus)
+	}
+
+	return n, nil
+}
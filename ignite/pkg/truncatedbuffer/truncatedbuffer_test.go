@@ -35,3 +35,18 @@ func TestWriter(t *testing.T) {
 	require.Equal(t, 1000, n)
 	require.Equal(t, ranBytes1000, b.GetBuffer().Bytes())
 }
+
+func TestTailWriter(t *testing.T) {
+	b := NewTailBuffer(10)
+
+	n, err := b.Write([]byte("012345"))
+	require.NoError(t, err)
+	require.Equal(t, 6, n)
+	require.Equal(t, "012345", b.GetBuffer().String())
+
+	n, err = b.Write([]byte("6789abcdef"))
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, "6789abcdef", b.GetBuffer().String())
+	require.Equal(t, 16, b.Written())
+}
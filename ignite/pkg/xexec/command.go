@@ -1,9 +1,25 @@
 package xexec
 
-import "os/exec"
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/ignite/cli/ignite/pkg/xos"
+)
 
 // IsCommandAvailable checks if command is available on user's path.
 func IsCommandAvailable(name string) bool {
 	_, err := exec.LookPath(name)
 	return err == nil
 }
+
+// LookInstalled returns the path of the command installed on user's path. It's used for the
+// commands that aren't embedded for the platform, e.g. on Windows or Alpine Linux, the error
+// tells how to install the command.
+func LookInstalled(name, install string) (string, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", fmt.Errorf("%s isn't embedded for %s, install it and add it to your PATH: %s", name, xos.Platform(), install)
+	}
+	return path, nil
+}
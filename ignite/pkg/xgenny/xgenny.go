@@ -3,6 +3,7 @@ package xgenny
 import (
 	"bytes"
 	"embed"
+	"io/fs"
 	"path/filepath"
 	"strings"
 
@@ -11,7 +12,7 @@ import (
 
 // Walker implements packd.Walker for Go embed's fs.FS.
 type Walker struct {
-	fs         embed.FS
+	fs         fs.FS
 	trimPrefix string
 	path       string
 }
@@ -22,13 +23,19 @@ func NewEmbedWalker(fs embed.FS, trimPrefix, path string) Walker {
 	return Walker{fs: fs, trimPrefix: trimPrefix, path: path}
 }
 
+// NewFSWalker returns a new Walker for any fs.FS, e.g. a directory opened with os.DirFS.
+// trimPrefix is used to trim parent paths from the paths of found files.
+func NewFSWalker(fsys fs.FS, trimPrefix, path string) Walker {
+	return Walker{fs: fsys, trimPrefix: trimPrefix, path: path}
+}
+
 // Walk implements packd.Walker.
 func (w Walker) Walk(wl packd.WalkFunc) error {
 	return w.walkDir(wl, ".")
 }
 
 func (w Walker) walkDir(wl packd.WalkFunc, path string) error {
-	entries, err := w.fs.ReadDir(path)
+	entries, err := fs.ReadDir(w.fs, path)
 	if err != nil {
 		return err
 	}
@@ -41,7 +48,7 @@ func (w Walker) walkDir(wl packd.WalkFunc, path string) error {
 
 		path := filepath.Join(path, entry.Name())
 
-		data, err := w.fs.ReadFile(path)
+		data, err := fs.ReadFile(w.fs, path)
 		if err != nil {
 			return err
 		}
@@ -1,9 +1,15 @@
 package xgit
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pkg/errors"
 )
 
 func AreChangesCommitted(appPath string) (bool, error) {
@@ -31,3 +37,122 @@ func AreChangesCommitted(appPath string) (bool, error) {
 	}
 	return ws.IsClean(), nil
 }
+
+// HeadHash returns the hash of the commit of HEAD of the repository at path.
+func HeadHash(path string) (string, error) {
+	repository, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+	head, err := repository.Head()
+	if err != nil {
+		return "", err
+	}
+	return head.Hash().String(), nil
+}
+
+// Commit is a commit of a git repository.
+type Commit struct {
+	// Hash of the commit.
+	Hash string
+
+	// Author is the name of the author of the commit.
+	Author string
+
+	// Subject is the first line of the message of the commit.
+	Subject string
+
+	// When is the time the commit was authored.
+	When time.Time
+}
+
+// CommitsSince returns the commits of the repository at path reachable from HEAD but not
+// from the revision rev, e.g. a tag. The commits are ordered from the newest.
+func CommitsSince(path, rev string) ([]Commit, error) {
+	repository, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	since, err := repository.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot resolve %s", rev)
+	}
+
+	// the commits reachable from rev are excluded.
+	excluded := make(map[plumbing.Hash]bool)
+	sinceLog, err := repository.Log(&git.LogOptions{From: *since})
+	if err != nil {
+		return nil, err
+	}
+	if err := sinceLog.ForEach(func(c *object.Commit) error {
+		excluded[c.Hash] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	headLog, err := repository.Log(&git.LogOptions{Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	err = headLog.ForEach(func(c *object.Commit) error {
+		if excluded[c.Hash] {
+			return nil
+		}
+		commits = append(commits, Commit{
+			Hash:    c.Hash.String(),
+			Author:  c.Author.Name,
+			Subject: strings.TrimSpace(strings.SplitN(c.Message, "\n", 2)[0]),
+			When:    c.Author.When,
+		})
+		return nil
+	})
+	return commits, err
+}
+
+// ExportDir writes the files of dir in the tree of the revision rev of the repository at path
+// to dst, dir is relative to the root of the repository.
+func ExportDir(path, rev, dir, dst string) error {
+	repository, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	hash, err := repository.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return errors.Wrapf(err, "cannot resolve %s", rev)
+	}
+	commit, err := repository.CommitObject(*hash)
+	if err != nil {
+		return err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return err
+	}
+
+	// the dir is missing from the trees of the older revisions.
+	dir = filepath.ToSlash(filepath.Clean(dir))
+	if dir != "." {
+		if tree, err = tree.Tree(dir); err == object.ErrDirectoryNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return tree.Files().ForEach(func(f *object.File) error {
+		content, err := f.Contents()
+		if err != nil {
+			return err
+		}
+		filePath := filepath.Join(dst, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(filePath, []byte(content), 0644)
+	})
+}
@@ -0,0 +1,74 @@
+package xgit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+func TestCommitsSinceAndExportDir(t *testing.T) {
+	dir := t.TempDir()
+	repository, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	w, err := repository.Worktree()
+	require.NoError(t, err)
+
+	when := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(file, content, message string) {
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		_, err := w.Add(file)
+		require.NoError(t, err)
+		when = when.Add(time.Hour)
+		_, err = w.Commit(message, &git.CommitOptions{
+			Author: &object.Signature{Name: "bob", Email: "bob@example.com", When: when},
+		})
+		require.NoError(t, err)
+	}
+
+	commit("proto/blog/post.proto", "v1", "Scaffold the blog")
+	head, err := repository.Head()
+	require.NoError(t, err)
+	_, err = repository.CreateTag("v0.1.0", head.Hash(), nil)
+	require.NoError(t, err)
+
+	commit("proto/blog/post.proto", "v2", "Add the post title\n\nThe title is required.")
+	commit("x/blog/module.go", "module", "Bump the consensus version")
+
+	headHash, err := xgit.HeadHash(dir)
+	require.NoError(t, err)
+	head, err = repository.Head()
+	require.NoError(t, err)
+	require.Equal(t, head.Hash().String(), headHash)
+
+	commits, err := xgit.CommitsSince(dir, "v0.1.0")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, "Bump the consensus version", commits[0].Subject)
+	require.Equal(t, "Add the post title", commits[1].Subject)
+	require.Equal(t, "bob", commits[1].Author)
+
+	_, err = xgit.CommitsSince(dir, "v9.9.9")
+	require.Error(t, err)
+
+	out := t.TempDir()
+	require.NoError(t, xgit.ExportDir(dir, "v0.1.0", "proto", out))
+	content, err := os.ReadFile(filepath.Join(out, "blog", "post.proto"))
+	require.NoError(t, err)
+	require.Equal(t, "v1", string(content))
+
+	// the dirs missing from the revision are empty.
+	out = t.TempDir()
+	require.NoError(t, xgit.ExportDir(dir, "v0.1.0", "x", out))
+	entries, err := os.ReadDir(out)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
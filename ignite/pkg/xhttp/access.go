@@ -0,0 +1,135 @@
+package xhttp
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// AccessTokenHeader is the header of the requests carrying their access token.
+const AccessTokenHeader = "X-Access-Token"
+
+var (
+	errForbidden    = errors.New("the requests from your IP aren't allowed")
+	errUnauthorized = errors.New("invalid access token, set it in the " + AccessTokenHeader + " header")
+)
+
+// AccessControl restricts the requests to a handler to the ones sent from the allowed networks
+// and carrying one of the access tokens. The requests sent from a loopback interface are always
+// allowed.
+type AccessControl struct {
+	tokens   []string
+	networks []*net.IPNet
+}
+
+// NewAccessControl creates an access control from the access tokens and the allowed IPs or CIDR
+// ranges, e.g. 10.0.0.0/8. The requests are authenticated when tokens are set and all the IPs are
+// allowed when allowedIPs is empty.
+func NewAccessControl(tokens, allowedIPs []string) (AccessControl, error) {
+	a := AccessControl{}
+	for _, token := range tokens {
+		if token == "" {
+			return AccessControl{}, errors.New("empty access token")
+		}
+		a.tokens = append(a.tokens, token)
+	}
+	for _, ip := range allowedIPs {
+		network, err := ParseNetwork(ip)
+		if err != nil {
+			return AccessControl{}, err
+		}
+		a.networks = append(a.networks, network)
+	}
+	return a, nil
+}
+
+// ParseNetwork parses an IP or a CIDR range, an IP is the range of its single address.
+func ParseNetwork(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR range %q", s)
+		}
+		return network, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP %q", s)
+	}
+	bits := net.IPv6len * 8
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, net.IPv4len*8
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// IsSet checks if the access is restricted.
+func (a AccessControl) IsSet() bool {
+	return len(a.tokens) > 0 || len(a.networks) > 0
+}
+
+// Handler restricts the access to h. The token header is removed from the allowed requests.
+func (a AccessControl) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(AccessTokenHeader)
+		r.Header.Del(AccessTokenHeader)
+
+		ip := remoteIP(r)
+		if ip != nil && ip.IsLoopback() {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if !a.isAllowedIP(ip) {
+			ResponseJSON(w, http.StatusForbidden, NewErrorResponse(errForbidden))
+			return
+		}
+		// the CORS preflight requests of the browsers can't carry the token.
+		if r.Method != http.MethodOptions && !a.isValidToken(token) {
+			ResponseJSON(w, http.StatusUnauthorized, NewErrorResponse(errUnauthorized))
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (a AccessControl) isAllowedIP(ip net.IP) bool {
+	if len(a.networks) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, network := range a.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a AccessControl) isValidToken(token string) bool {
+	if len(a.tokens) == 0 {
+		return true
+	}
+	valid := false
+	for _, t := range a.tokens {
+		// all the tokens are compared to not leak which one is matched.
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// remoteIP returns the IP the request is sent from, nil when it's unknown.
+func remoteIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
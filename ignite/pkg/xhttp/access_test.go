@@ -0,0 +1,73 @@
+package xhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessControl(t *testing.T) {
+	a, err := NewAccessControl([]string{"secret", "other"}, []string{"10.0.0.0/8", "192.168.1.5"})
+	require.NoError(t, err)
+	require.True(t, a.IsSet())
+
+	var token string
+	h := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Header.Get(AccessTokenHeader)
+	}))
+
+	cases := []struct {
+		name       string
+		method     string
+		remoteAddr string
+		token      string
+		status     int
+	}{
+		{"loopback", http.MethodGet, "127.0.0.1:4000", "", http.StatusOK},
+		{"loopback ipv6", http.MethodGet, "[::1]:4000", "", http.StatusOK},
+		{"allowed range", http.MethodGet, "10.1.2.3:4000", "secret", http.StatusOK},
+		{"allowed ip", http.MethodPost, "192.168.1.5:4000", "other", http.StatusOK},
+		{"missing token", http.MethodGet, "10.1.2.3:4000", "", http.StatusUnauthorized},
+		{"invalid token", http.MethodGet, "10.1.2.3:4000", "secrets", http.StatusUnauthorized},
+		{"preflight", http.MethodOptions, "10.1.2.3:4000", "", http.StatusOK},
+		{"forbidden ip", http.MethodGet, "192.168.1.6:4000", "secret", http.StatusForbidden},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			token = ""
+			r := httptest.NewRequest(tt.method, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.token != "" {
+				r.Header.Set(AccessTokenHeader, tt.token)
+			}
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			require.Equal(t, tt.status, w.Code)
+			require.Empty(t, token)
+		})
+	}
+
+	// all the IPs are allowed without ranges.
+	a, err = NewAccessControl([]string{"secret"}, nil)
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.7:4000"
+	r.Header.Set(AccessTokenHeader, "secret")
+	w := httptest.NewRecorder()
+	a.Handler(http.NotFoundHandler()).ServeHTTP(w, r)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	_, err = NewAccessControl(nil, []string{"10.0.0.0/33"})
+	require.ErrorContains(t, err, "invalid CIDR range")
+	_, err = NewAccessControl(nil, []string{"office"})
+	require.ErrorContains(t, err, "invalid IP")
+	_, err = NewAccessControl([]string{""}, nil)
+	require.Error(t, err)
+
+	a, err = NewAccessControl(nil, nil)
+	require.NoError(t, err)
+	require.False(t, a.IsSet())
+}
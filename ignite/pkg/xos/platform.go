@@ -0,0 +1,35 @@
+package xos
+
+import (
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+var (
+	onceMusl sync.Once
+	isMusl   bool
+)
+
+// IsMusl returns true when the system runs the musl libc instead of the glibc, e.g. on Alpine Linux.
+// The binaries linked with the glibc don't run on it.
+func IsMusl() bool {
+	onceMusl.Do(func() {
+		if runtime.GOOS != "linux" {
+			return
+		}
+		// the dynamic loader of musl, e.g. /lib/ld-musl-x86_64.so.1 or /lib/ld-musl-aarch64.so.1.
+		loaders, _ := filepath.Glob("/lib/ld-musl-*.so.1")
+		isMusl = len(loaders) > 0
+	})
+	return isMusl
+}
+
+// Platform returns the platform of the system, e.g. linux/arm64, with its libc when it's musl.
+func Platform() string {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	if IsMusl() {
+		platform += " (musl)"
+	}
+	return platform
+}
@@ -0,0 +1,30 @@
+package xos
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Size returns the size in bytes of the file or of all the files in the directory at path.
+// A zero size is returned when the path doesn't exist.
+func Size(path string) (size int64, err error) {
+	err = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	return size, err
+}
@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// apiSocketPath returns the path of the Unix socket the API of the node listens on when it's
+// served behind the access control proxy, see chainconfig.IsAPIProxied.
+func apiSocketPath(home string) string {
+	return filepath.Join(home, "api.sock")
+}
+
+// removeAPISocket removes the Unix socket of the API left by a killed node, the node can't listen
+// on it again otherwise.
+func removeAPISocket(home string) error {
+	if err := os.Remove(apiSocketPath(home)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// runAPIProxy serves the API of the node listening on the Unix socket in home on the API address
+// of the config, the requests are allowed by the access control of the config.
+func (c *Chain) runAPIProxy(ctx context.Context, home string, config chainconfig.Config) error {
+	access, err := config.Host.Access.Control()
+	if err != nil {
+		return err
+	}
+
+	socket := apiSocketPath(home)
+	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: "api"})
+	proxy.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}
+
+	// the address format is validated when the node is configured.
+	addr, _ := xurl.TCP(config.Host.API)
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    strings.TrimPrefix(addr, "tcp://"),
+		Handler: access.Handler(proxy),
+	})
+}
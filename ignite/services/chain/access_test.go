@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/availableport"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+func TestRunAPIProxy(t *testing.T) {
+	home, err := os.MkdirTemp("", "api")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(home) })
+
+	// a socket left by a killed node is removed.
+	require.NoError(t, os.WriteFile(apiSocketPath(home), nil, 0644))
+	require.NoError(t, removeAPISocket(home))
+	require.NoError(t, removeAPISocket(home))
+
+	// the API of the node.
+	l, err := net.Listen("unix", apiSocketPath(home))
+	require.NoError(t, err)
+	api := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s", r.URL.Path, r.Header.Get(xhttp.AccessTokenHeader))
+	})}
+	go api.Serve(l)
+	t.Cleanup(func() { api.Close() })
+
+	ports, err := availableport.Find(1)
+	require.NoError(t, err)
+	config := chainconfig.Config{Host: chainconfig.Host{
+		API:    fmt.Sprintf("0.0.0.0:%d", ports[0]),
+		Access: chainconfig.Access{Tokens: []string{"secret"}},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() { errc <- (&Chain{}).runAPIProxy(ctx, home, config) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errc)
+	})
+
+	// the requests from the machine are allowed, the token is removed.
+	url := fmt.Sprintf("http://127.0.0.1:%d/cosmos/bank/v1beta1/balances", ports[0])
+	var res *http.Response
+	require.Eventually(t, func() bool {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set(xhttp.AccessTokenHeader, "invalid")
+		res, err = http.DefaultClient.Do(req)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, "/cosmos/bank/v1beta1/balances ", string(body))
+}
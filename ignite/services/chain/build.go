@@ -9,6 +9,7 @@ import (
 	"runtime"
 
 	"github.com/docker/docker/pkg/archive"
+	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
 
 	"github.com/ignite/cli/ignite/pkg/cache"
@@ -19,6 +20,8 @@ import (
 	"github.com/ignite/cli/ignite/pkg/dirchange"
 	"github.com/ignite/cli/ignite/pkg/goanalysis"
 	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/goenv"
+	"github.com/ignite/cli/ignite/pkg/xos"
 	"github.com/ignite/cli/ignite/pkg/xstrings"
 )
 
@@ -30,6 +33,8 @@ const (
 )
 
 // Build builds and installs app binaries.
+// The shell completion scripts and the man pages of the binary are generated in output,
+// or in the build dir of the app when output is not set.
 func (c *Chain) Build(ctx context.Context, cacheStorage cache.Storage, output string) (binaryName string, err error) {
 	if err := c.setup(); err != nil {
 		return "", err
@@ -39,7 +44,26 @@ func (c *Chain) Build(ctx context.Context, cacheStorage cache.Storage, output st
 		return "", err
 	}
 
-	return c.Binary()
+	binaryName, err = c.Binary()
+	if err != nil {
+		return "", err
+	}
+
+	docsPath := output
+	binaryPath := filepath.Join(output, gocmd.ExecutableName(binaryName, runtime.GOOS))
+	if output == "" {
+		docsPath = filepath.Join(c.app.Path, buildDir)
+		binaryPath = filepath.Join(goenv.Bin(), gocmd.ExecutableName(binaryName, runtime.GOOS))
+	}
+	if err := os.RemoveAll(filepath.Join(docsPath, completionsDir)); err != nil {
+		return "", err
+	}
+	if err := os.RemoveAll(filepath.Join(docsPath, manDir)); err != nil {
+		return "", err
+	}
+	c.buildBinaryDocs(ctx, binaryPath, docsPath)
+
+	return binaryName, nil
 }
 
 func (c *Chain) build(ctx context.Context, cacheStorage cache.Storage, output string) (err error) {
@@ -55,7 +79,7 @@ func (c *Chain) build(ctx context.Context, cacheStorage cache.Storage, output st
 		return err
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage)
+	buildFlags, err := c.preBuild(ctx, cacheStorage, c.sourceVersion.tag)
 	if err != nil {
 		return err
 	}
@@ -70,13 +94,33 @@ func (c *Chain) build(ctx context.Context, cacheStorage cache.Storage, output st
 		return err
 	}
 
-	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
+	env, err := c.buildEnv(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return err
+	}
+
+	return gocmd.BuildPath(ctx, output, gocmd.ExecutableName(binary, runtime.GOOS), path, buildFlags, exec.StepOption(step.Env(env...)))
 }
 
 // BuildRelease builds binaries for a release. targets is a list
 // of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
 // prefix is used as prefix to tarballs containing each target.
-func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, output, prefix string, targets ...string) (releasePath string, err error) {
+func (c *Chain) BuildRelease(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	output,
+	prefix string,
+	targets []string,
+	options ...ReleaseOption,
+) (releasePath string, err error) {
+	var releaseOpts releaseOptions
+	for _, apply := range options {
+		apply(&releaseOpts)
+	}
+	if releaseOpts.image != "" {
+		releaseOpts.dockerfile = true
+	}
+
 	if prefix == "" {
 		prefix = c.app.Name
 	}
@@ -89,7 +133,20 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		return "", err
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage)
+	ver := c.sourceVersion.tag
+	if releaseOpts.version != "" {
+		ver = releaseOpts.version
+	}
+
+	// the Dockerfile packages a linux binary, checked before the build.
+	var dockerTargets []string
+	if releaseOpts.dockerfile {
+		if dockerTargets, err = releaseDockerTargets(targets); err != nil {
+			return "", err
+		}
+	}
+
+	buildFlags, err := c.preBuild(ctx, cacheStorage, ver)
 	if err != nil {
 		return "", err
 	}
@@ -117,6 +174,31 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		return "", err
 	}
 
+	// the docs are generated once by a binary built for the host,
+	// the binaries of the other targets can't run on it.
+	docsPath, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(docsPath)
+
+	hostOut, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(hostOut)
+
+	hostEnv, err := c.buildEnv(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return "", err
+	}
+
+	hostBinary := gocmd.ExecutableName(binary, runtime.GOOS)
+	if err := gocmd.BuildPath(ctx, hostOut, hostBinary, mainPath, buildFlags, exec.StepOption(step.Env(hostEnv...))); err != nil {
+		return "", err
+	}
+	hasDocs := c.buildBinaryDocs(ctx, filepath.Join(hostOut, hostBinary), docsPath)
+
 	for _, t := range targets {
 		// build binary for a target, tarball it and save it under the release dir.
 		goos, goarch, err := gocmd.ParseTarget(t)
@@ -124,6 +206,11 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 			return "", err
 		}
 
+		env, err := c.buildEnv(goos, goarch)
+		if err != nil {
+			return "", err
+		}
+
 		out, err := os.MkdirTemp("", "")
 		if err != nil {
 			return "", err
@@ -131,16 +218,22 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		defer os.RemoveAll(out)
 
 		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
+			exec.StepOption(step.Env(append(env,
 				cmdrunner.Env(gocmd.EnvGOOS, goos),
 				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
+			)...)),
 		}
 
-		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
+		if err := gocmd.BuildPath(ctx, out, gocmd.ExecutableName(binary, goos), mainPath, buildFlags, buildOptions...); err != nil {
 			return "", err
 		}
 
+		if hasDocs {
+			if err := copy.Copy(docsPath, out); err != nil {
+				return "", err
+			}
+		}
+
 		tarr, err := archive.Tar(out, archive.Gzip)
 		if err != nil {
 			return "", err
@@ -161,13 +254,32 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		tarf.Close()
 	}
 
+	if releaseOpts.dockerfile {
+		if err := writeReleaseDockerfile(releasePath, prefix, binary, dockerTargets); err != nil {
+			return "", err
+		}
+	}
+
 	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
 
 	// create a checksum.txt and return with the path to release dir.
-	return releasePath, checksum.Sum(releasePath, checksumPath)
+	if err := checksum.Sum(releasePath, checksumPath); err != nil {
+		return "", err
+	}
+
+	if releaseOpts.image != "" {
+		fmt.Fprintf(c.stdLog().out, "🐳 Building the image %s...\n", releaseOpts.image)
+		if err := buildReleaseImage(ctx, releasePath, releaseOpts.image, c.stdLog().out); err != nil {
+			return "", err
+		}
+	}
+
+	return releasePath, nil
 }
 
-func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (buildFlags []string, err error) {
+// preBuild prepares the build of the app and returns its build flags, ver is the version of the
+// app embedded in the binary with its commit.
+func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage, ver string) (buildFlags []string, err error) {
 	config, err := c.Config()
 	if err != nil {
 		return nil, err
@@ -179,17 +291,38 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 	}
 
 	ldFlags := config.Build.LDFlags
+	tags := c.options.buildTags
+
+	// wasmvm is linked statically with its musl library on musl systems, e.g. Alpine Linux.
+	wasmvm, err := wasmvmVersion(c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+	if wasmvm != "" && xos.IsMusl() {
+		if err := checkWasmvmMuslLib(wasmvm); err != nil {
+			return nil, err
+		}
+		tags = append(tags, wasmvmMuslTag)
+		ldFlags = append(ldFlags, "-linkmode=external", `-extldflags "-Wl,-z,muldefs -static"`)
+	}
+
 	ldFlags = append(ldFlags,
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Name=%s", xstrings.Title(c.app.Name)),
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.AppName=%sd", c.app.Name),
-		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Version=%s", c.sourceVersion.tag),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Version=%s", ver),
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Commit=%s", c.sourceVersion.hash),
 		fmt.Sprintf("-X %s/cmd/%s/cmd.ChainID=%s", c.app.ImportPath, c.app.D(), chainID),
 	)
+	if len(tags) > 0 {
+		ldFlags = append(ldFlags, fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.BuildTags=%s", gocmd.Tags(tags...)))
+	}
 	buildFlags = []string{
 		gocmd.FlagMod, gocmd.FlagModValueReadOnly,
 		gocmd.FlagLdflags, gocmd.Ldflags(ldFlags...),
 	}
+	if len(tags) > 0 {
+		buildFlags = append(buildFlags, gocmd.FlagTags, gocmd.Tags(tags...))
+	}
 
 	fmt.Fprintln(c.stdLog().out, "📦 Installing dependencies...")
 
@@ -220,6 +353,15 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 	return buildFlags, nil
 }
 
+// buildEnv returns the env of the build of the app for goos/goarch.
+func (c *Chain) buildEnv(goos, goarch string) ([]string, error) {
+	wasmvm, err := wasmvmVersion(c.app.Path)
+	if err != nil || wasmvm == "" {
+		return nil, err
+	}
+	return wasmvmEnv(goos, goarch, xos.IsMusl())
+}
+
 func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
 	conf, err := c.Config()
 	if err != nil {
@@ -236,3 +378,18 @@ func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
 	}
 	return path, err
 }
+
+// BuildArtifactPaths returns the paths of the artifacts built for the chain,
+// the release directory and the binary installed in the Go bin directory.
+func (c *Chain) BuildArtifactPaths() ([]string, error) {
+	binary, err := c.Binary()
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		filepath.Join(c.app.Path, releaseDir),
+		filepath.Join(c.app.Path, buildDir),
+		filepath.Join(goenv.Bin(), gocmd.ExecutableName(binary, runtime.GOOS)),
+	}, nil
+}
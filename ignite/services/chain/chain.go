@@ -5,16 +5,20 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/gookit/color"
 	"github.com/tendermint/spn/pkg/chainid"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/ignite/cli/ignite/chainconfig"
 	sperrors "github.com/ignite/cli/ignite/errors"
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/ignite/pkg/repoversion"
 	"github.com/ignite/cli/ignite/pkg/xurl"
@@ -44,6 +48,7 @@ const (
 	LogSilent LogLvl = iota
 	LogRegular
 	LogVerbose
+	LogQuiet
 )
 
 // Chain provides programatic access and tools for a Cosmos SDK blockchain.
@@ -62,10 +67,20 @@ type Chain struct {
 	serveRefresher chan struct{}
 	served         bool
 
+	// servedConf is the config of the running app, the changes of the config are applied from it.
+	servedConf   *chainconfig.Config
+	servedConfMu sync.Mutex
+
+	// faucetReloader receives the faucets replacing the served one after the changes of its config.
+	faucetReloader chan cosmosfaucet.Faucet
+
 	// protoBuiltAtLeastOnce indicates that app's proto generation at least made once.
 	protoBuiltAtLeastOnce bool
 
 	stdout, stderr io.Writer
+
+	// logger logs the operations of the chain with levels and fields.
+	logger log.Logger
 }
 
 // chainOptions holds user given options that overwrites chain's defaults.
@@ -79,12 +94,25 @@ type chainOptions struct {
 	// keyring backend used by commands if not specified in configuration
 	keyringBackend chaincmd.KeyringBackend
 
+	// accountsKeyringBackend is the backend of the Ignite CLI keyring the accounts of the config
+	// are imported from.
+	accountsKeyringBackend cosmosaccount.KeyringBackend
+
 	// isThirdPartyModuleCodegen indicates if proto code generation should be made
 	// for 3rd party modules. SDK modules are also considered as a 3rd party.
 	isThirdPartyModuleCodegenEnabled bool
 
 	// path of a custom config file
 	ConfigFile string
+
+	// configProfile is the profile of the config overriding it.
+	configProfile string
+
+	// buildTags are the Go build tags used to build the app binary.
+	buildTags []string
+
+	// rosetta enables the Rosetta API gateway of the node regardless of the config.
+	rosetta bool
 }
 
 // Option configures Chain.
@@ -97,6 +125,14 @@ func LogLevel(level LogLvl) Option {
 	}
 }
 
+// Logger logs the operations of the chain like the builds, restarts and failures of the served app
+// with levels and fields, besides the output selected by LogLevel. By default, they aren't logged.
+func Logger(logger log.Logger) Option {
+	return func(c *Chain) {
+		c.logger = logger
+	}
+}
+
 // ID replaces chain's id with given id.
 func ID(id string) Option {
 	return func(c *Chain) {
@@ -118,6 +154,14 @@ func KeyringBackend(keyringBackend chaincmd.KeyringBackend) Option {
 	}
 }
 
+// AccountsKeyringBackend sets the backend of the Ignite CLI keyring the accounts of the config with
+// keyring set are imported from. It's the test backend by default.
+func AccountsKeyringBackend(backend cosmosaccount.KeyringBackend) Option {
+	return func(c *Chain) {
+		c.options.accountsKeyringBackend = backend
+	}
+}
+
 // ConfigFile specifies a custom config file to use
 func ConfigFile(configFile string) Option {
 	return func(c *Chain) {
@@ -125,6 +169,28 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ConfigProfile selects the profile of the config overriding it, e.g. testnet.
+func ConfigProfile(name string) Option {
+	return func(c *Chain) {
+		c.options.configProfile = name
+	}
+}
+
+// BuildTags sets the Go build tags used to build the app binary,
+// modules scaffolded with a feature are only included when their build tag is set.
+func BuildTags(tags ...string) Option {
+	return func(c *Chain) {
+		c.options.buildTags = tags
+	}
+}
+
+// EnableRosetta starts the Rosetta API gateway with the node, even when it isn't enabled in the config.
+func EnableRosetta() Option {
+	return func(c *Chain) {
+		c.options.rosetta = true
+	}
+}
+
 // EnableThirdPartyModuleCodegen enables code generation for third party modules,
 // including the SDK.
 func EnableThirdPartyModuleCodegen() Option {
@@ -144,8 +210,10 @@ func New(path string, options ...Option) (*Chain, error) {
 		app:            app,
 		logLevel:       LogSilent,
 		serveRefresher: make(chan struct{}, 1),
+		faucetReloader: make(chan cosmosfaucet.Faucet, 1),
 		stdout:         io.Discard,
 		stderr:         io.Discard,
+		logger:         log.NewNopLogger(),
 	}
 
 	// Apply the options
@@ -224,11 +292,36 @@ func (c *Chain) Config() (chainconfig.Config, error) {
 	if configPath == "" {
 		return chainconfig.DefaultConf, nil
 	}
-	return chainconfig.ParseFile(configPath)
+	conf, err := chainconfig.ParseFileProfile(configPath, c.options.configProfile)
+	if err != nil {
+		return conf, err
+	}
+	if c.options.rosetta {
+		conf.Rosetta.Enabled = true
+	}
+	return conf, nil
 }
 
-// ID returns the chain's id.
+// ID returns the chain's id. The id can be a template, e.g. mychain-ci-${GIT_SHA}, its variables
+// are replaced by their values in the environment, GIT_SHA defaults to the short hash of the HEAD
+// commit of the app.
 func (c *Chain) ID() (string, error) {
+	template, err := c.idTemplate()
+	if err != nil {
+		return "", err
+	}
+	return expandChainID(template, c.app.Path)
+}
+
+// KeyringNamespace returns the namespace of the accounts of the chain in the Ignite CLI keyring.
+// It's the id of the chain before its variables are replaced, so the accounts are kept when the
+// id changes, e.g. with the GIT_SHA of a new commit.
+func (c *Chain) KeyringNamespace() (string, error) {
+	return c.idTemplate()
+}
+
+// idTemplate returns the chain's id before its variables are replaced.
+func (c *Chain) idTemplate() (string, error) {
 	// chainID in App has the most priority.
 	if c.options.chainID != "" {
 		return c.options.chainID, nil
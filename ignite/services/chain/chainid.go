@@ -0,0 +1,103 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+const (
+	// chainIDVarGitSHA is the variable of the chain id templates replaced by the short hash of the
+	// HEAD commit of the app, unless it's set in the environment.
+	chainIDVarGitSHA = "GIT_SHA"
+
+	// gitSHALen is the length of the short hash of a commit.
+	gitSHALen = 7
+
+	// genesisTimeNow is the genesis time resolved to the time of the initialization of the chain,
+	// it can be followed by an offset, e.g. now+1m.
+	genesisTimeNow = "now"
+)
+
+// expandChainID replaces the variables of the chain id template, e.g. mychain-ci-${GIT_SHA}, by
+// their values in the environment. GIT_SHA defaults to the short hash of the HEAD commit of the app.
+func expandChainID(template, appPath string) (string, error) {
+	var err error
+	id := os.Expand(template, func(name string) string {
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+		if name == chainIDVarGitSHA {
+			hash, hashErr := xgit.HeadHash(appPath)
+			if hashErr != nil {
+				err = fmt.Errorf("cannot resolve %s of the chain id: %w", name, hashErr)
+				return ""
+			}
+			return hash[:gitSHALen]
+		}
+		if err == nil {
+			err = fmt.Errorf("variable %s of the chain id %s is not set", name, template)
+		}
+		return ""
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// resolveGenesisTime replaces the relative genesis time of the genesis overrides, now or now
+// followed by an offset like now+1m, by the time it resolves to. Other values are kept as is.
+func resolveGenesisTime(genesis map[string]interface{}, now time.Time) error {
+	value, ok := genesis["genesis_time"].(string)
+	if !ok || !isRelativeGenesisTime(value) {
+		return nil
+	}
+
+	var offset time.Duration
+	if value != genesisTimeNow {
+		var err error
+		if offset, err = time.ParseDuration(strings.TrimPrefix(value, genesisTimeNow)); err != nil {
+			return fmt.Errorf("invalid genesis time %s: %w", value, err)
+		}
+	}
+
+	genesis["genesis_time"] = now.Add(offset).UTC().Format(time.RFC3339Nano)
+	return nil
+}
+
+// isRelativeGenesisTime returns true when the genesis time is resolved at the initialization.
+func isRelativeGenesisTime(value string) bool {
+	return value == genesisTimeNow ||
+		strings.HasPrefix(value, genesisTimeNow+"+") ||
+		strings.HasPrefix(value, genesisTimeNow+"-")
+}
+
+// hasChainIDChanged returns true when the chain id of the genesis of the initialized chain differs
+// from the id of the chain, e.g. after a new commit changed its templated id.
+func (c *Chain) hasChainIDChanged() (bool, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return false, err
+	}
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return false, err
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return false, err
+	}
+
+	var gen struct {
+		ChainID string `json:"chain_id"`
+	}
+	if err := json.Unmarshal(genesis, &gen); err != nil {
+		return false, err
+	}
+	return gen.ChainID != chainID, nil
+}
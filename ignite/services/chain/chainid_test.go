@@ -0,0 +1,70 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandChainID(t *testing.T) {
+	dir := t.TempDir()
+	repository, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	w, err := repository.Worktree()
+	require.NoError(t, err)
+	hash, err := w.Commit("Scaffold", &git.CommitOptions{
+		Author: &object.Signature{Name: "bob", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	id, err := expandChainID("mars-ci-${GIT_SHA}", dir)
+	require.NoError(t, err)
+	require.Equal(t, "mars-ci-"+hash.String()[:gitSHALen], id)
+
+	t.Setenv("GIT_SHA", "abc")
+	t.Setenv("RUN", "42")
+	id, err = expandChainID("mars-ci-$GIT_SHA-${RUN}", dir)
+	require.NoError(t, err)
+	require.Equal(t, "mars-ci-abc-42", id)
+
+	id, err = expandChainID("mars", dir)
+	require.NoError(t, err)
+	require.Equal(t, "mars", id)
+
+	_, err = expandChainID("mars-${IGNITE_UNSET_VAR}", dir)
+	require.ErrorContains(t, err, "IGNITE_UNSET_VAR")
+}
+
+func TestKeyringNamespace(t *testing.T) {
+	// the app has no commit to resolve GIT_SHA.
+	c := &Chain{app: App{Path: t.TempDir()}, options: chainOptions{chainID: "mars-ci-${GIT_SHA}"}}
+
+	_, err := c.ID()
+	require.ErrorContains(t, err, "GIT_SHA")
+
+	// the accounts are namespaced by the template, kept across the commits.
+	namespace, err := c.KeyringNamespace()
+	require.NoError(t, err)
+	require.Equal(t, "mars-ci-${GIT_SHA}", namespace)
+}
+
+func TestResolveGenesisTime(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for value, want := range map[string]string{
+		"now":                  "2022-06-01T12:00:00Z",
+		"now+1m30s":            "2022-06-01T12:01:30Z",
+		"now-1h":               "2022-06-01T11:00:00Z",
+		"2021-01-01T00:00:00Z": "2021-01-01T00:00:00Z",
+	} {
+		genesis := map[string]interface{}{"genesis_time": value}
+		require.NoError(t, resolveGenesisTime(genesis, now))
+		require.Equal(t, want, genesis["genesis_time"], value)
+	}
+
+	require.Error(t, resolveGenesisTime(map[string]interface{}{"genesis_time": "now+soon"}, now))
+	require.NoError(t, resolveGenesisTime(nil, now))
+}
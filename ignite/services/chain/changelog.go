@@ -0,0 +1,183 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+// modulesDir is the dir of the modules of the app.
+const modulesDir = "x"
+
+// consensusVersionRe matches the consensus version returned by the AppModule of a module.
+var consensusVersionRe = regexp.MustCompile(`ConsensusVersion\(\)\s*uint64\s*{\s*return\s+(\d+)`)
+
+// Changelog is the draft of the changelog of the app since a release.
+type Changelog struct {
+	// Since is the git revision of the release, e.g. its tag.
+	Since string
+
+	// Commits are the commits since the release, from the newest.
+	Commits []xgit.Commit
+
+	// API lists the changes of the proto API since the release.
+	API protoanalysis.Diff
+
+	// Migrations are the modules whose consensus version is bumped since the release,
+	// their state is migrated by the upgrade.
+	Migrations []ModuleMigration
+}
+
+// ModuleMigration is the bump of the consensus version of a module.
+type ModuleMigration struct {
+	Module string
+	From   uint64
+	To     uint64
+}
+
+// Changelog returns the draft of the changelog of the app since the git revision since, e.g. the
+// tag of the last release. It combines the commits since the release, the changes of the proto
+// API and the state breaking changes, i.e. the changes of the stored messages and the migrations
+// of the modules. The working tree of the app is compared to the release.
+func (c *Chain) Changelog(ctx context.Context, since string) (Changelog, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return Changelog{}, err
+	}
+
+	changelog := Changelog{Since: since}
+	if changelog.Commits, err = xgit.CommitsSince(c.app.Path, since); err != nil {
+		return Changelog{}, err
+	}
+
+	releasePath, err := os.MkdirTemp("", "")
+	if err != nil {
+		return Changelog{}, err
+	}
+	defer os.RemoveAll(releasePath)
+
+	for _, dir := range []string{conf.Build.Proto.Path, modulesDir} {
+		if err := xgit.ExportDir(c.app.Path, since, dir, filepath.Join(releasePath, dir)); err != nil {
+			return Changelog{}, err
+		}
+	}
+
+	releasePkgs, err := parseProtoPackages(ctx, filepath.Join(releasePath, conf.Build.Proto.Path))
+	if err != nil {
+		return Changelog{}, err
+	}
+	pkgs, err := parseProtoPackages(ctx, filepath.Join(c.app.Path, conf.Build.Proto.Path))
+	if err != nil {
+		return Changelog{}, err
+	}
+	changelog.API = protoanalysis.Compare(releasePkgs, pkgs)
+
+	releaseVersions, err := consensusVersions(filepath.Join(releasePath, modulesDir))
+	if err != nil {
+		return Changelog{}, err
+	}
+	versions, err := consensusVersions(filepath.Join(c.app.Path, modulesDir))
+	if err != nil {
+		return Changelog{}, err
+	}
+	for module, version := range versions {
+		// the new modules aren't migrated.
+		if releaseVersion, ok := releaseVersions[module]; ok && releaseVersion != version {
+			changelog.Migrations = append(changelog.Migrations, ModuleMigration{module, releaseVersion, version})
+		}
+	}
+	sort.Slice(changelog.Migrations, func(i, j int) bool {
+		return changelog.Migrations[i].Module < changelog.Migrations[j].Module
+	})
+
+	return changelog, nil
+}
+
+// Markdown returns the changelog in markdown, the sections without changes are omitted.
+func (c Changelog) Markdown() string {
+	var (
+		b       strings.Builder
+		section = func(title string, items []string) {
+			if len(items) == 0 {
+				return
+			}
+			fmt.Fprintf(&b, "\n### %s\n\n", title)
+			for _, item := range items {
+				fmt.Fprintf(&b, "- %s\n", item)
+			}
+		}
+	)
+
+	fmt.Fprintf(&b, "## Unreleased\n\nChanges since %s.\n", c.Since)
+
+	stateBreaking := make([]string, 0, len(c.Migrations)+len(c.API.StateBreaking))
+	for _, m := range c.Migrations {
+		stateBreaking = append(stateBreaking, fmt.Sprintf("x/%s: consensus version bumped from %d to %d, the state is migrated", m.Module, m.From, m.To))
+	}
+	for _, change := range c.API.StateBreaking {
+		stateBreaking = append(stateBreaking, fmt.Sprintf("`%s`", change))
+	}
+	section("State Breaking", stateBreaking)
+	section("API Breaking", codeItems(c.API.Breaking))
+	section("New Endpoints", codeItems(c.API.NewEndpoints))
+	section("New Messages", codeItems(c.API.NewMsgs))
+
+	commits := make([]string, 0, len(c.Commits))
+	for _, commit := range c.Commits {
+		commits = append(commits, fmt.Sprintf("%s (%s)", commit.Subject, commit.Hash[:7]))
+	}
+	section("Commits", commits)
+
+	return b.String()
+}
+
+// codeItems formats the items as code.
+func codeItems(items []string) []string {
+	code := make([]string, 0, len(items))
+	for _, item := range items {
+		code = append(code, fmt.Sprintf("`%s`", item))
+	}
+	return code
+}
+
+// parseProtoPackages parses the proto packages in path, there isn't any when path doesn't exist.
+func parseProtoPackages(ctx context.Context, path string) (protoanalysis.Packages, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	return protoanalysis.Parse(ctx, nil, path)
+}
+
+// consensusVersions returns the consensus versions of the modules in path by name.
+func consensusVersions(path string) (map[string]uint64, error) {
+	modules, err := filepath.Glob(filepath.Join(path, "*", "module.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]uint64)
+	for _, module := range modules {
+		content, err := os.ReadFile(module)
+		if err != nil {
+			return nil, err
+		}
+		match := consensusVersionRe.FindSubmatch(content)
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(string(match[1]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		versions[filepath.Base(filepath.Dir(module))] = version
+	}
+	return versions, nil
+}
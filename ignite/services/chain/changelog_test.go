@@ -0,0 +1,63 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+func TestConsensusVersions(t *testing.T) {
+	dir := t.TempDir()
+	write := func(module, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, module), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, module, "module.go"), []byte(content), 0644))
+	}
+	write("blog", "// ConsensusVersion implements ConsensusVersion.\nfunc (AppModule) ConsensusVersion() uint64 { return 2 }\n")
+	write("mars", "func (am AppModule) ConsensusVersion() uint64 {\n\treturn 3\n}\n")
+	write("legacy", "package legacy\n")
+
+	versions, err := consensusVersions(dir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"blog": 2, "mars": 3}, versions)
+
+	versions, err = consensusVersions(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	require.Empty(t, versions)
+}
+
+func TestChangelogMarkdown(t *testing.T) {
+	changelog := Changelog{
+		Since: "v0.1.0",
+		Commits: []xgit.Commit{
+			{Hash: "0123456789abcdef", Subject: "Add the post author"},
+		},
+		API: protoanalysis.Diff{
+			NewMsgs:       []string{"blog.Msg/DeletePost"},
+			StateBreaking: []string{"blog.Post field body (4) removed"},
+		},
+		Migrations: []ModuleMigration{{Module: "blog", From: 2, To: 3}},
+	}
+
+	require.Equal(t, `## Unreleased
+
+Changes since v0.1.0.
+
+### State Breaking
+
+- x/blog: consensus version bumped from 2 to 3, the state is migrated
+- `+"`blog.Post field body (4) removed`"+`
+
+### New Messages
+
+- `+"`blog.Msg/DeletePost`"+`
+
+### Commits
+
+- Add the post author (0123456)
+`, changelog.Markdown())
+}
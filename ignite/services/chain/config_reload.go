@@ -0,0 +1,160 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/localfs"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+// configChange is the kind of a change of the config of the served app.
+type configChange int
+
+const (
+	// configUnchanged is a change of the config file that doesn't modify the config, e.g. of its comments.
+	configUnchanged configChange = iota
+
+	// configFaucetChanged is a change of the faucet config only, it is applied without restarting the app.
+	configFaucetChanged
+
+	// configAppChanged is any other change, the app restarts and its state is only reset
+	// when the fields applied to the genesis are modified.
+	configAppChanged
+)
+
+// diffConfig returns the kind of change from the served config to the modified one.
+func diffConfig(served, modified chainconfig.Config) configChange {
+	if reflect.DeepEqual(served, modified) {
+		return configUnchanged
+	}
+
+	// the faucet server only runs when the faucet is enabled,
+	// the app restarts to enable or disable it.
+	servedFaucet, modifiedFaucet := served.Faucet, modified.Faucet
+	if servedFaucet.Name == nil || modifiedFaucet.Name == nil {
+		return configAppChanged
+	}
+
+	served.Faucet = chainconfig.Faucet{}
+	modified.Faucet = chainconfig.Faucet{}
+	if reflect.DeepEqual(served, modified) {
+		return configFaucetChanged
+	}
+	return configAppChanged
+}
+
+// watchConfig applies the changes of the config of the served app every time its file is modified.
+func (c *Chain) watchConfig(ctx context.Context) error {
+	if c.ConfigPath() == "" {
+		return nil
+	}
+
+	return localfs.Watch(
+		ctx,
+		[]string{c.ConfigPath()},
+		localfs.WatcherWorkdir(c.app.Path),
+		localfs.WatcherOnChange(func() { c.reloadConfig(ctx) }),
+	)
+}
+
+// reloadConfig applies the changes of the config to the served app: the faucet changes are
+// applied live by restarting the faucet server and the other changes restart the app.
+func (c *Chain) reloadConfig(ctx context.Context) {
+	conf, err := c.Config()
+	served, ok := c.servedConfig()
+
+	// the app isn't running or the config is invalid, the restart of the app reports the errors
+	if err != nil || !ok {
+		c.refreshServe()
+		return
+	}
+
+	switch diffConfig(served, conf) {
+	case configUnchanged:
+		c.logger.Debug("config file modified without config changes")
+	case configFaucetChanged:
+		faucet, err := c.Faucet(ctx)
+		if err != nil {
+			fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(fmt.Sprintf("cannot apply the faucet configuration changes: %s", err)))
+			return
+		}
+
+		fmt.Fprintln(c.stdLog().out, "🔄 Applying faucet configuration changes...")
+		c.logger.Info("reloading the faucet")
+
+		c.setServedConfig(&conf)
+		c.reloadFaucet(faucet)
+	default:
+		c.refreshServe()
+	}
+}
+
+// servedConfig returns the config of the running app, it returns false when the app isn't running.
+func (c *Chain) servedConfig() (chainconfig.Config, bool) {
+	c.servedConfMu.Lock()
+	defer c.servedConfMu.Unlock()
+
+	if c.servedConf == nil {
+		return chainconfig.Config{}, false
+	}
+	return *c.servedConf, true
+}
+
+// setServedConfig sets the config of the running app, a nil config means the app isn't running.
+func (c *Chain) setServedConfig(conf *chainconfig.Config) {
+	c.servedConfMu.Lock()
+	defer c.servedConfMu.Unlock()
+
+	c.servedConf = conf
+}
+
+// reloadFaucet replaces the faucet served by the faucet server, a pending faucet not yet
+// served is dropped.
+func (c *Chain) reloadFaucet(faucet cosmosfaucet.Faucet) {
+	select {
+	case <-c.faucetReloader:
+	default:
+	}
+	c.faucetReloader <- faucet
+}
+
+// runFaucetServer serves the faucet until ctx is done.
+// The server restarts with the faucets reloaded by the changes of the config.
+func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet) error {
+	for {
+		config, ok := c.servedConfig()
+		if !ok {
+			return nil
+		}
+
+		access, err := config.Host.Access.Control()
+		if err != nil {
+			return err
+		}
+
+		serverCtx, cancel := context.WithCancel(ctx)
+		errc := make(chan error, 1)
+		go func(faucet cosmosfaucet.Faucet) {
+			errc <- xhttp.Serve(serverCtx, &http.Server{
+				Addr:    chainconfig.FaucetHost(config),
+				Handler: access.Handler(faucet),
+			})
+		}(faucet)
+
+		select {
+		case err := <-errc:
+			cancel()
+			return err
+		case faucet = <-c.faucetReloader:
+			cancel()
+			if err := <-errc; err != nil {
+				return err
+			}
+		}
+	}
+}
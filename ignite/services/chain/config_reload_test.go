@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestDiffConfig(t *testing.T) {
+	faucet := "bob"
+	newConfig := func() chainconfig.Config {
+		return chainconfig.Config{
+			Accounts: []chainconfig.Account{
+				{Name: "alice", Coins: []string{"1000token"}},
+				{Name: "bob", Coins: []string{"500token"}},
+			},
+			Faucet: chainconfig.Faucet{
+				Name:  &faucet,
+				Coins: []string{"5token"},
+				Host:  "0.0.0.0:4500",
+			},
+			Host: chainconfig.Host{API: "0.0.0.0:1317"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*chainconfig.Config)
+		expected configChange
+	}{
+		{
+			name:     "unchanged",
+			modify:   func(*chainconfig.Config) {},
+			expected: configUnchanged,
+		},
+		{
+			name:     "faucet coins",
+			modify:   func(c *chainconfig.Config) { c.Faucet.Coins = []string{"10token"} },
+			expected: configFaucetChanged,
+		},
+		{
+			name:     "faucet host",
+			modify:   func(c *chainconfig.Config) { c.Faucet.Host = "0.0.0.0:4501" },
+			expected: configFaucetChanged,
+		},
+		{
+			name:     "faucet disabled",
+			modify:   func(c *chainconfig.Config) { c.Faucet.Name = nil },
+			expected: configAppChanged,
+		},
+		{
+			name:     "api host",
+			modify:   func(c *chainconfig.Config) { c.Host.API = "0.0.0.0:1318" },
+			expected: configAppChanged,
+		},
+		{
+			name: "faucet and accounts",
+			modify: func(c *chainconfig.Config) {
+				c.Faucet.Coins = []string{"10token"}
+				c.Accounts[0].Coins = []string{"2000token"}
+			},
+			expected: configAppChanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modified := newConfig()
+			tt.modify(&modified)
+			require.Equal(t, tt.expected, diffConfig(newConfig(), modified))
+		})
+	}
+}
+
+func TestStateConfigChecksum(t *testing.T) {
+	conf := chainconfig.Config{
+		Accounts: []chainconfig.Account{{Name: "alice", Coins: []string{"1000token"}}},
+	}
+	checksum, err := stateConfigChecksum(conf)
+	require.NoError(t, err)
+
+	// the hosts, the faucet and the Rosetta gateway don't require the state to be reset
+	modified := conf
+	modified.Host.API = "0.0.0.0:1318"
+	modified.Faucet.Coins = []string{"10token"}
+	modified.Rosetta.Enabled = true
+	modified.Profiles = map[string]chainconfig.Profile{"testnet": {"faucet": nil}}
+	modifiedChecksum, err := stateConfigChecksum(modified)
+	require.NoError(t, err)
+	require.Equal(t, checksum, modifiedChecksum)
+
+	// the accounts are applied to the genesis
+	modified.Accounts = []chainconfig.Account{{Name: "alice", Coins: []string{"2000token"}}}
+	modifiedChecksum, err = stateConfigChecksum(modified)
+	require.NoError(t, err)
+	require.NotEqual(t, checksum, modifiedChecksum)
+}
+
+func TestProfileConfigChecksum(t *testing.T) {
+	conf := chainconfig.Config{
+		Accounts: []chainconfig.Account{{Name: "alice", Coins: []string{"1000token"}}},
+	}
+	checksum, err := profileConfigChecksum("", conf)
+	require.NoError(t, err)
+
+	// the profiles not selected aren't applied to the config
+	modified := conf
+	modified.Profiles = map[string]chainconfig.Profile{"testnet": {"faucet": nil}}
+	modifiedChecksum, err := profileConfigChecksum("", modified)
+	require.NoError(t, err)
+	require.Equal(t, checksum, modifiedChecksum)
+
+	// another profile is selected with the same config file
+	modifiedChecksum, err = profileConfigChecksum("testnet", modified)
+	require.NoError(t, err)
+	require.NotEqual(t, checksum, modifiedChecksum)
+
+	// the node settings of the resolved config are detected too
+	modified.Host.API = "0.0.0.0:1318"
+	profileChecksum, err := profileConfigChecksum("testnet", modified)
+	require.NoError(t, err)
+	require.NotEqual(t, modifiedChecksum, profileChecksum)
+}
@@ -0,0 +1,342 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// daemonFile is the name of the file describing the chain served in the background.
+	daemonFile = "daemon.json"
+
+	// daemonLogFile is the name of the file holding the output of the chain served in the background.
+	daemonLogFile = "serve.log"
+
+	// daemonStopTimeout is the time given to the chain served in the background to save its state
+	// and stop before it's killed.
+	daemonStopTimeout = 30 * time.Second
+
+	// daemonPollInterval is the interval between two checks of the chain served in the background.
+	daemonPollInterval = 200 * time.Millisecond
+)
+
+// daemonStartTimeout is the time given to the chain served in the background to be built and to
+// answer before it's killed.
+var daemonStartTimeout = 10 * time.Minute
+
+// ErrDaemonNotRunning is returned when the chain isn't served in the background.
+var ErrDaemonNotRunning = errors.New("the chain isn't served in the background, serve it with `ignite chain serve --detach`")
+
+// Daemon describes a chain served in the background.
+type Daemon struct {
+	// PID is the ID of the process serving the chain.
+	PID int `json:"pid"`
+
+	// ProcessStart is the start time of the process serving the chain as reported by the system, it
+	// tells the process apart from a process reusing its PID, e.g. after a restart of the machine.
+	ProcessStart string `json:"process_start"`
+
+	// StartedAt is the time the chain was detached.
+	StartedAt time.Time `json:"started_at"`
+
+	// Command is the command serving the chain.
+	Command []string `json:"command"`
+
+	// LogFile is the file holding the output of the command.
+	LogFile string `json:"log_file"`
+
+	// EndpointsFile is the file describing the endpoints of the chain once it's served.
+	EndpointsFile string `json:"endpoints_file"`
+}
+
+// Endpoints returns the endpoints of the chain served in the background, they're available once
+// the node answers the RPC queries.
+func (d Daemon) Endpoints() (Endpoints, error) {
+	data, err := os.ReadFile(d.EndpointsFile)
+	if err != nil {
+		return Endpoints{}, err
+	}
+	var endpoints Endpoints
+	err = json.Unmarshal(data, &endpoints)
+	return endpoints, err
+}
+
+// DaemonLogPath returns the path of the file holding the output of the chain served in the
+// background, the file is kept once the chain is stopped.
+func (c *Chain) DaemonLogPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(savePath, daemonLogFile), nil
+}
+
+// StartDaemon serves the chain in the background by running command, an `ignite chain serve`
+// command writing the endpoints of the chain to endpointsPath. The output of the command is
+// appended to the log file of the daemon.
+//
+// StartDaemon returns once the endpoints file is written, the daemon keeps running when the
+// calling process exits. It fails with the end of the logs when the command exits before, or when
+// the file isn't written within daemonStartTimeout, the command is killed then.
+func (c *Chain) StartDaemon(ctx context.Context, command []string, endpointsPath string) (Daemon, error) {
+	if d, err := c.Daemon(); err == nil {
+		return Daemon{}, fmt.Errorf("the chain is already served in the background by the process %d", d.PID)
+	}
+
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return Daemon{}, err
+	}
+	if err := os.MkdirAll(savePath, 0700); err != nil {
+		return Daemon{}, err
+	}
+
+	logPath := filepath.Join(savePath, daemonLogFile)
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return Daemon{}, err
+	}
+	defer logFile.Close()
+
+	// the endpoints file of a previous run must not be taken for the one of the daemon.
+	if err := os.Remove(endpointsPath); err != nil && !os.IsNotExist(err) {
+		return Daemon{}, err
+	}
+
+	d := Daemon{
+		StartedAt:     time.Now().UTC(),
+		Command:       command,
+		LogFile:       logPath,
+		EndpointsFile: endpointsPath,
+	}
+	fmt.Fprintf(logFile, "\n--- %s: %q\n", d.StartedAt.Format(time.RFC3339), command)
+
+	// the daemon isn't part of the terminal session, so it survives the close of the terminal.
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = detachedProcAttr()
+	if c.app.Path != "" {
+		cmd.Dir = c.app.Path
+	}
+	if err := cmd.Start(); err != nil {
+		return Daemon{}, err
+	}
+	d.PID = cmd.Process.Pid
+	if d.ProcessStart, err = processStartTime(d.PID); err != nil {
+		_ = cmd.Process.Kill()
+		return Daemon{}, fmt.Errorf("cannot identify the process of the chain served in the background: %w", err)
+	}
+
+	if err := writeDaemon(filepath.Join(savePath, daemonFile), d); err != nil {
+		_ = cmd.Process.Kill()
+		return Daemon{}, err
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	ticker := time.NewTicker(daemonPollInterval)
+	defer ticker.Stop()
+
+	timeout := time.After(daemonStartTimeout)
+	for {
+		select {
+		case err := <-exited:
+			os.Remove(filepath.Join(savePath, daemonFile))
+			return Daemon{}, fmt.Errorf("the chain served in the background exited (%v):\n%s", err, tailFile(logPath, 20))
+		case <-timeout:
+			_ = interruptProcess(cmd.Process)
+			_ = cmd.Process.Kill()
+			<-exited
+			os.Remove(filepath.Join(savePath, daemonFile))
+			return Daemon{}, fmt.Errorf(
+				"the chain served in the background didn't answer within %s:\n%s",
+				daemonStartTimeout,
+				tailFile(logPath, 20),
+			)
+		case <-ctx.Done():
+			return d, ctx.Err()
+		case <-ticker.C:
+			if _, err := os.Stat(endpointsPath); err == nil {
+				return d, nil
+			}
+		}
+	}
+}
+
+// Daemon returns the chain served in the background, it fails with ErrDaemonNotRunning when the
+// chain isn't served in the background or when its process exited. The daemon file of a process
+// that exited is removed.
+func (c *Chain) Daemon() (Daemon, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return Daemon{}, err
+	}
+	path := filepath.Join(savePath, daemonFile)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Daemon{}, ErrDaemonNotRunning
+	}
+	if err != nil {
+		return Daemon{}, err
+	}
+
+	var d Daemon
+	if err := json.Unmarshal(data, &d); err != nil {
+		return Daemon{}, fmt.Errorf("invalid daemon file %s: %w", path, err)
+	}
+
+	// the process exited without being stopped, e.g. when it failed or the machine restarted, and
+	// its PID can be reused by another process.
+	if !d.isRunning() {
+		os.Remove(path)
+		return Daemon{}, ErrDaemonNotRunning
+	}
+	return d, nil
+}
+
+// StopDaemon stops the chain served in the background and waits for its process to exit, the
+// process is interrupted to save the state of the chain and killed when it doesn't exit in time.
+func (c *Chain) StopDaemon(ctx context.Context) (Daemon, error) {
+	d, err := c.Daemon()
+	if err != nil {
+		return Daemon{}, err
+	}
+
+	p, err := os.FindProcess(d.PID)
+	if err != nil {
+		return Daemon{}, err
+	}
+	if err := interruptProcess(p); err != nil {
+		return Daemon{}, err
+	}
+
+	ticker := time.NewTicker(daemonPollInterval)
+	defer ticker.Stop()
+
+	timeout := time.After(daemonStopTimeout)
+	for d.isRunning() {
+		select {
+		case <-ctx.Done():
+			return Daemon{}, ctx.Err()
+		case <-timeout:
+			if err := p.Kill(); err != nil {
+				return Daemon{}, err
+			}
+			timeout = nil
+		case <-ticker.C:
+		}
+	}
+
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return Daemon{}, err
+	}
+	if err := os.Remove(filepath.Join(savePath, daemonFile)); err != nil && !os.IsNotExist(err) {
+		return Daemon{}, err
+	}
+	return d, nil
+}
+
+// isRunning checks if the process of the daemon is running, a process with the same PID started
+// at another time is another process.
+func (d Daemon) isRunning() bool {
+	if !processAlive(d.PID) {
+		return false
+	}
+	start, err := processStartTime(d.PID)
+	return err == nil && start == d.ProcessStart
+}
+
+// writeDaemon writes the daemon to the file at path.
+func writeDaemon(path string, d Daemon) error {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// DaemonLogs writes the last lines of the logs of the chain served in the background to w, all the
+// lines when lines is zero or negative. When follow is true, the lines appended to the logs are
+// written until ctx is canceled.
+func (c *Chain) DaemonLogs(ctx context.Context, w io.Writer, lines int, follow bool) error {
+	path, err := c.DaemonLogPath()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return ErrDaemonNotRunning
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(lastLines(data, lines)); err != nil {
+		return err
+	}
+	if !follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(daemonPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := io.Copy(w, f); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// tailFile returns the last lines of the file at path, it's empty when the file can't be read.
+func tailFile(path string, lines int) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return string(lastLines(data, lines))
+}
+
+// lastLines returns the last lines of data, all of them when lines is zero or negative.
+func lastLines(data []byte, lines int) []byte {
+	if lines <= 0 {
+		return data
+	}
+
+	// the trailing newline doesn't start a line.
+	end := len(data)
+	if end > 0 && data[end-1] == '\n' {
+		end--
+	}
+	for i := end - 1; i >= 0; i-- {
+		if data[i] != '\n' {
+			continue
+		}
+		if lines--; lines == 0 {
+			return data[i+1:]
+		}
+	}
+	return data
+}
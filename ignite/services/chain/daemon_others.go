@@ -0,0 +1,56 @@
+//go:build !windows
+// +build !windows
+
+package chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// detachedProcAttr starts the process in a new session, so it's not ended with the terminal.
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+// processAlive checks if the process of pid is running.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return p.Signal(syscall.Signal(0)) == nil
+}
+
+// interruptProcess interrupts the process so it stops gracefully.
+func interruptProcess(p *os.Process) error {
+	return p.Signal(os.Interrupt)
+}
+
+// processStartTime returns the start time of the process of pid, read from the stat file of the
+// process on Linux and from ps on the other systems.
+func processStartTime(pid int) (string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err == nil {
+		// the command name can hold spaces, the other fields follow its closing parenthesis.
+		fields := strings.Fields(string(data[bytes.LastIndexByte(data, ')')+1:]))
+
+		// the start time is the 22nd field of the file, the 20th after the command name.
+		if len(fields) < 20 {
+			return "", errors.New("invalid stat file of the process")
+		}
+		return fields[19], nil
+	}
+
+	out, err := exec.Command("ps", "-o", "lstart=", "-p", strconv.Itoa(pid)).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
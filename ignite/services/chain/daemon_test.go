@@ -0,0 +1,119 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDaemon(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the daemon is run by a shell script")
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	c := &Chain{options: chainOptions{chainID: "mars"}}
+	ctx := context.Background()
+	endpointsPath := filepath.Join(t.TempDir(), "endpoints.json")
+
+	_, err := c.Daemon()
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+
+	// the daemon writes the endpoints once the chain answers and stops when it's interrupted.
+	script := `echo serving; echo '{"chain_id":"mars"}' > "$0"; trap 'echo stopped; exit 0' INT; while true; do sleep 0.05; done`
+	d, err := c.StartDaemon(ctx, []string{"sh", "-c", script, endpointsPath}, endpointsPath)
+	require.NoError(t, err)
+
+	endpoints, err := d.Endpoints()
+	require.NoError(t, err)
+	require.Equal(t, "mars", endpoints.ChainID)
+
+	running, err := c.Daemon()
+	require.NoError(t, err)
+	require.Equal(t, d.PID, running.PID)
+
+	_, err = c.StartDaemon(ctx, []string{"sh", "-c", script, endpointsPath}, endpointsPath)
+	require.ErrorContains(t, err, "already served in the background")
+
+	stopped, err := c.StopDaemon(ctx)
+	require.NoError(t, err)
+	require.Equal(t, d.PID, stopped.PID)
+
+	_, err = c.Daemon()
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+
+	var logs bytes.Buffer
+	require.NoError(t, c.DaemonLogs(ctx, &logs, 2, false))
+	require.Equal(t, "serving\nstopped\n", logs.String())
+
+	// the daemon exiting before the chain answers fails with its logs.
+	_, err = c.StartDaemon(ctx, []string{"sh", "-c", "echo invalid config; exit 1"}, endpointsPath)
+	require.ErrorContains(t, err, "invalid config")
+
+	_, err = c.Daemon()
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+}
+
+func TestDaemonStartTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the daemon is run by a shell script")
+	}
+
+	defer func(timeout time.Duration) { daemonStartTimeout = timeout }(daemonStartTimeout)
+	daemonStartTimeout = 500 * time.Millisecond
+
+	t.Setenv("HOME", t.TempDir())
+	c := &Chain{options: chainOptions{chainID: "mars"}}
+	endpointsPath := filepath.Join(t.TempDir(), "endpoints.json")
+
+	// the daemon never writing the endpoints is killed.
+	script := `echo building; while true; do sleep 0.05; done`
+	_, err := c.StartDaemon(context.Background(), []string{"sh", "-c", script}, endpointsPath)
+	require.ErrorContains(t, err, "didn't answer within")
+	require.ErrorContains(t, err, "building")
+
+	_, err = c.Daemon()
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+}
+
+func TestDaemonStale(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	c := &Chain{options: chainOptions{chainID: "mars"}}
+	savePath, err := c.chainSavePath()
+	require.NoError(t, err)
+	path := filepath.Join(savePath, daemonFile)
+
+	start, err := processStartTime(os.Getpid())
+	require.NoError(t, err)
+
+	// the PID of the daemon file is reused by the process of the test, which is never interrupted.
+	require.NoError(t, writeDaemon(path, Daemon{PID: os.Getpid(), ProcessStart: start + "0"}))
+
+	_, err = c.StopDaemon(context.Background())
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+	require.NoFileExists(t, path)
+
+	// the daemon file of a running process is kept.
+	require.NoError(t, writeDaemon(path, Daemon{PID: os.Getpid(), ProcessStart: start}))
+
+	d, err := c.Daemon()
+	require.NoError(t, err)
+	require.Equal(t, os.Getpid(), d.PID)
+	require.FileExists(t, path)
+}
+
+func TestLastLines(t *testing.T) {
+	data := []byte("a\nb\nc\n")
+
+	require.Equal(t, "c\n", string(lastLines(data, 1)))
+	require.Equal(t, "b\nc\n", string(lastLines(data, 2)))
+	require.Equal(t, "a\nb\nc\n", string(lastLines(data, 5)))
+	require.Equal(t, "a\nb\nc\n", string(lastLines(data, 0)))
+	require.Equal(t, "b\nc", string(lastLines([]byte("a\nb\nc"), 2)))
+}
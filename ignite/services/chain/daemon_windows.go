@@ -0,0 +1,59 @@
+package chain
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+const (
+	createNewProcessGroup = 0x00000200
+	detachedProcess       = 0x00000008
+	processQueryLimited   = 0x1000
+	stillActive           = 259
+)
+
+// detachedProcAttr starts the process without console, so it's not ended with the terminal.
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: createNewProcessGroup | detachedProcess}
+}
+
+// processAlive checks if the process of pid is running.
+func processAlive(pid int) bool {
+	h, err := syscall.OpenProcess(processQueryLimited, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	defer syscall.CloseHandle(h)
+
+	var code uint32
+	if err := syscall.GetExitCodeProcess(h, &code); err != nil {
+		return false
+	}
+	return code == stillActive
+}
+
+// interruptProcess ends the process and its child processes. Windows can't signal an interrupt to
+// a process, the processes are killed instead.
+func interruptProcess(p *os.Process) error {
+	if err := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(p.Pid)).Run(); err != nil {
+		return p.Kill()
+	}
+	return nil
+}
+
+// processStartTime returns the creation time of the process of pid.
+func processStartTime(pid int) (string, error) {
+	h, err := syscall.OpenProcess(processQueryLimited, false, uint32(pid))
+	if err != nil {
+		return "", err
+	}
+	defer syscall.CloseHandle(h)
+
+	var creation, exit, kernel, user syscall.Filetime
+	if err := syscall.GetProcessTimes(h, &creation, &exit, &kernel, &user); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(creation.Nanoseconds(), 10), nil
+}
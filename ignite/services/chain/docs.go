@@ -0,0 +1,80 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+)
+
+const (
+	// buildDir is the directory of the chain where the docs of its binary are generated.
+	buildDir = "build"
+
+	// completionsDir is the directory of the shell completion scripts of the binary.
+	completionsDir = "completions"
+
+	// manDir is the directory of the man pages of the binary.
+	manDir = "man"
+)
+
+// generateBinaryDocs generates the shell completion scripts and the man pages of the binary at
+// binaryPath in dir, the completion scripts are generated in completions/ and the man pages
+// in man/man1/.
+func generateBinaryDocs(ctx context.Context, binaryPath, dir string) error {
+	// the binary commands read and write their client config in the home of the chain,
+	// a temporary home keeps the home of the chain untouched.
+	home, err := os.MkdirTemp("", "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(home)
+
+	binary := filepath.Base(binaryPath)
+	completions := filepath.Join(dir, completionsDir)
+	if err := os.MkdirAll(completions, 0755); err != nil {
+		return err
+	}
+
+	scripts := []struct {
+		name string
+		args []string
+	}{
+		{binary + ".bash", []string{"completion"}},
+		{"_" + binary, []string{"completion", "--zsh"}},
+	}
+	for _, script := range scripts {
+		f, err := os.Create(filepath.Join(completions, script.name))
+		if err != nil {
+			return err
+		}
+		args := append(script.args, "--home", home)
+		err = cmdrunner.New().Run(ctx, step.New(
+			step.Exec(binaryPath, args...),
+			step.Stdout(f),
+		))
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("cannot generate the %s completion script: %w", script.name, err)
+		}
+	}
+
+	man := filepath.Join(dir, manDir, "man1")
+	if err := cmdrunner.New().Run(ctx, step.New(step.Exec(binaryPath, "man", man, "--home", home))); err != nil {
+		return fmt.Errorf("cannot generate the man pages, the root command of the chain must define the man command of cosmoscmd: %w", err)
+	}
+	return nil
+}
+
+// buildBinaryDocs generates the docs of the binary at binaryPath in dir, the build doesn't fail
+// when the docs can't be generated, a warning is printed instead.
+func (c *Chain) buildBinaryDocs(ctx context.Context, binaryPath, dir string) bool {
+	if err := generateBinaryDocs(ctx, binaryPath, dir); err != nil {
+		fmt.Fprintf(c.stdLog().out, "⚠️  The docs of the binary are not generated: %s\n", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBinaryDocs(t *testing.T) {
+	binaryPath := filepath.Join(t.TempDir(), "marsd")
+	require.NoError(t, os.WriteFile(binaryPath, []byte(`#!/bin/sh
+case "$1" in
+completion) if [ "$2" = "--zsh" ]; then echo zsh; else echo bash; fi ;;
+man) mkdir -p "$2" && echo page > "$2/marsd.1" ;;
+*) exit 1 ;;
+esac
+`), 0755))
+
+	dir := t.TempDir()
+	require.NoError(t, generateBinaryDocs(context.Background(), binaryPath, dir))
+
+	for path, content := range map[string]string{
+		"completions/marsd.bash": "bash\n",
+		"completions/_marsd":     "zsh\n",
+		"man/man1/marsd.1":       "page\n",
+	} {
+		generated, err := os.ReadFile(filepath.Join(dir, path))
+		require.NoError(t, err)
+		require.Equal(t, content, string(generated), path)
+	}
+
+	// the man pages are not generated by the binaries without the man command
+	require.NoError(t, os.WriteFile(binaryPath, []byte("#!/bin/sh\n[ \"$1\" = completion ]\n"), 0755))
+	require.Error(t, generateBinaryDocs(context.Background(), binaryPath, t.TempDir()))
+}
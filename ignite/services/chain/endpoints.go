@@ -0,0 +1,133 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cenkalti/backoff"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/httpstatuschecker"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// endpointsFile is the name of the file describing the endpoints of a served chain
+const endpointsFile = "endpoints.json"
+
+// Endpoints describes how to reach a served chain.
+type Endpoints struct {
+	ChainID  string             `json:"chain_id"`
+	RPC      string             `json:"rpc"`
+	GRPC     string             `json:"grpc"`
+	GRPCWeb  string             `json:"grpc_web"`
+	API      string             `json:"api"`
+	Faucet   string             `json:"faucet,omitempty"`
+	Rosetta  string             `json:"rosetta,omitempty"`
+	Accounts []EndpointsAccount `json:"accounts"`
+}
+
+// EndpointsAccount is an account funded in the genesis of a served chain.
+type EndpointsAccount struct {
+	Name    string   `json:"name"`
+	Address string   `json:"address"`
+	Coins   []string `json:"coins"`
+}
+
+// DefaultEndpointsPath returns the default path of the endpoints file written when the chain is served.
+func (c *Chain) DefaultEndpointsPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, endpointsFile), nil
+}
+
+// endpoints returns the endpoints of the chain served with the config.
+func (c *Chain) endpoints(ctx context.Context, config chainconfig.Config, isFaucetEnabled bool) (Endpoints, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return Endpoints{}, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return Endpoints{}, err
+	}
+
+	// note: address format errors are handled when the
+	// servers start, so they can be safely ignored here
+	rpcAddr, _ := xurl.HTTP(config.Host.RPC)
+	apiAddr, _ := xurl.HTTP(config.Host.API)
+	grpcWebAddr, _ := xurl.HTTP(config.Host.GRPCWeb)
+
+	endpoints := Endpoints{
+		ChainID:  chainID,
+		RPC:      rpcAddr,
+		GRPC:     config.Host.GRPC,
+		GRPCWeb:  grpcWebAddr,
+		API:      apiAddr,
+		Accounts: []EndpointsAccount{},
+	}
+	if isFaucetEnabled {
+		endpoints.Faucet, _ = xurl.HTTP(chainconfig.FaucetHost(config))
+	}
+	if config.Rosetta.Enabled {
+		endpoints.Rosetta, _ = xurl.HTTP(config.Host.Rosetta)
+	}
+
+	for _, account := range config.Accounts {
+		address := account.Address
+		if address == "" {
+			keyAccount, err := commands.ShowAccount(ctx, account.Name)
+			if err != nil {
+				return Endpoints{}, err
+			}
+			address = keyAccount.Address
+		}
+
+		endpoints.Accounts = append(endpoints.Accounts, EndpointsAccount{
+			Name:    account.Name,
+			Address: address,
+			Coins:   account.Coins,
+		})
+	}
+
+	return endpoints, nil
+}
+
+// isNodeListening waits until the node answers the RPC queries on the address.
+func isNodeListening(ctx context.Context, rpcAddr string) error {
+	checkAlive := func() error {
+		ok, err := httpstatuschecker.Check(ctx, fmt.Sprintf("%s/health", rpcAddr))
+		if err == nil && !ok {
+			err = errors.New("node is not online")
+		}
+		return err
+	}
+	return backoff.Retry(checkAlive, backoff.WithContext(backoff.NewConstantBackOff(time.Second), ctx))
+}
+
+// writeEndpoints writes the endpoints to the file at path.
+// The file is replaced atomically so a reader never gets a partial file.
+func writeEndpoints(path string, endpoints Endpoints) error {
+	data, err := json.MarshalIndent(endpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,36 @@
+package chain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteEndpoints(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mars", endpointsFile)
+	endpoints := Endpoints{
+		ChainID: "mars",
+		RPC:     "http://0.0.0.0:26657",
+		GRPC:    "0.0.0.0:9090",
+		GRPCWeb: "http://0.0.0.0:9091",
+		API:     "http://0.0.0.0:1317",
+		Accounts: []EndpointsAccount{
+			{Name: "alice", Address: "cosmos1alice", Coins: []string{"1000token"}},
+		},
+	}
+
+	require.NoError(t, writeEndpoints(path, endpoints))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var written Endpoints
+	require.NoError(t, json.Unmarshal(data, &written))
+	require.Equal(t, endpoints, written)
+
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,87 @@
+package chain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// ModuleState is the content of a module store exported from a running chain.
+// The format is the one of the snapshots of the testutil/state package of the scaffolded chains.
+type ModuleState struct {
+	Module string            `json:"module"`
+	Height int64             `json:"height"`
+	Pairs  []ModuleStatePair `json:"pairs"`
+}
+
+// ModuleStatePair is a key-value entry of a module store,
+// the key is hex encoded and the value is base64 encoded.
+type ModuleStatePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ExportModule exports the content of the store with storeKey from the running chain.
+// The state at the latest height is exported when height is 0.
+func (c *Chain) ExportModule(ctx context.Context, storeKey string, height int64) (ModuleState, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return ModuleState{}, err
+	}
+
+	rpcAddr, err := xurl.HTTP(conf.Host.RPC)
+	if err != nil {
+		return ModuleState{}, fmt.Errorf("invalid rpc address %s: %w", conf.Host.RPC, err)
+	}
+	client, err := rpchttp.New(rpcAddr, "/websocket")
+	if err != nil {
+		return ModuleState{}, err
+	}
+
+	// an empty subspace queries all the entries of the store
+	res, err := client.ABCIQueryWithOptions(
+		ctx,
+		fmt.Sprintf("/store/%s/subspace", storeKey),
+		nil,
+		rpcclient.ABCIQueryOptions{Height: height},
+	)
+	if err != nil {
+		return ModuleState{}, errors.Wrap(err, "make sure the chain is running")
+	}
+	return newModuleState(storeKey, res.Response)
+}
+
+// newModuleState decodes the key-value pairs of a store from the response of a subspace query.
+func newModuleState(storeKey string, res abci.ResponseQuery) (ModuleState, error) {
+	if !res.IsOK() {
+		return ModuleState{}, fmt.Errorf("cannot export the store %s: %s", storeKey, res.Log)
+	}
+
+	var pairs kv.Pairs
+	if err := pairs.Unmarshal(res.Value); err != nil {
+		return ModuleState{}, err
+	}
+
+	state := ModuleState{
+		Module: storeKey,
+		Height: res.Height,
+		Pairs:  make([]ModuleStatePair, 0, len(pairs.Pairs)),
+	}
+	for _, pair := range pairs.Pairs {
+		state.Pairs = append(state.Pairs, ModuleStatePair{
+			Key:   hex.EncodeToString(pair.Key),
+			Value: base64.StdEncoding.EncodeToString(pair.Value),
+		})
+	}
+
+	return state, nil
+}
@@ -0,0 +1,65 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestNewModuleState(t *testing.T) {
+	pairs := kv.Pairs{Pairs: []kv.Pair{
+		{Key: []byte{0x01, 0x02}, Value: []byte("foo")},
+		{Key: []byte("b"), Value: []byte{}},
+	}}
+	value, err := pairs.Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		res   abci.ResponseQuery
+		state ModuleState
+		err   string
+	}{
+		{
+			name: "pairs",
+			res:  abci.ResponseQuery{Value: value, Height: 42},
+			state: ModuleState{
+				Module: "bank",
+				Height: 42,
+				Pairs: []ModuleStatePair{
+					{Key: "0102", Value: "Zm9v"},
+					{Key: "62", Value: ""},
+				},
+			},
+		},
+		{
+			name:  "empty store",
+			res:   abci.ResponseQuery{Height: 7},
+			state: ModuleState{Module: "bank", Height: 7, Pairs: []ModuleStatePair{}},
+		},
+		{
+			name: "failed query",
+			res:  abci.ResponseQuery{Code: 1, Log: "no such store"},
+			err:  "cannot export the store bank: no such store",
+		},
+		{
+			name: "invalid pairs",
+			res:  abci.ResponseQuery{Value: []byte{0xff}},
+			err:  "unexpected EOF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := newModuleState("bank", tt.res)
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.state, state)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,8 +25,21 @@ var (
 
 var (
 	envAPIAddress = os.Getenv("API_ADDRESS")
+
+	// envFaucetAdminToken enables the admin endpoints of the faucet, the token isn't read from
+	// the config.yml so it isn't committed with the chain.
+	envFaucetAdminToken = os.Getenv("FAUCET_ADMIN_TOKEN")
+
+	// envFaucetCaptchaSecret is the secret verifying the CAPTCHA responses of the faucet requests.
+	envFaucetCaptchaSecret = os.Getenv("FAUCET_CAPTCHA_SECRET")
+
+	// envFaucetClaimKey is the key signing the claim tokens of the faucet requests.
+	envFaucetClaimKey = os.Getenv("FAUCET_CLAIM_KEY")
 )
 
+// faucetLimitsFile is the file in the chain save path persisting the rate limits of the faucet.
+const faucetLimitsFile = "faucet-limits.json"
+
 // Faucet returns the faucet for the chain or an error if the faucet
 // configuration is wrong or not configured (not enabled) at all.
 func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
@@ -71,6 +85,7 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		cosmosfaucet.Account(*conf.Faucet.Name, "", ""),
 		cosmosfaucet.ChainID(id),
 		cosmosfaucet.OpenAPI(apiAddress),
+		cosmosfaucet.Logger(c.logger),
 	}
 
 	// parse coins to pass to the faucet as coins.
@@ -106,6 +121,67 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		faucetOptions = append(faucetOptions, cosmosfaucet.RefreshWindow(rateLimitWindow))
 	}
 
+	if conf.Faucet.TestAccounts {
+		faucetOptions = append(faucetOptions, cosmosfaucet.TestAccounts())
+	}
+
+	if limit := conf.Faucet.RateLimit; limit.Window != "" {
+		window, err := time.ParseDuration(limit.Window)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, fmt.Errorf("%s: %s", err, limit.Window)
+		}
+		if limit.PerAddress < 0 || limit.PerIP < 0 {
+			return cosmosfaucet.Faucet{}, errors.New("the faucet rate limits can't be negative")
+		}
+
+		savePath, err := c.chainSavePath()
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+
+		faucetOptions = append(faucetOptions,
+			cosmosfaucet.RateLimit(window, limit.PerAddress, limit.PerIP),
+			cosmosfaucet.RateLimitStore(cosmosfaucet.NewFileLimitStore(filepath.Join(savePath, faucetLimitsFile))),
+		)
+	}
+
+	if batch := conf.Faucet.Batch; batch.Window != "" {
+		window, err := time.ParseDuration(batch.Window)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, fmt.Errorf("%s: %s", err, batch.Window)
+		}
+		if batch.MaxSize < 0 {
+			return cosmosfaucet.Faucet{}, errors.New("the faucet batch size can't be negative")
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.Batch(window, batch.MaxSize))
+	}
+
+	if captcha := conf.Faucet.Captcha; captcha.Provider != "" {
+		provider := cosmosfaucet.CaptchaProvider(captcha.Provider)
+		if provider != cosmosfaucet.HCaptcha && provider != cosmosfaucet.ReCaptcha {
+			return cosmosfaucet.Faucet{}, fmt.Errorf(
+				"invalid faucet captcha provider %q, use %s or %s",
+				captcha.Provider,
+				cosmosfaucet.HCaptcha,
+				cosmosfaucet.ReCaptcha,
+			)
+		}
+		if envFaucetCaptchaSecret == "" {
+			return cosmosfaucet.Faucet{}, errors.New("the faucet captcha requires the FAUCET_CAPTCHA_SECRET environment variable")
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.Captcha(provider, captcha.SiteKey, envFaucetCaptchaSecret))
+	}
+
+	if envFaucetClaimKey != "" {
+		faucetOptions = append(faucetOptions, cosmosfaucet.ClaimTokens([]byte(envFaucetClaimKey)))
+	}
+
+	if envFaucetAdminToken != "" {
+		faucetOptions = append(faucetOptions, cosmosfaucet.AdminToken(envFaucetAdminToken))
+	}
+
 	// init the faucet with options and return.
 	return cosmosfaucet.New(ctx, commands, faucetOptions...)
 }
@@ -2,26 +2,60 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite/cli/ignite/pkg/cosmosgen"
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/localfs"
 )
 
 const (
-	defaultVuexPath    = "vue/src/store"
-	defaultDartPath    = "flutter/lib"
-	defaultOpenAPIPath = "docs/static/openapi.yml"
+	defaultVuexPath     = "vue/src/store"
+	defaultReactPath    = "react/src/hooks"
+	defaultDartPath     = "flutter/lib"
+	defaultOpenAPIPath  = "docs/static/openapi.yml"
+	defaultGoClientPath = "goclient"
+	defaultDocsPath     = "docs/modules"
+
+	defaultVuePath             = "vue"
+	defaultVueComponentsPath   = "vue/src/components/generated"
+	defaultReactComponentsPath = "react/src/components/generated"
 )
 
+// checkSkippedDirs are the dirs of the app that are not compared when checking the generated code.
+var checkSkippedDirs = []string{"node_modules"}
+
 type generateOptions struct {
 	isGoEnabled      bool
+	isGoForced       bool
 	isVuexEnabled    bool
+	isReactEnabled   bool
 	isDartEnabled    bool
 	isOpenAPIEnabled bool
+
+	isComponentsEnabled bool
+	componentsFramework string
+
+	isE2EEnabled bool
+
+	isAdminEnabled        bool
+	isAdminDevKeysEnabled bool
+
+	isGoClientEnabled bool
+
+	isORMEnabled     bool
+	ormTemplatesPath string
+
+	isDocsEnabled bool
+	docsFormat    string
 }
 
 // GenerateTarget is a target to generate code for from proto files.
@@ -34,6 +68,15 @@ func GenerateGo() GenerateTarget {
 	}
 }
 
+// GenerateGoForce enables generating the Go code of all the proto packages, the packages unchanged
+// since their last generation are generated as well.
+func GenerateGoForce() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isGoEnabled = true
+		o.isGoForced = true
+	}
+}
+
 // GenerateVuex enables generating proto based Vuex store.
 func GenerateVuex() GenerateTarget {
 	return func(o *generateOptions) {
@@ -41,6 +84,14 @@ func GenerateVuex() GenerateTarget {
 	}
 }
 
+// GenerateReact enables generating React Query hooks for the queries and the messages of the modules,
+// bound to the generated JS clients.
+func GenerateReact() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isReactEnabled = true
+	}
+}
+
 // GenerateDart enables generating Dart client.
 func GenerateDart() GenerateTarget {
 	return func(o *generateOptions) {
@@ -55,6 +106,74 @@ func GenerateOpenAPI() GenerateTarget {
 	}
 }
 
+// GenerateComponents enables generating the UI components of the stored types, bound to the generated
+// Vuex stores or JS clients. framework is either vue or react, the one of the config is used when empty.
+func GenerateComponents(framework string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isComponentsEnabled = true
+		o.componentsFramework = framework
+	}
+}
+
+// GenerateE2E enables generating a Playwright end-to-end test suite for the Vue app, it drives the
+// Vue components of the stored types generated with it. The wallet of the tests is funded with the faucet.
+func GenerateE2E() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isE2EEnabled = true
+		o.isComponentsEnabled = true
+		o.componentsFramework = cosmosgen.FrameworkVue
+	}
+}
+
+// GenerateAdmin enables generating a dev admin page in the Vue app, it displays the params of the
+// modules, submits parameter change proposals and votes on them with the accounts imported in it.
+// The page is bound to the generated Vuex stores.
+func GenerateAdmin() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isAdminEnabled = true
+		o.isVuexEnabled = true
+	}
+}
+
+// GenerateAdminWithDevKeys enables generating the dev admin page with the private keys of the
+// accounts of the config, the page signs with them. Never use it outside of development.
+func GenerateAdminWithDevKeys() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isAdminEnabled = true
+		o.isAdminDevKeysEnabled = true
+		o.isVuexEnabled = true
+	}
+}
+
+// GenerateGoClient enables generating a typed Go client package of the chain, wrapping cosmosclient
+// with the queries and the messages of the modules. The client is built on the Go code of the chain.
+func GenerateGoClient() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isGoClientEnabled = true
+		o.isGoEnabled = true
+	}
+}
+
+// GenerateORM enables generating the data-access layer of the types stored by the modules, in the orm
+// package of each module. The templates of templatesPath, when not empty, replace the built-in ones
+// with the same name. The layer is built on the Go code of the chain.
+func GenerateORM(templatesPath string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isORMEnabled = true
+		o.ormTemplatesPath = templatesPath
+		o.isGoEnabled = true
+	}
+}
+
+// GenerateDocs enables generating the reference docs of the modules from the comments of their proto
+// files and of their keepers. format is either markdown or html, the one of the config is used when empty.
+func GenerateDocs(format string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.isDocsEnabled = true
+		o.docsFormat = format
+	}
+}
+
 func (c *Chain) generateAll(ctx context.Context, cacheStorage cache.Storage) error {
 	conf, err := c.Config()
 	if err != nil {
@@ -67,6 +186,10 @@ func (c *Chain) generateAll(ctx context.Context, cacheStorage cache.Storage) err
 		additionalTargets = append(additionalTargets, GenerateVuex())
 	}
 
+	if conf.Client.React.Path != "" {
+		additionalTargets = append(additionalTargets, GenerateReact())
+	}
+
 	if conf.Client.Dart.Path != "" {
 		additionalTargets = append(additionalTargets, GenerateDart())
 	}
@@ -75,6 +198,19 @@ func (c *Chain) generateAll(ctx context.Context, cacheStorage cache.Storage) err
 		additionalTargets = append(additionalTargets, GenerateOpenAPI())
 	}
 
+	if conf.Client.Components.Path != "" {
+		additionalTargets = append(additionalTargets, GenerateComponents(""))
+	}
+
+	if conf.Client.Go.Path != "" {
+		additionalTargets = append(additionalTargets, GenerateGoClient())
+	}
+
+	// the docs are generated again with the code so they don't drift from the modules.
+	if conf.Client.Docs.Path != "" {
+		additionalTargets = append(additionalTargets, GenerateDocs(""))
+	}
+
 	return c.Generate(ctx, cacheStorage, GenerateGo(), additionalTargets...)
 }
 
@@ -104,14 +240,39 @@ func (c *Chain) Generate(
 
 	options := []cosmosgen.Option{
 		cosmosgen.IncludeDirs(conf.Build.Proto.ThirdPartyPaths),
+		cosmosgen.WithAddressCodec(conf.AddressCodec),
 	}
 
 	if targetOptions.isGoEnabled {
 		options = append(options, cosmosgen.WithGoGeneration(c.app.ImportPath))
+
+		if targetOptions.isGoForced {
+			options = append(options, cosmosgen.WithGoForceGeneration())
+		}
+
+		// generate the pulsar code as well if the layout is used.
+		if conf.Build.Proto.Layout == chainconfig.ProtoLayoutPulsar {
+			if err := cosmosgen.InstallPulsarDependencies(ctx, c.app.Path); err != nil {
+				return err
+			}
+			options = append(options, cosmosgen.WithPulsarGeneration())
+		}
 	}
 
 	enableThirdPartyModuleCodegen := !c.protoBuiltAtLeastOnce && c.options.isThirdPartyModuleCodegenEnabled
 
+	// vue components are bound to the Vuex stores.
+	framework := targetOptions.componentsFramework
+	if framework == "" {
+		framework = conf.Client.Components.Framework
+	}
+	if framework == "" {
+		framework = cosmosgen.FrameworkVue
+	}
+	if targetOptions.isComponentsEnabled && framework == cosmosgen.FrameworkVue {
+		targetOptions.isVuexEnabled = true
+	}
+
 	// generate Vuex code as well if it is enabled.
 	if targetOptions.isVuexEnabled {
 		vuexPath := conf.Client.Vuex.Path
@@ -133,6 +294,68 @@ func (c *Chain) Generate(
 		)
 	}
 
+	// generate the React hooks and the JS clients they're bound to, unless the Vuex stores, that
+	// include the clients, are generated.
+	if targetOptions.isReactEnabled {
+		reactPath := conf.Client.React.Path
+		if reactPath == "" {
+			reactPath = defaultReactPath
+		}
+
+		rootPath := filepath.Join(c.app.Path, reactPath, "generated")
+		if !targetOptions.isVuexEnabled {
+			options = append(options,
+				cosmosgen.WithJSGeneration(
+					enableThirdPartyModuleCodegen,
+					cosmosgen.VuexStoreModulePath(filepath.Join(rootPath, "client")),
+				),
+			)
+		}
+
+		options = append(options,
+			cosmosgen.WithReactGeneration(cosmosgen.ReactHooksModulePath(rootPath), rootPath),
+		)
+	}
+
+	if targetOptions.isComponentsEnabled {
+		componentsPath := conf.Client.Components.Path
+
+		if componentsPath == "" {
+			switch framework {
+			case cosmosgen.FrameworkVue:
+				componentsPath = defaultVueComponentsPath
+			case cosmosgen.FrameworkReact:
+				componentsPath = defaultReactComponentsPath
+			default:
+				return fmt.Errorf("unknown components framework %q, use %s or %s", framework, cosmosgen.FrameworkVue, cosmosgen.FrameworkReact)
+			}
+		}
+
+		rootPath := filepath.Join(c.app.Path, componentsPath)
+
+		// react components are bound to the JS clients, they are generated next to the
+		// components unless the Vuex stores or the React hooks, that include them, are generated.
+		if !targetOptions.isVuexEnabled && !targetOptions.isReactEnabled {
+			clientRootPath := filepath.Join(rootPath, "client")
+			options = append(options,
+				cosmosgen.WithJSGeneration(
+					enableThirdPartyModuleCodegen,
+					cosmosgen.VuexStoreModulePath(clientRootPath),
+				),
+			)
+		}
+
+		options = append(options,
+			cosmosgen.WithComponentsGeneration(
+				framework,
+				func(m module.Module) string {
+					appModulePath := gomodulepath.ExtractAppPath(m.GoModulePath)
+					return filepath.Join(rootPath, appModulePath, m.Pkg.Name)
+				},
+			),
+		)
+	}
+
 	if targetOptions.isDartEnabled {
 		dartPath := conf.Client.Dart.Path
 
@@ -164,6 +387,99 @@ func (c *Chain) Generate(
 		}
 
 		options = append(options, cosmosgen.WithOpenAPIGeneration(openAPIPath))
+
+		if conf.Client.OpenAPI.Version == 3 {
+			options = append(options, cosmosgen.WithOpenAPIV3())
+		}
+
+		if conf.Client.OpenAPI.ModulesPath != "" || len(conf.Client.OpenAPI.Modules) > 0 {
+			options = append(options,
+				cosmosgen.WithOpenAPIModuleSpecs(func(m module.Module) string {
+					if path, ok := conf.Client.OpenAPI.Modules[m.Name]; ok {
+						return path
+					}
+					if conf.Client.OpenAPI.ModulesPath == "" {
+						return ""
+					}
+					return filepath.Join(conf.Client.OpenAPI.ModulesPath, m.Name+".yml")
+				}),
+			)
+		}
+	}
+
+	if targetOptions.isGoClientEnabled {
+		goClientPath := conf.Client.Go.Path
+
+		if goClientPath == "" {
+			goClientPath = defaultGoClientPath
+		}
+
+		options = append(options, cosmosgen.WithGoClientGeneration(filepath.Join(c.app.Path, goClientPath)))
+	}
+
+	if targetOptions.isORMEnabled {
+		options = append(options,
+			cosmosgen.WithORMGeneration(
+				// the layer of a module is next to its types, e.g. x/blog/orm.
+				func(m module.Module) string {
+					typesPath := strings.TrimPrefix(m.Pkg.GoImportPath(), c.app.ImportPath)
+					return filepath.Join(c.app.Path, filepath.Dir(typesPath), "orm")
+				},
+				targetOptions.ormTemplatesPath,
+			),
+		)
+	}
+
+	if targetOptions.isDocsEnabled {
+		docsPath := conf.Client.Docs.Path
+		if docsPath == "" {
+			docsPath = defaultDocsPath
+		}
+
+		docsFormat := targetOptions.docsFormat
+		if docsFormat == "" {
+			docsFormat = conf.Client.Docs.Format
+		}
+		if docsFormat == "" {
+			docsFormat = cosmosgen.DocsFormatMarkdown
+		}
+
+		options = append(options, cosmosgen.WithDocsGeneration(filepath.Join(c.app.Path, docsPath), docsFormat))
+	}
+
+	if targetOptions.isE2EEnabled {
+		if framework != cosmosgen.FrameworkVue {
+			return fmt.Errorf("e2e tests are generated for the %s components only", cosmosgen.FrameworkVue)
+		}
+		if conf.Faucet.Name == nil {
+			return errors.New("e2e tests fund their wallet with the faucet, enable it in config.yml")
+		}
+
+		var (
+			faucetURL    string
+			frontendPort int
+		)
+		for _, server := range chainconfig.Servers(conf) {
+			switch server.Name {
+			case "Faucet":
+				faucetURL = fmt.Sprintf("http://localhost:%d", server.Port)
+			case "Frontend":
+				frontendPort = server.Port
+			}
+		}
+
+		options = append(options, cosmosgen.WithE2EGeneration(filepath.Join(c.app.Path, defaultVuePath), frontendPort, faucetURL))
+	}
+
+	if targetOptions.isAdminEnabled {
+		var accounts []cosmosgen.AdminAccount
+		if targetOptions.isAdminDevKeysEnabled {
+			if accounts, err = c.adminAccounts(conf); err != nil {
+				return err
+			}
+		}
+
+		options = append(options, cosmosgen.WithAdminGeneration(filepath.Join(c.app.Path, defaultVuePath), accounts))
 	}
 
 	if err := cosmosgen.Generate(ctx, cacheStorage, c.app.Path, conf.Build.Proto.Path, options...); err != nil {
@@ -174,3 +490,76 @@ func (c *Chain) Generate(
 
 	return nil
 }
+
+// adminAccounts returns the private keys of the accounts of the config created by the chain, they are
+// stored in the test keyring of the chain home. The accounts not created yet, before the chain is
+// initialized, and the ones configured with an address only are skipped.
+func (c *Chain) adminAccounts(conf chainconfig.Config) ([]cosmosgen.AdminAccount, error) {
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []cosmosgen.AdminAccount
+	for _, account := range conf.Accounts {
+		if account.Address != "" {
+			continue
+		}
+
+		privKey, err := registry.ExportHex(account.Name, "")
+
+		var accErr *cosmosaccount.AccountDoesNotExistError
+		if errors.As(err, &accErr) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, cosmosgen.AdminAccount{Name: account.Name, PrivKey: privKey})
+	}
+
+	return accounts, nil
+}
+
+// CheckGenerated regenerates the code of target and additionalTargets and returns the paths, relative to
+// the app, of the files that would be created, modified or deleted by the regeneration, none when the
+// generated code is up to date. The source code of the app is reverted to its initial state afterwards.
+//
+// The code is regenerated with an empty cache to not depend on the outputs of previous builds.
+func (c *Chain) CheckGenerated(ctx context.Context, target GenerateTarget, additionalTargets ...GenerateTarget) (changes []string, err error) {
+	snapshot, err := localfs.TakeSnapshot(c.app.Path, checkSkippedDirs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if restoreErr := snapshot.Restore(); err == nil {
+			err = restoreErr
+		}
+	}()
+
+	cacheDir, err := os.MkdirTemp("", "ignite-generate-check")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cacheDir)
+
+	cacheStorage, err := cache.NewStorage(filepath.Join(cacheDir, "cache.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Generate(ctx, cacheStorage, target, additionalTargets...); err != nil {
+		return nil, err
+	}
+
+	return snapshot.Changes()
+}
@@ -0,0 +1,292 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// importGenesis replaces the genesis of the node with the existing genesis from the config.
+func (c *Chain) importGenesis(ctx context.Context, genesis chainconfig.InitGenesis) error {
+	var (
+		data   []byte
+		hash   string
+		source string
+		err    error
+	)
+	if genesis.URL != "" {
+		source = genesis.URL
+		if data, hash, err = cosmosutil.GenesisAndHashFromURL(ctx, genesis.URL); err != nil {
+			return errors.Wrapf(err, "cannot fetch the genesis from %s", genesis.URL)
+		}
+	} else {
+		source = genesis.File
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(c.app.Path, source)
+		}
+		if data, err = os.ReadFile(source); err != nil {
+			return errors.Wrap(err, "cannot read the genesis file")
+		}
+		sum := sha256.Sum256(data)
+		hash = hex.EncodeToString(sum[:])
+	}
+
+	if genesis.SHA256 != "" && !strings.EqualFold(hash, genesis.SHA256) {
+		return errors.Errorf("genesis from %s is invalid. expected hash %s, actual hash %s", source, genesis.SHA256, hash)
+	}
+
+	// the commands of the chain use its chain id, it must be the one of the genesis
+	chainGenesis, err := cosmosutil.ParseChainGenesis(data)
+	if err != nil {
+		return err
+	}
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	if chainGenesis.ChainID != chainID {
+		return errors.Errorf(
+			"the genesis from %s is for the chain %s instead of %s, set genesis.chain_id to %s in the config",
+			source,
+			chainGenesis.ChainID,
+			chainID,
+			chainGenesis.ChainID,
+		)
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(genesisPath, data, 0644)
+}
+
+// peersConfig returns the config.toml changes connecting the node to the peers from the config.
+func peersConfig(conf chainconfig.Init) map[string]interface{} {
+	p2p := make(map[string]interface{})
+	if len(conf.Seeds) > 0 {
+		p2p["seeds"] = strings.Join(conf.Seeds, ",")
+	}
+	if len(conf.PersistentPeers) > 0 {
+		p2p["persistent_peers"] = strings.Join(conf.PersistentPeers, ",")
+	}
+	if len(p2p) == 0 {
+		return nil
+	}
+	return map[string]interface{}{"p2p": p2p}
+}
+
+// consensusConfig returns the config.toml changes setting the consensus timeouts from the config.
+func consensusConfig(validator chainconfig.Validator) map[string]interface{} {
+	consensus := make(map[string]interface{})
+	for key, timeout := range validator.Timeouts() {
+		consensus[key] = timeout
+	}
+	if len(consensus) == 0 {
+		return nil
+	}
+	return map[string]interface{}{"consensus": consensus}
+}
+
+// genesisDrift is a field of the config differing from the genesis of the chain, the change only
+// takes effect once the genesis is rebuilt from the config.
+type genesisDrift struct {
+	// field is the path of the field in the config, e.g. `genesis.app_state.staking.params.bond_denom`.
+	field string
+
+	// change describes the difference between the config and the genesis.
+	change string
+}
+
+func (d genesisDrift) String() string {
+	return fmt.Sprintf("%s: %s", d.field, d.change)
+}
+
+// checkGenesisDrift warns about the fields of the config differing from the genesis of the chain.
+// The genesis is kept when an initialized chain restarts, so these changes are silently ignored.
+func (c *Chain) checkGenesisDrift(ctx context.Context, conf chainconfig.Config) error {
+	// the accounts and the genesis overrides aren't applied to an existing genesis
+	if conf.Init.Genesis.IsSet() {
+		return nil
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := c.accountAddresses(ctx, conf.Accounts)
+	if err != nil {
+		return err
+	}
+
+	drifts, err := genesisDrifts(conf, addresses, genesis)
+	if err != nil || len(drifts) == 0 {
+		return err
+	}
+
+	fmt.Fprintln(c.stdLog().out, "⚠️  The config changes below don't take effect until the genesis is rebuilt:")
+	for _, d := range drifts {
+		fmt.Fprintf(c.stdLog().out, "  - %s\n", d)
+	}
+	fmt.Fprintf(c.stdLog().out, "%s %s\n", infoColor("Rebuild the genesis from the config with:"), "ignite chain serve --reset genesis")
+
+	return nil
+}
+
+// accountAddresses returns the addresses of the accounts of the config indexed by their name.
+// The accounts without address and key in the keyring are omitted.
+func (c *Chain) accountAddresses(ctx context.Context, accounts []chainconfig.Account) (map[string]string, error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string)
+	for _, account := range accounts {
+		if account.Address != "" {
+			addresses[account.Name] = account.Address
+			continue
+		}
+
+		existingAccount, err := commands.ShowAccount(ctx, account.Name)
+		if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		addresses[account.Name] = existingAccount.Address
+	}
+
+	return addresses, nil
+}
+
+// genesisDrifts returns the accounts and the denoms of the config missing from the genesis and the genesis
+// overrides of the config differing from the genesis. The balances of the accounts aren't
+// compared since they change with the transactions of the chain.
+func genesisDrifts(conf chainconfig.Config, addresses map[string]string, genesis []byte) ([]genesisDrift, error) {
+	var state map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(genesis))
+	d.UseNumber()
+	if err := d.Decode(&state); err != nil {
+		return nil, errors.Wrap(err, "cannot decode the genesis")
+	}
+
+	var gen struct {
+		AppState struct {
+			Bank struct {
+				Balances []struct {
+					Address string `json:"address"`
+				} `json:"balances"`
+				DenomMetadata []struct {
+					Base string `json:"base"`
+				} `json:"denom_metadata"`
+			} `json:"bank"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &gen); err != nil {
+		return nil, errors.Wrap(err, "cannot decode the genesis")
+	}
+	balances := make(map[string]bool)
+	for _, balance := range gen.AppState.Bank.Balances {
+		balances[balance.Address] = true
+	}
+
+	var drifts []genesisDrift
+	for _, account := range conf.Accounts {
+		if address := addresses[account.Name]; address == "" || !balances[address] {
+			drifts = append(drifts, genesisDrift{"accounts." + account.Name, "added to the config"})
+		}
+	}
+
+	metadata := make(map[string]bool)
+	for _, m := range gen.AppState.Bank.DenomMetadata {
+		metadata[m.Base] = true
+	}
+	for _, denom := range conf.Denoms {
+		if !metadata[denom.Base] {
+			drifts = append(drifts, genesisDrift{"denoms." + denom.Base, "added to the config"})
+		}
+	}
+
+	// the chain id of the genesis is always the one of the chain,
+	// and the relative genesis time is resolved at the initialization.
+	overrides := make(map[string]interface{}, len(conf.Genesis))
+	for key, value := range conf.Genesis {
+		if genesisTime, ok := value.(string); key == "genesis_time" && ok && isRelativeGenesisTime(genesisTime) {
+			continue
+		}
+		if key != "chain_id" {
+			overrides[key] = value
+		}
+	}
+
+	return append(drifts, overrideDrifts("genesis", overrides, state)...), nil
+}
+
+// overrideDrifts returns the genesis overrides of the config at field differing from the genesis.
+func overrideDrifts(field string, overrides, genesis map[string]interface{}) (drifts []genesisDrift) {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var (
+			path        = field + "." + key
+			value       = overrides[key]
+			current, ok = genesis[key]
+		)
+
+		if nested, isMap := value.(map[string]interface{}); isMap {
+			if currentNested, isMap := current.(map[string]interface{}); isMap {
+				drifts = append(drifts, overrideDrifts(path, nested, currentNested)...)
+				continue
+			}
+		}
+
+		switch {
+		case !ok:
+			drifts = append(drifts, genesisDrift{path, "missing from the genesis"})
+		case driftValue(value) != driftValue(current):
+			drifts = append(drifts, genesisDrift{path, fmt.Sprintf(
+				"%s in the config, %s in the genesis",
+				driftValue(value),
+				driftValue(current),
+			)})
+		}
+	}
+
+	return drifts
+}
+
+// driftValue formats a value of the config or the genesis to compare them, the numbers of the
+// genesis are often encoded as strings.
+func driftValue(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,113 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestGenesisDrifts(t *testing.T) {
+	genesis := []byte(`{
+  "chain_id": "mars",
+  "app_state": {
+    "bank": {
+      "balances": [{"address": "cosmos1alice", "coins": [{"denom": "token", "amount": "1000"}]}],
+      "denom_metadata": [{"base": "token", "display": "token"}]
+    },
+    "staking": {
+      "params": {"bond_denom": "stake", "max_validators": 100, "unbonding_time": "1814400s"}
+    }
+  }
+}`)
+	conf := chainconfig.Config{
+		Accounts: []chainconfig.Account{
+			{Name: "alice", Coins: []string{"5000token"}},
+			{Name: "bob", Coins: []string{"1000token"}},
+			{Name: "carol", Address: "cosmos1carol"},
+		},
+		Denoms: []chainconfig.Denom{{Base: "token"}, {Base: "uatom", Display: "atom", Exponent: 6}},
+		Genesis: map[string]interface{}{
+			"chain_id": "venus",
+			"app_state": map[string]interface{}{
+				"staking": map[string]interface{}{
+					"params": map[string]interface{}{
+						"bond_denom":     "stake",
+						"max_validators": uint64(100),
+						"unbonding_time": "60s",
+					},
+				},
+				"gov": map[string]interface{}{
+					"voting_params": map[string]interface{}{"voting_period": "60s"},
+				},
+			},
+		},
+	}
+	addresses := map[string]string{
+		"alice": "cosmos1alice",
+		"carol": "cosmos1carol",
+	}
+
+	drifts, err := genesisDrifts(conf, addresses, genesis)
+	require.NoError(t, err)
+	require.Equal(t, []genesisDrift{
+		{"accounts.bob", "added to the config"},
+		{"accounts.carol", "added to the config"},
+		{"denoms.uatom", "added to the config"},
+		{"genesis.app_state.gov", "missing from the genesis"},
+		{"genesis.app_state.staking.params.unbonding_time", "60s in the config, 1814400s in the genesis"},
+	}, drifts)
+
+	_, err = genesisDrifts(conf, addresses, []byte("{"))
+	require.Error(t, err)
+}
+
+func TestGenesisWithDenoms(t *testing.T) {
+	genesis := map[string]interface{}{
+		"chain_id": "mars",
+		"app_state": map[string]interface{}{
+			"bank": map[string]interface{}{"send_enabled": []interface{}{}},
+		},
+	}
+	require.Equal(t, genesis, genesisWithDenoms(genesis, nil))
+
+	merged := genesisWithDenoms(genesis, []chainconfig.Denom{
+		{Base: "stake"},
+		{Base: "utoken", Display: "token", Exponent: 6, Name: "Token", Symbol: "TKN"},
+	})
+	require.Equal(t, map[string]interface{}{
+		"chain_id": "mars",
+		"app_state": map[string]interface{}{
+			"bank": map[string]interface{}{
+				"send_enabled": []interface{}{},
+				"denom_metadata": []interface{}{
+					map[string]interface{}{
+						"description": "",
+						"denom_units": []interface{}{
+							map[string]interface{}{"denom": "stake", "exponent": 0, "aliases": []interface{}{}},
+						},
+						"base":    "stake",
+						"display": "stake",
+						"name":    "",
+						"symbol":  "",
+					},
+					map[string]interface{}{
+						"description": "",
+						"denom_units": []interface{}{
+							map[string]interface{}{"denom": "utoken", "exponent": 0, "aliases": []interface{}{}},
+							map[string]interface{}{"denom": "token", "exponent": uint32(6), "aliases": []interface{}{}},
+						},
+						"base":    "utoken",
+						"display": "token",
+						"name":    "Token",
+						"symbol":  "TKN",
+					},
+				},
+			},
+		},
+	}, merged)
+
+	// the overrides of the config are unchanged.
+	require.NotContains(t, genesis["app_state"].(map[string]interface{})["bank"], "denom_metadata")
+}
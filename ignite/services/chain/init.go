@@ -2,10 +2,12 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/imdario/mergo"
 
@@ -29,7 +31,8 @@ func (c *Chain) Init(ctx context.Context, initAccounts bool) error {
 		return err
 	}
 
-	if initAccounts {
+	// the accounts can't be added to an existing genesis
+	if initAccounts && !conf.Init.Genesis.IsSet() {
 		return c.InitAccounts(ctx, conf)
 	}
 	return nil
@@ -37,6 +40,20 @@ func (c *Chain) Init(ctx context.Context, initAccounts bool) error {
 
 // InitChain initializes the chain.
 func (c *Chain) InitChain(ctx context.Context) error {
+	// cleanup persistent data from previous `serve`.
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(home); err != nil {
+		return err
+	}
+
+	return c.initNode(ctx)
+}
+
+// initNode initializes the node's home and creates the genesis using the config.
+func (c *Chain) initNode(ctx context.Context) error {
 	chainID, err := c.ID()
 	if err != nil {
 		return err
@@ -47,14 +64,10 @@ func (c *Chain) InitChain(ctx context.Context) error {
 		return err
 	}
 
-	// cleanup persistent data from previous `serve`.
 	home, err := c.Home()
 	if err != nil {
 		return err
 	}
-	if err := os.RemoveAll(home); err != nil {
-		return err
-	}
 
 	commands, err := c.Commands(ctx)
 	if err != nil {
@@ -66,11 +79,12 @@ func (c *Chain) InitChain(ctx context.Context) error {
 		return err
 	}
 
-	// overwrite configuration changes from Ignite CLI's config.yml to
-	// over app's sdk configs.
-
-	if err := c.plugin.Configure(home, conf); err != nil {
-		return err
+	if conf.Init.Genesis.IsSet() {
+		// the existing genesis replaces the generated one as is
+		if err := c.importGenesis(ctx, conf.Init.Genesis); err != nil {
+			return err
+		}
+		return c.configureNode(home, conf)
 	}
 
 	// make sure that chain id given during chain.New() has the most priority.
@@ -78,45 +92,67 @@ func (c *Chain) InitChain(ctx context.Context) error {
 		conf.Genesis["chain_id"] = chainID
 	}
 
+	if err := resolveGenesisTime(conf.Genesis, time.Now()); err != nil {
+		return err
+	}
+
 	// Initilize app config
 	genesisPath, err := c.GenesisPath()
 	if err != nil {
 		return err
 	}
-	appTOMLPath, err := c.AppTOMLPath()
-	if err != nil {
+
+	// overwrite configuration changes from Ignite CLI's config.yml to
+	// over app's sdk configs.
+	if err := mergeConfigFile(confile.DefaultJSONEncodingCreator, genesisPath, genesisWithDenoms(conf.Genesis, conf.Denoms)); err != nil {
 		return err
 	}
-	clientTOMLPath, err := c.ClientTOMLPath()
-	if err != nil {
-		return err
+
+	return c.configureNode(home, conf)
+}
+
+// configureNode overwrites the node's app.toml, client.toml and config.toml
+// files with the configuration from Ignite CLI's config.yml.
+// Unlike InitChain it keeps the chain's data, keys and genesis untouched, which
+// allows applying node settings to an already initialized chain.
+func (c *Chain) configureNode(home string, conf chainconfig.Config) error {
+	// the network identifier of the Rosetta gateway is the one of the chain by default.
+	if conf.Rosetta.Blockchain == "" {
+		conf.Rosetta.Blockchain = c.app.N()
 	}
-	configTOMLPath, err := c.ConfigTOMLPath()
-	if err != nil {
+	if conf.Rosetta.Network == "" {
+		chainID, err := c.ID()
+		if err != nil {
+			return err
+		}
+		conf.Rosetta.Network = chainID
+	}
+
+	if err := c.plugin.Configure(home, conf); err != nil {
 		return err
 	}
 
+	var (
+		appTOMLPath    = filepath.Join(home, "config/app.toml")
+		clientTOMLPath = filepath.Join(home, "config/client.toml")
+		configTOMLPath = filepath.Join(home, "config/config.toml")
+	)
+
 	appconfigs := []struct {
-		ec      confile.EncodingCreator
 		path    string
 		changes map[string]interface{}
 	}{
-		{confile.DefaultJSONEncodingCreator, genesisPath, conf.Genesis},
-		{confile.DefaultTOMLEncodingCreator, appTOMLPath, conf.Init.App},
-		{confile.DefaultTOMLEncodingCreator, clientTOMLPath, conf.Init.Client},
-		{confile.DefaultTOMLEncodingCreator, configTOMLPath, conf.Init.Config},
+		{appTOMLPath, pruningConfig(conf.Validator)},
+		{appTOMLPath, conf.Init.App},
+		{clientTOMLPath, conf.Init.Client},
+		{configTOMLPath, conf.Init.Config},
+		{configTOMLPath, peersConfig(conf.Init)},
+		{configTOMLPath, consensusConfig(conf.Validator)},
+		{configTOMLPath, remoteSignerConfig(conf.Validator)},
 	}
 
 	for _, ac := range appconfigs {
-		cf := confile.New(ac.ec, ac.path)
-		var conf map[string]interface{}
-		if err := cf.Load(&conf); err != nil {
-			return err
-		}
-		if err := mergo.Merge(&conf, ac.changes, mergo.WithOverride); err != nil {
-			return err
-		}
-		if err := cf.Save(conf); err != nil {
+		if err := mergeConfigFile(confile.DefaultTOMLEncodingCreator, ac.path, ac.changes); err != nil {
 			return err
 		}
 	}
@@ -124,8 +160,28 @@ func (c *Chain) InitChain(ctx context.Context) error {
 	return nil
 }
 
+// mergeConfigFile overwrites the values of the config file at path with changes.
+func mergeConfigFile(ec confile.EncodingCreator, path string, changes map[string]interface{}) error {
+	cf := confile.New(ec, path)
+	var conf map[string]interface{}
+	if err := cf.Load(&conf); err != nil {
+		return err
+	}
+	if err := mergo.Merge(&conf, changes, mergo.WithOverride); err != nil {
+		return err
+	}
+	return cf.Save(conf)
+}
+
 // InitAccounts initializes the chain accounts and creates validator gentxs
 func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error {
+	return c.initAccounts(ctx, conf, false)
+}
+
+// initAccounts initializes the chain accounts and creates validator gentxs.
+// When reuseKeys is true, the keys already existing in the keyring are used
+// instead of creating new ones.
+func (c *Chain) initAccounts(ctx context.Context, conf chainconfig.Config, reuseKeys bool) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
@@ -136,7 +192,22 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
-		// If the account doesn't provide an address, we create one
+		// If the account doesn't provide an address, we use the existing key or create one
+		if accountAddress == "" && reuseKeys {
+			existingAccount, err := commands.ShowAccount(ctx, account.Name)
+			if err != nil && !errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+				return err
+			}
+			accountAddress = existingAccount.Address
+		}
+		var importedAccount chaincmdrunner.Account
+		if accountAddress == "" && account.Keyring {
+			importedAccount, err = c.importKeyringAccount(ctx, commands, conf, account.Name)
+			if err != nil {
+				return fmt.Errorf("account %q of the keyring: %w", account.Name, err)
+			}
+			accountAddress = importedAccount.Address
+		}
 		if accountAddress == "" {
 			generatedAccount, err = commands.AddAccount(ctx, account.Name, account.Mnemonic, account.CoinType)
 			if err != nil {
@@ -145,12 +216,19 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 			accountAddress = generatedAccount.Address
 		}
 
-		coins := strings.Join(account.Coins, ",")
-		if err := commands.AddGenesisAccount(ctx, accountAddress, coins); err != nil {
+		if err := addGenesisAccount(ctx, commands, accountAddress, account, time.Now()); err != nil {
 			return err
 		}
 
-		if account.Address == "" {
+		switch {
+		case importedAccount.Address != "":
+			fmt.Fprintf(
+				c.stdLog().out,
+				"🙂 Imported the account %q of the Ignite CLI keyring with address: %q\n",
+				account.Name,
+				importedAccount.Address,
+			)
+		case generatedAccount.Address != "":
 			fmt.Fprintf(
 				c.stdLog().out,
 				"🙂 Created account %q with address %q with mnemonic: %q\n",
@@ -158,7 +236,14 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 				generatedAccount.Address,
 				generatedAccount.Mnemonic,
 			)
-		} else {
+		case account.Address == "":
+			fmt.Fprintf(
+				c.stdLog().out,
+				"🙂 Reused the existing account %q with address: %q\n",
+				account.Name,
+				accountAddress,
+			)
+		default:
 			fmt.Fprintf(
 				c.stdLog().out,
 				"🙂 Imported an account %q with address: %q\n",
@@ -175,8 +260,86 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 	return err
 }
 
+// addGenesisAccount adds the account of the config to the genesis with its address, as a vesting
+// account when it has a vesting. The relative vesting times are resolved from now.
+func addGenesisAccount(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	address string,
+	account chainconfig.Account,
+	now time.Time,
+) error {
+	coins := strings.Join(account.Coins, ",")
+	if account.Vesting == nil {
+		return commands.AddGenesisAccount(ctx, address, coins)
+	}
+
+	// the vesting is validated when the config is parsed.
+	vestingCoins := strings.Join(account.Vesting.Coins, ",")
+	start, end, err := account.Vesting.Times(now)
+	if err != nil {
+		return err
+	}
+	if account.Vesting.IsContinuous() {
+		return commands.AddContinuousVestingAccount(ctx, address, coins, vestingCoins, start.Unix(), end.Unix())
+	}
+	return commands.AddVestingAccount(ctx, address, coins, vestingCoins, end.Unix())
+}
+
+// genesisWithDenoms returns the genesis overrides with the metadata of the denoms in the denom
+// metadata of the bank module.
+func genesisWithDenoms(genesis map[string]interface{}, denoms []chainconfig.Denom) map[string]interface{} {
+	if len(denoms) == 0 {
+		return genesis
+	}
+
+	var metadata []interface{}
+	for _, denom := range denoms {
+		units := []interface{}{
+			map[string]interface{}{"denom": denom.Base, "exponent": 0, "aliases": []interface{}{}},
+		}
+		if denom.DisplayDenom() != denom.Base {
+			units = append(units, map[string]interface{}{
+				"denom":    denom.Display,
+				"exponent": denom.Exponent,
+				"aliases":  []interface{}{},
+			})
+		}
+		metadata = append(metadata, map[string]interface{}{
+			"description": denom.Description,
+			"denom_units": units,
+			"base":        denom.Base,
+			"display":     denom.DisplayDenom(),
+			"name":        denom.Name,
+			"symbol":      denom.Symbol,
+		})
+	}
+
+	// the overrides of the config are kept as is.
+	merged := make(map[string]interface{}, len(genesis)+1)
+	for key, value := range genesis {
+		merged[key] = value
+	}
+	appState := make(map[string]interface{})
+	if values, ok := merged["app_state"].(map[string]interface{}); ok {
+		for key, value := range values {
+			appState[key] = value
+		}
+	}
+	bank := make(map[string]interface{})
+	if values, ok := appState["bank"].(map[string]interface{}); ok {
+		for key, value := range values {
+			bank[key] = value
+		}
+	}
+	bank["denom_metadata"] = metadata
+	appState["bank"] = bank
+	merged["app_state"] = appState
+	return merged
+}
+
 // IssueGentx generates a gentx from the validator information in chain config and import it in the chain genesis
-func (c Chain) IssueGentx(ctx context.Context, v Validator) (string, error) {
+func (c *Chain) IssueGentx(ctx context.Context, v Validator) (string, error) {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return "", err
@@ -193,15 +356,26 @@ func (c Chain) IssueGentx(ctx context.Context, v Validator) (string, error) {
 }
 
 // IsInitialized checks if the chain is initialized
-// the check is performed by checking if the gentx dir exist in the config
+// the check is performed by checking if the gentx dir exist in the config,
+// or the genesis when the chain is initialized with an existing genesis
 func (c *Chain) IsInitialized() (bool, error) {
 	home, err := c.Home()
 	if err != nil {
 		return false, err
 	}
-	gentxDir := filepath.Join(home, "config", "gentx")
+	initPath := filepath.Join(home, "config", "gentx")
+
+	conf, err := c.Config()
+	if err != nil {
+		return false, err
+	}
+	if conf.Init.Genesis.IsSet() {
+		if initPath, err = c.GenesisPath(); err != nil {
+			return false, err
+		}
+	}
 
-	if _, err := os.Stat(gentxDir); os.IsNotExist(err) {
+	if _, err := os.Stat(initPath); os.IsNotExist(err) {
 		return false, nil
 	}
 	if err != nil {
@@ -0,0 +1,95 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+// keyringImportPassphrase encrypts the keys of the Ignite CLI keyring while they're imported into the
+// keyring of the chain.
+const keyringImportPassphrase = "ignite-keyring"
+
+// keyringRegistries returns the registries of the Ignite CLI keyring an account of the config is
+// looked up in: the accounts of the keyring namespace of the chain first, then the shared accounts.
+// They're the registries of the backend set with the AccountsKeyringBackend option, or of the test
+// backend, the default one of the account commands. The accounts of the chain are stored in the OS
+// keyring service of the config when it's set, like the account commands do.
+func (c *Chain) keyringRegistries(conf chainconfig.Config) ([]cosmosaccount.Registry, error) {
+	chainNamespace, err := c.KeyringNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	backend := c.options.accountsKeyringBackend
+	if backend == "" {
+		backend = cosmosaccount.KeyringTest
+	}
+
+	var registries []cosmosaccount.Registry
+	for _, namespace := range []string{chainNamespace, ""} {
+		options := []cosmosaccount.Option{
+			cosmosaccount.WithKeyringBackend(backend),
+			cosmosaccount.WithNamespace(namespace),
+		}
+		if namespace != "" && conf.Init.KeyringService != "" {
+			options = append(options, cosmosaccount.WithKeyringServiceName(conf.Init.KeyringService))
+		}
+		registry, err := cosmosaccount.New(options...)
+		if err != nil {
+			return nil, err
+		}
+		registries = append(registries, registry)
+	}
+	return registries, nil
+}
+
+// exportKeyringAccount exports the account with name of the first registry holding it as an armored
+// private key encrypted with keyringImportPassphrase.
+func exportKeyringAccount(name string, registries ...cosmosaccount.Registry) (string, error) {
+	for _, registry := range registries {
+		armored, err := registry.Export(name, keyringImportPassphrase)
+
+		var accErr *cosmosaccount.AccountDoesNotExistError
+		if errors.As(err, &accErr) {
+			continue
+		}
+		return armored, err
+	}
+	return "", &cosmosaccount.AccountDoesNotExistError{Name: name}
+}
+
+// importKeyringAccount imports the account with name of the Ignite CLI keyring into the keyring of
+// the chain.
+func (c *Chain) importKeyringAccount(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	conf chainconfig.Config,
+	name string,
+) (chaincmdrunner.Account, error) {
+	registries, err := c.keyringRegistries(conf)
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	armored, err := exportKeyringAccount(name, registries...)
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	dir, err := os.MkdirTemp("", "ignite-keyring")
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, name)
+	if err := os.WriteFile(keyFile, []byte(armored), 0600); err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	return commands.ImportAccount(ctx, name, keyFile, keyringImportPassphrase)
+}
@@ -0,0 +1,83 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestExportKeyringAccount(t *testing.T) {
+	namespaced, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	shared, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	alice, _, err := namespaced.Create("alice")
+	require.NoError(t, err)
+	bob, _, err := shared.Create("bob")
+	require.NoError(t, err)
+	_, _, err = shared.Create("alice")
+	require.NoError(t, err)
+
+	// the account of the first registry holding it is exported.
+	for _, acc := range []cosmosaccount.Account{alice, bob} {
+		armored, err := exportKeyringAccount(acc.Name, namespaced, shared)
+		require.NoError(t, err)
+
+		imported, err := cosmosaccount.NewInMemory()
+		require.NoError(t, err)
+		got, err := imported.Import(acc.Name, armored, keyringImportPassphrase)
+		require.NoError(t, err)
+		require.Equal(t, acc.Address("cosmos"), got.Address("cosmos"))
+	}
+
+	_, err = exportKeyringAccount("carol", namespaced, shared)
+	require.EqualError(t, err, `account "carol" does not exist`)
+}
+
+func TestKeyringRegistries(t *testing.T) {
+	defer func(home string) { cosmosaccount.KeyringHome = home }(cosmosaccount.KeyringHome)
+	cosmosaccount.KeyringHome = t.TempDir()
+
+	registry, err := cosmosaccount.New(cosmosaccount.WithNamespace("mars-1"))
+	require.NoError(t, err)
+	_, _, err = registry.Create("alice")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		backend cosmosaccount.KeyringBackend
+		err     string
+	}{
+		{
+			name: "default backend",
+		},
+		{
+			name:    "test backend",
+			backend: cosmosaccount.KeyringTest,
+		},
+		{
+			name:    "other backend",
+			backend: cosmosaccount.KeyringMemory,
+			err:     `account "alice" does not exist`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Chain{options: chainOptions{chainID: "mars-1"}}
+			AccountsKeyringBackend(tt.backend)(c)
+
+			registries, err := c.keyringRegistries(chainconfig.Config{})
+			require.NoError(t, err)
+			_, err = exportKeyringAccount("alice", registries...)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
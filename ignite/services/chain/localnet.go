@@ -0,0 +1,409 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/otiai10/copy"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/prefixgen"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// localnetDir is the directory of the chain home containing the homes of the validators
+	// of the local network after the first one.
+	localnetDir = "localnet"
+
+	// localnetPortOffset is the offset between the ports of two consecutive validators of the local network.
+	localnetPortOffset = 10
+
+	// localnetValidatorsKey is the cache key for the number of validators of the local network.
+	localnetValidatorsKey = "localnet_validators"
+)
+
+// ServeValidators serves a local network of n validators instead of a single node. The first validator
+// is the node of the chain home, the other ones run in their own home with the ports of the config
+// shifted for each validator.
+func ServeValidators(n int) ServeOption {
+	return func(c *serveOptions) {
+		c.validators = n
+	}
+}
+
+// localnetNode is a validator of the local network after the first one.
+type localnetNode struct {
+	// index of the validator in the local network, the first validator has the index 0.
+	index int
+
+	// home of the node.
+	home string
+
+	// conf is the config of the chain with the addresses of the node.
+	conf chainconfig.Config
+
+	// commands run the binary with the home of the node.
+	commands chaincmdrunner.Runner
+}
+
+// moniker returns the moniker of the node.
+func (n localnetNode) moniker() string {
+	return fmt.Sprintf("%s-%d", moniker, n.index)
+}
+
+// validatorName returns the name of the key of the validator.
+func (n localnetNode) validatorName() string {
+	return fmt.Sprintf("%s-%d", n.conf.Validator.Name, n.index)
+}
+
+// genesisPath returns the path of the genesis of the node.
+func (n localnetNode) genesisPath() string {
+	return filepath.Join(n.home, "config/genesis.json")
+}
+
+// localnetNodes returns the validators of the local network of the given size after the first one,
+// no validator is returned for a single node.
+func (c *Chain) localnetNodes(ctx context.Context, conf chainconfig.Config, validators int) ([]localnetNode, error) {
+	if validators <= 1 {
+		return nil, nil
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []localnetNode
+	for i := 1; i < validators; i++ {
+		host, err := localnetHost(conf.Host, i)
+		if err != nil {
+			return nil, &CannotBuildAppError{err}
+		}
+		nodeAddr, err := xurl.TCP(host.RPC)
+		if err != nil {
+			return nil, &CannotBuildAppError{err}
+		}
+
+		signer, err := localnetRemoteSigner(conf.Validator.RemoteSigner, i)
+		if err != nil {
+			return nil, &CannotBuildAppError{err}
+		}
+
+		nodeConf := conf
+		nodeConf.Host = host
+		nodeConf.Validator.RemoteSigner = signer
+		nodeHome := filepath.Join(home, localnetDir, fmt.Sprintf("validator%d", i))
+
+		var options []chaincmdrunner.Option
+		if c.logLevel == LogVerbose {
+			prefix := prefixes[logValidator]
+			options = append(options,
+				chaincmdrunner.Stdout(os.Stdout),
+				chaincmdrunner.Stderr(os.Stderr),
+				chaincmdrunner.DaemonLogPrefix(prefixgen.
+					New(prefix.Name, prefixgen.Common(prefixgen.Color(prefix.Color))...).
+					Gen(c.app.Name, i)),
+			)
+		}
+		nodeCommands, err := chaincmdrunner.New(
+			ctx,
+			commands.Cmd().Copy(chaincmd.WithHome(nodeHome), chaincmd.WithNodeAddress(nodeAddr)),
+			options...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, localnetNode{
+			index:    i,
+			home:     nodeHome,
+			conf:     nodeConf,
+			commands: nodeCommands,
+		})
+	}
+	return nodes, nil
+}
+
+// localnetHost returns the addresses of the validator of the local network at index,
+// the ports of host are shifted by the index of the validator.
+func localnetHost(host chainconfig.Host, index int) (chainconfig.Host, error) {
+	addresses := []*string{
+		&host.RPC,
+		&host.P2P,
+		&host.Prof,
+		&host.GRPC,
+		&host.GRPCWeb,
+		&host.API,
+		&host.Rosetta,
+	}
+	for _, address := range addresses {
+		shifted, err := shiftPort(*address, index*localnetPortOffset)
+		if err != nil {
+			return chainconfig.Host{}, err
+		}
+		*address = shifted
+	}
+	return host, nil
+}
+
+// shiftPort adds offset to the port of address, an empty address is kept empty.
+func shiftPort(address string, offset int) (string, error) {
+	if address == "" {
+		return "", nil
+	}
+	i := strings.LastIndex(address, ":")
+	if i == -1 {
+		return "", fmt.Errorf("the address %q has no port", address)
+	}
+	port, err := strconv.Atoi(address[i+1:])
+	if err != nil {
+		return "", fmt.Errorf("invalid port of the address %q: %w", address, err)
+	}
+	if port+offset > 65535 {
+		return "", fmt.Errorf("the port of the address %q can't be shifted by %d for the local network", address, offset)
+	}
+	return address[:i+1] + strconv.Itoa(port+offset), nil
+}
+
+// localnetPeers returns the persistent peers of the validator at index, the other validators
+// of the local network with their node IDs and p2p addresses.
+func localnetPeers(nodeIDs, p2pAddresses []string, index int) ([]string, error) {
+	var peers []string
+	for i, nodeID := range nodeIDs {
+		if i == index {
+			continue
+		}
+		address := p2pAddresses[i]
+		if j := strings.Index(address, "://"); j != -1 {
+			address = address[j+3:]
+		}
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid p2p address %q: %w", p2pAddresses[i], err)
+		}
+		// the validators listening on all the interfaces are dialed on the loopback interface.
+		if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+			host = "127.0.0.1"
+		}
+		peers = append(peers, fmt.Sprintf("%s@%s", nodeID, net.JoinHostPort(host, port)))
+	}
+	return peers, nil
+}
+
+// hasLocalnetChanged checks if the number of validators of the local network is different from
+// the one of the last serve or if the home of a validator is missing.
+func (c *Chain) hasLocalnetChanged(dirCache cache.Cache[[]byte], nodes []localnetNode) (bool, error) {
+	for _, node := range nodes {
+		if _, err := os.Stat(node.genesisPath()); os.IsNotExist(err) {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+	}
+
+	saved, err := dirCache.Get(localnetValidatorsKey)
+	if err == cache.ErrorNotFound {
+		return len(nodes) > 0, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(saved) != strconv.Itoa(len(nodes)+1), nil
+}
+
+// initLocalnet initializes the validators of the local network after the first one: each validator
+// creates a gentx with an account funded in the genesis of the first validator, the gentxs are
+// collected in its genesis and the genesis is shared with all the validators.
+func (c *Chain) initLocalnet(ctx context.Context, conf chainconfig.Config, nodes []localnetNode) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	if conf.Init.Genesis.IsSet() {
+		return &CannotBuildAppError{errors.New("the validators of the local network can't be added to an existing genesis")}
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	gentxsPath, err := c.GentxsPath()
+	if err != nil {
+		return err
+	}
+
+	// the accounts of the validators are funded with their self-delegation.
+	for _, node := range nodes {
+		if err := os.RemoveAll(node.home); err != nil {
+			return err
+		}
+		if err := node.commands.Init(ctx, node.moniker()); err != nil {
+			return err
+		}
+		account, err := node.commands.AddAccount(ctx, node.validatorName(), "", "")
+		if err != nil {
+			return err
+		}
+		if err := commands.AddGenesisAccount(ctx, account.Address, conf.Validator.Staked); err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		if err := copy.Copy(genesisPath, node.genesisPath()); err != nil {
+			return err
+		}
+		gentxPath, err := c.plugin.Gentx(ctx, node.commands, Validator{
+			Name:          node.validatorName(),
+			Moniker:       node.moniker(),
+			StakingAmount: conf.Validator.Staked,
+		})
+		if err != nil {
+			return err
+		}
+		if err := copy.Copy(gentxPath, filepath.Join(gentxsPath, filepath.Base(gentxPath))); err != nil {
+			return err
+		}
+	}
+
+	if err := commands.CollectGentxs(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.stdLog().out, "👥 Created %d validators for the local network\n", len(nodes)+1)
+	return c.shareLocalnetGenesis(nodes)
+}
+
+// resetLocalnet shares the genesis of the first validator with the other validators of the local
+// network and resets their data.
+func (c *Chain) resetLocalnet(ctx context.Context, nodes []localnetNode) error {
+	if err := c.shareLocalnetGenesis(nodes); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if err := node.commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shareLocalnetGenesis copies the genesis of the first validator to the other validators of the local network.
+func (c *Chain) shareLocalnetGenesis(nodes []localnetNode) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if err := copy.Copy(genesisPath, node.genesisPath()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configureLocalnet applies the config to the nodes of the validators of the local network and
+// connects every validator to the other ones as persistent peers.
+func (c *Chain) configureLocalnet(ctx context.Context, conf chainconfig.Config, nodes []localnetNode) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	homes := []string{home}
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return err
+	}
+	nodeIDs := []string{nodeID}
+	p2pAddresses := []string{conf.Host.P2P}
+
+	for _, node := range nodes {
+		if err := c.configureNode(node.home, node.conf); err != nil {
+			return &CannotBuildAppError{err}
+		}
+
+		// the addresses overwritten by the node config are replaced with the ones of the validator,
+		// their format is validated when the node is configured.
+		apiAddr, _ := xurl.TCP(node.conf.Host.API)
+		rpcAddr, _ := xurl.TCP(node.conf.Host.RPC)
+		p2pAddr, _ := xurl.TCP(node.conf.Host.P2P)
+		appconfigs := []struct {
+			path    string
+			changes map[string]interface{}
+		}{
+			{filepath.Join(node.home, "config/app.toml"), map[string]interface{}{
+				"api":      map[string]interface{}{"address": apiAddr},
+				"grpc":     map[string]interface{}{"address": node.conf.Host.GRPC},
+				"grpc-web": map[string]interface{}{"address": node.conf.Host.GRPCWeb},
+				"rosetta":  map[string]interface{}{"address": node.conf.Host.Rosetta},
+			}},
+			{filepath.Join(node.home, "config/config.toml"), map[string]interface{}{
+				"rpc": map[string]interface{}{"laddr": rpcAddr, "pprof_laddr": node.conf.Host.Prof},
+				"p2p": map[string]interface{}{"laddr": p2pAddr},
+			}},
+		}
+		for _, ac := range appconfigs {
+			if err := mergeConfigFile(confile.DefaultTOMLEncodingCreator, ac.path, ac.changes); err != nil {
+				return err
+			}
+		}
+
+		nodeID, err := node.commands.ShowNodeID(ctx)
+		if err != nil {
+			return err
+		}
+		homes = append(homes, node.home)
+		nodeIDs = append(nodeIDs, nodeID)
+		p2pAddresses = append(p2pAddresses, node.conf.Host.P2P)
+	}
+
+	for i, home := range homes {
+		peers, err := localnetPeers(nodeIDs, p2pAddresses, i)
+		if err != nil {
+			return &CannotBuildAppError{err}
+		}
+		peers = append(peers, conf.Init.PersistentPeers...)
+
+		// the validators share the IP of the loopback interface.
+		p2p := map[string]interface{}{
+			"persistent_peers":   strings.Join(peers, ","),
+			"allow_duplicate_ip": true,
+			"addr_book_strict":   false,
+		}
+		if err := mergeConfigFile(
+			confile.DefaultTOMLEncodingCreator,
+			filepath.Join(home, "config/config.toml"),
+			map[string]interface{}{"p2p": p2p},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
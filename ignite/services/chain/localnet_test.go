@@ -0,0 +1,51 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestLocalnetHost(t *testing.T) {
+	host := chainconfig.DefaultConf.Host
+	host.RPC = "tcp://127.0.0.1:26657"
+	host.Prof = ""
+
+	shifted, err := localnetHost(host, 2)
+	require.NoError(t, err)
+	require.Equal(t, chainconfig.Host{
+		RPC:      "tcp://127.0.0.1:26677",
+		P2P:      "0.0.0.0:26676",
+		GRPC:     "0.0.0.0:9110",
+		GRPCWeb:  "0.0.0.0:9111",
+		API:      "0.0.0.0:1337",
+		Rosetta:  "0.0.0.0:8100",
+		Frontend: host.Frontend,
+	}, shifted)
+
+	host.API = "0.0.0.0"
+	_, err = localnetHost(host, 1)
+	require.EqualError(t, err, `the address "0.0.0.0" has no port`)
+
+	host.API = "0.0.0.0:65530"
+	_, err = localnetHost(host, 1)
+	require.EqualError(t, err, `the port of the address "0.0.0.0:65530" can't be shifted by 10 for the local network`)
+}
+
+func TestLocalnetPeers(t *testing.T) {
+	nodeIDs := []string{"a", "b", "c"}
+	p2pAddresses := []string{"tcp://0.0.0.0:26656", "0.0.0.0:26666", "192.168.1.2:26676"}
+
+	peers, err := localnetPeers(nodeIDs, p2pAddresses, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b@127.0.0.1:26666", "c@192.168.1.2:26676"}, peers)
+
+	peers, err = localnetPeers(nodeIDs, p2pAddresses, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a@127.0.0.1:26656", "b@127.0.0.1:26666"}, peers)
+
+	_, err = localnetPeers(nodeIDs, []string{"0.0.0.0", "", ""}, 1)
+	require.Error(t, err)
+}
@@ -14,9 +14,10 @@ var prefixes = map[logType]struct {
 	Name  string
 	Color uint8
 }{
-	logStarport: {"starport", 202},
-	logBuild:    {"build", 203},
-	logAppd:     {"%s daemon", 204},
+	logStarport:  {"starport", 202},
+	logBuild:     {"build", 203},
+	logAppd:      {"%s daemon", 204},
+	logValidator: {"%s validator %d", 205},
 }
 
 // logType represents the different types of logs.
@@ -26,6 +27,7 @@ const (
 	logStarport logType = iota
 	logBuild
 	logAppd
+	logValidator
 )
 
 type std struct {
@@ -52,9 +54,14 @@ func (c *Chain) stdLog() std {
 		stdout io.Writer = prefixed(c.stdout)
 		stderr io.Writer = prefixed(c.stderr)
 	)
-	if c.logLevel == LogRegular {
+	switch c.logLevel {
+	case LogRegular:
 		stdout = os.Stdout
 		stderr = os.Stderr
+	case LogQuiet:
+		// only errors are printed
+		stdout = io.Discard
+		stderr = os.Stderr
 	}
 	return std{
 		out: stdout,
@@ -0,0 +1,70 @@
+package chain
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/gopanic"
+)
+
+// maxPanicFrames is the maximum number of frames of the app printed for a panic.
+const maxPanicFrames = 5
+
+// panicFrame is a frame of the stack trace of a panic located in the source code of the app.
+type panicFrame struct {
+	gopanic.Frame
+
+	// Path of the source file relative to the app.
+	Path string
+}
+
+// appFrames returns the frames of the panic located in the source code of the app, the innermost first.
+// The files of the frames are either absolute paths or, when the app is built with -trimpath,
+// prefixed with the Go module path of the app.
+func (c *Chain) appFrames(p gopanic.Panic) (frames []panicFrame) {
+	for _, f := range p.Frames {
+		var path string
+
+		if rel, err := filepath.Rel(c.app.Path, f.File); err == nil && filepath.IsAbs(f.File) && !strings.HasPrefix(rel, "..") {
+			path = rel
+		} else if strings.HasPrefix(f.File, c.app.ImportPath+"/") {
+			path = filepath.FromSlash(strings.TrimPrefix(f.File, c.app.ImportPath+"/"))
+		} else {
+			continue
+		}
+
+		frames = append(frames, panicFrame{f, path})
+	}
+
+	return frames
+}
+
+// printPanic prints a summary of the panic of the app with the location of the panic in its source
+// code on top, its full stack trace stays in the logs of the app.
+func (c *Chain) printPanic(w io.Writer, p gopanic.Panic) {
+	fmt.Fprintf(w, "%s\n", errorColor(fmt.Sprintf("💥 %s panicked: %s", c.app.D(), p.Message)))
+
+	frames := c.appFrames(p)
+	if len(frames) == 0 {
+		fmt.Fprintln(w, "The panic didn't occur in the source code of the chain, see the logs above for its stack trace.")
+		return
+	}
+
+	for i, f := range frames {
+		if i == maxPanicFrames {
+			fmt.Fprintf(w, "    ... %d more\n", len(frames)-maxPanicFrames)
+			break
+		}
+
+		prefix := "   at"
+		if i > 0 {
+			prefix = "   by"
+		}
+
+		// keep the package of the function to name the method of a type, e.g. keeper.Keeper.GetPost
+		function := f.Function[strings.LastIndex(f.Function, "/")+1:]
+		fmt.Fprintf(w, "%s %s (%s)\n", prefix, infoColor(fmt.Sprintf("%s:%d", f.Path, f.Line)), function)
+	}
+}
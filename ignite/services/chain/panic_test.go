@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/gopanic"
+)
+
+func TestPrintPanic(t *testing.T) {
+	color.Enable = false
+	defer func() { color.Enable = true }()
+
+	c := &Chain{app: App{Name: "mars", Path: "/home/foo/mars", ImportPath: "github.com/foo/mars"}}
+	p := gopanic.Panic{
+		Message: "invalid post id",
+		Frames: []gopanic.Frame{
+			{Function: "panic", File: "/usr/local/go/src/runtime/panic.go", Line: 838},
+			{Function: "github.com/foo/mars/x/mars/keeper.Keeper.GetPost", File: "/home/foo/mars/x/mars/keeper/post.go", Line: 42},
+			{Function: "github.com/foo/mars/x/mars.BeginBlocker", File: "github.com/foo/mars/x/mars/abci.go", Line: 12},
+			{Function: "github.com/foo/mars-extra/x/extra.BeginBlocker", File: "/home/foo/mars-extra/x/extra/abci.go", Line: 8},
+		},
+	}
+
+	var b bytes.Buffer
+	c.printPanic(&b, p)
+	require.Equal(t, `💥 marsd panicked: invalid post id
+   at `+filepath.FromSlash("x/mars/keeper/post.go")+`:42 (keeper.Keeper.GetPost)
+   by `+filepath.FromSlash("x/mars/abci.go")+`:12 (mars.BeginBlocker)
+`, b.String())
+
+	b.Reset()
+	c.printPanic(&b, gopanic.Panic{Message: "concurrent map writes"})
+	require.Contains(t, b.String(), "didn't occur in the source code of the chain")
+}
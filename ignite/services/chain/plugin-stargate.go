@@ -52,7 +52,7 @@ func (p *stargatePlugin) Configure(homePath string, conf chainconfig.Config) err
 	if err := p.appTOML(homePath, conf); err != nil {
 		return err
 	}
-	if err := p.clientTOML(homePath); err != nil {
+	if err := p.clientTOML(homePath, conf); err != nil {
 		return err
 	}
 	return p.configTOML(homePath, conf)
@@ -70,13 +70,25 @@ func (p *stargatePlugin) appTOML(homePath string, conf chainconfig.Config) error
 	if err != nil {
 		return fmt.Errorf("invalid api address format %s: %w", conf.Host.API, err)
 	}
+	// the API proxy controlling the access to the node listens on the API address.
+	if chainconfig.IsAPIProxied(conf) {
+		apiAddr = "unix://" + apiSocketPath(homePath)
+	}
 
 	config.Set("api.enable", true)
 	config.Set("api.enabled-unsafe-cors", true)
 	config.Set("rpc.cors_allowed_origins", []string{"*"})
 	config.Set("api.address", apiAddr)
 	config.Set("grpc.address", conf.Host.GRPC)
+	config.Set("grpc-web.enable", true)
+	config.Set("grpc-web.enable-unsafe-cors", true)
 	config.Set("grpc-web.address", conf.Host.GRPCWeb)
+	config.Set("rosetta.enable", conf.Rosetta.Enabled)
+	if conf.Rosetta.Enabled {
+		config.Set("rosetta.address", conf.Host.Rosetta)
+		config.Set("rosetta.blockchain", conf.Rosetta.Blockchain)
+		config.Set("rosetta.network", conf.Rosetta.Network)
+	}
 
 	staked, err := sdktypes.ParseCoinNormalized(conf.Validator.Staked)
 	if err != nil {
@@ -131,7 +143,7 @@ func (p *stargatePlugin) configTOML(homePath string, conf chainconfig.Config) er
 	return err
 }
 
-func (p *stargatePlugin) clientTOML(homePath string) error {
+func (p *stargatePlugin) clientTOML(homePath string, conf chainconfig.Config) error {
 	path := filepath.Join(homePath, "config/client.toml")
 	config, err := toml.LoadFile(path)
 	if os.IsNotExist(err) {
@@ -140,8 +152,15 @@ func (p *stargatePlugin) clientTOML(homePath string) error {
 	if err != nil {
 		return err
 	}
+	// the commands of the binary query the node on its rpc address.
+	rpcAddr, err := xurl.TCP(conf.Host.RPC)
+	if err != nil {
+		return fmt.Errorf("invalid rpc address format %s: %w", conf.Host.RPC, err)
+	}
+
 	config.Set("keyring-backend", "test")
 	config.Set("broadcast-mode", "block")
+	config.Set("node", rpcAddr)
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0644)
 	if err != nil {
 		return err
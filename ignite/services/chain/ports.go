@@ -0,0 +1,75 @@
+package chain
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+var portsDocTemplate = template.Must(template.New("ports").Parse(`# Ports of {{ .Name }}
+
+| Server | Address | Port | Config | Reachable from other machines |
+| ------ | ------- | ---- | ------ | ----------------------------- |
+{{ range .Servers }}| {{ .Name }} | {{ .Address }} | {{ .Port }} | {{ .Key }} | {{ if .IsExposed }}yes{{ else }}no{{ end }} |
+{{ end }}
+The faucet is only started when it is enabled in config.yml, the frontend is started with "npm run dev" in the vue
+directory.
+{{ if .Exposed }}
+## Firewall
+
+Open the ports of the servers reachable from other machines, only to trusted networks.
+
+With ufw:
+
+` + "```" + `
+{{ range .Exposed }}sudo ufw allow {{ .Port }}/tcp comment '{{ .Name }}'
+{{ end }}` + "```" + `
+
+With iptables:
+
+` + "```" + `
+{{ range .Exposed }}sudo iptables -A INPUT -p tcp --dport {{ .Port }} -j ACCEPT
+{{ end }}` + "```" + `
+{{ end }}
+## Dev container
+
+Forward the ports in devcontainer.json:
+
+` + "```json" + `
+"forwardPorts": [{{ range $i, $s := .Servers }}{{ if $i }}, {{ end }}{{ $s.Port }}{{ end }}]
+` + "```" + `
+`))
+
+// PortsDoc returns the markdown documentation of the ports of the servers started when the chain
+// is served, with the config keys setting them, the firewall rules opening them and the dev
+// container ports to forward.
+func (c *Chain) PortsDoc() ([]byte, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+	return portsDoc(c.Name(), conf)
+}
+
+func portsDoc(name string, conf chainconfig.Config) ([]byte, error) {
+	data := struct {
+		Name    string
+		Servers []chainconfig.Server
+		Exposed []chainconfig.Server
+	}{
+		Name:    name,
+		Servers: chainconfig.Servers(conf),
+	}
+	for _, server := range data.Servers {
+		if server.IsExposed() {
+			data.Exposed = append(data.Exposed, server)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := portsDocTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
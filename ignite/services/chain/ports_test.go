@@ -0,0 +1,65 @@
+package chain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestPortsDoc(t *testing.T) {
+	conf := chainconfig.DefaultConf
+	conf.Host.RPC = "tcp://127.0.0.1:26657"
+
+	doc, err := portsDoc("mars", conf)
+	require.NoError(t, err)
+	require.Contains(t, string(doc), "# Ports of mars")
+	require.Contains(t, string(doc), "| Tendermint RPC | tcp://127.0.0.1:26657 | 26657 | host.rpc | no |")
+	require.Contains(t, string(doc), "| API | 0.0.0.0:1317 | 1317 | host.api | yes |")
+	require.Contains(t, string(doc), "| Frontend | localhost:3000 | 3000 | host.frontend | no |")
+	require.Contains(t, string(doc), "sudo ufw allow 1317/tcp comment 'API'")
+	require.NotContains(t, string(doc), "sudo ufw allow 26657/tcp")
+	require.Contains(t, string(doc), `"forwardPorts": [26657, 26656, 6060, 9090, 9091, 1317, 4500, 3000]`)
+}
+
+func TestPortsDocNotExposed(t *testing.T) {
+	conf, err := chainconfig.Parse(strings.NewReader(`
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+validator:
+  name: alice
+  staked: "100000000stake"
+host:
+  bind: "::1"
+`))
+	require.NoError(t, err)
+
+	doc, err := portsDoc("mars", conf)
+	require.NoError(t, err)
+	require.Contains(t, string(doc), "| API | [::1]:1317 | 1317 | host.api | no |")
+	require.Contains(t, string(doc), "| Faucet | [::1]:4500 | 4500 | faucet.host | no |")
+	require.NotContains(t, string(doc), "## Firewall")
+}
+
+func TestPortsDocInitOverwrite(t *testing.T) {
+	conf, err := chainconfig.Parse(strings.NewReader(`
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+validator:
+  name: alice
+  staked: "100000000stake"
+init:
+  app:
+    api:
+      address: "tcp://127.0.0.1:1318"
+`))
+	require.NoError(t, err)
+
+	doc, err := portsDoc("mars", conf)
+	require.NoError(t, err)
+	require.Contains(t, string(doc), "| API | tcp://127.0.0.1:1318 | 1318 | init.app.api.address | no |")
+}
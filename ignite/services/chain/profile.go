@@ -0,0 +1,182 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// DefaultProfileInterval is the default interval between two captures of the profiles.
+	DefaultProfileInterval = time.Minute
+
+	// MinProfileInterval is the minimum interval between two captures of the profiles,
+	// a capture lasts as long as the CPU profile.
+	MinProfileInterval = profileCPUDuration
+
+	// profilesDir is the dir of the app where the profiles are saved.
+	profilesDir = "profiles"
+
+	// profileCPUDuration is the duration of a CPU profile.
+	profileCPUDuration = 10 * time.Second
+
+	// profileTopCount is the number of functions listed by each section of the report.
+	profileTopCount = 20
+
+	profileCPUFile    = "cpu.pprof"
+	profileHeapFile   = "heap.pprof"
+	profileReportFile = "report.md"
+)
+
+// ServeProfile enables the profiling mode, the CPU and heap profiles of the node are captured
+// at each interval and saved in the profiles dir of the app with a report of their top consumers.
+func ServeProfile(interval time.Duration) ServeOption {
+	return func(c *serveOptions) {
+		c.profileInterval = interval
+	}
+}
+
+// runProfiler captures the profiles of the node at each interval until ctx is canceled.
+// The failed captures are reported without stopping the node.
+func (c *Chain) runProfiler(ctx context.Context, conf chainconfig.Config, rpcAddr string, interval time.Duration) error {
+	profAddr, err := xurl.HTTP(conf.Host.Prof)
+	if err != nil {
+		return err
+	}
+
+	if err := isNodeListening(ctx, rpcAddr); err != nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		dir, err := c.captureProfiles(ctx, profAddr)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil:
+			fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(fmt.Sprintf("cannot capture the profiles: %s", err)))
+		default:
+			rel, _ := filepath.Rel(c.app.Path, dir)
+			fmt.Fprintf(c.stdLog().out, "📊 Profiles saved in %s, see %s\n", rel, filepath.Join(rel, profileReportFile))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// captureProfiles saves the CPU and heap profiles of the node in a new dir of the profiles
+// dir with their report and returns the path of the dir.
+func (c *Chain) captureProfiles(ctx context.Context, profAddr string) (string, error) {
+	capturedAt := time.Now()
+	dir := filepath.Join(c.app.Path, profilesDir, capturedAt.Format("20060102-150405"))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	cpuURL := fmt.Sprintf("%s/debug/pprof/profile?seconds=%d", profAddr, int(profileCPUDuration.Seconds()))
+	if err := downloadProfile(ctx, cpuURL, filepath.Join(dir, profileCPUFile)); err != nil {
+		return "", err
+	}
+
+	heapURL := fmt.Sprintf("%s/debug/pprof/heap", profAddr)
+	if err := downloadProfile(ctx, heapURL, filepath.Join(dir, profileHeapFile)); err != nil {
+		return "", err
+	}
+
+	report, err := c.profileReport(ctx, dir, capturedAt)
+	if err != nil {
+		return "", err
+	}
+
+	return dir, os.WriteFile(filepath.Join(dir, profileReportFile), report, 0644)
+}
+
+// downloadProfile saves the profile served at url to path.
+func downloadProfile(ctx context.Context, url, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot get %s: %s", url, res.Status)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, res.Body)
+	return err
+}
+
+// profileReport returns the report of the profiles saved in dir. The report lists the top
+// consumers of the app, such as its keepers, and then the top consumers of the whole node.
+func (c *Chain) profileReport(ctx context.Context, dir string, capturedAt time.Time) ([]byte, error) {
+	var (
+		report  bytes.Buffer
+		appShow = fmt.Sprintf("-show=^%s/", regexp.QuoteMeta(c.app.ImportPath))
+	)
+
+	fmt.Fprintf(&report, "# Profiles of %s\n\n", c.app.Name)
+	fmt.Fprintf(
+		&report,
+		"Captured at %s, the CPU profile covers %s.\n\n",
+		capturedAt.Format(time.RFC1123),
+		profileCPUDuration,
+	)
+
+	sections := []struct {
+		title   string
+		profile string
+		flags   []string
+	}{
+		{"Top CPU consumers in your modules", profileCPUFile, []string{appShow}},
+		{"Top memory consumers in your modules", profileHeapFile, []string{appShow}},
+		{"Top CPU consumers of the node", profileCPUFile, nil},
+		{"Top memory consumers of the node", profileHeapFile, nil},
+	}
+	for _, s := range sections {
+		var top bytes.Buffer
+		flags := append([]string{fmt.Sprintf("-nodecount=%d", profileTopCount)}, s.flags...)
+		err := gocmd.PprofTop(ctx, filepath.Join(dir, s.profile), flags, exec.StepOption(step.Stdout(&top)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot summarize the %s profile", s.profile)
+		}
+
+		fmt.Fprintf(&report, "## %s\n\n```\n%s```\n\n", s.title, top.String())
+	}
+
+	fmt.Fprintf(&report, "Explore the profiles in the browser with:\n\n```\ngo tool pprof -http=: %s\n```\n", filepath.Join(dir, profileCPUFile))
+
+	return report.Bytes(), nil
+}
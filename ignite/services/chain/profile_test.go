@@ -0,0 +1,30 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileReport(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{profileCPUFile, profileHeapFile} {
+		f, err := os.Create(filepath.Join(dir, name))
+		require.NoError(t, err)
+		require.NoError(t, pprof.WriteHeapProfile(f))
+		require.NoError(t, f.Close())
+	}
+
+	c := &Chain{app: App{Name: "mars", Path: "/home/foo/mars", ImportPath: "github.com/foo/mars"}}
+	report, err := c.profileReport(context.Background(), dir, time.Now())
+	require.NoError(t, err)
+	require.Contains(t, string(report), "# Profiles of mars")
+	require.Contains(t, string(report), "## Top CPU consumers in your modules")
+	require.Contains(t, string(report), "## Top memory consumers of the node")
+	require.Contains(t, string(report), "go tool pprof -http=: "+filepath.Join(dir, profileCPUFile))
+}
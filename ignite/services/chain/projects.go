@@ -0,0 +1,113 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+// localProjectsPath is the file listing the projects served locally
+var localProjectsPath = xfilepath.Join(
+	chainconfig.ConfigDirPath,
+	xfilepath.Path("projects.yml"),
+)
+
+// LocalProject is a chain project served locally.
+type LocalProject struct {
+	// Path is the path of the source of the project.
+	Path string `yaml:"path"`
+
+	// ChainID is the id of the served chain.
+	ChainID string `yaml:"chain_id"`
+
+	// Home is the home directory of the served chain.
+	Home string `yaml:"home"`
+
+	// Binary is the name of the binary of the chain.
+	Binary string `yaml:"binary"`
+}
+
+// StatePath returns the path where the state of the project chain is saved.
+func (p LocalProject) StatePath() (string, error) {
+	savePath, err := starportSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, p.ChainID), nil
+}
+
+// IsStale returns true when the source of the project no longer exists.
+func (p LocalProject) IsStale() (bool, error) {
+	_, err := os.Stat(filepath.Join(p.Path, "go.mod"))
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	return false, err
+}
+
+// LocalProjects returns the projects served locally.
+func LocalProjects() ([]LocalProject, error) {
+	path, err := localProjectsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []LocalProject
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, path).Load(&projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// SaveLocalProjects replaces the list of projects served locally.
+func SaveLocalProjects(projects []LocalProject) error {
+	path, err := localProjectsPath()
+	if err != nil {
+		return err
+	}
+
+	return confile.New(confile.DefaultYAMLEncodingCreator, path).Save(projects)
+}
+
+// registerLocalProject adds the chain to the list of projects served locally.
+func (c *Chain) registerLocalProject() error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+
+	project := LocalProject{
+		Path:    c.app.Path,
+		ChainID: chainID,
+		Home:    home,
+		Binary:  binary,
+	}
+
+	projects, err := LocalProjects()
+	if err != nil {
+		return err
+	}
+	for i, p := range projects {
+		if p.Path == project.Path && p.Home == project.Home {
+			if p == project {
+				return nil
+			}
+			projects[i] = project
+			return SaveLocalProjects(projects)
+		}
+	}
+
+	return SaveLocalProjects(append(projects, project))
+}
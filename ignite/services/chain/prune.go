@@ -0,0 +1,121 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosstore"
+)
+
+// PruneResult describes the data pruned from the nodes of the chain.
+type PruneResult struct {
+	// States is the number of heights of the state pruned.
+	States int
+
+	// Blocks is the number of blocks pruned.
+	Blocks uint64
+
+	// SizeBefore and SizeAfter are the sizes in bytes of the data dirs before and after the pruning.
+	SizeBefore, SizeAfter int64
+}
+
+// Prune deletes the states and the blocks of the nodes of the chain that aren't kept by the pruning of
+// the config and compacts their databases to reclaim the disk space. The data of the validators
+// served with the chain are pruned as well. The chain must not be running since the nodes lock their data.
+func (c *Chain) Prune() (PruneResult, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return PruneResult{}, err
+	}
+	isInit, err := c.IsInitialized()
+	if err != nil {
+		return PruneResult{}, err
+	}
+	if !isInit {
+		return PruneResult{}, errors.New("the chain is not initialized, serve it before pruning its data")
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return PruneResult{}, err
+	}
+	homes, err := filepath.Glob(filepath.Join(home, localnetDir, "*"))
+	if err != nil {
+		return PruneResult{}, err
+	}
+	homes = append([]string{home}, homes...)
+
+	var (
+		pruning = conf.Validator.Pruning.WithDefaultStrategy()
+		result  PruneResult
+	)
+	for _, home := range homes {
+		dataDir := filepath.Join(home, "data")
+		if _, err := os.Stat(dataDir); os.IsNotExist(err) {
+			continue
+		}
+		r, err := pruneData(dataDir, pruning)
+		if err != nil {
+			return PruneResult{}, fmt.Errorf("cannot prune %s: %w", dataDir, err)
+		}
+		result.States += r.States
+		result.Blocks += r.Blocks
+		result.SizeBefore += r.SizeBefore
+		result.SizeAfter += r.SizeAfter
+	}
+
+	return result, nil
+}
+
+// pruneData prunes the states and the blocks of the data dir of a node and compacts its databases.
+func pruneData(dataDir string, pruning chainconfig.Pruning) (result PruneResult, err error) {
+	if result.SizeBefore, err = cosmosstore.Size(dataDir); err != nil {
+		return PruneResult{}, err
+	}
+	if result.States, err = cosmosstore.PruneStates(dataDir, statePruningOptions(pruning)); err != nil {
+		return PruneResult{}, err
+	}
+	if result.Blocks, err = cosmosstore.PruneBlocks(dataDir, int64(pruning.MinRetainBlocks)); err != nil {
+		return PruneResult{}, err
+	}
+	if err := cosmosstore.Compact(dataDir); err != nil {
+		return PruneResult{}, err
+	}
+	if result.SizeAfter, err = cosmosstore.Size(dataDir); err != nil {
+		return PruneResult{}, err
+	}
+	return result, nil
+}
+
+// statePruningOptions returns the options of the Cosmos SDK pruning the state with the strategy.
+func statePruningOptions(pruning chainconfig.Pruning) storetypes.PruningOptions {
+	if pruning.Strategy == chainconfig.PruningCustom {
+		return storetypes.NewPruningOptions(pruning.KeepRecent, 0, pruning.Interval)
+	}
+	return storetypes.NewPruningOptionsFromString(pruning.Strategy)
+}
+
+// pruningConfig returns the app.toml changes setting the pruning and the snapshots from the config.
+func pruningConfig(validator chainconfig.Validator) map[string]interface{} {
+	pruning := validator.Pruning.WithDefaultStrategy()
+	app := map[string]interface{}{
+		"pruning":           pruning.Strategy,
+		"min-retain-blocks": pruning.MinRetainBlocks,
+		"state-sync": map[string]interface{}{
+			"snapshot-interval": pruning.SnapshotInterval,
+		},
+	}
+
+	// app.toml holds the numbers of the custom strategy as strings.
+	if pruning.Strategy == chainconfig.PruningCustom {
+		app["pruning-keep-recent"] = fmt.Sprint(pruning.KeepRecent)
+		app["pruning-keep-every"] = "0"
+		app["pruning-interval"] = fmt.Sprint(pruning.Interval)
+	}
+	return app
+}
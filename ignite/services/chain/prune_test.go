@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestPruningConfig(t *testing.T) {
+	require.Equal(t, map[string]interface{}{
+		"pruning":             "custom",
+		"pruning-keep-recent": "100",
+		"pruning-keep-every":  "0",
+		"pruning-interval":    "10",
+		"min-retain-blocks":   uint64(0),
+		"state-sync":          map[string]interface{}{"snapshot-interval": uint64(0)},
+	}, pruningConfig(chainconfig.Validator{}))
+
+	require.Equal(t, map[string]interface{}{
+		"pruning":           "nothing",
+		"min-retain-blocks": uint64(1000),
+		"state-sync":        map[string]interface{}{"snapshot-interval": uint64(500)},
+	}, pruningConfig(chainconfig.Validator{
+		Pruning: chainconfig.Pruning{
+			Strategy:         chainconfig.PruningNothing,
+			MinRetainBlocks:  1000,
+			SnapshotInterval: 500,
+		},
+	}))
+}
+
+func TestStatePruningOptions(t *testing.T) {
+	require.Equal(t, storetypes.NewPruningOptions(100, 0, 10), statePruningOptions(chainconfig.DefaultPruning))
+	require.Equal(t, storetypes.PruneEverything, statePruningOptions(chainconfig.Pruning{Strategy: chainconfig.PruningEverything}))
+	require.Equal(t, storetypes.PruneNothing, statePruningOptions(chainconfig.Pruning{Strategy: chainconfig.PruningNothing}))
+}
@@ -0,0 +1,115 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+)
+
+// releaseDockerfile is the name of the Dockerfile packaging the release.
+const releaseDockerfile = "Dockerfile"
+
+// releaseDockerfileTemplate packages the tarball of a linux target of the release, the target is
+// selected by the platform of the image, e.g. docker buildx build --platform linux/arm64.
+var releaseDockerfileTemplate = template.Must(template.New(releaseDockerfile).Parse(`# Code generated by Ignite CLI. DO NOT EDIT.
+# The image of the release, for the linux targets {{ range $i, $arch := .Archs }}{{ if $i }}, {{ end }}{{ $arch }}{{ end }}.
+FROM debian:bullseye-slim
+
+ARG TARGETOS=linux
+ARG TARGETARCH={{ index .Archs 0 }}
+
+RUN apt-get update && apt-get install -y --no-install-recommends ca-certificates && rm -rf /var/lib/apt/lists/*
+
+ADD {{ .Prefix }}_${TARGETOS}_${TARGETARCH}.tar.gz /opt/{{ .Binary }}/
+RUN ln -s /opt/{{ .Binary }}/{{ .Binary }} /usr/local/bin/{{ .Binary }}
+
+# p2p, rpc, api and grpc
+EXPOSE 26656 26657 1317 9090
+
+ENTRYPOINT ["{{ .Binary }}"]
+CMD ["start"]
+`))
+
+// releaseOptions configures the build of a release.
+type releaseOptions struct {
+	version    string
+	dockerfile bool
+	image      string
+}
+
+// ReleaseOption configures the build of a release.
+type ReleaseOption func(*releaseOptions)
+
+// ReleaseWithVersion sets the version embedded in the binaries of the release, the git tag of the
+// app by default.
+func ReleaseWithVersion(version string) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.version = version
+	}
+}
+
+// ReleaseWithDockerfile adds a Dockerfile to the release, packaging the tarballs of its linux
+// targets in an image.
+func ReleaseWithDockerfile() ReleaseOption {
+	return func(o *releaseOptions) {
+		o.dockerfile = true
+	}
+}
+
+// ReleaseWithImage builds the image of the release named image with docker, the Dockerfile is
+// added to the release.
+func ReleaseWithImage(image string) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.image = image
+	}
+}
+
+// releaseDockerTargets returns the archs of the linux targets packaged by the Dockerfile.
+func releaseDockerTargets(targets []string) (archs []string, err error) {
+	for _, t := range targets {
+		goos, goarch, err := gocmd.ParseTarget(t)
+		if err != nil {
+			return nil, err
+		}
+		if goos == "linux" {
+			archs = append(archs, goarch)
+		}
+	}
+	if len(archs) == 0 {
+		return nil, fmt.Errorf("the Dockerfile of the release requires a linux target, e.g. linux:amd64")
+	}
+	return archs, nil
+}
+
+// writeReleaseDockerfile writes the Dockerfile of the release in releasePath, it packages the
+// binary of the tarballs named after prefix for the linux archs.
+func writeReleaseDockerfile(releasePath, prefix, binary string, archs []string) error {
+	f, err := os.Create(filepath.Join(releasePath, releaseDockerfile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return releaseDockerfileTemplate.Execute(f, struct {
+		Prefix string
+		Binary string
+		Archs  []string
+	}{prefix, binary, archs})
+}
+
+// buildReleaseImage builds the image of the release in releasePath with docker.
+func buildReleaseImage(ctx context.Context, releasePath, image string, out io.Writer) error {
+	return cmdrunner.New().Run(ctx, step.New(
+		step.Exec("docker", "build", "--tag", image, "."),
+		step.Workdir(releasePath),
+		step.Stdout(out),
+		step.Stderr(out),
+	))
+}
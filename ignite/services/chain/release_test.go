@@ -0,0 +1,34 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseDockerTargets(t *testing.T) {
+	archs, err := releaseDockerTargets([]string{"darwin:arm64", "linux:amd64", "linux:arm64"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"amd64", "arm64"}, archs)
+
+	_, err = releaseDockerTargets([]string{"darwin:arm64"})
+	require.EqualError(t, err, "the Dockerfile of the release requires a linux target, e.g. linux:amd64")
+
+	_, err = releaseDockerTargets([]string{"linux"})
+	require.Error(t, err)
+}
+
+func TestWriteReleaseDockerfile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, writeReleaseDockerfile(dir, "mars_v1.0.0", "marsd", []string{"arm64", "amd64"}))
+
+	dockerfile, err := os.ReadFile(filepath.Join(dir, releaseDockerfile))
+	require.NoError(t, err)
+	require.Contains(t, string(dockerfile), "# The image of the release, for the linux targets arm64, amd64.\n")
+	require.Contains(t, string(dockerfile), "ARG TARGETARCH=arm64\n")
+	require.Contains(t, string(dockerfile), "ADD mars_v1.0.0_${TARGETOS}_${TARGETARCH}.tar.gz /opt/marsd/\n")
+	require.Contains(t, string(dockerfile), "RUN ln -s /opt/marsd/marsd /usr/local/bin/marsd\n")
+	require.Contains(t, string(dockerfile), `ENTRYPOINT ["marsd"]`)
+}
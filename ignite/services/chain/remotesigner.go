@@ -0,0 +1,63 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/remotesigner"
+)
+
+// remoteSignerConfig returns the config.toml changes making the node listen for the remote signer
+// of the validator, the address is cleared when the validator signs with the key of the node.
+func remoteSignerConfig(validator chainconfig.Validator) map[string]interface{} {
+	return map[string]interface{}{"priv_validator_laddr": validator.RemoteSigner.Address}
+}
+
+// localnetRemoteSigner returns the remote signer of the validator of the local network at index,
+// the port of its address is shifted by the index of the validator.
+func localnetRemoteSigner(signer chainconfig.RemoteSigner, index int) (chainconfig.RemoteSigner, error) {
+	if !signer.IsSet() {
+		return signer, nil
+	}
+	if strings.HasPrefix(signer.Address, "unix://") {
+		return chainconfig.RemoteSigner{}, fmt.Errorf(
+			"the remote signers of the local network must listen on tcp:// addresses, not %q",
+			signer.Address,
+		)
+	}
+	address, err := shiftPort(signer.Address, index*localnetPortOffset)
+	if err != nil {
+		return chainconfig.RemoteSigner{}, err
+	}
+	signer.Address = address
+	return signer, nil
+}
+
+// runMockSigner runs the mock remote signer of the validator of home until ctx is canceled, it signs
+// with the key of the node.
+func (c *Chain) runMockSigner(ctx context.Context, home string, signer chainconfig.RemoteSigner) error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+
+	s, err := remotesigner.New(
+		signer.Address,
+		chainID,
+		filepath.Join(home, "config/priv_validator_key.json"),
+		filepath.Join(home, "data/priv_validator_state.json"),
+		remotesigner.WithLogger(c.logger),
+	)
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	if err := s.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
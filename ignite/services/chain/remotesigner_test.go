@@ -0,0 +1,30 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestRemoteSignerConfig(t *testing.T) {
+	validator := chainconfig.Validator{RemoteSigner: chainconfig.RemoteSigner{Address: "tcp://127.0.0.1:26659"}}
+	require.Equal(t, map[string]interface{}{"priv_validator_laddr": "tcp://127.0.0.1:26659"}, remoteSignerConfig(validator))
+
+	// the address is cleared when the remote signer is removed from the config.
+	require.Equal(t, map[string]interface{}{"priv_validator_laddr": ""}, remoteSignerConfig(chainconfig.Validator{}))
+}
+
+func TestLocalnetRemoteSigner(t *testing.T) {
+	signer, err := localnetRemoteSigner(chainconfig.RemoteSigner{Address: "tcp://127.0.0.1:26659", Mock: true}, 2)
+	require.NoError(t, err)
+	require.Equal(t, chainconfig.RemoteSigner{Address: "tcp://127.0.0.1:26679", Mock: true}, signer)
+
+	signer, err = localnetRemoteSigner(chainconfig.RemoteSigner{}, 2)
+	require.NoError(t, err)
+	require.False(t, signer.IsSet())
+
+	_, err = localnetRemoteSigner(chainconfig.RemoteSigner{Address: "unix:///tmp/signer.sock"}, 1)
+	require.EqualError(t, err, `the remote signers of the local network must listen on tcp:// addresses, not "unix:///tmp/signer.sock"`)
+}
@@ -0,0 +1,123 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// ResetScope defines which part of the app state is reset.
+type ResetScope string
+
+const (
+	// ResetData resets the blockchain data while keeping the keys and the genesis.
+	ResetData ResetScope = "data"
+
+	// ResetGenesis rebuilds the genesis from the config while keeping the keys.
+	// Since the genesis defines the initial state, the blockchain data is reset too.
+	ResetGenesis ResetScope = "genesis"
+
+	// ResetKeys recreates the keys of the accounts from the config.
+	// Since the genesis references the accounts, the genesis and the blockchain data are reset too.
+	ResetKeys ResetScope = "keys"
+)
+
+// resetScopePriorities orders the reset scopes from the least to the most destructive one.
+var resetScopePriorities = map[ResetScope]int{
+	ResetData:    1,
+	ResetGenesis: 2,
+	ResetKeys:    3,
+}
+
+// ParseResetScope parses a reset scope from its name.
+func ParseResetScope(name string) (ResetScope, error) {
+	scope := ResetScope(name)
+	if _, ok := resetScopePriorities[scope]; !ok {
+		return "", fmt.Errorf("invalid reset scope %q, accepted values are %q, %q and %q", name, ResetData, ResetGenesis, ResetKeys)
+	}
+	return scope, nil
+}
+
+// widestResetScope returns the most destructive scope of the list.
+// An empty scope is returned when the list is empty.
+func widestResetScope(scopes []ResetScope) (widest ResetScope) {
+	for _, scope := range scopes {
+		if resetScopePriorities[scope] > resetScopePriorities[widest] {
+			widest = scope
+		}
+	}
+	return widest
+}
+
+// ResetPaths returns the paths that are deleted when the app state is reset with the scopes.
+func (c *Chain) ResetPaths(scopes ...ResetScope) ([]string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	scope := widestResetScope(scopes)
+	if scope == ResetKeys {
+		// keys can only be recreated by initializing the whole home
+		return []string{home}, nil
+	}
+
+	paths := []string{filepath.Join(home, "data")}
+	if scope == ResetGenesis {
+		genesisPath, err := c.GenesisPath()
+		if err != nil {
+			return nil, err
+		}
+		gentxsPath, err := c.GentxsPath()
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, genesisPath, gentxsPath)
+	}
+
+	return paths, nil
+}
+
+// resetGenesis resets the blockchain data and rebuilds the genesis from the config.
+// Keys already existing in the keyring are used for the genesis accounts.
+func (c *Chain) resetGenesis(ctx context.Context, conf chainconfig.Config) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := commands.UnsafeReset(ctx); err != nil {
+		return err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(genesisPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	gentxsPath, err := c.GentxsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(gentxsPath); err != nil {
+		return err
+	}
+
+	if err := c.initNode(ctx); err != nil {
+		return errors.Wrap(err, "cannot rebuild the genesis")
+	}
+
+	// the accounts can't be added to an existing genesis
+	if conf.Init.Genesis.IsSet() {
+		return nil
+	}
+	return c.initAccounts(ctx, conf, true)
+}
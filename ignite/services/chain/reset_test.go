@@ -0,0 +1,25 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResetScope(t *testing.T) {
+	for _, name := range []string{"data", "genesis", "keys"} {
+		scope, err := ParseResetScope(name)
+		require.NoError(t, err)
+		require.EqualValues(t, name, scope)
+	}
+
+	_, err := ParseResetScope("foo")
+	require.Error(t, err)
+}
+
+func TestWidestResetScope(t *testing.T) {
+	require.EqualValues(t, "", widestResetScope(nil))
+	require.Equal(t, ResetData, widestResetScope([]ResetScope{ResetData}))
+	require.Equal(t, ResetGenesis, widestResetScope([]ResetScope{ResetData, ResetGenesis}))
+	require.Equal(t, ResetKeys, widestResetScope([]ResetScope{ResetKeys, ResetData}))
+}
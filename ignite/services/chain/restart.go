@@ -0,0 +1,186 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/sourcechange"
+)
+
+// sourceSnapshotKey is the cache key for the snapshot of the source to classify its changes.
+const sourceSnapshotKey = "source_snapshot"
+
+// restartMode is how the served chain restarts after a change of its source.
+type restartMode int
+
+const (
+	// restartRestore restores the state from the exported genesis, the changes are unknown.
+	restartRestore restartMode = iota
+
+	// restartKeep keeps the state, the changes don't affect its layout.
+	restartKeep
+
+	// restartPatchParams restores the state from the exported genesis with the new default
+	// params of the modules.
+	restartPatchParams
+
+	// restartReset initializes the chain again, the changes break the layout of the state.
+	restartReset
+)
+
+func (m restartMode) String() string {
+	switch m {
+	case restartKeep:
+		return "keep"
+	case restartPatchParams:
+		return "patch_params"
+	case restartReset:
+		return "reset"
+	default:
+		return "restore"
+	}
+}
+
+// decideRestart decides how the chain restarts from the changes of the source since the last
+// serve and explains the decision. It returns the modules whose default params changed.
+func (c *Chain) decideRestart(dirCache cache.Cache[[]byte]) (mode restartMode, paramsModules []string, err error) {
+	saved, err := dirCache.Get(sourceSnapshotKey)
+	if err == cache.ErrorNotFound {
+		return restartRestore, nil, nil
+	}
+	if err != nil {
+		return restartRestore, nil, err
+	}
+	var old sourcechange.Snapshot
+	if err := json.Unmarshal(saved, &old); err != nil {
+		return restartRestore, nil, nil
+	}
+	snapshot, err := sourcechange.Take(c.app.Path, appBackendSourceWatchPaths...)
+	if err != nil {
+		return restartRestore, nil, err
+	}
+
+	changes := sourcechange.Compare(old, snapshot)
+	widest, ok := sourcechange.Widest(changes)
+	if !ok {
+		fmt.Fprintln(c.stdLog().out, "🔄 The changes don't affect the app, restarting the app with its state...")
+		c.logger.Info("source changed without changes of the declarations", "restart", restartKeep)
+		return restartKeep, nil, nil
+	}
+
+	c.logger.Info("source changed", "change", widest.String(), "kind", widest.Kind.String(), "changes", len(changes))
+
+	switch widest.Kind {
+	case sourcechange.KindStore:
+		fmt.Fprintf(c.stdLog().out, "🔄 %s changes the layout of the state, resetting the app state...\n", widest)
+		return restartReset, nil, nil
+
+	case sourcechange.KindParams:
+		seen := make(map[string]bool)
+		for _, change := range changes {
+			if module := change.Module(); change.Kind == sourcechange.KindParams && !seen[module] {
+				seen[module] = true
+				paramsModules = append(paramsModules, module)
+			}
+		}
+		fmt.Fprintf(c.stdLog().out, "🔄 %s changes the default params, patching the params of the genesis...\n", widest)
+		return restartPatchParams, paramsModules, nil
+
+	default:
+		fmt.Fprintf(c.stdLog().out, "🔄 %s is a %s change, restarting the app with its state...\n", widest, widest.Kind)
+		return restartKeep, nil, nil
+	}
+}
+
+// saveSourceSnapshot saves the snapshot of the source to classify its next changes.
+func (c *Chain) saveSourceSnapshot(dirCache cache.Cache[[]byte]) error {
+	snapshot, err := sourcechange.Take(c.app.Path, appBackendSourceWatchPaths...)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return dirCache.Put(sourceSnapshotKey, data)
+}
+
+// patchGenesisParams replaces the params of the modules in the genesis by their new default
+// params, generated by the binary in a temporary home. The params overwritten by the config
+// are kept.
+func (c *Chain) patchGenesisParams(ctx context.Context, commands chaincmdrunner.Runner, conf chainconfig.Config, modules []string) error {
+	home, err := os.MkdirTemp("", "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(home)
+
+	runner, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return err
+	}
+	if err := runner.Init(ctx, moniker); err != nil {
+		return errors.Wrap(err, "cannot generate the default params")
+	}
+	defaults, err := readGenesisMap(filepath.Join(home, "config", "genesis.json"))
+	if err != nil {
+		return err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	genesis, err := readGenesisMap(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	defaultState, _ := defaults["app_state"].(map[string]interface{})
+	state, _ := genesis["app_state"].(map[string]interface{})
+	overrides, _ := conf.Genesis["app_state"].(map[string]interface{})
+	for _, module := range modules {
+		defaultModule, _ := defaultState[module].(map[string]interface{})
+		moduleState, _ := state[module].(map[string]interface{})
+		params, ok := defaultModule["params"].(map[string]interface{})
+		if !ok || moduleState == nil {
+			continue
+		}
+		if override, ok := overrides[module].(map[string]interface{}); ok {
+			if overrideParams, ok := override["params"].(map[string]interface{}); ok {
+				for key, value := range overrideParams {
+					params[key] = value
+				}
+			}
+		}
+		moduleState["params"] = params
+	}
+
+	data, err := json.MarshalIndent(genesis, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(genesisPath, data, 0644)
+}
+
+// readGenesisMap reads the genesis at path.
+func readGenesisMap(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var genesis map[string]interface{}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return nil, fmt.Errorf("invalid genesis %s: %w", path, err)
+	}
+	return genesis, nil
+}
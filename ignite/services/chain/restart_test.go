@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+)
+
+func TestDecideRestart(t *testing.T) {
+	var (
+		app = t.TempDir()
+		c   = &Chain{app: App{Name: "mars", Path: app}, logger: log.NewNopLogger(), stdout: io.Discard, stderr: io.Discard}
+	)
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+	dirCache := cache.New[[]byte](storage, serveDirchangeCacheNamespace)
+
+	write := func(file, content string) {
+		path := filepath.Join(app, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	write("x/mars/keeper/grpc_query.go", "package keeper\n\nfunc (k Keeper) Params() {}\n")
+	write("x/mars/types/params.go", "package types\n\nconst DefaultRate = 1\n")
+
+	// the state is restored when the changes are unknown.
+	mode, _, err := c.decideRestart(dirCache)
+	require.NoError(t, err)
+	require.Equal(t, restartRestore, mode)
+
+	require.NoError(t, c.saveSourceSnapshot(dirCache))
+	mode, _, err = c.decideRestart(dirCache)
+	require.NoError(t, err)
+	require.Equal(t, restartKeep, mode)
+
+	write("x/mars/keeper/grpc_query.go", "package keeper\n\nfunc (k Keeper) Params() { _ = 1 }\n")
+	mode, _, err = c.decideRestart(dirCache)
+	require.NoError(t, err)
+	require.Equal(t, restartKeep, mode)
+
+	write("x/mars/types/params.go", "package types\n\nconst DefaultRate = 2\n")
+	mode, modules, err := c.decideRestart(dirCache)
+	require.NoError(t, err)
+	require.Equal(t, restartPatchParams, mode)
+	require.Equal(t, []string{"mars"}, modules)
+
+	write("x/mars/types/keys.go", "package types\n\nconst StoreKey = \"mars\"\n")
+	mode, _, err = c.decideRestart(dirCache)
+	require.NoError(t, err)
+	require.Equal(t, restartReset, mode)
+}
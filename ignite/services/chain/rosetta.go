@@ -0,0 +1,20 @@
+package chain
+
+import (
+	"path/filepath"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// hasRosettaChanged returns true when the Rosetta gateway is enabled in the config but not in the
+// app.toml of the node in home, or the other way around, e.g. when it's toggled by the serve flag.
+func hasRosettaChanged(home string, conf chainconfig.Config) (bool, error) {
+	config, err := toml.LoadFile(filepath.Join(home, "config/app.toml"))
+	if err != nil {
+		return false, err
+	}
+	enabled, _ := config.Get("rosetta.enable").(bool)
+	return enabled != conf.Rosetta.Enabled, nil
+}
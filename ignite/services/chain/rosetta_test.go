@@ -0,0 +1,33 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestHasRosettaChanged(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(home, "config"), 0755))
+	appTOML := filepath.Join(home, "config/app.toml")
+
+	var conf chainconfig.Config
+	require.NoError(t, os.WriteFile(appTOML, []byte("[rosetta]\nenable = false\n"), 0644))
+	changed, err := hasRosettaChanged(home, conf)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	conf.Rosetta.Enabled = true
+	changed, err = hasRosettaChanged(home, conf)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	require.NoError(t, os.WriteFile(appTOML, []byte("[rosetta]\nenable = true\n"), 0644))
+	changed, err = hasRosettaChanged(home, conf)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
@@ -0,0 +1,296 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/goccy/go-yaml"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+const (
+	seedTxRetryDelay = time.Second
+	seedTxMaxRetry   = 30
+)
+
+// Seed is the test data of a chain, the bank sends and the objects of the scaffolded types
+// created by broadcasting their messages.
+type Seed struct {
+	// Bank are the tokens sent between the accounts.
+	Bank []SeedSend `yaml:"bank"`
+
+	// Objects are the objects of the scaffolded types.
+	Objects []SeedObject `yaml:"objects"`
+}
+
+// SeedSend sends tokens from an account of the chain.
+type SeedSend struct {
+	// Name references the send in the dependencies of the other entries.
+	Name string `yaml:"name"`
+
+	// From is the name of the sending account.
+	From string `yaml:"from"`
+
+	// To is the name or the address of the receiving account.
+	To string `yaml:"to"`
+
+	// Amount are the coins sent, e.g. `100token,20stake`.
+	Amount string `yaml:"amount"`
+
+	// DependsOn are the names of the entries created before the send.
+	DependsOn []string `yaml:"depends_on"`
+}
+
+// SeedObject creates an object of a scaffolded type with its create message.
+type SeedObject struct {
+	// Name references the object in the dependencies of the other entries.
+	Name string `yaml:"name"`
+
+	// Module is the module of the type.
+	Module string `yaml:"module"`
+
+	// Type is the name of the type, e.g. `post`.
+	Type string `yaml:"type"`
+
+	// From is the name of the account creating the object.
+	From string `yaml:"from"`
+
+	// Fields are the values of the indexes and the fields of the object by name.
+	Fields map[string]interface{} `yaml:"fields"`
+
+	// DependsOn are the names of the entries created before the object.
+	DependsOn []string `yaml:"depends_on"`
+}
+
+// seedEntry is a bank send or an object of the seed.
+type seedEntry struct {
+	name      string
+	dependsOn []string
+	send      *SeedSend
+	object    *SeedObject
+}
+
+// ParseSeed parses the seed file at path.
+func ParseSeed(path string) (Seed, error) {
+	var seed Seed
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return seed, err
+	}
+	if err := yaml.Unmarshal(data, &seed); err != nil {
+		return seed, fmt.Errorf("invalid seed file %s: %w", path, err)
+	}
+	return seed, nil
+}
+
+// Seed creates the bank sends and the objects of the seed on the running chain, the entries
+// are created in the order of their dependencies and each tx is committed before the next one.
+func (c *Chain) Seed(ctx context.Context, seed Seed) error {
+	entries, err := orderSeed(seed)
+	if err != nil {
+		return err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		var txHash string
+		switch {
+		case e.send != nil:
+			to, err := seedAddress(ctx, commands, e.send.To)
+			if err != nil {
+				return err
+			}
+			if txHash, err = commands.BankSend(ctx, e.send.From, to, e.send.Amount); err != nil {
+				return fmt.Errorf("cannot send %s to %s: %w", e.send.Amount, e.send.To, err)
+			}
+		default:
+			args, err := c.seedObjectArgs(*e.object)
+			if err != nil {
+				return err
+			}
+			typeName, err := multiformatname.NewName(e.object.Type)
+			if err != nil {
+				return err
+			}
+			command := "create-" + typeName.Kebab
+			if txHash, err = commands.Tx(ctx, e.object.From, e.object.Module, command, args...); err != nil {
+				return err
+			}
+		}
+
+		if err := commands.WaitTx(ctx, txHash, seedTxRetryDelay, seedTxMaxRetry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// orderSeed returns the entries of the seed in the order of their dependencies, the bank sends
+// are created before the objects when they don't depend on each other.
+func orderSeed(seed Seed) ([]seedEntry, error) {
+	var entries []seedEntry
+	for i := range seed.Bank {
+		send := &seed.Bank[i]
+		entries = append(entries, seedEntry{name: send.Name, dependsOn: send.DependsOn, send: send})
+	}
+	for i := range seed.Objects {
+		object := &seed.Objects[i]
+		if object.Module == "" || object.Type == "" || object.From == "" {
+			return nil, fmt.Errorf("the seed object %d requires a module, a type and a from account", i+1)
+		}
+		entries = append(entries, seedEntry{name: object.Name, dependsOn: object.DependsOn, object: object})
+	}
+
+	names := make(map[string]bool)
+	for _, e := range entries {
+		if e.name == "" {
+			continue
+		}
+		if names[e.name] {
+			return nil, fmt.Errorf("the seed entry %s is defined twice", e.name)
+		}
+		names[e.name] = true
+	}
+	for _, e := range entries {
+		for _, dep := range e.dependsOn {
+			if !names[dep] {
+				return nil, fmt.Errorf("the seed entry %s depends on the unknown entry %s", e.name, dep)
+			}
+		}
+	}
+
+	// the entries are added once their dependencies are created, in the order of the file.
+	var (
+		ordered []seedEntry
+		created = make(map[string]bool)
+		done    = make([]bool, len(entries))
+	)
+	for len(ordered) < len(entries) {
+		progress := false
+		for i, e := range entries {
+			if done[i] || !dependenciesCreated(e, created) {
+				continue
+			}
+			ordered = append(ordered, e)
+			done[i] = true
+			progress = true
+			if e.name != "" {
+				created[e.name] = true
+			}
+		}
+		if !progress {
+			return nil, fmt.Errorf("the seed entries have circular dependencies")
+		}
+	}
+
+	return ordered, nil
+}
+
+func dependenciesCreated(e seedEntry, created map[string]bool) bool {
+	for _, dep := range e.dependsOn {
+		if !created[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+// seedAddress returns the address of the account name or the address itself.
+func seedAddress(ctx context.Context, commands chaincmdrunner.Runner, nameOrAddress string) (string, error) {
+	if _, _, err := bech32.DecodeAndConvert(nameOrAddress); err == nil {
+		return nameOrAddress, nil
+	}
+	account, err := commands.ShowAccount(ctx, nameOrAddress)
+	if err != nil {
+		return "", fmt.Errorf("cannot find the account %s: %w", nameOrAddress, err)
+	}
+	return account.Address, nil
+}
+
+// createCommandRe matches the arguments of the create command of a scaffolded type.
+var createCommandRe = regexp.MustCompile(`Use:\s+"create-[a-z0-9-]+((?: \[[a-z0-9-]+\])*)"`)
+
+// seedObjectArgs returns the arguments of the create command of the object in the order of the
+// arguments of the command scaffolded for its type.
+func (c *Chain) seedObjectArgs(object SeedObject) ([]string, error) {
+	typeName, err := multiformatname.NewName(object.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	cliPath := filepath.Join(c.app.Path, "x", object.Module, "client", "cli", fmt.Sprintf("tx_%s.go", typeName.Snake))
+	data, err := os.ReadFile(cliPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the type %s has no create message in the module %s", object.Type, object.Module)
+		}
+		return nil, err
+	}
+	match := createCommandRe.FindSubmatch(data)
+	if match == nil {
+		return nil, fmt.Errorf("cannot find the create command of the type %s", object.Type)
+	}
+
+	values := make(map[string]interface{})
+	for name, value := range object.Fields {
+		fieldName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+		values[fieldName.Kebab] = value
+	}
+
+	var args []string
+	for _, field := range strings.Fields(string(match[1])) {
+		field = strings.Trim(field, "[]")
+		value, ok := values[field]
+		if !ok {
+			return nil, fmt.Errorf("the field %s of the %s object is required", field, object.Type)
+		}
+		delete(values, field)
+
+		arg, err := seedArg(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %s: %w", field, err)
+		}
+		args = append(args, arg)
+	}
+	for field := range values {
+		return nil, fmt.Errorf("the type %s has no field %s", object.Type, field)
+	}
+
+	return args, nil
+}
+
+// seedArg returns the argument of a field value: the lists are separated with commas and the
+// custom types are encoded in JSON.
+func seedArg(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ","), nil
+	case map[string]interface{}:
+		data, err := json.Marshal(v)
+		return string(data), err
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,83 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderSeed(t *testing.T) {
+	seed := Seed{
+		Bank: []SeedSend{
+			{Name: "fund-bob", From: "alice", To: "bob", Amount: "100token", DependsOn: []string{"alice-post"}},
+			{From: "alice", To: "carol", Amount: "10token"},
+		},
+		Objects: []SeedObject{
+			{Name: "bob-comment", Module: "blog", Type: "comment", From: "bob", DependsOn: []string{"fund-bob"}},
+			{Name: "alice-post", Module: "blog", Type: "post", From: "alice"},
+		},
+	}
+
+	entries, err := orderSeed(seed)
+	require.NoError(t, err)
+
+	var order []string
+	for _, e := range entries {
+		if e.send != nil {
+			order = append(order, "send:"+e.send.To)
+		} else {
+			order = append(order, "object:"+e.object.Type)
+		}
+	}
+	require.Equal(t, []string{"send:carol", "object:post", "send:bob", "object:comment"}, order)
+}
+
+func TestOrderSeedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		seed Seed
+	}{
+		{
+			name: "circular dependencies",
+			seed: Seed{Objects: []SeedObject{
+				{Name: "a", Module: "blog", Type: "post", From: "alice", DependsOn: []string{"b"}},
+				{Name: "b", Module: "blog", Type: "post", From: "alice", DependsOn: []string{"a"}},
+			}},
+		},
+		{
+			name: "unknown dependency",
+			seed: Seed{Bank: []SeedSend{{From: "alice", To: "bob", Amount: "1token", DependsOn: []string{"a"}}}},
+		},
+		{
+			name: "duplicated name",
+			seed: Seed{Objects: []SeedObject{
+				{Name: "a", Module: "blog", Type: "post", From: "alice"},
+				{Name: "a", Module: "blog", Type: "post", From: "alice"},
+			}},
+		},
+		{
+			name: "missing module",
+			seed: Seed{Objects: []SeedObject{{Type: "post", From: "alice"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := orderSeed(tt.seed)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSeedArg(t *testing.T) {
+	arg, err := seedArg([]interface{}{"a", 2})
+	require.NoError(t, err)
+	require.Equal(t, "a,2", arg)
+
+	arg, err = seedArg(map[string]interface{}{"name": "x"})
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"x"}`, arg)
+
+	arg, err = seedArg(true)
+	require.NoError(t, err)
+	require.Equal(t, "true", arg)
+}
@@ -1,14 +1,20 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
@@ -16,13 +22,14 @@ import (
 
 	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
-	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/gopanic"
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/xexec"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
-	"github.com/ignite/cli/ignite/pkg/xhttp"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
@@ -36,9 +43,20 @@ const (
 	// binaryChecksumKey is the cache key for the checksum to detect binary modification
 	binaryChecksumKey = "binary_checksum"
 
+	// buildTagsKey is the cache key for the build tags used to build the binary
+	buildTagsKey = "build_tags"
+
 	// configChecksumKey is the cache key for containing the checksum to detect config modification
 	configChecksumKey = "config_checksum"
 
+	// profileConfigChecksumKey is the cache key for the checksum to detect modifications of the
+	// config resolved with the selected profile, e.g. when another profile is selected
+	profileConfigChecksumKey = "profile_config_checksum"
+
+	// stateConfigChecksumKey is the cache key for the checksum to detect modifications
+	// of the config fields that require the app state to be reset
+	stateConfigChecksumKey = "state_config_checksum"
+
 	// serveDirchangeCacheNamespace is the name of the cache namespace for detecting changes in directories
 	serveDirchangeCacheNamespace = "serve.dirchange"
 )
@@ -55,8 +73,13 @@ var (
 )
 
 type serveOptions struct {
-	forceReset bool
-	resetOnce  bool
+	forceReset      bool
+	resetOnce       bool
+	resetScopes     []ResetScope
+	endpointsPath   string
+	profileInterval time.Duration
+	validators      int
+	skipStateSave   bool
 }
 
 func newServeOption() serveOptions {
@@ -83,6 +106,29 @@ func ServeResetOnce() ServeOption {
 	}
 }
 
+// ServeResetScopes allows to reset only the given parts of the state when the chain is served once
+func ServeResetScopes(scopes ...ResetScope) ServeOption {
+	return func(c *serveOptions) {
+		c.resetScopes = scopes
+	}
+}
+
+// ServeSkipStateSave doesn't save the genesis state of the chain when serve stops, e.g. for a
+// throwaway chain of a test, the state saved by the previous serves is kept untouched
+func ServeSkipStateSave() ServeOption {
+	return func(c *serveOptions) {
+		c.skipStateSave = true
+	}
+}
+
+// ServeEndpointsFile allows to write the endpoints of the served chain to a JSON file
+// each time the chain starts, the file is removed when serve stops
+func ServeEndpointsFile(path string) ServeOption {
+	return func(c *serveOptions) {
+		c.endpointsPath = path
+	}
+}
+
 // Serve serves an app.
 func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options ...ServeOption) error {
 	serveOptions := newServeOption()
@@ -92,6 +138,14 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		apply(&serveOptions)
 	}
 
+	// include the dev-only features of the app in the served binary
+	c.options.buildTags = append(c.options.buildTags, DevBuildTag)
+
+	// keep the keys of the dev accounts in the test keyring of the chain home
+	if err := c.isolateKeyring(); err != nil {
+		return err
+	}
+
 	// initial checks and setup.
 	if err := c.setup(); err != nil {
 		return err
@@ -106,6 +160,16 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		return err
 	}
 
+	// keep track of the served projects to clean their data once deleted
+	if err := c.registerLocalProject(); err != nil {
+		return err
+	}
+
+	// remove the endpoints file once the chain is no longer served
+	if serveOptions.endpointsPath != "" {
+		defer os.Remove(serveOptions.endpointsPath)
+	}
+
 	// start serving components.
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -138,14 +202,23 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				shouldReset := serveOptions.forceReset || serveOptions.resetOnce
 
 				// serve the app.
-				err = c.serve(serveCtx, cacheStorage, shouldReset)
+				err = c.serve(
+					serveCtx,
+					cacheStorage,
+					shouldReset,
+					serveOptions.resetScopes,
+					serveOptions.endpointsPath,
+					serveOptions.profileInterval,
+					serveOptions.validators,
+				)
 				serveOptions.resetOnce = false
+				serveOptions.resetScopes = nil
 
 				switch {
 				case err == nil:
 				case errors.Is(err, context.Canceled):
 					// If the app has been served, we save the genesis state
-					if c.served {
+					if c.served && !serveOptions.skipStateSave {
 						c.served = false
 
 						fmt.Fprintln(c.stdLog().out, "💿 Saving genesis state...")
@@ -162,8 +235,10 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 							return err
 						}
 						fmt.Fprintf(c.stdLog().out, "💿 Genesis state saved in %s\n", genesisPath)
+						c.logger.Info("genesis state saved", "path", genesisPath)
 					}
 				case errors.As(err, &buildErr):
+					c.logger.Error("cannot build the app", "err", err)
 					fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(err.Error()))
 
 					var validationErr *chainconfig.ValidationError
@@ -174,6 +249,15 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					fmt.Fprintf(c.stdLog().out, "%s\n", infoColor("Waiting for a fix before retrying..."))
 
 				case errors.As(err, &startErr):
+					c.logger.Error("cannot start the app", "err", err)
+
+					// Summarize the panic of the app and wait for its fix
+					if p, ok := startErr.ParsePanic(); ok {
+						c.printPanic(c.stdLog().err, p)
+						fmt.Fprintf(c.stdLog().out, "%s\n", infoColor("Waiting for a fix before retrying..."))
+						break
+					}
+
 					// Parse returned error logs
 					parsedErr := startErr.ParseStartError()
 
@@ -201,9 +285,43 @@ If the new code is no longer compatible with the saved state, you can reset the
 		return c.watchAppBackend(ctx)
 	})
 
+	// routine to apply the config changes
+	g.Go(func() error {
+		return c.watchConfig(ctx)
+	})
+
+	// routine to re-apply the node configuration on SIGHUP
+	g.Go(func() error {
+		return c.watchReloadSignal(ctx)
+	})
+
 	return g.Wait()
 }
 
+// isolateKeyring makes the served chain store the keys of its accounts in the test keyring of
+// the chain home, so they're removed with the chain state and never stored in the OS keyring
+// of the user. A keyring backend set explicitly with the KeyringBackend option is kept.
+func (c *Chain) isolateKeyring() error {
+	if c.options.keyringBackend != "" {
+		return nil
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return err
+	}
+	if backend != chaincmd.KeyringBackendTest {
+		fmt.Fprintf(
+			c.stdLog().out,
+			"🔑 The accounts are stored in the test keyring of the chain home instead of the %q keyring\n",
+			backend,
+		)
+	}
+
+	c.options.keyringBackend = chaincmd.KeyringBackendTest
+	return nil
+}
+
 func (c *Chain) setup() error {
 	fmt.Fprintf(c.stdLog().out, "Cosmos SDK's version is: %s\n\n", infoColor(c.Version))
 
@@ -228,14 +346,9 @@ func (c *Chain) refreshServe() {
 }
 
 func (c *Chain) watchAppBackend(ctx context.Context) error {
-	watchPaths := appBackendSourceWatchPaths
-	if c.ConfigPath() != "" {
-		watchPaths = append(watchPaths, c.ConfigPath())
-	}
-
 	return localfs.Watch(
 		ctx,
-		watchPaths,
+		appBackendSourceWatchPaths,
 		localfs.WatcherWorkdir(c.app.Path),
 		localfs.WatcherOnChange(c.refreshServe),
 		localfs.WatcherIgnoreHidden(),
@@ -244,10 +357,39 @@ func (c *Chain) watchAppBackend(ctx context.Context) error {
 	)
 }
 
+// watchReloadSignal restarts the app every time a SIGHUP signal is received.
+// Changes in the node configuration are applied on restart without resetting the app state.
+// There is no SIGHUP on Windows, the app is restarted by the changes of the config only.
+func (c *Chain) watchReloadSignal(ctx context.Context) error {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGHUP)
+	defer signal.Stop(sigc)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sigc:
+			fmt.Fprintln(c.stdLog().out, "🔄 Reload signal received, restarting the app...")
+			c.logger.Info("reload signal received")
+			c.refreshServe()
+		}
+	}
+}
+
 // serve performs the operations to serve the blockchain: build, init and start
 // if the chain is already initialized and the file didn't changed, the app is directly started
 // if the files changed, the state is imported
-func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceReset bool) error {
+// resetScopes allows to reset only parts of the state when the chain is already initialized
+func (c *Chain) serve(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	forceReset bool,
+	resetScopes []ResetScope,
+	endpointsPath string,
+	profileInterval time.Duration,
+	validators int,
+) error {
 	conf, err := c.Config()
 	if err != nil {
 		return &CannotBuildAppError{err}
@@ -258,6 +400,12 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		return err
 	}
 
+	// nodes are the validators of the local network after the first one
+	nodes, err := c.localnetNodes(ctx, conf, validators)
+	if err != nil {
+		return err
+	}
+
 	// isInit determines if the app is initialized
 	var isInit bool
 
@@ -276,15 +424,78 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 			if err != nil {
 				return err
 			}
+			if !configModified {
+				configModified, err = c.hasProfileConfigChanged(dirCache, conf)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
-		if forceReset || configModified {
+		// node settings like the ones overwriting app.toml and config.toml can be applied
+		// to the initialized chain, the state is only reset when other configs are modified
+		if configModified && !forceReset {
+			stateConfigModified, err := c.hasStateConfigChanged(dirCache, conf)
+			if err != nil {
+				return err
+			}
+			if !stateConfigModified {
+				fmt.Fprintln(c.stdLog().out, "🔄 Applying node configuration changes...")
+
+				home, err := c.Home()
+				if err != nil {
+					return err
+				}
+				if err := c.configureNode(home, conf); err != nil {
+					return &CannotBuildAppError{err}
+				}
+				configModified = false
+			}
+		}
+
+		// the Rosetta gateway toggled since the last start is configured in the node.
+		if !configModified && !forceReset {
+			home, err := c.Home()
+			if err != nil {
+				return err
+			}
+			rosettaModified, err := hasRosettaChanged(home, conf)
+			if err != nil {
+				return err
+			}
+			if rosettaModified {
+				if err := c.configureNode(home, conf); err != nil {
+					return &CannotBuildAppError{err}
+				}
+			}
+		}
+
+		// the validators of the local network are created when the app is initialized
+		localnetModified, err := c.hasLocalnetChanged(dirCache, nodes)
+		if err != nil {
+			return err
+		}
+
+		// the genesis is created again for a new chain id, e.g. a templated id after a new commit.
+		chainIDModified, err := c.hasChainIDChanged()
+		if err != nil {
+			return err
+		}
+
+		if forceReset || configModified || localnetModified || chainIDModified {
 			// if forceReset is set, we consider the app as being not initialized
 			fmt.Fprintln(c.stdLog().out, "🔄 Resetting the app state...")
 			isInit = false
 		}
 	}
 
+	// keys can only be recreated by initializing the app again
+	resetScope := widestResetScope(resetScopes)
+	if isInit && resetScope == ResetKeys {
+		fmt.Fprintln(c.stdLog().out, "🔄 Resetting the app keys, genesis and data...")
+		isInit = false
+	}
+
 	// check if source has been modified since last serve
 	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
 	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...)
@@ -311,7 +522,35 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		}
 	}
 
-	appModified := sourceModified || binaryModified
+	// the binary must be rebuilt when the build tags are modified
+	buildTagsModified, err := c.hasBuildTagsChanged(dirCache)
+	if err != nil {
+		return err
+	}
+
+	appModified := sourceModified || binaryModified || buildTagsModified
+
+	// the changes of the source decide if the chain restarts with its state, the state is restored
+	// from the exported genesis when they're unknown or the binary is modified otherwise.
+	restart := restartRestore
+	var paramsModules []string
+	if isInit && sourceModified && !binaryModified && !buildTagsModified && resetScope == "" {
+		if restart, paramsModules, err = c.decideRestart(dirCache); err != nil {
+			return err
+		}
+		if restart == restartReset {
+			isInit = false
+		}
+	}
+
+	c.logger.Info("serving the app",
+		"initialized", isInit,
+		"source_modified", sourceModified,
+		"binary_modified", binaryModified,
+		"build_tags_modified", buildTagsModified,
+		"reset_scope", resetScope,
+		"restart_mode", restart,
+	)
 
 	// check if exported genesis exists
 	exportGenesisExists := true
@@ -328,23 +567,71 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	// build phase
 	if !isInit || appModified {
 		// build the blockchain app
+		start := time.Now()
 		if err := c.build(ctx, cacheStorage, ""); err != nil {
 			return err
 		}
+		c.logger.Info("app built", "duration", time.Since(start))
 	}
 
 	// init phase
+	// genesisKept is true when the chain restarts with its current genesis
+	genesisKept := false
+
 	// nolint:gocritic
-	if !isInit || (appModified && !exportGenesisExists) {
+	if !isInit || (appModified && !exportGenesisExists && restart != restartKeep) {
 		fmt.Fprintln(c.stdLog().out, "💿 Initializing the app...")
+		c.logger.Info("initializing the app")
 
 		if err := c.Init(ctx, true); err != nil {
 			return err
 		}
-	} else if appModified {
+		if err := c.initLocalnet(ctx, conf, nodes); err != nil {
+			return err
+		}
+	} else if resetScope == ResetGenesis {
+		fmt.Fprintln(c.stdLog().out, "💿 Rebuilding the genesis from the config and resetting the data...")
+		c.logger.Info("rebuilding the genesis and resetting the data")
+
+		if err := c.resetGenesis(ctx, conf); err != nil {
+			return err
+		}
+		if err := c.initLocalnet(ctx, conf, nodes); err != nil {
+			return err
+		}
+	} else if resetScope == ResetData {
+		fmt.Fprintln(c.stdLog().out, "💿 Resetting the app data...")
+		c.logger.Info("resetting the app data")
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+		if err := c.resetLocalnet(ctx, nodes); err != nil {
+			return err
+		}
+		genesisKept = true
+	} else if restart == restartPatchParams {
+		fmt.Fprintln(c.stdLog().out, "💿 Restoring the database with the new default params...")
+		c.logger.Info("restoring the database from the exported genesis with the new default params", "modules", paramsModules)
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+		if err := c.importChainState(); err != nil {
+			return err
+		}
+		if err := c.patchGenesisParams(ctx, commands, conf, paramsModules); err != nil {
+			return err
+		}
+		if err := c.resetLocalnet(ctx, nodes); err != nil {
+			return err
+		}
+		genesisKept = true
+	} else if appModified && restart != restartKeep {
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
 		fmt.Fprintln(c.stdLog().out, "💿 Existent genesis detected, restoring the database...")
+		c.logger.Info("restoring the database from the exported genesis")
 
 		if err := commands.UnsafeReset(ctx); err != nil {
 			return err
@@ -353,8 +640,21 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		if err := c.importChainState(); err != nil {
 			return err
 		}
+		if err := c.resetLocalnet(ctx, nodes); err != nil {
+			return err
+		}
+		genesisKept = true
 	} else {
 		fmt.Fprintln(c.stdLog().out, "▶️  Restarting existing app...")
+		c.logger.Info("restarting the app")
+		genesisKept = true
+	}
+
+	// the changes of the config applied to the genesis are ignored until it's rebuilt
+	if genesisKept {
+		if err := c.checkGenesisDrift(ctx, conf); err != nil {
+			return err
+		}
 	}
 
 	// save checksums
@@ -362,10 +662,27 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
 			return err
 		}
+		profileChecksum, err := profileConfigChecksum(c.options.configProfile, conf)
+		if err != nil {
+			return err
+		}
+		if err := dirCache.Put(profileConfigChecksumKey, profileChecksum); err != nil {
+			return err
+		}
+		stateChecksum, err := stateConfigChecksum(conf)
+		if err != nil {
+			return err
+		}
+		if err := dirCache.Put(stateConfigChecksumKey, stateChecksum); err != nil {
+			return err
+		}
 	}
 	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...); err != nil {
 		return err
 	}
+	if err := c.saveSourceSnapshot(dirCache); err != nil {
+		return err
+	}
 	binaryPath, err = exec.LookPath(binaryName)
 	if err != nil {
 		return err
@@ -373,22 +690,188 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	if err := dirchange.SaveDirChecksum(dirCache, binaryChecksumKey, "", binaryPath); err != nil {
 		return err
 	}
+	if err := dirCache.Put(buildTagsKey, []byte(gocmd.Tags(c.options.buildTags...))); err != nil {
+		return err
+	}
+	if err := dirCache.Put(localnetValidatorsKey, []byte(strconv.Itoa(len(nodes)+1))); err != nil {
+		return err
+	}
+
+	// connect the validators of the local network
+	if err := c.configureLocalnet(ctx, conf, nodes); err != nil {
+		return err
+	}
 
 	// start the blockchain
-	return c.start(ctx, conf)
+	return c.start(ctx, conf, nodes, endpointsPath, profileInterval)
+}
+
+// hasStateConfigChanged checks if the config fields that require the app state
+// to be reset have been modified since the last serve.
+func (c *Chain) hasStateConfigChanged(dirCache cache.Cache[[]byte], conf chainconfig.Config) (bool, error) {
+	savedChecksum, err := dirCache.Get(stateConfigChecksumKey)
+	if err == cache.ErrorNotFound {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	checksum, err := stateConfigChecksum(conf)
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(checksum, savedChecksum), nil
+}
+
+// hasProfileConfigChanged checks if the selected profile or the config resolved with it have been
+// modified since the last serve, the config file alone doesn't change when another profile is selected.
+func (c *Chain) hasProfileConfigChanged(dirCache cache.Cache[[]byte], conf chainconfig.Config) (bool, error) {
+	savedChecksum, err := dirCache.Get(profileConfigChecksumKey)
+	if err == cache.ErrorNotFound {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	checksum, err := profileConfigChecksum(c.options.configProfile, conf)
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(checksum, savedChecksum), nil
+}
+
+// hasBuildTagsChanged checks if the build tags are different from the ones used for the last serve.
+func (c *Chain) hasBuildTagsChanged(dirCache cache.Cache[[]byte]) (bool, error) {
+	savedTags, err := dirCache.Get(buildTagsKey)
+	if err == cache.ErrorNotFound {
+		return len(c.options.buildTags) > 0, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(savedTags) != gocmd.Tags(c.options.buildTags...), nil
+}
+
+// stateConfigChecksum computes the checksum of the config fields that require
+// the app state to be reset when modified.
+// Host addresses, peers, consensus timeouts, pruning and the app.toml, client.toml and config.toml
+// overwrites are excluded because they are re-applied to the node's home on restart,
+// the faucet is excluded because it is served with the config of every restart and the profiles are
+// excluded because the selected one is already resolved in the config.
+func stateConfigChecksum(conf chainconfig.Config) ([]byte, error) {
+	conf.Host = chainconfig.Host{}
+	conf.Faucet = chainconfig.Faucet{}
+	conf.Rosetta = chainconfig.Rosetta{}
+	conf.Profiles = nil
+	conf.Validator.BlockTime = ""
+	conf.Validator.Consensus = chainconfig.Consensus{}
+	conf.Validator.Pruning = chainconfig.Pruning{}
+	conf.Validator.RemoteSigner = chainconfig.RemoteSigner{}
+	conf.Init.App = nil
+	conf.Init.Client = nil
+	conf.Init.Config = nil
+	conf.Init.Seeds = nil
+	conf.Init.PersistentPeers = nil
+
+	// JSON encoding is used because it sorts map keys
+	data, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(data)
+	return checksum[:], nil
+}
+
+// profileConfigChecksum computes the checksum of the name of the selected profile and of the config
+// resolved with it.
+func profileConfigChecksum(profile string, conf chainconfig.Config) ([]byte, error) {
+	conf.Profiles = nil
+
+	data, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(profile))
+	h.Write([]byte{0})
+	h.Write(data)
+	return h.Sum(nil), nil
 }
 
-func (c *Chain) start(ctx context.Context, config chainconfig.Config) error {
+func (c *Chain) start(
+	ctx context.Context,
+	config chainconfig.Config,
+	nodes []localnetNode,
+	endpointsPath string,
+	profileInterval time.Duration,
+) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
 	}
 
+	if profileInterval > 0 && config.Host.Prof == "" {
+		return &CannotBuildAppError{errors.New("the profiling mode requires the address of the pprof server of the node in host.prof")}
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
+	// the config changes are applied to the running app from its config
+	c.setServedConfig(&config)
+	defer c.setServedConfig(nil)
+
+	// drop the faucet reloaded while the app wasn't running, the faucet is created with the current config
+	select {
+	case <-c.faucetReloader:
+	default:
+	}
+
+	// serve the API behind the proxy controlling its access, the API of the node listens on a socket.
+	if chainconfig.IsAPIProxied(config) {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		if err := removeAPISocket(home); err != nil {
+			return err
+		}
+		g.Go(func() error {
+			if err := c.runAPIProxy(ctx, home, config); err != nil {
+				return &CannotBuildAppError{err}
+			}
+			return nil
+		})
+	}
+
 	// start the blockchain.
 	g.Go(func() error { return c.plugin.Start(ctx, commands, config) })
 
+	// start the other validators of the local network.
+	for _, node := range nodes {
+		node := node
+		g.Go(func() error { return c.plugin.Start(ctx, node.commands, node.conf) })
+	}
+
+	// start the mock remote signers of the validators, the nodes wait for them to sign the blocks.
+	if config.Validator.RemoteSigner.Mock {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		g.Go(func() error { return c.runMockSigner(ctx, home, config.Validator.RemoteSigner) })
+
+		for _, node := range nodes {
+			node := node
+			g.Go(func() error { return c.runMockSigner(ctx, node.home, node.conf.Validator.RemoteSigner) })
+		}
+	}
+
 	// start the faucet if enabled.
 	faucet, err := c.Faucet(ctx)
 	isFaucetEnabled := err != ErrFaucetIsNotEnabled
@@ -416,29 +899,96 @@ func (c *Chain) start(ctx context.Context, config chainconfig.Config) error {
 	// error group, so they can be safely ignored here
 	rpcAddr, _ := xurl.HTTP(config.Host.RPC)
 	apiAddr, _ := xurl.HTTP(config.Host.API)
+	grpcWebAddr, _ := xurl.HTTP(config.Host.GRPCWeb)
 
 	// print the server addresses.
+	c.logger.Info("app started", "rpc", rpcAddr, "api", apiAddr, "grpc_web", grpcWebAddr, "faucet", isFaucetEnabled)
+
 	fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node: %s\n", rpcAddr)
 	fmt.Fprintf(c.stdLog().out, "🌍 Blockchain API: %s\n", apiAddr)
+	fmt.Fprintf(c.stdLog().out, "🌍 gRPC-Web: %s\n", grpcWebAddr)
+	if config.Rosetta.Enabled {
+		rosettaAddr, _ := xurl.HTTP(config.Host.Rosetta)
+		fmt.Fprintf(c.stdLog().out, "🌍 Rosetta API: %s\n", rosettaAddr)
+	}
+
+	for _, node := range nodes {
+		nodeAddr, _ := xurl.HTTP(node.conf.Host.RPC)
+		fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node of validator %d: %s\n", node.index, nodeAddr)
+	}
+
+	if signer := config.Validator.RemoteSigner; signer.IsSet() {
+		kind := "remote signer"
+		if signer.Mock {
+			kind = "mock remote signer"
+		}
+		fmt.Fprintf(c.stdLog().out, "🔏 Blocks signed by a %s on %s\n", kind, signer.Address)
+	}
 
 	if isFaucetEnabled {
 		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(config))
 		fmt.Fprintf(c.stdLog().out, "🌍 Token faucet: %s\n", faucetAddr)
 	}
 
-	return g.Wait()
-}
+	// warn when the servers are bound to an interface reachable from other machines.
+	if config.Host.Bind != "" {
+		var exposed, restricted []string
+		for _, server := range chainconfig.Servers(config) {
+			// the frontend isn't started by serve.
+			if server.Name == "Frontend" {
+				continue
+			}
+			if !server.IsExposed() || (server.Name == "Faucet" && !isFaucetEnabled) {
+				continue
+			}
+			// the access to the API and the faucet is controlled by config.yml.
+			if (server.Name == "API" && chainconfig.IsAPIProxied(config)) ||
+				(server.Name == "Faucet" && config.Host.Access.IsSet()) {
+				restricted = append(restricted, server.Name)
+				continue
+			}
+			exposed = append(exposed, server.Name)
+		}
+		if len(restricted) > 0 {
+			fmt.Fprintf(
+				c.stdLog().out,
+				"🔒 The access to %s from other machines is restricted by host.access.\n",
+				strings.Join(restricted, ", "),
+			)
+		}
+		if len(exposed) > 0 {
+			fmt.Fprintf(
+				c.stdLog().out,
+				"⚠️  %s can be reached from other machines on %s. The API allows any origin, the accounts "+
+					"use an unencrypted keyring and the faucet gives tokens away, only expose them on trusted networks.\n",
+				strings.Join(exposed, ", "),
+				config.Host.Bind,
+			)
+		}
+	}
 
-func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet) error {
-	config, err := c.Config()
-	if err != nil {
-		return err
+	// capture the profiles of the node once it is reachable
+	if profileInterval > 0 {
+		g.Go(func() error {
+			return c.runProfiler(ctx, config, rpcAddr, profileInterval)
+		})
 	}
 
-	return xhttp.Serve(ctx, &http.Server{
-		Addr:    chainconfig.FaucetHost(config),
-		Handler: faucet,
-	})
+	// write the endpoints for the tools discovering the served chain once the node is reachable
+	if endpointsPath != "" {
+		g.Go(func() error {
+			if err := isNodeListening(ctx, rpcAddr); err != nil {
+				return err
+			}
+			endpoints, err := c.endpoints(ctx, config, isFaucetEnabled)
+			if err != nil {
+				return err
+			}
+			return writeEndpoints(endpointsPath, endpoints)
+		})
+	}
+
+	return g.Wait()
 }
 
 // saveChainState runs the export command of the chain and store the exported genesis in the chain saved config
@@ -522,14 +1072,23 @@ func (e *CannotStartAppError) Unwrap() error {
 	return e.Err
 }
 
+// ParsePanic parses the panic of the app from the error logs, it returns false when the app didn't panic.
+func (e *CannotStartAppError) ParsePanic() (gopanic.Panic, bool) {
+	if errors.Unwrap(e.Err) == nil {
+		return gopanic.Panic{}, false
+	}
+	return gopanic.Parse(errors.Unwrap(e.Err).Error())
+}
+
 // ParseStartError parses the error into a clear error string
 // The error logs from Cosmos SDK application are too extensive to be directly printed
 // If the error is not recognized, returns an empty string
 func (e *CannotStartAppError) ParseStartError() string {
 	errorLogs := errors.Unwrap(e.Err).Error()
 	switch {
-	case strings.Contains(errorLogs, "bind: address already in use"):
-		r := regexp.MustCompile(`listen .* bind: address already in use`)
+	case strings.Contains(errorLogs, "bind: address already in use"),
+		strings.Contains(errorLogs, "bind: Only one usage of each socket address"): // Windows.
+		r := regexp.MustCompile(`listen .* bind: (address already in use|Only one usage of each socket address[^\r\n]*)`)
 		return r.FindString(errorLogs)
 	case strings.Contains(errorLogs, "validator set is nil in genesis"):
 		return "Error: error during handshake: error on replay: validator set is nil in genesis and still empty after InitChain"
@@ -0,0 +1,25 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStartError(t *testing.T) {
+	startErr := func(logs string) *CannotStartAppError {
+		return &CannotStartAppError{AppName: "mars", Err: fmt.Errorf("exit status 1: %w", errors.New(logs))}
+	}
+
+	require.Equal(t,
+		"listen tcp 0.0.0.0:26657: bind: address already in use",
+		startErr("I[2022-06-01] starting\nError: listen tcp 0.0.0.0:26657: bind: address already in use\n").ParseStartError(),
+	)
+	require.Equal(t,
+		"listen tcp 0.0.0.0:26657: bind: Only one usage of each socket address (protocol/network address/port) is normally permitted.",
+		startErr("Error: listen tcp 0.0.0.0:26657: bind: Only one usage of each socket address (protocol/network address/port) is normally permitted.\r\nUsage:").ParseStartError(),
+	)
+	require.Empty(t, startErr("Error: unknown flag").ParseStartError())
+}
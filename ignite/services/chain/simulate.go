@@ -1,17 +1,184 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/pkg/errors"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/truncatedbuffer"
+)
+
+const (
+	// simulationOutputMaxLen is the length of the end of the simulation output kept to find the failure.
+	simulationOutputMaxLen = 64 * 1024
+
+	// simulationTestFile is the file of the simulation test of the app, it declares the SimApp
+	// interface used by the exported failure tests.
+	simulationTestFile = "simulation_test.go"
 )
 
+// SimulationProfile is a named set of simulation parameters.
+type SimulationProfile struct {
+	// NumBlocks is the number of blocks simulated.
+	NumBlocks int
+
+	// BlockSize is the number of operations per block.
+	BlockSize int
+
+	// Period runs the slow invariants only once every period assertions.
+	Period uint
+
+	// OnOperation runs the slow invariants every operation.
+	OnOperation bool
+
+	// AllInvariants prints all the invariants when one of them is broken.
+	AllInvariants bool
+
+	// Lean only logs the successful operations.
+	Lean bool
+}
+
+// SimulationProfiles are the profiles of the simulation by name: short for a quick check before
+// pushing a change, nightly for a long run in CI and heavy-invariant to find the operation breaking
+// an invariant.
+var SimulationProfiles = map[string]SimulationProfile{
+	"short": {
+		NumBlocks: 50,
+		BlockSize: 30,
+	},
+	"nightly": {
+		NumBlocks: 500,
+		BlockSize: 200,
+		Period:    10,
+		Lean:      true,
+	},
+	"heavy-invariant": {
+		NumBlocks:     100,
+		BlockSize:     50,
+		Period:        1,
+		OnOperation:   true,
+		AllInvariants: true,
+	},
+}
+
+// SimulationProfileNames returns the sorted names of the simulation profiles.
+func SimulationProfileNames() []string {
+	var names []string
+	for name := range SimulationProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SimulationFailure is the artifact of a failed simulation, it holds the parameters replaying
+// the same operations and the block where the simulation failed.
+type SimulationFailure struct {
+	ChainID            string `json:"chain_id"`
+	Profile            string `json:"profile,omitempty"`
+	Seed               int64  `json:"seed"`
+	InitialBlockHeight int    `json:"initial_block_height"`
+	NumBlocks          int    `json:"num_blocks"`
+	BlockSize          int    `json:"block_size"`
+	Period             uint   `json:"period"`
+	GenesisTime        int64  `json:"genesis_time"`
+	OnOperation        bool   `json:"on_operation"`
+	AllInvariants      bool   `json:"all_invariants"`
+	Lean               bool   `json:"lean"`
+	Genesis            string `json:"genesis,omitempty"`
+	Params             string `json:"params,omitempty"`
+
+	// Height is the block where the simulation failed, zero when it's unknown.
+	Height int `json:"height,omitempty"`
+
+	// Module is the module of the failed operation, when the simulation fails on an operation.
+	Module string `json:"module,omitempty"`
+
+	// Error is the error of the simulation.
+	Error string `json:"error"`
+
+	// Time is the time of the failure.
+	Time time.Time `json:"time"`
+}
+
+// ReadSimulationFailure reads the simulation failure artifact at path.
+func ReadSimulationFailure(path string) (SimulationFailure, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return SimulationFailure{}, err
+	}
+	var f SimulationFailure
+	if err := json.Unmarshal(data, &f); err != nil {
+		return SimulationFailure{}, errors.Wrapf(err, "invalid simulation failure %s", path)
+	}
+	return f, nil
+}
+
+// Save writes the failure artifact to path.
+func (f SimulationFailure) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Config returns the simulation config replaying the simulation.
+func (f SimulationFailure) Config() simulation.Config {
+	return simulation.Config{
+		Commit:             true,
+		ChainID:            f.ChainID,
+		GenesisFile:        f.Genesis,
+		ParamsFile:         f.Params,
+		Seed:               f.Seed,
+		InitialBlockHeight: f.InitialBlockHeight,
+		NumBlocks:          f.NumBlocks,
+		BlockSize:          f.BlockSize,
+		Lean:               f.Lean,
+		OnOperation:        f.OnOperation,
+		AllInvariants:      f.AllInvariants,
+	}
+}
+
+// SimulationFailedError is returned when the simulation fails, its failure is saved to Path.
+type SimulationFailedError struct {
+	Failure SimulationFailure
+	Path    string
+}
+
+func (e *SimulationFailedError) Error() string {
+	at := ""
+	if e.Failure.Height > 0 {
+		at = fmt.Sprintf(" on block %d", e.Failure.Height)
+	}
+	return fmt.Sprintf("simulation with seed %d failed%s: %s", e.Failure.Seed, at, e.Failure.Error)
+}
+
 type simappOptions struct {
 	enabled     bool
 	verbose     bool
 	config      simulation.Config
 	period      uint
 	genesisTime int64
+	profile     string
+	failureOut  string
 }
 
 func newSimappOptions() simappOptions {
@@ -57,6 +224,33 @@ func SimappWithConfig(config simulation.Config) SimappOption {
 	}
 }
 
+// SimappWithProfile records the name of the profile of the simulation in its failure artifact
+func SimappWithProfile(profile string) SimappOption {
+	return func(c *simappOptions) {
+		c.profile = profile
+	}
+}
+
+// SimappWithFailureOut saves the failure artifact of the simulation to path when it fails
+func SimappWithFailureOut(path string) SimappOption {
+	return func(c *simappOptions) {
+		c.failureOut = path
+	}
+}
+
+// SimappWithReplay replays the simulation of the failure artifact
+func SimappWithReplay(f SimulationFailure) SimappOption {
+	return func(c *simappOptions) {
+		c.config = f.Config()
+		c.period = f.Period
+		c.genesisTime = f.GenesisTime
+		c.profile = f.Profile
+	}
+}
+
+// Simulate runs the simulation of the app. When it fails and a failure output is set, the
+// parameters replaying the simulation are saved to the output and a *SimulationFailedError is
+// returned.
 func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 	simappOptions := newSimappOptions()
 
@@ -69,7 +263,12 @@ func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 	if err != nil {
 		return err
 	}
-	return commands.Simulation(ctx,
+
+	// the end of the output reports the failure.
+	output := truncatedbuffer.NewTailBuffer(simulationOutputMaxLen)
+	commands = commands.Copy(chaincmdrunner.Stdout(output), chaincmdrunner.Stderr(output))
+
+	err = commands.Simulation(ctx,
 		c.app.Path,
 		simappOptions.enabled,
 		simappOptions.verbose,
@@ -77,4 +276,175 @@ func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 		simappOptions.period,
 		simappOptions.genesisTime,
 	)
+	if err == nil || simappOptions.failureOut == "" || ctx.Err() != nil {
+		return err
+	}
+
+	failure := newSimulationFailure(simappOptions, output.GetBuffer().String(), err)
+	if err := failure.Save(simappOptions.failureOut); err != nil {
+		return err
+	}
+	return &SimulationFailedError{Failure: failure, Path: simappOptions.failureOut}
+}
+
+var (
+	// simulationOperationErrorRe matches the error of an operation reported by the simulation.
+	simulationOperationErrorRe = regexp.MustCompile(`error on block\s+(\d+)/\d+, operation \(\d+/\d+\) from x/(\S+):\s*\n(.*)`)
+
+	// simulationPanicRe matches a panic of the simulation.
+	simulationPanicRe = regexp.MustCompile(`(?m)^panic: (.*)$`)
+
+	// simulationProgressRe matches the progress of the simulation, the last match is the block
+	// the simulation stopped on.
+	simulationProgressRe = regexp.MustCompile(`Simulating\.\.\. block (\d+)/`)
+)
+
+// newSimulationFailure returns the failure of the simulation from the end of its output.
+func newSimulationFailure(o simappOptions, output string, err error) SimulationFailure {
+	f := SimulationFailure{
+		ChainID:            o.config.ChainID,
+		Profile:            o.profile,
+		Seed:               o.config.Seed,
+		InitialBlockHeight: o.config.InitialBlockHeight,
+		NumBlocks:          o.config.NumBlocks,
+		BlockSize:          o.config.BlockSize,
+		Period:             o.period,
+		GenesisTime:        o.genesisTime,
+		OnOperation:        o.config.OnOperation,
+		AllInvariants:      o.config.AllInvariants,
+		Lean:               o.config.Lean,
+		Genesis:            o.config.GenesisFile,
+		Params:             o.config.ParamsFile,
+		Error:              err.Error(),
+		Time:               time.Now().UTC(),
+	}
+
+	if m := simulationOperationErrorRe.FindStringSubmatch(output); m != nil {
+		f.Height, _ = strconv.Atoi(m[1])
+		f.Module = m[2]
+		f.Error = strings.TrimSpace(m[3])
+		return f
+	}
+
+	if m := simulationPanicRe.FindStringSubmatch(output); m != nil {
+		f.Error = strings.TrimSpace(m[1])
+	}
+	if m := simulationProgressRe.FindAllStringSubmatch(output, -1); m != nil {
+		f.Height, _ = strconv.Atoi(m[len(m)-1][1])
+	}
+	return f
+}
+
+var simulationTestTemplate = template.Must(template.New("simulation").Parse(`package app_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ignite/cli/ignite/pkg/cosmoscmd"
+	"github.com/stretchr/testify/require"
+
+	"{{ .ImportPath }}/app"
+)
+
+// {{ .Name }} replays the simulation with seed {{ .Seed }}{{ if .Height }} until the block {{ .Height }}{{ end }}, where it failed:
+// {{ .Error }}
+//
+// The operations of the simulation are logged to $HOME/.simapp/simulations when it fails.
+func {{ .Name }}(t *testing.T) {
+	simapp.FlagEnabledValue = true
+	simapp.FlagCommitValue = true
+	simapp.FlagGenesisFileValue = {{ printf "%q" .Genesis }}
+	simapp.FlagParamsFileValue = {{ printf "%q" .Params }}
+	simapp.FlagSeedValue = {{ .Seed }}
+	simapp.FlagInitialBlockHeightValue = {{ .InitialBlockHeight }}
+	simapp.FlagNumBlocksValue = {{ .NumBlocks }}
+	simapp.FlagBlockSizeValue = {{ .BlockSize }}
+	simapp.FlagLeanValue = {{ .Lean }}
+	simapp.FlagOnOperationValue = {{ .OnOperation }}
+	simapp.FlagAllInvariantsValue = {{ .AllInvariants }}
+	simapp.FlagPeriodValue = {{ .Period }}
+	simapp.FlagGenesisTimeValue = {{ .GenesisTime }}
+
+	config, db, dir, logger, _, err := simapp.SetupSimulation("goleveldb-app-sim", "Simulation")
+	require.NoError(t, err, "simulation setup failed")
+	config.ChainID = {{ printf "%q" .ChainID }}
+
+	t.Cleanup(func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	app := app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		0,
+		encoding,
+		simapp.EmptyAppOptions{},
+	)
+
+	simApp, ok := app.(SimApp)
+	require.True(t, ok, "can't use simapp")
+
+	_, _, err = simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		simApp.GetBaseApp(),
+		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simapp.SimulationOperations(simApp, simApp.AppCodec(), config),
+		simApp.ModuleAccountAddrs(),
+		config,
+		simApp.AppCodec(),
+	)
+	require.NoError(t, err)
+}
+`))
+
+// ExportSimulationTest writes the Go test replaying the operations of the failed simulation to
+// the app directory, the test fails until the failure is fixed. The path and the name of the test,
+// named after the seed of the simulation, are returned.
+func (c *Chain) ExportSimulationTest(f SimulationFailure) (path, name string, err error) {
+	appDir := filepath.Join(c.app.Path, "app")
+	if _, err := os.Stat(filepath.Join(appDir, simulationTestFile)); err != nil {
+		return "", "", errors.Wrapf(err, "the simulation test of the app isn't found in %s", appDir)
+	}
+
+	// the error is documented in a comment.
+	f.Error = strings.TrimSpace(strings.SplitN(f.Error, "\n", 2)[0])
+
+	// the operations after the failed block aren't replayed.
+	if f.Height >= f.InitialBlockHeight && f.Height > 0 {
+		f.NumBlocks = f.Height - f.InitialBlockHeight + 1
+	}
+
+	name = fmt.Sprintf("TestSimulationFailure%d", f.Seed)
+	if f.Seed < 0 {
+		name = fmt.Sprintf("TestSimulationFailureNeg%d", -f.Seed)
+	}
+
+	var buf bytes.Buffer
+	if err := simulationTestTemplate.Execute(&buf, struct {
+		SimulationFailure
+		Name       string
+		ImportPath string
+	}{f, name, c.app.ImportPath}); err != nil {
+		return "", "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", "", err
+	}
+
+	path = filepath.Join(appDir, fmt.Sprintf("simulation_failure_%s_test.go", strings.TrimPrefix(name, "TestSimulationFailure")))
+	return path, name, os.WriteFile(path, src, 0644)
 }
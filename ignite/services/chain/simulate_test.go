@@ -0,0 +1,94 @@
+package chain
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSimulationFailure(t *testing.T) {
+	o := newSimappOptions()
+	SimappWithConfig(simulation.Config{Commit: true, ChainID: "mars", Seed: 7, InitialBlockHeight: 1, NumBlocks: 200, BlockSize: 30})(&o)
+	SimappWithPeriod(5)(&o)
+	SimappWithProfile("short")(&o)
+
+	// the operation failing the simulation is reported.
+	f := newSimulationFailure(o, `Simulating... block 11/200, operation 0/30.
+--- FAIL: BenchmarkSimulation
+    simulate.go:306: error on block  12/200, operation (3/30) from x/blog:
+        insufficient funds
+        Comment: unable to deliver tx
+FAIL
+`, errors.New("exit status 1"))
+	require.Equal(t, 12, f.Height)
+	require.Equal(t, "blog", f.Module)
+	require.Equal(t, "insufficient funds", f.Error)
+	require.Equal(t, "short", f.Profile)
+	require.Equal(t, uint(5), f.Period)
+
+	// a panic stops the simulation on the last simulated block.
+	f = newSimulationFailure(o, `Simulating... block 11/200, operation 0/30.
+Simulating... block 12/200, operation 0/30.
+panic: invariant broken: blog: total supply
+`, errors.New("exit status 2"))
+	require.Equal(t, 12, f.Height)
+	require.Empty(t, f.Module)
+	require.Equal(t, "invariant broken: blog: total supply", f.Error)
+
+	f = newSimulationFailure(o, "", errors.New("exit status 1"))
+	require.Zero(t, f.Height)
+	require.Equal(t, "exit status 1", f.Error)
+
+	// the replayed simulation has the same parameters.
+	path := filepath.Join(t.TempDir(), "failures", "simulation-failure.json")
+	require.NoError(t, f.Save(path))
+	saved, err := ReadSimulationFailure(path)
+	require.NoError(t, err)
+	require.Equal(t, f.Time.Unix(), saved.Time.Unix())
+	saved.Time = f.Time
+	require.Equal(t, f, saved)
+
+	replay := newSimappOptions()
+	SimappWithReplay(saved)(&replay)
+	require.Equal(t, o.config, replay.config)
+	require.Equal(t, o.period, replay.period)
+}
+
+func TestExportSimulationTest(t *testing.T) {
+	appPath := t.TempDir()
+	c := &Chain{app: App{Name: "mars", Path: appPath, ImportPath: "github.com/foo/mars"}}
+	f := SimulationFailure{
+		ChainID:            "mars",
+		Seed:               7,
+		InitialBlockHeight: 1,
+		NumBlocks:          200,
+		BlockSize:          30,
+		Height:             12,
+		Error:              "insufficient funds\nmore",
+	}
+
+	_, _, err := c.ExportSimulationTest(f)
+	require.ErrorContains(t, err, "the simulation test of the app isn't found")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "app", simulationTestFile), nil, 0644))
+
+	path, name, err := c.ExportSimulationTest(f)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(appPath, "app", "simulation_failure_7_test.go"), path)
+	require.Equal(t, "TestSimulationFailure7", name)
+
+	src, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(src), `"github.com/foo/mars/app"`)
+	require.Contains(t, string(src), "func TestSimulationFailure7(t *testing.T) {")
+	require.Contains(t, string(src), "// insufficient funds\n//\n")
+	require.Contains(t, string(src), "simapp.FlagSeedValue = 7\n")
+
+	// the blocks after the failed one aren't simulated.
+	require.Contains(t, string(src), "simapp.FlagNumBlocksValue = 12\n")
+}
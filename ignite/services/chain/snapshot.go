@@ -0,0 +1,284 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/otiai10/copy"
+)
+
+const (
+	// snapshotsDir is the directory of the chain save path containing the snapshots.
+	snapshotsDir = "snapshots"
+
+	// snapshotHomeDir is the directory of a snapshot containing the copy of the chain home.
+	snapshotHomeDir = "home"
+
+	// snapshotGenesis is the name of the file of a snapshot containing the exported state.
+	snapshotGenesis = "genesis.json"
+
+	// snapshotMetadata is the name of the file describing a snapshot.
+	snapshotMetadata = "snapshot.json"
+)
+
+var (
+	// ErrSnapshotNotFound is returned when a snapshot doesn't exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	// ErrSnapshotExists is returned when a snapshot is saved with the name of an existing one.
+	ErrSnapshotExists = errors.New("snapshot already exists")
+
+	snapshotNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+)
+
+// Snapshot is a checkpoint of the state and the node data of the chain at a height.
+type Snapshot struct {
+	Name    string    `json:"name"`
+	ChainID string    `json:"chain_id"`
+	Height  int64     `json:"height"`
+	Created time.Time `json:"created"`
+
+	// StateChecksum is the SHA-256 checksum of the exported state, it is checked when the snapshot is restored.
+	StateChecksum string `json:"state_checksum"`
+}
+
+// SaveSnapshot exports the state of the chain and copies its home, node data and keys included,
+// in a snapshot with name. The chain must not be running since the node locks its data.
+// An existing snapshot with the same name is replaced when overwrite is true.
+func (c *Chain) SaveSnapshot(ctx context.Context, name string, overwrite bool) (Snapshot, error) {
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotExists, name)
+	} else if err != nil && !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	isInit, err := c.IsInitialized()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if !isInit {
+		return Snapshot{}, errors.New("the chain is not initialized, serve it before saving a snapshot")
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// the snapshot is written next to the existing one, which is only replaced once it's complete
+	tmpPath := path + ".tmp"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmpPath)
+	if err := os.MkdirAll(tmpPath, 0700); err != nil {
+		return Snapshot{}, err
+	}
+
+	genesisPath := filepath.Join(tmpPath, snapshotGenesis)
+	if err := commands.Export(ctx, genesisPath); err != nil {
+		return Snapshot{}, fmt.Errorf("cannot export the state, make sure the chain is not running: %w", err)
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	height, err := exportedHeight(genesis)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := copy.Copy(home, filepath.Join(tmpPath, snapshotHomeDir)); err != nil {
+		return Snapshot{}, err
+	}
+
+	checksum := sha256.Sum256(genesis)
+	snapshot := Snapshot{
+		Name:          name,
+		ChainID:       chainID,
+		Height:        height,
+		Created:       time.Now().UTC(),
+		StateChecksum: hex.EncodeToString(checksum[:]),
+	}
+	metadata, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, snapshotMetadata), metadata, 0600); err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot replaces the home of the chain with the one of the snapshot with name.
+// The exported state of the snapshot replaces the state saved when serve stops, so the chain
+// restarts from the snapshot even when its source code is modified.
+func (c *Chain) RestoreSnapshot(name string) (Snapshot, error) {
+	snapshot, err := c.Snapshot(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if snapshot.ChainID != chainID {
+		return Snapshot{}, fmt.Errorf("the snapshot %s of the chain %s can't be restored for the chain %s", name, snapshot.ChainID, chainID)
+	}
+
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	genesis, err := os.ReadFile(filepath.Join(path, snapshotGenesis))
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if checksum := sha256.Sum256(genesis); hex.EncodeToString(checksum[:]) != snapshot.StateChecksum {
+		return Snapshot{}, fmt.Errorf("the state of the snapshot %s is corrupted, its checksum doesn't match", name)
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.RemoveAll(home); err != nil {
+		return Snapshot{}, err
+	}
+	if err := copy.Copy(filepath.Join(path, snapshotHomeDir), home); err != nil {
+		return Snapshot{}, err
+	}
+
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.WriteFile(exportedGenesisPath, genesis, 0644); err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// Snapshot returns the snapshot with name.
+func (c *Chain) Snapshot(name string) (Snapshot, error) {
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	data, err := os.ReadFile(filepath.Join(path, snapshotMetadata))
+	if os.IsNotExist(err) {
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotNotFound, name)
+	}
+	if err != nil {
+		return Snapshot{}, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("invalid snapshot %s: %w", name, err)
+	}
+	return snapshot, nil
+}
+
+// Snapshots returns the snapshots of the chain sorted by creation date.
+func (c *Chain) Snapshots() ([]Snapshot, error) {
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for _, entry := range entries {
+		if !entry.IsDir() || !snapshotNameRe.MatchString(entry.Name()) {
+			continue
+		}
+		snapshot, err := c.Snapshot(entry.Name())
+		if errors.Is(err, ErrSnapshotNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Created.Before(snapshots[j].Created) })
+	return snapshots, nil
+}
+
+// snapshotsPath returns the directory of the snapshots of the chain.
+func (c *Chain) snapshotsPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(savePath, snapshotsDir), nil
+}
+
+// snapshotPath returns the directory of the snapshot with name.
+func (c *Chain) snapshotPath(name string) (string, error) {
+	if !snapshotNameRe.MatchString(name) {
+		return "", fmt.Errorf("invalid snapshot name %q, use letters, digits, dots, dashes and underscores", name)
+	}
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// exportedHeight returns the height of the state exported in genesis,
+// the chain exported at a height restarts at the next one.
+func exportedHeight(genesis []byte) (int64, error) {
+	var doc struct {
+		InitialHeight json.RawMessage `json:"initial_height"`
+	}
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		return 0, fmt.Errorf("invalid exported state: %w", err)
+	}
+	var initialHeight string
+	if err := json.Unmarshal(doc.InitialHeight, &initialHeight); err != nil {
+		// the initial height is encoded as a number by older versions
+		initialHeight = string(doc.InitialHeight)
+	}
+	height, err := strconv.ParseInt(initialHeight, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid initial height of the exported state %q", initialHeight)
+	}
+	return height - 1, nil
+}
@@ -0,0 +1,88 @@
+package chain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportedHeight(t *testing.T) {
+	height, err := exportedHeight([]byte(`{"chain_id":"mars","initial_height":"42"}`))
+	require.NoError(t, err)
+	require.EqualValues(t, 41, height)
+
+	height, err = exportedHeight([]byte(`{"initial_height":7}`))
+	require.NoError(t, err)
+	require.EqualValues(t, 6, height)
+
+	_, err = exportedHeight([]byte(`{"chain_id":"mars"}`))
+	require.Error(t, err)
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	home := filepath.Join(t.TempDir(), ".mars")
+	c := &Chain{options: chainOptions{chainID: "mars", homePath: home}}
+
+	writeSnapshot := func(name, chainID string, created time.Time, genesis string) {
+		path, err := c.snapshotPath(name)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(path, snapshotHomeDir, "data"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, snapshotHomeDir, "data", "state.db"), []byte(name), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(path, snapshotGenesis), []byte(genesis), 0644))
+		checksum := sha256.Sum256([]byte(`{"initial_height":"11"}`))
+		metadata, err := json.Marshal(Snapshot{
+			Name:          name,
+			ChainID:       chainID,
+			Height:        10,
+			Created:       created,
+			StateChecksum: hex.EncodeToString(checksum[:]),
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(path, snapshotMetadata), metadata, 0644))
+	}
+	now := time.Now().UTC()
+	writeSnapshot("seeded", "mars", now, `{"initial_height":"11"}`)
+	writeSnapshot("corrupted", "mars", now.Add(time.Second), `{"initial_height":"12"}`)
+	writeSnapshot("venus", "venus", now.Add(-time.Second), `{"initial_height":"11"}`)
+
+	// the home modified since the snapshot is replaced
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", "new.db"), nil, 0644))
+
+	snapshot, err := c.RestoreSnapshot("seeded")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, snapshot.Height)
+	state, err := os.ReadFile(filepath.Join(home, "data", "state.db"))
+	require.NoError(t, err)
+	require.Equal(t, "seeded", string(state))
+	require.NoFileExists(t, filepath.Join(home, "data", "new.db"))
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	require.NoError(t, err)
+	genesis, err := os.ReadFile(exportedGenesisPath)
+	require.NoError(t, err)
+	require.Equal(t, `{"initial_height":"11"}`, string(genesis))
+
+	_, err = c.RestoreSnapshot("corrupted")
+	require.EqualError(t, err, "the state of the snapshot corrupted is corrupted, its checksum doesn't match")
+
+	_, err = c.RestoreSnapshot("venus")
+	require.EqualError(t, err, "the snapshot venus of the chain venus can't be restored for the chain mars")
+
+	_, err = c.RestoreSnapshot("missing")
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+
+	_, err = c.RestoreSnapshot("../mars")
+	require.Error(t, err)
+
+	snapshots, err := c.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	require.Equal(t, []string{"venus", "seeded", "corrupted"}, []string{snapshots[0].Name, snapshots[1].Name, snapshots[2].Name})
+}
@@ -0,0 +1,61 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosstate"
+)
+
+// ExportState exports the application state of the chain at its current height with the export
+// command of the chain binary. The chain must not be running since the node locks its data.
+func (c *Chain) ExportState(ctx context.Context) (cosmosstate.State, error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return cosmosstate.State{}, err
+	}
+
+	dir, err := os.MkdirTemp("", "state")
+	if err != nil {
+		return cosmosstate.State{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	genesisPath := filepath.Join(dir, "genesis.json")
+	if err := commands.Export(ctx, genesisPath); err != nil {
+		return cosmosstate.State{}, fmt.Errorf("cannot export the state, make sure the chain is not running: %w", err)
+	}
+	return readState(genesisPath)
+}
+
+// LoadState returns the application state of source, either the path of a file with an exported
+// state or an exported genesis, or the name of a snapshot of the chain. The file is used when a
+// snapshot has the same name.
+func (c *Chain) LoadState(source string) (cosmosstate.State, error) {
+	if _, err := os.Stat(source); err == nil {
+		return readState(source)
+	}
+
+	if _, err := c.Snapshot(source); err != nil {
+		return cosmosstate.State{}, fmt.Errorf("%s is neither a state file nor a snapshot: %w", source, err)
+	}
+	path, err := c.snapshotPath(source)
+	if err != nil {
+		return cosmosstate.State{}, err
+	}
+	return readState(filepath.Join(path, snapshotGenesis))
+}
+
+func readState(path string) (cosmosstate.State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cosmosstate.State{}, err
+	}
+	state, err := cosmosstate.Parse(data)
+	if err != nil {
+		return cosmosstate.State{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return state, nil
+}
@@ -0,0 +1,40 @@
+package chain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadState(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	c := &Chain{options: chainOptions{chainID: "mars", homePath: filepath.Join(t.TempDir(), ".mars")}}
+
+	// a snapshot of the chain.
+	path, err := c.snapshotPath("seeded")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(path, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(path, snapshotGenesis), []byte(`{"initial_height":"11","app_state":{"bank":{}}}`), 0644))
+	metadata, err := json.Marshal(Snapshot{Name: "seeded", ChainID: "mars", Height: 10})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(path, snapshotMetadata), metadata, 0644))
+
+	state, err := c.LoadState("seeded")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, state.Height)
+	require.Equal(t, []string{"bank"}, state.ModuleNames())
+
+	// a file with an exported state.
+	file := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"height":20,"app_state":{"mars":{}}}`), 0644))
+	state, err = c.LoadState(file)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, state.Height)
+	require.Equal(t, []string{"mars"}, state.ModuleNames())
+
+	_, err = c.LoadState("missing")
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+}
@@ -0,0 +1,464 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+)
+
+// DefaultTestPackages are the packages tested by default, all the packages of the chain.
+var DefaultTestPackages = []string{"./..."}
+
+type testOptions struct {
+	packages     []string
+	jobs         int
+	race         bool
+	coverProfile string
+	timeout      time.Duration
+}
+
+// TestOption configures the tests of the chain.
+type TestOption func(*testOptions)
+
+// TestPackages sets the patterns of the tested packages, e.g. ./x/...
+func TestPackages(patterns ...string) TestOption {
+	return func(o *testOptions) {
+		o.packages = patterns
+	}
+}
+
+// TestParallel tests up to jobs modules of the chain at once, the modules are tested one after
+// the other by default.
+func TestParallel(jobs int) TestOption {
+	return func(o *testOptions) {
+		o.jobs = jobs
+	}
+}
+
+// TestRace enables the race detector.
+func TestRace() TestOption {
+	return func(o *testOptions) {
+		o.race = true
+	}
+}
+
+// TestCoverProfile writes the coverage profile aggregated across the modules to path.
+func TestCoverProfile(path string) TestOption {
+	return func(o *testOptions) {
+		o.coverProfile = path
+	}
+}
+
+// TestTimeout fails the tests of a module running longer than timeout.
+func TestTimeout(timeout time.Duration) TestOption {
+	return func(o *testOptions) {
+		o.timeout = timeout
+	}
+}
+
+// TestReport is the summary of the tests of the chain.
+type TestReport struct {
+	// Modules are the results of the tested modules, sorted by name.
+	Modules []ModuleTestResult
+
+	// Coverage is the percentage of statements covered by the tests of all the modules,
+	// it is only set when a coverage profile is written.
+	Coverage *float64
+
+	// Duration of the tests.
+	Duration time.Duration
+}
+
+// Failed returns true when the tests of a module failed.
+func (r TestReport) Failed() bool {
+	for _, m := range r.Modules {
+		if m.Failed {
+			return true
+		}
+	}
+	return false
+}
+
+// ModuleTestResult is the result of the tests of a module of the chain.
+type ModuleTestResult struct {
+	// Name of the module, the directory of a Cosmos SDK module like x/blog or a top level
+	// directory of the chain like app.
+	Name string
+
+	// Packages are the tested packages of the module.
+	Packages []string
+
+	// Passed, Failed and Skipped are the number of tests and subtests passed, failed and skipped.
+	Passed, FailedTests, Skipped int
+
+	// Failed is true when a test failed or when the packages can't be tested.
+	Failed bool
+
+	// Failures are the names of the failed tests with their package.
+	Failures []string
+
+	// Output is the output of the failed tests and packages.
+	Output string
+
+	// Coverage is the percentage of statements of the module covered by its tests,
+	// it is only set when a coverage profile is written.
+	Coverage *float64
+
+	// Duration of the tests of the module.
+	Duration time.Duration
+}
+
+// Test runs the tests of the chain with go test. The packages are grouped by module and each
+// module is tested by its own go test process, so the heavy test setups of a module, like the
+// app of the simulation tests, don't slow down or break the tests of the other ones.
+func (c *Chain) Test(ctx context.Context, options ...TestOption) (TestReport, error) {
+	o := testOptions{
+		packages: DefaultTestPackages,
+		jobs:     1,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	start := time.Now()
+
+	packages, err := gocmd.List(ctx, c.app.Path, o.packages)
+	if err != nil {
+		return TestReport{}, err
+	}
+	modules := groupTestPackages(c.app.ImportPath, packages)
+	if len(modules) == 0 {
+		return TestReport{}, fmt.Errorf("no package matches %s", strings.Join(o.packages, " "))
+	}
+
+	profilesDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return TestReport{}, err
+	}
+	defer os.RemoveAll(profilesDir)
+
+	results := make([]ModuleTestResult, len(modules))
+	profiles := make([]string, len(modules))
+	jobs := make(chan struct{}, o.jobs)
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i, module := range modules {
+		i, module := i, module
+		if o.coverProfile != "" {
+			profiles[i] = filepath.Join(profilesDir, fmt.Sprintf("%d.out", i))
+		}
+
+		g.Go(func() error {
+			select {
+			case jobs <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			defer func() { <-jobs }()
+
+			result, err := c.testModule(ctx, module, o, profiles[i])
+			results[i] = result
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return TestReport{}, err
+	}
+
+	report := TestReport{Modules: results}
+	if o.coverProfile != "" {
+		var merged coverProfile
+		for i, path := range profiles {
+			profile, err := readCoverProfile(path)
+			if err != nil {
+				return TestReport{}, err
+			}
+			if profile.blocks != nil {
+				coverage := profile.coverage()
+				report.Modules[i].Coverage = &coverage
+			}
+			if err := merged.merge(profile); err != nil {
+				return TestReport{}, err
+			}
+		}
+		if err := merged.write(o.coverProfile); err != nil {
+			return TestReport{}, err
+		}
+		coverage := merged.coverage()
+		report.Coverage = &coverage
+	}
+	report.Duration = time.Since(start)
+
+	return report, nil
+}
+
+// testModule tests the packages of module in a go test process.
+func (c *Chain) testModule(ctx context.Context, module testModule, o testOptions, coverProfile string) (ModuleTestResult, error) {
+	flags := []string{gocmd.FlagJSON}
+	if o.race {
+		flags = append(flags, gocmd.FlagRace)
+	}
+	if coverProfile != "" {
+		flags = append(flags, gocmd.FlagCoverProfile, coverProfile)
+	}
+	if o.timeout > 0 {
+		flags = append(flags, gocmd.FlagTimeout, o.timeout.String())
+	}
+	if len(c.options.buildTags) > 0 {
+		flags = append(flags, gocmd.FlagTags, gocmd.Tags(c.options.buildTags...))
+	}
+
+	start := time.Now()
+
+	var events bytes.Buffer
+	testErr := gocmd.Test(ctx, c.app.Path, flags, module.packages, exec.StepOption(step.Stdout(&events)))
+	if ctx.Err() != nil {
+		return ModuleTestResult{}, ctx.Err()
+	}
+
+	result, err := parseTestEvents(&events)
+	if err != nil {
+		return ModuleTestResult{}, err
+	}
+	result.Name = module.name
+	result.Packages = module.packages
+	result.Duration = time.Since(start)
+
+	// go test fails without test failures when the packages can't be built or tested.
+	if testErr != nil && !result.Failed {
+		result.Failed = true
+		result.Output += testErr.Error()
+	}
+	return result, nil
+}
+
+// testModule is a group of packages tested by the same go test process.
+type testModule struct {
+	name     string
+	packages []string
+}
+
+// groupTestPackages groups the packages of the chain with the import path by module: the packages
+// of a Cosmos SDK module are grouped by its directory in x/, the other packages by their top
+// level directory.
+func groupTestPackages(importPath string, packages []string) []testModule {
+	byName := make(map[string][]string)
+	for _, pkg := range packages {
+		name := "."
+		if rel := strings.TrimPrefix(pkg, importPath+"/"); rel != pkg {
+			parts := strings.Split(rel, "/")
+			name = parts[0]
+			if name == "x" && len(parts) > 1 {
+				name = "x/" + parts[1]
+			}
+		}
+		byName[name] = append(byName[name], pkg)
+	}
+
+	var modules []testModule
+	for name, packages := range byName {
+		modules = append(modules, testModule{name, packages})
+	}
+	sort.Slice(modules, func(i, j int) bool { return modules[i].name < modules[j].name })
+	return modules
+}
+
+// testEvent is an event of the JSON output of go test.
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Output  string
+}
+
+// parseTestEvents parses the JSON output of go test into the result of the tests.
+func parseTestEvents(r io.Reader) (ModuleTestResult, error) {
+	var (
+		result ModuleTestResult
+		output = make(map[string]*strings.Builder)
+		failed []string
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		// the build errors are printed before the events
+		if !bytes.HasPrefix(line, []byte("{")) {
+			continue
+		}
+
+		var e testEvent
+		if err := json.Unmarshal(line, &e); err != nil {
+			return ModuleTestResult{}, fmt.Errorf("cannot parse the output of go test: %w", err)
+		}
+
+		key := e.Package
+		if e.Test != "" {
+			key += "." + e.Test
+		}
+
+		switch e.Action {
+		case "output":
+			if output[key] == nil {
+				output[key] = &strings.Builder{}
+			}
+			output[key].WriteString(e.Output)
+		case "pass":
+			if e.Test != "" {
+				result.Passed++
+			}
+		case "skip":
+			if e.Test != "" {
+				result.Skipped++
+			}
+		case "fail":
+			result.Failed = true
+			if e.Test != "" {
+				result.FailedTests++
+				result.Failures = append(result.Failures, key)
+			}
+			failed = append(failed, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return ModuleTestResult{}, err
+	}
+
+	var b strings.Builder
+	for _, key := range failed {
+		if o, ok := output[key]; ok {
+			b.WriteString(o.String())
+		}
+	}
+	result.Output = b.String()
+	return result, nil
+}
+
+// coverProfile is a coverage profile of go test.
+type coverProfile struct {
+	mode string
+
+	// blocks are the lines of the profile without their count, in their order in the profile.
+	blocks []string
+
+	// counts are the counts of the blocks.
+	counts map[string]int
+}
+
+// readCoverProfile reads the coverage profile at path, the profile is empty when
+// the file doesn't exist because the tests failed to build.
+func readCoverProfile(path string) (coverProfile, error) {
+	var profile coverProfile
+	if path == "" {
+		return profile, nil
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return profile, nil
+	}
+	if err != nil {
+		return profile, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if mode := strings.TrimPrefix(line, "mode: "); mode != line {
+			profile.mode = mode
+			continue
+		}
+		i := strings.LastIndex(line, " ")
+		if i == -1 {
+			continue
+		}
+		count, err := strconv.Atoi(line[i+1:])
+		if err != nil {
+			return profile, fmt.Errorf("invalid coverage profile %s: %w", path, err)
+		}
+		profile.add(line[:i], count)
+	}
+	return profile, scanner.Err()
+}
+
+// add adds count to the count of the block, the count of the set mode is 0 or 1.
+func (p *coverProfile) add(block string, count int) {
+	if p.counts == nil {
+		p.counts = make(map[string]int)
+	}
+	current, ok := p.counts[block]
+	if !ok {
+		p.blocks = append(p.blocks, block)
+	}
+	if p.mode == "set" {
+		if count > current {
+			p.counts[block] = count
+		}
+		return
+	}
+	p.counts[block] = current + count
+}
+
+// merge adds the blocks of other to the profile.
+func (p *coverProfile) merge(other coverProfile) error {
+	if other.mode == "" {
+		return nil
+	}
+	if p.mode == "" {
+		p.mode = other.mode
+	}
+	if p.mode != other.mode {
+		return fmt.Errorf("cannot merge the coverage profiles of the %s and %s modes", p.mode, other.mode)
+	}
+	for _, block := range other.blocks {
+		p.add(block, other.counts[block])
+	}
+	return nil
+}
+
+// coverage returns the percentage of the statements covered by the profile.
+func (p coverProfile) coverage() float64 {
+	var total, covered int
+	for _, block := range p.blocks {
+		// the blocks end with their number of statements.
+		statements, _ := strconv.Atoi(block[strings.LastIndex(block, " ")+1:])
+		total += statements
+		if p.counts[block] > 0 {
+			covered += statements
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(covered) * 100 / float64(total)
+}
+
+// write writes the profile to path.
+func (p coverProfile) write(path string) error {
+	var b strings.Builder
+	mode := p.mode
+	if mode == "" {
+		mode = "set"
+	}
+	fmt.Fprintf(&b, "mode: %s\n", mode)
+	for _, block := range p.blocks {
+		fmt.Fprintf(&b, "%s %d\n", block, p.counts[block])
+	}
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
@@ -0,0 +1,98 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupTestPackages(t *testing.T) {
+	modules := groupTestPackages("github.com/alice/mars", []string{
+		"github.com/alice/mars/x/mars/keeper",
+		"github.com/alice/mars/app",
+		"github.com/alice/mars/x/mars/types",
+		"github.com/alice/mars/x/venus",
+		"github.com/alice/mars/testutil/network",
+		"github.com/alice/mars",
+	})
+	require.Equal(t, []testModule{
+		{".", []string{"github.com/alice/mars"}},
+		{"app", []string{"github.com/alice/mars/app"}},
+		{"testutil", []string{"github.com/alice/mars/testutil/network"}},
+		{"x/mars", []string{"github.com/alice/mars/x/mars/keeper", "github.com/alice/mars/x/mars/types"}},
+		{"x/venus", []string{"github.com/alice/mars/x/venus"}},
+	}, modules)
+}
+
+func TestParseTestEvents(t *testing.T) {
+	events := `# github.com/alice/mars/x/mars/keeper
+{"Action":"run","Package":"github.com/alice/mars/x/mars/keeper","Test":"TestParams"}
+{"Action":"output","Package":"github.com/alice/mars/x/mars/keeper","Test":"TestParams","Output":"=== RUN   TestParams\n"}
+{"Action":"pass","Package":"github.com/alice/mars/x/mars/keeper","Test":"TestParams"}
+{"Action":"run","Package":"github.com/alice/mars/x/mars/keeper","Test":"TestPost"}
+{"Action":"output","Package":"github.com/alice/mars/x/mars/keeper","Test":"TestPost","Output":"    post_test.go:12: unexpected count\n"}
+{"Action":"fail","Package":"github.com/alice/mars/x/mars/keeper","Test":"TestPost"}
+{"Action":"output","Package":"github.com/alice/mars/x/mars/keeper","Output":"FAIL\n"}
+{"Action":"fail","Package":"github.com/alice/mars/x/mars/keeper"}
+{"Action":"skip","Package":"github.com/alice/mars/x/mars/types","Test":"TestGenesis/invalid"}
+{"Action":"pass","Package":"github.com/alice/mars/x/mars/types","Test":"TestGenesis"}
+{"Action":"pass","Package":"github.com/alice/mars/x/mars/types"}
+`
+	result, err := parseTestEvents(strings.NewReader(events))
+	require.NoError(t, err)
+	require.True(t, result.Failed)
+	require.Equal(t, 2, result.Passed)
+	require.Equal(t, 1, result.FailedTests)
+	require.Equal(t, 1, result.Skipped)
+	require.Equal(t, []string{"github.com/alice/mars/x/mars/keeper.TestPost"}, result.Failures)
+	require.Equal(t, "    post_test.go:12: unexpected count\nFAIL\n", result.Output)
+}
+
+func TestMergeCoverProfiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	keeper, err := readCoverProfile(write("keeper.out", `mode: atomic
+github.com/alice/mars/x/mars/keeper/post.go:10.2,12.3 2 1
+github.com/alice/mars/x/mars/types/genesis.go:5.2,8.3 3 0
+`))
+	require.NoError(t, err)
+	require.InDelta(t, 40, keeper.coverage(), 0.01)
+
+	types, err := readCoverProfile(write("types.out", `mode: atomic
+github.com/alice/mars/x/mars/types/genesis.go:5.2,8.3 3 4
+github.com/alice/mars/x/mars/types/msgs.go:3.2,4.3 5 0
+`))
+	require.NoError(t, err)
+
+	// the profile of a module failing to build doesn't exist
+	missing, err := readCoverProfile(filepath.Join(dir, "missing.out"))
+	require.NoError(t, err)
+
+	var merged coverProfile
+	require.NoError(t, merged.merge(keeper))
+	require.NoError(t, merged.merge(missing))
+	require.NoError(t, merged.merge(types))
+	require.InDelta(t, 50, merged.coverage(), 0.01)
+
+	path := filepath.Join(dir, "merged.out")
+	require.NoError(t, merged.write(path))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `mode: atomic
+github.com/alice/mars/x/mars/keeper/post.go:10.2,12.3 2 1
+github.com/alice/mars/x/mars/types/genesis.go:5.2,8.3 3 4
+github.com/alice/mars/x/mars/types/msgs.go:3.2,4.3 5 0
+`, string(content))
+
+	set, err := readCoverProfile(write("set.out", "mode: set\n"))
+	require.NoError(t, err)
+	require.EqualError(t, merged.merge(set), "cannot merge the coverage profiles of the atomic and set modes")
+}
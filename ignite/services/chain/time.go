@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// DevBuildTag is the build tag of the binaries built by serve,
+	// it includes the dev-only features of the app like the time travel.
+	DevBuildTag = "ignite_dev"
+
+	// timeOffsetFile is the file in the chain home holding the offset added to the block time.
+	timeOffsetFile = "config/time_offset"
+
+	// timeTravelFile is the app file adding the time offset to the block time.
+	timeTravelFile = "app/timetravel.go"
+)
+
+var (
+	// ErrTimeTravelNotSupported is returned when the app doesn't support the block time offset.
+	ErrTimeTravelNotSupported = fmt.Errorf("the chain doesn't support time travel, %s is missing", timeTravelFile)
+
+	errTimeNotAdvanced = errors.New("the time can only be advanced by a positive duration")
+)
+
+// TimeOffset returns the offset added to the block time of the served chain.
+func (c *Chain) TimeOffset() (time.Duration, error) {
+	path, err := c.timeOffsetPath()
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return time.ParseDuration(strings.TrimSpace(string(data)))
+}
+
+// AdvanceTime fast-forwards the block time of the served chain by d starting from the next block.
+// It returns the total offset added to the block time.
+func (c *Chain) AdvanceTime(d time.Duration) (time.Duration, error) {
+	if d <= 0 {
+		return 0, errTimeNotAdvanced
+	}
+	if _, err := os.Stat(filepath.Join(c.app.Path, timeTravelFile)); os.IsNotExist(err) {
+		return 0, ErrTimeTravelNotSupported
+	} else if err != nil {
+		return 0, err
+	}
+
+	offset, err := c.TimeOffset()
+	if err != nil {
+		return 0, err
+	}
+	offset += d
+
+	path, err := c.timeOffsetPath()
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(path, []byte(offset.String()), 0644); err != nil {
+		return 0, err
+	}
+
+	return offset, nil
+}
+
+func (c *Chain) timeOffsetPath() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, timeOffsetFile), nil
+}
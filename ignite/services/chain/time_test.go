@@ -0,0 +1,112 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvanceTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		timeTravel bool
+		offset     string
+		advance    time.Duration
+		want       time.Duration
+		err        error
+	}{
+		{
+			name:       "first advance",
+			timeTravel: true,
+			advance:    24 * time.Hour,
+			want:       24 * time.Hour,
+		},
+		{
+			name:       "advance added to the offset",
+			timeTravel: true,
+			offset:     "1h0m0s\n",
+			advance:    30 * time.Minute,
+			want:       90 * time.Minute,
+		},
+		{
+			name:    "chain without time travel",
+			advance: time.Hour,
+			err:     ErrTimeTravelNotSupported,
+		},
+		{
+			name:       "zero duration",
+			timeTravel: true,
+			err:        errTimeNotAdvanced,
+		},
+		{
+			name:       "negative duration",
+			timeTravel: true,
+			advance:    -time.Hour,
+			err:        errTimeNotAdvanced,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				appPath = t.TempDir()
+				home    = t.TempDir()
+				c       = &Chain{app: App{Name: "mars", Path: appPath}, options: chainOptions{homePath: home}}
+			)
+			require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+			if tt.timeTravel {
+				require.NoError(t, os.MkdirAll(filepath.Join(appPath, "app"), 0755))
+				require.NoError(t, os.WriteFile(filepath.Join(appPath, timeTravelFile), nil, 0644))
+			}
+			if tt.offset != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(home, timeOffsetFile), []byte(tt.offset), 0644))
+			}
+
+			offset, err := c.AdvanceTime(tt.advance)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, offset)
+
+			// the offset is read back by the served chain.
+			offset, err = c.TimeOffset()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, offset)
+		})
+	}
+}
+
+func TestTimeOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset string
+		want   time.Duration
+		err    bool
+	}{
+		{name: "no offset"},
+		{name: "offset", offset: "48h0m0s", want: 48 * time.Hour},
+		{name: "invalid offset", offset: "two days", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			c := &Chain{options: chainOptions{homePath: home}}
+			if tt.offset != "" {
+				require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+				require.NoError(t, os.WriteFile(filepath.Join(home, timeOffsetFile), []byte(tt.offset), 0644))
+			}
+
+			offset, err := c.TimeOffset()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, offset)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package chain
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosgenesis"
+)
+
+// Validate validates the genesis of the chain with the validate-genesis command of the chain
+// binary. In strict mode, the genesis is also checked against the deterministic rules of the
+// cosmosgenesis package and their findings are returned.
+func (c *Chain) Validate(ctx context.Context, strict bool) (cosmosgenesis.Findings, error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := commands.ValidateGenesis(ctx); err != nil {
+		return nil, errors.Wrap(err, "the genesis is invalid")
+	}
+
+	if !strict {
+		return nil, nil
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read the genesis file")
+	}
+
+	return cosmosgenesis.Check(genesis)
+}
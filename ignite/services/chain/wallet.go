@@ -0,0 +1,193 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmoswallet"
+)
+
+const (
+	// defaultWalletPath is the dir of the wallet helper in the Vue app.
+	defaultWalletPath = "vue/src/wallet"
+
+	// defaultAddressPrefix is the account address prefix of the Cosmos SDK.
+	defaultAddressPrefix = "cosmos"
+)
+
+// addressPrefixRe matches the account address prefix declared by the app scaffolded with Ignite.
+var addressPrefixRe = regexp.MustCompile(`AccountAddressPrefix\s*=\s*"(\w+)"`)
+
+// walletGenesis holds the fields of the genesis describing the coins of the chain.
+type walletGenesis struct {
+	ChainID  string `json:"chain_id"`
+	AppState struct {
+		Bank struct {
+			Supply        sdktypes.Coins       `json:"supply"`
+			DenomMetadata []banktypes.Metadata `json:"denom_metadata"`
+		} `json:"bank"`
+		Staking struct {
+			Params struct {
+				BondDenom string `json:"bond_denom"`
+			} `json:"params"`
+		} `json:"staking"`
+	} `json:"app_state"`
+}
+
+// WalletChainInfo returns the chain info suggested to the browser wallets. The coins of the chain are
+// the coins of the accounts of the config, and the coins of the genesis once the chain is initialized.
+// The staking coin pays the fees.
+//
+// The endpoints are the rpc and rest addresses, the local servers of the config are used when they are empty.
+func (c *Chain) WalletChainInfo(rpc, rest string) (cosmoswallet.ChainInfo, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return cosmoswallet.ChainInfo{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return cosmoswallet.ChainInfo{}, err
+	}
+
+	var gen walletGenesis
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return cosmoswallet.ChainInfo{}, err
+	}
+	switch genesis, err := os.ReadFile(genesisPath); {
+	case os.IsNotExist(err):
+	case err != nil:
+		return cosmoswallet.ChainInfo{}, err
+	default:
+		if err := json.Unmarshal(genesis, &gen); err != nil {
+			return cosmoswallet.ChainInfo{}, fmt.Errorf("invalid genesis %s: %w", genesisPath, err)
+		}
+		chainID = gen.ChainID
+	}
+
+	stakeDenom := gen.AppState.Staking.Params.BondDenom
+	if stakeDenom == "" {
+		staked, err := sdktypes.ParseCoinNormalized(conf.Validator.Staked)
+		if err != nil {
+			return cosmoswallet.ChainInfo{}, fmt.Errorf("invalid staked coin of the validator: %w", err)
+		}
+		stakeDenom = staked.Denom
+	}
+
+	denoms := map[string]bool{stakeDenom: true}
+	for _, account := range conf.Accounts {
+		for _, coin := range account.Coins {
+			parsed, err := sdktypes.ParseCoinNormalized(coin)
+			if err != nil {
+				return cosmoswallet.ChainInfo{}, fmt.Errorf("invalid coin of the account %s: %w", account.Name, err)
+			}
+			denoms[parsed.Denom] = true
+		}
+	}
+	for _, coin := range gen.AppState.Bank.Supply {
+		denoms[coin.Denom] = true
+	}
+
+	info := cosmoswallet.ChainInfo{
+		ChainID:      chainID,
+		ChainName:    c.app.N(),
+		RPC:          rpc,
+		REST:         rest,
+		BIP44:        cosmoswallet.BIP44{CoinType: cosmoswallet.CoinTypeCosmos},
+		Bech32Config: cosmoswallet.NewBech32Config(c.addressPrefix()),
+	}
+	for _, server := range chainconfig.Servers(conf) {
+		switch {
+		case server.Name == "Tendermint RPC" && info.RPC == "":
+			info.RPC = fmt.Sprintf("http://localhost:%d", server.Port)
+		case server.Name == "API" && info.REST == "":
+			info.REST = fmt.Sprintf("http://localhost:%d", server.Port)
+		}
+	}
+
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+	for _, denom := range sorted {
+		info.Currencies = append(info.Currencies, cosmoswallet.NewCurrency(denom, gen.AppState.Bank.DenomMetadata))
+	}
+
+	info.StakeCurrency = cosmoswallet.NewCurrency(stakeDenom, gen.AppState.Bank.DenomMetadata)
+	info.FeeCurrencies = []cosmoswallet.FeeCurrency{{
+		Currency:     info.StakeCurrency,
+		GasPriceStep: gasPriceStep(conf, stakeDenom),
+	}}
+
+	return info, nil
+}
+
+// WalletFiles returns the files of the wallet helper of the frontend by path, relative to the app.
+// The helper adds the chain to the browser wallets with its chain info.
+func (c *Chain) WalletFiles(rpc, rest string) (map[string][]byte, error) {
+	info, err := c.WalletChainInfo(rpc, rest)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := cosmoswallet.Files(info)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string][]byte, len(files))
+	for name, content := range files {
+		paths[filepath.Join(defaultWalletPath, name)] = content
+	}
+	return paths, nil
+}
+
+// addressPrefix returns the account address prefix of the app, the prefix of the Cosmos SDK is used
+// when the app doesn't declare it.
+func (c *Chain) addressPrefix() string {
+	appGo, err := os.ReadFile(filepath.Join(c.app.Path, "app", "app.go"))
+	if err != nil {
+		return defaultAddressPrefix
+	}
+	if match := addressPrefixRe.FindSubmatch(appGo); match != nil {
+		return string(match[1])
+	}
+	return defaultAddressPrefix
+}
+
+// gasPriceStep returns the gas prices offered by the wallets for the denom, they are raised to the
+// minimum gas price of the node when it's higher than the low gas price by default.
+func gasPriceStep(conf chainconfig.Config, denom string) cosmoswallet.GasPriceStep {
+	step := cosmoswallet.DefaultGasPriceStep
+
+	minGasPrices, ok := conf.Init.App["minimum-gas-prices"].(string)
+	if !ok {
+		return step
+	}
+	prices, err := sdktypes.ParseDecCoins(minGasPrices)
+	if err != nil {
+		return step
+	}
+	minGasPrice, err := prices.AmountOf(denom).Float64()
+	if err != nil || minGasPrice <= step.Low {
+		return step
+	}
+
+	// the gas prices keep the ratios of the default ones.
+	ratio := minGasPrice / step.Low
+	return cosmoswallet.GasPriceStep{
+		Low:     minGasPrice,
+		Average: step.Average * ratio,
+		High:    step.High * ratio,
+	}
+}
@@ -0,0 +1,23 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmoswallet"
+)
+
+func TestGasPriceStep(t *testing.T) {
+	var conf chainconfig.Config
+	require.Equal(t, cosmoswallet.DefaultGasPriceStep, gasPriceStep(conf, "stake"))
+
+	conf.Init.App = map[string]interface{}{"minimum-gas-prices": "0.1stake"}
+	step := gasPriceStep(conf, "stake")
+	require.InDelta(t, 0.1, step.Low, 1e-9)
+	require.InDelta(t, 0.25, step.Average, 1e-9)
+	require.InDelta(t, 0.4, step.High, 1e-9)
+
+	require.Equal(t, cosmoswallet.DefaultGasPriceStep, gasPriceStep(conf, "token"))
+}
@@ -0,0 +1,87 @@
+package chain
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+)
+
+const (
+	// wasmvmModulePath is the module of wasmvm, the cgo library running the CosmWasm contracts.
+	wasmvmModulePath = "github.com/CosmWasm/wasmvm"
+
+	// wasmvmMuslTag is the build tag linking wasmvm statically with its musl library.
+	wasmvmMuslTag = "muslc"
+)
+
+// wasmvmCrossCompilers are the C compilers used to cross compile an app linking wasmvm by target.
+var wasmvmCrossCompilers = map[string]string{
+	gocmd.BuildTarget("linux", "amd64"): "x86_64-linux-gnu-gcc",
+	gocmd.BuildTarget("linux", "arm64"): "aarch64-linux-gnu-gcc",
+}
+
+// wasmvmMuslLibDirs are the dirs searched for the static musl library of wasmvm.
+var wasmvmMuslLibDirs = []string{"/lib", "/usr/lib"}
+
+// wasmvmVersion returns the version of wasmvm required by the app, it's empty when the app
+// doesn't link wasmvm.
+func wasmvmVersion(appPath string) (string, error) {
+	modFile, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range modFile.Require {
+		if r.Mod.Path == wasmvmModulePath {
+			return r.Mod.Version, nil
+		}
+	}
+	return "", nil
+}
+
+// wasmvmEnv returns the env of the build of an app linking wasmvm for goos/goarch.
+// cgo is disabled by default when cross compiling, it's enabled with the C compiler of the target.
+func wasmvmEnv(goos, goarch string, musl bool) ([]string, error) {
+	env := []string{cmdrunner.Env(gocmd.EnvCGOEnabled, "1")}
+	if goos == runtime.GOOS && goarch == runtime.GOARCH {
+		return env, nil
+	}
+
+	target := gocmd.BuildTarget(goos, goarch)
+	if musl {
+		return nil, fmt.Errorf("cannot cross compile to %s an app linking wasmvm on a musl system, build it on the target instead", target)
+	}
+
+	cc, ok := wasmvmCrossCompilers[target]
+	if !ok {
+		return nil, fmt.Errorf("cannot cross compile to %s an app linking wasmvm, build it on the target instead", target)
+	}
+	if _, err := exec.LookPath(cc); err != nil {
+		return nil, fmt.Errorf("%s is required to cross compile to %s an app linking wasmvm, install it and add it to your PATH", cc, target)
+	}
+	return append(env, cmdrunner.Env(gocmd.EnvCC, cc)), nil
+}
+
+// checkWasmvmMuslLib checks that the static musl library of wasmvm is installed, the apps linking
+// wasmvm are built with it on musl systems.
+func checkWasmvmMuslLib(version string) error {
+	for _, dir := range wasmvmMuslLibDirs {
+		libs, err := filepath.Glob(filepath.Join(dir, "libwasmvm_muslc*.a"))
+		if err != nil {
+			return err
+		}
+		if len(libs) > 0 {
+			return nil
+		}
+	}
+	return errors.Errorf(
+		"libwasmvm_muslc.a is required to build an app linking wasmvm on a musl system, download it to /lib from https://github.com/CosmWasm/wasmvm/releases/tag/%s",
+		version,
+	)
+}
@@ -0,0 +1,74 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmvmVersion(t *testing.T) {
+	dir := t.TempDir()
+	goMod := filepath.Join(dir, "go.mod")
+
+	require.NoError(t, os.WriteFile(goMod, []byte("module github.com/owner/app\n\ngo 1.18\n\nrequire github.com/cosmos/cosmos-sdk v0.45.4\n"), 0644))
+	version, err := wasmvmVersion(dir)
+	require.NoError(t, err)
+	require.Empty(t, version)
+
+	require.NoError(t, os.WriteFile(goMod, []byte("module github.com/owner/app\n\ngo 1.18\n\nrequire (\n\tgithub.com/CosmWasm/wasmvm v1.0.0\n\tgithub.com/cosmos/cosmos-sdk v0.45.4\n)\n"), 0644))
+	version, err = wasmvmVersion(dir)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", version)
+}
+
+func TestWasmvmEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake compiler is a shell script")
+	}
+
+	// the host build only enables cgo.
+	env, err := wasmvmEnv(runtime.GOOS, runtime.GOARCH, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"CGO_ENABLED=1"}, env)
+
+	env, err = wasmvmEnv(runtime.GOOS, runtime.GOARCH, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"CGO_ENABLED=1"}, env)
+
+	target := "arm64"
+	if runtime.GOARCH == "arm64" {
+		target = "amd64"
+	}
+	cc := wasmvmCrossCompilers["linux:"+target]
+
+	_, err = wasmvmEnv("windows", "amd64", false)
+	require.EqualError(t, err, "cannot cross compile to windows:amd64 an app linking wasmvm, build it on the target instead")
+
+	_, err = wasmvmEnv("linux", target, true)
+	require.EqualError(t, err, "cannot cross compile to linux:"+target+" an app linking wasmvm on a musl system, build it on the target instead")
+
+	// the C compiler of the target is required.
+	bin := t.TempDir()
+	t.Setenv("PATH", bin)
+	_, err = wasmvmEnv("linux", target, false)
+	require.EqualError(t, err, cc+" is required to cross compile to linux:"+target+" an app linking wasmvm, install it and add it to your PATH")
+
+	require.NoError(t, os.WriteFile(filepath.Join(bin, cc), []byte("#!/bin/sh\n"), 0755))
+	env, err = wasmvmEnv("linux", target, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"CGO_ENABLED=1", "CC=" + cc}, env)
+}
+
+func TestCheckWasmvmMuslLib(t *testing.T) {
+	dir := t.TempDir()
+	defer func(dirs []string) { wasmvmMuslLibDirs = dirs }(wasmvmMuslLibDirs)
+	wasmvmMuslLibDirs = []string{filepath.Join(dir, "lib"), dir}
+
+	require.EqualError(t, checkWasmvmMuslLib("v1.0.0"), "libwasmvm_muslc.a is required to build an app linking wasmvm on a musl system, download it to /lib from https://github.com/CosmWasm/wasmvm/releases/tag/v1.0.0")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "libwasmvm_muslc.aarch64.a"), nil, 0644))
+	require.NoError(t, checkWasmvmMuslLib("v1.0.0"))
+}
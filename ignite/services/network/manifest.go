@@ -0,0 +1,136 @@
+package network
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// LaunchManifest returns the manifest of the launch artifacts from the genesis
+// prepared at genesisPath, the binaries installed in PATH and the chain peers.
+func (n Network) LaunchManifest(
+	ctx context.Context,
+	chainLaunch networktypes.ChainLaunch,
+	genesisPath string,
+	binaries ...string,
+) (networktypes.LaunchManifest, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Computing the launch manifest"))
+
+	genesisHash, err := checksum.File(genesisPath)
+	if err != nil {
+		return networktypes.LaunchManifest{}, err
+	}
+
+	manifest := networktypes.LaunchManifest{
+		LaunchID:    chainLaunch.ID,
+		ChainID:     chainLaunch.ChainID,
+		GenesisHash: genesisHash,
+		Binaries:    make(map[string]string),
+		Peers:       []string{},
+	}
+
+	for _, binary := range binaries {
+		if manifest.Binaries[binary], err = checksum.Binary(binary); err != nil {
+			return networktypes.LaunchManifest{}, err
+		}
+	}
+
+	validators, err := n.GenesisValidators(ctx, chainLaunch.ID)
+	if err != nil {
+		return networktypes.LaunchManifest{}, err
+	}
+	for _, validator := range validators {
+		peer, err := PeerAddress(validator.Peer)
+		if err != nil {
+			return networktypes.LaunchManifest{}, err
+		}
+		manifest.Peers = append(manifest.Peers, peer)
+	}
+	sort.Strings(manifest.Peers)
+
+	n.ev.Send(events.New(events.StatusDone, "Launch manifest computed"))
+
+	return manifest, nil
+}
+
+// SignLaunchManifest signs the launch manifest with the account of the chain coordinator.
+func (n Network) SignLaunchManifest(
+	ctx context.Context,
+	manifest networktypes.LaunchManifest,
+) (networktypes.SignedLaunchManifest, error) {
+	address := n.account.Address(networktypes.SPN)
+	if err := n.checkCoordinator(ctx, manifest.LaunchID, address); err != nil {
+		return networktypes.SignedLaunchManifest{}, err
+	}
+
+	signBytes, err := manifest.SignBytes()
+	if err != nil {
+		return networktypes.SignedLaunchManifest{}, err
+	}
+
+	signature, pubKey, err := n.cosmos.Context().Keyring.Sign(n.account.Name, signBytes)
+	if err != nil {
+		return networktypes.SignedLaunchManifest{}, err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, "Launch manifest signed"))
+
+	return networktypes.SignedLaunchManifest{
+		Manifest:  manifest,
+		Signer:    address,
+		PubKey:    pubKey.Bytes(),
+		Signature: signature,
+	}, nil
+}
+
+// VerifyLaunchManifest checks that the launch manifest is signed by the coordinator of the chain.
+func (n Network) VerifyLaunchManifest(ctx context.Context, signed networktypes.SignedLaunchManifest) error {
+	n.ev.Send(events.New(events.StatusOngoing, "Verifying the launch manifest signature"))
+
+	if err := signed.Verify(); err != nil {
+		return err
+	}
+	if err := n.checkCoordinator(ctx, signed.Manifest.LaunchID, signed.Signer); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, "Launch manifest signature verified"))
+
+	return nil
+}
+
+// checkCoordinator returns an error if the address isn't the coordinator of the chain.
+func (n Network) checkCoordinator(ctx context.Context, launchID uint64, address string) error {
+	chainRes, err := n.launchQuery.
+		Chain(ctx,
+			&launchtypes.QueryGetChainRequest{
+				LaunchID: launchID,
+			},
+		)
+	if err != nil {
+		return err
+	}
+
+	coordinatorRes, err := n.profileQuery.
+		CoordinatorByAddress(ctx,
+			&profiletypes.QueryGetCoordinatorByAddressRequest{
+				Address: address,
+			},
+		)
+	if err != nil {
+		return errors.Wrapf(err, "%s is not a coordinator", address)
+	}
+
+	if coordinatorRes.CoordinatorByAddress.CoordinatorID != chainRes.Chain.CoordinatorID {
+		return errors.Errorf("%s is not the coordinator of the chain %d", address, launchID)
+	}
+
+	return nil
+}
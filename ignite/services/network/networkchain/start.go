@@ -0,0 +1,110 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	defaultStartRetries    = 3
+	defaultStartRetryDelay = 10 * time.Second
+)
+
+type startOptions struct {
+	retries    int
+	retryDelay time.Duration
+	onFailure  func(attempt int, err error)
+}
+
+// StartOption configures the start of the node.
+type StartOption func(*startOptions)
+
+// StartRetries sets the number of times the node is restarted after a failure and the delay between restarts.
+func StartRetries(retries int, delay time.Duration) StartOption {
+	return func(o *startOptions) {
+		o.retries = retries
+		o.retryDelay = delay
+	}
+}
+
+// StartOnFailure sets a func called each time the node fails, it can be used to alert the validator.
+func StartOnFailure(onFailure func(attempt int, err error)) StartOption {
+	return func(o *startOptions) {
+		o.onFailure = onFailure
+	}
+}
+
+// Start waits until the launch time and starts the node of the chain.
+// The node is restarted when it fails, until the number of retries is reached.
+func (c Chain) Start(ctx context.Context, launchTime time.Time, options ...StartOption) error {
+	o := startOptions{
+		retries:    defaultStartRetries,
+		retryDelay: defaultStartRetryDelay,
+		onFailure:  func(int, error) {},
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if err := c.waitLaunchTime(ctx, launchTime); err != nil {
+		return err
+	}
+
+	cmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		c.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Starting the node (attempt %d)", attempt)))
+
+		err := cmd.Start(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			err = errors.New("the node stopped")
+		}
+		o.onFailure(attempt, err)
+
+		if attempt > o.retries {
+			return errors.Wrapf(err, "the node failed to start after %d attempts", attempt)
+		}
+
+		c.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("The node failed, restarting in %s", o.retryDelay)))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(o.retryDelay):
+		}
+	}
+}
+
+// waitLaunchTime counts down until the launch time.
+func (c Chain) waitLaunchTime(ctx context.Context, launchTime time.Time) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		remaining := time.Until(launchTime)
+		if remaining <= 0 {
+			return nil
+		}
+		c.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf(
+			"Launch of %s in %s",
+			c.id,
+			remaining.Truncate(time.Second),
+		)))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
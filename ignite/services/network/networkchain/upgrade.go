@@ -0,0 +1,140 @@
+package networkchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+const (
+	// upgradeDir is the dir of the home of the chain holding the artifacts of the upgrades.
+	upgradeDir = "upgrade"
+
+	// upgradePlanFile is the x/upgrade plan of an upgrade, it's the content of the software
+	// upgrade proposal of an in place upgrade.
+	upgradePlanFile = "plan.json"
+
+	// upgradeGenesisFile is the genesis exported at the halt height and migrated to the new chain id.
+	upgradeGenesisFile = "genesis.json"
+)
+
+// UpgradePath returns the path of the artifacts of the upgrade in the home of the chain.
+func (c Chain) UpgradePath(plan networktypes.UpgradePlan) (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, upgradeDir, plan.Name), nil
+}
+
+// PrepareUpgrade prepares the node of the chain for the upgrade plan, the x/upgrade plan of the upgrade
+// is written in the upgrade path. When the chain is restarted with a new chain id, the node is configured
+// to halt at the upgrade height to export its genesis.
+func (c Chain) PrepareUpgrade(plan networktypes.UpgradePlan) (upgradePath string, err error) {
+	c.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Preparing upgrade %s", plan.Name)))
+
+	if upgradePath, err = c.UpgradePath(plan); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(upgradePath, 0755); err != nil {
+		return "", err
+	}
+
+	// the height is a string in the JSON encoding of the x/upgrade plan.
+	upgradePlan, err := json.MarshalIndent(struct {
+		Name   string `json:"name"`
+		Height string `json:"height"`
+		Info   string `json:"info"`
+	}{plan.Name, strconv.FormatInt(plan.Height, 10), plan.Info}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(upgradePath, upgradePlanFile), upgradePlan, 0644); err != nil {
+		return "", err
+	}
+
+	if plan.NewChainID != "" {
+		if err := c.setHaltHeight(plan.Height); err != nil {
+			return "", err
+		}
+	}
+
+	c.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Upgrade %s prepared", plan.Name)))
+
+	return upgradePath, nil
+}
+
+// MigrateGenesis exports the genesis of the node halted at the upgrade height and migrates it to the new
+// chain id of the upgrade plan. It returns the path of the migrated genesis and its hash that must be
+// the same for all the validators.
+func (c Chain) MigrateGenesis(ctx context.Context, plan networktypes.UpgradePlan) (genesisPath, hash string, err error) {
+	if plan.NewChainID == "" {
+		return "", "", fmt.Errorf("upgrade %s is in place, its genesis isn't migrated", plan.Name)
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Exporting the genesis"))
+
+	upgradePath, err := c.UpgradePath(plan)
+	if err != nil {
+		return "", "", err
+	}
+	genesisPath = filepath.Join(upgradePath, upgradeGenesisFile)
+
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if err := chainCmd.Export(ctx, genesisPath); err != nil {
+		return "", "", err
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Migrating the genesis"))
+
+	if err := cosmosutil.UpdateGenesis(
+		genesisPath,
+		cosmosutil.WithKeyValue(cosmosutil.FieldChainID, plan.NewChainID),
+	); err != nil {
+		return "", "", err
+	}
+
+	if hash, err = checksum.File(genesisPath); err != nil {
+		return "", "", err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Genesis migrated"))
+
+	return genesisPath, hash, nil
+}
+
+// setHaltHeight configures the node to halt at height.
+func (c Chain) setHaltHeight(height int64) error {
+	appPath, err := c.AppTOMLPath()
+	if err != nil {
+		return err
+	}
+	config, err := toml.LoadFile(appPath)
+	if err != nil {
+		return err
+	}
+
+	config.Set("halt-height", height)
+
+	file, err := os.OpenFile(appPath, os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = config.WriteTo(file)
+	return err
+}
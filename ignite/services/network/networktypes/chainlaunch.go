@@ -7,18 +7,20 @@ type (
 
 	// ChainLaunch represents the launch of a chain on SPN
 	ChainLaunch struct {
-		ID                     uint64      `json:"ID"`
-		ConsumerRevisionHeight int64       `json:"ConsumerRevisionHeight"`
-		ChainID                string      `json:"ChainID"`
-		SourceURL              string      `json:"SourceURL"`
-		SourceHash             string      `json:"SourceHash"`
-		GenesisURL             string      `json:"GenesisURL"`
-		GenesisHash            string      `json:"GenesisHash"`
-		LaunchTime             int64       `json:"LaunchTime"`
-		CampaignID             uint64      `json:"CampaignID"`
-		LaunchTriggered        bool        `json:"LaunchTriggered"`
-		Network                NetworkType `json:"Network"`
-		Reward                 string      `json:"Reward,omitempty"`
+		ID                     uint64       `json:"ID"`
+		ConsumerRevisionHeight int64        `json:"ConsumerRevisionHeight"`
+		ChainID                string       `json:"ChainID"`
+		SourceURL              string       `json:"SourceURL"`
+		SourceHash             string       `json:"SourceHash"`
+		GenesisURL             string       `json:"GenesisURL"`
+		GenesisHash            string       `json:"GenesisHash"`
+		LaunchTime             int64        `json:"LaunchTime"`
+		CampaignID             uint64       `json:"CampaignID"`
+		CoordinatorID          uint64       `json:"CoordinatorID"`
+		LaunchTriggered        bool         `json:"LaunchTriggered"`
+		Network                NetworkType  `json:"Network"`
+		Reward                 string       `json:"Reward,omitempty"`
+		Upgrade                *UpgradePlan `json:"Upgrade,omitempty"`
 	}
 )
 
@@ -51,8 +53,10 @@ func ToChainLaunch(chain launchtypes.Chain) ChainLaunch {
 		SourceHash:             chain.SourceHash,
 		LaunchTime:             launchTime,
 		CampaignID:             chain.CampaignID,
+		CoordinatorID:          chain.CoordinatorID,
 		LaunchTriggered:        chain.LaunchTriggered,
 		Network:                network,
+		Upgrade:                ParseChainMetadata(chain.Metadata).Upgrade,
 	}
 
 	// check if custom genesis URL is provided.
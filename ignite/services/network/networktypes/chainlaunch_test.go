@@ -19,6 +19,7 @@ func TestToChainLaunch(t *testing.T) {
 			name: "chain with default genesis",
 			fetched: launchtypes.Chain{
 				LaunchID:       1,
+				CoordinatorID:  1,
 				GenesisChainID: "foo-1",
 				SourceURL:      "foo.com",
 				SourceHash:     "0xaaa",
@@ -35,6 +36,7 @@ func TestToChainLaunch(t *testing.T) {
 				GenesisHash:     "",
 				LaunchTriggered: false,
 				CampaignID:      1,
+				CoordinatorID:   1,
 				Network:         "testnet",
 			},
 		},
@@ -65,6 +67,25 @@ func TestToChainLaunch(t *testing.T) {
 				Network:         "testnet",
 			},
 		},
+		{
+			name: "launched chain with an upgrade plan",
+			fetched: launchtypes.Chain{
+				LaunchID:        1,
+				GenesisChainID:  "foo-1",
+				LaunchTriggered: true,
+				LaunchTimestamp: 100,
+				InitialGenesis:  launchtypes.NewDefaultInitialGenesis(),
+				Metadata:        []byte(`{"upgrade":{"launch_id":1,"name":"v2","height":1000}}`),
+			},
+			expected: networktypes.ChainLaunch{
+				ID:              1,
+				ChainID:         "foo-1",
+				LaunchTriggered: true,
+				LaunchTime:      100,
+				Network:         "testnet",
+				Upgrade:         &networktypes.UpgradePlan{LaunchID: 1, Name: "v2", Height: 1000},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
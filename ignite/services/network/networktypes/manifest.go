@@ -0,0 +1,111 @@
+package networktypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// LaunchManifest lists the artifacts of a chain launch validators have to trust to join it
+	LaunchManifest struct {
+		LaunchID    uint64            `json:"launch_id"`
+		ChainID     string            `json:"chain_id"`
+		GenesisHash string            `json:"genesis_hash"`
+		Binaries    map[string]string `json:"binaries"`
+		Peers       []string          `json:"peers"`
+	}
+
+	// SignedLaunchManifest is a launch manifest signed by the coordinator of the chain
+	SignedLaunchManifest struct {
+		Manifest  LaunchManifest `json:"manifest"`
+		Signer    string         `json:"signer"`
+		PubKey    []byte         `json:"pub_key"`
+		Signature []byte         `json:"signature"`
+	}
+)
+
+// SignBytes returns the canonical encoding of the manifest that is signed
+func (m LaunchManifest) SignBytes() ([]byte, error) {
+	peers := append([]string{}, m.Peers...)
+	sort.Strings(peers)
+	m.Peers = peers
+
+	// maps are encoded with sorted keys so the encoding is deterministic
+	return json.Marshal(m)
+}
+
+// Compare returns the differences between the manifest and the actual one
+func (m LaunchManifest) Compare(actual LaunchManifest) (diffs []string) {
+	if m.LaunchID != actual.LaunchID {
+		diffs = append(diffs, fmt.Sprintf("launch id: expected %d, actual %d", m.LaunchID, actual.LaunchID))
+	}
+	if m.ChainID != actual.ChainID {
+		diffs = append(diffs, fmt.Sprintf("chain id: expected %s, actual %s", m.ChainID, actual.ChainID))
+	}
+	if m.GenesisHash != actual.GenesisHash {
+		diffs = append(diffs, fmt.Sprintf("genesis hash: expected %s, actual %s", m.GenesisHash, actual.GenesisHash))
+	}
+	for name, hash := range m.Binaries {
+		actualHash, ok := actual.Binaries[name]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("binary %s: missing", name))
+		case hash != actualHash:
+			diffs = append(diffs, fmt.Sprintf("binary %s: expected checksum %s, actual %s", name, hash, actualHash))
+		}
+	}
+
+	actualPeers := make(map[string]bool)
+	for _, peer := range actual.Peers {
+		actualPeers[peer] = true
+	}
+	for _, peer := range m.Peers {
+		if !actualPeers[peer] {
+			diffs = append(diffs, fmt.Sprintf("peer %s: missing", peer))
+		}
+		delete(actualPeers, peer)
+	}
+	for _, peer := range actual.Peers {
+		if actualPeers[peer] {
+			diffs = append(diffs, fmt.Sprintf("peer %s: not signed", peer))
+		}
+	}
+
+	return diffs
+}
+
+// Verify checks that the manifest is signed by the key of the signer
+func (s SignedLaunchManifest) Verify() error {
+	signBytes, err := s.Manifest.SignBytes()
+	if err != nil {
+		return err
+	}
+	return verifySignature("manifest", s.Signer, s.PubKey, signBytes, s.Signature)
+}
+
+// verifySignature checks that signature of signBytes is made by the key of the signer,
+// what names the signed object in the errors.
+func verifySignature(what, signerAddress string, pubKeyBytes, signBytes, signature []byte) error {
+	if len(pubKeyBytes) != secp256k1.PubKeySize {
+		return fmt.Errorf("invalid %s public key", what)
+	}
+	pubKey := &secp256k1.PubKey{Key: pubKeyBytes}
+
+	signer, err := sdktypes.Bech32ifyAddressBytes(SPN, pubKey.Address())
+	if err != nil {
+		return err
+	}
+	if signer != signerAddress {
+		return fmt.Errorf("%s public key belongs to %s instead of the signer %s", what, signer, signerAddress)
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return fmt.Errorf("invalid %s signature", what)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestSignedLaunchManifestVerify(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	account, _, err := registry.Create("coordinator")
+	require.NoError(t, err)
+
+	manifest := networktypes.LaunchManifest{
+		LaunchID:    1,
+		ChainID:     "foo-1",
+		GenesisHash: "0xaaa",
+		Binaries:    map[string]string{"food": "0xbbb"},
+		Peers:       []string{"bar@1.1.1.1:26656", "foo@0.0.0.0:26656"},
+	}
+	sign := func(m networktypes.LaunchManifest) networktypes.SignedLaunchManifest {
+		signBytes, err := m.SignBytes()
+		require.NoError(t, err)
+		signature, pubKey, err := registry.Keyring.Sign(account.Name, signBytes)
+		require.NoError(t, err)
+		return networktypes.SignedLaunchManifest{
+			Manifest:  m,
+			Signer:    account.Address(networktypes.SPN),
+			PubKey:    pubKey.Bytes(),
+			Signature: signature,
+		}
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+		signed := sign(manifest)
+		require.NoError(t, signed.Verify())
+
+		// the order of the peers isn't signed
+		signed.Manifest.Peers = []string{"foo@0.0.0.0:26656", "bar@1.1.1.1:26656"}
+		require.NoError(t, signed.Verify())
+	})
+
+	t.Run("tampered manifest", func(t *testing.T) {
+		signed := sign(manifest)
+		signed.Manifest.GenesisHash = "0xccc"
+		require.Error(t, signed.Verify())
+	})
+
+	t.Run("other signer", func(t *testing.T) {
+		other, _, err := registry.Create("other")
+		require.NoError(t, err)
+
+		signed := sign(manifest)
+		signed.Signer = other.Address(networktypes.SPN)
+		require.Error(t, signed.Verify())
+	})
+}
+
+func TestLaunchManifestCompare(t *testing.T) {
+	manifest := networktypes.LaunchManifest{
+		LaunchID:    1,
+		ChainID:     "foo-1",
+		GenesisHash: "0xaaa",
+		Binaries:    map[string]string{"food": "0xbbb"},
+		Peers:       []string{"foo@0.0.0.0:26656"},
+	}
+
+	require.Empty(t, manifest.Compare(manifest))
+
+	actual := networktypes.LaunchManifest{
+		LaunchID:    1,
+		ChainID:     "foo-1",
+		GenesisHash: "0xccc",
+		Binaries:    map[string]string{"food": "0xddd"},
+		Peers:       []string{"bar@1.1.1.1:26656"},
+	}
+	require.Equal(t, []string{
+		"genesis hash: expected 0xaaa, actual 0xccc",
+		"binary food: expected checksum 0xbbb, actual 0xddd",
+		"peer foo@0.0.0.0:26656: missing",
+		"peer bar@1.1.1.1:26656: not signed",
+	}, manifest.Compare(actual))
+}
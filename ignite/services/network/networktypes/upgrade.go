@@ -0,0 +1,144 @@
+package networktypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// ChainMetadata is the metadata of a chain on SPN managed by Ignite
+	ChainMetadata struct {
+		Upgrade *UpgradePlan `json:"upgrade,omitempty"`
+	}
+
+	// UpgradePlan is a software upgrade of a launched chain coordinated on SPN,
+	// it's published in the chain metadata by its coordinator
+	UpgradePlan struct {
+		LaunchID uint64 `json:"launch_id"`
+
+		// Name of the upgrade, it's the name of the upgrade handler registered by the new binary
+		Name string `json:"name"`
+
+		// Height is the halt height of the chain, the new binary runs from the next block
+		Height int64 `json:"height"`
+
+		// Info describes the new binary, e.g. its source and its checksum
+		Info string `json:"info,omitempty"`
+
+		// NewChainID is set when the chain is restarted from its genesis exported at the halt height
+		// instead of being upgraded in place
+		NewChainID string `json:"new_chain_id,omitempty"`
+	}
+
+	// UpgradeSignal is an upgrade plan signed by a validator of the chain ready for it
+	UpgradeSignal struct {
+		Plan      UpgradePlan `json:"plan"`
+		Signer    string      `json:"signer"`
+		PubKey    []byte      `json:"pub_key"`
+		Signature []byte      `json:"signature"`
+	}
+
+	// UpgradeReadiness lists the validators ready for an upgrade plan
+	UpgradeReadiness struct {
+		Plan       UpgradePlan `json:"plan"`
+		Ready      []string    `json:"ready"`
+		Pending    []string    `json:"pending"`
+		ReadyPower sdk.Int     `json:"ready_power"`
+		TotalPower sdk.Int     `json:"total_power"`
+
+		// Invalid lists the reasons why signals are ignored
+		Invalid []string `json:"invalid,omitempty"`
+	}
+)
+
+// ParseChainMetadata parses the metadata of a chain, the metadata not managed by Ignite is ignored
+func ParseChainMetadata(metadata []byte) ChainMetadata {
+	var m ChainMetadata
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &m); err != nil {
+			return ChainMetadata{}
+		}
+	}
+	return m
+}
+
+// Bytes returns the encoded metadata
+func (m ChainMetadata) Bytes() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// Validate checks the upgrade plan
+func (p UpgradePlan) Validate() error {
+	if p.Name == "" {
+		return errors.New("upgrade name is required")
+	}
+	if p.Height <= 0 {
+		return fmt.Errorf("invalid upgrade height %d", p.Height)
+	}
+	return nil
+}
+
+// SignBytes returns the canonical encoding of the plan that is signed
+func (p UpgradePlan) SignBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// Verify checks that the plan is signed by the key of the signer
+func (s UpgradeSignal) Verify() error {
+	signBytes, err := s.Plan.SignBytes()
+	if err != nil {
+		return err
+	}
+	return verifySignature("signal", s.Signer, s.PubKey, signBytes, s.Signature)
+}
+
+// NewUpgradeReadiness returns the readiness of the validators for the upgrade plan from their signals,
+// the power of a validator is its self delegation
+func NewUpgradeReadiness(plan UpgradePlan, validators []GenesisValidator, signals []UpgradeSignal) UpgradeReadiness {
+	readiness := UpgradeReadiness{
+		Plan:       plan,
+		Ready:      []string{},
+		Pending:    []string{},
+		ReadyPower: sdk.ZeroInt(),
+		TotalPower: sdk.ZeroInt(),
+	}
+
+	power := make(map[string]sdk.Int)
+	for _, validator := range validators {
+		power[validator.Address] = validator.SelfDelegation.Amount
+		readiness.TotalPower = readiness.TotalPower.Add(validator.SelfDelegation.Amount)
+	}
+
+	ready := make(map[string]bool)
+	for _, signal := range signals {
+		switch _, isValidator := power[signal.Signer]; {
+		case !isValidator:
+			readiness.Invalid = append(readiness.Invalid, fmt.Sprintf("%s: not a validator of the chain", signal.Signer))
+		case signal.Plan != plan:
+			readiness.Invalid = append(readiness.Invalid, fmt.Sprintf("%s: signal for another upgrade plan", signal.Signer))
+		default:
+			if err := signal.Verify(); err != nil {
+				readiness.Invalid = append(readiness.Invalid, fmt.Sprintf("%s: %s", signal.Signer, err))
+				continue
+			}
+			ready[signal.Signer] = true
+		}
+	}
+
+	for address, p := range power {
+		if ready[address] {
+			readiness.Ready = append(readiness.Ready, address)
+			readiness.ReadyPower = readiness.ReadyPower.Add(p)
+		} else {
+			readiness.Pending = append(readiness.Pending, address)
+		}
+	}
+	sort.Strings(readiness.Ready)
+	sort.Strings(readiness.Pending)
+
+	return readiness
+}
@@ -0,0 +1,84 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+func TestParseChainMetadata(t *testing.T) {
+	plan := networktypes.UpgradePlan{LaunchID: 1, Name: "v2", Height: 1000}
+	metadata, err := networktypes.ChainMetadata{Upgrade: &plan}.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, networktypes.ChainMetadata{Upgrade: &plan}, networktypes.ParseChainMetadata(metadata))
+
+	require.Equal(t, networktypes.ChainMetadata{}, networktypes.ParseChainMetadata(nil))
+	require.Equal(t, networktypes.ChainMetadata{}, networktypes.ParseChainMetadata([]byte("not ignite metadata")))
+}
+
+func TestUpgradePlanValidate(t *testing.T) {
+	require.NoError(t, networktypes.UpgradePlan{Name: "v2", Height: 1000}.Validate())
+	require.EqualError(t, networktypes.UpgradePlan{Height: 1000}.Validate(), "upgrade name is required")
+	require.EqualError(t, networktypes.UpgradePlan{Name: "v2"}.Validate(), "invalid upgrade height 0")
+}
+
+func TestNewUpgradeReadiness(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	var (
+		plan    = networktypes.UpgradePlan{LaunchID: 1, Name: "v2", Height: 1000}
+		signals []networktypes.UpgradeSignal
+		sign    = func(name string, p networktypes.UpgradePlan) networktypes.UpgradeSignal {
+			account, err := registry.GetByName(name)
+			if err != nil {
+				account, _, err = registry.Create(name)
+				require.NoError(t, err)
+			}
+			signBytes, err := p.SignBytes()
+			require.NoError(t, err)
+			signature, pubKey, err := registry.Keyring.Sign(account.Name, signBytes)
+			require.NoError(t, err)
+			return networktypes.UpgradeSignal{
+				Plan:      p,
+				Signer:    account.Address(networktypes.SPN),
+				PubKey:    pubKey.Bytes(),
+				Signature: signature,
+			}
+		}
+	)
+
+	alice, bob, carol := sign("alice", plan), sign("bob", plan), sign("carol", plan)
+	validators := []networktypes.GenesisValidator{
+		{Address: alice.Signer, SelfDelegation: sdk.NewInt64Coin("stake", 30)},
+		{Address: bob.Signer, SelfDelegation: sdk.NewInt64Coin("stake", 50)},
+		{Address: carol.Signer, SelfDelegation: sdk.NewInt64Coin("stake", 20)},
+	}
+
+	tampered := sign("bob", plan)
+	tampered.Signature = alice.Signature
+	signals = append(signals,
+		alice,
+		tampered,
+		sign("carol", networktypes.UpgradePlan{LaunchID: 1, Name: "v2", Height: 2000}),
+		sign("dave", plan),
+	)
+
+	readiness := networktypes.NewUpgradeReadiness(plan, validators, signals)
+	require.Equal(t, []string{alice.Signer}, readiness.Ready)
+	require.ElementsMatch(t, []string{bob.Signer, carol.Signer}, readiness.Pending)
+	require.Equal(t, sdk.NewInt(30), readiness.ReadyPower)
+	require.Equal(t, sdk.NewInt(100), readiness.TotalPower)
+	require.Len(t, readiness.Invalid, 3)
+	require.Equal(t, bob.Signer+": invalid signal signature", readiness.Invalid[0])
+	require.Equal(t, carol.Signer+": signal for another upgrade plan", readiness.Invalid[1])
+	require.Contains(t, readiness.Invalid[2], "not a validator of the chain")
+
+	readiness = networktypes.NewUpgradeReadiness(plan, validators, []networktypes.UpgradeSignal{alice, bob, carol})
+	require.Empty(t, readiness.Pending)
+	require.Equal(t, sdk.NewInt(100), readiness.ReadyPower)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	spntypes "github.com/tendermint/spn/pkg/types"
 
@@ -12,14 +13,16 @@ import (
 
 // Node is node builder.
 type Node struct {
-	cosmos       CosmosClient
-	stakingQuery stakingtypes.QueryClient
+	cosmos        CosmosClient
+	stakingQuery  stakingtypes.QueryClient
+	slashingQuery slashingtypes.QueryClient
 }
 
 func NewNodeClient(cosmos CosmosClient) (Node, error) {
 	return Node{
-		cosmos:       cosmos,
-		stakingQuery: stakingtypes.NewQueryClient(cosmos.Context()),
+		cosmos:        cosmos,
+		stakingQuery:  stakingtypes.NewQueryClient(cosmos.Context()),
+		slashingQuery: slashingtypes.NewQueryClient(cosmos.Context()),
 	}, nil
 }
 
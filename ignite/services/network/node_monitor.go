@@ -0,0 +1,274 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+)
+
+// ValidatorLiveness is the liveness of a validator of a launched chain.
+type ValidatorLiveness struct {
+	// Height is the height of the chain when the liveness is checked.
+	Height int64
+
+	// Moniker is the moniker of the validator.
+	Moniker string
+
+	// InValidatorSet is true when the validator is in the validator set of the chain.
+	InValidatorSet bool
+
+	// Jailed is true when the validator is jailed until JailedUntil.
+	Jailed      bool
+	JailedUntil time.Time
+
+	// Tombstoned is true when the validator is permanently jailed for a double sign.
+	Tombstoned bool
+
+	// MissedBlocks is the number of blocks missed by the validator in the last SignedBlocksWindow blocks.
+	MissedBlocks       int64
+	SignedBlocksWindow int64
+
+	// Peers is the number of peers of the node monitoring the validator.
+	Peers int
+}
+
+// MonitorThresholds are the thresholds of the liveness of a validator raising an alert.
+type MonitorThresholds struct {
+	// MaxMissedBlocks is the number of missed blocks in the signed blocks window raising an alert.
+	MaxMissedBlocks int64
+
+	// MinPeers is the minimum number of peers of the node.
+	MinPeers int
+}
+
+// Alert is an alert raised by the monitoring of a validator, it's raised when a liveness issue
+// starts and when it's resolved.
+type Alert struct {
+	Time      time.Time `json:"time"`
+	Validator string    `json:"validator"`
+	Height    int64     `json:"height"`
+	Kind      string    `json:"kind"`
+	Message   string    `json:"message"`
+	Resolved  bool      `json:"resolved"`
+}
+
+// Kinds of alerts.
+const (
+	AlertNotInValidatorSet = "not-in-validator-set"
+	AlertJailed            = "jailed"
+	AlertTombstoned        = "tombstoned"
+	AlertMissedBlocks      = "missed-blocks"
+	AlertLowPeers          = "low-peers"
+	AlertUnreachable       = "unreachable"
+)
+
+// AlertChannel sends the alerts of the monitoring, e.g. to the stdout or to a webhook.
+type AlertChannel interface {
+	Send(ctx context.Context, alert Alert) error
+}
+
+// AlertChannelFunc is a function sending the alerts.
+type AlertChannelFunc func(ctx context.Context, alert Alert) error
+
+// Send sends the alert.
+func (f AlertChannelFunc) Send(ctx context.Context, alert Alert) error {
+	return f(ctx, alert)
+}
+
+// WebhookAlertChannel posts the alerts to a webhook as JSON.
+type WebhookAlertChannel struct {
+	URL string
+}
+
+// Send posts the alert to the webhook, the webhook must respond with a 2xx status code.
+func (w WebhookAlertChannel) Send(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "cannot post to the webhook")
+	}
+	defer res.Body.Close()
+
+	// drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with %s", res.Status)
+	}
+	return nil
+}
+
+// ValidatorLiveness returns the liveness of the validator with the operator address valAddress.
+func (n Node) ValidatorLiveness(ctx context.Context, valAddress string) (ValidatorLiveness, error) {
+	status, err := n.cosmos.Status(ctx)
+	if err != nil {
+		return ValidatorLiveness{}, err
+	}
+	liveness := ValidatorLiveness{Height: status.SyncInfo.LatestBlockHeight}
+
+	valRes, err := n.stakingQuery.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddress})
+	if err != nil {
+		return ValidatorLiveness{}, errors.Wrapf(err, "cannot fetch the validator %s", valAddress)
+	}
+	validator := valRes.Validator
+	if err := validator.UnpackInterfaces(n.cosmos.Context().InterfaceRegistry); err != nil {
+		return ValidatorLiveness{}, err
+	}
+	consAddress, err := validator.GetConsAddr()
+	if err != nil {
+		return ValidatorLiveness{}, err
+	}
+	liveness.Moniker = validator.GetMoniker()
+	liveness.Jailed = validator.IsJailed()
+
+	// the validator set is the one used by the rewards of the launched chain.
+	consensus, err := n.cosmos.ConsensusInfo(ctx, liveness.Height)
+	if err != nil {
+		return ValidatorLiveness{}, err
+	}
+	for _, v := range consensus.ValidatorSet.Validators {
+		if bytes.Equal(v.Address, consAddress) {
+			liveness.InValidatorSet = true
+			break
+		}
+	}
+
+	signingRes, err := n.slashingQuery.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdktypes.ConsAddress(consAddress).String(),
+	})
+	if err != nil {
+		return ValidatorLiveness{}, errors.Wrap(err, "cannot fetch the signing info of the validator")
+	}
+	liveness.JailedUntil = signingRes.ValSigningInfo.JailedUntil
+	liveness.Tombstoned = signingRes.ValSigningInfo.Tombstoned
+	liveness.MissedBlocks = signingRes.ValSigningInfo.MissedBlocksCounter
+
+	paramsRes, err := n.slashingQuery.Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return ValidatorLiveness{}, err
+	}
+	liveness.SignedBlocksWindow = paramsRes.Params.SignedBlocksWindow
+
+	netInfo, err := n.cosmos.Context().Client.NetInfo(ctx)
+	if err != nil {
+		return ValidatorLiveness{}, errors.Wrap(err, "cannot fetch the peers of the node")
+	}
+	liveness.Peers = netInfo.NPeers
+
+	return liveness, nil
+}
+
+// MonitorValidator checks the liveness of the validator with the operator address valAddress at
+// each interval until ctx is canceled. An alert is sent to the channels when a liveness issue
+// starts and when it's resolved.
+func (n Node) MonitorValidator(
+	ctx context.Context,
+	valAddress string,
+	interval time.Duration,
+	thresholds MonitorThresholds,
+	channels ...AlertChannel,
+) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	active := make(map[string]bool)
+	for {
+		var issues map[string]string
+		liveness, err := n.ValidatorLiveness(ctx, valAddress)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// the issues found before can't be checked, they're kept until the next check.
+			issues = make(map[string]string)
+			for kind := range active {
+				issues[kind] = ""
+			}
+			issues[AlertUnreachable] = fmt.Sprintf("cannot check the liveness: %s", err)
+		} else {
+			issues = livenessIssues(liveness, thresholds)
+		}
+
+		for _, alert := range changedAlerts(active, issues) {
+			alert.Time = time.Now()
+			alert.Validator = valAddress
+			alert.Height = liveness.Height
+			for _, channel := range channels {
+				if err := channel.Send(ctx, alert); err != nil {
+					return errors.Wrapf(err, "cannot send the %s alert", alert.Kind)
+				}
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// livenessIssues returns the messages of the liveness issues of a validator by kind of alert.
+func livenessIssues(liveness ValidatorLiveness, thresholds MonitorThresholds) map[string]string {
+	issues := make(map[string]string)
+	switch {
+	case liveness.Tombstoned:
+		issues[AlertTombstoned] = "the validator is tombstoned for a double sign"
+	case liveness.Jailed:
+		issues[AlertJailed] = fmt.Sprintf("the validator is jailed until %s", liveness.JailedUntil.Format(time.RFC3339))
+	case !liveness.InValidatorSet:
+		issues[AlertNotInValidatorSet] = "the validator is not in the validator set"
+	}
+	if thresholds.MaxMissedBlocks > 0 && liveness.MissedBlocks >= thresholds.MaxMissedBlocks {
+		issues[AlertMissedBlocks] = fmt.Sprintf(
+			"the validator missed %d blocks of the last %d blocks",
+			liveness.MissedBlocks,
+			liveness.SignedBlocksWindow,
+		)
+	}
+	if liveness.Peers < thresholds.MinPeers {
+		issues[AlertLowPeers] = fmt.Sprintf("the node has %d peers", liveness.Peers)
+	}
+	return issues
+}
+
+// changedAlerts returns the alerts of the issues that started or were resolved since the last
+// check and updates the active issues.
+func changedAlerts(active map[string]bool, issues map[string]string) []Alert {
+	var alerts []Alert
+	for kind, message := range issues {
+		if !active[kind] {
+			active[kind] = true
+			alerts = append(alerts, Alert{Kind: kind, Message: message})
+		}
+	}
+	for kind := range active {
+		if _, ok := issues[kind]; !ok {
+			delete(active, kind)
+			alerts = append(alerts, Alert{Kind: kind, Message: "resolved", Resolved: true})
+		}
+	}
+
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Kind < alerts[j].Kind })
+	return alerts
+}
@@ -0,0 +1,63 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLivenessIssues(t *testing.T) {
+	thresholds := MonitorThresholds{MaxMissedBlocks: 10, MinPeers: 2}
+	tests := []struct {
+		name     string
+		liveness ValidatorLiveness
+		kinds    []string
+	}{
+		{
+			name:     "live validator",
+			liveness: ValidatorLiveness{InValidatorSet: true, MissedBlocks: 9, Peers: 2},
+		},
+		{
+			name:     "jailed validator",
+			liveness: ValidatorLiveness{Jailed: true, MissedBlocks: 50, Peers: 3},
+			kinds:    []string{AlertJailed, AlertMissedBlocks},
+		},
+		{
+			name:     "tombstoned validator",
+			liveness: ValidatorLiveness{Jailed: true, Tombstoned: true, Peers: 3},
+			kinds:    []string{AlertTombstoned},
+		},
+		{
+			name:     "validator out of the set with few peers",
+			liveness: ValidatorLiveness{Peers: 1},
+			kinds:    []string{AlertNotInValidatorSet, AlertLowPeers},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := livenessIssues(tt.liveness, thresholds)
+			kinds := make([]string, 0, len(issues))
+			for kind := range issues {
+				kinds = append(kinds, kind)
+			}
+			require.ElementsMatch(t, tt.kinds, kinds)
+		})
+	}
+}
+
+func TestChangedAlerts(t *testing.T) {
+	active := make(map[string]bool)
+
+	alerts := changedAlerts(active, map[string]string{AlertJailed: "jailed", AlertLowPeers: "low"})
+	require.Equal(t, []Alert{
+		{Kind: AlertJailed, Message: "jailed"},
+		{Kind: AlertLowPeers, Message: "low"},
+	}, alerts)
+
+	// the active issues aren't sent again
+	alerts = changedAlerts(active, map[string]string{AlertJailed: "jailed"})
+	require.Equal(t, []Alert{{Kind: AlertLowPeers, Message: "resolved", Resolved: true}}, alerts)
+
+	alerts = changedAlerts(active, map[string]string{AlertJailed: "jailed"})
+	require.Empty(t, alerts)
+}
@@ -0,0 +1,117 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+)
+
+// ProposeUpgrade publishes the upgrade plan of a launched chain in its metadata as its coordinator,
+// it replaces the plan previously proposed.
+func (n Network) ProposeUpgrade(ctx context.Context, plan networktypes.UpgradePlan) error {
+	if err := plan.Validate(); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Proposing upgrade %s for chain %d", plan.Name, plan.LaunchID)))
+
+	res, err := n.launchQuery.
+		Chain(ctx,
+			&launchtypes.QueryGetChainRequest{
+				LaunchID: plan.LaunchID,
+			},
+		)
+	if err != nil {
+		return err
+	}
+	if !res.Chain.LaunchTriggered {
+		return fmt.Errorf("chain %d launch has not been triggered yet", plan.LaunchID)
+	}
+
+	metadata := networktypes.ParseChainMetadata(res.Chain.Metadata)
+	metadata.Upgrade = &plan
+	metadataBytes, err := metadata.Bytes()
+	if err != nil {
+		return err
+	}
+
+	address := n.account.Address(networktypes.SPN)
+	msg := launchtypes.NewMsgEditChain(address, plan.LaunchID, false, 0, metadataBytes)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Upgrade %s proposed at height %d for chain %d", plan.Name, plan.Height, plan.LaunchID),
+	))
+	return nil
+}
+
+// UpgradePlan fetches the upgrade plan proposed for a chain.
+func (n Network) UpgradePlan(ctx context.Context, launchID uint64) (networktypes.UpgradePlan, error) {
+	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return networktypes.UpgradePlan{}, err
+	}
+	if chainLaunch.Upgrade == nil {
+		return networktypes.UpgradePlan{}, fmt.Errorf("no upgrade proposed for the chain %d", launchID)
+	}
+	return *chainLaunch.Upgrade, nil
+}
+
+// SignalUpgrade signs the upgrade plan with the account of a validator of the chain to signal
+// it's ready for the upgrade.
+func (n Network) SignalUpgrade(ctx context.Context, plan networktypes.UpgradePlan) (networktypes.UpgradeSignal, error) {
+	address := n.account.Address(networktypes.SPN)
+	isValidator, err := n.hasValidator(ctx, plan.LaunchID, address)
+	if err != nil {
+		return networktypes.UpgradeSignal{}, err
+	}
+	if !isValidator {
+		return networktypes.UpgradeSignal{}, errors.Errorf("%s is not a validator of the chain %d", address, plan.LaunchID)
+	}
+
+	signBytes, err := plan.SignBytes()
+	if err != nil {
+		return networktypes.UpgradeSignal{}, err
+	}
+
+	signature, pubKey, err := n.cosmos.Context().Keyring.Sign(n.account.Name, signBytes)
+	if err != nil {
+		return networktypes.UpgradeSignal{}, err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Upgrade %s signaled", plan.Name)))
+
+	return networktypes.UpgradeSignal{
+		Plan:      plan,
+		Signer:    address,
+		PubKey:    pubKey.Bytes(),
+		Signature: signature,
+	}, nil
+}
+
+// UpgradeReadiness returns the readiness of the validators of the chain for its upgrade plan
+// from the signals collected by the coordinator.
+func (n Network) UpgradeReadiness(
+	ctx context.Context,
+	launchID uint64,
+	signals []networktypes.UpgradeSignal,
+) (networktypes.UpgradeReadiness, error) {
+	plan, err := n.UpgradePlan(ctx, launchID)
+	if err != nil {
+		return networktypes.UpgradeReadiness{}, err
+	}
+
+	validators, err := n.GenesisValidators(ctx, launchID)
+	if err != nil {
+		return networktypes.UpgradeReadiness{}, err
+	}
+
+	return networktypes.NewUpgradeReadiness(plan, validators, signals), nil
+}
@@ -0,0 +1,70 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite/cli/ignite/services/network/networktypes"
+	"github.com/ignite/cli/ignite/services/network/testutil"
+)
+
+func TestProposeUpgrade(t *testing.T) {
+	plan := networktypes.UpgradePlan{LaunchID: testutil.LaunchID, Name: "v2", Height: 1000}
+
+	t.Run("successfully propose an upgrade", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{
+				Chain: launchtypes.Chain{LaunchID: testutil.LaunchID, LaunchTriggered: true},
+			}, nil).
+			Once()
+		suite.CosmosClientMock.
+			On("BroadcastTx", account.Name, &launchtypes.MsgEditChain{
+				Coordinator: account.Address(networktypes.SPN),
+				LaunchID:    testutil.LaunchID,
+				Metadata:    []byte(`{"upgrade":{"launch_id":1,"name":"v2","height":1000}}`),
+			}).
+			Return(testutil.NewResponse(&launchtypes.MsgEditChainResponse{}), nil).
+			Once()
+
+		require.NoError(t, network.ProposeUpgrade(context.Background(), plan))
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to propose an upgrade, chain not launched", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		suite.LaunchQueryMock.
+			On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+			Return(&launchtypes.QueryGetChainResponse{
+				Chain: launchtypes.Chain{LaunchID: testutil.LaunchID},
+			}, nil).
+			Once()
+
+		err := network.ProposeUpgrade(context.Background(), plan)
+		require.EqualError(t, err, "chain 1 launch has not been triggered yet")
+		suite.AssertAllMocks(t)
+	})
+
+	t.Run("failed to propose an upgrade, invalid plan", func(t *testing.T) {
+		var (
+			account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+			suite, network = newSuite(account)
+		)
+
+		err := network.ProposeUpgrade(context.Background(), networktypes.UpgradePlan{LaunchID: testutil.LaunchID, Name: "v2"})
+		require.EqualError(t, err, "invalid upgrade height 0")
+		suite.AssertAllMocks(t)
+	})
+}
@@ -0,0 +1,102 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+// codecName is the content subtype of the gRPC calls of the plugins, their messages are encoded
+// in JSON to not depend on generated proto code.
+const codecName = "ignite-plugin-json"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec encodes the messages of the plugins in JSON.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) { return json.Marshal(v) }
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+
+func (jsonCodec) Name() string { return codecName }
+
+// empty is the message of the calls returning nothing.
+type empty struct{}
+
+// serviceDesc describes the gRPC service of the plugins.
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ignite.plugin.Plugin",
+	HandlerType: (*Interface)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Manifest",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := dec(&empty{}); err != nil {
+					return nil, err
+				}
+				return srv.(Interface).Manifest(ctx)
+			},
+		},
+		{
+			MethodName: "Execute",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				var req ExecuteRequest
+				if err := dec(&req); err != nil {
+					return nil, err
+				}
+				return empty{}, srv.(Interface).Execute(ctx, req)
+			},
+		},
+		{
+			MethodName: "ExecuteHook",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				var req HookRequest
+				if err := dec(&req); err != nil {
+					return nil, err
+				}
+				return empty{}, srv.(Interface).ExecuteHook(ctx, req)
+			},
+		},
+	},
+}
+
+// newServer returns a gRPC server serving the plugin p.
+func newServer(p Interface) *grpc.Server {
+	s := grpc.NewServer()
+	s.RegisterService(&serviceDesc, p)
+	return s
+}
+
+// client calls a plugin served over gRPC, it implements Interface.
+type client struct {
+	conn *grpc.ClientConn
+}
+
+func (c client) invoke(ctx context.Context, method string, req, res interface{}) error {
+	err := c.conn.Invoke(ctx, "/"+serviceDesc.ServiceName+"/"+method, req, res, grpc.CallContentSubtype(codecName))
+	if s, ok := status.FromError(err); ok && err != nil {
+		// the errors of the plugins are returned as is.
+		return errors.New(s.Message())
+	}
+	return err
+}
+
+func (c client) Manifest(ctx context.Context) (m Manifest, err error) {
+	err = c.invoke(ctx, "Manifest", empty{}, &m)
+	return m, err
+}
+
+func (c client) Execute(ctx context.Context, req ExecuteRequest) error {
+	return c.invoke(ctx, "Execute", req, &empty{})
+}
+
+func (c client) ExecuteHook(ctx context.Context, req HookRequest) error {
+	return c.invoke(ctx, "ExecuteHook", req, &empty{})
+}
@@ -0,0 +1,195 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// handshakeTimeout is the time given to the plugins to announce their address.
+const handshakeTimeout = 10 * time.Second
+
+// Plugin is a plugin loaded by ignite, its process runs until it's closed.
+type Plugin struct {
+	// Manifest describes the commands and the hooks of the plugin.
+	Manifest Manifest
+
+	// AppPath is the path of the app declaring the plugin.
+	AppPath string
+
+	// With holds the parameters of the plugin from config.yml.
+	With map[string]string
+
+	client Interface
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+}
+
+// Load starts the plugin declared in the config of the app at appPath and returns it once it's
+// served. The output of the plugin is forwarded to the stdout and the stderr of ignite.
+func Load(ctx context.Context, conf chainconfig.Plugin, appPath string) (_ *Plugin, err error) {
+	path := conf.Path
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(appPath, path)
+	}
+
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", magicCookieKey, magicCookieValue))
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", conf.Name, err)
+	}
+
+	p := &Plugin{
+		AppPath: appPath,
+		With:    conf.With,
+		cmd:     cmd,
+	}
+	defer func() {
+		if err != nil {
+			p.Close()
+			err = fmt.Errorf("plugin %s: %w", conf.Name, err)
+		}
+	}()
+
+	out := bufio.NewReader(stdout)
+	addr, err := readHandshake(ctx, out)
+	if err != nil {
+		return nil, err
+	}
+	go io.Copy(os.Stdout, out)
+
+	p.conn, err = grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, err
+	}
+	p.client = client{p.conn}
+
+	if p.Manifest, err = p.client.Manifest(ctx); err != nil {
+		return nil, err
+	}
+	if p.Manifest.Name == "" {
+		p.Manifest.Name = conf.Name
+	}
+	for _, hook := range p.Manifest.Hooks {
+		if !isHook(hook) {
+			return nil, fmt.Errorf("unknown hook %q, the hooks are %s", hook, strings.Join(Hooks, ", "))
+		}
+	}
+
+	return p, nil
+}
+
+// readHandshake reads the handshake of the plugin from its stdout and returns its address.
+func readHandshake(ctx context.Context, out *bufio.Reader) (addr string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, handshakeTimeout)
+	defer cancel()
+
+	type result struct {
+		line string
+		err  error
+	}
+	lines := make(chan result, 1)
+	go func() {
+		line, err := out.ReadString('\n')
+		lines <- result{line, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("no handshake: %w", ctx.Err())
+	case r := <-lines:
+		if r.err != nil {
+			return "", fmt.Errorf("no handshake, the plugin must be served with plugin.Serve: %w", r.err)
+		}
+		return parseHandshake(r.line)
+	}
+}
+
+// parseHandshake parses the handshake line of a plugin, e.g. 1|tcp|127.0.0.1:4242.
+func parseHandshake(line string) (addr string, err error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 3 || parts[1] != "tcp" {
+		return "", fmt.Errorf("invalid handshake %q", strings.TrimSpace(line))
+	}
+	if parts[0] != handshakeVersion {
+		return "", fmt.Errorf("unsupported plugin protocol version %s, ignite supports the version %s", parts[0], handshakeVersion)
+	}
+	return parts[2], nil
+}
+
+// Execute executes the command of the plugin with its args and the values of its flags.
+func (p *Plugin) Execute(ctx context.Context, command string, args []string, flags map[string]string) error {
+	return p.client.Execute(ctx, ExecuteRequest{
+		Command: command,
+		Args:    args,
+		Flags:   flags,
+		AppPath: p.AppPath,
+		With:    p.With,
+	})
+}
+
+// HasHook returns true when the plugin runs the hook.
+func (p *Plugin) HasHook(hook string) bool {
+	for _, h := range p.Manifest.Hooks {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// Close ends the plugin.
+func (p *Plugin) Close() error {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	if err := p.cmd.Process.Kill(); err != nil {
+		return err
+	}
+	p.cmd.Wait()
+	return nil
+}
+
+// RunHooks runs the hook of the plugins running it, in their order. It stops at the first failing hook.
+func RunHooks(ctx context.Context, plugins []*Plugin, hook, command string, args []string) error {
+	for _, p := range plugins {
+		if !p.HasHook(hook) {
+			continue
+		}
+		err := p.client.ExecuteHook(ctx, HookRequest{
+			Hook:    hook,
+			Command: command,
+			Args:    args,
+			AppPath: p.AppPath,
+			With:    p.With,
+		})
+		if err != nil {
+			return fmt.Errorf("%s hook of plugin %s: %w", hook, p.Manifest.Name, err)
+		}
+	}
+	return nil
+}
+
+func isHook(name string) bool {
+	for _, hook := range Hooks {
+		if hook == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// Package plugin extends the CLI with external plugins, the binaries declared in the plugins of
+// config.yml. A plugin adds commands to ignite and runs hooks before and after the commands that
+// scaffold code or serve the chain.
+//
+// The plugins are served over gRPC: ignite starts the binary of a plugin, the plugin listens on a
+// local port and announces it in a handshake line written to its stdout. ignite calls the plugin
+// with a gRPC client until it exits, the plugin is killed then.
+//
+// A plugin implements Interface and serves it from its main function:
+//
+//	func main() {
+//		plugin.Serve(hello{})
+//	}
+package plugin
+
+import "context"
+
+// the hooks run by the plugins.
+const (
+	// HookPreScaffold runs before the commands scaffolding code, an error aborts the command.
+	HookPreScaffold = "pre-scaffold"
+
+	// HookPostScaffold runs after the commands scaffolding code succeed.
+	HookPostScaffold = "post-scaffold"
+
+	// HookPreServe runs before the chain is served, an error aborts the serve.
+	HookPreServe = "pre-serve"
+
+	// HookPostServe runs after the chain is served.
+	HookPostServe = "post-serve"
+)
+
+// Hooks are the hooks a plugin can run.
+var Hooks = []string{HookPreScaffold, HookPostScaffold, HookPreServe, HookPostServe}
+
+// Manifest describes the commands and the hooks of a plugin.
+type Manifest struct {
+	// Name of the plugin.
+	Name string `json:"name"`
+
+	// Commands are added to ignite.
+	Commands []Command `json:"commands,omitempty"`
+
+	// Hooks run by the plugin, e.g. pre-scaffold.
+	Hooks []string `json:"hooks,omitempty"`
+}
+
+// Command is a command added to ignite by a plugin.
+type Command struct {
+	// Use is the one-line usage of the command, its first word is its name.
+	Use string `json:"use"`
+
+	// Short is the short description of the command.
+	Short string `json:"short,omitempty"`
+
+	// Long is the long description of the command.
+	Long string `json:"long,omitempty"`
+
+	// Flags of the command.
+	Flags []Flag `json:"flags,omitempty"`
+}
+
+// Flag is a string flag of a command.
+type Flag struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand,omitempty"`
+	Usage     string `json:"usage,omitempty"`
+	Default   string `json:"default,omitempty"`
+}
+
+// ExecuteRequest executes a command of a plugin.
+type ExecuteRequest struct {
+	// Command is the name of the command.
+	Command string `json:"command"`
+
+	// Args are the arguments of the command.
+	Args []string `json:"args,omitempty"`
+
+	// Flags are the values of the flags of the command.
+	Flags map[string]string `json:"flags,omitempty"`
+
+	// AppPath is the path of the app declaring the plugin.
+	AppPath string `json:"app_path"`
+
+	// With holds the parameters of the plugin from config.yml.
+	With map[string]string `json:"with,omitempty"`
+}
+
+// HookRequest runs a hook of a plugin.
+type HookRequest struct {
+	// Hook is the name of the hook, e.g. pre-scaffold.
+	Hook string `json:"hook"`
+
+	// Command is the path of the command running the hook, e.g. ignite scaffold list.
+	Command string `json:"command"`
+
+	// Args are the arguments of the command.
+	Args []string `json:"args,omitempty"`
+
+	// AppPath is the path of the app declaring the plugin.
+	AppPath string `json:"app_path"`
+
+	// With holds the parameters of the plugin from config.yml.
+	With map[string]string `json:"with,omitempty"`
+}
+
+// Interface is implemented by the plugins.
+type Interface interface {
+	// Manifest returns the commands and the hooks of the plugin.
+	Manifest(ctx context.Context) (Manifest, error)
+
+	// Execute executes a command of the plugin.
+	Execute(ctx context.Context, req ExecuteRequest) error
+
+	// ExecuteHook runs a hook of the plugin.
+	ExecuteHook(ctx context.Context, req HookRequest) error
+}
@@ -0,0 +1,97 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// TestMain serves the test plugin when the test binary is started as a plugin.
+func TestMain(m *testing.M) {
+	if os.Getenv(magicCookieKey) == magicCookieValue {
+		Serve(testPlugin{})
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// testPlugin greets in its command and fails its pre-scaffold hook for the list types.
+type testPlugin struct{}
+
+func (testPlugin) Manifest(context.Context) (Manifest, error) {
+	return Manifest{
+		Commands: []Command{
+			{Use: "hello [name]", Short: "Say hello", Flags: []Flag{{Name: "greeting", Default: "hello"}}},
+		},
+		Hooks: []string{HookPreScaffold},
+	}, nil
+}
+
+func (testPlugin) Execute(_ context.Context, req ExecuteRequest) error {
+	if len(req.Args) == 0 {
+		return errors.New("name is required")
+	}
+	return fmt.Errorf("%s %s from %s", req.Flags["greeting"], req.Args[0], req.With["from"])
+}
+
+func (testPlugin) ExecuteHook(_ context.Context, req HookRequest) error {
+	if req.Command == "ignite scaffold list" {
+		return fmt.Errorf("%s refused in %s", req.Hook, req.AppPath)
+	}
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	appPath := t.TempDir()
+
+	p, err := Load(ctx, chainconfig.Plugin{Name: "test", Path: os.Args[0], With: map[string]string{"from": "mars"}}, appPath)
+	require.NoError(t, err)
+	defer p.Close()
+
+	require.Equal(t, "test", p.Manifest.Name)
+	require.Equal(t, "hello [name]", p.Manifest.Commands[0].Use)
+	require.True(t, p.HasHook(HookPreScaffold))
+	require.False(t, p.HasHook(HookPreServe))
+
+	// the errors of the plugin are returned as is.
+	err = p.Execute(ctx, "hello", []string{"alice"}, map[string]string{"greeting": "hi"})
+	require.EqualError(t, err, "hi alice from mars")
+	require.EqualError(t, p.Execute(ctx, "hello", nil, nil), "name is required")
+
+	plugins := []*Plugin{p}
+	require.NoError(t, RunHooks(ctx, plugins, HookPreScaffold, "ignite scaffold map", nil))
+	require.NoError(t, RunHooks(ctx, plugins, HookPreServe, "ignite scaffold list", nil))
+	require.EqualError(t,
+		RunHooks(ctx, plugins, HookPreScaffold, "ignite scaffold list", nil),
+		fmt.Sprintf("pre-scaffold hook of plugin test: pre-scaffold refused in %s", appPath),
+	)
+}
+
+func TestLoadNotPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no true binary on Windows")
+	}
+
+	_, err := Load(context.Background(), chainconfig.Plugin{Name: "true", Path: "/bin/true"}, t.TempDir())
+	require.ErrorContains(t, err, "plugin true: no handshake")
+}
+
+func TestParseHandshake(t *testing.T) {
+	addr, err := parseHandshake("1|tcp|127.0.0.1:4242\n")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:4242", addr)
+
+	_, err = parseHandshake("2|tcp|127.0.0.1:4242\n")
+	require.EqualError(t, err, "unsupported plugin protocol version 2, ignite supports the version 1")
+
+	_, err = parseHandshake("hello\n")
+	require.EqualError(t, err, `invalid handshake "hello"`)
+}
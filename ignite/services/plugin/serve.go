@@ -0,0 +1,44 @@
+package plugin
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+)
+
+const (
+	// handshakeVersion is the version of the protocol between ignite and the plugins.
+	handshakeVersion = "1"
+
+	// magicCookieKey and magicCookieValue are set in the env of the plugins started by ignite,
+	// they tell the plugins are not run by a user.
+	magicCookieKey   = "IGNITE_PLUGIN_MAGIC_COOKIE"
+	magicCookieValue = "d3f8c8a0-ignite-plugin"
+)
+
+// Serve serves the plugin p to ignite, it's called by the main function of the plugin and returns
+// once ignite ends the plugin.
+func Serve(p Interface) {
+	if os.Getenv(magicCookieKey) != magicCookieValue {
+		fmt.Fprintln(os.Stderr, "This binary is a plugin of Ignite CLI, declare it in the plugins of config.yml to use it.")
+		os.Exit(1)
+	}
+
+	// the interrupts of the terminal are received by the plugin too, ignite ends it.
+	signal.Ignore(os.Interrupt)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// the handshake announces the address of the plugin to ignite.
+	fmt.Printf("%s|tcp|%s\n", handshakeVersion, l.Addr())
+
+	if err := newServer(p).Serve(l); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,248 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	appanalysis "github.com/ignite/cli/ignite/pkg/cosmosanalysis/app"
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/query"
+)
+
+// AddAggregate adds a new query to the module that aggregates the data of the source modules
+func (s Scaffolder) AddAggregate(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	queryName,
+	description string,
+	sourceModules []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the query to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(queryName)
+	if err != nil {
+		return sm, err
+	}
+
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+
+	sources, err := checkAggregateSources(s.path, s.modpath.RawPath, moduleName, sourceModules)
+	if err != nil {
+		return sm, err
+	}
+
+	var (
+		g    *genny.Generator
+		opts = &query.AggregateOptions{
+			AppName:     s.modpath.Package,
+			AppPath:     s.path,
+			ModulePath:  s.modpath.RawPath,
+			ModuleName:  moduleName,
+			QueryName:   name,
+			Description: description,
+			Sources:     sources,
+		}
+	)
+
+	// Scaffold
+	g, err = query.NewAggregate(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(cacheStorage, opts.AppPath, s.modpath.RawPath)
+}
+
+// sdkAggregateSource is a module of the Cosmos SDK whose queries can be aggregated
+type sdkAggregateSource struct {
+	query.AggregateSource
+
+	// rpcs are the queries of the module sent by the aggregate query, without request parameters
+	rpcs []string
+}
+
+// sdkAggregateSources are the modules of the Cosmos SDK whose queries can be aggregated, by name
+var sdkAggregateSources = map[string]sdkAggregateSource{
+	"bank": {
+		AggregateSource: query.AggregateSource{
+			TypesPath:    "github.com/cosmos/cosmos-sdk/x/bank/types",
+			ProtoFile:    "cosmos/bank/v1beta1/query.proto",
+			ProtoPackage: "cosmos.bank.v1beta1",
+			Keeper:       "BankKeeper",
+		},
+		rpcs: []string{"TotalSupply"},
+	},
+	"staking": {
+		AggregateSource: query.AggregateSource{
+			TypesPath:    "github.com/cosmos/cosmos-sdk/x/staking/types",
+			ProtoFile:    "cosmos/staking/v1beta1/query.proto",
+			ProtoPackage: "cosmos.staking.v1beta1",
+			Keeper:       "StakingKeeper",
+			QueryServer:  "stakingkeeper.Querier{Keeper: app.StakingKeeper}",
+		},
+		rpcs: []string{"Validators"},
+	},
+	"mint": {
+		AggregateSource: query.AggregateSource{
+			TypesPath:    "github.com/cosmos/cosmos-sdk/x/mint/types",
+			ProtoFile:    "cosmos/mint/v1beta1/query.proto",
+			ProtoPackage: "cosmos.mint.v1beta1",
+			Keeper:       "MintKeeper",
+		},
+		rpcs: []string{"Inflation"},
+	},
+	"distribution": {
+		AggregateSource: query.AggregateSource{
+			TypesPath:    "github.com/cosmos/cosmos-sdk/x/distribution/types",
+			ProtoFile:    "cosmos/distribution/v1beta1/query.proto",
+			ProtoPackage: "cosmos.distribution.v1beta1",
+			Keeper:       "DistrKeeper",
+		},
+		rpcs: []string{"CommunityPool"},
+	},
+	"gov": {
+		AggregateSource: query.AggregateSource{
+			TypesPath:    "github.com/cosmos/cosmos-sdk/x/gov/types",
+			ProtoFile:    "cosmos/gov/v1beta1/query.proto",
+			ProtoPackage: "cosmos.gov.v1beta1",
+			Keeper:       "GovKeeper",
+		},
+		rpcs: []string{"Proposals"},
+	},
+}
+
+// listQueryRe matches the rpc of the list queries scaffolded in the query.proto of a module
+var listQueryRe = regexp.MustCompile(`rpc\s+([A-Za-z]\w*All)\s*\(\s*(QueryAll\w+Request)\s*\)\s*returns\s*\(\s*(QueryAll\w+Response)\s*\)`)
+
+// checkAggregateSources checks the source modules are modules registered in the app with a keeper
+// and returns the queries of their data aggregated by the query
+func checkAggregateSources(appPath, modulePath, moduleName string, sourceModules []string) ([]query.AggregateSource, error) {
+	if len(sourceModules) == 0 {
+		return nil, errors.New("at least one source module must be provided")
+	}
+
+	registered, err := appanalysis.FindRegisteredModules(appPath)
+	if err != nil {
+		return nil, err
+	}
+	isRegistered := func(path string) bool {
+		for _, r := range registered {
+			if r == path {
+				return true
+			}
+		}
+		return false
+	}
+
+	var (
+		sources []query.AggregateSource
+		seen    = make(map[string]struct{})
+	)
+	for _, sourceModule := range sourceModules {
+		name, err := multiformatname.NewName(sourceModule, multiformatname.NoNumber)
+		if err != nil {
+			return nil, err
+		}
+		if name.LowerCase == moduleName {
+			return nil, fmt.Errorf("%s cannot aggregate its own data", moduleName)
+		}
+		if _, ok := seen[name.LowerCase]; ok {
+			return nil, fmt.Errorf("%s is a duplicated source module", name.LowerCase)
+		}
+		seen[name.LowerCase] = struct{}{}
+
+		var source query.AggregateSource
+		sdkSource, isSDK := sdkAggregateSources[name.LowerCase]
+		switch {
+		case isRegistered(fmt.Sprintf("%s/x/%s", modulePath, name.LowerCase)):
+			if source, err = appAggregateSource(appPath, modulePath, name); err != nil {
+				return nil, err
+			}
+		case isSDK && isRegistered(strings.TrimSuffix(sdkSource.TypesPath, "/types")):
+			source = sdkSource.AggregateSource
+			source.Name = name
+			for _, rpc := range sdkSource.rpcs {
+				q, err := newSourceQuery(rpc, fmt.Sprintf("Query%sRequest", rpc), fmt.Sprintf("Query%sResponse", rpc))
+				if err != nil {
+					return nil, err
+				}
+				source.Queries = append(source.Queries, q)
+			}
+		default:
+			return nil, fmt.Errorf("the module %s is not registered in the app", name.LowerCase)
+		}
+
+		if err := appanalysis.CheckKeeper(filepath.Join(appPath, module.PathAppModule), source.Keeper); err != nil {
+			return nil, fmt.Errorf("the module cannot aggregate %s: %s", name.LowerCase, err.Error())
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// appAggregateSource returns the source of the module of the app with the list queries of the types
+// scaffolded in the module
+func appAggregateSource(appPath, modulePath string, name multiformatname.Name) (query.AggregateSource, error) {
+	source := query.AggregateSource{
+		Name:         name,
+		TypesPath:    fmt.Sprintf("%s/x/%s/types", modulePath, name.LowerCase),
+		ProtoFile:    fmt.Sprintf("%s/query.proto", name.LowerCase),
+		ProtoPackage: module.ProtoPackageName(gomodulepath.ExtractAppPath(modulePath), name.LowerCase),
+		Keeper:       fmt.Sprintf("%sKeeper", xstrings.Title(name.LowerCase)),
+	}
+
+	proto, err := os.ReadFile(filepath.Join(appPath, protoFolder, source.ProtoFile))
+	if err != nil {
+		return source, err
+	}
+	for _, match := range listQueryRe.FindAllStringSubmatch(string(proto), -1) {
+		q, err := newSourceQuery(match[1], match[2], match[3])
+		if err != nil {
+			return source, err
+		}
+		source.Queries = append(source.Queries, q)
+	}
+	if len(source.Queries) == 0 {
+		return source, fmt.Errorf("the module %s has no list query to aggregate, scaffold a list or a map type in it first", name.LowerCase)
+	}
+	return source, nil
+}
+
+// newSourceQuery returns the query of a source module with its rpc and its messages
+func newSourceQuery(rpc, request, response string) (query.AggregateSourceQuery, error) {
+	name, err := multiformatname.NewName(rpc)
+	if err != nil {
+		return query.AggregateSourceQuery{}, err
+	}
+	return query.AggregateSourceQuery{Name: name, Request: request, Response: response}, nil
+}
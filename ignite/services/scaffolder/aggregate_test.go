@@ -0,0 +1,164 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const aggregateAppFile = `package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	marsmodule "github.com/test/blog/x/mars"
+	marsmodulekeeper "github.com/test/blog/x/mars/keeper"
+	venusmodule "github.com/test/blog/x/venus"
+	venusmodulekeeper "github.com/test/blog/x/venus/keeper"
+)
+
+var ModuleBasics = module.NewBasicManager(
+	bank.AppModuleBasic{},
+	marsmodule.AppModuleBasic{},
+	venusmodule.AppModuleBasic{},
+)
+
+type App struct {
+	BankKeeper  bankkeeper.Keeper
+	MarsKeeper  marsmodulekeeper.Keeper
+	VenusKeeper venusmodulekeeper.Keeper
+}
+
+func (app *App) Name() string               { return "blog" }
+func (app *App) BeginBlocker()              {}
+func (app *App) EndBlocker()                {}
+func (app *App) RegisterAPIRoutes()         {}
+func (app *App) RegisterTxService()         {}
+func (app *App) RegisterTendermintService() {}
+`
+
+const aggregateMarsQueryProto = `syntax = "proto3";
+package test.blog.mars;
+
+service Query {
+	rpc Params(QueryParamsRequest) returns (QueryParamsResponse) {}
+	rpc Post(QueryGetPostRequest) returns (QueryGetPostResponse) {}
+	rpc PostAll(QueryAllPostRequest) returns (QueryAllPostResponse) {}
+	rpc CommentAll(QueryAllCommentRequest) returns (QueryAllCommentResponse) {}
+}
+`
+
+const aggregateVenusQueryProto = `syntax = "proto3";
+package test.blog.venus;
+
+service Query {
+	rpc Params(QueryParamsRequest) returns (QueryParamsResponse) {}
+}
+`
+
+func TestCheckAggregateSources(t *testing.T) {
+	appPath := t.TempDir()
+	for path, content := range map[string]string{
+		"app/app.go":              aggregateAppFile,
+		"proto/mars/query.proto":  aggregateMarsQueryProto,
+		"proto/venus/query.proto": aggregateVenusQueryProto,
+	} {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	type sourceQueries struct {
+		keeper, typesPath, protoFile, protoPackage string
+		queries                                    [][3]string
+	}
+	tests := []struct {
+		name    string
+		sources []string
+		want    []sourceQueries
+		err     string
+	}{
+		{
+			name:    "sdk and app modules",
+			sources: []string{"bank", "mars"},
+			want: []sourceQueries{
+				{
+					keeper:       "BankKeeper",
+					typesPath:    "github.com/cosmos/cosmos-sdk/x/bank/types",
+					protoFile:    "cosmos/bank/v1beta1/query.proto",
+					protoPackage: "cosmos.bank.v1beta1",
+					queries:      [][3]string{{"TotalSupply", "QueryTotalSupplyRequest", "QueryTotalSupplyResponse"}},
+				},
+				{
+					keeper:       "MarsKeeper",
+					typesPath:    "github.com/test/blog/x/mars/types",
+					protoFile:    "mars/query.proto",
+					protoPackage: "test.blog.mars",
+					queries: [][3]string{
+						{"PostAll", "QueryAllPostRequest", "QueryAllPostResponse"},
+						{"CommentAll", "QueryAllCommentRequest", "QueryAllCommentResponse"},
+					},
+				},
+			},
+		},
+		{
+			name:    "no source",
+			sources: nil,
+			err:     "at least one source module must be provided",
+		},
+		{
+			name:    "own module",
+			sources: []string{"blog"},
+			err:     "blog cannot aggregate its own data",
+		},
+		{
+			name:    "duplicated source",
+			sources: []string{"mars", "mars"},
+			err:     "mars is a duplicated source module",
+		},
+		{
+			name:    "sdk module not registered",
+			sources: []string{"staking"},
+			err:     "the module staking is not registered in the app",
+		},
+		{
+			name:    "unknown module",
+			sources: []string{"jupiter"},
+			err:     "the module jupiter is not registered in the app",
+		},
+		{
+			name:    "app module without list query",
+			sources: []string{"venus"},
+			err:     "the module venus has no list query to aggregate, scaffold a list or a map type in it first",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, err := checkAggregateSources(appPath, "github.com/test/blog", "blog", tt.sources)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, sources, len(tt.want))
+			for i, want := range tt.want {
+				source := sources[i]
+				require.Equal(t, tt.sources[i], source.Name.LowerCase)
+				require.Equal(t, want.keeper, source.Keeper)
+				require.Equal(t, want.typesPath, source.TypesPath)
+				require.Equal(t, want.protoFile, source.ProtoFile)
+				require.Equal(t, want.protoPackage, source.ProtoPackage)
+
+				var queries [][3]string
+				for _, q := range source.Queries {
+					queries = append(queries, [3]string{q.Name.UpperCamel, q.Request, q.Response})
+				}
+				require.Equal(t, want.queries, queries)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/query"
+)
+
+// appInfoQueryName is the name of the query returning the information of the app
+const appInfoQueryName = "app-info"
+
+// AddAppInfo adds a query to the module returning the information wallets and explorers need to
+// integrate the app: its name, version, modules, IBC apps and denoms metadata.
+// The query is served at /app/info, an app can only have one.
+func (s Scaffolder) AddAppInfo(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the query to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(appInfoQueryName)
+	if err != nil {
+		return sm, err
+	}
+
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+
+	owner, err := appInfoOwner(s.path)
+	if err != nil {
+		return sm, err
+	}
+	if owner != "" {
+		return sm, fmt.Errorf("the app info query is already served by the module %s", owner)
+	}
+
+	opts := &query.AppInfoOptions{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		QueryName:  name,
+	}
+
+	g, err := query.NewAppInfo(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(cacheStorage, opts.AppPath, s.modpath.RawPath)
+}
+
+// appInfoOwner returns the module serving the app info query, if any
+func appInfoOwner(appPath string) (string, error) {
+	protoFiles, err := filepath.Glob(filepath.Join(appPath, "proto", "*", "query.proto"))
+	if err != nil {
+		return "", err
+	}
+
+	route := fmt.Sprintf("%q", query.AppInfoRoute)
+	for _, path := range protoFiles {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(string(content), route) {
+			return filepath.Base(filepath.Dir(path)), nil
+		}
+	}
+	return "", nil
+}
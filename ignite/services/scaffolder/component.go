@@ -212,13 +212,8 @@ func checkCustomTypes(ctx context.Context, path, module string, fields []string)
 	protoPath := filepath.Join(path, protoFolder, module)
 	customFields := make([]string, 0)
 	for _, name := range fields {
-		fieldSplit := strings.Split(name, datatype.Separator)
-		if len(fieldSplit) <= 1 {
-			continue
-		}
-		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
-			customFields = append(customFields, string(fieldType))
+		if customType, ok := customFieldType(name); ok {
+			customFields = append(customFields, customType)
 		}
 	}
 	return protoanalysis.HasMessages(ctx, protoPath, customFields...)
@@ -227,14 +222,26 @@ func checkCustomTypes(ctx context.Context, path, module string, fields []string)
 // containCustomTypes returns true if the list of fields contains at least one custom type
 func containCustomTypes(fields []string) bool {
 	for _, name := range fields {
-		fieldSplit := strings.Split(name, datatype.Separator)
-		if len(fieldSplit) <= 1 {
-			continue
-		}
-		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
+		if _, ok := customFieldType(name); ok {
 			return true
 		}
 	}
 	return false
 }
+
+// customFieldType returns the custom type of a field, or of the elements of an array field,
+// ok is false when the field has a built-in type or is an enum.
+func customFieldType(name string) (customType string, ok bool) {
+	fieldSplit := strings.Split(name, datatype.Separator)
+	if len(fieldSplit) <= 1 {
+		return "", false
+	}
+	fieldType := datatype.Name(fieldSplit[1])
+	if _, ok := datatype.SupportedTypes[fieldType]; ok {
+		return "", false
+	}
+	if _, ok := datatype.EnumValues(fieldType); ok {
+		return "", false
+	}
+	return strings.TrimPrefix(string(fieldType), datatype.SlicePrefix), true
+}
@@ -0,0 +1,81 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/devcontainer"
+)
+
+// servers forwarded from the development environments.
+var devcontainerServers = map[string]bool{
+	"Tendermint RPC": true,
+	"API":            true,
+	"gRPC":           true,
+	"Faucet":         true,
+}
+
+// AddDevcontainer scaffolds a dev container and a Gitpod config to develop the app in a reproducible
+// environment, with the Go toolchain of the app, Ignite CLI and the ports of the served chain forwarded.
+// igniteVersion is the version of Ignite CLI installed, the latest one is installed when empty.
+func (s Scaffolder) AddDevcontainer(igniteVersion string) (sm xgenny.SourceModification, err error) {
+	for _, file := range []string{".devcontainer", ".gitpod.yml"} {
+		if _, err := os.Stat(filepath.Join(s.path, file)); err == nil {
+			return sm, fmt.Errorf("%s already exists", file)
+		} else if !os.IsNotExist(err) {
+			return sm, err
+		}
+	}
+
+	modfile, err := gomodule.ParseAt(s.path)
+	if err != nil {
+		return sm, err
+	}
+	if modfile.Go == nil {
+		return sm, fmt.Errorf("the Go version is missing from the go.mod of %s", s.path)
+	}
+
+	conf := chainconfig.DefaultConf
+	if path, err := chainconfig.LocateDefault(s.path); err == nil {
+		if conf, err = chainconfig.ParseFile(path); err != nil {
+			return sm, err
+		}
+	}
+
+	_, err = os.Stat(filepath.Join(s.path, "vue"))
+	frontend := err == nil
+
+	var (
+		ports        []devcontainer.Port
+		frontendPort int
+	)
+	for _, server := range chainconfig.Servers(conf) {
+		switch {
+		case server.Name == "Frontend" && frontend:
+			frontendPort = server.Port
+		case !devcontainerServers[server.Name]:
+			continue
+		}
+		ports = append(ports, devcontainer.Port{Name: server.Name, Port: server.Port})
+	}
+
+	g, err := devcontainer.NewGenerator(&devcontainer.Options{
+		AppName:       s.modpath.Package,
+		AppPath:       s.path,
+		GoVersion:     modfile.Go.Version,
+		IgniteVersion: igniteVersion,
+		Ports:         ports,
+		Frontend:      frontend,
+		FrontendPort:  frontendPort,
+	})
+	if err != nil {
+		return sm, err
+	}
+
+	return xgenny.RunWithValidation(placeholder.New(), g)
+}
@@ -0,0 +1,141 @@
+package scaffolder
+
+import (
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/escrow"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/message"
+	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
+	"github.com/ignite/cli/ignite/templates/typed"
+	"github.com/ignite/cli/ignite/templates/typed/list"
+)
+
+// AddEscrow creates a new module holding funds in escrows in the scaffolded app.
+// The funds of the escrows are locked in the module account until they are released to
+// their receiver or refunded to their sender, at the latest when their timeout is reached.
+func (s Scaffolder) AddEscrow(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName string,
+) (sm xgenny.SourceModification, err error) {
+	// the module account only holds the funds, it doesn't need any permission
+	sm, err = s.createModule(
+		tracer,
+		moduleName,
+		WithDependencies([]modulecreate.Dependency{
+			modulecreate.NewDependency("account", ""),
+			modulecreate.NewDependency("bank", ""),
+		}),
+		withModuleAccountPerms(),
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	signer, err := multiformatname.NewName("creator")
+	if err != nil {
+		return sm, err
+	}
+
+	// escrows stored in a list
+	typeName, err := multiformatname.NewName(escrow.TypeName)
+	if err != nil {
+		return sm, err
+	}
+	typeFields, err := field.ParseFields(escrow.TypeFields, checkForbiddenTypeField)
+	if err != nil {
+		return sm, err
+	}
+	g, err := list.NewStargate(tracer, &typed.Options{
+		AppName:      s.modpath.Package,
+		AppPath:      s.path,
+		ModulePath:   s.modpath.RawPath,
+		ModuleName:   moduleName,
+		TypeName:     typeName,
+		MsgSigner:    signer,
+		Fields:       typeFields,
+		NoMessage:    true,
+		NoSimulation: true,
+	})
+	if err != nil {
+		return sm, err
+	}
+	gens := []*genny.Generator{g}
+
+	// messages creating, releasing and refunding the escrows
+	messages := []struct {
+		name      string
+		fields    []string
+		resFields []string
+	}{
+		{escrow.MsgCreate, escrow.MsgCreateFields, escrow.MsgIDFields},
+		{escrow.MsgRelease, escrow.MsgIDFields, nil},
+		{escrow.MsgRefund, escrow.MsgIDFields, nil},
+	}
+	for _, msg := range messages {
+		msgName, err := multiformatname.NewName(msg.name)
+		if err != nil {
+			return sm, err
+		}
+		fields, err := field.ParseFields(msg.fields, checkForbiddenMessageField, signer.LowerCamel)
+		if err != nil {
+			return sm, err
+		}
+		resFields, err := field.ParseFields(msg.resFields, checkGoReservedWord, signer.LowerCamel)
+		if err != nil {
+			return sm, err
+		}
+		g, err := message.NewStargate(tracer, &message.Options{
+			AppName:      s.modpath.Package,
+			AppPath:      s.path,
+			ModulePath:   s.modpath.RawPath,
+			ModuleName:   moduleName,
+			MsgName:      msgName,
+			MsgSigner:    signer,
+			SignerOption: s.msgSignerOption(),
+			MsgDesc:      newMessageOptions(msg.name).description,
+			Fields:       fields,
+			ResFields:    resFields,
+		})
+		if err != nil {
+			return sm, err
+		}
+		gens = append(gens, g)
+	}
+
+	// escrow logic implementing the messages
+	g, err = escrow.NewStargate(tracer, &escrow.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+	})
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	gens, err = supportAddressCodec(gens, s.path)
+	if err != nil {
+		return sm, err
+	}
+
+	escrowSourceModification, err := xgenny.RunWithValidation(tracer, gens...)
+	sm.Merge(escrowSourceModification)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
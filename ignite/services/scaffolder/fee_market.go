@@ -0,0 +1,73 @@
+package scaffolder
+
+import (
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/feemarket"
+)
+
+// AddFeeMarket creates a new module pricing the gas of the txs with a base fee adjusted with the
+// fullness of the blocks, as specified by EIP-1559. The base fee increases when the blocks use more
+// than their target gas and decreases when they use less, down to the minimum base fee.
+// The txs must pay their gas at the base fee in denom, the fees are checked by the ante handler
+// of the app.
+func (s Scaffolder) AddFeeMarket(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	denom,
+	minBaseFee string,
+) (sm xgenny.SourceModification, err error) {
+	if err := sdktypes.ValidateDenom(denom); err != nil {
+		return sm, fmt.Errorf("invalid base fee denom %q: %w", denom, err)
+	}
+	if _, err := parseMinBaseFee(minBaseFee); err != nil {
+		return sm, err
+	}
+
+	sm, err = s.createModule(tracer, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	g, err := feemarket.NewStargate(tracer, &feemarket.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		Denom:      denom,
+		MinBaseFee: minBaseFee,
+	})
+	if err != nil {
+		return sm, err
+	}
+
+	feeMarketSourceModification, err := xgenny.RunWithValidation(tracer, g)
+	sm.Merge(feeMarketSourceModification)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// parseMinBaseFee parses the minimum base fee, a non-negative decimal price of the gas, e.g. 0.0025
+func parseMinBaseFee(minBaseFee string) (sdktypes.Dec, error) {
+	fee, err := sdktypes.NewDecFromStr(minBaseFee)
+	if err != nil || fee.IsNegative() {
+		return sdktypes.Dec{}, fmt.Errorf("invalid minimum base fee %q, use a non-negative decimal, e.g. 0.0025", minBaseFee)
+	}
+	return fee, nil
+}
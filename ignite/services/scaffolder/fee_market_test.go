@@ -0,0 +1,33 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMinBaseFee(t *testing.T) {
+	tests := []struct {
+		minBaseFee string
+		want       string
+		err        bool
+	}{
+		{minBaseFee: "0.0025", want: "0.002500000000000000"},
+		{minBaseFee: "0", want: "0.000000000000000000"},
+		{minBaseFee: "10", want: "10.000000000000000000"},
+		{minBaseFee: "-1", err: true},
+		{minBaseFee: "0.0025stake", err: true},
+		{minBaseFee: "", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.minBaseFee, func(t *testing.T) {
+			fee, err := parseMinBaseFee(tt.minBaseFee)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, fee.String())
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+// sdkTypesImport is the import path of the Cosmos SDK package declaring the coins.
+const sdkTypesImport = "github.com/cosmos/cosmos-sdk/types"
+
+// AddTypeFromStruct adds a new type to a scaffolded app with the fields of the struct structName
+// declared in the Go file at path. The fields generated by the type scaffolder, the ID and the
+// signer, and the unexported fields are skipped, their names are returned. The indexes of a map
+// are fields of the struct, they're declared without type.
+func (s Scaffolder) AddTypeFromStruct(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	path,
+	structName string,
+	kind AddTypeKind,
+	options ...AddTypeOption,
+) (sm xgenny.SourceModification, skipped []string, err error) {
+	o := newAddTypeOptions(s.modpath.Package)
+	for _, apply := range append(options, AddTypeOption(kind)) {
+		apply(&o)
+	}
+	signer := o.signer
+	if o.withoutMessage {
+		signer = ""
+	}
+
+	fields, skipped, err := StructFields(path, structName, signer)
+	if err != nil {
+		return sm, nil, err
+	}
+
+	if o.isMap {
+		if len(o.indexes) == 0 {
+			return sm, nil, fmt.Errorf("the map %s needs at least one field of the struct as index", structName)
+		}
+		var indexes []string
+		if fields, indexes, err = structIndexes(fields, o.indexes); err != nil {
+			return sm, nil, err
+		}
+		kind = MapType(indexes...)
+	}
+
+	options = append(options, TypeWithFields(fields...))
+	sm, err = s.AddType(ctx, cacheStorage, structName, tracer, kind, options...)
+	return sm, skipped, err
+}
+
+// StructFields returns the fields of the struct structName declared in the Go file at path as
+// the fields of a scaffolded type, name:type. The fields are named after their JSON name when
+// they have one. The ID, the signer and the unexported fields are skipped, their names are
+// returned apart.
+func StructFields(path, structName, signer string) (fields, skipped []string, err error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the types declared in the file, the named basic types are converted to their underlying type
+	decls := make(map[string]ast.Expr)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok {
+			decls[spec.Name.Name] = spec.Type
+		}
+		return true
+	})
+
+	decl, ok := decls[structName]
+	if !ok {
+		return nil, nil, fmt.Errorf("the type %s is not declared in %s", structName, path)
+	}
+	st, ok := decl.(*ast.StructType)
+	if !ok {
+		return nil, nil, fmt.Errorf("the type %s is not a struct", structName)
+	}
+
+	sdkName := importName(f, sdkTypesImport)
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			return nil, nil, fmt.Errorf("the embedded field %s of %s is not supported", types.ExprString(field.Type), structName)
+		}
+
+		jsonName := structFieldJSONName(field.Tag)
+		for _, ident := range field.Names {
+			name := ident.Name
+			if jsonName != "" {
+				name = jsonName
+			}
+			if !ident.IsExported() || jsonName == "-" {
+				skipped = append(skipped, ident.Name)
+				continue
+			}
+
+			mfName, err := multiformatname.NewName(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			if mfName.LowerCase == "id" || (signer != "" && mfName.LowerCamel == signer) {
+				skipped = append(skipped, ident.Name)
+				continue
+			}
+
+			datatypeName, err := structFieldType(field.Type, decls, sdkName)
+			if err != nil {
+				return nil, nil, fmt.Errorf("the field %s of %s: %w", ident.Name, structName, err)
+			}
+			fields = append(fields, fmt.Sprintf("%s%s%s", name, datatype.Separator, datatypeName))
+		}
+	}
+
+	return fields, skipped, nil
+}
+
+// structFieldType returns the type of a scaffolded field from the type of a struct field.
+func structFieldType(expr ast.Expr, decls map[string]ast.Expr, sdkName string) (string, error) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return structFieldType(t.X, decls, sdkName)
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return string(datatype.String), nil
+		case "bool":
+			return string(datatype.Bool), nil
+		case "int", "int8", "int16", "int32", "int64":
+			return string(datatype.Int), nil
+		case "uint", "uint8", "uint16", "uint32", "uint64":
+			return string(datatype.Uint), nil
+		}
+		if underlying, ok := decls[t.Name]; ok {
+			if _, ok := underlying.(*ast.Ident); ok {
+				return structFieldType(underlying, decls, sdkName)
+			}
+		}
+		// the other types are custom types declared in the proto files of the module
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok && x.Name == sdkName {
+			switch t.Sel.Name {
+			case "Coin":
+				return string(datatype.Coin), nil
+			case "Coins":
+				return string(datatype.Coins), nil
+			}
+		}
+		return t.Sel.Name, nil
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("the array type %s is not supported, use a slice", types.ExprString(t))
+		}
+		if ident, ok := t.Elt.(*ast.Ident); ok && (ident.Name == "byte" || ident.Name == "uint8") {
+			return "", fmt.Errorf("the type %s is not supported", types.ExprString(t))
+		}
+		elt, err := structFieldType(t.Elt, decls, sdkName)
+		if err != nil {
+			return "", err
+		}
+		switch datatype.Name(elt) {
+		case datatype.String:
+			return string(datatype.StringSlice), nil
+		case datatype.Int:
+			return string(datatype.IntSlice), nil
+		case datatype.Uint:
+			return string(datatype.UintSlice), nil
+		case datatype.Coin:
+			return string(datatype.Coins), nil
+		}
+		// the slices of custom types are arrays of the custom type
+		if _, ok := datatype.SupportedTypes[datatype.Name(elt)]; !ok {
+			return datatype.SlicePrefix + elt, nil
+		}
+		return "", fmt.Errorf("the slice type %s is not supported", types.ExprString(t))
+	}
+	return "", fmt.Errorf("the type %s is not supported", types.ExprString(expr))
+}
+
+// structIndexes moves the indexes of a map from its fields to its typed indexes. The indexes are
+// matched with the fields in any naming convention.
+func structIndexes(fields, indexes []string) (remaining, typedIndexes []string, err error) {
+	byName := make(map[string]string)
+	for _, f := range fields {
+		name, err := multiformatname.NewName(strings.Split(f, datatype.Separator)[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		byName[name.LowerCamel] = f
+	}
+
+	isIndex := make(map[string]bool)
+	for _, index := range indexes {
+		name, err := multiformatname.NewName(strings.Split(index, datatype.Separator)[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		f, ok := byName[name.LowerCamel]
+		if !ok {
+			return nil, nil, fmt.Errorf("the index %s is not a field of the struct", name.Original)
+		}
+		isIndex[f] = true
+		typedIndexes = append(typedIndexes, f)
+	}
+	for _, f := range fields {
+		if !isIndex[f] {
+			remaining = append(remaining, f)
+		}
+	}
+	return remaining, typedIndexes, nil
+}
+
+// structFieldJSONName returns the JSON name of a struct field from its tag.
+func structFieldJSONName(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return ""
+	}
+	return strings.Split(reflect.StructTag(value).Get("json"), ",")[0]
+}
+
+// importName returns the name of the package imported with importPath in f, or an empty string
+// when it's not imported.
+func importName(f *ast.File, importPath string) string {
+	for _, imp := range f.Imports {
+		if strings.Trim(imp.Path.Value, `"`) != importPath {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return importPath[strings.LastIndex(importPath, "/")+1:]
+	}
+	return ""
+}
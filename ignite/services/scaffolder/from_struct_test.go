@@ -0,0 +1,120 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const orderSource = `package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type Status string
+
+type Order struct {
+	ID       uint64
+	Creator  string
+	OrderID  string ` + "`json:\"order_id\"`" + `
+	Buyer    string
+	Price    sdk.Coin
+	Fees     sdk.Coins
+	Amount   int32
+	Quantity uint
+	Filled   *bool
+	Status   Status
+	Tags     []string
+	Item     Item
+	Items    []*Item
+	internal string
+	Ignored  string ` + "`json:\"-\"`" + `
+}
+
+type Item struct {
+	Name string
+}
+
+type Bytes struct {
+	Data []byte
+}
+`
+
+func TestStructFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "order.go")
+	require.NoError(t, os.WriteFile(path, []byte(orderSource), 0644))
+
+	tests := []struct {
+		name       string
+		structName string
+		signer     string
+		fields     []string
+		skipped    []string
+		err        string
+	}{
+		{
+			name:       "fields",
+			structName: "Order",
+			signer:     "creator",
+			fields: []string{
+				"order_id:string",
+				"Buyer:string",
+				"Price:coin",
+				"Fees:array.coin",
+				"Amount:int",
+				"Quantity:uint",
+				"Filled:bool",
+				"Status:string",
+				"Tags:array.string",
+				"Item:Item",
+				"Items:array.Item",
+			},
+			skipped: []string{"ID", "Creator", "internal", "Ignored"},
+		},
+		{
+			name:       "without signer",
+			structName: "Item",
+			fields:     []string{"Name:string"},
+		},
+		{
+			name:       "unsupported type",
+			structName: "Bytes",
+			err:        "the field Data of Bytes: the type []byte is not supported",
+		},
+		{
+			name:       "not a struct",
+			structName: "Status",
+			err:        "the type Status is not a struct",
+		},
+		{
+			name:       "not declared",
+			structName: "Trade",
+			err:        "the type Trade is not declared in " + path,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, skipped, err := StructFields(path, tt.structName, tt.signer)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.fields, fields)
+			require.Equal(t, tt.skipped, skipped)
+		})
+	}
+}
+
+func TestStructIndexes(t *testing.T) {
+	fields := []string{"order_id:string", "Buyer:string", "Amount:int"}
+
+	remaining, indexes, err := structIndexes(fields, []string{"orderId", "buyer"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Amount:int"}, remaining)
+	require.Equal(t, []string{"order_id:string", "Buyer:string"}, indexes)
+
+	_, _, err = structIndexes(fields, []string{"seller"})
+	require.EqualError(t, err, "the index seller is not a field of the struct")
+}
@@ -0,0 +1,117 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/icq"
+)
+
+// icqFile is the file of the keeper defining the interchain queries support of a module
+const icqFile = "icq.go"
+
+var storeNameRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// AddICQuery adds an interchain query type to a module, its queries read a key of the store of a
+// host chain, e.g. bank, and their responses are verified with the IBC client of the host chain.
+// The first query type of a module scaffolds the interchain queries support: the pending queries,
+// the message submitting their responses with proofs, the allowlist of the host stores and the
+// mocks testing the queries.
+func (s Scaffolder) AddICQuery(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	queryName,
+	store string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the query to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(queryName)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+	queryPath := filepath.Join(s.path, "x", moduleName, "keeper", fmt.Sprintf("icq_%s.go", name.Snake))
+	if _, err := os.Stat(queryPath); err == nil {
+		return sm, fmt.Errorf("the interchain query %s already exists in the module %s", name.Original, moduleName)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	if !storeNameRe.MatchString(store) {
+		return sm, fmt.Errorf("invalid store %q, use the store key of a module of the host chain, e.g. bank", store)
+	}
+
+	gens, err := s.supportICQ(nil, tracer, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	gens, err = supportAddressCodec(gens, s.path)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := icq.NewStargate(tracer, &icq.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		QueryName:  name,
+		Store:      store,
+	})
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// supportICQ adds the generator scaffolding the interchain queries support of the module if the
+// module doesn't define it yet
+func (s Scaffolder) supportICQ(
+	gens []*genny.Generator,
+	replacer placeholder.Replacer,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	supportPath := filepath.Join(s.path, "x", moduleName, "keeper", icqFile)
+	if _, err := os.Stat(supportPath); err == nil {
+		return gens, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	g, err := icq.NewStargateSupport(replacer, &icq.SupportOptions{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(gens, g), nil
+}
@@ -3,6 +3,7 @@ package scaffolder
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -13,10 +14,12 @@ import (
 	"github.com/tendermint/flutter/v2"
 	"github.com/tendermint/vue"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/ignite/pkg/localfs"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/addresscodec"
 	"github.com/ignite/cli/ignite/templates/app"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
@@ -30,43 +33,163 @@ var (
 	}
 )
 
+// initOptions represents configuration for the app initialization
+type initOptions struct {
+	modulePath   string
+	template     string
+	templateVars map[string]string
+	minimal      bool
+	denoms       []chainconfig.Denom
+	addressCodec string
+}
+
+// InitOption configures the app initialization
+type InitOption func(*initOptions)
+
+// InitWithModulePath provides the Go module path of the app.
+// The app is created directly in the root directory instead of a directory named after the app,
+// this allows to create an app inside an existing repository.
+func InitWithModulePath(modulePath string) InitOption {
+	return func(o *initOptions) {
+		o.modulePath = modulePath
+	}
+}
+
+// InitMinimal scaffolds only the shell of the app, without the default module, the Vue.js app and
+// the OpenAPI docs. They can be added later with "ignite scaffold module", "ignite scaffold vue"
+// and "ignite scaffold openapi".
+func InitMinimal() InitOption {
+	return func(o *initOptions) {
+		o.minimal = true
+	}
+}
+
+// InitWithDenoms adds the denoms to the config of the app with their metadata, the coins of the
+// accounts and the faucet of the config are given in these denoms instead of the default token.
+func InitWithDenoms(denoms []chainconfig.Denom) InitOption {
+	return func(o *initOptions) {
+		o.denoms = denoms
+	}
+}
+
+// InitWithAddressCodec sets the codec of the account addresses of the app, the scaffolded modules
+// and the generated clients encode the addresses with it instead of bech32.
+func InitWithAddressCodec(codec string) InitOption {
+	return func(o *initOptions) {
+		o.addressCodec = codec
+	}
+}
+
 // Init initializes a new app with name and given options.
-func Init(cacheStorage cache.Storage, tracer *placeholder.Tracer, root, name, addressPrefix string, noDefaultModule bool) (path string, err error) {
+func Init(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	root,
+	name,
+	addressPrefix string,
+	noDefaultModule bool,
+	options ...InitOption,
+) (path string, err error) {
+	var initOpts initOptions
+	for _, apply := range options {
+		apply(&initOpts)
+	}
+
 	if root, err = filepath.Abs(root); err != nil {
 		return "", err
 	}
 
-	pathInfo, err := gomodulepath.Parse(name)
-	if err != nil {
-		return "", err
+	var pathInfo gomodulepath.Path
+	if initOpts.modulePath != "" {
+		if pathInfo, err = gomodulepath.Parse(initOpts.modulePath); err != nil {
+			return "", err
+		}
+		if err := checkEmptyDir(root); err != nil {
+			return "", err
+		}
+		path = root
+	} else {
+		if pathInfo, err = gomodulepath.Parse(name); err != nil {
+			return "", err
+		}
+		path = filepath.Join(root, pathInfo.Root)
 	}
 
-	path = filepath.Join(root, pathInfo.Root)
+	// fetch the project template when the app isn't created from the built-in one
+	var template *projectTemplate
+	if initOpts.template != "" {
+		dir, manifest, err := fetchTemplate(context.Background(), initOpts.template)
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(dir)
+
+		vars, err := resolveTemplateVars(manifest, initOpts.templateVars)
+		if err != nil {
+			return "", err
+		}
+		template = &projectTemplate{dir: dir, vars: vars}
+	}
 
 	// create the project
-	if err := generate(tracer, pathInfo, addressPrefix, path, noDefaultModule); err != nil {
+	if err := generate(tracer, pathInfo, addressPrefix, path, noDefaultModule, initOpts.minimal, initOpts.denoms, initOpts.addressCodec, template); err != nil {
 		return "", err
 	}
 
+	// add the app to the Go workspace of the repository it is created in
+	if initOpts.modulePath != "" {
+		if err := setupWorkspace(context.Background(), path); err != nil {
+			return "", err
+		}
+	}
+
 	if err := finish(cacheStorage, path, pathInfo.RawPath); err != nil {
 		return "", err
 	}
 
 	// initialize git repository and perform the first commit
-	if err := initGit(path); err != nil {
-		return "", err
+	// unless the app is created in an existing repository
+	inRepository := false
+	if initOpts.modulePath != "" {
+		if inRepository, err = isInGitRepository(path); err != nil {
+			return "", err
+		}
+	}
+	if !inRepository {
+		if err := initGit(path); err != nil {
+			return "", err
+		}
 	}
 
 	return path, nil
 }
 
+// checkEmptyDir returns an error if the directory exists and is not empty.
+func checkEmptyDir(path string) error {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("the directory %s is not empty", path)
+	}
+	return nil
+}
+
 //nolint:interfacer
 func generate(
 	tracer *placeholder.Tracer,
 	pathInfo gomodulepath.Path,
 	addressPrefix,
 	absRoot string,
-	noDefaultModule bool,
+	noDefaultModule,
+	minimal bool,
+	denoms []chainconfig.Denom,
+	addressCodec string,
+	template *projectTemplate,
 ) error {
 	githubPath := gomodulepath.ExtractAppPath(pathInfo.RawPath)
 	if !strings.Contains(githubPath, "/") {
@@ -74,7 +197,7 @@ func generate(
 		githubPath = fmt.Sprintf("username/%s", githubPath)
 	}
 
-	g, err := app.New(&app.Options{
+	appOpts := &app.Options{
 		// generate application template
 		ModulePath:       pathInfo.RawPath,
 		AppName:          pathInfo.Package,
@@ -82,7 +205,20 @@ func generate(
 		GitHubPath:       githubPath,
 		BinaryNamePrefix: pathInfo.Root,
 		AddressPrefix:    addressPrefix,
-	})
+		Minimal:          minimal,
+		Denoms:           denoms,
+		AddressCodec:     addressCodec,
+	}
+
+	var (
+		g   *genny.Generator
+		err error
+	)
+	if template != nil {
+		g, err = app.NewFromTemplate(template.dir, appOpts, template.vars)
+	} else {
+		g, err = app.New(appOpts)
+	}
 	if err != nil {
 		return err
 	}
@@ -96,6 +232,20 @@ func generate(
 		return err
 	}
 
+	// create the package encoding the account addresses with the codec of the app
+	g, err = addresscodec.NewGenerator(absRoot, addressCodec)
+	if err != nil {
+		return err
+	}
+	if err := run(genny.WetRunner(context.Background()), g); err != nil {
+		return err
+	}
+
+	// the minimal app is only the app shell
+	if minimal {
+		return nil
+	}
+
 	// generate module template
 	if !noDefaultModule {
 		opts := &modulecreate.CreateOptions{
@@ -3,6 +3,8 @@ package scaffolder
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/gobuffalo/genny"
 
@@ -16,11 +18,23 @@ import (
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
 
+const (
+	// accessFile is the file defining the access control of a module
+	accessFile = "access.go"
+
+	// adminTransferMessage is the name of the message transferring the admin role of a module
+	adminTransferMessage = "transfer-admin"
+
+	// adminTransferField is the field of the admin transfer message
+	adminTransferField = "newAdmin"
+)
+
 // messageOptions represents configuration for the message scaffolding
 type messageOptions struct {
 	description       string
 	signer            string
 	withoutSimulation bool
+	permission        string
 }
 
 // newMessageOptions returns a messageOptions with default options
@@ -55,6 +69,14 @@ func WithoutSimulation() MessageOption {
 	}
 }
 
+// WithPermission restricts the message to the accounts with the permission,
+// either the module admin or the accounts of the module allowlist
+func WithPermission(permission string) MessageOption {
+	return func(m *messageOptions) {
+		m.permission = permission
+	}
+}
+
 // AddMessage adds a new message to scaffolded app
 func (s Scaffolder) AddMessage(
 	ctx context.Context,
@@ -91,6 +113,17 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	switch scaffoldingOpts.permission {
+	case "", message.PermissionAdmin, message.PermissionAllowlist:
+	default:
+		return sm, fmt.Errorf(
+			"invalid permission %q, accepted values are %q and %q",
+			scaffoldingOpts.permission,
+			message.PermissionAdmin,
+			message.PermissionAllowlist,
+		)
+	}
+
 	// Check and parse provided fields
 	if err := checkCustomTypes(ctx, s.path, moduleName, fields); err != nil {
 		return sm, err
@@ -126,7 +159,9 @@ func (s Scaffolder) AddMessage(
 			ResFields:    parsedResFields,
 			MsgDesc:      scaffoldingOpts.description,
 			MsgSigner:    mfSigner,
+			SignerOption: s.msgSignerOption(),
 			NoSimulation: scaffoldingOpts.withoutSimulation,
+			Permission:   scaffoldingOpts.permission,
 		}
 	)
 
@@ -157,6 +192,24 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	gens, err = supportEnums(gens, opts.AppPath, opts.ModulePath, opts.ModuleName, opts.Fields, opts.ResFields)
+	if err != nil {
+		return sm, err
+	}
+
+	gens, err = supportAddressCodec(gens, opts.AppPath)
+	if err != nil {
+		return sm, err
+	}
+
+	// Scaffold the access control of the module the first time a message is restricted
+	if opts.Permission != "" {
+		gens, err = supportAccessControl(gens, tracer, opts)
+		if err != nil {
+			return sm, err
+		}
+	}
+
 	// Scaffold
 	g, err = message.NewStargate(tracer, opts)
 	if err != nil {
@@ -183,3 +236,47 @@ func checkForbiddenMessageField(name string) error {
 
 	return checkGoReservedWord(name)
 }
+
+// supportAccessControl adds the generator scaffolding the access control of the module
+// with its admin transfer message if the module doesn't define it yet
+func supportAccessControl(
+	gens []*genny.Generator,
+	replacer placeholder.Replacer,
+	opts *message.Options,
+) ([]*genny.Generator, error) {
+	accessPath := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types", accessFile)
+	if _, err := os.Stat(accessPath); err == nil {
+		return gens, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	msgName, err := multiformatname.NewName(adminTransferMessage)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkComponentValidity(opts.AppPath, opts.ModuleName, msgName, false); err != nil {
+		return nil, err
+	}
+	fields, err := field.ParseFields([]string{adminTransferField}, checkForbiddenMessageField, opts.MsgSigner.LowerCamel)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := message.NewStargateAccess(replacer, &message.Options{
+		AppName:      opts.AppName,
+		AppPath:      opts.AppPath,
+		ModulePath:   opts.ModulePath,
+		ModuleName:   opts.ModuleName,
+		MsgName:      msgName,
+		MsgSigner:    opts.MsgSigner,
+		SignerOption: opts.SignerOption,
+		MsgDesc:      "Transfer the admin role of the module",
+		Fields:       fields,
+		NoSimulation: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(gens, g), nil
+}
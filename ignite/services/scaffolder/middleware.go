@@ -0,0 +1,67 @@
+package scaffolder
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/ibc"
+)
+
+// CreateMiddleware scaffolds an ICS-30 IBC middleware in x/ wrapping the IBC route of the module
+// wrap, the transfer module or a module scaffolded with --ibc. The middleware has a keeper
+// sending the packets of the wrapped module through the IBC channel keeper.
+func (s Scaffolder) CreateMiddleware(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	middlewareName,
+	wrap string,
+) (sm xgenny.SourceModification, err error) {
+	mfName, err := multiformatname.NewName(middlewareName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	middlewareName = mfName.LowerCase
+
+	// the middleware is a package of x/, its name can't conflict with the modules
+	ok, err := moduleExists(s.path, middlewareName)
+	if err != nil {
+		return sm, err
+	}
+	if ok {
+		return sm, fmt.Errorf("the module %v already exists", middlewareName)
+	}
+	if err := checkModuleName(s.path, middlewareName); err != nil {
+		return sm, err
+	}
+
+	if wrap != ibc.MiddlewareWrapTransfer {
+		ok, err := isIBCModule(s.path, wrap)
+		if err != nil {
+			return sm, err
+		}
+		if !ok {
+			return sm, fmt.Errorf("the module %s doesn't implement IBC module interface", wrap)
+		}
+	}
+
+	g, err := ibc.NewMiddleware(tracer, &ibc.MiddlewareOptions{
+		AppName:        s.modpath.Package,
+		AppPath:        s.path,
+		ModulePath:     s.modpath.RawPath,
+		MiddlewareName: middlewareName,
+		Wrap:           wrap,
+	})
+	if err != nil {
+		return sm, err
+	}
+
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
@@ -8,6 +8,7 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/gobuffalo/genny"
@@ -29,14 +30,18 @@ import (
 )
 
 const (
-	wasmImport    = "github.com/CosmWasm/wasmd"
-	wasmVersion   = "v0.16.0"
-	extrasImport  = "github.com/tendermint/spm-extras"
-	extrasVersion = "v0.1.0"
-	appPkg        = "app"
-	moduleDir     = "x"
+	wasmImport  = "github.com/CosmWasm/wasmd"
+	wasmVersion = "v0.27.0"
+	appPkg      = "app"
+	moduleDir   = "x"
 )
 
+// featureRegexp matches the build tags accepted for feature modules
+var featureRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// ibcVersionRegexp matches the versions of the IBC channel accepted for IBC modules
+var ibcVersionRegexp = regexp.MustCompile(`^[^\s"\\]+$`)
+
 var (
 	// reservedNames are either names from the default modules defined in a Cosmos-SDK app or names used in the default query and tx CLI namespace
 	// A new module's name can't be equal to a reserved name
@@ -102,8 +107,20 @@ type moduleCreationOptions struct {
 	// ibcChannelOrdering ibc channel ordering
 	ibcChannelOrdering string
 
+	// ibcChannelVersions versions of the ibc channel supported by the module, by order of preference
+	ibcChannelVersions []string
+
+	// ibcChannelUpgradable true if the module implements the ibc channel upgradability callbacks
+	ibcChannelUpgradable bool
+
 	// dependencies list of module dependencies
 	dependencies []modulecreate.Dependency
+
+	// feature build tag required to include the module in the app
+	feature string
+
+	// moduleAccountPerms permissions of the module account, the default ones are used when nil
+	moduleAccountPerms []string
 }
 
 // ModuleCreationOption configures Chain.
@@ -137,6 +154,21 @@ func WithIBCChannelOrdering(ordering string) ModuleCreationOption {
 	}
 }
 
+// WithIBCChannelVersions configures the versions of the channel negotiated by the IBC module, by order
+// of preference. The first one is proposed when the module opens a channel, <module>-1 is used when empty
+func WithIBCChannelVersions(versions []string) ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.ibcChannelVersions = versions
+	}
+}
+
+// WithIBCChannelUpgrades scaffolds the channel upgradability callbacks of the IBC module
+func WithIBCChannelUpgrades() ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.ibcChannelUpgradable = true
+	}
+}
+
 // WithDependencies specifies the name of the modules that the module depends on
 func WithDependencies(dependencies []modulecreate.Dependency) ModuleCreationOption {
 	return func(m *moduleCreationOptions) {
@@ -144,12 +176,40 @@ func WithDependencies(dependencies []modulecreate.Dependency) ModuleCreationOpti
 	}
 }
 
+// WithFeature scaffolds a module only included in the app when built with the feature build tag
+func WithFeature(feature string) ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.feature = feature
+	}
+}
+
+// withModuleAccountPerms sets the permissions of the module account of a module depending on bank,
+// the module account has no permissions when none are provided
+func withModuleAccountPerms(perms ...string) ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.moduleAccountPerms = append([]string{}, perms...)
+	}
+}
+
 // CreateModule creates a new empty module in the scaffolded app
 func (s Scaffolder) CreateModule(
 	cacheStorage cache.Storage,
 	tracer *placeholder.Tracer,
 	moduleName string,
 	options ...ModuleCreationOption,
+) (sm xgenny.SourceModification, err error) {
+	sm, err = s.createModule(tracer, moduleName, options...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// createModule generates a new empty module in the scaffolded app without building its sources
+func (s Scaffolder) createModule(
+	tracer *placeholder.Tracer,
+	moduleName string,
+	options ...ModuleCreationOption,
 ) (sm xgenny.SourceModification, err error) {
 	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
 	if err != nil {
@@ -188,6 +248,22 @@ func (s Scaffolder) CreateModule(
 		return sm, err
 	}
 
+	// Check the feature build tag
+	if creationOpts.feature != "" {
+		if creationOpts.ibc {
+			return sm, errors.New("IBC modules can't be included with a build tag")
+		}
+		if !featureRegexp.MatchString(creationOpts.feature) {
+			return sm, fmt.Errorf("%s is not a valid build tag", creationOpts.feature)
+		}
+	}
+
+	// Check the versions of the IBC channel
+	ibcVersions, err := checkIBCVersions(moduleName, creationOpts.ibcChannelVersions)
+	if err != nil {
+		return sm, err
+	}
+
 	opts := &modulecreate.CreateOptions{
 		ModuleName:   moduleName,
 		ModulePath:   s.modpath.RawPath,
@@ -197,6 +273,12 @@ func (s Scaffolder) CreateModule(
 		IsIBC:        creationOpts.ibc,
 		IBCOrdering:  creationOpts.ibcChannelOrdering,
 		Dependencies: creationOpts.dependencies,
+		Feature:      creationOpts.feature,
+
+		IBCVersions:   ibcVersions,
+		IBCUpgradable: creationOpts.ibcChannelUpgradable,
+
+		ModuleAccountPerms: creationOpts.moduleAccountPerms,
 	}
 
 	// Generator from Cosmos SDK version
@@ -214,6 +296,10 @@ func (s Scaffolder) CreateModule(
 		}
 		gens = append(gens, g)
 	}
+	gens, err = supportAddressCodec(gens, opts.AppPath)
+	if err != nil {
+		return sm, err
+	}
 	sm, err = xgenny.RunWithValidation(tracer, gens...)
 	if err != nil {
 		return sm, err
@@ -227,7 +313,7 @@ func (s Scaffolder) CreateModule(
 		return sm, runErr
 	}
 
-	return sm, finish(cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, nil
 }
 
 // ImportModule imports specified module with name to the scaffolded app.
@@ -248,6 +334,7 @@ func (s Scaffolder) ImportModule(cacheStorage cache.Storage, tracer *placeholder
 	// run generator
 	g, err := moduleimport.NewStargate(tracer, &moduleimport.ImportOptions{
 		AppPath:          s.path,
+		ModulePath:       s.modpath.RawPath,
 		Feature:          name,
 		AppName:          s.modpath.Package,
 		BinaryNamePrefix: s.modpath.Root,
@@ -258,11 +345,6 @@ func (s Scaffolder) ImportModule(cacheStorage cache.Storage, tracer *placeholder
 
 	sm, err = xgenny.RunWithValidation(tracer, g)
 	if err != nil {
-		var validationErr validation.Error
-		if errors.As(err, &validationErr) {
-			// TODO: implement a more generic method when there will be new methods to import wasm
-			return sm, errors.New("wasm cannot be imported. Apps initialized with Starport <=0.16.2 must downgrade Starport to 0.16.2 to import wasm")
-		}
 		return sm, err
 	}
 
@@ -272,6 +354,14 @@ func (s Scaffolder) ImportModule(cacheStorage cache.Storage, tracer *placeholder
 		return sm, err
 	}
 
+	confPath, err := enableWasmGenesis(s.path)
+	if err != nil {
+		return sm, err
+	}
+	if confPath != "" {
+		sm.AppendModifiedFiles(confPath)
+	}
+
 	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
 }
 
@@ -364,7 +454,6 @@ func (s Scaffolder) installWasm() error {
 			New().
 			Run(context.Background(),
 				step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(wasmImport, wasmVersion))),
-				step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(extrasImport, extrasVersion))),
 			)
 	default:
 		return errors.New("version not supported")
@@ -395,3 +484,23 @@ func checkDependencies(dependencies []modulecreate.Dependency, appPath string) e
 
 	return nil
 }
+
+// checkIBCVersions checks the versions of the IBC channel of the module, <module>-1 is returned when
+// no version is set
+func checkIBCVersions(moduleName string, versions []string) ([]string, error) {
+	if len(versions) == 0 {
+		return []string{moduleName + "-1"}, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, version := range versions {
+		if !ibcVersionRegexp.MatchString(version) {
+			return nil, fmt.Errorf("%q is not a valid IBC channel version", version)
+		}
+		if seen[version] {
+			return nil, fmt.Errorf("the IBC channel version %s is set twice", version)
+		}
+		seen[version] = true
+	}
+	return versions, nil
+}
@@ -0,0 +1,25 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckIBCVersions(t *testing.T) {
+	versions, err := checkIBCVersions("foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo-1"}, versions)
+
+	versions, err = checkIBCVersions("foo", []string{"foo-2", "foo-1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo-2", "foo-1"}, versions)
+
+	_, err = checkIBCVersions("foo", []string{"foo-1", "foo-1"})
+	require.EqualError(t, err, "the IBC channel version foo-1 is set twice")
+
+	for _, version := range []string{"", "foo 1", `foo"1`, `foo\1`} {
+		_, err = checkIBCVersions("foo", []string{version})
+		require.Error(t, err, version)
+	}
+}
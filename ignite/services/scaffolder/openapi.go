@@ -0,0 +1,122 @@
+package scaffolder
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/app"
+)
+
+// vuexStorePath is the path of the Vuex stores in the Vue.js app.
+const vuexStorePath = "src/store"
+
+// AddOpenAPI embeds the OpenAPI docs in an app scaffolded with --minimal, serves them with the API
+// of the app and enables their generation in the config.
+func (s Scaffolder) AddOpenAPI(cacheStorage cache.Storage, tracer *placeholder.Tracer) (sm xgenny.SourceModification, err error) {
+	if _, err := os.Stat(filepath.Join(s.path, "docs", "docs.go")); err == nil {
+		return sm, errors.New("the OpenAPI docs are already embedded in the app")
+	}
+
+	opts := &app.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+	}
+	g, err := app.NewOpenAPI(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	if sm, err = xgenny.RunWithValidation(tracer, g); err != nil {
+		return sm, err
+	}
+
+	confPath, err := enableClientConfig(s.path, "openapi", app.OpenAPIPath, func(conf chainconfig.Config) string {
+		return conf.Client.OpenAPI.Path
+	})
+	if err != nil {
+		return sm, err
+	}
+	if confPath != "" {
+		sm.AppendModifiedFiles(confPath)
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// EnableVuex enables the generation of the Vuex stores of the Vue.js app at vuePath in the config
+// of the app at appPath, when the app has a config without Vuex stores.
+func EnableVuex(appPath, vuePath string) error {
+	appPath, err := filepath.Abs(appPath)
+	if err != nil {
+		return err
+	}
+	vuePath, err = filepath.Abs(vuePath)
+	if err != nil {
+		return err
+	}
+	storePath, err := filepath.Rel(appPath, filepath.Join(vuePath, vuexStorePath))
+	if err != nil {
+		return err
+	}
+
+	_, err = enableClientConfig(appPath, "vuex", filepath.ToSlash(storePath), func(conf chainconfig.Config) string {
+		return conf.Client.Vuex.Path
+	})
+	if errors.Is(err, chainconfig.ErrCouldntLocateConfig) {
+		return nil
+	}
+	return err
+}
+
+// enableClientConfig sets the path of the client in the config of the app when path returns no
+// path for the config. It returns the path of the config when it's modified.
+// The config is edited as text to keep its comments and its layout.
+func enableClientConfig(appPath, client, clientPath string, path func(chainconfig.Config) string) (string, error) {
+	confPath, err := chainconfig.LocateDefault(appPath)
+	if err != nil {
+		return "", err
+	}
+	conf, err := chainconfig.ParseFile(confPath)
+	if err != nil {
+		return "", err
+	}
+	if path(conf) != "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(confPath)
+	if err != nil {
+		return "", err
+	}
+
+	entry := fmt.Sprintf("  %s:\n    path: %q", client, clientPath)
+	lines := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	clientLine := -1
+	for i, line := range lines {
+		if strings.TrimRight(line, " ") == "client:" {
+			clientLine = i
+			break
+		}
+	}
+	if clientLine == -1 {
+		lines = append(lines, "client:", entry)
+	} else {
+		lines = append(lines[:clientLine+1], append([]string{entry}, lines[clientLine+1:]...)...)
+	}
+	updated := strings.Join(lines, "\n") + "\n"
+
+	// ensure the edited config is valid and sets the path
+	conf, err = chainconfig.Parse(strings.NewReader(updated))
+	if err != nil || path(conf) != clientPath {
+		return "", fmt.Errorf("cannot set client.%s.path in %s, set it to %q", client, confPath, clientPath)
+	}
+
+	return confPath, os.WriteFile(confPath, []byte(updated), 0644)
+}
@@ -0,0 +1,79 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestEnableClientConfig(t *testing.T) {
+	const accounts = `accounts:
+  - name: alice
+    coins: ["100stake"]
+validator:
+  name: alice
+  staked: "100stake"
+`
+	openAPIPath := func(conf chainconfig.Config) string { return conf.Client.OpenAPI.Path }
+
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+		modified bool
+	}{
+		{
+			name:   "without client",
+			config: accounts,
+			expected: accounts + `client:
+  openapi:
+    path: "docs/static/openapi.yml"
+`,
+			modified: true,
+		},
+		{
+			name: "with client",
+			config: accounts + `client:
+  vuex:
+    path: "vue/src/store"
+`,
+			expected: accounts + `client:
+  openapi:
+    path: "docs/static/openapi.yml"
+  vuex:
+    path: "vue/src/store"
+`,
+			modified: true,
+		},
+		{
+			name: "already enabled",
+			config: accounts + `client:
+  openapi:
+    path: "docs/openapi.yml"
+`,
+			expected: accounts + `client:
+  openapi:
+    path: "docs/openapi.yml"
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			confPath := filepath.Join(dir, "config.yml")
+			require.NoError(t, os.WriteFile(confPath, []byte(tt.config), 0644))
+
+			modified, err := enableClientConfig(dir, "openapi", "docs/static/openapi.yml", openAPIPath)
+			require.NoError(t, err)
+			require.Equal(t, tt.modified, modified != "")
+
+			content, err := os.ReadFile(confPath)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, string(content))
+		})
+	}
+}
@@ -92,19 +92,24 @@ func (s *Scaffolder) AddOracle(
 	var (
 		g    *genny.Generator
 		opts = &ibc.OracleOptions{
-			AppName:    s.modpath.Package,
-			AppPath:    s.path,
-			ModulePath: s.modpath.RawPath,
-			ModuleName: moduleName,
-			QueryName:  name,
-			MsgSigner:  mfSigner,
+			AppName:      s.modpath.Package,
+			AppPath:      s.path,
+			ModulePath:   s.modpath.RawPath,
+			ModuleName:   moduleName,
+			QueryName:    name,
+			MsgSigner:    mfSigner,
+			SignerOption: s.msgSignerOption(),
 		}
 	)
+	gens, err := supportAddressCodec(nil, opts.AppPath)
+	if err != nil {
+		return sm, err
+	}
 	g, err = ibc.NewOracle(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = xgenny.RunWithValidation(tracer, append(gens, g)...)
 	if err != nil {
 		return sm, err
 	}
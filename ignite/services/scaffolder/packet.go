@@ -2,9 +2,11 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 
 	"github.com/gobuffalo/genny"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
 	"github.com/ignite/cli/ignite/templates/field"
 	"github.com/ignite/cli/ignite/templates/field/datatype"
 	"github.com/ignite/cli/ignite/templates/ibc"
@@ -25,6 +28,8 @@ const (
 type packetOptions struct {
 	withoutMessage bool
 	signer         string
+	call           bool
+	refund         bool
 }
 
 // newPacketOptions returns a packetOptions with default options
@@ -51,6 +56,23 @@ func PacketWithSigner(signer string) PacketOption {
 	}
 }
 
+// PacketAsCall scaffolds the packet as a call between the modules of two chains, the call is kept
+// pending until its response is acknowledged and compensated when it fails or times out.
+func PacketAsCall() PacketOption {
+	return func(o *packetOptions) {
+		o.call = true
+	}
+}
+
+// PacketWithRefund scaffolds the packet as a call escrowing the coins of its coin fields when it's sent,
+// they're refunded to the signer of the send message when the call fails or times out.
+func PacketWithRefund() PacketOption {
+	return func(o *packetOptions) {
+		o.call = true
+		o.refund = true
+	}
+}
+
 // AddPacket adds a new type stype to scaffolded app by using optional type fields.
 func (s Scaffolder) AddPacket(
 	ctx context.Context,
@@ -97,6 +119,20 @@ func (s Scaffolder) AddPacket(
 		return sm, fmt.Errorf("the module %s doesn't implement IBC module interface", moduleName)
 	}
 
+	// The coins are escrowed from the signer of the send message by the bank keeper of the module
+	if o.refund {
+		if o.withoutMessage {
+			return sm, errors.New("the coins of a packet refunded on failure are escrowed by its send message")
+		}
+		ok, err := hasModuleDependency(s.path, moduleName, "bank")
+		if err != nil {
+			return sm, err
+		}
+		if !ok {
+			return sm, fmt.Errorf("the module %s must depend on bank to refund the packet coins, scaffold it with --dep bank", moduleName)
+		}
+	}
+
 	signer := ""
 	if !o.withoutMessage {
 		signer = o.signer
@@ -124,22 +160,36 @@ func (s Scaffolder) AddPacket(
 	var (
 		g    *genny.Generator
 		opts = &ibc.PacketOptions{
-			AppName:    s.modpath.Package,
-			AppPath:    s.path,
-			ModulePath: s.modpath.RawPath,
-			ModuleName: moduleName,
-			PacketName: name,
-			Fields:     parsedPacketFields,
-			AckFields:  parsedAcksFields,
-			NoMessage:  o.withoutMessage,
-			MsgSigner:  mfSigner,
+			AppName:      s.modpath.Package,
+			AppPath:      s.path,
+			ModulePath:   s.modpath.RawPath,
+			ModuleName:   moduleName,
+			PacketName:   name,
+			Fields:       parsedPacketFields,
+			AckFields:    parsedAcksFields,
+			NoMessage:    o.withoutMessage,
+			Call:         o.call,
+			Refund:       o.refund,
+			MsgSigner:    mfSigner,
+			SignerOption: s.msgSignerOption(),
 		}
 	)
+	if opts.Refund && len(opts.RefundFields()) == 0 {
+		return sm, errors.New("a packet refunded on failure must have a coin or coins field")
+	}
+	gens, err := supportEnums(nil, opts.AppPath, opts.ModulePath, opts.ModuleName, opts.Fields, opts.AckFields)
+	if err != nil {
+		return sm, err
+	}
+	gens, err = supportAddressCodec(gens, opts.AppPath)
+	if err != nil {
+		return sm, err
+	}
 	g, err = ibc.NewPacket(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = xgenny.RunWithValidation(tracer, append(gens, g)...)
 	if err != nil {
 		return sm, err
 	}
@@ -163,6 +213,19 @@ func isIBCModule(appPath string, moduleName string) (bool, error) {
 	return true, err
 }
 
+// hasModuleDependency returns true if the keeper of the module depends on the keeper of the dependency,
+// we naively check the keeper field of the dependency in keeper.go
+func hasModuleDependency(appPath, moduleName, dependency string) (bool, error) {
+	path := filepath.Join(appPath, moduleDir, moduleName, "keeper", "keeper.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	field := regexp.MustCompile(fmt.Sprintf(`\b%sKeeper\s+types\.%sKeeper\b`, dependency, xstrings.Title(dependency)))
+	return field.Match(content), nil
+}
+
 // checkForbiddenPacketField returns true if the name is forbidden as a packet name
 func checkForbiddenPacketField(name string) error {
 	mfName, err := multiformatname.NewName(name)
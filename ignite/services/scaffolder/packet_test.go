@@ -0,0 +1,34 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasModuleDependency(t *testing.T) {
+	appPath := t.TempDir()
+	keeperDir := filepath.Join(appPath, moduleDir, "dex", "keeper")
+	require.NoError(t, os.MkdirAll(keeperDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(keeperDir, "keeper.go"), []byte(`package keeper
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	bankKeeper types.BankKeeper
+}
+`), 0644))
+
+	ok, err := hasModuleDependency(appPath, "dex", "bank")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = hasModuleDependency(appPath, "dex", "account")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = hasModuleDependency(appPath, "loan", "bank")
+	require.Error(t, err)
+}
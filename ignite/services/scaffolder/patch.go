@@ -1,12 +1,18 @@
 package scaffolder
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/addresscodec"
+	"github.com/ignite/cli/ignite/templates/enum"
+	"github.com/ignite/cli/ignite/templates/field"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
 
@@ -30,6 +36,22 @@ func supportSimulation(
 	return gens, nil
 }
 
+// supportAddressCodec appends the generator creating the package encoding the account addresses
+// with the codec of the config, the package is kept when it already exists
+func supportAddressCodec(gens []*genny.Generator, appPath string) ([]*genny.Generator, error) {
+	conf := chainconfig.DefaultConf
+	if path, err := chainconfig.LocateDefault(appPath); err == nil {
+		if conf, err = chainconfig.ParseFile(path); err != nil {
+			return gens, err
+		}
+	}
+	g, err := addresscodec.NewGenerator(appPath, conf.AddressCodec)
+	if err != nil {
+		return gens, err
+	}
+	return append(gens, g), nil
+}
+
 // supportGenesisTests checks if types/genesis_test.go exists
 // appends the generator to create the file if it doesn't
 func supportGenesisTests(
@@ -95,3 +117,79 @@ func isMsgServerDefined(appPath, moduleName string) (bool, error) {
 	}
 	return true, err
 }
+
+// supportEnums appends the generators scaffolding the proto enums of the enum fields,
+// an enum already scaffolded in the module is reused when it has the same values
+func supportEnums(
+	gens []*genny.Generator,
+	appPath,
+	modulePath,
+	moduleName string,
+	fields ...field.Fields,
+) ([]*genny.Generator, error) {
+	scaffolded := make(map[string][]string)
+	for _, fs := range fields {
+		for _, f := range fs.Enums() {
+			opts := &enum.Options{
+				AppPath:    appPath,
+				ModuleName: moduleName,
+				ModulePath: modulePath,
+				Field:      f,
+			}
+			name := opts.EnumName()
+			values := opts.Values()
+
+			// the same enum can be used by several fields of the component
+			if existing, ok := scaffolded[name.Snake]; ok {
+				if strings.Join(existing, ",") != strings.Join(values, ",") {
+					return gens, fmt.Errorf("the enum %s is used with different values", name.UpperCamel)
+				}
+				continue
+			}
+			scaffolded[name.Snake] = values
+
+			content, err := os.ReadFile(filepath.Join(appPath, protoFolder, moduleName, name.Snake+".proto"))
+			if err == nil {
+				if !hasEnumValues(string(content), name.UpperCamel, values) {
+					return gens, fmt.Errorf("the enum %s already exists in the module with different values", name.UpperCamel)
+				}
+				continue
+			}
+			if !os.IsNotExist(err) {
+				return gens, err
+			}
+
+			g, err := enum.NewStargate(opts)
+			if err != nil {
+				return gens, err
+			}
+			gens = append(gens, g)
+		}
+	}
+	return gens, nil
+}
+
+// hasEnumValues returns true if the proto file defines the enum with the values
+func hasEnumValues(proto, enumName string, values []string) bool {
+	_, block, ok := strings.Cut(proto, fmt.Sprintf("enum %s {", enumName))
+	if !ok {
+		return false
+	}
+	block, _, _ = strings.Cut(block, "}")
+
+	var defined []string
+	for _, line := range strings.Split(block, ";") {
+		if line = strings.TrimSpace(line); line != "" {
+			defined = append(defined, line)
+		}
+	}
+	if len(defined) != len(values) {
+		return false
+	}
+	for i, value := range values {
+		if defined[i] != fmt.Sprintf("%s = %d", value, i) {
+			return false
+		}
+	}
+	return true
+}
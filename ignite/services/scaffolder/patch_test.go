@@ -0,0 +1,55 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestSupportEnums(t *testing.T) {
+	appPath := t.TempDir()
+	parse := func(fields ...string) field.Fields {
+		parsed, err := field.ParseFields(fields, checkGoReservedWord)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	// the enums of the fields are scaffolded once.
+	gens, err := supportEnums(nil, appPath, "github.com/test/mars", "mars",
+		parse("title", "status:enum(Open,Closed)"),
+		parse("status:enum(Open,Closed)"),
+	)
+	require.NoError(t, err)
+	require.Len(t, gens, 1)
+
+	_, err = supportEnums(nil, appPath, "github.com/test/mars", "mars",
+		parse("status:enum(Open,Closed)"),
+		parse("status:enum(Open)"),
+	)
+	require.EqualError(t, err, "the enum Status is used with different values")
+
+	// an enum of the module is reused with the same values.
+	protoPath := filepath.Join(appPath, "proto", "mars")
+	require.NoError(t, os.MkdirAll(protoPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, "status.proto"), []byte(`syntax = "proto3";
+package test.mars.mars;
+
+option go_package = "github.com/test/mars/x/mars/types";
+
+enum Status {
+  STATUS_OPEN = 0;
+  STATUS_CLOSED = 1;
+}
+`), 0644))
+
+	gens, err = supportEnums(nil, appPath, "github.com/test/mars", "mars", parse("status:enum(Open,Closed)"))
+	require.NoError(t, err)
+	require.Empty(t, gens)
+
+	_, err = supportEnums(nil, appPath, "github.com/test/mars", "mars", parse("status:enum(Open,Closed,Archived)"))
+	require.EqualError(t, err, "the enum Status already exists in the module with different values")
+}
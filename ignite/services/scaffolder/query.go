@@ -3,6 +3,14 @@ package scaffolder
 import (
 	"context"
 	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 
@@ -11,9 +19,35 @@ import (
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
 	"github.com/ignite/cli/ignite/templates/query"
 )
 
+// queryOptions represents configuration for the query scaffolding
+type queryOptions struct {
+	storeType string
+	filters   []string
+}
+
+// QueryOption configures the query scaffolding
+type QueryOption func(*queryOptions)
+
+// QueryWithStore iterates the store of the list or map type typeName of the module in the
+// paginated query, the query returns the values of the store.
+func QueryWithStore(typeName string) QueryOption {
+	return func(o *queryOptions) {
+		o.storeType = typeName
+	}
+}
+
+// QueryWithFilters adds the filters to the request of the query, the filters are the leading
+// indexes of the map iterated by the query and only the values with their prefix are returned.
+func QueryWithFilters(filters ...string) QueryOption {
+	return func(o *queryOptions) {
+		o.filters = filters
+	}
+}
+
 // AddQuery adds a new query to scaffolded app
 func (s Scaffolder) AddQuery(
 	ctx context.Context,
@@ -25,7 +59,13 @@ func (s Scaffolder) AddQuery(
 	reqFields,
 	resFields []string,
 	paginated bool,
+	options ...QueryOption,
 ) (sm xgenny.SourceModification, err error) {
+	var o queryOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
 	// If no module is provided, we add the type to the app's module
 	if moduleName == "" {
 		moduleName = s.modpath.Package
@@ -49,10 +89,35 @@ func (s Scaffolder) AddQuery(
 	if ok := containCustomTypes(reqFields); ok {
 		return sm, errors.New("query request params can't contain custom type")
 	}
-	parsedReqFields, err := field.ParseFields(reqFields, checkGoReservedWord)
+	if len(o.filters) != 0 && o.storeType == "" {
+		return sm, errors.New("the filters of a query need the map iterated by the query")
+	}
+	if ok := containCustomTypes(o.filters); ok {
+		return sm, errors.New("query filters can't contain custom type")
+	}
+	// the filters are the last fields of the request
+	parsedReqFields, err := field.ParseFields(append(reqFields, o.filters...), checkGoReservedWord)
 	if err != nil {
 		return sm, err
 	}
+	if len(parsedReqFields.Enums()) > 0 {
+		return sm, errors.New("query request params can't contain enum")
+	}
+	filters := parsedReqFields[len(reqFields):]
+
+	var (
+		storeType multiformatname.Name
+		storeKey  string
+	)
+	if o.storeType != "" {
+		if storeType, err = multiformatname.NewName(o.storeType); err != nil {
+			return sm, err
+		}
+		if storeKey, err = queryStoreKey(s.path, moduleName, storeType, filters); err != nil {
+			return sm, err
+		}
+		paginated = true
+	}
 
 	// Check and parse provided response fields
 	if err := checkCustomTypes(ctx, s.path, moduleName, resFields); err != nil {
@@ -75,17 +140,104 @@ func (s Scaffolder) AddQuery(
 			ResFields:   parsedResFields,
 			Description: description,
 			Paginated:   paginated,
+			StoreType:   storeType,
+			StoreKey:    storeKey,
+			Filters:     filters,
 		}
 	)
 
+	gens, err := supportEnums(nil, opts.AppPath, opts.ModulePath, opts.ModuleName, opts.ResFields)
+	if err != nil {
+		return sm, err
+	}
+
 	// Scaffold
 	g, err = query.NewStargate(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = xgenny.RunWithValidation(tracer, append(gens, g)...)
 	if err != nil {
 		return sm, err
 	}
 	return sm, finish(cacheStorage, opts.AppPath, s.modpath.RawPath)
 }
+
+// queryStoreKey returns the constant holding the prefix of the store of the list or map type
+// typeName of the module. The filters must be the leading indexes of the map.
+func queryStoreKey(appPath, moduleName string, typeName multiformatname.Name, filters field.Fields) (string, error) {
+	typesPath := filepath.Join(appPath, moduleDir, moduleName, "types")
+
+	// the keys of a map are built by a function in the key file of the type
+	keyPath := filepath.Join(typesPath, fmt.Sprintf("key_%s.go", typeName.Snake))
+	if _, err := os.Stat(keyPath); err == nil {
+		indexes, err := mapIndexes(keyPath, typeName)
+		if err != nil {
+			return "", err
+		}
+		for i, filter := range filters {
+			index := fmt.Sprintf("%s%s%s", filter.Name.LowerCamel, datatype.Separator, filter.DatatypeName)
+			if i >= len(indexes) || indexes[i] != index {
+				return "", fmt.Errorf(
+					"the filter %s is not the index %d of the map %s, filter by its leading indexes: %s",
+					filter.Name.Original,
+					i+1,
+					typeName.Original,
+					strings.Join(indexes, ", "),
+				)
+			}
+		}
+		return fmt.Sprintf("%sKeyPrefix", typeName.UpperCamel), nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	// the keys of a list are declared in the keys file of the module
+	keys, err := os.ReadFile(filepath.Join(typesPath, "keys.go"))
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(string(keys), fmt.Sprintf("%sCountKey", typeName.UpperCamel)) {
+		return "", fmt.Errorf("the type %s is not a list or a map of the module %s", typeName.Original, moduleName)
+	}
+	if len(filters) != 0 {
+		return "", fmt.Errorf("the list %s can't be filtered, only the maps are filtered by their indexes", typeName.Original)
+	}
+	return fmt.Sprintf("%sKey", typeName.UpperCamel), nil
+}
+
+// mapIndexes returns the indexes of a map, name:type, from the parameters of the function
+// building its keys. The Go types of the parameters are converted to the types of the fields.
+func mapIndexes(keyPath string, typeName multiformatname.Name) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), keyPath, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	funcName := fmt.Sprintf("%sKey", typeName.UpperCamel)
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != funcName {
+			continue
+		}
+		var indexes []string
+		for _, param := range fn.Type.Params.List {
+			for _, name := range param.Names {
+				indexes = append(indexes, fmt.Sprintf("%s%s%s", name.Name, datatype.Separator, indexType(param.Type)))
+			}
+		}
+		return indexes, nil
+	}
+	return nil, fmt.Errorf("the keys of the map %s are not built by %s in %s", typeName.Original, funcName, keyPath)
+}
+
+// indexType returns the type of an index field from its Go type.
+func indexType(expr ast.Expr) string {
+	goType := types.ExprString(expr)
+	for _, name := range []datatype.Name{datatype.String, datatype.Bool, datatype.Int, datatype.Uint} {
+		if datatype.SupportedTypes[name].DataType("") == goType {
+			return string(name)
+		}
+	}
+	return goType
+}
@@ -0,0 +1,100 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestQueryStoreKey(t *testing.T) {
+	appPath := t.TempDir()
+	typesPath := filepath.Join(appPath, "x", "blog", "types")
+	require.NoError(t, os.MkdirAll(typesPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(typesPath, "keys.go"), []byte(`package types
+
+const (
+	PostKey      = "Post-value-"
+	PostCountKey = "Post-count-"
+)
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(typesPath, "key_vote_entry.go"), []byte(`package types
+
+const VoteEntryKeyPrefix = "VoteEntry/value/"
+
+func VoteEntryKey(
+	author string,
+	postID uint64,
+) []byte {
+	return nil
+}
+`), 0644))
+
+	tests := []struct {
+		name     string
+		typeName string
+		filters  []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "list",
+			typeName: "post",
+			expected: "PostKey",
+		},
+		{
+			name:     "map",
+			typeName: "vote-entry",
+			expected: "VoteEntryKeyPrefix",
+		},
+		{
+			name:     "map filtered by leading indexes",
+			typeName: "VoteEntry",
+			filters:  []string{"author", "postID:uint"},
+			expected: "VoteEntryKeyPrefix",
+		},
+		{
+			name:     "map filtered by other indexes",
+			typeName: "vote-entry",
+			filters:  []string{"postID:uint"},
+			err:      "the filter postID is not the index 1 of the map vote-entry, filter by its leading indexes: author:string, postID:uint",
+		},
+		{
+			name:     "map filtered by an index of another type",
+			typeName: "vote-entry",
+			filters:  []string{"author:int"},
+			err:      "the filter author is not the index 1 of the map vote-entry, filter by its leading indexes: author:string, postID:uint",
+		},
+		{
+			name:     "filtered list",
+			typeName: "post",
+			filters:  []string{"title"},
+			err:      "the list post can't be filtered, only the maps are filtered by their indexes",
+		},
+		{
+			name:     "unknown type",
+			typeName: "comment",
+			err:      "the type comment is not a list or a map of the module blog",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typeName, err := multiformatname.NewName(tt.typeName)
+			require.NoError(t, err)
+			filters, err := field.ParseFields(tt.filters, checkGoReservedWord)
+			require.NoError(t, err)
+
+			storeKey, err := queryStoreKey(appPath, "blog", typeName, filters)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, storeKey)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/ratelimit"
+)
+
+// rateLimitFile is the file of the keeper defining the rate limit support of a module
+const rateLimitFile = "rate_limit.go"
+
+// AddRateLimit rate limits a message of a module: an address can send up to limit messages per
+// window of blocks, e.g. 100blocks. The limit and the window are params of the module, the limit
+// is checked by the handler of the message.
+func (s Scaffolder) AddRateLimit(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	msgName string,
+	limit uint64,
+	per string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the rate limit to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(msgName)
+	if err != nil {
+		return sm, err
+	}
+
+	// the message must be scaffolded with its own handler file
+	keeperPath := filepath.Join(s.path, "x", moduleName, "keeper")
+	msgServerPath := filepath.Join(keeperPath, fmt.Sprintf("msg_server_%s.go", name.Snake))
+	if _, err := os.Stat(msgServerPath); os.IsNotExist(err) {
+		return sm, fmt.Errorf("the message %s doesn't exist in the module %s", name.Original, moduleName)
+	} else if err != nil {
+		return sm, err
+	}
+	rateLimitPath := filepath.Join(keeperPath, fmt.Sprintf("rate_limit_%s.go", name.Snake))
+	if _, err := os.Stat(rateLimitPath); err == nil {
+		return sm, fmt.Errorf("the message %s is already rate limited", name.Original)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	if limit == 0 {
+		return sm, fmt.Errorf("the rate limit of the message %s must be one message at least", name.Original)
+	}
+	window, err := parseRateWindow(per)
+	if err != nil {
+		return sm, err
+	}
+
+	gens, err := s.supportRateLimit(nil, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := ratelimit.NewStargate(tracer, &ratelimit.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		MsgName:    name,
+		Limit:      limit,
+		Window:     window,
+	})
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// supportRateLimit adds the generator scaffolding the rate limit support of the module if the
+// module doesn't define it yet
+func (s Scaffolder) supportRateLimit(gens []*genny.Generator, moduleName string) ([]*genny.Generator, error) {
+	supportPath := filepath.Join(s.path, "x", moduleName, "keeper", rateLimitFile)
+	if _, err := os.Stat(supportPath); err == nil {
+		return gens, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	g, err := ratelimit.NewStargateSupport(&ratelimit.SupportOptions{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(gens, g), nil
+}
+
+// parseRateWindow parses the window of a rate limit as a number of blocks, e.g. 100blocks
+func parseRateWindow(per string) (uint64, error) {
+	match := blocksIntervalRe.FindStringSubmatch(per)
+	if match == nil {
+		return 0, fmt.Errorf("invalid window %q, use a number of blocks, e.g. 100blocks", per)
+	}
+
+	window, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil || window == 0 {
+		return 0, fmt.Errorf("invalid window %q, the window must be one block at least", per)
+	}
+	return window, nil
+}
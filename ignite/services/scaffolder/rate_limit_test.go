@@ -0,0 +1,34 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateWindow(t *testing.T) {
+	tests := []struct {
+		per    string
+		window uint64
+		err    bool
+	}{
+		{per: "100blocks", window: 100},
+		{per: "1block", window: 1},
+		{per: "10 blocks", window: 10},
+		{per: "0blocks", err: true},
+		{per: "1h", err: true},
+		{per: "epoch", err: true},
+		{per: "", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.per, func(t *testing.T) {
+			window, err := parseRateWindow(tt.per)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.window, window)
+		})
+	}
+}
@@ -69,6 +69,12 @@ func App(path string) (Scaffolder, error) {
 	return s, nil
 }
 
+// msgSignerOption checks if the signers of the scaffolded messages are annotated with the
+// cosmos.msg.v1.signer option, the Cosmos SDK routes the Msg services with it since v0.46.
+func (s Scaffolder) msgSignerOption() bool {
+	return s.Version.GTE(cosmosver.StargateFortySixVersion)
+}
+
 func finish(cacheStorage cache.Storage, path, gomodPath string) error {
 	if err := protoc(cacheStorage, path, gomodPath); err != nil {
 		return err
@@ -98,6 +104,14 @@ func protoc(cacheStorage cache.Storage, projectPath, gomodPath string) error {
 		cosmosgen.IncludeDirs(conf.Build.Proto.ThirdPartyPaths),
 	}
 
+	// generate the pulsar code as well if the layout is used.
+	if conf.Build.Proto.Layout == chainconfig.ProtoLayoutPulsar {
+		if err := cosmosgen.InstallPulsarDependencies(context.Background(), projectPath); err != nil {
+			return err
+		}
+		options = append(options, cosmosgen.WithPulsarGeneration())
+	}
+
 	// generate Vuex code as well if it is enabled.
 	if conf.Client.Vuex.Path != "" {
 		storeRootPath := filepath.Join(projectPath, conf.Client.Vuex.Path, "generated")
@@ -110,6 +124,20 @@ func protoc(cacheStorage cache.Storage, projectPath, gomodPath string) error {
 			),
 		)
 	}
+	// generate the React hooks as well if they're enabled, with their JS clients unless the
+	// Vuex stores include them.
+	if conf.Client.React.Path != "" {
+		rootPath := filepath.Join(projectPath, conf.Client.React.Path, "generated")
+		if conf.Client.Vuex.Path == "" {
+			options = append(options,
+				cosmosgen.WithJSGeneration(false, cosmosgen.VuexStoreModulePath(filepath.Join(rootPath, "client"))),
+			)
+		}
+
+		options = append(options,
+			cosmosgen.WithReactGeneration(cosmosgen.ReactHooksModulePath(rootPath), rootPath),
+		)
+	}
 	if conf.Client.OpenAPI.Path != "" {
 		options = append(options, cosmosgen.WithOpenAPIGeneration(conf.Client.OpenAPI.Path))
 	}
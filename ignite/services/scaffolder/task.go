@@ -0,0 +1,232 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/message"
+	"github.com/ignite/cli/ignite/templates/task"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+// taskRegistryFile is the file of the keeper defining the registry of the scheduled tasks of a module
+const taskRegistryFile = "task.go"
+
+var blocksIntervalRe = regexp.MustCompile(`^(\d+)\s*blocks?$`)
+
+// AddTask adds a task executed in the begin blocker of the module at a regular interval, either
+// a number of blocks, e.g. 100blocks, or a duration, e.g. 1h.
+// The first task of a module scaffolds its task registry: the schedules of the tasks stored in the
+// module and the messages of the module admin pausing, resuming and rescheduling the tasks.
+func (s Scaffolder) AddTask(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	taskName,
+	every string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the task to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(taskName)
+	if err != nil {
+		return sm, err
+	}
+	if err := checkForbiddenComponentName(name); err != nil {
+		return sm, fmt.Errorf("%s can't be used as a task name: %s", name.LowerCamel, err.Error())
+	}
+	taskPath := filepath.Join(s.path, "x", moduleName, "keeper", fmt.Sprintf("task_%s.go", name.Snake))
+	if _, err := os.Stat(taskPath); err == nil {
+		return sm, fmt.Errorf("the task %s already exists in the module %s", name.Original, moduleName)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	everyBlocks, everySeconds, err := parseTaskInterval(every)
+	if err != nil {
+		return sm, err
+	}
+
+	gens, err := s.supportTaskRegistry(nil, tracer, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := task.NewStargate(tracer, &task.Options{
+		AppName:      s.modpath.Package,
+		AppPath:      s.path,
+		ModulePath:   s.modpath.RawPath,
+		ModuleName:   moduleName,
+		TaskName:     name,
+		EveryBlocks:  everyBlocks,
+		EverySeconds: everySeconds,
+	})
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// supportTaskRegistry adds the generators scaffolding the task registry of the module with its
+// schedule type and messages if the module doesn't define it yet
+func (s Scaffolder) supportTaskRegistry(
+	gens []*genny.Generator,
+	tracer *placeholder.Tracer,
+	moduleName string,
+) ([]*genny.Generator, error) {
+	registryPath := filepath.Join(s.path, "x", moduleName, "keeper", taskRegistryFile)
+	if _, err := os.Stat(registryPath); err == nil {
+		return gens, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	signer, err := multiformatname.NewName("creator")
+	if err != nil {
+		return nil, err
+	}
+
+	// schedules stored in a map indexed by the name of the tasks
+	typeName, err := multiformatname.NewName(task.TypeName)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkComponentValidity(s.path, moduleName, typeName, true); err != nil {
+		return nil, err
+	}
+	typeFields, err := field.ParseFields(task.TypeFields, checkForbiddenTypeField)
+	if err != nil {
+		return nil, err
+	}
+	g, err := mapGenerator(tracer, &typed.Options{
+		AppName:      s.modpath.Package,
+		AppPath:      s.path,
+		ModulePath:   s.modpath.RawPath,
+		ModuleName:   moduleName,
+		TypeName:     typeName,
+		MsgSigner:    signer,
+		Fields:       typeFields,
+		NoMessage:    true,
+		NoSimulation: true,
+	}, []string{task.TypeIndex})
+	if err != nil {
+		return nil, err
+	}
+	gens = append(gens, g)
+
+	// messages of the module admin managing the tasks
+	messages := []struct {
+		name   string
+		fields []string
+	}{
+		{task.MsgPause, task.MsgNameFields},
+		{task.MsgResume, task.MsgNameFields},
+		{task.MsgReschedule, task.MsgRescheduleFields},
+	}
+	for i, msg := range messages {
+		msgName, err := multiformatname.NewName(msg.name)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkComponentValidity(s.path, moduleName, msgName, false); err != nil {
+			return nil, err
+		}
+		fields, err := field.ParseFields(msg.fields, checkForbiddenMessageField, signer.LowerCamel)
+		if err != nil {
+			return nil, err
+		}
+		opts := &message.Options{
+			AppName:      s.modpath.Package,
+			AppPath:      s.path,
+			ModulePath:   s.modpath.RawPath,
+			ModuleName:   moduleName,
+			MsgName:      msgName,
+			MsgSigner:    signer,
+			SignerOption: s.msgSignerOption(),
+			MsgDesc:      newMessageOptions(msg.name).description,
+			Fields:       fields,
+			NoSimulation: true,
+			Permission:   message.PermissionAdmin,
+		}
+
+		// the access control is scaffolded with the first message if the module doesn't define it yet
+		if i == 0 {
+			if gens, err = supportAccessControl(gens, tracer, opts); err != nil {
+				return nil, err
+			}
+		}
+
+		g, err := message.NewStargate(tracer, opts)
+		if err != nil {
+			return nil, err
+		}
+		gens = append(gens, g)
+	}
+
+	// registry running the tasks and implementing the messages
+	g, err = task.NewStargateRegistry(tracer, &task.RegistryOptions{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(gens, g), nil
+}
+
+// parseTaskInterval parses the interval of a task, either a number of blocks, e.g. 100blocks,
+// or a duration in seconds at least, e.g. 1h or 30s
+func parseTaskInterval(every string) (blocks uint64, seconds uint64, err error) {
+	if match := blocksIntervalRe.FindStringSubmatch(every); match != nil {
+		blocks, err = strconv.ParseUint(match[1], 10, 64)
+		if err != nil || blocks == 0 {
+			return 0, 0, fmt.Errorf("invalid block interval %q, the task must run every one block at least", every)
+		}
+		return blocks, 0, nil
+	}
+
+	d, err := time.ParseDuration(every)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid interval %q, use a number of blocks, e.g. 100blocks, or a duration, e.g. 1h", every)
+	}
+	if d < time.Second || d%time.Second != 0 {
+		return 0, 0, fmt.Errorf("invalid interval %q, the duration must be a whole number of seconds", every)
+	}
+
+	return 0, uint64(d / time.Second), nil
+}
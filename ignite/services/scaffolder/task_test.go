@@ -0,0 +1,38 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaskInterval(t *testing.T) {
+	tests := []struct {
+		every   string
+		blocks  uint64
+		seconds uint64
+		err     bool
+	}{
+		{every: "100blocks", blocks: 100},
+		{every: "1block", blocks: 1},
+		{every: "1h", seconds: 3600},
+		{every: "1m30s", seconds: 90},
+		{every: "0blocks", err: true},
+		{every: "500ms", err: true},
+		{every: "1.5s", err: true},
+		{every: "daily", err: true},
+		{every: "", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.every, func(t *testing.T) {
+			blocks, seconds, err := parseTaskInterval(tt.every)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.blocks, blocks)
+			require.Equal(t, tt.seconds, seconds)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/goccy/go-yaml"
+)
+
+// TemplateManifestFile is the manifest of a project template declaring its variables, it's
+// located at the root of the template repository.
+const TemplateManifestFile = "ignite-template.yml"
+
+// templateVarRe matches the names of the variables usable in the plush files of a template.
+var templateVarRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// builtinTemplateVars are the variables set by Ignite in the templates.
+var builtinTemplateVars = map[string]bool{
+	"ModulePath":       true,
+	"AppName":          true,
+	"GitHubPath":       true,
+	"BinaryNamePrefix": true,
+	"AddressPrefix":    true,
+}
+
+// TemplateManifest is the manifest of a project template.
+type TemplateManifest struct {
+	// Name is the name of the template.
+	Name string `yaml:"name"`
+
+	// Description describes the chains created with the template.
+	Description string `yaml:"description"`
+
+	// Variables are the variables of the template.
+	Variables []TemplateVariable `yaml:"variables"`
+}
+
+// TemplateVariable is a variable of a project template, set in the plush files of the template.
+type TemplateVariable struct {
+	// Name is the name of the variable in the plush files, e.g. `<%= Denom %>`.
+	Name string `yaml:"name"`
+
+	// Description describes the variable.
+	Description string `yaml:"description"`
+
+	// Default is the value of the variable when it isn't set, a variable without a default
+	// value is required.
+	Default *string `yaml:"default"`
+}
+
+// projectTemplate is a project template fetched to create an app.
+type projectTemplate struct {
+	// dir is the directory of the template files.
+	dir string
+
+	// vars are the values of the variables of the template.
+	vars map[string]string
+}
+
+// InitWithTemplate creates the app from the project template of a git repository instead of
+// the built-in template, e.g. `github.com/org/template` or `github.com/org/template@v1.0.0` to
+// use a tag or a branch. vars are the values of the variables declared by the template manifest.
+func InitWithTemplate(template string, vars map[string]string) InitOption {
+	return func(o *initOptions) {
+		o.template = template
+		o.templateVars = vars
+	}
+}
+
+// fetchTemplate clones the repository of a project template in a temporary directory and returns
+// the directory of the template files and its manifest. The directory must be removed by the
+// caller.
+func fetchTemplate(ctx context.Context, template string) (dir string, manifest TemplateManifest, err error) {
+	url, ref := templateURL(template)
+
+	if dir, err = os.MkdirTemp("", "ignite-template"); err != nil {
+		return "", manifest, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	repo, err := git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{URL: url})
+	if err != nil {
+		return "", manifest, fmt.Errorf("cannot clone the template %s: %w", template, err)
+	}
+
+	if ref != "" {
+		h, err := repo.ResolveRevision(plumbing.Revision(ref))
+		if err != nil {
+			return "", manifest, fmt.Errorf("cannot find the version %s of the template %s: %w", ref, template, err)
+		}
+		wt, err := repo.Worktree()
+		if err != nil {
+			return "", manifest, err
+		}
+		if err := wt.Checkout(&git.CheckoutOptions{Hash: *h}); err != nil {
+			return "", manifest, err
+		}
+	}
+
+	if manifest, err = readTemplateManifest(dir); err != nil {
+		return "", manifest, err
+	}
+
+	// the git directory and the manifest aren't part of the template files.
+	for _, name := range []string{git.GitDirName, TemplateManifestFile} {
+		if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+			return "", manifest, err
+		}
+	}
+
+	return dir, manifest, nil
+}
+
+// templateURL returns the git URL and the version of a template, e.g. `github.com/org/template@v1`.
+func templateURL(template string) (url, ref string) {
+	url = template
+	if i := strings.LastIndex(template, "@"); i > strings.LastIndex(template, "/") {
+		url, ref = template[:i], template[i+1:]
+	}
+	if !strings.Contains(url, "://") && !strings.HasPrefix(url, "git@") {
+		url = "https://" + url
+	}
+	return url, ref
+}
+
+// readTemplateManifest reads the manifest of the template in dir.
+func readTemplateManifest(dir string) (TemplateManifest, error) {
+	var manifest TemplateManifest
+
+	data, err := os.ReadFile(filepath.Join(dir, TemplateManifestFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return manifest, fmt.Errorf("the template has no %s manifest", TemplateManifestFile)
+		}
+		return manifest, err
+	}
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return manifest, fmt.Errorf("invalid template manifest: %w", err)
+	}
+
+	for _, v := range manifest.Variables {
+		if !templateVarRe.MatchString(v.Name) {
+			return manifest, fmt.Errorf("invalid template variable name %q", v.Name)
+		}
+		if builtinTemplateVars[v.Name] {
+			return manifest, fmt.Errorf("the template variable %s is set by Ignite", v.Name)
+		}
+	}
+
+	return manifest, nil
+}
+
+// resolveTemplateVars returns the values of the variables of the template manifest, the values
+// of vars or their default value.
+func resolveTemplateVars(manifest TemplateManifest, vars map[string]string) (map[string]string, error) {
+	declared := make(map[string]bool)
+	values := make(map[string]string)
+	for _, v := range manifest.Variables {
+		declared[v.Name] = true
+
+		value, ok := vars[v.Name]
+		switch {
+		case ok:
+			values[v.Name] = value
+		case v.Default != nil:
+			values[v.Name] = *v.Default
+		default:
+			return nil, fmt.Errorf("the template variable %s is required: %s", v.Name, v.Description)
+		}
+	}
+
+	for name := range vars {
+		if !declared[name] {
+			return nil, fmt.Errorf("the template doesn't declare the variable %s", name)
+		}
+	}
+
+	return values, nil
+}
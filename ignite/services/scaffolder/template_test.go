@@ -0,0 +1,103 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateURL(t *testing.T) {
+	tests := []struct {
+		template string
+		url      string
+		ref      string
+	}{
+		{template: "github.com/org/template", url: "https://github.com/org/template"},
+		{template: "github.com/org/template@v1.0.0", url: "https://github.com/org/template", ref: "v1.0.0"},
+		{template: "https://gitlab.com/org/template.git@main", url: "https://gitlab.com/org/template.git", ref: "main"},
+		{template: "git@github.com:org/template.git", url: "git@github.com:org/template.git"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			url, ref := templateURL(tt.template)
+			require.Equal(t, tt.url, url)
+			require.Equal(t, tt.ref, ref)
+		})
+	}
+}
+
+func TestReadTemplateManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		err      bool
+	}{
+		{
+			name: "valid manifest",
+			manifest: `name: org
+variables:
+  - name: Denom
+    default: uorg
+  - name: Team
+`,
+		},
+		{
+			name: "invalid variable name",
+			manifest: `variables:
+  - name: my-denom
+`,
+			err: true,
+		},
+		{
+			name: "builtin variable",
+			manifest: `variables:
+  - name: AppName
+`,
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, TemplateManifestFile), []byte(tt.manifest), 0o644))
+
+			_, err := readTemplateManifest(dir)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("missing manifest", func(t *testing.T) {
+		_, err := readTemplateManifest(t.TempDir())
+		require.Error(t, err)
+	})
+}
+
+func TestResolveTemplateVars(t *testing.T) {
+	denom := "uorg"
+	manifest := TemplateManifest{
+		Variables: []TemplateVariable{
+			{Name: "Denom", Default: &denom},
+			{Name: "Team"},
+		},
+	}
+
+	values, err := resolveTemplateVars(manifest, map[string]string{"Team": "core"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Denom": "uorg", "Team": "core"}, values)
+
+	values, err = resolveTemplateVars(manifest, map[string]string{"Denom": "stake", "Team": "core"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Denom": "stake", "Team": "core"}, values)
+
+	_, err = resolveTemplateVars(manifest, nil)
+	require.Error(t, err, "missing required variable")
+
+	_, err = resolveTemplateVars(manifest, map[string]string{"Team": "core", "Other": "x"})
+	require.Error(t, err, "undeclared variable")
+}
@@ -182,6 +182,7 @@ func (s Scaffolder) AddType(
 			NoMessage:    o.withoutMessage,
 			NoSimulation: o.withoutSimulation,
 			MsgSigner:    mfSigner,
+			SignerOption: s.msgSignerOption(),
 			IsIBC:        isIBC,
 		}
 		gens []*genny.Generator
@@ -223,6 +224,16 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	gens, err = supportEnums(gens, opts.AppPath, opts.ModulePath, opts.ModuleName, opts.Fields)
+	if err != nil {
+		return sm, err
+	}
+
+	gens, err = supportAddressCodec(gens, opts.AppPath)
+	if err != nil {
+		return sm, err
+	}
+
 	// create the type generator depending on the model
 	switch {
 	case o.isList:
@@ -0,0 +1,197 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+	"github.com/ignite/cli/ignite/templates/typemigration"
+)
+
+// migrationsFile is the file of the keeper defining the migrations support of a module
+const migrationsFile = "migrations.go"
+
+// AddTypeMigration adds a field to a type stored by a module and migrates the existing records:
+// the field is added to the proto message of the type, the consensus version of the module is
+// bumped and the migration of the store sets the field of the existing records to defaultValue.
+func (s Scaffolder) AddTypeMigration(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	typeName,
+	addField,
+	defaultValue string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we migrate the type of the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return sm, err
+	}
+
+	// the type must be stored by the keeper of the module
+	keeperTypePath := filepath.Join(s.path, moduleDir, moduleName, "keeper", name.Snake+".go")
+	keeperType, err := os.ReadFile(keeperTypePath)
+	if os.IsNotExist(err) {
+		return sm, fmt.Errorf("the type %s isn't stored by the module %s", name.Original, moduleName)
+	} else if err != nil {
+		return sm, err
+	}
+
+	protoPath := filepath.Join(s.path, "proto", moduleName, name.Snake+".proto")
+	pkgs, err := protoanalysis.Parse(context.Background(), nil, protoPath)
+	if err != nil {
+		return sm, err
+	}
+	if len(pkgs) == 0 {
+		return sm, fmt.Errorf("%s is not a proto file", protoPath)
+	}
+	message, err := pkgs[0].MessageByName(name.UpperCamel)
+	if err != nil {
+		return sm, err
+	}
+	existingFields := make([]string, 0, len(message.Fields))
+	for _, f := range message.Fields {
+		existingFields = append(existingFields, f.Name)
+	}
+
+	fields, err := field.ParseFields([]string{addField}, checkForbiddenTypeField, existingFields...)
+	if err != nil {
+		return sm, err
+	}
+	if len(fields) != 1 {
+		return sm, fmt.Errorf("a single field is added by a migration, e.g. score:uint")
+	}
+	newField := fields[0]
+
+	value, err := migrationDefaultValue(newField, defaultValue)
+	if err != nil {
+		return sm, err
+	}
+
+	moduleGo, err := os.ReadFile(filepath.Join(s.path, moduleDir, moduleName, "module.go"))
+	if err != nil {
+		return sm, err
+	}
+	from, err := typemigration.ConsensusVersion(string(moduleGo))
+	if err != nil {
+		return sm, err
+	}
+
+	gens, err := s.supportMigrations(nil, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	g, err := typemigration.NewStargate(&typemigration.Options{
+		AppName:      s.modpath.Package,
+		AppPath:      s.path,
+		ModulePath:   s.modpath.RawPath,
+		ModuleName:   moduleName,
+		TypeName:     name,
+		Field:        newField,
+		FieldNumber:  message.HighestFieldNumber + 1,
+		DefaultValue: value,
+		IsSingleton:  !strings.Contains(string(keeperType), fmt.Sprintf("GetAll%s(", name.UpperCamel)),
+		From:         from,
+	})
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+
+	return sm, finish(cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// supportMigrations adds the generator scaffolding the migrations support of the module if the
+// module doesn't define it yet
+func (s Scaffolder) supportMigrations(gens []*genny.Generator, moduleName string) ([]*genny.Generator, error) {
+	supportPath := filepath.Join(s.path, moduleDir, moduleName, "keeper", migrationsFile)
+	if _, err := os.Stat(supportPath); err == nil {
+		return gens, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	g, err := typemigration.NewStargateSupport(&typemigration.SupportOptions{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(gens, g), nil
+}
+
+// migrationDefaultValue returns the Go literal of the default value of a field added by a
+// migration, the zero value of the field is used when the value is empty
+func migrationDefaultValue(f field.Field, value string) (string, error) {
+	switch f.DatatypeName {
+	case datatype.String:
+		return strconv.Quote(value), nil
+	case datatype.Bool:
+		if value == "" {
+			return "false", nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid default value %q of the bool field %s", value, f.Name.Original)
+		}
+		return strconv.FormatBool(b), nil
+	case datatype.Int:
+		if value == "" {
+			return "0", nil
+		}
+		i, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid default value %q of the int field %s", value, f.Name.Original)
+		}
+		return strconv.FormatInt(i, 10), nil
+	case datatype.Uint:
+		if value == "" {
+			return "0", nil
+		}
+		u, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid default value %q of the uint field %s", value, f.Name.Original)
+		}
+		return strconv.FormatUint(u, 10), nil
+	default:
+		return "", fmt.Errorf("the field %s can't be added by a migration, use a string, bool, int or uint field", f.Name.Original)
+	}
+}
@@ -0,0 +1,45 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestMigrationDefaultValue(t *testing.T) {
+	tests := []struct {
+		field string
+		value string
+		want  string
+		err   bool
+	}{
+		{field: "score:uint", value: "10", want: "10"},
+		{field: "score:uint", want: "0"},
+		{field: "score:uint", value: "-1", err: true},
+		{field: "rank:int", value: "-1", want: "-1"},
+		{field: "rank:int", value: "4294967296", err: true},
+		{field: "hidden:bool", value: "true", want: "true"},
+		{field: "hidden:bool", want: "false"},
+		{field: "hidden:bool", value: "maybe", err: true},
+		{field: "tag", value: `say "hi"`, want: `"say \"hi\""`},
+		{field: "tag:string", want: `""`},
+		{field: "tags:array.string", err: true},
+		{field: "amount:coin", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field+"="+tt.value, func(t *testing.T) {
+			fields, err := field.ParseFields([]string{tt.field}, checkForbiddenTypeField)
+			require.NoError(t, err)
+
+			value, err := migrationDefaultValue(fields[0], tt.value)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, value)
+		})
+	}
+}
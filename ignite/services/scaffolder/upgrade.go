@@ -0,0 +1,466 @@
+package scaffolder
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/templates/app"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+// importRewrite rewrites the imports of a package moved to another module, the import paths
+// starting with from are rewritten to start with to.
+type importRewrite struct {
+	from, to string
+}
+
+// importRewrites are the packages used by the apps scaffolded with older versions of Ignite CLI,
+// Starport and the IBC modules, and their current import paths.
+var importRewrites = []importRewrite{
+	{"github.com/tendermint/spm/cosmoscmd", "github.com/ignite/cli/ignite/pkg/cosmoscmd"},
+	{"github.com/tendermint/spm/openapiconsole", "github.com/ignite/cli/ignite/pkg/openapiconsole"},
+	{"github.com/tendermint/spm/ibckeeper", "github.com/ignite/cli/ignite/pkg/cosmosibckeeper"},
+	{"github.com/tendermint/starport/starport", "github.com/ignite/cli/ignite"},
+	{"github.com/ignite-hq/cli/ignite", "github.com/ignite/cli/ignite"},
+	{"github.com/cosmos/ibc-go/v2", "github.com/cosmos/ibc-go/v3"},
+	{"github.com/cosmos/ibc-go/modules", "github.com/cosmos/ibc-go/v3/modules"},
+}
+
+// legacyModules are the modules replaced by the rewritten imports, they're dropped from go.mod.
+var legacyModules = []string{
+	"github.com/tendermint/spm",
+	"github.com/tendermint/starport",
+	"github.com/ignite-hq/cli",
+	"github.com/cosmos/ibc-go/v2",
+	"github.com/cosmos/ibc-go",
+}
+
+const (
+	// transferIBCModule is the IBC module of the transfer app routed by ibc-go v3.
+	transferIBCModule = "transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)"
+
+	// transferAppModule is the definition of the transfer module in app.go.
+	transferAppModule = "transferModule := transfer.NewAppModule(app.TransferKeeper)"
+
+	// transferRoute is the IBC route of the transfer app in app.go before ibc-go v3.
+	transferRoute = "ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)"
+
+	// transferKeeperArgs are the channel and port keepers of the transfer keeper before ibc-go v3,
+	// the channel keeper is also its ICS4 wrapper since ibc-go v3.
+	transferKeeperArgs = "app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,"
+)
+
+// faucetPortRe matches the legacy port of the faucet in the config.
+var faucetPortRe = regexp.MustCompile(`^(\s+)port:\s*(\d+)\s*$`)
+
+// UpgradeReport reports the migrations applied to an app scaffolded with an older version.
+type UpgradeReport struct {
+	// Migrated lists the migrations applied to the app.
+	Migrated []string
+
+	// Manual lists the changes that couldn't be migrated, they're left to the developer.
+	Manual []string
+
+	// Modified lists the files modified by the migrations, relative to the app path.
+	Modified []string
+}
+
+func (r *UpgradeReport) migrated(format string, a ...interface{}) {
+	r.Migrated = append(r.Migrated, fmt.Sprintf(format, a...))
+}
+
+func (r *UpgradeReport) manual(format string, a ...interface{}) {
+	r.Manual = append(r.Manual, fmt.Sprintf(format, a...))
+}
+
+func (r *UpgradeReport) modified(appPath, path string) {
+	if rel, err := filepath.Rel(appPath, path); err == nil {
+		path = rel
+	}
+	for _, p := range r.Modified {
+		if p == path {
+			return
+		}
+	}
+	r.Modified = append(r.Modified, path)
+}
+
+// UpgradeScaffold migrates an app scaffolded with an older version of Ignite CLI or of the
+// Cosmos SDK to the versions of the apps scaffolded by this version: the imports of the moved
+// packages are rewritten, the dependencies of go.mod are upgraded, app.go is updated for the API
+// changes of the dependencies and the legacy options of the config are migrated.
+// The changes that can't be migrated automatically are reported.
+func (s Scaffolder) UpgradeScaffold() (report UpgradeReport, err error) {
+	if s.Version.LT(cosmosver.StargateFortyFourVersion) {
+		report.manual(
+			"the app uses the Cosmos SDK %s, migrate it to v0.44 first, it requires the upgrade handlers and the module versions in app.go",
+			s.Version.Version,
+		)
+	}
+
+	imports, err := rewriteImports(s.path, &report)
+	if err != nil {
+		return report, err
+	}
+	if err := upgradeGoMod(s.path, imports, &report); err != nil {
+		return report, err
+	}
+	if err := upgradeAppGo(s.path, &report); err != nil {
+		return report, err
+	}
+	if err := checkIBCModules(s.path, &report); err != nil {
+		return report, err
+	}
+	if err := upgradeConfig(s.path, &report); err != nil {
+		return report, err
+	}
+
+	if len(report.Modified) > 0 {
+		if err := tidy(s.path); err != nil {
+			report.manual("go mod tidy failed, fix go.mod and run it: %s", err)
+		} else if err := fmtProject(s.path); err != nil {
+			report.manual("the Go files can't be formatted: %s", err)
+		}
+	}
+	return report, nil
+}
+
+// rewriteImports rewrites the imports of the moved packages in the Go files of the app. It
+// returns the import paths of the app after the rewrite.
+func rewriteImports(appPath string, report *UpgradeReport) (imports map[string]bool, err error) {
+	imports = make(map[string]bool)
+	rewritten := make(map[string]string)
+	var files int
+
+	err = filepath.WalkDir(appPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != appPath && (strings.HasPrefix(d.Name(), ".") || d.Name() == "vendor" || d.Name() == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+		if err != nil {
+			report.manual("%s can't be parsed, its imports are not migrated: %s", path, err)
+			return nil
+		}
+
+		replacements := make(map[string]string)
+		for _, imp := range f.Imports {
+			importPath, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				return err
+			}
+			newPath := rewriteImport(importPath)
+			if newPath != importPath {
+				replacements[importPath] = newPath
+				rewritten[importPath] = newPath
+			}
+			imports[newPath] = true
+		}
+		if len(replacements) == 0 {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		updated := string(content)
+		for from, to := range replacements {
+			updated = strings.ReplaceAll(updated, strconv.Quote(from), strconv.Quote(to))
+		}
+		if err := os.WriteFile(path, []byte(updated), 0644); err != nil {
+			return err
+		}
+		report.modified(appPath, path)
+		files++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if files > 0 {
+		report.migrated("rewrote the imports of %d moved packages in %d files", len(rewritten), files)
+	}
+	for importPath := range imports {
+		if strings.HasPrefix(importPath, "github.com/tendermint/spm/") {
+			report.manual("the package %s is not part of Ignite CLI, copy it to the app", importPath)
+		}
+	}
+	return imports, nil
+}
+
+// rewriteImport returns the current import path of importPath.
+func rewriteImport(importPath string) string {
+	for _, r := range importRewrites {
+		if importPath == r.from || strings.HasPrefix(importPath, r.from+"/") {
+			return r.to + strings.TrimPrefix(importPath, r.from)
+		}
+	}
+	return importPath
+}
+
+// upgradeGoMod upgrades the modules required by the app to the versions required by the
+// scaffolded apps, drops the legacy modules and adds the replacements of the scaffolded apps.
+func upgradeGoMod(appPath string, imports map[string]bool, report *UpgradeReport) error {
+	path := filepath.Join(appPath, "go.mod")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	gomod, err := modfile.Parse(path, content, nil)
+	if err != nil {
+		return err
+	}
+	target, err := app.GoMod(gomod.Module.Mod.Path)
+	if err != nil {
+		return err
+	}
+
+	required := make(map[string]string)
+	for _, r := range gomod.Require {
+		required[r.Mod.Path] = r.Mod.Version
+	}
+
+	var changed bool
+	for _, legacy := range legacyModules {
+		if _, ok := required[legacy]; !ok {
+			continue
+		}
+		if err := gomod.DropRequire(legacy); err != nil {
+			return err
+		}
+		var replacedVersions []string
+		for _, r := range gomod.Replace {
+			if r.Old.Path == legacy {
+				replacedVersions = append(replacedVersions, r.Old.Version)
+			}
+		}
+		for _, version := range replacedVersions {
+			if err := gomod.DropReplace(legacy, version); err != nil {
+				return err
+			}
+		}
+		delete(required, legacy)
+		report.migrated("dropped %s from go.mod", legacy)
+		changed = true
+	}
+	for _, r := range target.Require {
+		current, ok := required[r.Mod.Path]
+		if !ok && !isImported(imports, r.Mod.Path) {
+			continue
+		}
+		if ok && semver.Compare(current, r.Mod.Version) >= 0 {
+			continue
+		}
+		if err := gomod.AddRequire(r.Mod.Path, r.Mod.Version); err != nil {
+			return err
+		}
+		if ok {
+			report.migrated("upgraded %s from %s to %s", r.Mod.Path, current, r.Mod.Version)
+		} else {
+			report.migrated("required %s %s", r.Mod.Path, r.Mod.Version)
+		}
+		changed = true
+	}
+
+	replaced := make(map[string]bool)
+	for _, r := range gomod.Replace {
+		replaced[r.Old.Path] = true
+	}
+	for _, r := range target.Replace {
+		if replaced[r.Old.Path] {
+			continue
+		}
+		if err := gomod.AddReplace(r.Old.Path, r.Old.Version, r.New.Path, r.New.Version); err != nil {
+			return err
+		}
+		report.migrated("replaced %s with %s %s", r.Old.Path, r.New.Path, r.New.Version)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	gomod.Cleanup()
+	updated, err := gomod.Format()
+	if err != nil {
+		return err
+	}
+	report.modified(appPath, path)
+	return os.WriteFile(path, updated, 0644)
+}
+
+// isImported checks if a package of the module modulePath is imported.
+func isImported(imports map[string]bool, modulePath string) bool {
+	for importPath := range imports {
+		if importPath == modulePath || strings.HasPrefix(importPath, modulePath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// upgradeAppGo updates app.go for the API changes of the dependencies: since ibc-go v3, the transfer
+// keeper is initialized with an ICS4 wrapper and the IBC route of the transfer app is its IBC module.
+func upgradeAppGo(appPath string, report *UpgradeReport) error {
+	path := filepath.Join(appPath, module.PathAppGo)
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		report.manual("%s is not found, update the app for the API changes of the Cosmos SDK and ibc-go", module.PathAppGo)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	appGo := string(content)
+	upgraded := appGo
+
+	ics4Wrapper := "app.IBCKeeper.ChannelKeeper, " + transferKeeperArgs
+	if strings.Contains(upgraded, transferKeeperArgs) && !strings.Contains(upgraded, ics4Wrapper) {
+		upgraded = strings.Replace(upgraded, transferKeeperArgs, ics4Wrapper, 1)
+		report.migrated("added the ICS4 wrapper of the transfer keeper in %s", module.PathAppGo)
+	}
+
+	if strings.Contains(upgraded, transferRoute) {
+		if strings.Contains(upgraded, transferAppModule) {
+			upgraded = strings.Replace(upgraded, transferAppModule, transferAppModule+"\n\t"+transferIBCModule, 1)
+			upgraded = strings.Replace(upgraded, transferRoute, strings.Replace(transferRoute, "transferModule", "transferIBCModule", 1), 1)
+			report.migrated("routed the IBC packets of transfer to its IBC module in %s", module.PathAppGo)
+		} else {
+			report.manual(
+				"%s routes the IBC packets of transfer to its app module, route them to transfer.NewIBCModule(app.TransferKeeper) for ibc-go v3",
+				module.PathAppGo,
+			)
+		}
+	}
+
+	if upgraded == appGo {
+		return nil
+	}
+	report.modified(appPath, path)
+	return os.WriteFile(path, []byte(upgraded), 0644)
+}
+
+// checkIBCModules reports the IBC modules of the app implementing the callbacks of ibc-go v2,
+// OnChanOpenTry returns the negotiated version since ibc-go v3.
+func checkIBCModules(appPath string, report *UpgradeReport) error {
+	paths, err := filepath.Glob(filepath.Join(appPath, moduleDir, "*", "module_ibc.go"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "OnChanOpenTry" {
+				continue
+			}
+			if fn.Type.Results != nil && len(fn.Type.Results.List) == 1 {
+				rel, _ := filepath.Rel(appPath, path)
+				report.manual(
+					"%s implements the IBC callbacks of ibc-go v2, OnChanOpenTry returns the version and OnChanOpenAck receives the counterparty channel ID in ibc-go v3",
+					rel,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// upgradeConfig migrates the legacy options of the config, the port of the faucet is set in its
+// host. The config is edited as text to keep its comments and its layout.
+func upgradeConfig(appPath string, report *UpgradeReport) error {
+	path, err := chainconfig.LocateDefault(appPath)
+	if err == chainconfig.ErrCouldntLocateConfig {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	updated, ok := migrateFaucetPort(string(content))
+	if !ok {
+		return nil
+	}
+	if _, err := chainconfig.Parse(strings.NewReader(updated)); err != nil {
+		report.manual("the port of the faucet can't be migrated in %s, set it in faucet.host: %s", filepath.Base(path), err)
+		return nil
+	}
+
+	report.migrated("moved faucet.port to faucet.host in %s", filepath.Base(path))
+	report.modified(appPath, path)
+	return os.WriteFile(path, []byte(updated), 0644)
+}
+
+// migrateFaucetPort replaces the legacy faucet.port of the config by faucet.host, the port
+// overrides the host of the faucet. It returns false when the config has no faucet port.
+func migrateFaucetPort(content string) (string, bool) {
+	lines := strings.Split(content, "\n")
+
+	portLine, hostLine := -1, -1
+	var port, indent string
+	inFaucet := false
+	for i, line := range lines {
+		trimmed := strings.TrimRight(line, " ")
+		if trimmed != "" && !strings.HasPrefix(trimmed, " ") && !strings.HasPrefix(trimmed, "#") {
+			inFaucet = trimmed == "faucet:"
+			continue
+		}
+		if !inFaucet {
+			continue
+		}
+		if m := faucetPortRe.FindStringSubmatch(trimmed); m != nil {
+			portLine, indent, port = i, m[1], m[2]
+		}
+		if strings.HasPrefix(strings.TrimSpace(trimmed), "host:") {
+			hostLine = i
+		}
+	}
+	if portLine == -1 {
+		return content, false
+	}
+
+	// the host is indented like the port, both are keys of the faucet
+	host := fmt.Sprintf("%shost: 0.0.0.0:%s", indent, port)
+	if hostLine != -1 && strings.HasPrefix(lines[hostLine], indent+"host:") {
+		lines[hostLine] = host
+		lines = append(lines[:portLine], lines[portLine+1:]...)
+	} else {
+		lines[portLine] = host
+	}
+	return strings.Join(lines, "\n"), true
+}
@@ -0,0 +1,185 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/ignite/templates/app"
+)
+
+func TestRewriteImport(t *testing.T) {
+	for from, to := range map[string]string{
+		"github.com/tendermint/spm/cosmoscmd":                          "github.com/ignite/cli/ignite/pkg/cosmoscmd",
+		"github.com/tendermint/spm/ibckeeper":                          "github.com/ignite/cli/ignite/pkg/cosmosibckeeper",
+		"github.com/tendermint/starport/starport/pkg/openapiconsole":   "github.com/ignite/cli/ignite/pkg/openapiconsole",
+		"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd":                "github.com/ignite/cli/ignite/pkg/cosmoscmd",
+		"github.com/cosmos/ibc-go/v2/modules/apps/transfer":            "github.com/cosmos/ibc-go/v3/modules/apps/transfer",
+		"github.com/cosmos/ibc-go/modules/core/keeper":                 "github.com/cosmos/ibc-go/v3/modules/core/keeper",
+		"github.com/cosmos/ibc-go/v3/modules/apps/transfer":            "github.com/cosmos/ibc-go/v3/modules/apps/transfer",
+		"github.com/tendermint/spm-extras/wasmcmd":                     "github.com/tendermint/spm-extras/wasmcmd",
+		"github.com/cosmos/cosmos-sdk/types":                           "github.com/cosmos/cosmos-sdk/types",
+		"github.com/tendermint/starport/starport/templates/field/type": "github.com/ignite/cli/ignite/templates/field/type",
+	} {
+		require.Equal(t, to, rewriteImport(from), from)
+	}
+}
+
+func TestMigrateFaucetPort(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+		migrated bool
+	}{
+		{
+			name:     "port",
+			config:   "faucet:\n  name: bob\n  port: 4500\nhost:\n  rpc: 0.0.0.0:26657\n",
+			expected: "faucet:\n  name: bob\n  host: 0.0.0.0:4500\nhost:\n  rpc: 0.0.0.0:26657\n",
+			migrated: true,
+		},
+		{
+			name:     "port overriding the host",
+			config:   "faucet:\n  host: 0.0.0.0:4501\n  # legacy\n  port: 4500\n",
+			expected: "faucet:\n  host: 0.0.0.0:4500\n  # legacy\n",
+			migrated: true,
+		},
+		{
+			name:   "host",
+			config: "faucet:\n  host: 0.0.0.0:4500\n",
+		},
+		{
+			name:   "port of another key",
+			config: "faucet:\n  name: bob\ndocker:\n  port: 4500\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, migrated := migrateFaucetPort(tt.config)
+			require.Equal(t, tt.migrated, migrated)
+			if tt.migrated {
+				require.Equal(t, tt.expected, updated)
+			} else {
+				require.Equal(t, tt.config, updated)
+			}
+		})
+	}
+}
+
+func TestUpgradeScaffoldedApp(t *testing.T) {
+	appPath := t.TempDir()
+	write := func(path, content string) {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	read := func(path string) string {
+		content, err := os.ReadFile(filepath.Join(appPath, path))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	write("go.mod", `module github.com/alice/mars
+
+go 1.16
+
+require (
+	github.com/cosmos/cosmos-sdk v0.44.5
+	github.com/cosmos/ibc-go/v2 v2.0.2
+	github.com/tendermint/spm v0.1.9
+	github.com/tendermint/tendermint v0.34.14
+	github.com/spf13/cobra v1.2.1
+)
+
+replace github.com/gogo/protobuf => github.com/regen-network/protobuf v1.3.3-alpha.regen.1
+`)
+	write("app/app.go", `package app
+
+import (
+	"github.com/cosmos/ibc-go/v2/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	"github.com/tendermint/spm/cosmoscmd"
+)
+
+func New() {
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+	)
+	transferModule := transfer.NewAppModule(app.TransferKeeper)
+
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+}
+`)
+	write("x/mars/module_ibc.go", `package mars
+
+func (am AppModule) OnChanOpenTry(version string) error {
+	return nil
+}
+`)
+
+	var report UpgradeReport
+	imports, err := rewriteImports(appPath, &report)
+	require.NoError(t, err)
+	require.NoError(t, upgradeGoMod(appPath, imports, &report))
+	require.NoError(t, upgradeAppGo(appPath, &report))
+	require.NoError(t, checkIBCModules(appPath, &report))
+
+	require.Equal(t, `package app
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ignite/cli/ignite/pkg/cosmoscmd"
+)
+
+func New() {
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+	)
+	transferModule := transfer.NewAppModule(app.TransferKeeper)
+	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+}
+`, read("app/app.go"))
+
+	gomod, err := modfile.Parse("go.mod", []byte(read("go.mod")), nil)
+	require.NoError(t, err)
+	required := make(map[string]string)
+	for _, r := range gomod.Require {
+		required[r.Mod.Path] = r.Mod.Version
+	}
+	// the modules are upgraded to the versions of the scaffolded apps
+	target, err := app.GoMod("github.com/alice/mars")
+	require.NoError(t, err)
+	expected := make(map[string]string)
+	for _, r := range target.Require {
+		switch r.Mod.Path {
+		case "github.com/cosmos/cosmos-sdk",
+			"github.com/cosmos/ibc-go/v3",
+			"github.com/ignite/cli",
+			"github.com/tendermint/tendermint",
+			"github.com/spf13/cobra":
+			expected[r.Mod.Path] = r.Mod.Version
+		}
+	}
+	require.Len(t, expected, 5)
+	require.Equal(t, expected, required)
+	replaced := make(map[string]string)
+	for _, r := range gomod.Replace {
+		replaced[r.Old.Path] = r.New.Path
+	}
+	require.Equal(t, "github.com/regen-network/protobuf", replaced["github.com/gogo/protobuf"])
+	require.Contains(t, replaced, "google.golang.org/grpc")
+
+	require.ElementsMatch(t, []string{"app/app.go", "go.mod"}, report.Modified)
+	require.Len(t, report.Manual, 1)
+	require.Contains(t, report.Manual[0], filepath.Join("x", "mars", "module_ibc.go"))
+}
@@ -0,0 +1,98 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// wasmGenesis is the genesis state of the wasm module set in the config, anyone can upload and
+// instantiate contracts on the chain. The entries are indented to be under genesis.app_state.
+var wasmGenesis = []string{
+	"    wasm:",
+	"      params:",
+	"        code_upload_access:",
+	`          permission: "Everybody"`,
+	`        instantiate_default_permission: "Everybody"`,
+}
+
+// enableWasmGenesis sets the params of the wasm module in the genesis of the config of the app
+// when the genesis doesn't set the state of the wasm module. It returns the path of the config
+// when it's modified. The config is edited as text to keep its comments and its layout.
+func enableWasmGenesis(appPath string) (string, error) {
+	confPath, err := chainconfig.LocateDefault(appPath)
+	if err != nil {
+		return "", err
+	}
+	conf, err := chainconfig.ParseFile(confPath)
+	if err != nil {
+		return "", err
+	}
+	if hasWasmGenesis(conf) {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(confPath)
+	if err != nil {
+		return "", err
+	}
+	updated := addWasmGenesis(string(content))
+
+	// ensure the edited config is valid and sets the wasm genesis
+	conf, err = chainconfig.Parse(strings.NewReader(updated))
+	if err != nil || !hasWasmGenesis(conf) {
+		return "", fmt.Errorf("cannot set genesis.app_state.wasm in %s, set the params of the wasm module", confPath)
+	}
+
+	return confPath, os.WriteFile(confPath, []byte(updated), 0644)
+}
+
+// addWasmGenesis adds the wasm genesis to the app state of the genesis of the config.
+func addWasmGenesis(content string) string {
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+
+	genesisLine, appStateLine := -1, -1
+	for i, line := range lines {
+		line = strings.TrimRight(line, " ")
+		if genesisLine == -1 {
+			if line == "genesis:" {
+				genesisLine = i
+			}
+			continue
+		}
+		// the genesis ends at the next key of the config
+		if line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "#") {
+			break
+		}
+		if line == "  app_state:" {
+			appStateLine = i
+			break
+		}
+	}
+
+	insert := func(at int, entries []string) {
+		lines = append(lines[:at], append(entries, lines[at:]...)...)
+	}
+	switch {
+	case appStateLine != -1:
+		insert(appStateLine+1, wasmGenesis)
+	case genesisLine != -1:
+		insert(genesisLine+1, append([]string{"  app_state:"}, wasmGenesis...))
+	default:
+		lines = append(lines, "genesis:", "  app_state:")
+		lines = append(lines, wasmGenesis...)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// hasWasmGenesis checks if the genesis of the config sets the state of the wasm module.
+func hasWasmGenesis(conf chainconfig.Config) bool {
+	appState, ok := conf.Genesis["app_state"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = appState["wasm"]
+	return ok
+}
@@ -0,0 +1,100 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableWasmGenesis(t *testing.T) {
+	const (
+		accounts = `accounts:
+  - name: alice
+    coins: ["100stake"]
+validator:
+  name: alice
+  staked: "100stake"
+`
+		wasm = `    wasm:
+      params:
+        code_upload_access:
+          permission: "Everybody"
+        instantiate_default_permission: "Everybody"
+`
+	)
+
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+		modified bool
+	}{
+		{
+			name:     "without genesis",
+			config:   accounts,
+			expected: accounts + "genesis:\n  app_state:\n" + wasm,
+			modified: true,
+		},
+		{
+			name: "with genesis",
+			config: `genesis:
+  chain_id: "mars-1"
+` + accounts,
+			expected: `genesis:
+  app_state:
+` + wasm + `  chain_id: "mars-1"
+` + accounts,
+			modified: true,
+		},
+		{
+			name: "with app state",
+			config: accounts + `genesis:
+  app_state:
+    staking:
+      params:
+        bond_denom: "stake"
+`,
+			expected: accounts + `genesis:
+  app_state:
+` + wasm + `    staking:
+      params:
+        bond_denom: "stake"
+`,
+			modified: true,
+		},
+		{
+			name: "already set",
+			config: accounts + `genesis:
+  app_state:
+    wasm:
+      params:
+        code_upload_access:
+          permission: "Nobody"
+`,
+			expected: accounts + `genesis:
+  app_state:
+    wasm:
+      params:
+        code_upload_access:
+          permission: "Nobody"
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			confPath := filepath.Join(dir, "config.yml")
+			require.NoError(t, os.WriteFile(confPath, []byte(tt.config), 0644))
+
+			modified, err := enableWasmGenesis(dir)
+			require.NoError(t, err)
+			require.Equal(t, tt.modified, modified != "")
+
+			content, err := os.ReadFile(confPath)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, string(content))
+		})
+	}
+}
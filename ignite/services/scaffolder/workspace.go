@@ -0,0 +1,68 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+)
+
+const (
+	goModFile  = "go.mod"
+	goWorkFile = "go.work"
+)
+
+// findParentFile returns the closest parent directory of path containing the file with name.
+func findParentFile(path, name string) (dir string, found bool, err error) {
+	for dir = filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if err == nil {
+			return dir, true, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", false, err
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			return "", false, nil
+		}
+	}
+}
+
+// setupWorkspace adds the Go module at path to the Go workspace of the repository it's scaffolded in.
+// When the repository is a Go module without workspace, a workspace with both modules is created.
+// Nothing is done when the module isn't scaffolded inside another Go module or workspace.
+func setupWorkspace(ctx context.Context, path string) error {
+	workDir, found, err := findParentFile(path, goWorkFile)
+	if err != nil {
+		return err
+	}
+	if found {
+		rel, err := filepath.Rel(workDir, path)
+		if err != nil {
+			return err
+		}
+		return gocmd.WorkUse(ctx, workDir, []string{rel})
+	}
+
+	modDir, found, err := findParentFile(path, goModFile)
+	if err != nil || !found {
+		return err
+	}
+	rel, err := filepath.Rel(modDir, path)
+	if err != nil {
+		return err
+	}
+	return gocmd.WorkInit(ctx, modDir, []string{".", rel})
+}
+
+// isInGitRepository returns true when path is inside an existing git repository.
+func isInGitRepository(path string) (bool, error) {
+	_, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err == git.ErrRepositoryNotExists {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,54 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupWorkspace(t *testing.T) {
+	writeGoMod := func(t *testing.T, dir, module string) {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		content := "module " + module + "\n\ngo 1.18\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, goModFile), []byte(content), 0644))
+	}
+
+	t.Run("outside a module", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "foo")
+		writeGoMod(t, path, "example.org/foo")
+
+		require.NoError(t, setupWorkspace(context.Background(), path))
+		require.NoFileExists(t, filepath.Join(filepath.Dir(path), goWorkFile))
+	})
+
+	t.Run("inside a module", func(t *testing.T) {
+		root := t.TempDir()
+		writeGoMod(t, root, "example.org/mono")
+		path := filepath.Join(root, "chains", "foo")
+		writeGoMod(t, path, "example.org/mono/chains/foo")
+
+		require.NoError(t, setupWorkspace(context.Background(), path))
+
+		work, err := os.ReadFile(filepath.Join(root, goWorkFile))
+		require.NoError(t, err)
+		require.Contains(t, string(work), "./chains/foo")
+	})
+
+	t.Run("inside a workspace", func(t *testing.T) {
+		root := t.TempDir()
+		writeGoMod(t, filepath.Join(root, "bar"), "example.org/mono/bar")
+		require.NoError(t, os.WriteFile(filepath.Join(root, goWorkFile), []byte("go 1.18\n\nuse ./bar\n"), 0644))
+		path := filepath.Join(root, "chains", "foo")
+		writeGoMod(t, path, "example.org/mono/chains/foo")
+
+		require.NoError(t, setupWorkspace(context.Background(), path))
+
+		work, err := os.ReadFile(filepath.Join(root, goWorkFile))
+		require.NoError(t, err)
+		require.Contains(t, string(work), "./bar")
+		require.Contains(t, string(work), "./chains/foo")
+	})
+}
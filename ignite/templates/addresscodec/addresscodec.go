@@ -0,0 +1,40 @@
+package addresscodec
+
+import (
+	"embed"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// NewGenerator returns the generator of the addresscodec package of the app, the scaffolded modules
+// encode and decode the account addresses with it. The addresses are encoded with bech32 when codec
+// is empty. The package is kept when it already exists.
+func NewGenerator(appPath, codec string) (*genny.Generator, error) {
+	if codec == "" {
+		codec = cosmosaccount.AddressCodecBech32
+	}
+	if _, err := cosmosaccount.NewAddressCodec(codec, ""); err != nil {
+		return nil, err
+	}
+
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsStargate, "stargate/", appPath)
+	)
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("addressCodec", codec)
+	g.Transformer(plushgen.Transformer(ctx))
+	return g, nil
+}
@@ -0,0 +1,70 @@
+package addresscodec
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestNewGenerator(t *testing.T) {
+	cases := []struct {
+		name     string
+		codec    string
+		contains []string
+		err      bool
+	}{
+		{
+			name:     "default codec",
+			contains: []string{"return sdk.AccAddressFromBech32(address)", "return address.String()"},
+		},
+		{
+			name:     "bech32 codec",
+			codec:    cosmosaccount.AddressCodecBech32,
+			contains: []string{"return sdk.AccAddressFromBech32(address)", "return address.String()"},
+		},
+		{
+			name:     "hex codec",
+			codec:    cosmosaccount.AddressCodecHex,
+			contains: []string{"hex.DecodeString(strings.TrimPrefix(address, hexPrefix))", "return hexPrefix + hex.EncodeToString(address)"},
+		},
+		{
+			name:  "unknown codec",
+			codec: "base58",
+			err:   true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			appPath := t.TempDir()
+			g, err := NewGenerator(appPath, tt.codec)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			r := genny.DryRunner(context.Background())
+			r.With(g)
+			require.NoError(t, r.Run())
+
+			files := r.Results().Files
+			require.Len(t, files, 1)
+			require.Equal(t, filepath.Join(appPath, "app", "addresscodec", "addresscodec.go"), files[0].Name())
+
+			// the generated package is valid Go.
+			content := files[0].String()
+			_, err = parser.ParseFile(token.NewFileSet(), files[0].Name(), content, parser.AllErrors)
+			require.NoError(t, err)
+			for _, s := range tt.contains {
+				require.Contains(t, content, s)
+			}
+		})
+	}
+}
@@ -2,11 +2,18 @@ package app
 
 import (
 	"embed"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
 
 	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/packd"
 	"github.com/gobuffalo/plush"
 	"github.com/gobuffalo/plushgen"
+	"golang.org/x/mod/modfile"
 
+	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/ignite/templates/testutil"
@@ -19,10 +26,46 @@ var (
 
 // New returns the generator to scaffold a new Cosmos SDK app
 func New(opts *Options) (*genny.Generator, error) {
-	var (
-		g        = genny.New()
-		template = xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
-	)
+	g, err := newGenerator(xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath), opts, nil)
+	if err != nil {
+		return g, err
+	}
+
+	// Embed the OpenAPI docs served by the API
+	if !opts.Minimal {
+		if err := g.Box(newOpenAPIWalker(opts.AppPath)); err != nil {
+			return g, err
+		}
+	}
+
+	// Create the 'testutil' package with the test helpers
+	if err := testutil.Register(g, opts.AppPath); err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// NewFromTemplate returns the generator to scaffold a new Cosmos SDK app from the project
+// template in dir instead of the built-in one. The variables of the template are set with vars
+// in addition to the variables of the built-in template.
+func NewFromTemplate(dir string, opts *Options, vars map[string]string) (*genny.Generator, error) {
+	return newGenerator(xgenny.NewFSWalker(os.DirFS(dir), "", opts.AppPath), opts, vars)
+}
+
+// GoMod returns the go.mod of the scaffolded apps, with the modules they require and their
+// replacements. The module path of the returned go.mod is modulePath.
+func GoMod(modulePath string) (*modfile.File, error) {
+	gomod, err := fsStargate.ReadFile("stargate/go.mod.plush")
+	if err != nil {
+		return nil, err
+	}
+	gomod = []byte(strings.Replace(string(gomod), "<%= ModulePath %>", modulePath, 1))
+	return modfile.Parse("go.mod", gomod, nil)
+}
+
+func newGenerator(template packd.Walker, opts *Options, vars map[string]string) (*genny.Generator, error) {
+	g := genny.New()
 	if err := g.Box(template); err != nil {
 		return g, err
 	}
@@ -32,16 +75,38 @@ func New(opts *Options) (*genny.Generator, error) {
 	ctx.Set("GitHubPath", opts.GitHubPath)
 	ctx.Set("BinaryNamePrefix", opts.BinaryNamePrefix)
 	ctx.Set("AddressPrefix", opts.AddressPrefix)
+	ctx.Set("Minimal", opts.Minimal)
+	ctx.Set("Denoms", opts.Denoms)
+	ctx.Set("coins", coins(opts.Denoms))
+	ctx.Set("AddressCodec", opts.AddressCodec)
+	for name, value := range vars {
+		ctx.Set(name, value)
+	}
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
 	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
 	g.Transformer(genny.Replace("{{binaryNamePrefix}}", opts.BinaryNamePrefix))
 
-	// Create the 'testutil' package with the test helpers
-	if err := testutil.Register(g, opts.AppPath); err != nil {
-		return g, err
-	}
-
 	return g, nil
 }
+
+// coins returns the helper listing the coins of an account of the config, amount display units of
+// each denom, e.g. 20000token. The stake coins of the accounts are listed apart.
+func coins(denoms []chainconfig.Denom) func(int) []string {
+	return func(amount int) []string {
+		var coins []string
+		for _, denom := range denoms {
+			if denom.Base == "stake" {
+				continue
+			}
+			units := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(denom.Exponent)), nil)
+			units.Mul(units, big.NewInt(int64(amount)))
+			coins = append(coins, units.String()+denom.Base)
+		}
+		if len(coins) == 0 {
+			return []string{fmt.Sprintf("%dtoken", amount)}
+		}
+		return coins
+	}
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+// OpenAPIPath is the path of the OpenAPI spec embedded in the app.
+const OpenAPIPath = "docs/static/openapi.yml"
+
+var (
+	//go:embed openapi/* openapi/**/*
+	fsOpenAPI embed.FS
+)
+
+func newOpenAPIWalker(appPath string) xgenny.Walker {
+	return xgenny.NewEmbedWalker(fsOpenAPI, "openapi/", appPath)
+}
+
+// NewOpenAPI returns the generator to embed the OpenAPI docs in an app scaffolded without them,
+// and to serve them with the API of the app.
+func NewOpenAPI(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(appOpenAPIModify(replacer, opts))
+	if err := g.Box(newOpenAPIWalker(opts.AppPath)); err != nil {
+		return g, err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("ModulePath", opts.ModulePath)
+	g.Transformer(plushgen.Transformer(ctx))
+	return g, nil
+}
+
+func appOpenAPIModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import the docs and the console
+		templateImport := `"net/http"
+
+"github.com/ignite/cli/ignite/pkg/openapiconsole"
+"%[2]v/docs"
+%[1]v`
+		replacementImport := fmt.Sprintf(
+			templateImport,
+			module.PlaceholderSgAppModuleImport,
+			opts.ModulePath,
+		)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacementImport)
+
+		// Serve the docs with the API
+		templateRoutes := `// register app's OpenAPI routes.
+apiSvr.Router.Handle("/static/openapi.yml", http.FileServer(http.FS(docs.Docs)))
+apiSvr.Router.HandleFunc("/", openapiconsole.Handler(Name, "/static/openapi.yml"))
+%[1]v`
+		replacementRoutes := fmt.Sprintf(templateRoutes, module.PlaceholderSgAppAPIRoutes)
+		content = replacer.Replace(content, module.PlaceholderSgAppAPIRoutes, replacementRoutes)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
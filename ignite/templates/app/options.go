@@ -1,5 +1,7 @@
 package app
 
+import "github.com/ignite/cli/ignite/chainconfig"
+
 // Options ...
 type Options struct {
 	AppName          string
@@ -8,6 +10,16 @@ type Options struct {
 	BinaryNamePrefix string
 	ModulePath       string
 	AddressPrefix    string
+
+	// Minimal scaffolds only the app shell, without the OpenAPI docs and the frontend config.
+	Minimal bool
+
+	// Denoms are the denominations of the coins of the accounts of the config with their metadata,
+	// the token denom is used when empty.
+	Denoms []chainconfig.Denom
+
+	// AddressCodec is the codec of the account addresses of the app, written to the config when set.
+	AddressCodec string
 }
 
 // Validate that options are usuable
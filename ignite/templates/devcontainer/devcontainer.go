@@ -0,0 +1,77 @@
+package devcontainer
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+)
+
+const igniteInstallURL = "https://get.ignite.com/cli"
+
+//go:embed files/* files/**/*
+var fsFiles embed.FS
+
+// Port is a port forwarded from the development environment
+type Port struct {
+	Name string
+	Port int
+}
+
+// Options represents the options to scaffold the development environments of an app
+type Options struct {
+	AppName string
+	AppPath string
+
+	// GoVersion is the version of the Go toolchain, e.g. 1.18
+	GoVersion string
+
+	// IgniteVersion is the version of Ignite CLI installed, the latest one is installed when empty
+	IgniteVersion string
+
+	// Ports are the ports of the servers forwarded to the host, including the frontend
+	Ports []Port
+
+	// Frontend is true when the app has a Vue frontend started with the chain
+	Frontend bool
+
+	// FrontendPort is the port of the Vite dev server of the Vue frontend
+	FrontendPort int
+}
+
+// NewGenerator returns the generator to scaffold the dev container and the Gitpod config of an app
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	template := xgenny.NewEmbedWalker(fsFiles, "files/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("goVersion", opts.GoVersion)
+	ctx.Set("igniteInstall", igniteInstallCommand(opts.IgniteVersion))
+	ctx.Set("ports", opts.Ports)
+	ctx.Set("frontend", opts.Frontend)
+	ctx.Set("frontendPort", opts.FrontendPort)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+
+	return g, nil
+}
+
+// igniteInstallCommand returns the command installing the version of Ignite CLI
+func igniteInstallCommand(version string) string {
+	url := igniteInstallURL
+	if version != "" {
+		url = fmt.Sprintf("%s@%s", url, version)
+	}
+	return fmt.Sprintf("curl -fsSL %s! | bash", url)
+}
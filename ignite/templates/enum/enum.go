@@ -0,0 +1,79 @@
+package enum
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// Options represents the options to scaffold the proto enum of an enum field
+type Options struct {
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	Field      field.Field
+}
+
+// EnumName returns the name of the proto enum
+func (opts *Options) EnumName() multiformatname.Name {
+	name, err := multiformatname.NewName(opts.Field.Datatype)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+// ValuePrefix returns the prefix of the values of the proto enum, the values of the enums
+// of a proto package are in the same scope
+func (opts *Options) ValuePrefix() string {
+	return strings.ToUpper(opts.EnumName().Snake) + "_"
+}
+
+// Values returns the values of the proto enum with their prefix
+func (opts *Options) Values() []string {
+	values := make([]string, 0, len(opts.Field.EnumValues))
+	for _, value := range opts.Field.EnumValues {
+		values = append(values, opts.ValuePrefix()+strings.ToUpper(value.Snake))
+	}
+	return values
+}
+
+// NewStargate returns the generator to scaffold the proto enum of an enum field
+// and the helper parsing its values in the types of the module.
+func NewStargate(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	template := xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
+	ctx.Set("enumName", opts.EnumName())
+	ctx.Set("valuePrefix", opts.ValuePrefix())
+	ctx.Set("values", opts.Values())
+	ctx.Set("exampleValue", opts.Field.EnumValues[0].Snake)
+
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{enumName}}", opts.EnumName().Snake))
+
+	return g, nil
+}
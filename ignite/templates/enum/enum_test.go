@@ -0,0 +1,44 @@
+package enum
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestNewStargate(t *testing.T) {
+	fields, err := field.ParseFields([]string{"status:enum(Open,InProgress)"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	opts := &Options{
+		AppPath:    t.TempDir(),
+		ModuleName: "mars",
+		ModulePath: "github.com/test/mars",
+		Field:      fields[0],
+	}
+	require.Equal(t, []string{"STATUS_OPEN", "STATUS_IN_PROGRESS"}, opts.Values())
+
+	g, err := NewStargate(opts)
+	require.NoError(t, err)
+	r := genny.DryRunner(context.Background())
+	r.With(g)
+	require.NoError(t, r.Run())
+
+	files := make(map[string]string)
+	for _, f := range r.Results().Files {
+		files[strings.TrimPrefix(f.Name(), opts.AppPath+"/")] = f.String()
+	}
+	require.Len(t, files, 2)
+	require.Contains(t, files["proto/mars/status.proto"], `enum Status {
+  STATUS_OPEN = 0;
+  STATUS_IN_PROGRESS = 1;
+}`)
+	require.Contains(t, files["proto/mars/status.proto"], "package test.mars.mars;")
+	require.Contains(t, files["x/mars/types/status.go"], "func ParseStatus(s string) (Status, error) {")
+	require.Contains(t, files["x/mars/types/status.go"], `name = "STATUS_" + name`)
+}
@@ -0,0 +1,195 @@
+package escrow
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+const (
+	// TypeName is the name of the type storing the escrows
+	TypeName = "escrow"
+
+	// MsgCreate is the name of the message locking funds in an escrow
+	MsgCreate = "create-escrow"
+
+	// MsgRelease is the name of the message releasing the funds of an escrow to its receiver
+	MsgRelease = "release-escrow"
+
+	// MsgRefund is the name of the message refunding the funds of an escrow to its sender
+	MsgRefund = "refund-escrow"
+)
+
+// Fields of the escrow type and its messages
+var (
+	TypeFields      = []string{"sender", "receiver", "amount:coins", "timeout:uint"}
+	MsgCreateFields = []string{"receiver", "amount:coins", "timeout:uint"}
+	MsgIDFields     = []string{"id:uint"}
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// Options represents the options to scaffold the escrow logic of a module
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+}
+
+// NewStargate returns the generator adding the escrow logic to a module scaffolded with
+// the escrow type and messages.
+// The generator implements the messages and must run after the ones scaffolding them.
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(moduleModify(replacer, opts))
+	g.RunFn(genesisModuleModify(replacer, opts))
+	g.RunFn(expectedKeepersModify(replacer, opts))
+	g.RunFn(typesErrorsModify(replacer, opts))
+	g.RunFn(typesKeyModify(opts))
+
+	template := xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+// moduleModify registers the invariants and the end blocker of the module
+func moduleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const invariants = "func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}"
+		replacement := `func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}`
+		content := replacer.Replace(f.String(), invariants, replacement)
+
+		const endBlock = `func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}`
+		replacement = `func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlocker(ctx)
+	return []abci.ValidatorUpdate{}
+}`
+		content = replacer.Replace(content, endBlock, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisModuleModify queues the escrows of the genesis with a timeout
+func genesisModuleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `// Queue the escrows refunded at their timeout
+for _, elem := range genState.EscrowList {
+	k.SetEscrowTimeout(ctx, elem)
+}
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.PlaceholderGenesisModuleInit)
+		content := replacer.Replace(f.String(), typed.PlaceholderGenesisModuleInit, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// expectedKeepersModify adds the methods of the account and bank keepers used to hold the funds
+func expectedKeepersModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const accountMethods = "// Methods imported from account should be defined here"
+		template := `GetModuleAddress(name string) sdk.AccAddress
+	%[1]v`
+		replacement := fmt.Sprintf(template, accountMethods)
+		content := replacer.Replace(f.String(), accountMethods, replacement)
+
+		const bankMethods = "// Methods imported from bank should be defined here"
+		template = `GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	%[1]v`
+		replacement = fmt.Sprintf(template, bankMethods)
+		content = replacer.Replace(content, bankMethods, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesErrorsModify adds the errors of the escrow messages
+func typesErrorsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/errors.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const errSample = `ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")`
+		template := `%[1]v
+	ErrInvalidTimeout = sdkerrors.Register(ModuleName, 1101, "invalid escrow timeout")`
+		replacement := fmt.Sprintf(template, errSample)
+		content := replacer.Replace(f.String(), errSample, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesKeyModify adds the key of the escrow timeout queue
+func typesKeyModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/keys.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String() + `
+const (
+	EscrowTimeoutKey = "Escrow-timeout-"
+)
+`
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,287 @@
+package feemarket
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/message"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/query"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+// appAnteHandler is the statement of app.go setting the ante handler wrapped by the fee market
+const appAnteHandler = "app.SetAnteHandler(anteHandler)"
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// Options represents the options to scaffold the fee market logic of a module
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	Denom      string
+	MinBaseFee string
+}
+
+// NewStargate returns the generator adding the fee market logic to a new module: the base fee
+// params, the base fee adjusted by the end blocker with the gas used by the block, the ante
+// handler checking the fees of the txs and the query of the base fee
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoParamsModify(replacer, opts))
+	g.RunFn(typesParamsModify(replacer, opts))
+	g.RunFn(protoQueryModify(replacer, opts))
+	g.RunFn(cliQueryModify(replacer, opts))
+	g.RunFn(moduleModify(replacer, opts))
+	g.RunFn(genesisModuleModify(replacer, opts))
+	g.RunFn(appModify(opts))
+
+	template := xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("denom", opts.Denom)
+	ctx.Set("minBaseFee", opts.MinBaseFee)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+func protoParamsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		fieldNumber, err := message.ParamsHighestFieldNumber(path)
+		if err != nil {
+			return err
+		}
+
+		template := `string base_fee_denom = %[2]v [(gogoproto.moretags) = "yaml:\"base_fee_denom\""];
+  string min_base_fee = %[3]v [
+    (gogoproto.customtype) = "github.com/cosmos/cosmos-sdk/types.Dec",
+    (gogoproto.nullable) = false,
+    (gogoproto.moretags) = "yaml:\"min_base_fee\""
+  ];
+  uint64 base_fee_change_denominator = %[4]v [(gogoproto.moretags) = "yaml:\"base_fee_change_denominator\""];
+  uint64 elasticity_multiplier = %[5]v [(gogoproto.moretags) = "yaml:\"elasticity_multiplier\""];
+  uint64 max_block_gas = %[6]v [(gogoproto.moretags) = "yaml:\"max_block_gas\""];
+  %[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			message.PlaceholderProtoParamsField,
+			fieldNumber+1,
+			fieldNumber+2,
+			fieldNumber+3,
+			fieldNumber+4,
+			fieldNumber+5,
+		)
+		content := replacer.Replace(f.String(), message.PlaceholderProtoParamsField, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesParamsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		params := []string{"BaseFeeDenom", "MinBaseFee", "BaseFeeChangeDenominator", "ElasticityMultiplier", "MaxBlockGas"}
+
+		var pairs, validate, defaults strings.Builder
+		for _, param := range params {
+			fmt.Fprintf(&pairs, "paramtypes.NewParamSetPair(Key%[1]v, &p.%[1]v, validate%[1]v),\n", param)
+			fmt.Fprintf(&validate, `if err := validate%[1]v(p.%[1]v); err != nil {
+		return err
+	}
+	`, param)
+			fmt.Fprintf(&defaults, "params.%[1]v = Default%[1]v\n", param)
+		}
+
+		content := replacer.Replace(
+			f.String(),
+			message.PlaceholderParamsSetPair,
+			pairs.String()+message.PlaceholderParamsSetPair,
+		)
+		content = replacer.Replace(
+			content,
+			message.PlaceholderParamsValidate,
+			validate.String()+message.PlaceholderParamsValidate,
+		)
+		content = replacer.Replace(
+			content,
+			message.PlaceholderParamsDefault,
+			defaults.String()+message.PlaceholderParamsDefault,
+		)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `%[1]v
+import "cosmos/base/v1beta1/coin.proto";`
+		replacementImport := fmt.Sprintf(templateImport, query.Placeholder)
+		content := replacer.Replace(f.String(), query.Placeholder, replacementImport)
+
+		templateRPC := `// BaseFee queries the base fee of the gas.
+  rpc BaseFee(QueryBaseFeeRequest) returns (QueryBaseFeeResponse) {
+    option (google.api.http).get = "/%[2]v/%[3]v/base_fee";
+  }
+  %[1]v`
+		replacementRPC := fmt.Sprintf(
+			templateRPC,
+			query.Placeholder2,
+			gomodulepath.ExtractAppPath(opts.ModulePath),
+			opts.ModuleName,
+		)
+		content = replacer.Replace(content, query.Placeholder2, replacementRPC)
+
+		templateMessages := `// QueryBaseFeeRequest is request type for the Query/BaseFee RPC method.
+message QueryBaseFeeRequest {}
+
+// QueryBaseFeeResponse is response type for the Query/BaseFee RPC method.
+message QueryBaseFeeResponse {
+  // base_fee is the minimum price of the gas of the txs in the next block.
+  cosmos.base.v1beta1.DecCoin base_fee = 1 [(gogoproto.nullable) = false];
+}
+
+%[1]v`
+		replacementMessages := fmt.Sprintf(templateMessages, query.Placeholder3)
+		content = replacer.Replace(content, query.Placeholder3, replacementMessages)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func cliQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `cmd.AddCommand(CmdQueryBaseFee())
+%[1]v`
+		replacement := fmt.Sprintf(template, query.Placeholder)
+		content := replacer.Replace(f.String(), query.Placeholder, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// moduleModify adjusts the base fee in the end blocker of the module
+func moduleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const endBlock = `func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}`
+		replacement := `func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlocker(ctx)
+	return []abci.ValidatorUpdate{}
+}`
+		content := replacer.Replace(f.String(), endBlock, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisModuleModify starts the base fee at the minimum base fee
+func genesisModuleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `// The base fee starts at the minimum base fee
+k.SetBaseFee(ctx, sdk.NewDecCoinFromDec(genState.Params.BaseFeeDenom, genState.Params.MinBaseFee))
+%[1]v`
+		replacement := fmt.Sprintf(template, typed.PlaceholderGenesisModuleInit)
+		content := replacer.Replace(f.String(), typed.PlaceholderGenesisModuleInit, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appModify wraps the ante handler of the app with the ante handler of the fee market, the fees
+// of the txs are checked before the default ante decorators deduct them
+func appModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		if !strings.Contains(content, appAnteHandler) {
+			return fmt.Errorf(
+				"%s not found in %s, the ante handler of the app is customized or it already has a fee market",
+				appAnteHandler,
+				path,
+			)
+		}
+
+		replacement := fmt.Sprintf(
+			"app.SetAnteHandler(%[1]vmodulekeeper.NewAnteHandler(app.%[2]vKeeper, anteHandler))",
+			opts.ModuleName,
+			xstrings.Title(opts.ModuleName),
+		)
+		content = strings.Replace(content, appAnteHandler, replacement, 1)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -28,3 +28,24 @@ var (
 		NonIndex:     true,
 	}
 )
+
+var (
+	// DataCustomSlice custom type array data type definition
+	DataCustomSlice = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("[]*%s", datatype) },
+		DefaultTestValue: "[]",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("repeated %s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := make([]*types.%[3]v, 0)
+					err = json.Unmarshal([]byte(args[%[4]v]), &%[1]v%[2]v)
+    				if err != nil {
+                		return err
+            		}`, prefix, name.UpperCamel, datatype, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		NonIndex:     true,
+	}
+)
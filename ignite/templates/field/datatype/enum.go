@@ -0,0 +1,41 @@
+package datatype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+var (
+	// DataEnum enum data type definition, the datatype is the name of the proto enum
+	DataEnum = DataType{
+		DataType:         func(datatype string) string { return datatype },
+		DefaultTestValue: "0",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("%s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v, err := types.Parse%[3]v(args[%[4]v])
+            		if err != nil {
+                		return err
+            		}`, prefix, name.UpperCamel, datatype, argIndex)
+		},
+		NonIndex: true,
+	}
+)
+
+// EnumValues returns the values of an enum type name with the format enum(Value1,Value2),
+// ok is false when the type isn't an enum.
+func EnumValues(name Name) (values []string, ok bool) {
+	s := string(name)
+	if !strings.HasPrefix(s, string(Enum)+"(") || !strings.HasSuffix(s, ")") {
+		return nil, false
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, string(Enum)+"("), ")")
+	if s == "" {
+		return nil, true
+	}
+	return strings.Split(s, ","), true
+}
@@ -30,6 +30,10 @@ const (
 	Coins Name = "array.coin"
 	// Custom represents the custom type name
 	Custom Name = Name(TypeCustom)
+	// CustomSlice represents the custom type array name
+	CustomSlice Name = Name(TypeCustomSlice)
+	// Enum represents the enum type name
+	Enum Name = "enum"
 
 	// StringSliceAlias represents the string array type name alias
 	StringSliceAlias Name = "strings"
@@ -42,6 +46,11 @@ const (
 
 	// TypeCustom represents the string type name id
 	TypeCustom = "customstarporttype"
+	// TypeCustomSlice represents the custom type array name id
+	TypeCustomSlice = "customstarporttypearray"
+
+	// SlicePrefix represents the prefix of the array type names
+	SlicePrefix = "array."
 )
 
 // SupportedTypes all support data types and definitions
@@ -60,6 +69,8 @@ var SupportedTypes = map[Name]DataType{
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
 	Custom:           DataCustom,
+	CustomSlice:      DataCustomSlice,
+	Enum:             DataEnum,
 }
 
 // Name represents the Alias Name for the data type
@@ -14,6 +14,9 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+
+	// EnumValues are the values of an enum field
+	EnumValues []multiformatname.Name
 }
 
 // DataType returns the field Datatype
@@ -51,11 +51,12 @@ func (f Fields) String() string {
 	return args
 }
 
-// Custom return a list of custom fields
+// Custom return a list of the custom types and the enums of the fields
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.TypeCustom {
+		switch field.DatatypeName {
+		case datatype.TypeCustom, datatype.TypeCustomSlice, datatype.Enum:
 			dataType, err := multiformatname.NewName(field.Datatype)
 			if err != nil {
 				panic(err)
@@ -65,3 +66,14 @@ func (f Fields) Custom() []string {
 	}
 	return fields
 }
+
+// Enums return a list of enum fields
+func (f Fields) Enums() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if field.DatatypeName == datatype.Enum {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
@@ -1,6 +1,7 @@
 package field
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -63,6 +64,21 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
+		// Check if is an enum with its values
+		if values, ok := datatype.EnumValues(datatypeName); ok {
+			enumValues, err := parseEnumValues(values)
+			if err != nil {
+				return parsedFields, fmt.Errorf("invalid enum %s: %w", name.Original, err)
+			}
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     name.UpperCamel,
+				DatatypeName: datatype.Enum,
+				EnumValues:   enumValues,
+			})
+			continue
+		}
+
 		// Check if is a static type
 		if _, ok := datatype.SupportedTypes[datatypeName]; ok {
 			parsedFields = append(parsedFields, Field{
@@ -72,6 +88,16 @@ func ParseFields(
 			continue
 		}
 
+		// Check if is an array of a custom type
+		if customType := strings.TrimPrefix(string(datatypeName), datatype.SlicePrefix); customType != string(datatypeName) {
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     customType,
+				DatatypeName: datatype.TypeCustomSlice,
+			})
+			continue
+		}
+
 		parsedFields = append(parsedFields, Field{
 			Name:         name,
 			Datatype:     string(datatypeName),
@@ -80,3 +106,26 @@ func ParseFields(
 	}
 	return parsedFields, nil
 }
+
+// parseEnumValues parses the values of an enum and checks there is no duplicated value
+func parseEnumValues(values []string) ([]multiformatname.Name, error) {
+	if len(values) == 0 {
+		return nil, errors.New("an enum must have at least one value")
+	}
+	var (
+		enumValues []multiformatname.Name
+		exist      = make(map[string]struct{})
+	)
+	for _, value := range values {
+		enumValue, err := multiformatname.NewName(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := exist[enumValue.Snake]; ok {
+			return nil, fmt.Errorf("the value %s is duplicated", value)
+		}
+		exist[enumValue.Snake] = struct{}{}
+		enumValues = append(enumValues, enumValue)
+	}
+	return enumValues, nil
+}
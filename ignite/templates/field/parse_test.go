@@ -35,6 +35,14 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum()"}, noCheck)
+	require.EqualError(t, err, "invalid enum foo: an enum must have at least one value")
+
+	// duplicated enum value
+	_, err = ParseFields([]string{"foo:enum(Open,open)"}, noCheck)
+	require.EqualError(t, err, "invalid enum foo: the value open is duplicated")
 }
 
 func TestParseFields1(t *testing.T) {
@@ -46,6 +54,10 @@ func TestParseFields1(t *testing.T) {
 	require.NoError(t, err)
 	name4, err := multiformatname.NewName("foo_foo")
 	require.NoError(t, err)
+	open, err := multiformatname.NewName("Open")
+	require.NoError(t, err)
+	inProgress, err := multiformatname.NewName("InProgress")
+	require.NoError(t, err)
 
 	tests := []struct {
 		name   string
@@ -165,6 +177,26 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test custom array and enum types",
+			fields: []string{
+				name1.Original + ":array.Bla",
+				name2.Original + ":enum(Open,InProgress)",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.CustomSlice,
+					Datatype:     "Bla",
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Enum,
+					Datatype:     "FooBar",
+					EnumValues:   []multiformatname.Name{open, inProgress},
+				},
+			},
+		},
 		{
 			name: "test sdk.Coin types",
 			fields: []string{
@@ -0,0 +1,145 @@
+package ibc
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+// MiddlewareWrapTransfer is the name of the IBC transfer module wrapped by a middleware
+const MiddlewareWrapTransfer = "transfer"
+
+var (
+	//go:embed middleware/* middleware/**/*
+	fsMiddleware embed.FS
+)
+
+// MiddlewareOptions are options to scaffold an IBC middleware
+type MiddlewareOptions struct {
+	AppName        string
+	AppPath        string
+	ModulePath     string
+	MiddlewareName string
+
+	// Wrap is the name of the IBC module wrapped by the middleware, the transfer module or a
+	// module scaffolded with --ibc
+	Wrap string
+}
+
+// NewMiddleware returns the generator to scaffold an ICS-30 IBC middleware wrapping the route of
+// an IBC module of the app
+func NewMiddleware(replacer placeholder.Replacer, opts *MiddlewareOptions) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(appMiddlewareModify(replacer, opts))
+
+	template := xgenny.NewEmbedWalker(fsMiddleware, "middleware/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("middlewareName", opts.MiddlewareName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{middlewareName}}", opts.MiddlewareName))
+
+	return g, nil
+}
+
+// middlewareRouteRe matches the registration of an IBC route in the router of app.go.
+var middlewareRouteRe = regexp.MustCompile(`ibcRouter\.AddRoute\(([^,]+), (.+)\)\n`)
+
+// wrapIBCRoute wraps the IBC module registered for routeKey in app.go with the middleware, the
+// middlewares wrapped before are wrapped as well. It returns false if the route isn't registered.
+func wrapIBCRoute(content, routeKey, middlewareName string) (string, bool) {
+	wrapped := false
+	content = middlewareRouteRe.ReplaceAllStringFunc(content, func(route string) string {
+		match := middlewareRouteRe.FindStringSubmatch(route)
+		if match[1] != routeKey {
+			return route
+		}
+		wrapped = true
+		return fmt.Sprintf(
+			"ibcRouter.AddRoute(%s, %smiddleware.NewIBCMiddleware(%s, app.%sKeeper))\n",
+			routeKey,
+			middlewareName,
+			match[2],
+			xstrings.Title(middlewareName),
+		)
+	})
+	return content, wrapped
+}
+
+func appMiddlewareModify(replacer placeholder.Replacer, opts *MiddlewareOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		template := `%[2]vmiddleware "%[3]v/x/%[2]v"
+%[2]vmiddlewarekeeper "%[3]v/x/%[2]v/keeper"
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppModuleImport, opts.MiddlewareName, opts.ModulePath)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacement)
+
+		// Keeper declaration
+		template = `%[2]vKeeper %[3]vmiddlewarekeeper.Keeper
+%[1]v`
+		replacement = fmt.Sprintf(
+			template,
+			module.PlaceholderSgAppKeeperDeclaration,
+			xstrings.Title(opts.MiddlewareName),
+			opts.MiddlewareName,
+		)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, replacement)
+
+		// Keeper definition, the middleware sends the packets through the IBC channel keeper
+		template = `app.%[2]vKeeper = %[3]vmiddlewarekeeper.NewKeeper(app.IBCKeeper.ChannelKeeper)
+
+%[1]v`
+		replacement = fmt.Sprintf(
+			template,
+			module.PlaceholderSgAppKeeperDefinition,
+			xstrings.Title(opts.MiddlewareName),
+			opts.MiddlewareName,
+		)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDefinition, replacement)
+
+		// Wrap the IBC route of the module with the middleware, the middlewares scaffolded later
+		// wrap the previous ones.
+		routeKey := fmt.Sprintf("%vmoduletypes.ModuleName", opts.Wrap)
+		if opts.Wrap == MiddlewareWrapTransfer {
+			routeKey = "ibctransfertypes.ModuleName"
+		}
+		content, wrapped := wrapIBCRoute(content, routeKey, opts.MiddlewareName)
+		if !wrapped {
+			replacer.AppendMiscError(fmt.Sprintf(
+				"the IBC route of the %s module is not registered in %s, wrap it with %smiddleware.NewIBCMiddleware",
+				opts.Wrap,
+				path,
+				opts.MiddlewareName,
+			))
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
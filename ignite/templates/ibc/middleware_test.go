@@ -0,0 +1,32 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapIBCRoute(t *testing.T) {
+	const router = `	ibcRouter := ibcporttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(marsmoduletypes.ModuleName, marsModule)
+	app.IBCKeeper.SetRouter(ibcRouter)
+`
+
+	content, wrapped := wrapIBCRoute(router, "ibctransfertypes.ModuleName", "fee")
+	require.True(t, wrapped)
+	require.Equal(t, `	ibcRouter := ibcporttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, feemiddleware.NewIBCMiddleware(transferIBCModule, app.FeeKeeper))
+	ibcRouter.AddRoute(marsmoduletypes.ModuleName, marsModule)
+	app.IBCKeeper.SetRouter(ibcRouter)
+`, content)
+
+	// a middleware scaffolded later wraps the previous one.
+	content, wrapped = wrapIBCRoute(content, "ibctransfertypes.ModuleName", "forward")
+	require.True(t, wrapped)
+	require.Contains(t, content, "ibcRouter.AddRoute(ibctransfertypes.ModuleName, "+
+		"forwardmiddleware.NewIBCMiddleware(feemiddleware.NewIBCMiddleware(transferIBCModule, app.FeeKeeper), app.ForwardKeeper))\n")
+
+	_, wrapped = wrapIBCRoute(router, "venusmoduletypes.ModuleName", "fee")
+	require.False(t, wrapped)
+}
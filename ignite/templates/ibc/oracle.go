@@ -17,6 +17,7 @@ import (
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/ignite/templates/module"
 	"github.com/ignite/cli/ignite/templates/testutil"
+	"github.com/ignite/cli/ignite/templates/typed"
 )
 
 var (
@@ -32,6 +33,9 @@ type OracleOptions struct {
 	ModulePath string
 	QueryName  multiformatname.Name
 	MsgSigner  multiformatname.Name
+
+	// SignerOption annotates the signer field of the messages with the cosmos.msg.v1.signer option.
+	SignerOption bool
 }
 
 // NewOracle returns the generator to scaffold the implementation of the Oracle interface inside a module
@@ -188,6 +192,12 @@ import "%[2]v/%[3]v.proto";
 %[1]v`
 		replacementImport := fmt.Sprintf(templateImport, PlaceholderProtoTxImport, opts.ModuleName, opts.QueryName.Snake)
 		content = replacer.Replace(content, PlaceholderProtoTxImport, replacementImport)
+		if opts.SignerOption {
+			importMsg := fmt.Sprintf(`
+import "%s";`, typed.MsgProtoFile)
+			content = strings.ReplaceAll(content, importMsg, "")
+			content = replacer.Replace(content, PlaceholderProtoTxImport, PlaceholderProtoTxImport+importMsg)
+		}
 
 		// RPC
 		templateRPC := `  rpc %[2]vData(Msg%[2]vData) returns (Msg%[2]vDataResponse);
@@ -196,7 +206,7 @@ import "%[2]v/%[3]v.proto";
 		content = replacer.Replace(content, PlaceholderProtoTxRPC, replacementRPC)
 
 		templateMessage := `message Msg%[2]vData {
-  string %[3]v = 1;
+%[4]v  string %[3]v = 1;
   uint64 oracle_script_id = 2 [
     (gogoproto.customname) = "OracleScriptID",
     (gogoproto.moretags) = "yaml:\"oracle_script_id\""
@@ -221,6 +231,7 @@ message Msg%[2]vDataResponse {
 		replacementMessage := fmt.Sprintf(templateMessage, PlaceholderProtoTxMessage,
 			opts.QueryName.UpperCamel,
 			opts.MsgSigner.LowerCamel,
+			typed.ProtoMsgSignerOption(opts.SignerOption, opts.MsgSigner),
 		)
 		content = replacer.Replace(content, PlaceholderProtoTxMessage, replacementMessage)
 
@@ -18,6 +18,7 @@ import (
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/ignite/templates/module"
 	"github.com/ignite/cli/ignite/templates/testutil"
+	"github.com/ignite/cli/ignite/templates/typed"
 )
 
 var (
@@ -26,6 +27,12 @@ var (
 
 	//go:embed packet/messages/* packet/messages/**/*
 	fsPacketMessages embed.FS
+
+	//go:embed packet/call/* packet/call/**/*
+	fsPacketCall embed.FS
+
+	//go:embed packet/refund/* packet/refund/**/*
+	fsPacketRefund embed.FS
 )
 
 // PacketOptions are options to scaffold a packet in a IBC module
@@ -39,6 +46,14 @@ type PacketOptions struct {
 	Fields     field.Fields
 	AckFields  field.Fields
 	NoMessage  bool
+	Call       bool
+
+	// Refund escrows the coins of the coin fields of a call packet when it's sent and refunds them
+	// to the signer of the send message when the call fails or times out.
+	Refund bool
+
+	// SignerOption annotates the signer field of the messages with the cosmos.msg.v1.signer option.
+	SignerOption bool
 }
 
 // NewPacket returns the generator to scaffold a packet in an IBC module
@@ -56,6 +71,16 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 			"packet/component/",
 			opts.AppPath,
 		)
+		callTemplate = xgenny.NewEmbedWalker(
+			fsPacketCall,
+			"packet/call/",
+			opts.AppPath,
+		)
+		refundTemplate = xgenny.NewEmbedWalker(
+			fsPacketRefund,
+			"packet/refund/",
+			opts.AppPath,
+		)
 	)
 
 	// Add the component
@@ -66,6 +91,21 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 		return g, err
 	}
 
+	// Add the pending calls and their compensation
+	if opts.Call {
+		if err := g.Box(callTemplate); err != nil {
+			return g, err
+		}
+	}
+
+	// Add the escrow of the coins of the packet and their refund
+	if opts.Refund {
+		g.RunFn(refundExpectedKeepersModify(replacer, opts))
+		if err := g.Box(refundTemplate); err != nil {
+			return g, err
+		}
+	}
+
 	// Add the send message
 	if !opts.NoMessage {
 		g.RunFn(protoTxModify(replacer, opts))
@@ -85,6 +125,9 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("fields", opts.Fields)
 	ctx.Set("ackFields", opts.AckFields)
+	ctx.Set("call", opts.Call)
+	ctx.Set("refund", opts.Refund)
+	ctx.Set("refundFields", opts.RefundFields())
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
@@ -99,6 +142,18 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 	return g, nil
 }
 
+// RefundFields returns the coin fields of the packet, their coins are escrowed when the packet is sent
+// and refunded when it fails or times out.
+func (opts *PacketOptions) RefundFields() field.Fields {
+	var fields field.Fields
+	for _, f := range opts.Fields {
+		if f.DataType() == "sdk.Coin" || f.DataType() == "sdk.Coins" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 func moduleModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module_ibc.go")
@@ -294,6 +349,9 @@ func protoTxModify(replacer placeholder.Replacer, opts *PacketOptions) genny.Run
 
 		// Ensure custom types are imported
 		protoImports := opts.Fields.ProtoImports()
+		if opts.SignerOption {
+			protoImports = append(protoImports, typed.MsgProtoFile)
+		}
 		for _, f := range opts.Fields.Custom() {
 			protoImports = append(protoImports,
 				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
@@ -313,7 +371,7 @@ import "%[1]v";`, f)
 		// This addition would include using the type ibc.core.client.v1.Height
 		// Ex: https://github.com/cosmos/cosmos-sdk/blob/816306b85addae6350bd380997f2f4bf9dce9471/proto/ibc/applications/transfer/v1/tx.proto
 		templateMessage := `message MsgSend%[2]v {
-  string %[3]v = 1;
+%[5]v  string %[3]v = 1;
   string port = 2;
   string channelID = 3;
   uint64 timeoutTimestamp = 4;
@@ -328,6 +386,7 @@ message MsgSend%[2]vResponse {
 			opts.PacketName.UpperCamel,
 			opts.MsgSigner.LowerCamel,
 			sendFields,
+			typed.ProtoMsgSignerOption(opts.SignerOption, opts.MsgSigner),
 		)
 		content = replacer.Replace(content, PlaceholderProtoTxMessage, replacementMessage)
 
@@ -413,3 +472,32 @@ func codecModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunFn
 		return r.File(newFile)
 	}
 }
+
+// refundExpectedKeepersModify adds the methods of the bank keeper used to escrow and refund the coins
+// of the packet, unless they're already defined.
+func refundExpectedKeepersModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const bankMethods = "// Methods imported from bank should be defined here"
+		content := f.String()
+		for _, method := range []string{
+			"SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error",
+			"SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error",
+		} {
+			name := method[:strings.Index(method, "(")]
+			if strings.Contains(content, name+"(") {
+				continue
+			}
+			replacement := fmt.Sprintf("%[1]v\n\t%[2]v", method, bankMethods)
+			content = replacer.Replace(content, bankMethods, replacement)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,92 @@
+package ibc
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+)
+
+func TestPacketRefund(t *testing.T) {
+	fields, err := field.ParseFields([]string{"name", "bid:coin", "fees:coins", "amount:uint"}, func(string) error { return nil })
+	require.NoError(t, err)
+	packetName, err := multiformatname.NewName("buyName")
+	require.NoError(t, err)
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	opts := &PacketOptions{
+		ModuleName: "dex",
+		ModulePath: "github.com/owner/mars",
+		PacketName: packetName,
+		MsgSigner:  signer,
+		Fields:     fields,
+		Call:       true,
+		Refund:     true,
+	}
+	refundFields := opts.RefundFields()
+	require.Len(t, refundFields, 2)
+	require.Equal(t, "bid", refundFields[0].Name.LowerCamel)
+	require.Equal(t, "fees", refundFields[1].Name.LowerCamel)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("packetName", opts.PacketName)
+	ctx.Set("MsgSigner", opts.MsgSigner)
+	ctx.Set("fields", opts.Fields)
+	ctx.Set("ackFields", opts.AckFields)
+	ctx.Set("call", opts.Call)
+	ctx.Set("refund", opts.Refund)
+	ctx.Set("refundFields", refundFields)
+	plushhelpers.ExtendPlushContext(ctx)
+
+	// the generated code of the refund, the call and the send message is valid Go.
+	rendered := make(map[string]string)
+	for _, fsys := range []fs.FS{fsPacketRefund, fsPacketCall, fsPacketComponent, fsPacketMessages} {
+		err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go.plush") {
+				return err
+			}
+			tpl, err := fs.ReadFile(fsys, path)
+			if err != nil {
+				return err
+			}
+			content, err := plush.Render(string(tpl), ctx)
+			if err != nil {
+				return err
+			}
+			_, err = parser.ParseFile(token.NewFileSet(), path, content, parser.AllErrors)
+			require.NoError(t, err, path)
+			rendered[path] = content
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	refund := rendered["packet/refund/x/{{moduleName}}/types/refund_{{packetName}}.go.plush"]
+	require.Contains(t, refund, "coins = coins.Add(p.Bid)\n")
+	require.Contains(t, refund, "coins = coins.Add(p.Fees...)\n")
+	require.NotContains(t, refund, "p.Amount")
+
+	refundTest := rendered["packet/refund/x/{{moduleName}}/types/refund_{{packetName}}_test.go.plush"]
+	require.Contains(t, refundTest, `sdk.NewCoins(sdk.NewInt64Coin("token", 20))`)
+
+	msgServer := rendered["packet/messages/x/{{moduleName}}/keeper/msg_server_{{packetName}}.go.plush"]
+	require.Contains(t, msgServer, "k.EscrowBuyName(ctx, sender, msg.Port, msg.ChannelID, packet)")
+	require.Contains(t, msgServer, "addresscodec.FromString(msg.Creator)")
+
+	call := rendered["packet/call/x/{{moduleName}}/keeper/{{packetName}}_call.go.plush"]
+	require.Contains(t, call, "k.refundBuyName(ctx, packet.SourceChannel, packet.Sequence, data)")
+
+	component := rendered["packet/component/x/{{moduleName}}/keeper/{{packetName}}.go.plush"]
+	require.Contains(t, component, "k.releaseBuyName(ctx, packet.SourceChannel, packet.Sequence)")
+}
@@ -0,0 +1,361 @@
+package icq
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/message"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+const (
+	// PlaceholderCallback is the placeholder of the callbacks of the query types in keeper/icq.go
+	PlaceholderCallback = "// this line is used by starport scaffolding # icq/callback"
+
+	// PlaceholderHostAllowlist is the placeholder of the allowlist of the host stores in types/icq_host.go
+	PlaceholderHostAllowlist = "// this line is used by starport scaffolding # icq/host/allowlist"
+)
+
+var (
+	//go:embed stargate/support/* stargate/support/**/*
+	fsStargateSupport embed.FS
+
+	//go:embed stargate/query/* stargate/query/**/*
+	fsStargateQuery embed.FS
+)
+
+// SupportOptions represents the options to scaffold the interchain queries support of a module
+type SupportOptions struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+}
+
+// Options represents the options to scaffold an interchain query type
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	QueryName  multiformatname.Name
+	Store      string
+}
+
+// NewStargateSupport returns the generator adding the interchain queries support to a module: the
+// pending queries, the message submitting their responses with proofs verified against the IBC
+// clients of the host chains, the allowlist of the host stores and the mocks testing the queries
+func NewStargateSupport(replacer placeholder.Replacer, opts *SupportOptions) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoTxSupportModify(replacer, opts))
+	g.RunFn(handlerSupportModify(replacer, opts))
+	g.RunFn(codecSupportModify(replacer, opts))
+	g.RunFn(expectedKeepersSupportModify(opts))
+	g.RunFn(keeperSupportModify(opts))
+	g.RunFn(appSupportModify(opts))
+
+	template := xgenny.NewEmbedWalker(fsStargateSupport, "stargate/support/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+// NewStargate returns the generator adding an interchain query type to a module: the request of
+// the queries of the type, their callback and the store of the host chains added to the allowlist
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(callbackModify(replacer, opts))
+	g.RunFn(hostAllowlistModify(replacer, opts))
+
+	template := xgenny.NewEmbedWalker(fsStargateQuery, "stargate/query/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("queryName", opts.QueryName)
+	ctx.Set("store", opts.Store)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{queryName}}", opts.QueryName.Snake))
+
+	return g, nil
+}
+
+func protoTxSupportModify(replacer placeholder.Replacer, opts *SupportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateImport := `import "tendermint/crypto/proof.proto";
+%[1]v`
+		replacementImport := fmt.Sprintf(templateImport, typed.PlaceholderProtoTxImport)
+		content := replacer.Replace(f.String(), typed.PlaceholderProtoTxImport, replacementImport)
+
+		templateRPC := `  rpc SubmitICQResponse(MsgSubmitICQResponse) returns (MsgSubmitICQResponseResponse);
+%[1]v`
+		replacementRPC := fmt.Sprintf(templateRPC, message.PlaceholderProtoTxRPC)
+		content = replacer.Replace(content, message.PlaceholderProtoTxRPC, replacementRPC)
+
+		templateMessage := `// MsgSubmitICQResponse submits the value of the key of an interchain query with its proof
+// at height on the host chain, an empty value is proven absent.
+message MsgSubmitICQResponse {
+  string submitter = 1;
+  uint64 query_id = 2;
+  bytes value = 3;
+  tendermint.crypto.ProofOps proof_ops = 4;
+  int64 height = 5;
+}
+
+message MsgSubmitICQResponseResponse {
+}
+
+%[1]v`
+		replacementMessage := fmt.Sprintf(templateMessage, message.PlaceholderProtoTxMessage)
+		content = replacer.Replace(content, message.PlaceholderProtoTxMessage, replacementMessage)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func handlerSupportModify(replacer placeholder.Replacer, opts *SupportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "handler.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Set once the MsgServer definition if it is not defined yet
+		replacementMsgServer := `msgServer := keeper.NewMsgServerImpl(k)`
+		content := replacer.ReplaceOnce(f.String(), message.PlaceholderHandlerMsgServer, replacementMsgServer)
+
+		templateHandlers := `case *types.MsgSubmitICQResponse:
+					res, err := msgServer.SubmitICQResponse(sdk.WrapSDKContext(ctx), msg)
+					return sdk.WrapServiceResult(ctx, res, err)
+%[1]v`
+		replacementHandlers := fmt.Sprintf(templateHandlers, message.Placeholder)
+		content = replacer.Replace(content, message.Placeholder, replacementHandlers)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func codecSupportModify(replacer placeholder.Replacer, opts *SupportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		replacementImport := `sdk "github.com/cosmos/cosmos-sdk/types"`
+		content := replacer.ReplaceOnce(f.String(), message.Placeholder, replacementImport)
+
+		templateRegisterConcrete := `cdc.RegisterConcrete(&MsgSubmitICQResponse{}, "%[2]v/SubmitICQResponse", nil)
+%[1]v`
+		replacementRegisterConcrete := fmt.Sprintf(templateRegisterConcrete, message.Placeholder2, opts.ModuleName)
+		content = replacer.Replace(content, message.Placeholder2, replacementRegisterConcrete)
+
+		templateRegisterImplementations := `registry.RegisterImplementations((*sdk.Msg)(nil),
+	&MsgSubmitICQResponse{},
+)
+%[1]v`
+		replacementRegisterImplementations := fmt.Sprintf(templateRegisterImplementations, message.Placeholder3)
+		content = replacer.Replace(content, message.Placeholder3, replacementRegisterImplementations)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// expectedKeepersSupportModify defines the IBC keepers verifying the proofs of the interchain queries
+func expectedKeepersSupportModify(opts *SupportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		if !strings.Contains(content, "import (") {
+			return fmt.Errorf("%s has no import block", path)
+		}
+		imports := `connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"`
+		if !strings.Contains(content, `sdk "github.com/cosmos/cosmos-sdk/types"`) {
+			imports = fmt.Sprintf("sdk \"github.com/cosmos/cosmos-sdk/types\"\n%s", imports)
+		}
+		content = strings.Replace(content, "import (", fmt.Sprintf("import (\n%s", imports), 1)
+
+		content += `
+// ICQClientKeeper defines the expected IBC client keeper verifying the proofs of the interchain queries.
+type ICQClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool)
+}
+
+// ICQConnectionKeeper defines the expected IBC connection keeper returning the clients of the host chains.
+type ICQConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
+`
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperSupportModify adds the IBC keepers of the interchain queries to the keeper of the module
+func keeperSupportModify(opts *SupportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		const keeperStruct = "Keeper struct {"
+		if !strings.Contains(content, keeperStruct) {
+			return fmt.Errorf("%s has no Keeper struct", path)
+		}
+		content = strings.Replace(
+			content,
+			keeperStruct,
+			keeperStruct+`
+icqClientKeeper types.ICQClientKeeper
+icqConnectionKeeper types.ICQConnectionKeeper`,
+			1,
+		)
+
+		content += `
+// SetICQKeepers sets the IBC keepers verifying the proofs of the interchain queries.
+func (k *Keeper) SetICQKeepers(clientKeeper types.ICQClientKeeper, connectionKeeper types.ICQConnectionKeeper) {
+	k.icqClientKeeper = clientKeeper
+	k.icqConnectionKeeper = connectionKeeper
+}
+`
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appSupportModify sets the IBC keepers of the interchain queries of the module.
+// The keepers are set before the app module is created since it holds a copy of the keeper.
+func appSupportModify(opts *SupportOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		appModule := fmt.Sprintf("%[1]vModule := %[1]vmodule.NewAppModule(", opts.ModuleName)
+		appModuleIndex := strings.Index(content, appModule)
+		if appModuleIndex == -1 {
+			return fmt.Errorf("the app module of %s is not created in %s", opts.ModuleName, path)
+		}
+
+		ibcKeeperIndex := strings.Index(content, "app.IBCKeeper = ")
+		if ibcKeeperIndex == -1 || ibcKeeperIndex > appModuleIndex {
+			return fmt.Errorf("the IBC keeper must be defined before the app module of %s in %s", opts.ModuleName, path)
+		}
+
+		setter := fmt.Sprintf(
+			"app.%sKeeper.SetICQKeepers(app.IBCKeeper.ClientKeeper, app.IBCKeeper.ConnectionKeeper)",
+			xstrings.Title(opts.ModuleName),
+		)
+		content = strings.Replace(content, appModule, fmt.Sprintf("%s\n%s", setter, appModule), 1)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// callbackModify passes the responses of the queries of the type to their callback
+func callbackModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/icq.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `%[1]v
+	case types.%[2]vICQueryType:
+		return k.on%[2]vICQueryResponse(ctx, query, value)`
+		replacement := fmt.Sprintf(template, PlaceholderCallback, opts.QueryName.UpperCamel)
+		content := replacer.Replace(f.String(), PlaceholderCallback, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// hostAllowlistModify allows the queries of the store of the host chains queried by the type
+func hostAllowlistModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/icq_host.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		entry := fmt.Sprintf("{Store: %q},", opts.Store)
+		if strings.Contains(content, entry) {
+			return r.File(f)
+		}
+
+		template := `%[2]v
+	%[1]v`
+		replacement := fmt.Sprintf(template, PlaceholderHostAllowlist, entry)
+		content = replacer.Replace(content, PlaceholderHostAllowlist, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
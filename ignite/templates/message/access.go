@@ -0,0 +1,109 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+// ProtoParamsMessage is the name of the proto message defining the module params
+const ProtoParamsMessage = "Params"
+
+// NewStargateAccess returns the generator to scaffold the access control of a Stargate module.
+// The generator adds the admin and allowlist params to the module and the message to transfer
+// the admin role, the message name and fields are expected in the options.
+func NewStargateAccess(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(handlerModify(replacer, opts))
+	g.RunFn(protoTxRPCModify(replacer, opts))
+	g.RunFn(protoTxMessageModify(replacer, opts))
+	g.RunFn(typesCodecModify(replacer, opts))
+	g.RunFn(clientCliTxModify(replacer, opts))
+	g.RunFn(protoParamsModify(replacer, opts))
+	g.RunFn(typesParamsModify(replacer, opts))
+
+	template := xgenny.NewEmbedWalker(
+		fsStargateAccess,
+		"stargate/access",
+		opts.AppPath,
+	)
+	return g, Box(template, opts, g)
+}
+
+func protoParamsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		fieldNumber, err := ParamsHighestFieldNumber(path)
+		if err != nil {
+			return err
+		}
+
+		template := `string admin = %[2]v [(gogoproto.moretags) = "yaml:\"admin\""];
+  repeated string allowlist = %[3]v [(gogoproto.moretags) = "yaml:\"allowlist\""];
+  %[1]v`
+		replacement := fmt.Sprintf(template, PlaceholderProtoParamsField, fieldNumber+1, fieldNumber+2)
+		content := replacer.Replace(f.String(), PlaceholderProtoParamsField, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesParamsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templatePairs := `paramtypes.NewParamSetPair(KeyAdmin, &p.Admin, validateAdmin),
+		paramtypes.NewParamSetPair(KeyAllowlist, &p.Allowlist, validateAllowlist),
+		%[1]v`
+		replacementPairs := fmt.Sprintf(templatePairs, PlaceholderParamsSetPair)
+		content := replacer.Replace(f.String(), PlaceholderParamsSetPair, replacementPairs)
+
+		templateValidate := `if err := validateAdmin(p.Admin); err != nil {
+		return err
+	}
+	if err := validateAllowlist(p.Allowlist); err != nil {
+		return err
+	}
+	%[1]v`
+		replacementValidate := fmt.Sprintf(templateValidate, PlaceholderParamsValidate)
+		content = replacer.Replace(content, PlaceholderParamsValidate, replacementValidate)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// ParamsHighestFieldNumber returns the highest field number in the Params proto message
+// This function is used to define the number of the params fields added to the message
+func ParamsHighestFieldNumber(path string) (int, error) {
+	pkgs, err := protoanalysis.Parse(context.Background(), nil, path)
+	if err != nil {
+		return 0, err
+	}
+	if len(pkgs) == 0 {
+		return 0, fmt.Errorf("%s is not a proto file", path)
+	}
+	m, err := pkgs[0].MessageByName(ProtoParamsMessage)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.HighestFieldNumber, nil
+}
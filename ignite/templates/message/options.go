@@ -17,8 +17,21 @@ type Options struct {
 	Fields       field.Fields
 	ResFields    field.Fields
 	NoSimulation bool
+	Permission   string
+
+	// SignerOption annotates the signer field of the messages with the cosmos.msg.v1.signer option.
+	SignerOption bool
 }
 
+// Permissions restricting the accounts allowed to send a message
+const (
+	// PermissionAdmin restricts a message to the module admin
+	PermissionAdmin = "admin"
+
+	// PermissionAllowlist restricts a message to the accounts of the module allowlist
+	PermissionAllowlist = "allowlist"
+)
+
 // Validate that options are usuable
 func (opts *Options) Validate() error {
 	return nil
@@ -9,4 +9,9 @@ const (
 	PlaceholderProtoTxMessage = "// this line is used by starport scaffolding # proto/tx/message"
 
 	PlaceholderHandlerMsgServer = "// this line is used by starport scaffolding # handler/msgServer"
+
+	PlaceholderProtoParamsField = "// this line is used by starport scaffolding # proto/params/field"
+	PlaceholderParamsSetPair    = "// this line is used by starport scaffolding # params/setPair"
+	PlaceholderParamsValidate   = "// this line is used by starport scaffolding # params/validate"
+	PlaceholderParamsDefault    = "// this line is used by starport scaffolding # params/default"
 )
@@ -104,7 +104,7 @@ func protoTxMessageModify(replacer placeholder.Replacer, opts *Options) genny.Ru
 		}
 
 		template := `message Msg%[2]v {
-  string %[5]v = 1;
+%[6]v  string %[5]v = 1;
 %[3]v}
 
 message Msg%[2]vResponse {
@@ -117,11 +117,15 @@ message Msg%[2]vResponse {
 			msgFields,
 			resFields,
 			opts.MsgSigner.LowerCamel,
+			typed.ProtoMsgSignerOption(opts.SignerOption, opts.MsgSigner),
 		)
 		content := replacer.Replace(f.String(), PlaceholderProtoTxMessage, replacement)
 
 		// Ensure custom types are imported
 		protoImports := append(opts.ResFields.ProtoImports(), opts.Fields.ProtoImports()...)
+		if opts.SignerOption {
+			protoImports = append(protoImports, typed.MsgProtoFile)
+		}
 		customFields := append(opts.ResFields.Custom(), opts.Fields.Custom()...)
 		for _, f := range customFields {
 			protoImports = append(protoImports,
@@ -40,6 +40,8 @@ func NewIBC(replacer placeholder.Replacer, opts *CreateOptions) (*genny.Generato
 	ctx.Set("modulePath", opts.ModulePath)
 	ctx.Set("appName", opts.AppName)
 	ctx.Set("ibcOrdering", opts.IBCOrdering)
+	ctx.Set("ibcVersions", opts.IBCVersions)
+	ctx.Set("ibcUpgradable", opts.IBCUpgradable)
 	ctx.Set("dependencies", opts.Dependencies)
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
 
@@ -148,12 +150,12 @@ func keysModify(replacer placeholder.Replacer, opts *CreateOptions) genny.RunFn
 		}
 
 		// Append version and the port ID in keys
-		templateName := `// Version defines the current version the IBC module supports
-Version = "%[1]v-1"
+		templateName := `// Version defines the version of the IBC channel proposed by the module
+Version = "%[2]v"
 
 // PortID is the default port id that module binds to
 PortID = "%[1]v"`
-		replacementName := fmt.Sprintf(templateName, opts.ModuleName)
+		replacementName := fmt.Sprintf(templateName, opts.ModuleName, opts.IBCVersions[0])
 		content := replacer.Replace(f.String(), module.PlaceholderIBCKeysName, replacementName)
 
 		// PlaceholderIBCKeysPort
@@ -21,8 +21,23 @@ type CreateOptions struct {
 	// Channel ordering of the IBC module: ordered, unordered or none
 	IBCOrdering string
 
+	// IBCVersions are the versions of the IBC channel supported by the module, by order of preference.
+	// The first one is proposed when the module opens a channel.
+	IBCVersions []string
+
+	// True if the module should implement the channel upgradability callbacks
+	IBCUpgradable bool
+
 	// Dependencies of the module
 	Dependencies []Dependency
+
+	// ModuleAccountPerms are the permissions of the module account of a module depending on bank.
+	// The module account has the minter, burner and staking permissions when nil.
+	ModuleAccountPerms []string
+
+	// Feature is the build tag required to include the module in the app.
+	// The module is always included when empty.
+	Feature string
 }
 
 // MsgServerOptions defines options to add MsgServer
@@ -38,6 +53,16 @@ func (opts *CreateOptions) Validate() error {
 	return nil
 }
 
+// HasDependency checks if the module depends on the module with the given name
+func (opts *CreateOptions) HasDependency(name string) bool {
+	for _, dep := range opts.Dependencies {
+		if dep.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Dependency represents a module dependency of a module
 type Dependency struct {
 	Name       string
@@ -3,6 +3,7 @@ package modulecreate
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush"
@@ -47,6 +48,16 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	if err := g.Box(stargateTemplate); err != nil {
 		return g, err
 	}
+	if opts.Feature != "" {
+		featureTemplate := xgenny.NewEmbedWalker(
+			fsFeature,
+			"feature/",
+			opts.AppPath,
+		)
+		if err := g.Box(featureTemplate); err != nil {
+			return g, err
+		}
+	}
 
 	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
 
@@ -57,6 +68,9 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	ctx.Set("dependencies", opts.Dependencies)
 	ctx.Set("params", opts.Params)
 	ctx.Set("isIBC", opts.IsIBC)
+	ctx.Set("feature", opts.Feature)
+	ctx.Set("hasBankDependency", opts.HasDependency("bank"))
+	ctx.Set("moduleAccountPerms", moduleAccountPermsValue(opts.ModuleAccountPerms))
 	ctx.Set("apiPath", fmt.Sprintf("/%s/%s", appModulePath, opts.ModuleName))
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
 
@@ -76,6 +90,10 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 // NewStargateAppModify returns generator with modifications required to register a module in the app.
 func NewStargateAppModify(replacer placeholder.Replacer, opts *CreateOptions) *genny.Generator {
 	g := genny.New()
+	if opts.Feature != "" {
+		g.RunFn(appModifyFeature(replacer, opts))
+		return g
+	}
 	g.RunFn(appModifyStargate(replacer, opts))
 	if opts.IsIBC {
 		g.RunFn(appIBCModify(replacer, opts))
@@ -138,13 +156,14 @@ func appModifyStargate(replacer placeholder.Replacer, opts *CreateOptions) genny
 
 			// If bank is a dependency, add account permissions to the module
 			if dep.Name == "bank" {
-				template = `%[2]vmoduletypes.ModuleName: {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+				template = `%[2]vmoduletypes.ModuleName: %[3]v,
 %[1]v`
 
 				replacement = fmt.Sprintf(
 					template,
 					module.PlaceholderSgAppMaccPerms,
 					opts.ModuleName,
+					moduleAccountPerms(opts.ModuleAccountPerms),
 				)
 				content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, replacement)
 			}
@@ -207,3 +226,55 @@ func appModifyStargate(replacer placeholder.Replacer, opts *CreateOptions) genny
 		return r.File(newFile)
 	}
 }
+
+// moduleAccountPerms returns the declaration of the module account permissions in app.go.
+func moduleAccountPerms(perms []string) string {
+	if perms == nil {
+		return "{authtypes.Minter, authtypes.Burner, authtypes.Staking}"
+	}
+	if len(perms) == 0 {
+		return "nil"
+	}
+	decl := make([]string, len(perms))
+	for i, perm := range perms {
+		decl[i] = fmt.Sprintf("authtypes.%s", perm)
+	}
+	return fmt.Sprintf("{%s}", strings.Join(decl, ", "))
+}
+
+// moduleAccountPermsValue returns the value of the module account permissions assigned to maccPerms.
+func moduleAccountPermsValue(perms []string) string {
+	decl := moduleAccountPerms(perms)
+	if decl == "nil" {
+		return decl
+	}
+	return "[]string" + decl
+}
+
+// app.go modification on Stargate when creating a module included with a build tag.
+// The module is registered by the app/feature_{moduleName}.go files, app.go only
+// references declarations existing whether the build tag is set or not.
+func appModifyFeature(replacer placeholder.Replacer, opts *CreateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Keeper declaration
+		template := `%[2]vFeature
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppKeeperDeclaration, opts.ModuleName)
+		content := replacer.Replace(f.String(), module.PlaceholderSgAppKeeperDeclaration, replacement)
+
+		// Module registration
+		template = `app.register%[2]vFeature()
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppFeatureModule, xstrings.Title(opts.ModuleName))
+		content = replacer.Replace(content, module.PlaceholderSgAppFeatureModule, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
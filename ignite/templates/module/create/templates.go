@@ -19,4 +19,7 @@ var (
 
 	//go:embed simapp/* simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed feature/* feature/**/*
+	fsFeature embed.FS
 )
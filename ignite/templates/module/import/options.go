@@ -4,6 +4,7 @@ package moduleimport
 type ImportOptions struct {
 	AppName          string
 	AppPath          string
+	ModulePath       string
 	Feature          string
 	BinaryNamePrefix string
 }
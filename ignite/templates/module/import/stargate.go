@@ -1,26 +1,46 @@
 package moduleimport
 
 import (
+	"embed"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
 
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/ignite/templates/module"
 )
 
+// govKeeperDefinition is the definition of the gov keeper in app.go. The wasm keeper is defined
+// before because the gov keeper seals the router of the gov proposals.
+const govKeeperDefinition = "app.GovKeeper = govkeeper.NewKeeper("
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+)
+
 // NewStargate returns the generator to scaffold code to import wasm module inside a Stargate app
 func NewStargate(replacer placeholder.Replacer, opts *ImportOptions) (*genny.Generator, error) {
 	g := genny.New()
 	g.RunFn(appModifyStargate(replacer, opts))
 	g.RunFn(cmdModifyStargate(replacer, opts))
 
+	template := xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
 	ctx := plush.NewContext()
 	ctx.Set("AppName", opts.AppName)
+	ctx.Set("ModulePath", opts.ModulePath)
 	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
 
 	return g, nil
 }
@@ -34,23 +54,42 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 			return err
 		}
 
-		templateImport := `%[1]v
-		"github.com/tendermint/spm-extras/wasmcmd"
-		"github.com/CosmWasm/wasmd/x/wasm"
-		wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"`
+		templateImport := `"github.com/CosmWasm/wasmd/x/wasm"
+		wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
+%[1]v`
+		if !strings.Contains(f.String(), `"strings"`) {
+			templateImport = `"strings"
+` + templateImport
+		}
 		replacementImport := fmt.Sprintf(templateImport, module.PlaceholderSgAppModuleImport)
 		content := replacer.Replace(f.String(), module.PlaceholderSgAppModuleImport, replacementImport)
 
 		templateEnabledProposals := `var (
-			// If EnabledSpecificProposals is "", and this is "true", then enable all x/wasm proposals.
-			// If EnabledSpecificProposals is "", and this is not "true", then disable all x/wasm proposals.
-			ProposalsEnabled = "false"
-			// If set to non-empty string it must be comma-separated list of values that are all a subset
-			// of "EnableAllProposals" (takes precedence over ProposalsEnabled)
-			// https://github.com/CosmWasm/wasmd/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
-			EnableSpecificProposals = ""
-		)
-		`
+	// If EnableSpecificProposals is "", and this is "true", then enable all x/wasm proposals.
+	// If EnableSpecificProposals is "", and this is not "true", then disable all x/wasm proposals.
+	ProposalsEnabled = "false"
+	// If set to non-empty string it must be comma-separated list of values that are all a subset
+	// of "EnableAllProposals" (takes precedence over ProposalsEnabled)
+	// https://github.com/CosmWasm/wasmd/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
+	EnableSpecificProposals = ""
+)
+
+// GetEnabledProposals parses the ProposalsEnabled / EnableSpecificProposals values to
+// produce a list of enabled proposals of the wasm module.
+func GetEnabledProposals() []wasm.ProposalType {
+	if EnableSpecificProposals == "" {
+		if ProposalsEnabled == "true" {
+			return wasm.EnableAllProposals
+		}
+		return wasm.DisableAllProposals
+	}
+	chunks := strings.Split(EnableSpecificProposals, ",")
+	proposals, err := wasm.ConvertToProposals(chunks)
+	if err != nil {
+		panic(err)
+	}
+	return proposals
+}`
 		content = replacer.Replace(content, module.PlaceholderSgWasmAppEnabledProposals, templateEnabledProposals)
 
 		templateGovProposalHandlers := `%[1]v
@@ -58,85 +97,98 @@ func appModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 		replacementProposalHandlers := fmt.Sprintf(templateGovProposalHandlers, module.PlaceholderSgAppGovProposalHandlers)
 		content = replacer.Replace(content, module.PlaceholderSgAppGovProposalHandlers, replacementProposalHandlers)
 
-		templateModuleBasic := `%[1]v
-		wasm.AppModuleBasic{},`
+		templateModuleBasic := `wasm.AppModuleBasic{},
+%[1]v`
 		replacementModuleBasic := fmt.Sprintf(templateModuleBasic, module.PlaceholderSgAppModuleBasic)
 		content = replacer.Replace(content, module.PlaceholderSgAppModuleBasic, replacementModuleBasic)
 
-		templateKeeperDeclaration := `%[1]v
-		wasmKeeper       wasm.Keeper
-		scopedWasmKeeper capabilitykeeper.ScopedKeeper
-		`
+		templateMaccPerms := `wasm.ModuleName: {authtypes.Burner},
+%[1]v`
+		replacementMaccPerms := fmt.Sprintf(templateMaccPerms, module.PlaceholderSgAppMaccPerms)
+		content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, replacementMaccPerms)
+
+		templateKeeperDeclaration := `WasmKeeper       wasm.Keeper
+		ScopedWasmKeeper capabilitykeeper.ScopedKeeper
+%[1]v`
 		replacementKeeperDeclaration := fmt.Sprintf(templateKeeperDeclaration, module.PlaceholderSgAppKeeperDeclaration)
 		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, replacementKeeperDeclaration)
 
-		templateDeclaration := `%[1]v
-		scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
-		`
-		replacementDeclaration := fmt.Sprintf(templateDeclaration, module.PlaceholderSgAppScopedKeeper)
-		content = replacer.Replace(content, module.PlaceholderSgAppScopedKeeper, replacementDeclaration)
-
-		templateDeclaration = `%[1]v
-		app.scopedWasmKeeper = scopedWasmKeeper
-		`
-		replacementDeclaration = fmt.Sprintf(templateDeclaration, module.PlaceholderSgAppBeforeInitReturn)
-		content = replacer.Replace(content, module.PlaceholderSgAppBeforeInitReturn, replacementDeclaration)
-
-		templateStoreKey := `%[1]v
-		wasm.StoreKey,`
+		templateStoreKey := `wasm.StoreKey,
+%[1]v`
 		replacementStoreKey := fmt.Sprintf(templateStoreKey, module.PlaceholderSgAppStoreKey)
 		content = replacer.Replace(content, module.PlaceholderSgAppStoreKey, replacementStoreKey)
 
-		templateKeeperDefinition := `%[1]v
-		wasmDir := filepath.Join(homePath, "wasm")
-	
-		wasmConfig, err := wasm.ReadWasmConfig(appOpts)
-		if err != nil {
-			panic("error while reading wasm config: " + err.Error())
-		}
+		templateScopedKeeper := `scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
+%[1]v`
+		replacementScopedKeeper := fmt.Sprintf(templateScopedKeeper, module.PlaceholderSgAppScopedKeeper)
+		content = replacer.Replace(content, module.PlaceholderSgAppScopedKeeper, replacementScopedKeeper)
+
+		// the wasm keeper is defined before the gov keeper to add the route of its proposals
+		templateKeeperDefinition := `wasmDir := filepath.Join(homePath, "wasm")
+	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+	if err != nil {
+		panic("error while reading wasm config: " + err.Error())
+	}
 
-		// The last arguments can contain custom message handlers, and custom query handlers,
-		// if we want to allow any custom callbacks
-		supportedFeatures := "staking"
-		app.wasmKeeper = wasm.NewKeeper(
-				appCodec,
-				keys[wasm.StoreKey],
-				app.GetSubspace(wasm.ModuleName),
-				app.AccountKeeper,
-				app.BankKeeper,
-				app.StakingKeeper,
-				app.DistrKeeper,
-				app.IBCKeeper.ChannelKeeper,
-				&app.IBCKeeper.PortKeeper,
-				scopedWasmKeeper,
-				app.TransferKeeper,
-				app.Router(),
-				app.GRPCQueryRouter(),
-				wasmDir,
-				wasmConfig,
-				supportedFeatures,
-		)
-	
-		// The gov proposal types can be individually enabled
-		enabledProposals := wasmcmd.GetEnabledProposals(ProposalsEnabled, EnableSpecificProposals)
-		if len(enabledProposals) != 0 {
-			govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper, enabledProposals))
-		}`
-		replacementKeeperDefinition := fmt.Sprintf(templateKeeperDefinition, module.PlaceholderSgAppKeeperDefinition)
-		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDefinition, replacementKeeperDefinition)
-
-		templateAppModule := `%[1]v
-		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper),`
+	// The last arguments can contain custom message handlers, and custom query handlers,
+	// if we want to allow any custom callbacks
+	availableCapabilities := "iterator,staking,stargate"
+	app.WasmKeeper = wasm.NewKeeper(
+		appCodec,
+		keys[wasm.StoreKey],
+		app.GetSubspace(wasm.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedWasmKeeper,
+		app.TransferKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		wasmDir,
+		wasmConfig,
+		availableCapabilities,
+	)
+	wasmModule := wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper)
+
+	// The gov proposal types can be individually enabled
+	if enabledProposals := GetEnabledProposals(); len(enabledProposals) != 0 {
+		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
+	}
+
+	%[1]v`
+		replacementKeeperDefinition := fmt.Sprintf(templateKeeperDefinition, govKeeperDefinition)
+		content = replacer.Replace(content, govKeeperDefinition, replacementKeeperDefinition)
+
+		templateRouter := `ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
+%[1]v`
+		replacementRouter := fmt.Sprintf(templateRouter, module.PlaceholderIBCAppRouter)
+		content = replacer.Replace(content, module.PlaceholderIBCAppRouter, replacementRouter)
+
+		templateAppModule := `wasmModule,
+%[1]v`
 		replacementAppModule := fmt.Sprintf(templateAppModule, module.PlaceholderSgAppAppModule)
-		content = replacer.Replace(content, module.PlaceholderSgAppAppModule, replacementAppModule)
+		content = replacer.ReplaceAll(content, module.PlaceholderSgAppAppModule, replacementAppModule)
+
+		templateModuleName := `wasm.ModuleName,
+%[1]v`
+		for _, order := range []string{
+			module.PlaceholderSgAppBeginBlockers,
+			module.PlaceholderSgAppEndBlockers,
+			module.PlaceholderSgAppInitGenesis,
+		} {
+			content = replacer.Replace(content, order, fmt.Sprintf(templateModuleName, order))
+		}
 
-		templateInitGenesis := `%[1]v
-		wasm.ModuleName,`
-		replacementInitGenesis := fmt.Sprintf(templateInitGenesis, module.PlaceholderSgAppInitGenesis)
-		content = replacer.Replace(content, module.PlaceholderSgAppInitGenesis, replacementInitGenesis)
+		templateBeforeInitReturn := `app.ScopedWasmKeeper = scopedWasmKeeper
+%[1]v`
+		replacementBeforeInitReturn := fmt.Sprintf(templateBeforeInitReturn, module.PlaceholderSgAppBeforeInitReturn)
+		content = replacer.Replace(content, module.PlaceholderSgAppBeforeInitReturn, replacementBeforeInitReturn)
 
-		templateParamSubspace := `%[1]v
-		paramsKeeper.Subspace(wasm.ModuleName)`
+		templateParamSubspace := `paramsKeeper.Subspace(wasm.ModuleName)
+%[1]v`
 		replacementParamSubspace := fmt.Sprintf(templateParamSubspace, module.PlaceholderSgAppParamSubspace)
 		content = replacer.Replace(content, module.PlaceholderSgAppParamSubspace, replacementParamSubspace)
 
@@ -154,15 +206,14 @@ func cmdModifyStargate(replacer placeholder.Replacer, opts *ImportOptions) genny
 			return err
 		}
 
-		templateArgs := `cosmoscmd.AddSubCmd(wasmcmd.GenesisWasmMsgCmd(app.DefaultNodeHome)),
-cosmoscmd.CustomizeStartCmd(wasmcmd.AddModuleInitFlags),
+		// the flags of the wasm module are added to the start command
+		templateArgs := `cosmoscmd.CustomizeStartCmd(wasm.AddModuleInitFlags),
 		%[1]v`
 		replacementArgs := fmt.Sprintf(templateArgs, module.PlaceholderSgRootArgument)
 		content := replacer.Replace(f.String(), module.PlaceholderSgRootArgument, replacementArgs)
 
-		// import spm-extras.
 		content = replacer.Replace(content, "package main", `package main
-import "github.com/tendermint/spm-extras/wasmcmd"`)
+import "github.com/CosmWasm/wasmd/x/wasm"`)
 
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
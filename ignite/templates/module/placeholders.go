@@ -20,6 +20,8 @@ const (
 	PlaceholderSgAppScopedKeeper        = "// this line is used by starport scaffolding # stargate/app/scopedKeeper"
 	PlaceholderSgAppBeforeInitReturn    = "// this line is used by starport scaffolding # stargate/app/beforeInitReturn"
 	PlaceholderSgAppMaccPerms           = "// this line is used by starport scaffolding # stargate/app/maccPerms"
+	PlaceholderSgAppFeatureModule       = "// this line is used by starport scaffolding # stargate/app/featureModule"
+	PlaceholderSgAppAPIRoutes           = "// this line is used by starport scaffolding # stargate/app/apiRoutes"
 
 	// Placeholders in Stargate app.go for wasm
 	PlaceholderSgWasmAppEnabledProposals = "// this line is used by starport scaffolding # stargate/wasm/app/enabledProposals"
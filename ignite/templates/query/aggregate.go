@@ -0,0 +1,340 @@
+package query
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+var (
+	//go:embed aggregate/* aggregate/**/*
+	fsAggregate embed.FS
+)
+
+// AggregateOptions ...
+type AggregateOptions struct {
+	AppName     string
+	AppPath     string
+	ModuleName  string
+	ModulePath  string
+	QueryName   multiformatname.Name
+	Description string
+	Sources     []AggregateSource
+}
+
+// AggregateSource is a module whose data is aggregated by the query
+type AggregateSource struct {
+	// Name is the name of the module
+	Name multiformatname.Name
+
+	// TypesPath is the Go import path of the types of the module
+	TypesPath string
+
+	// ProtoFile is the proto file defining the queries of the module, e.g. cosmos/bank/v1beta1/query.proto
+	ProtoFile string
+
+	// ProtoPackage is the proto package of the queries of the module, e.g. cosmos.bank.v1beta1
+	ProtoPackage string
+
+	// Keeper is the name of the keeper of the module in the app, e.g. BankKeeper
+	Keeper string
+
+	// QueryServer is the query server of the module set in the app, the keeper when empty
+	QueryServer string
+
+	// Queries are the queries of the module sent by the aggregate query
+	Queries []AggregateSourceQuery
+}
+
+// AggregateSourceQuery is a query of a source module sent by the aggregate query
+type AggregateSourceQuery struct {
+	// Name is the name of the rpc of the query, e.g. TotalSupply
+	Name multiformatname.Name
+
+	// Request and Response are the messages of the query, e.g. QueryTotalSupplyRequest
+	Request  string
+	Response string
+}
+
+// TypesAlias returns the alias of the import of the types of the source module
+func (s AggregateSource) TypesAlias() string {
+	return s.Name.LowerCase + "types"
+}
+
+// NewAggregate returns the generator to scaffold a query aggregating the data of other modules
+func NewAggregate(replacer placeholder.Replacer, opts *AggregateOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(
+			fsAggregate,
+			"aggregate/",
+			opts.AppPath,
+		)
+	)
+
+	g.RunFn(protoAggregateModify(replacer, opts))
+	g.RunFn(cliQueryModify(replacer, &Options{
+		AppPath:    opts.AppPath,
+		ModuleName: opts.ModuleName,
+		QueryName:  opts.QueryName,
+	}))
+	g.RunFn(expectedKeepersAggregateModify(opts))
+	g.RunFn(keeperAggregateModify(opts))
+	g.RunFn(appAggregateModify(opts))
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("QueryName", opts.QueryName)
+	ctx.Set("Description", opts.Description)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("Sources", opts.Sources)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{queryName}}", opts.QueryName.Snake))
+	return g, nil
+}
+
+func protoAggregateModify(replacer placeholder.Replacer, opts *AggregateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// RPC service
+		templateRPC := `// Queries the data aggregated from the %[6]v modules.
+	rpc %[2]v(Query%[2]vRequest) returns (Query%[2]vResponse) {
+		option (google.api.http).get = "/%[3]v/%[4]v/%[5]v";
+	}
+
+%[1]v`
+		appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+		var sourceNames []string
+		for _, source := range opts.Sources {
+			sourceNames = append(sourceNames, source.Name.LowerCase)
+		}
+		replacementRPC := fmt.Sprintf(
+			templateRPC,
+			Placeholder2,
+			opts.QueryName.UpperCamel,
+			appModulePath,
+			opts.ModuleName,
+			opts.QueryName.Snake,
+			strings.Join(sourceNames, ", "),
+		)
+		content := replacer.Replace(f.String(), Placeholder2, replacementRPC)
+
+		// Fields for response, one with the response of each query of the source modules
+		var (
+			resFields string
+			index     int
+		)
+		for _, source := range opts.Sources {
+			for _, q := range source.Queries {
+				index++
+				resFields += fmt.Sprintf(
+					"  %s.%s %s_%s = %d;\n",
+					source.ProtoPackage,
+					q.Response,
+					source.Name.LowerCase,
+					q.Name.Snake,
+					index,
+				)
+			}
+
+			// Ensure the queries of the source module are imported
+			importModule := fmt.Sprintf(`
+import "%[1]v";`, source.ProtoFile)
+			content = strings.ReplaceAll(content, importModule, "")
+
+			replacementImport := fmt.Sprintf("%[1]v%[2]v", Placeholder, importModule)
+			content = replacer.Replace(content, Placeholder, replacementImport)
+		}
+
+		// Messages
+		templateMessages := `message Query%[2]vRequest {}
+
+message Query%[2]vResponse {
+%[3]v}
+
+%[1]v`
+		replacementMessages := fmt.Sprintf(
+			templateMessages,
+			Placeholder3,
+			opts.QueryName.UpperCamel,
+			resFields,
+		)
+		content = replacer.Replace(content, Placeholder3, replacementMessages)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// expectedKeepersAggregateModify defines the queries of the source modules expected by the module
+func expectedKeepersAggregateModify(opts *AggregateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, source := range opts.Sources {
+			interfaceName := fmt.Sprintf("%sQueryServer", xstrings.Title(source.Name.LowerCase))
+			if strings.Contains(content, fmt.Sprintf("type %s interface", interfaceName)) {
+				continue
+			}
+
+			var imports []string
+			if !strings.Contains(content, `"context"`) {
+				imports = append(imports, `"context"`)
+			}
+			typesImport := fmt.Sprintf(`%s "%s"`, source.TypesAlias(), source.TypesPath)
+			if !strings.Contains(content, typesImport) {
+				imports = append(imports, typesImport)
+			}
+			if !strings.Contains(content, "import (") {
+				return fmt.Errorf("%s has no import block", path)
+			}
+			content = strings.Replace(content, "import (", fmt.Sprintf("import (\n%s", strings.Join(imports, "\n")), 1)
+
+			var methods string
+			for _, q := range source.Queries {
+				methods += fmt.Sprintf(
+					"\t%[1]v(context.Context, *%[2]v.%[3]v) (*%[2]v.%[4]v, error)\n",
+					q.Name.UpperCamel,
+					source.TypesAlias(),
+					q.Request,
+					q.Response,
+				)
+			}
+
+			template := `
+// %[1]v defines the expected %[2]v queries aggregated by the module.
+type %[1]v interface {
+%[3]v	// Queries imported from %[2]v should be defined here
+}
+`
+			content += fmt.Sprintf(template, interfaceName, source.Name.LowerCase, methods)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperAggregateModify adds the queries of the source modules to the keeper of the module
+func keeperAggregateModify(opts *AggregateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		const keeperStruct = "Keeper struct {"
+		for _, source := range opts.Sources {
+			interfaceName := fmt.Sprintf("%sQueryServer", xstrings.Title(source.Name.LowerCase))
+			fieldName := fmt.Sprintf("%sQueryServer", source.Name.LowerCase)
+			if strings.Contains(content, fmt.Sprintf("%s types.%s", fieldName, interfaceName)) {
+				continue
+			}
+			if !strings.Contains(content, keeperStruct) {
+				return fmt.Errorf("%s has no Keeper struct", path)
+			}
+
+			content = strings.Replace(
+				content,
+				keeperStruct,
+				fmt.Sprintf("%s\n%s types.%s", keeperStruct, fieldName, interfaceName),
+				1,
+			)
+
+			template := `
+// Set%[1]v sets the %[3]v queries aggregated by the module.
+func (k *Keeper) Set%[1]v(%[2]v types.%[1]v) {
+	k.%[2]v = %[2]v
+}
+`
+			content += fmt.Sprintf(template, interfaceName, fieldName, source.Name.LowerCase)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appAggregateModify sets the keepers of the source modules as the queries aggregated by the module.
+// The queries are set before the app module is created since it holds a copy of the keeper.
+func appAggregateModify(opts *AggregateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		appModule := fmt.Sprintf("%[1]vModule := %[1]vmodule.NewAppModule(", opts.ModuleName)
+		appModuleIndex := strings.Index(content, appModule)
+		if appModuleIndex == -1 {
+			return fmt.Errorf("the app module of %s is not created in %s", opts.ModuleName, path)
+		}
+
+		for _, source := range opts.Sources {
+			sourceKeeper := fmt.Sprintf("app.%s", source.Keeper)
+			queryServer := source.QueryServer
+			if queryServer == "" {
+				queryServer = sourceKeeper
+			}
+			setter := fmt.Sprintf(
+				"app.%sKeeper.Set%sQueryServer(%s)",
+				xstrings.Title(opts.ModuleName),
+				xstrings.Title(source.Name.LowerCase),
+				queryServer,
+			)
+			if strings.Contains(content, setter) {
+				continue
+			}
+
+			sourceKeeperIndex := strings.Index(content, sourceKeeper+" = ")
+			if sourceKeeperIndex == -1 || sourceKeeperIndex > appModuleIndex {
+				return fmt.Errorf(
+					"the keeper of %s must be defined before the app module of %s in %s",
+					source.Name.LowerCase,
+					opts.ModuleName,
+					path,
+				)
+			}
+
+			content = strings.Replace(content, appModule, fmt.Sprintf("%s\n%s", setter, appModule), 1)
+			appModuleIndex = strings.Index(content, appModule)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
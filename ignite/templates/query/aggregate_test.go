@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+func TestNewAggregate(t *testing.T) {
+	name := func(s string) multiformatname.Name {
+		n, err := multiformatname.NewName(s)
+		require.NoError(t, err)
+		return n
+	}
+
+	appPath := t.TempDir()
+	opts := &AggregateOptions{
+		AppName:     "blog",
+		AppPath:     appPath,
+		ModuleName:  "mercury",
+		ModulePath:  "github.com/test/blog",
+		QueryName:   name("dashboard"),
+		Description: "Query dashboard",
+		Sources: []AggregateSource{
+			{
+				Name:         name("bank"),
+				TypesPath:    "github.com/cosmos/cosmos-sdk/x/bank/types",
+				ProtoFile:    "cosmos/bank/v1beta1/query.proto",
+				ProtoPackage: "cosmos.bank.v1beta1",
+				Keeper:       "BankKeeper",
+				Queries: []AggregateSourceQuery{
+					{Name: name("TotalSupply"), Request: "QueryTotalSupplyRequest", Response: "QueryTotalSupplyResponse"},
+				},
+			},
+			{
+				Name:         name("staking"),
+				TypesPath:    "github.com/cosmos/cosmos-sdk/x/staking/types",
+				ProtoFile:    "cosmos/staking/v1beta1/query.proto",
+				ProtoPackage: "cosmos.staking.v1beta1",
+				Keeper:       "StakingKeeper",
+				QueryServer:  "stakingkeeper.Querier{Keeper: app.StakingKeeper}",
+				Queries: []AggregateSourceQuery{
+					{Name: name("Validators"), Request: "QueryValidatorsRequest", Response: "QueryValidatorsResponse"},
+				},
+			},
+			{
+				Name:         name("mars"),
+				TypesPath:    "github.com/test/blog/x/mars/types",
+				ProtoFile:    "mars/query.proto",
+				ProtoPackage: "test.blog.mars",
+				Keeper:       "MarsKeeper",
+				Queries: []AggregateSourceQuery{
+					{Name: name("PostAll"), Request: "QueryAllPostRequest", Response: "QueryAllPostResponse"},
+				},
+			},
+		},
+	}
+
+	g, err := NewAggregate(placeholder.New(), opts)
+	require.NoError(t, err)
+
+	r := genny.DryRunner(context.Background())
+	for path, content := range map[string]string{
+		"proto/mercury/query.proto": `syntax = "proto3";
+package test.blog.mercury;
+
+import "gogoproto/gogo.proto";
+` + Placeholder + `
+
+service Query {
+	` + Placeholder2 + `
+}
+
+` + Placeholder3 + `
+`,
+		"x/mercury/client/cli/query.go": "package cli\n\n" + Placeholder + "\n",
+		"x/mercury/types/expected_keepers.go": `package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+`,
+		"x/mercury/keeper/keeper.go": `package keeper
+
+type (
+	Keeper struct {
+	}
+)
+`,
+		"app/app.go": `package app
+
+func New() {
+	app.BankKeeper = bankkeeper.NewBaseKeeper()
+	app.StakingKeeper = *stakingKeeper.SetHooks()
+	app.MarsKeeper = *marsmodulekeeper.NewKeeper()
+	mercuryModule := mercurymodule.NewAppModule(appCodec, app.MercuryKeeper)
+}
+`,
+	} {
+		r.Disk.Add(genny.NewFileS(filepath.Join(appPath, path), content))
+	}
+	r.With(g)
+	require.NoError(t, r.Run())
+
+	files := make(map[string]string)
+	for _, f := range r.Results().Files {
+		files[strings.TrimPrefix(f.Name(), appPath+"/")] = f.String()
+	}
+
+	// the response has the response of each query of the source modules.
+	proto := files["proto/mercury/query.proto"]
+	require.Contains(t, proto, `import "cosmos/bank/v1beta1/query.proto";`)
+	require.Contains(t, proto, `import "mars/query.proto";`)
+	require.Contains(t, proto, `message QueryDashboardResponse {
+  cosmos.bank.v1beta1.QueryTotalSupplyResponse bank_total_supply = 1;
+  cosmos.staking.v1beta1.QueryValidatorsResponse staking_validators = 2;
+  test.blog.mars.QueryAllPostResponse mars_post_all = 3;
+}`)
+
+	expectedKeepers := files["x/mercury/types/expected_keepers.go"]
+	require.Contains(t, expectedKeepers, `banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"`)
+	require.Contains(t, expectedKeepers, `type BankQueryServer interface {
+	TotalSupply(context.Context, *banktypes.QueryTotalSupplyRequest) (*banktypes.QueryTotalSupplyResponse, error)
+`)
+	require.Contains(t, expectedKeepers, `type MarsQueryServer interface {
+	PostAll(context.Context, *marstypes.QueryAllPostRequest) (*marstypes.QueryAllPostResponse, error)
+`)
+	_, err = parser.ParseFile(token.NewFileSet(), "expected_keepers.go", expectedKeepers, parser.AllErrors)
+	require.NoError(t, err)
+
+	// the staking keeper isn't a query server, its querier is set instead.
+	app := files["app/app.go"]
+	require.Contains(t, app, "app.MercuryKeeper.SetBankQueryServer(app.BankKeeper)\n")
+	require.Contains(t, app, "app.MercuryKeeper.SetStakingQueryServer(stakingkeeper.Querier{Keeper: app.StakingKeeper})\n")
+	require.Contains(t, app, "app.MercuryKeeper.SetMarsQueryServer(app.MarsKeeper)\n")
+
+	query := files["x/mercury/keeper/grpc_query_dashboard.go"]
+	_, err = parser.ParseFile(token.NewFileSet(), "grpc_query_dashboard.go", query, parser.AllErrors)
+	require.NoError(t, err)
+	require.Contains(t, query, "bankTotalSupply, err := k.bankQueryServer.TotalSupply(goCtx, &banktypes.QueryTotalSupplyRequest{})")
+	require.Contains(t, query, "res.StakingValidators = stakingValidators")
+	require.Contains(t, query, "marsPostAll, err := k.marsQueryServer.PostAll(goCtx, &marstypes.QueryAllPostRequest{})")
+}
@@ -0,0 +1,234 @@
+package query
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/pkg/xstrings"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+// AppInfoRoute is the REST route of the query returning the information of the app
+const AppInfoRoute = "/app/info"
+
+var (
+	//go:embed appinfo/* appinfo/**/*
+	fsAppInfo embed.FS
+)
+
+// AppInfoOptions represents the options to scaffold the query returning the information of the app
+type AppInfoOptions struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	QueryName  multiformatname.Name
+}
+
+// NewAppInfo returns the generator to scaffold a query returning the name and the version of the
+// app, its modules, its IBC apps and the metadata of its denoms
+func NewAppInfo(replacer placeholder.Replacer, opts *AppInfoOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(
+			fsAppInfo,
+			"appinfo/",
+			opts.AppPath,
+		)
+	)
+
+	g.RunFn(protoAppInfoModify(replacer, opts))
+	g.RunFn(cliQueryModify(replacer, &Options{
+		AppPath:    opts.AppPath,
+		ModuleName: opts.ModuleName,
+		QueryName:  opts.QueryName,
+	}))
+	g.RunFn(expectedKeepersAppInfoModify(opts))
+	g.RunFn(keeperAppInfoModify(opts))
+	g.RunFn(appAppInfoModify(opts))
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("QueryName", opts.QueryName)
+	ctx.Set("ModulePath", opts.ModulePath)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{queryName}}", opts.QueryName.Snake))
+	return g, nil
+}
+
+func protoAppInfoModify(replacer placeholder.Replacer, opts *AppInfoOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// RPC service
+		templateRPC := `// Queries the name, the version, the modules, the IBC apps and the denoms metadata of the app.
+	rpc %[2]v(Query%[2]vRequest) returns (Query%[2]vResponse) {
+		option (google.api.http).get = "%[3]v";
+	}
+
+%[1]v`
+		replacementRPC := fmt.Sprintf(
+			templateRPC,
+			Placeholder2,
+			opts.QueryName.UpperCamel,
+			AppInfoRoute,
+		)
+		content := replacer.Replace(f.String(), Placeholder2, replacementRPC)
+
+		// Ensure the denoms metadata of bank are imported
+		const importBank = `
+import "cosmos/bank/v1beta1/bank.proto";`
+		content = strings.ReplaceAll(content, importBank, "")
+		content = replacer.Replace(content, Placeholder, fmt.Sprintf("%[1]v%[2]v", Placeholder, importBank))
+
+		// Messages
+		templateMessages := `message Query%[2]vRequest {}
+
+message Query%[2]vResponse {
+  string name = 1;
+  string version = 2;
+  repeated string modules = 3;
+  repeated string ibcApps = 4;
+  repeated cosmos.bank.v1beta1.Metadata denomsMetadata = 5 [(gogoproto.nullable) = false];
+}
+
+%[1]v`
+		replacementMessages := fmt.Sprintf(
+			templateMessages,
+			Placeholder3,
+			opts.QueryName.UpperCamel,
+		)
+		content = replacer.Replace(content, Placeholder3, replacementMessages)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// expectedKeepersAppInfoModify defines the bank keeper returning the denoms metadata expected by the module
+func expectedKeepersAppInfoModify(opts *AppInfoOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		if !strings.Contains(content, "import (") {
+			return fmt.Errorf("%s has no import block", path)
+		}
+		imports := `banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"`
+		if !strings.Contains(content, `sdk "github.com/cosmos/cosmos-sdk/types"`) {
+			imports = fmt.Sprintf("sdk \"github.com/cosmos/cosmos-sdk/types\"\n%s", imports)
+		}
+		content = strings.Replace(content, "import (", fmt.Sprintf("import (\n%s", imports), 1)
+
+		content += `
+// DenomMetadataKeeper defines the expected bank keeper returning the denoms metadata of the app.
+type DenomMetadataKeeper interface {
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(banktypes.Metadata) bool)
+}
+`
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperAppInfoModify adds the sources of the information of the app to the keeper of the module
+func keeperAppInfoModify(opts *AppInfoOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		const keeperStruct = "Keeper struct {"
+		if !strings.Contains(content, keeperStruct) {
+			return fmt.Errorf("%s has no Keeper struct", path)
+		}
+		content = strings.Replace(
+			content,
+			keeperStruct,
+			fmt.Sprintf("%s\nappModules AppModules\ndenomMetadataKeeper types.DenomMetadataKeeper", keeperStruct),
+			1,
+		)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appAppInfoModify sets the modules of the app and the bank keeper as the sources of the information
+// of the app. They're set before the app module is created since it holds a copy of the keeper.
+func appAppInfoModify(opts *AppInfoOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		appModule := fmt.Sprintf("%[1]vModule := %[1]vmodule.NewAppModule(", opts.ModuleName)
+		appModuleIndex := strings.Index(content, appModule)
+		if appModuleIndex == -1 {
+			return fmt.Errorf("the app module of %s is not created in %s", opts.ModuleName, path)
+		}
+		bankKeeperIndex := strings.Index(content, "app.BankKeeper = ")
+		if bankKeeperIndex == -1 || bankKeeperIndex > appModuleIndex {
+			return fmt.Errorf(
+				"the bank keeper must be defined before the app module of %s in %s",
+				opts.ModuleName,
+				path,
+			)
+		}
+
+		setter := fmt.Sprintf(
+			"app.%sKeeper.SetAppInfoSources(app.appModules, app.BankKeeper)",
+			xstrings.Title(opts.ModuleName),
+		)
+		content = strings.Replace(content, appModule, fmt.Sprintf("%s\n%s", setter, appModule), 1)
+
+		// the modules are read from the module manager and the IBC router once they're created
+		content += `
+// appModules returns the names of the modules of the app and the names of its IBC apps
+func (app *App) appModules() (modules []string, ibcApps []string) {
+	for _, name := range app.mm.OrderInitGenesis {
+		modules = append(modules, name)
+		if app.IBCKeeper.Router != nil && app.IBCKeeper.Router.HasRoute(name) {
+			ibcApps = append(ibcApps, name)
+		}
+	}
+	return modules, ibcApps
+}
+`
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -16,4 +16,12 @@ type Options struct {
 	ResFields   field.Fields
 	ReqFields   field.Fields
 	Paginated   bool
+
+	// StoreType is the list or map type whose store is iterated by the query, StoreKey is the
+	// constant holding the prefix of its store. The query has no store when StoreKey is empty.
+	StoreType multiformatname.Name
+	StoreKey  string
+
+	// Filters are the request fields filtering the store by the leading indexes of its keys.
+	Filters field.Fields
 }
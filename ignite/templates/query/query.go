@@ -29,6 +29,9 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ReqFields", opts.ReqFields)
 	ctx.Set("ResFields", opts.ResFields)
 	ctx.Set("Paginated", opts.Paginated)
+	ctx.Set("StoreType", opts.StoreType)
+	ctx.Set("StoreKey", opts.StoreKey)
+	ctx.Set("Filters", opts.Filters)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
@@ -77,8 +77,18 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 		for i, field := range opts.ResFields {
 			resFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
+		resIndex := len(opts.ResFields) + 1
+		if opts.StoreKey != "" {
+			resFields += fmt.Sprintf(
+				"  repeated %s %s = %d [(gogoproto.nullable) = false];\n",
+				opts.StoreType.UpperCamel,
+				opts.StoreType.LowerCamel,
+				resIndex,
+			)
+			resIndex++
+		}
 		if opts.Paginated {
-			resFields += fmt.Sprintf("cosmos.base.query.v1beta1.PageResponse pagination = %d;\n", len(opts.ResFields)+1)
+			resFields += fmt.Sprintf("cosmos.base.query.v1beta1.PageResponse pagination = %d;\n", resIndex)
 		}
 
 		// Ensure custom types are imported
@@ -89,6 +99,11 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
 			)
 		}
+		if opts.StoreKey != "" {
+			protoImports = append(protoImports,
+				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, opts.StoreType.Snake),
+			)
+		}
 		for _, f := range protoImports {
 			importModule := fmt.Sprintf(`
 import "%[1]v";`, f)
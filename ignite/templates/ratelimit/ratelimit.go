@@ -0,0 +1,194 @@
+package ratelimit
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/message"
+)
+
+// msgServerContext is the statement of the message handlers after which the rate limit is checked
+const msgServerContext = "ctx := sdk.UnwrapSDKContext(goCtx)\n"
+
+var (
+	//go:embed stargate/support/* stargate/support/**/*
+	fsStargateSupport embed.FS
+
+	//go:embed stargate/message/* stargate/message/**/*
+	fsStargateMessage embed.FS
+)
+
+// SupportOptions represents the options to scaffold the rate limit support of a module
+type SupportOptions struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+}
+
+// Options represents the options to scaffold the rate limit of a message
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	MsgName    multiformatname.Name
+	Limit      uint64
+	Window     uint64
+}
+
+// NewStargateSupport returns the generator adding the rate limit support to a module: the store of
+// the number of messages sent by the addresses in the current window and the CheckRateLimit
+// method of the keeper
+func NewStargateSupport(opts *SupportOptions) (*genny.Generator, error) {
+	g := genny.New()
+
+	template := xgenny.NewEmbedWalker(fsStargateSupport, "stargate/support/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+// NewStargate returns the generator rate limiting a message of a module: the limit and the window
+// of the message are added to the module params and checked by the handler of the message
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoParamsModify(replacer, opts))
+	g.RunFn(typesParamsModify(replacer, opts))
+	g.RunFn(msgServerModify(replacer, opts))
+
+	template := xgenny.NewEmbedWalker(fsStargateMessage, "stargate/message/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("msgName", opts.MsgName)
+	ctx.Set("limit", opts.Limit)
+	ctx.Set("window", opts.Window)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{msgName}}", opts.MsgName.Snake))
+
+	return g, nil
+}
+
+func protoParamsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		fieldNumber, err := message.ParamsHighestFieldNumber(path)
+		if err != nil {
+			return err
+		}
+
+		template := `uint64 %[2]v_rate_limit = %[3]v [(gogoproto.moretags) = "yaml:\"%[2]v_rate_limit\""];
+  uint64 %[2]v_rate_window = %[4]v [(gogoproto.moretags) = "yaml:\"%[2]v_rate_window\""];
+  %[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			message.PlaceholderProtoParamsField,
+			opts.MsgName.Snake,
+			fieldNumber+1,
+			fieldNumber+2,
+		)
+		content := replacer.Replace(f.String(), message.PlaceholderProtoParamsField, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesParamsModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templatePairs := `paramtypes.NewParamSetPair(Key%[2]vRateLimit, &p.%[2]vRateLimit, validate%[2]vRateLimit),
+		paramtypes.NewParamSetPair(Key%[2]vRateWindow, &p.%[2]vRateWindow, validate%[2]vRateWindow),
+		%[1]v`
+		replacementPairs := fmt.Sprintf(templatePairs, message.PlaceholderParamsSetPair, opts.MsgName.UpperCamel)
+		content := replacer.Replace(f.String(), message.PlaceholderParamsSetPair, replacementPairs)
+
+		templateValidate := `if err := validate%[2]vRateLimit(p.%[2]vRateLimit); err != nil {
+		return err
+	}
+	if err := validate%[2]vRateWindow(p.%[2]vRateWindow); err != nil {
+		return err
+	}
+	%[1]v`
+		replacementValidate := fmt.Sprintf(templateValidate, message.PlaceholderParamsValidate, opts.MsgName.UpperCamel)
+		content = replacer.Replace(content, message.PlaceholderParamsValidate, replacementValidate)
+
+		templateDefault := `params.%[2]vRateLimit = Default%[2]vRateLimit
+	params.%[2]vRateWindow = Default%[2]vRateWindow
+	%[1]v`
+		replacementDefault := fmt.Sprintf(templateDefault, message.PlaceholderParamsDefault, opts.MsgName.UpperCamel)
+		content = replacer.Replace(content, message.PlaceholderParamsDefault, replacementDefault)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// msgServerModify checks the rate limit of the signer at the beginning of the message handler, the
+// message is only counted when it succeeds since the writes of the failed messages are discarded
+func msgServerModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(
+			opts.AppPath,
+			"x",
+			opts.ModuleName,
+			"keeper",
+			fmt.Sprintf("msg_server_%s.go", opts.MsgName.Snake),
+		)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `%[1]v
+	if err := k.Check%[2]vRateLimit(ctx, msg.GetSigners()[0].String()); err != nil {
+		return nil, err
+	}
+`
+		replacement := fmt.Sprintf(template, msgServerContext, opts.MsgName.UpperCamel)
+		content := replacer.Replace(f.String(), msgServerContext, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
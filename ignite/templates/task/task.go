@@ -0,0 +1,182 @@
+package task
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+)
+
+// PlaceholderTask is the placeholder of the registry of the scheduled tasks of a module
+const PlaceholderTask = "// this line is used by starport scaffolding # task"
+
+const (
+	// TypeName is the name of the type storing the schedules of the tasks
+	TypeName = "task-schedule"
+
+	// TypeIndex is the index of the schedules of the tasks
+	TypeIndex = "name"
+
+	// MsgPause is the name of the message pausing a task
+	MsgPause = "pause-task"
+
+	// MsgResume is the name of the message resuming a task
+	MsgResume = "resume-task"
+
+	// MsgReschedule is the name of the message changing the interval of a task
+	MsgReschedule = "reschedule-task"
+)
+
+// Fields of the schedule type and of the messages managing the tasks
+var (
+	TypeFields          = []string{"everyBlocks:uint", "everySeconds:uint", "lastHeight:uint", "lastTime:uint", "paused:bool"}
+	MsgNameFields       = []string{"name"}
+	MsgRescheduleFields = []string{"name", "everyBlocks:uint", "everySeconds:uint"}
+)
+
+var (
+	//go:embed stargate/registry/* stargate/registry/**/*
+	fsStargateRegistry embed.FS
+
+	//go:embed stargate/task/* stargate/task/**/*
+	fsStargateTask embed.FS
+)
+
+// RegistryOptions represents the options to scaffold the registry of the scheduled tasks of a module
+type RegistryOptions struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+}
+
+// Options represents the options to scaffold a scheduled task
+type Options struct {
+	AppName      string
+	AppPath      string
+	ModuleName   string
+	ModulePath   string
+	TaskName     multiformatname.Name
+	EveryBlocks  uint64
+	EverySeconds uint64
+}
+
+// Every returns the description of the interval of the task
+func (opts *Options) Every() string {
+	if opts.EveryBlocks > 0 {
+		return fmt.Sprintf("every %d blocks", opts.EveryBlocks)
+	}
+	return fmt.Sprintf("every %s", time.Duration(opts.EverySeconds)*time.Second)
+}
+
+// NewStargateRegistry returns the generator adding the registry of the scheduled tasks to a module
+// scaffolded with the schedule type and the messages managing the tasks.
+// The registry is executed in the begin blocker, the generator must run after the ones scaffolding
+// the type and the messages.
+func NewStargateRegistry(replacer placeholder.Replacer, opts *RegistryOptions) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(moduleModify(replacer, opts))
+
+	template := xgenny.NewEmbedWalker(fsStargateRegistry, "stargate/registry/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+// NewStargate returns the generator scaffolding a task registered in the task registry of the module
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(registryModify(replacer, opts))
+
+	template := xgenny.NewEmbedWalker(fsStargateTask, "stargate/task/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("taskName", opts.TaskName)
+	ctx.Set("every", opts.Every())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{taskName}}", opts.TaskName.Snake))
+
+	return g, nil
+}
+
+// moduleModify runs the scheduled tasks in the begin blocker of the module
+func moduleModify(replacer placeholder.Replacer, opts *RegistryOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		const beginBlock = "func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}"
+		replacement := `func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.RunScheduledTasks(ctx)
+}`
+		content := replacer.Replace(f.String(), beginBlock, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// registryModify registers the task in the task registry of the module
+func registryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/task.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `{
+			Name:         "%[2]v",
+			EveryBlocks:  %[3]v,
+			EverySeconds: %[4]v,
+			Run:          k.%[5]vTask,
+		},
+		%[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			PlaceholderTask,
+			opts.TaskName.Kebab,
+			opts.EveryBlocks,
+			opts.EverySeconds,
+			opts.TaskName.UpperCamel,
+		)
+		content := replacer.Replace(f.String(), PlaceholderTask, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -156,6 +156,9 @@ func protoTxModify(replacer placeholder.Replacer, opts *typed.Options) genny.Run
 
 		// Ensure custom types are imported
 		protoImports := opts.Fields.ProtoImports()
+		if opts.SignerOption {
+			protoImports = append(protoImports, typed.MsgProtoFile)
+		}
 		for _, f := range opts.Fields.Custom() {
 			protoImports = append(protoImports,
 				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
@@ -171,7 +174,7 @@ import "%[1]v";`, f)
 		}
 
 		templateMessages := `message MsgCreate%[2]v {
-  string %[3]v = 1;
+%[6]v  string %[3]v = 1;
 %[4]v}
 
 message MsgCreate%[2]vResponse {
@@ -179,14 +182,14 @@ message MsgCreate%[2]vResponse {
 }
 
 message MsgUpdate%[2]v {
-  string %[3]v = 1;
+%[6]v  string %[3]v = 1;
   uint64 id = 2;
 %[5]v}
 
 message MsgUpdate%[2]vResponse {}
 
 message MsgDelete%[2]v {
-  string %[3]v = 1;
+%[6]v  string %[3]v = 1;
   uint64 id = 2;
 }
 
@@ -198,6 +201,7 @@ message MsgDelete%[2]vResponse {}
 			opts.MsgSigner.LowerCamel,
 			createFields,
 			updateFields,
+			typed.ProtoMsgSignerOption(opts.SignerOption, opts.MsgSigner),
 		)
 		content = replacer.Replace(content, typed.PlaceholderProtoTxMessage, replacementMessages)
 
@@ -535,6 +535,9 @@ func protoTxModify(replacer placeholder.Replacer, opts *typed.Options) genny.Run
 
 		// Ensure custom types are imported
 		protoImports := append(opts.Fields.ProtoImports(), opts.Indexes.ProtoImports()...)
+		if opts.SignerOption {
+			protoImports = append(protoImports, typed.MsgProtoFile)
+		}
 		customFields := append(opts.Fields.Custom(), opts.Indexes.Custom()...)
 		for _, f := range customFields {
 			protoImports = append(protoImports,
@@ -551,19 +554,19 @@ import "%[1]v";`, f)
 		}
 
 		templateMessages := `message MsgCreate%[2]v {
-  string %[3]v = 1;
+%[6]v  string %[3]v = 1;
 %[4]v
 %[5]v}
 message MsgCreate%[2]vResponse {}
 
 message MsgUpdate%[2]v {
-  string %[3]v = 1;
+%[6]v  string %[3]v = 1;
 %[4]v
 %[5]v}
 message MsgUpdate%[2]vResponse {}
 
 message MsgDelete%[2]v {
-  string %[3]v = 1;
+%[6]v  string %[3]v = 1;
 %[4]v}
 message MsgDelete%[2]vResponse {}
 
@@ -573,6 +576,7 @@ message MsgDelete%[2]vResponse {}
 			opts.MsgSigner.LowerCamel,
 			indexes,
 			fields,
+			typed.ProtoMsgSignerOption(opts.SignerOption, opts.MsgSigner),
 		)
 		content = replacer.Replace(content, typed.PlaceholderProtoTxMessage, replacementMessages)
 
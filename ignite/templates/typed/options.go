@@ -18,6 +18,9 @@ type Options struct {
 	NoMessage    bool
 	NoSimulation bool
 	IsIBC        bool
+
+	// SignerOption annotates the signer field of the messages with the cosmos.msg.v1.signer option.
+	SignerOption bool
 }
 
 // Validate that options are usable
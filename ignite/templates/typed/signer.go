@@ -0,0 +1,20 @@
+package typed
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+)
+
+// MsgProtoFile is the proto file of the Cosmos SDK defining the options of the Msg services.
+const MsgProtoFile = "cosmos/msg/v1/msg.proto"
+
+// ProtoMsgSignerOption returns the option annotating the signer field of a scaffolded message,
+// it is empty when signerOption is not set.
+func ProtoMsgSignerOption(signerOption bool, signer multiformatname.Name) string {
+	if !signerOption {
+		return ""
+	}
+	return fmt.Sprintf("  option %s = \"%s\";\n\n", protoanalysis.OptionMsgSigner, signer.LowerCamel)
+}
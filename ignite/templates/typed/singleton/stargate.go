@@ -398,6 +398,9 @@ func protoTxModify(replacer placeholder.Replacer, opts *typed.Options) genny.Run
 
 		// Ensure custom types are imported
 		protoImports := opts.Fields.ProtoImports()
+		if opts.SignerOption {
+			protoImports = append(protoImports, typed.MsgProtoFile)
+		}
 		for _, f := range opts.Fields.Custom() {
 			protoImports = append(protoImports,
 				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
@@ -413,17 +416,17 @@ import "%[1]v";`, f)
 		}
 
 		templateMessages := `message MsgCreate%[2]v {
-  string %[3]v = 1;
+%[5]v  string %[3]v = 1;
 %[4]v}
 message MsgCreate%[2]vResponse {}
 
 message MsgUpdate%[2]v {
-  string %[3]v = 1;
+%[5]v  string %[3]v = 1;
 %[4]v}
 message MsgUpdate%[2]vResponse {}
 
 message MsgDelete%[2]v {
-  string %[3]v = 1;
+%[5]v  string %[3]v = 1;
 }
 message MsgDelete%[2]vResponse {}
 %[1]v`
@@ -431,6 +434,7 @@ message MsgDelete%[2]vResponse {}
 			opts.TypeName.UpperCamel,
 			opts.MsgSigner.LowerCamel,
 			fields,
+			typed.ProtoMsgSignerOption(opts.SignerOption, opts.MsgSigner),
 		)
 		content = replacer.Replace(content, typed.PlaceholderProtoTxMessage, replacementMessages)
 
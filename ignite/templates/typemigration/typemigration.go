@@ -0,0 +1,235 @@
+package typemigration
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+)
+
+// registerServicesContext is the statement of RegisterServices after which the migrations are registered
+const registerServicesContext = "types.RegisterQueryServer(cfg.QueryServer(), am.keeper)\n"
+
+var (
+	//go:embed stargate/support/* stargate/support/**/*
+	fsStargateSupport embed.FS
+
+	//go:embed stargate/migration/* stargate/migration/**/*
+	fsStargateMigration embed.FS
+
+	// consensusVersionRe matches the consensus version returned by the AppModule of a module.
+	consensusVersionRe = regexp.MustCompile(`(ConsensusVersion\(\)\s*uint64\s*{\s*return\s+)(\d+)`)
+
+	// registerMigrationRe matches the registrations of the migrations of a module.
+	registerMigrationRe = regexp.MustCompile(`(?s)if err := cfg\.RegisterMigration\(.*?\n\t}\n`)
+)
+
+// SupportOptions represents the options to scaffold the migrations support of a module
+type SupportOptions struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+}
+
+// Options represents the options to scaffold the migration adding a field to a stored type
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	TypeName   multiformatname.Name
+	Field      field.Field
+
+	// FieldNumber is the number of the field added to the proto message of the type.
+	FieldNumber int
+
+	// DefaultValue is the Go literal of the value of the field in the existing records.
+	DefaultValue string
+
+	// IsSingleton is true when the type is stored as a singleton, a collection otherwise.
+	IsSingleton bool
+
+	// From is the consensus version of the module migrated to From+1.
+	From uint64
+}
+
+// To returns the consensus version of the module after the migration.
+func (opts *Options) To() uint64 {
+	return opts.From + 1
+}
+
+// ConsensusVersion returns the consensus version of the module defined in module.go.
+func ConsensusVersion(moduleGo string) (uint64, error) {
+	match := consensusVersionRe.FindStringSubmatch(moduleGo)
+	if match == nil {
+		return 0, fmt.Errorf("the consensus version of the module is not found")
+	}
+	return strconv.ParseUint(match[2], 10, 64)
+}
+
+// NewStargateSupport returns the generator adding the migrations support to a module: the
+// Migrator of the keeper running the migrations of the store
+func NewStargateSupport(opts *SupportOptions) (*genny.Generator, error) {
+	g := genny.New()
+
+	template := xgenny.NewEmbedWalker(fsStargateSupport, "stargate/support/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+// NewStargate returns the generator adding a field to a stored type: the field is added to the proto
+// message of the type, the consensus version of the module is bumped and the migration setting the
+// field of the existing records is registered
+func NewStargate(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoModify(opts))
+	g.RunFn(moduleModify(opts))
+
+	template := xgenny.NewEmbedWalker(fsStargateMigration, "stargate/migration/", opts.AppPath)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("typeName", opts.TypeName)
+	ctx.Set("field", opts.Field)
+	ctx.Set("defaultValue", opts.DefaultValue)
+	ctx.Set("isSingleton", opts.IsSingleton)
+	ctx.Set("from", opts.From)
+	ctx.Set("to", opts.To())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{version}}", strconv.FormatUint(opts.To(), 10)))
+
+	return g, nil
+}
+
+func protoModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, opts.TypeName.Snake+".proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := addProtoField(
+			f.String(),
+			opts.TypeName.UpperCamel,
+			opts.Field.ProtoType(opts.FieldNumber),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func moduleModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := registerMigration(f.String(), opts.From, opts.To())
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// addProtoField adds the field declaration, e.g. uint64 score = 5, at the end of the message of the proto file.
+func addProtoField(proto, messageName, declaration string) (string, error) {
+	re := regexp.MustCompile(`message\s+` + regexp.QuoteMeta(messageName) + `\s*{`)
+	loc := re.FindStringIndex(proto)
+	if loc == nil {
+		return "", fmt.Errorf("the message %s is not found", messageName)
+	}
+
+	// find the closing brace of the message, the options of the fields can't contain braces
+	depth := 1
+	for i := loc[1]; i < len(proto); i++ {
+		switch proto[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+		if depth > 0 {
+			continue
+		}
+		body := strings.TrimRight(proto[:i], " \t\n")
+		return fmt.Sprintf("%s\n  %s;\n%s", body, declaration, proto[i:]), nil
+	}
+	return "", fmt.Errorf("the message %s is not closed", messageName)
+}
+
+// registerMigration bumps the consensus version of the module.go of a module from the version from
+// to to and registers the migration between the two versions in RegisterServices.
+func registerMigration(moduleGo string, from, to uint64) (string, error) {
+	version, err := ConsensusVersion(moduleGo)
+	if err != nil {
+		return "", err
+	}
+	if version != from {
+		return "", fmt.Errorf("the consensus version of the module is %d, not %d", version, from)
+	}
+	moduleGo = consensusVersionRe.ReplaceAllString(moduleGo, fmt.Sprintf("${1}%d", to))
+
+	registration := fmt.Sprintf(`	if err := cfg.RegisterMigration(types.ModuleName, %[1]d, m.Migrate%[1]dto%[2]d); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%%s from version %[1]d to %[2]d: %%v", types.ModuleName, err))
+	}
+`, from, to)
+
+	// the registration follows the registrations of the previous migrations
+	if locs := registerMigrationRe.FindAllStringIndex(moduleGo, -1); len(locs) > 0 {
+		end := locs[len(locs)-1][1]
+		return moduleGo[:end] + registration + moduleGo[end:], nil
+	}
+
+	if !strings.Contains(moduleGo, registerServicesContext) {
+		return "", fmt.Errorf("the query server registration of the module is not found")
+	}
+	return strings.Replace(
+		moduleGo,
+		registerServicesContext,
+		registerServicesContext+"\tm := keeper.NewMigrator(am.keeper)\n"+registration,
+		1,
+	), nil
+}
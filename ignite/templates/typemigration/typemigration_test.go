@@ -0,0 +1,79 @@
+package typemigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddProtoField(t *testing.T) {
+	const proto = `syntax = "proto3";
+package blog.blog;
+
+message Post {
+  string index = 1;
+  string title = 2 [(gogoproto.moretags) = "yaml:\"title\""];
+  string creator = 3;
+}
+
+message Comment {
+  string body = 1;
+}
+`
+
+	content, err := addProtoField(proto, "Post", "uint64 score = 4")
+	require.NoError(t, err)
+	require.Equal(t, `syntax = "proto3";
+package blog.blog;
+
+message Post {
+  string index = 1;
+  string title = 2 [(gogoproto.moretags) = "yaml:\"title\""];
+  string creator = 3;
+  uint64 score = 4;
+}
+
+message Comment {
+  string body = 1;
+}
+`, content)
+
+	_, err = addProtoField(proto, "Like", "uint64 score = 1")
+	require.Error(t, err)
+}
+
+func TestRegisterMigration(t *testing.T) {
+	const moduleGo = `func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// ConsensusVersion implements ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+`
+
+	content, err := registerMigration(moduleGo, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, `func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+}
+
+// ConsensusVersion implements ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+`, content)
+
+	// the next migration is registered after the previous one.
+	content, err = registerMigration(content, 3, 4)
+	require.NoError(t, err)
+	require.Contains(t, content, `m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {`)
+	require.Contains(t, content, "ConsensusVersion() uint64 { return 4 }")
+
+	_, err = registerMigration(moduleGo, 3, 4)
+	require.Error(t, err)
+}
@@ -36,6 +36,11 @@ var (
 	Head = "-"
 )
 
+// IsRelease returns true when Ignite CLI is a released version, not a development or nightly build.
+func IsRelease() bool {
+	return Version != versionDev && Version != versionNightly
+}
+
 // CheckNext checks whether there is a new version of Ignite CLI.
 func CheckNext(ctx context.Context) (isAvailable bool, version string, err error) {
 	if Version == versionDev || Version == versionNightly {
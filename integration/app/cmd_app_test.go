@@ -67,6 +67,31 @@ func TestGenerateAnAppWithNoDefaultModuleAndCreateAModule(t *testing.T) {
 	))
 }
 
+func TestGenerateAnAppWithHexAddressCodec(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("github.com/test/blog", "--address-codec", "hex")
+	)
+
+	config, err := os.ReadFile(filepath.Join(path, "config.yml"))
+	require.NoError(t, err)
+	require.Contains(t, string(config), `address_codec: "hex"`)
+
+	env.Must(env.Exec("should scaffold a list type with hex encoded creators",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "post", "title", "body"),
+			step.Workdir(path),
+		)),
+	))
+
+	messages, err := os.ReadFile(filepath.Join(path, "x", "blog", "types", "messages_post.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(messages), "addresscodec.FromString(msg.Creator)")
+	require.NotContains(t, string(messages), "AccAddressFromBech32")
+
+	env.EnsureAppIsSteady(path)
+}
+
 func TestGenerateAnAppWithWasm(t *testing.T) {
 	t.Skip()
 
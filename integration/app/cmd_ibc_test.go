@@ -82,6 +82,26 @@ func TestCreateModuleWithIBC(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create an upgradable IBC module negotiating the channel version",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"module",
+				"--yes",
+				"versionedfoo",
+				"--ibc",
+				"--ordering",
+				"ordered",
+				"--channel-version",
+				"versionedfoo-2,versionedfoo-1",
+				"--upgradable",
+				"--require-registration",
+			),
+			step.Workdir(path),
+		)),
+	))
+
 	env.Must(env.Exec("create a non IBC module",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp, "s", "module", "--yes", "non_ibc", "--require-registration"),